@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/enterprise-pms/pms-api/internal/config"
+	"github.com/enterprise-pms/pms-api/internal/domain/auth"
 	"github.com/enterprise-pms/pms-api/internal/handler"
 	"github.com/enterprise-pms/pms-api/internal/jobs"
 	"github.com/enterprise-pms/pms-api/internal/middleware"
@@ -40,16 +41,24 @@ func main() {
 	// Initialize services
 	svc := service.New(repos, cfg, log)
 
+	// Register the permissions the router enforces so admins can grant them
+	if _, err := svc.RoleMgt.RegisterPermissions(context.Background(), auth.Permissions()); err != nil {
+		log.Fatal().Err(err).Msg("Failed to register permissions")
+	}
+
 	// Initialize and start background job scheduler
 	// Replaces .NET Hangfire server + BackgroundService hosted services.
 	scheduler := jobs.NewScheduler(svc, repos, cfg, log)
 	scheduler.Start(context.Background())
 
 	// Initialize middleware
-	mw := middleware.New(cfg, log)
+	mw := middleware.New(cfg, log, svc.Auth)
 
 	// Initialize HTTP handlers and router
-	router := handler.NewRouter(svc, mw, cfg, log)
+	router, err := handler.NewRouter(svc, mw, cfg, log)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to initialize router")
+	}
 
 	// Create HTTP server
 	srv := &http.Server{
@@ -59,6 +68,9 @@ func main() {
 		WriteTimeout: cfg.Server.WriteTimeout,
 		IdleTimeout:  cfg.Server.IdleTimeout,
 	}
+	// Live notification streams never finish on their own; end them when
+	// shutdown starts so it does not wait out the timeout.
+	srv.RegisterOnShutdown(svc.Notification.CloseStreams)
 
 	// Start server in a goroutine
 	go func() {
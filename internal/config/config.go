@@ -14,6 +14,7 @@ type Config struct {
 	Database        DatabaseConfig        `mapstructure:"database"`
 	JWT             JWTConfig             `mapstructure:"jwt"`
 	ActiveDirectory ActiveDirectoryConfig `mapstructure:"active_directory"`
+	OIDC            OIDCConfig            `mapstructure:"oidc"`
 	Email           EmailConfig           `mapstructure:"email"`
 	Bitly           BitlyConfig           `mapstructure:"bitly"`
 	CORS            CORSConfig            `mapstructure:"cors"`
@@ -29,14 +30,30 @@ type Config struct {
 	Storage         StorageConfig         `mapstructure:"storage"`
 	Encryption      EncryptionConfig      `mapstructure:"encryption"`
 	SOA             SOAConfig             `mapstructure:"soa"`
+	Competency      CompetencyConfig      `mapstructure:"competency"`
+	SLA             SLAConfig             `mapstructure:"sla"`
+	Reassignment    ReassignmentConfig    `mapstructure:"reassignment"`
 }
 
-// JobsConfig holds background job processing settings.
+// JobsConfig holds background job processing settings. Failed queue jobs
+// are retried after RetryBaseDelay, doubling per attempt up to RetryMaxDelay,
+// until MaxAttempts is reached and the job is dead-lettered. LeaseTTL bounds
+// how long a dead replica keeps scheduler leadership or a job lock.
 type JobsConfig struct {
 	WorkerPoolSize     int           `mapstructure:"worker_pool_size"`
-	WorkerQueueSize    int           `mapstructure:"worker_queue_size"`
+	PollInterval       time.Duration `mapstructure:"poll_interval"`
+	JobTimeout         time.Duration `mapstructure:"job_timeout"`
+	MaxAttempts        int           `mapstructure:"max_attempts"`
+	RetryBaseDelay     time.Duration `mapstructure:"retry_base_delay"`
+	RetryMaxDelay      time.Duration `mapstructure:"retry_max_delay"`
+	LeaseTTL           time.Duration `mapstructure:"lease_ttl"`
 	MailSenderInterval time.Duration `mapstructure:"mail_sender_interval"`
 	CronSchedule       string        `mapstructure:"cron_schedule"`
+	// Schedules seeds per-job cron schedules, keyed by job name, the first
+	// time a job is registered; CronSchedule is used for jobs not listed.
+	// Afterwards the schedule in pms.recurring_jobs is authoritative.
+	Schedules    map[string]string `mapstructure:"schedules"`
+	SyncInterval time.Duration     `mapstructure:"sync_interval"`
 }
 
 // ServerConfig holds HTTP server settings.
@@ -112,6 +129,26 @@ type ActiveDirectoryConfig struct {
 	Password string `mapstructure:"password"`
 }
 
+// OIDCConfig holds OpenID Connect single sign-on settings. Users sign in
+// at the identity provider with the authorization-code flow and PKCE; the
+// value of UsernameClaim in the ID token must be the user's staff ID, as the
+// sAMAccountName is for AD logins.
+type OIDCConfig struct {
+	Enabled      bool     `mapstructure:"enabled"`
+	IssuerURL    string   `mapstructure:"issuer_url"`
+	ClientID     string   `mapstructure:"client_id"`
+	ClientSecret string   `mapstructure:"client_secret"`
+	RedirectURL  string   `mapstructure:"redirect_url"`
+	Scopes       []string `mapstructure:"scopes"`
+	// UsernameClaim and GroupsClaim name the ID token claims holding the
+	// username and the user's IdP groups.
+	UsernameClaim string `mapstructure:"username_claim"`
+	GroupsClaim   string `mapstructure:"groups_claim"`
+	// RoleMappings maps IdP group names to PMS role names. Groups are
+	// matched case-insensitively; unmapped groups are ignored.
+	RoleMappings map[string]string `mapstructure:"role_mappings"`
+}
+
 // EmailConfig holds SMTP email settings.
 type EmailConfig struct {
 	SMTPServer     string `mapstructure:"smtp_server"`
@@ -121,6 +158,14 @@ type EmailConfig struct {
 	SenderDisplay  string `mapstructure:"sender_display"`
 	ApplicationURL string `mapstructure:"application_url"`
 	ToAllStaff     string `mapstructure:"to_all_staff"`
+	// A failed send is retried after RetryBaseDelay, doubling per attempt
+	// up to RetryMaxDelay, until MaxAttempts is reached. An email still
+	// Processing after StuckAfter is reported as stuck.
+	MaxAttempts    int           `mapstructure:"max_attempts"`
+	RetryBaseDelay time.Duration `mapstructure:"retry_base_delay"`
+	RetryMaxDelay  time.Duration `mapstructure:"retry_max_delay"`
+	StuckAfter     time.Duration `mapstructure:"stuck_after"`
+	BatchSize      int           `mapstructure:"batch_size"`
 }
 
 // BitlyConfig holds Bitly API settings.
@@ -195,7 +240,28 @@ type RSAConfig struct {
 
 // StorageConfig holds file storage settings.
 type StorageConfig struct {
+	// Backend selects where uploads are stored: "local" or "s3".
+	Backend  string `mapstructure:"backend"`
 	BasePath string `mapstructure:"base_path"`
+	// MaxUploadBytes caps the size of a single upload.
+	MaxUploadBytes int64 `mapstructure:"max_upload_bytes"`
+	// AllowedContentTypes lists the sniffed MIME types accepted for upload.
+	AllowedContentTypes []string `mapstructure:"allowed_content_types"`
+	// DownloadURLTTL is how long a signed download URL stays valid.
+	DownloadURLTTL time.Duration `mapstructure:"download_url_ttl"`
+	// URLSigningKey signs download URLs; the JWT secret is used when empty.
+	URLSigningKey string   `mapstructure:"url_signing_key"`
+	S3            S3Config `mapstructure:"s3"`
+}
+
+// S3Config holds settings for an S3-compatible object store (AWS S3, MinIO).
+type S3Config struct {
+	Endpoint        string `mapstructure:"endpoint"`
+	Region          string `mapstructure:"region"`
+	Bucket          string `mapstructure:"bucket"`
+	AccessKeyID     string `mapstructure:"access_key_id"`
+	SecretAccessKey string `mapstructure:"secret_access_key"`
+	UsePathStyle    bool   `mapstructure:"use_path_style"`
 }
 
 // EncryptionConfig holds AES encryption settings.
@@ -209,6 +275,41 @@ type SOAConfig struct {
 	APIUrl string `mapstructure:"api_url"`
 }
 
+// CompetencyConfig holds competency development settings. Staff with an
+// open development plan are reminded the listed numbers of days before its
+// target date, and they and their supervisor the listed numbers of days
+// after it.
+type CompetencyConfig struct {
+	ReminderDaysBefore []int `mapstructure:"reminder_days_before"`
+	ReminderDaysAfter  []int `mapstructure:"reminder_days_after"`
+}
+
+// SLAConfig defines the business calendar feedback request SLAs run on.
+// Only the hours between DayStart and DayEnd ("15:04") on WorkingDays count,
+// and ERP public holidays and the assignee's approved absences are skipped.
+// The SLA global settings are in 24-hour days, so every 24 hours allowed is
+// one working day. Timezone is an IANA name; empty means the server's zone.
+type SLAConfig struct {
+	WorkingDays []string `mapstructure:"working_days"`
+	DayStart    string   `mapstructure:"day_start"`
+	DayEnd      string   `mapstructure:"day_end"`
+	Timezone    string   `mapstructure:"timezone"`
+}
+
+// ReassignmentConfig controls how breached feedback requests are reassigned
+// when ENABLE_AUTO_REASSIGN_REQUEST_BACKGROUND_SERVICE is on. An active
+// delegation of the assignee wins; otherwise Policies are tried in order
+// until one names a new assignee: "supervisor", "org_chain" (the nearest
+// head of office, division or department), "pool" (the Pool staff IDs in
+// turn) and "hrd_queue" (HRD staff in turn). A request is never handed back
+// to a previous assignee or its owner, and is reassigned automatically at
+// most MaxPerRequest times; 0 means no limit.
+type ReassignmentConfig struct {
+	Policies      []string `mapstructure:"policies"`
+	Pool          []string `mapstructure:"pool"`
+	MaxPerRequest int      `mapstructure:"max_per_request"`
+}
+
 // Load reads the configuration from files and environment variables.
 func Load() (*Config, error) {
 	v := viper.New()
@@ -313,6 +414,11 @@ func setDefaults(v *viper.Viper) {
 
 	// Email
 	v.SetDefault("email.smtp_port", 25)
+	v.SetDefault("email.max_attempts", 5)
+	v.SetDefault("email.retry_base_delay", "1m")
+	v.SetDefault("email.retry_max_delay", "1h")
+	v.SetDefault("email.stuck_after", "30m")
+	v.SetDefault("email.batch_size", 50)
 
 	// Bitly
 	v.SetDefault("bitly.group_guid", "")
@@ -341,9 +447,30 @@ func setDefaults(v *viper.Viper) {
 
 	// Jobs
 	v.SetDefault("jobs.worker_pool_size", 5)
-	v.SetDefault("jobs.worker_queue_size", 100)
+	v.SetDefault("jobs.poll_interval", "5s")
+	v.SetDefault("jobs.job_timeout", "30m")
+	v.SetDefault("jobs.max_attempts", 5)
+	v.SetDefault("jobs.retry_base_delay", "30s")
+	v.SetDefault("jobs.retry_max_delay", "1h")
+	v.SetDefault("jobs.lease_ttl", "1m")
 	v.SetDefault("jobs.mail_sender_interval", "30s")
 	v.SetDefault("jobs.cron_schedule", "@every 10m")
+	v.SetDefault("jobs.sync_interval", "15s")
+
+	// Competency
+	v.SetDefault("competency.reminder_days_before", []int{7, 1})
+	v.SetDefault("competency.reminder_days_after", []int{1, 7})
+
+	// SLA
+	v.SetDefault("sla.working_days", []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday"})
+	v.SetDefault("sla.day_start", "08:00")
+	v.SetDefault("sla.day_end", "17:00")
+	v.SetDefault("sla.timezone", "")
+
+	// Reassignment
+	v.SetDefault("reassignment.policies", []string{"supervisor", "org_chain", "hrd_queue"})
+	v.SetDefault("reassignment.pool", []string{})
+	v.SetDefault("reassignment.max_per_request", 3)
 
 	// Hangfire
 	v.SetDefault("hangfire_schema", "WebAPiHangfire")
@@ -369,12 +496,44 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("general.logo", "")
 	v.SetDefault("general.no_reply_email", "")
 
+	// OIDC
+	v.SetDefault("oidc.enabled", false)
+	v.SetDefault("oidc.issuer_url", "")
+	v.SetDefault("oidc.client_id", "")
+	v.SetDefault("oidc.client_secret", "")
+	v.SetDefault("oidc.redirect_url", "")
+	v.SetDefault("oidc.scopes", []string{"openid", "profile", "email"})
+	v.SetDefault("oidc.username_claim", "preferred_username")
+	v.SetDefault("oidc.groups_claim", "groups")
+	v.SetDefault("oidc.role_mappings", map[string]string{})
+
 	// RSA
 	v.SetDefault("rsa.base_url", "")
 	v.SetDefault("rsa.api_key", "")
 
 	// Storage
+	v.SetDefault("storage.backend", "local")
 	v.SetDefault("storage.base_path", "./uploads")
+	v.SetDefault("storage.max_upload_bytes", 10<<20)
+	v.SetDefault("storage.allowed_content_types", []string{
+		"application/pdf",
+		"image/png",
+		"image/jpeg",
+		"text/plain",
+		"text/csv",
+		"application/msword",
+		"application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+		"application/vnd.ms-excel",
+		"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	})
+	v.SetDefault("storage.download_url_ttl", "15m")
+	v.SetDefault("storage.url_signing_key", "")
+	v.SetDefault("storage.s3.endpoint", "")
+	v.SetDefault("storage.s3.region", "us-east-1")
+	v.SetDefault("storage.s3.bucket", "")
+	v.SetDefault("storage.s3.access_key_id", "")
+	v.SetDefault("storage.s3.secret_access_key", "")
+	v.SetDefault("storage.s3.use_path_style", true)
 
 	// Encryption
 	v.SetDefault("encryption.key", "")
@@ -23,6 +23,40 @@ type AuthenticateResponse struct {
 	ExpiresAt          int64    `json:"expires_at"`
 }
 
+// MFAChallengeResponse is returned by login instead of an
+// AuthenticateResponse when the user must complete a second factor. The
+// challenge token is exchanged, together with an RSA SecurID token code, for
+// the JWT pair at POST /api/v1/auth/mfa/verify.
+type MFAChallengeResponse struct {
+	MFARequired    bool   `json:"mfa_required"`
+	ChallengeToken string `json:"challenge_token"`
+	ExpiresAt      int64  `json:"expires_at"`
+}
+
+// MFAVerifyRequest is the request payload for completing a second factor.
+type MFAVerifyRequest struct {
+	ChallengeToken string `json:"challenge_token" validate:"required"`
+	TokenCode      string `json:"token_code"      validate:"required"`
+}
+
+// OIDCLoginResponse starts a single sign-on login. The client sends the
+// user to AuthorizationURL and posts the code and state the identity
+// provider returns to POST /api/v1/auth/oidc/callback. State is not part of
+// the body: the handler binds it to the browser in a cookie that the
+// callback must present.
+type OIDCLoginResponse struct {
+	AuthorizationURL string `json:"authorization_url"`
+	State            string `json:"-"`
+	ExpiresAt        int64  `json:"expires_at"`
+}
+
+// OIDCCallbackRequest is the request payload for completing a single
+// sign-on login.
+type OIDCCallbackRequest struct {
+	Code  string `json:"code"  validate:"required"`
+	State string `json:"state" validate:"required"`
+}
+
 // TokenResponse is returned when refreshing an access token.
 type TokenResponse struct {
 	AccessToken  string `json:"access_token"`
@@ -35,6 +69,17 @@ type RefreshTokenRequest struct {
 	RefreshToken string `json:"refresh_token" validate:"required"`
 }
 
+// SessionVm describes an active session for the session list.
+type SessionVm struct {
+	ID         string    `json:"id"`
+	Device     string    `json:"device"`
+	IPAddress  string    `json:"ip_address"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	Current    bool      `json:"current"`
+}
+
 // ADUser holds the user information retrieved from Active Directory.
 type ADUser struct {
 	Username    string `json:"username"`
@@ -0,0 +1,27 @@
+package auth
+
+import "time"
+
+// MFAChallenge is a pending second-factor step issued after a successful
+// password check. The client receives the plaintext challenge token; only
+// its SHA-256 hash is stored. AuthnAttemptID and MessageID correlate the
+// challenge with the RSA SecurID authentication attempt started at login.
+type MFAChallenge struct {
+	ID             string     `json:"id"               gorm:"column:id;primaryKey;size:450"`
+	UserID         string     `json:"user_id"          gorm:"column:user_id;not null;size:450;index"`
+	Token          string     `json:"-"                gorm:"column:token;not null;size:64;uniqueIndex"`
+	AuthnAttemptID string     `json:"authn_attempt_id" gorm:"column:authn_attempt_id"`
+	MessageID      string     `json:"message_id"       gorm:"column:message_id"`
+	Attempts       int        `json:"attempts"         gorm:"column:attempts;default:0"`
+	ExpiresAt      time.Time  `json:"expires_at"       gorm:"column:expires_at;not null"`
+	ConsumedAt     *time.Time `json:"consumed_at"      gorm:"column:consumed_at"`
+	CreatedAt      time.Time  `json:"created_at"       gorm:"column:created_at;autoCreateTime"`
+}
+
+// TableName returns the fully-qualified PostgreSQL table name including the schema prefix.
+func (MFAChallenge) TableName() string { return "CoreSchema.mfa_challenges" }
+
+// IsUsable reports whether the challenge can still be answered at now.
+func (c *MFAChallenge) IsUsable(now time.Time) bool {
+	return c.ConsumedAt == nil && now.Before(c.ExpiresAt)
+}
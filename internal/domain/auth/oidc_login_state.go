@@ -0,0 +1,51 @@
+package auth
+
+import "time"
+
+// OIDCLoginState is a pending single sign-on login, created when the user
+// is sent to the identity provider and consumed by the callback. The client
+// holds the plaintext state; only its SHA-256 hash is stored. Nonce and
+// CodeVerifier never leave the server.
+type OIDCLoginState struct {
+	ID           string     `json:"id"          gorm:"column:id;primaryKey;size:450"`
+	State        string     `json:"-"           gorm:"column:state;not null;size:64;uniqueIndex"`
+	Nonce        string     `json:"-"           gorm:"column:nonce;not null"`
+	CodeVerifier string     `json:"-"           gorm:"column:code_verifier;not null"`
+	ExpiresAt    time.Time  `json:"expires_at"  gorm:"column:expires_at;not null"`
+	ConsumedAt   *time.Time `json:"consumed_at" gorm:"column:consumed_at"`
+	CreatedAt    time.Time  `json:"created_at"  gorm:"column:created_at;autoCreateTime"`
+}
+
+// TableName returns the fully-qualified PostgreSQL table name including the schema prefix.
+func (OIDCLoginState) TableName() string { return "CoreSchema.oidc_login_states" }
+
+// IsUsable reports whether the login can still be completed at now.
+func (s *OIDCLoginState) IsUsable(now time.Time) bool {
+	return s.ConsumedAt == nil && now.Before(s.ExpiresAt)
+}
+
+// UserIdPRole is a PMS role granted to a user through their identity
+// provider groups. The set is replaced at every single sign-on login and
+// merged into the user's roles alongside the roles assigned in PMS.
+type UserIdPRole struct {
+	UserID   string    `json:"user_id"   gorm:"column:user_id;primaryKey;size:450"`
+	RoleName string    `json:"role_name" gorm:"column:role_name;primaryKey;size:256"`
+	SyncedAt time.Time `json:"synced_at" gorm:"column:synced_at;not null"`
+}
+
+// TableName returns the fully-qualified PostgreSQL table name including the schema prefix.
+func (UserIdPRole) TableName() string { return "CoreSchema.user_idp_roles" }
+
+// UserExternalLogin links a PMS user to the identity provider account that
+// signs them in, keyed by the ID token's immutable issuer and subject. The
+// username claim is only used to find an existing account on its first
+// single sign-on login.
+type UserExternalLogin struct {
+	Issuer    string    `json:"issuer"     gorm:"column:issuer;primaryKey;size:512"`
+	Subject   string    `json:"subject"    gorm:"column:subject;primaryKey;size:255"`
+	UserID    string    `json:"user_id"    gorm:"column:user_id;not null;size:450;index"`
+	CreatedAt time.Time `json:"created_at" gorm:"column:created_at;autoCreateTime"`
+}
+
+// TableName returns the fully-qualified PostgreSQL table name including the schema prefix.
+func (UserExternalLogin) TableName() string { return "CoreSchema.user_external_logins" }
@@ -0,0 +1,120 @@
+package auth
+
+// Permission constants name the entries of the CoreSchema.permissions table
+// that guard the API. Each /api/v1 route requires one of them (see
+// handler/route_policies.go); admins grant them to roles through the
+// /rolemgmt endpoints and they reach the JWT at login.
+const (
+	PermPerformanceSetupView     = "PerformanceSetup.View"
+	PermPerformanceSetupManage   = "PerformanceSetup.Manage"
+	PermObjectivesView           = "Objectives.View"
+	PermObjectivesManage         = "Objectives.Manage"
+	PermObjectivesApprove        = "Objectives.Approve"
+	PermReviewPeriodView         = "ReviewPeriod.View"
+	PermReviewPeriodManage       = "ReviewPeriod.Manage"
+	PermReviewPeriodApprove      = "ReviewPeriod.Approve"
+	PermObjectivePlanningPlan    = "ObjectivePlanning.Plan"
+	PermObjectivePlanningApprove = "ObjectivePlanning.Approve"
+	PermWorkProductsManage       = "WorkProducts.Manage"
+	PermWorkProductsApprove      = "WorkProducts.Approve"
+	PermEvaluationSubmit         = "Evaluation.Submit"
+	PermEvaluationApprove        = "Evaluation.Approve"
+	PermAdhocView                = "Adhoc.View"
+	PermAdhocManage              = "Adhoc.Manage"
+	PermAdhocApprove             = "Adhoc.Approve"
+	PermFeedbackParticipate      = "Feedback.Participate"
+	PermFeedbackManage           = "Feedback.Manage"
+	PermPerformanceView          = "Performance.View"
+	PermPerformanceReports       = "Performance.Reports"
+	PermCompetencyView           = "Competency.View"
+	PermCompetencyReview         = "Competency.Review"
+	PermCompetencyManage         = "Competency.Manage"
+	PermCompetencyApprove        = "Competency.Approve"
+	PermReview360Manage          = "Review360.Manage"
+	PermGrievanceRaise           = "Grievance.Raise"
+	PermGrievanceResolve         = "Grievance.Resolve"
+	PermGrievanceReport          = "Grievance.Report"
+	PermCalibrationManage        = "Calibration.Manage"
+	PermOrganogramView           = "Organogram.View"
+	PermOrganogramManage         = "Organogram.Manage"
+	PermEmployeesView            = "Employees.View"
+	PermJobRoleRequest           = "JobRole.Request"
+	PermJobRoleApprove           = "JobRole.Approve"
+	PermUsersManage              = "Users.Manage"
+	PermPermissionsManage        = "Permissions.Manage"
+	PermSettingsManage           = "Settings.Manage"
+	PermJobsManage               = "Jobs.Manage"
+	PermAuditTrailView           = "AuditTrail.View"
+	PermAuditConfigManage        = "AuditConfig.Manage"
+
+	// PermStaffDataAll guards no route. It widens the caller's data scope
+	// from their own part of the ERP hierarchy to every staff member.
+	PermStaffDataAll = "StaffData.All"
+)
+
+// PermissionDefinition describes a permission the API registers at startup.
+// DefaultRoles receive the permission when it is first registered; later
+// changes to its grants are left to admins.
+type PermissionDefinition struct {
+	Name         string
+	Description  string
+	DefaultRoles []string
+}
+
+// Permissions returns every permission the API enforces.
+func Permissions() []PermissionDefinition {
+	admins := []string{RoleAdmin, RoleSuperAdmin}
+	staff := []string{RoleStaff}
+	managers := []string{
+		RoleSupervisor, RoleHeadOfOffice, RoleHeadOfDivision, RoleHeadOfDepartment,
+		RoleDeputyDirector, RoleDirector, RoleApprover, RoleAdmin, RoleSuperAdmin,
+	}
+	with := func(base []string, extra ...string) []string {
+		return append(append([]string{}, base...), extra...)
+	}
+
+	return []PermissionDefinition{
+		{PermPerformanceSetupView, "View strategies, themes, categories, evaluation options and other performance setup", staff},
+		{PermPerformanceSetupManage, "Create and change performance setup", with(admins, RoleSmd)},
+		{PermObjectivesView, "View enterprise, department, division and office objectives", staff},
+		{PermObjectivesManage, "Create, upload, deactivate and reactivate organisational objectives", with(admins, RoleSmd)},
+		{PermObjectivesApprove, "Approve or reject performance setup and objective records", with(admins, RoleSmdApprover)},
+		{PermReviewPeriodView, "View review periods, their objectives, extensions and evaluations", staff},
+		{PermReviewPeriodManage, "Create, change, open, close and archive review periods", with(admins, RoleSmd)},
+		{PermReviewPeriodApprove, "Approve, reject or return review periods, their category definitions and extensions", with(admins, RoleSmdApprover)},
+		{PermObjectivePlanningPlan, "Plan and submit one's own individual objectives", staff},
+		{PermObjectivePlanningApprove, "Approve, return, pause, suspend and reinstate staff objectives", managers},
+		{PermWorkProductsManage, "Plan, update and complete one's own work products and tasks", staff},
+		{PermWorkProductsApprove, "Assign, approve, evaluate and suspend staff work products", managers},
+		{PermEvaluationSubmit, "Record objective evaluations", with(managers, RoleSmdOutcomeEvaluator)},
+		{PermEvaluationApprove, "Approve or reject objective evaluations", with(managers, RoleSmdApprover)},
+		{PermAdhocView, "View projects and committees", staff},
+		{PermAdhocManage, "Set up projects and committees and manage their members and objectives", staff},
+		{PermAdhocApprove, "Approve, reject or return projects, committees and their members", managers},
+		{PermFeedbackParticipate, "Request, give and treat feedback", staff},
+		{PermFeedbackManage, "View all feedback requests and reassign or close them", with(admins, RoleHRD, RoleHrAdmin)},
+		{PermPerformanceView, "View one's own scores, scorecards and dashboard", staff},
+		{PermPerformanceReports, "View performance reports for subordinates and the organogram", with(managers, RoleHRD, RoleHrReportAdmin, RoleGeneralReportAdmin)},
+		{PermCompetencyView, "View competency setup, reviews and development plans", staff},
+		{PermCompetencyReview, "Submit competency reviews, ratings and development plans", staff},
+		{PermCompetencyManage, "Maintain competency setup and run review population and calculation", with(admins, RoleHrAdmin)},
+		{PermCompetencyApprove, "Approve competencies and competency review periods", with(admins, RoleHrApprover)},
+		{PermReview360Manage, "Trigger and initiate 360-degree reviews", with(admins, RoleHRD, RoleHrAdmin)},
+		{PermGrievanceRaise, "Raise and follow one's own grievances", staff},
+		{PermGrievanceResolve, "Record grievance resolutions", with(admins, RoleHRD, RoleHrAdmin)},
+		{PermGrievanceReport, "View the grievance report", with(admins, RoleHRD, RoleHrAdmin, RoleHrReportAdmin)},
+		{PermCalibrationManage, "Run calibration sessions and view quotas", with(admins, RoleHRD)},
+		{PermOrganogramView, "View directorates, departments, divisions and offices", staff},
+		{PermOrganogramManage, "Maintain the organogram and seed it from the ERP", admins},
+		{PermEmployeesView, "Look up employees, subordinates and peers", staff},
+		{PermJobRoleRequest, "Request a change of one's own job role", staff},
+		{PermJobRoleApprove, "Review and decide staff job role requests", with(managers, RoleHrAdmin)},
+		{PermUsersManage, "Manage staff accounts, roles and sessions", with(admins, RoleSecurityAdmin)},
+		{PermPermissionsManage, "Grant and revoke role permissions", with(admins, RoleSecurityAdmin)},
+		{PermSettingsManage, "Maintain settings, PMS configurations, approval chains, grading scales and calibration quotas", admins},
+		{PermJobsManage, "Manage background and recurring jobs", admins},
+		{PermAuditTrailView, "View audit trails and audit logs", with(admins, RoleHRD, RoleHrAdmin)},
+		{PermAuditConfigManage, "Choose which entities and attributes are audited", admins},
+		{PermStaffDataAll, "Read and act on the performance, competency and grievance records of all staff", with(admins, RoleHRD, RoleHrAdmin, RoleHrReportAdmin)},
+	}
+}
@@ -4,9 +4,12 @@ import "time"
 
 // RefreshToken represents a hashed refresh token stored in the database.
 // The plaintext token is never persisted; only a SHA-256 hash is stored.
+// Tokens are single-use: each refresh revokes the presented token and issues
+// a new one in the same session.
 type RefreshToken struct {
 	ID        string    `json:"id"         gorm:"column:id;primaryKey;size:450"`
 	UserID    string    `json:"user_id"    gorm:"column:user_id;not null;size:450;index"`
+	SessionID string    `json:"session_id" gorm:"column:session_id;size:450;index"`
 	Token     string    `json:"-"          gorm:"column:token;not null;size:64;uniqueIndex"`
 	ExpiresAt time.Time `json:"expires_at" gorm:"column:expires_at;not null"`
 	CreatedAt time.Time `json:"created_at" gorm:"column:created_at;autoCreateTime"`
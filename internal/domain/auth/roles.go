@@ -99,4 +99,9 @@ const (
 	SettingDefaultPassword      = "DEFAULT_PASSWORD"
 	SettingMaxFailedAttempts     = "MAX_FAILED_ACCESS_ATTEMPTS"
 	SettingLockoutDuration      = "LOCKOUT_DURATION_MINUTES"
+	// SettingRequireMFA requires an RSA SecurID token code from every user.
+	SettingRequireMFA = "REQUIRE_MFA"
+	// SettingMFARequiredRoles is a comma-separated list of roles that must
+	// complete a second factor even when REQUIRE_MFA is off.
+	SettingMFARequiredRoles = "MFA_REQUIRED_ROLES"
 )
@@ -0,0 +1,36 @@
+package auth
+
+import "time"
+
+// UserSession is one signed-in device. Every access token carries its
+// session ID ("sid" claim) and every refresh token belongs to exactly one
+// session, so a session is also the family that refresh-token rotation
+// revokes as a whole when reuse is detected.
+type UserSession struct {
+	ID            string     `json:"id"             gorm:"column:id;primaryKey;size:450"`
+	UserID        string     `json:"user_id"        gorm:"column:user_id;not null;size:450;index"`
+	Device        string     `json:"device"         gorm:"column:device"`
+	IPAddress     string     `json:"ip_address"     gorm:"column:ip_address"`
+	CreatedAt     time.Time  `json:"created_at"     gorm:"column:created_at;autoCreateTime"`
+	LastUsedAt    time.Time  `json:"last_used_at"   gorm:"column:last_used_at"`
+	ExpiresAt     time.Time  `json:"expires_at"     gorm:"column:expires_at;not null"`
+	RevokedAt     *time.Time `json:"revoked_at"     gorm:"column:revoked_at;index"`
+	RevokedReason string     `json:"revoked_reason" gorm:"column:revoked_reason"`
+}
+
+// TableName returns the fully-qualified PostgreSQL table name including the schema prefix.
+func (UserSession) TableName() string { return "CoreSchema.user_sessions" }
+
+// IsActive reports whether the session can still be used at now.
+func (s *UserSession) IsActive(now time.Time) bool {
+	return s.RevokedAt == nil && now.Before(s.ExpiresAt)
+}
+
+// Session revocation reasons recorded on UserSession.RevokedReason.
+const (
+	SessionRevokedLogout      = "logout"
+	SessionRevokedByUser      = "revoked_by_user"
+	SessionRevokedByAdmin     = "revoked_by_admin"
+	SessionRevokedReuse       = "refresh_token_reuse"
+	SessionRevokedDeactivated = "user_deactivated"
+)
@@ -130,6 +130,8 @@ type CompetencyReviewProfile struct {
 func (CompetencyReviewProfile) TableName() string { return "CoreSchema.competency_review_profiles" }
 
 // DevelopmentPlan records a training/development action to close a competency gap.
+// TaskStatus holds an enums.DevelopmentTaskStatus name; VerifiedBy and
+// DateVerified record the supervisor's sign-off.
 type DevelopmentPlan struct {
 	DevelopmentPlanID         int        `json:"development_plan_id"          gorm:"column:development_plan_id;primaryKey;autoIncrement"`
 	CompetencyReviewProfileID int        `json:"competency_review_profile_id" gorm:"column:competency_review_profile_id;not null"`
@@ -140,9 +142,13 @@ type DevelopmentPlan struct {
 	CompletionDate            *time.Time `json:"completion_date"              gorm:"column:completion_date"`
 	TaskStatus                string     `json:"task_status"                  gorm:"column:task_status"`
 	LearningResource          string     `json:"learning_resource"            gorm:"column:learning_resource"`
+	VerifiedBy                string     `json:"verified_by"                  gorm:"column:verified_by"`
+	DateVerified              *time.Time `json:"date_verified"                gorm:"column:date_verified"`
+	SupervisorComment         string     `json:"supervisor_comment"           gorm:"column:supervisor_comment"`
 	domain.BaseAudit
 
-	CompetencyReviewProfile *CompetencyReviewProfile `json:"competency_review_profile" gorm:"foreignKey:CompetencyReviewProfileID"`
+	CompetencyReviewProfile *CompetencyReviewProfile  `json:"competency_review_profile" gorm:"foreignKey:CompetencyReviewProfileID"`
+	Evidence                []DevelopmentPlanEvidence `json:"evidence"                  gorm:"foreignKey:DevelopmentPlanID"`
 }
 
 func (DevelopmentPlan) TableName() string { return "CoreSchema.development_plans" }
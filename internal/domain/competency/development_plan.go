@@ -0,0 +1,170 @@
+package competency
+
+import (
+	"strings"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/domain"
+	"github.com/enterprise-pms/pms-api/internal/domain/enums"
+)
+
+// DevelopmentPlanEvidence attaches a file to a development plan as proof
+// of the activity. FileUploadID is a FileUpload issued by POST /api/v1/files.
+type DevelopmentPlanEvidence struct {
+	DevelopmentPlanEvidenceID int       `json:"development_plan_evidence_id" gorm:"column:development_plan_evidence_id;primaryKey;autoIncrement"`
+	DevelopmentPlanID         int       `json:"development_plan_id"          gorm:"column:development_plan_id;not null;index"`
+	FileUploadID              string    `json:"file_upload_id"               gorm:"column:file_upload_id;not null"`
+	Description               string    `json:"description"                  gorm:"column:description"`
+	UploadedBy                string    `json:"uploaded_by"                  gorm:"column:uploaded_by"`
+	DateUploaded              time.Time `json:"date_uploaded"                gorm:"column:date_uploaded;autoCreateTime"`
+}
+
+func (DevelopmentPlanEvidence) TableName() string { return "CoreSchema.development_plan_evidence" }
+
+// DevelopmentPlanReminder records a reminder sent about a development
+// plan's target date, so each reminder goes out once. ReminderKey names
+// the reminder, e.g. "before:7" or "after:1".
+type DevelopmentPlanReminder struct {
+	DevelopmentPlanID int       `json:"development_plan_id" gorm:"column:development_plan_id;primaryKey"`
+	ReminderKey       string    `json:"reminder_key"        gorm:"column:reminder_key;primaryKey"`
+	SentAt            time.Time `json:"sent_at"             gorm:"column:sent_at;not null"`
+}
+
+func (DevelopmentPlanReminder) TableName() string { return "CoreSchema.development_plan_reminders" }
+
+// DevelopmentPlanActor is who may move a development plan between two
+// statuses.
+type DevelopmentPlanActor int
+
+const (
+	DevelopmentPlanActorNone DevelopmentPlanActor = iota
+	// DevelopmentPlanActorEmployee is the employee the plan belongs to.
+	DevelopmentPlanActorEmployee
+	// DevelopmentPlanActorSupervisor is anyone above the employee in the
+	// reporting line, never the employee themselves.
+	DevelopmentPlanActorSupervisor
+)
+
+// DevelopmentPlanTransition returns who may move a plan from one status to
+// another, or DevelopmentPlanActorNone when the move is not allowed. The
+// supervisor confirms drafted plans; the employee starts and completes the
+// plan; the supervisor verifies it, returns it for more work, or closes the
+// gap once it is verified.
+func DevelopmentPlanTransition(from, to enums.DevelopmentTaskStatus) DevelopmentPlanActor {
+	switch to {
+	case enums.DevelopmentTaskStatusAssigned:
+		if from == enums.DevelopmentTaskStatusDraft {
+			return DevelopmentPlanActorSupervisor
+		}
+	case enums.DevelopmentTaskStatusInProgress:
+		switch from {
+		case enums.DevelopmentTaskStatusAssigned, enums.DevelopmentTaskStatusInitiated:
+			return DevelopmentPlanActorEmployee
+		case enums.DevelopmentTaskStatusCompleted:
+			return DevelopmentPlanActorSupervisor
+		}
+	case enums.DevelopmentTaskStatusCompleted:
+		switch from {
+		case enums.DevelopmentTaskStatusAssigned, enums.DevelopmentTaskStatusInitiated, enums.DevelopmentTaskStatusInProgress:
+			return DevelopmentPlanActorEmployee
+		}
+	case enums.DevelopmentTaskStatusVerified:
+		if from == enums.DevelopmentTaskStatusCompleted {
+			return DevelopmentPlanActorSupervisor
+		}
+	case enums.DevelopmentTaskStatusClosedGap:
+		if from == enums.DevelopmentTaskStatusVerified {
+			return DevelopmentPlanActorSupervisor
+		}
+	}
+	return DevelopmentPlanActorNone
+}
+
+// IsDevelopmentPlanOpen reports whether a plan in the status still awaits
+// the employee's work.
+func IsDevelopmentPlanOpen(status enums.DevelopmentTaskStatus) bool {
+	switch status {
+	case enums.DevelopmentTaskStatusAssigned, enums.DevelopmentTaskStatusInitiated, enums.DevelopmentTaskStatusInProgress:
+		return true
+	}
+	return false
+}
+
+// CloseGap records that the employee now meets the expected rating.
+func (p *CompetencyReviewProfile) CloseGap() {
+	p.HaveGap = false
+	p.CompetencyGap = 0
+	p.AverageRatingID = p.ExpectedRatingID
+	p.AverageRatingValue = p.ExpectedRatingValue
+	p.AverageScore = float64(p.ExpectedRatingValue)
+	p.AverageRatingName = p.ExpectedRatingName
+}
+
+// DevelopmentPlanRule recommends a development activity for a competency
+// gap. A rule applies to one competency when CompetencyID is set, otherwise
+// to every competency in CompetencyCategoryName, otherwise to every
+// competency. It covers gaps from MinGap to MaxGap inclusive; a MaxGap of 0
+// has no upper bound. Drafted plans are due TargetDays after drafting.
+type DevelopmentPlanRule struct {
+	DevelopmentPlanRuleID  int    `json:"development_plan_rule_id"  gorm:"column:development_plan_rule_id;primaryKey;autoIncrement"`
+	CompetencyID           *int   `json:"competency_id"             gorm:"column:competency_id"`
+	CompetencyCategoryName string `json:"competency_category_name"  gorm:"column:competency_category_name"`
+	MinGap                 int    `json:"min_gap"                   gorm:"column:min_gap;not null;default:1"`
+	MaxGap                 int    `json:"max_gap"                   gorm:"column:max_gap;not null;default:0"`
+	TrainingTypeName       string `json:"training_type_name"        gorm:"column:training_type_name;not null"`
+	Activity               string `json:"activity"                  gorm:"column:activity;not null"`
+	LearningResource       string `json:"learning_resource"         gorm:"column:learning_resource"`
+	TargetDays             int    `json:"target_days"               gorm:"column:target_days;not null;default:90"`
+	domain.BaseAudit
+}
+
+func (DevelopmentPlanRule) TableName() string { return "CoreSchema.development_plan_rules" }
+
+// specificity ranks how narrowly a rule targets a profile's competency:
+// 2 for the competency itself, 1 for its category, 0 for any competency,
+// and -1 when the rule does not apply to it or to the size of its gap.
+func (r DevelopmentPlanRule) specificity(p CompetencyReviewProfile) int {
+	if p.CompetencyGap < r.MinGap || (r.MaxGap > 0 && p.CompetencyGap > r.MaxGap) {
+		return -1
+	}
+	switch {
+	case r.CompetencyID != nil:
+		if *r.CompetencyID == p.CompetencyID {
+			return 2
+		}
+		return -1
+	case strings.TrimSpace(r.CompetencyCategoryName) != "":
+		if strings.EqualFold(strings.TrimSpace(r.CompetencyCategoryName), strings.TrimSpace(p.CompetencyCategoryName)) {
+			return 1
+		}
+		return -1
+	}
+	return 0
+}
+
+// RecommendDevelopmentActivities returns the active rules recommending
+// activities for a profile's gap. Only the most specific rules apply: rules
+// for the competency itself replace rules for its category, which replace
+// rules for any competency. A profile without a gap gets none.
+func RecommendDevelopmentActivities(rules []DevelopmentPlanRule, p CompetencyReviewProfile) []DevelopmentPlanRule {
+	if !p.HaveGap || p.CompetencyGap <= 0 {
+		return nil
+	}
+	best := -1
+	var matched []DevelopmentPlanRule
+	for _, r := range rules {
+		if !r.IsActive || r.SoftDeleted {
+			continue
+		}
+		s := r.specificity(p)
+		switch {
+		case s < 0 || s < best:
+			continue
+		case s > best:
+			best = s
+			matched = matched[:0]
+		}
+		matched = append(matched, r)
+	}
+	return matched
+}
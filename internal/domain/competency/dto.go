@@ -366,6 +366,12 @@ type DevelopmentPlanVm struct {
 	CurrentGap                int        `json:"currentGap"`
 	EmployeeName              string     `json:"employeeName"`
 	TrainingTypeName          string     `json:"trainingTypeName"          validate:"required"`
+	VerifiedBy                string     `json:"verifiedBy"`
+	DateVerified              *time.Time `json:"dateVerified"`
+	SupervisorComment         string     `json:"supervisorComment"`
+	IsOverdue                 bool       `json:"isOverdue"`
+
+	Evidence []DevelopmentPlanEvidenceVm `json:"evidence,omitempty"`
 }
 
 // ---------------------------------------------------------------------------
@@ -0,0 +1,154 @@
+package competency
+
+import "time"
+
+// ---------------------------------------------------------------------------
+// Development plan lifecycle DTOs
+// ---------------------------------------------------------------------------
+
+// DevelopmentPlanEvidenceVm is a file attached to a development plan.
+type DevelopmentPlanEvidenceVm struct {
+	DevelopmentPlanEvidenceID int       `json:"developmentPlanEvidenceId"`
+	FileUploadID              string    `json:"fileUploadId"`
+	OriginalName              string    `json:"originalName"`
+	Description               string    `json:"description"`
+	UploadedBy                string    `json:"uploadedBy"`
+	DateUploaded              time.Time `json:"dateUploaded"`
+}
+
+// DevelopmentPlanResponseVm wraps a single development plan with its evidence.
+type DevelopmentPlanResponseVm struct {
+	BaseAPIResponse
+	Data *DevelopmentPlanVm `json:"data"`
+}
+
+// DevelopmentPlanStatusRequestModel moves a development plan to Status, an
+// enums.DevelopmentTaskStatus name. CompletionDate applies when completing
+// and defaults to today; Comment is the supervisor's remark when verifying
+// or returning the plan.
+type DevelopmentPlanStatusRequestModel struct {
+	DevelopmentPlanID int        `json:"-"`
+	Status            string     `json:"status"         validate:"required"`
+	CompletionDate    *time.Time `json:"completionDate"`
+	Comment           string     `json:"comment"        validate:"max=1000"`
+	UpdatedBy         string     `json:"-"`
+}
+
+// DevelopmentPlanEvidenceRequestModel attaches an uploaded file to a
+// development plan.
+type DevelopmentPlanEvidenceRequestModel struct {
+	DevelopmentPlanID int    `json:"-"`
+	FileUploadID      string `json:"fileUploadId" validate:"required"`
+	Description       string `json:"description"  validate:"max=500"`
+	UploadedBy        string `json:"-"`
+}
+
+// DevelopmentPlanCompletionSearchModel selects the plans a completion report
+// covers. GroupBy is "office", "division" or "department" (the default);
+// the unit filters narrow the report as they do for review profiles.
+type DevelopmentPlanCompletionSearchModel struct {
+	ReviewPeriodID *int   `json:"reviewPeriodId"`
+	OfficeID       *int   `json:"officeId"`
+	DivisionID     *int   `json:"divisionId"`
+	DepartmentID   *int   `json:"departmentId"`
+	GroupBy        string `json:"groupBy"`
+}
+
+// DevelopmentPlanCompletionVm counts one unit's development plans by status.
+// Planned covers Assigned and Initiated plans; Overdue counts open plans
+// past their target date. CompletionRate is the percentage of plans
+// completed, verified or closed.
+type DevelopmentPlanCompletionVm struct {
+	UnitID         string  `json:"unitId"`
+	UnitName       string  `json:"unitName"`
+	TotalPlans     int     `json:"totalPlans"`
+	Planned        int     `json:"planned"`
+	InProgress     int     `json:"inProgress"`
+	Completed      int     `json:"completed"`
+	Verified       int     `json:"verified"`
+	ClosedGap      int     `json:"closedGap"`
+	Overdue        int     `json:"overdue"`
+	CompletionRate float64 `json:"completionRate"`
+}
+
+// DevelopmentPlanCompletionListResponseVm wraps a development plan
+// completion report.
+type DevelopmentPlanCompletionListResponseVm struct {
+	BaseAPIResponse
+	GroupBy     string                        `json:"groupBy"`
+	Data        []DevelopmentPlanCompletionVm `json:"data"`
+	TotalRecord int                           `json:"totalRecord"`
+}
+
+// DevelopmentPlanListResponseVm wraps a list of development plans.
+type DevelopmentPlanListResponseVm struct {
+	BaseAPIResponse
+	Data        []DevelopmentPlanVm `json:"data"`
+	TotalRecord int                 `json:"totalRecord"`
+}
+
+// ---------------------------------------------------------------------------
+// Development plan drafting DTOs
+// ---------------------------------------------------------------------------
+
+// DevelopmentPlanRuleVm is a rule recommending a development activity for
+// a competency gap. Leave CompetencyID and CompetencyCategoryName empty for
+// a rule that applies to every competency; a MaxGap of 0 has no upper bound.
+type DevelopmentPlanRuleVm struct {
+	BaseAuditVm
+	DevelopmentPlanRuleID  int    `json:"developmentPlanRuleId"`
+	CompetencyID           *int   `json:"competencyId"`
+	CompetencyName         string `json:"competencyName"`
+	CompetencyCategoryName string `json:"competencyCategoryName"`
+	MinGap                 int    `json:"minGap"           validate:"min=1"`
+	MaxGap                 int    `json:"maxGap"           validate:"min=0"`
+	TrainingTypeName       string `json:"trainingTypeName" validate:"required"`
+	Activity               string `json:"activity"         validate:"required,min=5,max=500"`
+	LearningResource       string `json:"learningResource" validate:"max=500"`
+	TargetDays             int    `json:"targetDays"       validate:"min=0"`
+}
+
+// DevelopmentPlanRuleListResponseVm wraps the development plan rules.
+type DevelopmentPlanRuleListResponseVm struct {
+	BaseAPIResponse
+	Data        []DevelopmentPlanRuleVm `json:"data"`
+	TotalRecord int                     `json:"totalRecord"`
+}
+
+// DevelopmentPlanDraftRequestModel selects the review profiles to draft
+// development plans for. Profiles with a gap and no development plan are
+// drafted; the filters narrow them as they do for review profiles.
+type DevelopmentPlanDraftRequestModel struct {
+	ReviewPeriodID *int   `json:"reviewPeriodId"`
+	OfficeID       *int   `json:"officeId"`
+	DivisionID     *int   `json:"divisionId"`
+	DepartmentID   *int   `json:"departmentId"`
+	EmployeeNumber string `json:"employeeNumber"`
+	RequestedBy    string `json:"-"`
+}
+
+// DevelopmentPlanDraftResultVm reports a drafting run. ProfilesWithoutRule
+// counts gaps no rule recommends an activity for.
+type DevelopmentPlanDraftResultVm struct {
+	BaseAPIResponse
+	ProfilesWithGaps    int `json:"profilesWithGaps"`
+	ProfilesDrafted     int `json:"profilesDrafted"`
+	PlansDrafted        int `json:"plansDrafted"`
+	ProfilesWithoutRule int `json:"profilesWithoutRule"`
+}
+
+// DevelopmentPlanDraftSearchModel lists the drafted plans awaiting the
+// caller's confirmation.
+type DevelopmentPlanDraftSearchModel struct {
+	EmployeeNumber string `json:"employeeNumber"`
+	ReviewPeriodID *int   `json:"reviewPeriodId"`
+}
+
+// DevelopmentPlanDraftDecisionRequestModel confirms drafted plans, making
+// them Assigned, and discards others. Nothing is changed if any plan is not
+// a draft the caller may decide on.
+type DevelopmentPlanDraftDecisionRequestModel struct {
+	Confirm   []int  `json:"confirm"`
+	Discard   []int  `json:"discard"`
+	DecidedBy string `json:"-"`
+}
@@ -1,5 +1,7 @@
 package enums
 
+import "strings"
+
 // Status represents the lifecycle state of a record.
 type Status int
 
@@ -55,6 +57,16 @@ func (s Status) String() string {
 	return "Unknown"
 }
 
+// ParseStatus parses a record status name as stored in record_status.
+func ParseStatus(name string) (Status, bool) {
+	for st := StatusDraft; st <= StatusReEvaluate; st++ {
+		if st.String() == name {
+			return st, true
+		}
+	}
+	return 0, false
+}
+
 // ResolutionLevel represents grievance resolution levels.
 type ResolutionLevel int
 
@@ -298,6 +310,18 @@ const (
 	AuditEventModified AuditEventType = 3
 )
 
+func (a AuditEventType) String() string {
+	names := map[AuditEventType]string{
+		AuditEventAdded:    "Added",
+		AuditEventDeleted:  "Deleted",
+		AuditEventModified: "Modified",
+	}
+	if n, ok := names[a]; ok {
+		return n
+	}
+	return "Unknown"
+}
+
 // SuspensionAction defines what happens on suspension.
 type SuspensionAction int
 
@@ -353,6 +377,10 @@ const (
 )
 
 // DevelopmentTaskStatus represents the lifecycle of a development task.
+// A plan is planned (Assigned or Initiated), worked on, completed by the
+// employee and verified by their supervisor, who may then close the gap.
+// Plans drafted from competency gaps start as Draft until the supervisor
+// confirms them. Development plans store the status by name.
 type DevelopmentTaskStatus int
 
 const (
@@ -361,7 +389,62 @@ const (
 	DevelopmentTaskStatusInProgress DevelopmentTaskStatus = 3
 	DevelopmentTaskStatusCompleted  DevelopmentTaskStatus = 4
 	DevelopmentTaskStatusClosedGap  DevelopmentTaskStatus = 5
-)
+	DevelopmentTaskStatusVerified   DevelopmentTaskStatus = 6
+	DevelopmentTaskStatusDraft      DevelopmentTaskStatus = 7
+)
+
+var developmentTaskStatusNames = map[DevelopmentTaskStatus]string{
+	DevelopmentTaskStatusAssigned:   "Assigned",
+	DevelopmentTaskStatusInitiated:  "Initiated",
+	DevelopmentTaskStatusInProgress: "InProgress",
+	DevelopmentTaskStatusCompleted:  "Completed",
+	DevelopmentTaskStatusClosedGap:  "ClosedGap",
+	DevelopmentTaskStatusVerified:   "Verified",
+	DevelopmentTaskStatusDraft:      "Draft",
+}
+
+func (d DevelopmentTaskStatus) String() string {
+	if n, ok := developmentTaskStatusNames[d]; ok {
+		return n
+	}
+	return "Unknown"
+}
+
+// legacyDevelopmentTaskStatuses maps the free-text statuses development
+// plans were saved with before the lifecycle, normalised as in
+// ParseDevelopmentTaskStatus. Migration 000021 rewrites stored plans the
+// same way.
+var legacyDevelopmentTaskStatuses = map[string]DevelopmentTaskStatus{
+	"":           DevelopmentTaskStatusAssigned,
+	"planned":    DevelopmentTaskStatusAssigned,
+	"notstarted": DevelopmentTaskStatusAssigned,
+	"pending":    DevelopmentTaskStatusAssigned,
+	"open":       DevelopmentTaskStatusAssigned,
+	"new":        DevelopmentTaskStatusAssigned,
+	"started":    DevelopmentTaskStatusInProgress,
+	"ongoing":    DevelopmentTaskStatusInProgress,
+	"done":       DevelopmentTaskStatusCompleted,
+	"complete":   DevelopmentTaskStatusCompleted,
+	"finished":   DevelopmentTaskStatusCompleted,
+	"closed":     DevelopmentTaskStatusClosedGap,
+	"gapclosed":  DevelopmentTaskStatusClosedGap,
+}
+
+// ParseDevelopmentTaskStatus parses a development task status name, ignoring
+// case, spaces, hyphens and underscores. An empty name, or "Planned", is
+// Assigned; other legacy statuses map to their lifecycle equivalent.
+func ParseDevelopmentTaskStatus(name string) (DevelopmentTaskStatus, bool) {
+	key := strings.ToLower(strings.NewReplacer(" ", "", "-", "", "_", "").Replace(strings.TrimSpace(name)))
+	if d, ok := legacyDevelopmentTaskStatuses[key]; ok {
+		return d, true
+	}
+	for d, n := range developmentTaskStatusNames {
+		if strings.ToLower(n) == key {
+			return d, true
+		}
+	}
+	return 0, false
+}
 
 // CompetencyGroup classifies competency groups.
 type CompetencyGroup int
@@ -390,6 +473,133 @@ const (
 	ApprovalRejected Approval = 2
 )
 
+// ApprovalEntityType identifies the kind of record an approval chain governs.
+type ApprovalEntityType int
+
+const (
+	ApprovalEntityWorkProduct                ApprovalEntityType = 1
+	ApprovalEntityIndividualPlannedObjective ApprovalEntityType = 2
+	ApprovalEntityProject                    ApprovalEntityType = 3
+	ApprovalEntityCommittee                  ApprovalEntityType = 4
+	ApprovalEntityReviewPeriodExtension      ApprovalEntityType = 5
+)
+
+func (a ApprovalEntityType) String() string {
+	names := map[ApprovalEntityType]string{
+		ApprovalEntityWorkProduct:                "WorkProduct",
+		ApprovalEntityIndividualPlannedObjective: "IndividualPlannedObjective",
+		ApprovalEntityProject:                    "Project",
+		ApprovalEntityCommittee:                  "Committee",
+		ApprovalEntityReviewPeriodExtension:      "ReviewPeriodExtension",
+	}
+	if n, ok := names[a]; ok {
+		return n
+	}
+	return "Unknown"
+}
+
+// ApproverResolverType determines how the approver of a chain stage is found.
+type ApproverResolverType int
+
+const (
+	ApproverResolverLineManager      ApproverResolverType = 1
+	ApproverResolverHeadOfOffice     ApproverResolverType = 2
+	ApproverResolverHeadOfDivision   ApproverResolverType = 3
+	ApproverResolverHeadOfDepartment ApproverResolverType = 4
+	ApproverResolverHrdRole          ApproverResolverType = 5
+	ApproverResolverNamedRole        ApproverResolverType = 6
+)
+
+func (a ApproverResolverType) String() string {
+	names := map[ApproverResolverType]string{
+		ApproverResolverLineManager:      "LineManager",
+		ApproverResolverHeadOfOffice:     "HeadOfOffice",
+		ApproverResolverHeadOfDivision:   "HeadOfDivision",
+		ApproverResolverHeadOfDepartment: "HeadOfDepartment",
+		ApproverResolverHrdRole:          "HrdRole",
+		ApproverResolverNamedRole:        "NamedRole",
+	}
+	if n, ok := names[a]; ok {
+		return n
+	}
+	return "Unknown"
+}
+
+// CalibrationStatus is the lifecycle state of a calibration session.
+type CalibrationStatus int
+
+const (
+	CalibrationStatusOpen      CalibrationStatus = 1
+	CalibrationStatusFinalized CalibrationStatus = 2
+)
+
+func (c CalibrationStatus) String() string {
+	names := map[CalibrationStatus]string{
+		CalibrationStatusOpen:      "Open",
+		CalibrationStatusFinalized: "Finalized",
+	}
+	if n, ok := names[c]; ok {
+		return n
+	}
+	return "Unknown"
+}
+
+// CalibrationDecision records what a calibration committee did with a
+// proposed grade change. Retained marks entries with no proposed change.
+type CalibrationDecision int
+
+const (
+	CalibrationDecisionProposed   CalibrationDecision = 1
+	CalibrationDecisionAccepted   CalibrationDecision = 2
+	CalibrationDecisionOverridden CalibrationDecision = 3
+	CalibrationDecisionRejected   CalibrationDecision = 4
+	CalibrationDecisionRetained   CalibrationDecision = 5
+)
+
+func (c CalibrationDecision) String() string {
+	names := map[CalibrationDecision]string{
+		CalibrationDecisionProposed:   "Proposed",
+		CalibrationDecisionAccepted:   "Accepted",
+		CalibrationDecisionOverridden: "Overridden",
+		CalibrationDecisionRejected:   "Rejected",
+		CalibrationDecisionRetained:   "Retained",
+	}
+	if n, ok := names[c]; ok {
+		return n
+	}
+	return "Unknown"
+}
+
+// BackgroundJobStatus is the lifecycle state of a persisted background job.
+// AwaitingRetry jobs failed and will run again once their RunAt is reached;
+// DeadLettered jobs exhausted their attempts and only run again when retried
+// by an administrator.
+type BackgroundJobStatus int
+
+const (
+	BackgroundJobStatusEnqueued      BackgroundJobStatus = 1
+	BackgroundJobStatusProcessing    BackgroundJobStatus = 2
+	BackgroundJobStatusSucceeded     BackgroundJobStatus = 3
+	BackgroundJobStatusAwaitingRetry BackgroundJobStatus = 4
+	BackgroundJobStatusDeadLettered  BackgroundJobStatus = 5
+	BackgroundJobStatusCancelled     BackgroundJobStatus = 6
+)
+
+func (b BackgroundJobStatus) String() string {
+	names := map[BackgroundJobStatus]string{
+		BackgroundJobStatusEnqueued:      "Enqueued",
+		BackgroundJobStatusProcessing:    "Processing",
+		BackgroundJobStatusSucceeded:     "Succeeded",
+		BackgroundJobStatusAwaitingRetry: "AwaitingRetry",
+		BackgroundJobStatusDeadLettered:  "DeadLettered",
+		BackgroundJobStatusCancelled:     "Cancelled",
+	}
+	if n, ok := names[b]; ok {
+		return n
+	}
+	return "Unknown"
+}
+
 // SequenceNumberTypes identifies the entity type for sequence number generation.
 type SequenceNumberTypes int
 
@@ -489,6 +699,20 @@ const (
 	OrganogramLevelDirectorate OrganogramLevel = 5
 )
 
+func (o OrganogramLevel) String() string {
+	names := map[OrganogramLevel]string{
+		OrganogramLevelBankwide:    "Bankwide",
+		OrganogramLevelDepartment:  "Department",
+		OrganogramLevelDivision:    "Division",
+		OrganogramLevelOffice:      "Office",
+		OrganogramLevelDirectorate: "Directorate",
+	}
+	if n, ok := names[o]; ok {
+		return n
+	}
+	return "Unknown"
+}
+
 // AdhocAssignmentType classifies ad-hoc assignment types.
 type AdhocAssignmentType int
 
@@ -110,3 +110,15 @@ type EmailObject struct {
 }
 
 func (EmailObject) TableName() string { return "dbo.EmailObjects" }
+
+// EmailObject statuses. The mail sender picks up New emails whose
+// ExpectedSendDate has passed; a failed attempt puts the email back to New
+// with a later ExpectedSendDate until its attempts run out. Bounced emails
+// were permanently rejected by the SMTP server and are not retried.
+const (
+	EmailStatusNew        = "New"
+	EmailStatusProcessing = "Processing"
+	EmailStatusSent       = "Sent"
+	EmailStatusFailed     = "Failed"
+	EmailStatusBounced    = "Bounced"
+)
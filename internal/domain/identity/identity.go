@@ -50,7 +50,7 @@ func (ApplicationRole) TableName() string { return "CoreSchema.asp_net_roles" }
 // Permission represents an application permission.
 type Permission struct {
 	PermissionID int    `json:"permission_id" gorm:"column:permission_id;primaryKey;autoIncrement"`
-	Name         string `json:"name"          gorm:"column:name;not null"`
+	Name         string `json:"name"          gorm:"column:name;not null;uniqueIndex:ux_permissions_name,where:soft_deleted = false"`
 	Description  string `json:"description"   gorm:"column:description"`
 	domain.BaseAudit
 	RolePermissions []RolePermission `json:"role_permissions" gorm:"foreignKey:PermissionID"`
@@ -0,0 +1,40 @@
+package performance
+
+import (
+	"github.com/enterprise-pms/pms-api/internal/domain"
+	"github.com/enterprise-pms/pms-api/internal/domain/enums"
+)
+
+// ApprovalChain is an ordered, database-managed list of approval stages for
+// one entity type. At most one chain per entity type is active at a time;
+// when none is active the built-in single-level chain applies.
+type ApprovalChain struct {
+	ApprovalChainID string                   `json:"approval_chain_id" gorm:"column:approval_chain_id;primaryKey"`
+	Name            string                   `json:"name"              gorm:"column:name;not null"`
+	Description     string                   `json:"description"       gorm:"column:description"`
+	EntityType      enums.ApprovalEntityType `json:"entity_type"       gorm:"column:entity_type;not null;index"`
+	domain.BaseEntity
+
+	Stages []ApprovalChainStage `json:"stages" gorm:"foreignKey:ApprovalChainID"`
+}
+
+func (ApprovalChain) TableName() string { return "pms.approval_chains" }
+
+// ApprovalChainStage is a single step in an ApprovalChain. PendingStatus is
+// the record status an entity carries while it waits at this stage, and the
+// resolver decides who may act on it.
+type ApprovalChainStage struct {
+	ApprovalChainStageID string                     `json:"approval_chain_stage_id" gorm:"column:approval_chain_stage_id;primaryKey"`
+	ApprovalChainID      string                     `json:"approval_chain_id"       gorm:"column:approval_chain_id;not null;index"`
+	StageOrder           int                        `json:"stage_order"             gorm:"column:stage_order;not null"`
+	Name                 string                     `json:"name"                    gorm:"column:name;not null"`
+	ApproverResolver     enums.ApproverResolverType `json:"approver_resolver"       gorm:"column:approver_resolver;not null"`
+	RoleName             string                     `json:"role_name"               gorm:"column:role_name"`
+	PendingStatus        enums.Status               `json:"pending_status"          gorm:"column:pending_status;not null"`
+	AllowReturn          bool                       `json:"allow_return"            gorm:"column:allow_return;default:false"`
+	domain.BaseEntity
+
+	ApprovalChain *ApprovalChain `json:"approval_chain,omitempty" gorm:"foreignKey:ApprovalChainID"`
+}
+
+func (ApprovalChainStage) TableName() string { return "pms.approval_chain_stages" }
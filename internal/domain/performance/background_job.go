@@ -0,0 +1,75 @@
+package performance
+
+import (
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/domain"
+	"github.com/enterprise-pms/pms-api/internal/domain/enums"
+)
+
+// BackgroundJob is a unit of asynchronous work persisted so that it survives
+// restarts. JobType selects the registered handler and Payload carries its
+// JSON-encoded arguments. A non-empty IdempotencyKey is unique among jobs
+// that are still enqueued, running or awaiting retry.
+type BackgroundJob struct {
+	BackgroundJobID string                    `json:"background_job_id" gorm:"column:background_job_id;primaryKey"`
+	JobType         string                    `json:"job_type"          gorm:"column:job_type;not null;index"`
+	Queue           string                    `json:"queue"             gorm:"column:queue"`
+	Payload         string                    `json:"payload"           gorm:"column:payload;type:text"`
+	IdempotencyKey  string                    `json:"idempotency_key"   gorm:"column:idempotency_key"`
+	JobStatus       enums.BackgroundJobStatus `json:"job_status"        gorm:"column:job_status;not null;default:1;index"`
+	Attempts        int                       `json:"attempts"          gorm:"column:attempts;default:0"`
+	MaxAttempts     int                       `json:"max_attempts"      gorm:"column:max_attempts;not null"`
+	RunAt           time.Time                 `json:"run_at"            gorm:"column:run_at;not null"`
+	LockedBy        string                    `json:"locked_by"         gorm:"column:locked_by"`
+	LockedUntil     *time.Time                `json:"locked_until"      gorm:"column:locked_until"`
+	LastError       string                    `json:"last_error"        gorm:"column:last_error;type:text"`
+	StartedAt       *time.Time                `json:"started_at"        gorm:"column:started_at"`
+	CompletedAt     *time.Time                `json:"completed_at"      gorm:"column:completed_at"`
+	domain.BaseEntity
+}
+
+func (BackgroundJob) TableName() string { return "pms.background_jobs" }
+
+// IsPending reports whether the job may still run without intervention.
+func (j *BackgroundJob) IsPending() bool {
+	switch j.JobStatus {
+	case enums.BackgroundJobStatusEnqueued, enums.BackgroundJobStatusProcessing, enums.BackgroundJobStatusAwaitingRetry:
+		return true
+	}
+	return false
+}
+
+// JobLease is a time-bounded, cluster-wide lock held by one API replica.
+// Leases guard scheduler leadership, each recurring job run and each mail
+// batch; a holder that dies stops renewing and the lease lapses at
+// ExpiresAt, letting another replica take over.
+type JobLease struct {
+	LeaseName  string    `json:"lease_name"  gorm:"column:lease_name;primaryKey"`
+	Holder     string    `json:"holder"      gorm:"column:holder;not null"`
+	AcquiredAt time.Time `json:"acquired_at" gorm:"column:acquired_at;not null"`
+	ExpiresAt  time.Time `json:"expires_at"  gorm:"column:expires_at;not null"`
+}
+
+func (JobLease) TableName() string { return "pms.job_leases" }
+
+// RecurringJob is the schedule and run status of one cron job. Every replica
+// reconciles its cron entries against these rows, so schedule changes and
+// pauses take effect without a restart. A non-nil TriggerRequestedAt asks
+// the scheduler leader to run the job once, outside its schedule.
+type RecurringJob struct {
+	JobName            string     `json:"job_name"             gorm:"column:job_name;primaryKey"`
+	Description        string     `json:"description"          gorm:"column:description"`
+	Schedule           string     `json:"schedule"             gorm:"column:schedule;not null"`
+	IsEnabled          bool       `json:"is_enabled"           gorm:"column:is_enabled;default:true"`
+	LastRunAt          *time.Time `json:"last_run_at"          gorm:"column:last_run_at"`
+	LastDurationMs     int64      `json:"last_duration_ms"     gorm:"column:last_duration_ms"`
+	LastRunSucceeded   bool       `json:"last_run_succeeded"   gorm:"column:last_run_succeeded"`
+	LastError          string     `json:"last_error"           gorm:"column:last_error;type:text"`
+	NextRunAt          *time.Time `json:"next_run_at"          gorm:"column:next_run_at"`
+	TriggerRequestedAt *time.Time `json:"trigger_requested_at" gorm:"column:trigger_requested_at"`
+	TriggerRequestedBy string     `json:"trigger_requested_by" gorm:"column:trigger_requested_by"`
+	domain.BaseEntity
+}
+
+func (RecurringJob) TableName() string { return "pms.recurring_jobs" }
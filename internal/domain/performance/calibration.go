@@ -0,0 +1,76 @@
+package performance
+
+import (
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/domain"
+	"github.com/enterprise-pms/pms-api/internal/domain/enums"
+)
+
+// CalibrationQuota bounds the share of staff that may hold a grade within one
+// organisational unit. Quotas with an empty ReviewPeriodID apply to every
+// period; period-specific quotas replace them for that period and level.
+type CalibrationQuota struct {
+	CalibrationQuotaID string                 `json:"calibration_quota_id" gorm:"column:calibration_quota_id;primaryKey"`
+	ReviewPeriodID     string                 `json:"review_period_id"     gorm:"column:review_period_id;index"`
+	OrganogramLevel    enums.OrganogramLevel  `json:"organogram_level"     gorm:"column:organogram_level;not null"`
+	Grade              enums.PerformanceGrade `json:"grade"                gorm:"column:grade;not null"`
+	MinPercentage      float64                `json:"min_percentage"       gorm:"column:min_percentage;type:decimal(18,2);default:0"`
+	MaxPercentage      float64                `json:"max_percentage"       gorm:"column:max_percentage;type:decimal(18,2);default:100"`
+	domain.BaseEntity
+}
+
+func (CalibrationQuota) TableName() string { return "pms.calibration_quotas" }
+
+// CalibrationSession is a calibration committee's review of the grade
+// distribution of one organisational unit in a closed review period.
+type CalibrationSession struct {
+	CalibrationSessionID string                  `json:"calibration_session_id" gorm:"column:calibration_session_id;primaryKey"`
+	ReviewPeriodID       string                  `json:"review_period_id"       gorm:"column:review_period_id;not null;index"`
+	OrganogramLevel      enums.OrganogramLevel   `json:"organogram_level"       gorm:"column:organogram_level;not null"`
+	ReferenceID          string                  `json:"reference_id"           gorm:"column:reference_id"`
+	ReferenceName        string                  `json:"reference_name"         gorm:"column:reference_name"`
+	SessionStatus        enums.CalibrationStatus `json:"session_status"         gorm:"column:session_status;not null;default:1"`
+	TotalStaff           int                     `json:"total_staff"            gorm:"column:total_staff"`
+	FinalizedBy          string                  `json:"finalized_by"           gorm:"column:finalized_by"`
+	DateFinalized        *time.Time              `json:"date_finalized"         gorm:"column:date_finalized"`
+	domain.BaseEntity
+
+	Entries      []CalibrationEntry       `json:"entries"   gorm:"foreignKey:CalibrationSessionID"`
+	ReviewPeriod *PerformanceReviewPeriod `json:"review_period" gorm:"foreignKey:ReviewPeriodID"`
+}
+
+func (CalibrationSession) TableName() string { return "pms.calibration_sessions" }
+
+// CalibrationEntry is one staff member's grade within a calibration session:
+// the grade they were scored with, the grade the quotas propose and the grade
+// the committee settled on. Entries with no proposed change start Retained.
+type CalibrationEntry struct {
+	CalibrationEntryID   string                    `json:"calibration_entry_id" gorm:"column:calibration_entry_id;primaryKey"`
+	CalibrationSessionID string                    `json:"calibration_session_id"    gorm:"column:calibration_session_id;not null;index"`
+	PeriodScoreID        string                    `json:"period_score_id"           gorm:"column:period_score_id;not null"`
+	StaffID              string                    `json:"staff_id"                  gorm:"column:staff_id;not null"`
+	ScorePercentage      float64                   `json:"score_percentage"          gorm:"column:score_percentage;type:decimal(18,2)"`
+	OriginalGrade        enums.PerformanceGrade    `json:"original_grade"            gorm:"column:original_grade;not null"`
+	ProposedGrade        enums.PerformanceGrade    `json:"proposed_grade"            gorm:"column:proposed_grade;not null"`
+	CalibratedGrade      enums.PerformanceGrade    `json:"calibrated_grade"          gorm:"column:calibrated_grade"`
+	Decision             enums.CalibrationDecision `json:"decision"                  gorm:"column:decision;not null;default:1"`
+	ProposalReason       string                    `json:"proposal_reason"           gorm:"column:proposal_reason"`
+	Justification        string                    `json:"justification"             gorm:"column:justification"`
+	DecidedBy            string                    `json:"decided_by"                gorm:"column:decided_by"`
+	DateDecided          *time.Time                `json:"date_decided"              gorm:"column:date_decided"`
+	domain.BaseEntity
+
+	CalibrationSession *CalibrationSession `json:"calibration_session,omitempty" gorm:"foreignKey:CalibrationSessionID"`
+}
+
+func (CalibrationEntry) TableName() string { return "pms.calibration_entries" }
+
+// ProjectedGrade is the grade the entry would carry if the session were
+// finalised now; undecided proposals are assumed accepted.
+func (e CalibrationEntry) ProjectedGrade() enums.PerformanceGrade {
+	if e.Decision == enums.CalibrationDecisionProposed {
+		return e.ProposedGrade
+	}
+	return e.CalibratedGrade
+}
@@ -0,0 +1,53 @@
+package performance
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/domain"
+	"github.com/enterprise-pms/pms-api/internal/domain/enums"
+)
+
+// ApprovalDelegation lets a staff member name a proxy who may approve,
+// reject or return records on their behalf within a date window. EntityTypes
+// is a comma-separated list of enums.ApprovalEntityType values; an empty list
+// covers every entity type.
+type ApprovalDelegation struct {
+	ApprovalDelegationID string    `json:"approval_delegation_id" gorm:"column:approval_delegation_id;primaryKey"`
+	DelegatorStaffID     string    `json:"delegator_staff_id"     gorm:"column:delegator_staff_id;not null;index"`
+	DelegatorStaffName   string    `json:"delegator_staff_name"   gorm:"column:delegator_staff_name"`
+	DelegateStaffID      string    `json:"delegate_staff_id"      gorm:"column:delegate_staff_id;not null;index"`
+	DelegateStaffName    string    `json:"delegate_staff_name"    gorm:"column:delegate_staff_name"`
+	StartDate            time.Time `json:"start_date"             gorm:"column:start_date;not null"`
+	EndDate              time.Time `json:"end_date"               gorm:"column:end_date;not null"`
+	EntityTypes          string    `json:"entity_types"           gorm:"column:entity_types"`
+	Reason               string    `json:"reason"                 gorm:"column:reason"`
+	domain.BaseEntity
+}
+
+func (ApprovalDelegation) TableName() string { return "pms.approval_delegations" }
+
+// Covers reports whether the delegation applies to the given entity type.
+func (d *ApprovalDelegation) Covers(entityType enums.ApprovalEntityType) bool {
+	if strings.TrimSpace(d.EntityTypes) == "" {
+		return true
+	}
+	for _, part := range strings.Split(d.EntityTypes, ",") {
+		if v, err := strconv.Atoi(strings.TrimSpace(part)); err == nil && enums.ApprovalEntityType(v) == entityType {
+			return true
+		}
+	}
+	return false
+}
+
+// InEffect reports whether at falls inside the delegation window. The end
+// date is inclusive of the whole day.
+func (d *ApprovalDelegation) InEffect(at time.Time) bool {
+	if !d.IsActive || d.SoftDeleted {
+		return false
+	}
+	start := time.Date(d.StartDate.Year(), d.StartDate.Month(), d.StartDate.Day(), 0, 0, 0, 0, d.StartDate.Location())
+	end := time.Date(d.EndDate.Year(), d.EndDate.Month(), d.EndDate.Day(), 0, 0, 0, 0, d.EndDate.Location()).AddDate(0, 0, 1)
+	return !at.Before(start) && at.Before(end)
+}
@@ -0,0 +1,112 @@
+package performance
+
+import "time"
+
+// ===========================================================================
+// Approval Chain Request Models
+// ===========================================================================
+
+// ApprovalChainStageRequestModel is a single stage in an approval chain
+// create/update payload.
+type ApprovalChainStageRequestModel struct {
+	StageOrder       int    `json:"stageOrder"       validate:"required"`
+	Name             string `json:"name"             validate:"required"`
+	ApproverResolver int    `json:"approverResolver" validate:"required"`
+	RoleName         string `json:"roleName"`
+	PendingStatus    int    `json:"pendingStatus"    validate:"required"`
+	AllowReturn      bool   `json:"allowReturn"`
+}
+
+// ApprovalChainRequestModel is the create/update payload for an approval
+// chain. An empty ApprovalChainID creates a new chain; the stage list always
+// replaces the stored stages in full.
+type ApprovalChainRequestModel struct {
+	ApprovalChainID string                           `json:"approvalChainId"`
+	Name            string                           `json:"name"        validate:"required"`
+	Description     string                           `json:"description"`
+	EntityType      int                              `json:"entityType"  validate:"required"`
+	IsActive        bool                             `json:"isActive"`
+	Stages          []ApprovalChainStageRequestModel `json:"stages"      validate:"required"`
+	UpdatedBy       string                           `json:"-"`
+}
+
+// ===========================================================================
+// Approval Chain Response VMs
+// ===========================================================================
+
+// ApprovalChainStageVm is the read/display DTO for a chain stage.
+type ApprovalChainStageVm struct {
+	ApprovalChainStageID string `json:"approvalChainStageId"`
+	StageOrder           int    `json:"stageOrder"`
+	Name                 string `json:"name"`
+	ApproverResolver     int    `json:"approverResolver"`
+	ApproverResolverName string `json:"approverResolverName"`
+	RoleName             string `json:"roleName"`
+	PendingStatus        int    `json:"pendingStatus"`
+	PendingStatusName    string `json:"pendingStatusName"`
+	AllowReturn          bool   `json:"allowReturn"`
+}
+
+// ApprovalChainVm is the read/display DTO for an approval chain.
+type ApprovalChainVm struct {
+	BaseEntityVm
+	ApprovalChainID string                 `json:"approvalChainId"`
+	Name            string                 `json:"name"`
+	Description     string                 `json:"description"`
+	EntityType      int                    `json:"entityType"`
+	EntityTypeName  string                 `json:"entityTypeName"`
+	IsBuiltIn       bool                   `json:"isBuiltIn"`
+	Stages          []ApprovalChainStageVm `json:"stages"`
+}
+
+// ApprovalChainResponseVm wraps a single approval chain.
+type ApprovalChainResponseVm struct {
+	BaseAPIResponse
+	Data *ApprovalChainVm `json:"data"`
+}
+
+// ApprovalChainListResponseVm wraps a list of approval chains.
+type ApprovalChainListResponseVm struct {
+	BaseAPIResponse
+	Data        []ApprovalChainVm `json:"data"`
+	TotalRecord int               `json:"totalRecord"`
+}
+
+// ===========================================================================
+// Approval Delegation DTOs
+// ===========================================================================
+
+// ApprovalDelegationRequestModel is the create payload for a delegation.
+// DelegatorStaffID is taken from the caller's token; EntityTypes restricts
+// the delegation to specific ApprovalEntityType values (empty means all).
+type ApprovalDelegationRequestModel struct {
+	DelegatorStaffID string    `json:"-"`
+	DelegateStaffID  string    `json:"delegateStaffId" validate:"required"`
+	StartDate        time.Time `json:"startDate"       validate:"required"`
+	EndDate          time.Time `json:"endDate"         validate:"required"`
+	EntityTypes      []int     `json:"entityTypes"`
+	Reason           string    `json:"reason"`
+}
+
+// ApprovalDelegationVm is the read/display DTO for a delegation.
+type ApprovalDelegationVm struct {
+	ApprovalDelegationID string    `json:"approvalDelegationId"`
+	DelegatorStaffID     string    `json:"delegatorStaffId"`
+	DelegatorStaffName   string    `json:"delegatorStaffName"`
+	DelegateStaffID      string    `json:"delegateStaffId"`
+	DelegateStaffName    string    `json:"delegateStaffName"`
+	StartDate            time.Time `json:"startDate"`
+	EndDate              time.Time `json:"endDate"`
+	EntityTypes          []int     `json:"entityTypes"`
+	Reason               string    `json:"reason"`
+	IsActive             bool      `json:"isActive"`
+	InEffect             bool      `json:"inEffect"`
+}
+
+// ApprovalDelegationListResponseVm wraps the delegations a staff member has
+// granted and the ones they hold for others.
+type ApprovalDelegationListResponseVm struct {
+	BaseAPIResponse
+	Granted  []ApprovalDelegationVm `json:"granted"`
+	Received []ApprovalDelegationVm `json:"received"`
+}
@@ -0,0 +1,110 @@
+package performance
+
+import "time"
+
+// ===========================================================================
+// Audit Trail Response VMs
+// NOTE: the raw AuditLogVm row DTOs are defined in dto_feedback.go.
+// ===========================================================================
+
+// AuditFieldChangeVm is one field's change between two states of a record.
+type AuditFieldChangeVm struct {
+	FieldName     string `json:"fieldName"`
+	OriginalValue string `json:"originalValue"`
+	NewValue      string `json:"newValue"`
+}
+
+// AuditChangeSetVm groups the field changes written by one user in one save.
+type AuditChangeSetVm struct {
+	ChangedBy          string               `json:"changedBy"`
+	ChangedAt          time.Time            `json:"changedAt"`
+	AuditEventType     int                  `json:"auditEventType"`
+	AuditEventTypeName string               `json:"auditEventTypeName"`
+	Changes            []AuditFieldChangeVm `json:"changes"`
+}
+
+// EntityTimelineResponseVm is the ordered change history of one record.
+type EntityTimelineResponseVm struct {
+	BaseAPIResponse
+	TableName   string             `json:"tableName"`
+	RecordID    string             `json:"recordId"`
+	Data        []AuditChangeSetVm `json:"data"`
+	TotalRecord int                `json:"totalRecord"`
+}
+
+// EntityStateVm is a record's field values as reconstructed from its audit
+// history at AsOf. Exists is false when nothing had been recorded by then.
+type EntityStateVm struct {
+	TableName     string            `json:"tableName"`
+	RecordID      string            `json:"recordId"`
+	AsOf          time.Time         `json:"asOf"`
+	Exists        bool              `json:"exists"`
+	IsDeleted     bool              `json:"isDeleted"`
+	LastChangedAt *time.Time        `json:"lastChangedAt"`
+	LastChangedBy string            `json:"lastChangedBy"`
+	Fields        map[string]string `json:"fields"`
+}
+
+// EntityStateResponseVm wraps a reconstructed record state.
+type EntityStateResponseVm struct {
+	BaseAPIResponse
+	Data *EntityStateVm `json:"data"`
+}
+
+// EntityDiffVm lists the fields of a record that differ between two points
+// in time.
+type EntityDiffVm struct {
+	TableName string               `json:"tableName"`
+	RecordID  string               `json:"recordId"`
+	From      time.Time            `json:"from"`
+	To        time.Time            `json:"to"`
+	Changes   []AuditFieldChangeVm `json:"changes"`
+}
+
+// EntityDiffResponseVm wraps a record diff.
+type EntityDiffResponseVm struct {
+	BaseAPIResponse
+	Data *EntityDiffVm `json:"data"`
+}
+
+// ===========================================================================
+// Audit Configuration Models
+// ===========================================================================
+
+// AuditableEntityRequestModel turns auditing of an entity on or off.
+// EntityName is a table name such as "pms.work_products".
+type AuditableEntityRequestModel struct {
+	EntityName  string `json:"entityName"  validate:"required"`
+	EnableAudit bool   `json:"enableAudit"`
+	UpdatedBy   string `json:"-"`
+}
+
+// AuditableAttributeRequestModel overrides the entity's audit flag for one
+// column.
+type AuditableAttributeRequestModel struct {
+	EntityName    string `json:"entityName"    validate:"required"`
+	AttributeName string `json:"attributeName" validate:"required"`
+	EnableAudit   bool   `json:"enableAudit"`
+	UpdatedBy     string `json:"-"`
+}
+
+// AuditableAttributeVm is a column-level audit rule.
+type AuditableAttributeVm struct {
+	AttributeName string `json:"attributeName"`
+	EnableAudit   bool   `json:"enableAudit"`
+}
+
+// AuditableEntityVm is an entity's audit configuration.
+type AuditableEntityVm struct {
+	BaseEntityVm
+	EntityName  string                 `json:"entityName"`
+	EnableAudit bool                   `json:"enableAudit"`
+	Attributes  []AuditableAttributeVm `json:"attributes"`
+}
+
+// AuditableEntityListResponseVm wraps the audit configuration.
+type AuditableEntityListResponseVm struct {
+	BaseAPIResponse
+	Data        []AuditableEntityVm `json:"data"`
+	TotalRecord int                 `json:"totalRecord"`
+}
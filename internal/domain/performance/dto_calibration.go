@@ -0,0 +1,135 @@
+package performance
+
+import "time"
+
+// ===========================================================================
+// Calibration Request Models
+// ===========================================================================
+
+// CalibrationQuotaItemRequestModel bounds the share of staff in one grade.
+type CalibrationQuotaItemRequestModel struct {
+	Grade         int     `json:"grade"         validate:"required"`
+	MinPercentage float64 `json:"minPercentage"`
+	MaxPercentage float64 `json:"maxPercentage" validate:"required"`
+}
+
+// CalibrationQuotaRequestModel replaces the quota set for an organogram
+// level. An empty ReviewPeriodID edits the quotas that apply to every period.
+type CalibrationQuotaRequestModel struct {
+	ReviewPeriodID  string                             `json:"reviewPeriodId"`
+	OrganogramLevel int                                `json:"organogramLevel" validate:"required"`
+	Quotas          []CalibrationQuotaItemRequestModel `json:"quotas"`
+	UpdatedBy       string                             `json:"-"`
+}
+
+// StartCalibrationRequestModel opens a calibration session for one unit of a
+// closed review period. ReferenceID is the office, division or department
+// ID and is ignored for bank-wide calibration.
+type StartCalibrationRequestModel struct {
+	ReviewPeriodID  string `json:"reviewPeriodId"  validate:"required"`
+	OrganogramLevel int    `json:"organogramLevel" validate:"required"`
+	ReferenceID     string `json:"referenceId"`
+	RequestedBy     string `json:"-"`
+}
+
+// CalibrationDecisionRequestModel records the committee's decision on one
+// entry. CalibratedGrade is required for overrides; a justification is
+// required whenever the committee departs from the proposal.
+type CalibrationDecisionRequestModel struct {
+	CalibrationSessionID string `json:"-"`
+	CalibrationEntryID   string `json:"calibrationEntryId" validate:"required"`
+	Decision             int    `json:"decision"           validate:"required"`
+	CalibratedGrade      int    `json:"calibratedGrade"`
+	Justification        string `json:"justification"`
+	DecidedBy            string `json:"-"`
+}
+
+// ===========================================================================
+// Calibration Response VMs
+// ===========================================================================
+
+// CalibrationQuotaVm is the read/display DTO for a grade quota.
+type CalibrationQuotaVm struct {
+	CalibrationQuotaID  string  `json:"calibrationQuotaId"`
+	ReviewPeriodID      string  `json:"reviewPeriodId"`
+	OrganogramLevel     int     `json:"organogramLevel"`
+	OrganogramLevelName string  `json:"organogramLevelName"`
+	Grade               int     `json:"grade"`
+	GradeName           string  `json:"gradeName"`
+	MinPercentage       float64 `json:"minPercentage"`
+	MaxPercentage       float64 `json:"maxPercentage"`
+}
+
+// CalibrationQuotaListResponseVm wraps a list of quotas.
+type CalibrationQuotaListResponseVm struct {
+	BaseAPIResponse
+	Data        []CalibrationQuotaVm `json:"data"`
+	TotalRecord int                  `json:"totalRecord"`
+}
+
+// GradeDistributionVm compares the share of staff in a grade before and
+// after calibration against its quota.
+type GradeDistributionVm struct {
+	Grade                int     `json:"grade"`
+	GradeName            string  `json:"gradeName"`
+	MinPercentage        float64 `json:"minPercentage"`
+	MaxPercentage        float64 `json:"maxPercentage"`
+	ActualCount          int     `json:"actualCount"`
+	ActualPercentage     float64 `json:"actualPercentage"`
+	CalibratedCount      int     `json:"calibratedCount"`
+	CalibratedPercentage float64 `json:"calibratedPercentage"`
+	WithinQuota          bool    `json:"withinQuota"`
+}
+
+// CalibrationEntryVm is the read/display DTO for a calibration entry.
+type CalibrationEntryVm struct {
+	CalibrationEntryID  string     `json:"calibrationEntryId"`
+	PeriodScoreID       string     `json:"periodScoreId"`
+	StaffID             string     `json:"staffId"`
+	ScorePercentage     float64    `json:"scorePercentage"`
+	OriginalGrade       int        `json:"originalGrade"`
+	OriginalGradeName   string     `json:"originalGradeName"`
+	ProposedGrade       int        `json:"proposedGrade"`
+	ProposedGradeName   string     `json:"proposedGradeName"`
+	CalibratedGrade     int        `json:"calibratedGrade"`
+	CalibratedGradeName string     `json:"calibratedGradeName"`
+	Decision            int        `json:"decision"`
+	DecisionName        string     `json:"decisionName"`
+	ProposalReason      string     `json:"proposalReason"`
+	Justification       string     `json:"justification"`
+	DecidedBy           string     `json:"decidedBy"`
+	DateDecided         *time.Time `json:"dateDecided"`
+}
+
+// CalibrationSessionVm is the read/display DTO for a calibration session.
+type CalibrationSessionVm struct {
+	BaseEntityVm
+	CalibrationSessionID string                `json:"calibrationSessionId"`
+	ReviewPeriodID       string                `json:"reviewPeriodId"`
+	ReviewPeriod         string                `json:"reviewPeriod"`
+	OrganogramLevel      int                   `json:"organogramLevel"`
+	OrganogramLevelName  string                `json:"organogramLevelName"`
+	ReferenceID          string                `json:"referenceId"`
+	ReferenceName        string                `json:"referenceName"`
+	SessionStatus        int                   `json:"sessionStatus"`
+	SessionStatusName    string                `json:"sessionStatusName"`
+	TotalStaff           int                   `json:"totalStaff"`
+	PendingDecisions     int                   `json:"pendingDecisions"`
+	FinalizedBy          string                `json:"finalizedBy"`
+	DateFinalized        *time.Time            `json:"dateFinalized"`
+	Distribution         []GradeDistributionVm `json:"distribution,omitempty"`
+	Entries              []CalibrationEntryVm  `json:"entries,omitempty"`
+}
+
+// CalibrationSessionResponseVm wraps a single calibration session.
+type CalibrationSessionResponseVm struct {
+	BaseAPIResponse
+	Data *CalibrationSessionVm `json:"data"`
+}
+
+// CalibrationSessionListResponseVm wraps a list of calibration sessions.
+type CalibrationSessionListResponseVm struct {
+	BaseAPIResponse
+	Data        []CalibrationSessionVm `json:"data"`
+	TotalRecord int                    `json:"totalRecord"`
+}
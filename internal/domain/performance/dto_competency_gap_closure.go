@@ -0,0 +1,30 @@
+package performance
+
+import "time"
+
+// ===========================================================================
+// Competency Gap Closure Report VMs
+// ===========================================================================
+
+// CompetencyGapClosureReportItemVm is a closed-gap development plan that was
+// converted into competency gap closure credit.
+type CompetencyGapClosureReportItemVm struct {
+	StaffID                   string    `json:"staffId"`
+	StaffName                 string    `json:"staffName"`
+	CompetencyReviewProfileID int       `json:"competencyReviewProfileId"`
+	CompetencyName            string    `json:"competencyName"`
+	DevelopmentPlanID         int       `json:"developmentPlanId"`
+	Activity                  string    `json:"activity"`
+	CompetencyGapClosureID    string    `json:"competencyGapClosureId"`
+	GapClosureScore           float64   `json:"gapClosureScore"`
+	CreditedAt                time.Time `json:"creditedAt"`
+}
+
+// CompetencyGapClosureReportResponseVm lists the gaps converted in a review
+// period.
+type CompetencyGapClosureReportResponseVm struct {
+	BaseAPIResponse
+	ReviewPeriodID string                             `json:"reviewPeriodId"`
+	Data           []CompetencyGapClosureReportItemVm `json:"data"`
+	TotalRecord    int                                `json:"totalRecord"`
+}
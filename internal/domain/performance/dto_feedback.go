@@ -24,6 +24,8 @@ type FeedbackRequestLogVm struct {
 	TimeCompleted          *time.Time                `json:"timeCompleted"`
 	HasSLA                 bool                      `json:"hasSla"`
 	IsBreached             bool                      `json:"isBreached"`
+	DueAt                  *time.Time                `json:"dueAt"`
+	ElapsedBusinessHours   float64                   `json:"elapsedBusinessHours"`
 	ReviewPeriodID         string                    `json:"reviewPeriodId"`
 	RequestOwnerComment    *string                   `json:"requestOwnerComment"`
 	RequestOwnerAttachment *string                   `json:"requestOwnerAttachment"`
@@ -43,10 +45,12 @@ type BreachedFeedbackRequestListResponseVm struct {
 	Requests []FeedbackRequestLogVm `json:"requests"`
 }
 
-// FeedbackRequestLogResponseVm wraps a single FeedbackRequestLog record.
+// FeedbackRequestLogResponseVm wraps a single FeedbackRequestLog record;
+// request details also carry the SLA escalation steps fired for it.
 type FeedbackRequestLogResponseVm struct {
 	BaseAPIResponse
-	Request *FeedbackRequestLog `json:"request"`
+	Request     *FeedbackRequestLog           `json:"request"`
+	Escalations []FeedbackRequestEscalationVm `json:"escalations,omitempty"`
 }
 
 // FeedbackRequestModel is the request DTO for assigning a feedback request.
@@ -56,10 +60,21 @@ type FeedbackRequestModel struct {
 }
 
 // TreatFeedbackRequestModel is the request DTO for treating a feedback request.
+// Attachment optionally references a file the assignee uploaded through the
+// file storage endpoints.
 type TreatFeedbackRequestModel struct {
 	RequestID     string              `json:"requestId" validate:"required"`
 	OperationType enums.OperationType `json:"operationType" validate:"required"`
 	Comment       string              `json:"comment" validate:"required"`
+	Attachment    string              `json:"attachment"`
+}
+
+// UpdateFeedbackRequestModel is the request DTO for adding the assignee's
+// comment or attachment to a feedback request without treating it.
+type UpdateFeedbackRequestModel struct {
+	RequestID  string `json:"requestId" validate:"required"`
+	Comment    string `json:"comment"`
+	Attachment string `json:"attachment"`
 }
 
 // AssignedRequestModel is the request DTO for an assigned feedback request.
@@ -103,6 +118,7 @@ type StaffPendingRequestVm struct {
 	RequestOwnerStaffID  string    `json:"requestOwnerStaffId"`
 	RecordStatus         int       `json:"recordStatus"`
 	HasSLA               bool      `json:"hasSla"`
+	IsBreached           bool      `json:"isBreached"`
 	ID                   int       `json:"id"`
 	IsActive             bool      `json:"isActive"`
 }
@@ -0,0 +1,54 @@
+package performance
+
+import "time"
+
+// ===========================================================================
+// File Upload Request Models
+// ===========================================================================
+
+// FileUploadRequestModel describes a file being uploaded. The content itself
+// is passed to the service separately as a stream.
+type FileUploadRequestModel struct {
+	FileName   string `json:"fileName"`
+	Category   string `json:"category"`
+	UploadedBy string `json:"-"`
+}
+
+// ===========================================================================
+// File Upload Response VMs
+// ===========================================================================
+
+// FileUploadVm is the read/display DTO for a stored file.
+type FileUploadVm struct {
+	BaseEntityVm
+	FileUploadID string `json:"fileUploadId"`
+	OriginalName string `json:"originalName"`
+	ContentType  string `json:"contentType"`
+	SizeBytes    int64  `json:"sizeBytes"`
+	Sha256       string `json:"sha256"`
+	Category     string `json:"category"`
+	UploadedBy   string `json:"uploadedBy"`
+	OwnerStaffID string `json:"ownerStaffId"`
+	EntityType   string `json:"entityType"`
+	EntityID     string `json:"entityId"`
+}
+
+// FileUploadResponseVm wraps a single stored file.
+type FileUploadResponseVm struct {
+	BaseAPIResponse
+	Data *FileUploadVm `json:"data"`
+}
+
+// FileDownloadURLVm is a signed, time-limited download link for a file. The
+// link is bound to the user it was issued to.
+type FileDownloadURLVm struct {
+	FileUploadID string    `json:"fileUploadId"`
+	URL          string    `json:"url"`
+	ExpiresAt    time.Time `json:"expiresAt"`
+}
+
+// FileDownloadURLResponseVm wraps a signed download link.
+type FileDownloadURLResponseVm struct {
+	BaseAPIResponse
+	Data *FileDownloadURLVm `json:"data"`
+}
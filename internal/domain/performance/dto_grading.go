@@ -0,0 +1,74 @@
+package performance
+
+// ===========================================================================
+// Grading Scale Request Models
+// ===========================================================================
+
+// GradingScaleBandRequestModel is a single band in a grading scale payload.
+// MinPercentage is inclusive and MaxPercentage exclusive.
+type GradingScaleBandRequestModel struct {
+	MinPercentage     float64 `json:"minPercentage"`
+	MaxPercentage     float64 `json:"maxPercentage"     validate:"required"`
+	Grade             int     `json:"grade"             validate:"required"`
+	Label             string  `json:"label"`
+	IsUnderPerforming bool    `json:"isUnderPerforming"`
+}
+
+// GradingScaleRequestModel is the create/update payload for a grading scale.
+// An empty GradingScaleID creates version 1 of a new scale; otherwise a new
+// version of the referenced scale is created and the old one retired.
+type GradingScaleRequestModel struct {
+	GradingScaleID string                         `json:"gradingScaleId"`
+	Name           string                         `json:"name"        validate:"required"`
+	Description    string                         `json:"description"`
+	Bands          []GradingScaleBandRequestModel `json:"bands"       validate:"required"`
+	UpdatedBy      string                         `json:"-"`
+}
+
+// GradingScaleAssignmentRequestModel attaches a grading scale to a review
+// period or a strategy. An empty GradingScaleID detaches the current scale.
+type GradingScaleAssignmentRequestModel struct {
+	GradingScaleID string `json:"gradingScaleId"`
+	ReviewPeriodID string `json:"reviewPeriodId"`
+	StrategyID     string `json:"strategyId"`
+	UpdatedBy      string `json:"-"`
+}
+
+// ===========================================================================
+// Grading Scale Response VMs
+// ===========================================================================
+
+// GradingScaleBandVm is the read/display DTO for a grading band.
+type GradingScaleBandVm struct {
+	GradingScaleBandID string  `json:"gradingScaleBandId"`
+	MinPercentage      float64 `json:"minPercentage"`
+	MaxPercentage      float64 `json:"maxPercentage"`
+	Grade              int     `json:"grade"`
+	GradeName          string  `json:"gradeName"`
+	Label              string  `json:"label"`
+	IsUnderPerforming  bool    `json:"isUnderPerforming"`
+}
+
+// GradingScaleVm is the read/display DTO for a grading scale version.
+type GradingScaleVm struct {
+	BaseEntityVm
+	GradingScaleID string               `json:"gradingScaleId"`
+	Name           string               `json:"name"`
+	Description    string               `json:"description"`
+	Version        int                  `json:"version"`
+	IsBuiltIn      bool                 `json:"isBuiltIn"`
+	Bands          []GradingScaleBandVm `json:"bands"`
+}
+
+// GradingScaleResponseVm wraps a single grading scale.
+type GradingScaleResponseVm struct {
+	BaseAPIResponse
+	Data *GradingScaleVm `json:"data"`
+}
+
+// GradingScaleListResponseVm wraps a list of grading scales.
+type GradingScaleListResponseVm struct {
+	BaseAPIResponse
+	Data        []GradingScaleVm `json:"data"`
+	TotalRecord int              `json:"totalRecord"`
+}
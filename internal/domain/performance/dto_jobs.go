@@ -0,0 +1,151 @@
+package performance
+
+import "time"
+
+// ===========================================================================
+// Background Job Request Models
+// ===========================================================================
+
+// BackgroundJobSearchModel filters the background job list. Zero values
+// leave the corresponding filter unset.
+type BackgroundJobSearchModel struct {
+	BasePagedData
+	JobStatus int    `json:"jobStatus"`
+	JobType   string `json:"jobType"`
+}
+
+// ===========================================================================
+// Background Job Response VMs
+// ===========================================================================
+
+// BackgroundJobVm is the read/display DTO for a background job.
+type BackgroundJobVm struct {
+	BaseEntityVm
+	BackgroundJobID string     `json:"backgroundJobId"`
+	JobType         string     `json:"jobType"`
+	Queue           string     `json:"queue"`
+	Payload         string     `json:"payload"`
+	IdempotencyKey  string     `json:"idempotencyKey"`
+	JobStatus       int        `json:"jobStatus"`
+	JobStatusName   string     `json:"jobStatusName"`
+	Attempts        int        `json:"attempts"`
+	MaxAttempts     int        `json:"maxAttempts"`
+	RunAt           time.Time  `json:"runAt"`
+	LockedBy        string     `json:"lockedBy"`
+	LockedUntil     *time.Time `json:"lockedUntil"`
+	LastError       string     `json:"lastError"`
+	StartedAt       *time.Time `json:"startedAt"`
+	CompletedAt     *time.Time `json:"completedAt"`
+}
+
+// BackgroundJobResponseVm wraps a single background job.
+type BackgroundJobResponseVm struct {
+	BaseAPIResponse
+	Data *BackgroundJobVm `json:"data"`
+}
+
+// BackgroundJobListResponseVm wraps a page of background jobs together with
+// the number of jobs in each status across the whole queue.
+type BackgroundJobListResponseVm struct {
+	BaseAPIResponse
+	Data         []BackgroundJobVm `json:"data"`
+	TotalRecord  int               `json:"totalRecord"`
+	StatusCounts map[string]int    `json:"statusCounts"`
+}
+
+// ===========================================================================
+// Recurring Job Models
+// ===========================================================================
+
+// RecurringJobScheduleRequestModel changes the cron schedule of a recurring
+// job. Schedule accepts five-field cron expressions and descriptors such as
+// "@daily" or "@every 10m".
+type RecurringJobScheduleRequestModel struct {
+	JobName   string `json:"-"`
+	Schedule  string `json:"schedule" validate:"required"`
+	UpdatedBy string `json:"-"`
+}
+
+// RecurringJobVm is the read/display DTO for a recurring job and the outcome
+// of its last run.
+type RecurringJobVm struct {
+	BaseEntityVm
+	JobName            string     `json:"jobName"`
+	Description        string     `json:"description"`
+	Schedule           string     `json:"schedule"`
+	IsEnabled          bool       `json:"isEnabled"`
+	LastRunAt          *time.Time `json:"lastRunAt"`
+	LastDurationMs     int64      `json:"lastDurationMs"`
+	LastRunSucceeded   bool       `json:"lastRunSucceeded"`
+	LastError          string     `json:"lastError"`
+	NextRunAt          *time.Time `json:"nextRunAt"`
+	TriggerRequestedAt *time.Time `json:"triggerRequestedAt"`
+	TriggerRequestedBy string     `json:"triggerRequestedBy"`
+}
+
+// RecurringJobListResponseVm wraps the list of recurring jobs.
+type RecurringJobListResponseVm struct {
+	BaseAPIResponse
+	Data        []RecurringJobVm `json:"data"`
+	TotalRecord int              `json:"totalRecord"`
+}
+
+// ===========================================================================
+// Email Delivery Models
+// ===========================================================================
+
+// EmailDeliverySearchModel pages the list of undelivered emails.
+type EmailDeliverySearchModel struct {
+	BasePagedData
+}
+
+// EmailResendRequestModel requeues undelivered emails.
+type EmailResendRequestModel struct {
+	EmailIDs    []int  `json:"emailIds" validate:"required"`
+	RequestedBy string `json:"-"`
+}
+
+// EmailDeliveryVm is a failed, bounced or stuck email together with its
+// last delivery attempt. Stuck emails were left Processing by a sender that
+// never finished them.
+type EmailDeliveryVm struct {
+	EmailID          int        `json:"emailId"`
+	From             string     `json:"from"`
+	To               string     `json:"to"`
+	CC               string     `json:"cc"`
+	Subject          string     `json:"subject"`
+	Action           string     `json:"action"`
+	AppSource        string     `json:"appSource"`
+	Status           string     `json:"status"`
+	Stuck            bool       `json:"stuck"`
+	Attempts         int        `json:"attempts"`
+	ExpectedSendDate *time.Time `json:"expectedSendDate"`
+	DateCreated      *time.Time `json:"dateCreated"`
+	LastUpdatedDate  *time.Time `json:"lastUpdatedDate"`
+	LastAttemptAt    *time.Time `json:"lastAttemptAt"`
+	LastError        string     `json:"lastError"`
+}
+
+// EmailDeliveryAttemptVm is one delivery attempt of an email.
+type EmailDeliveryAttemptVm struct {
+	Attempt     int       `json:"attempt"`
+	Outcome     string    `json:"outcome"`
+	Error       string    `json:"error"`
+	AttemptedAt time.Time `json:"attemptedAt"`
+	DurationMs  int64     `json:"durationMs"`
+}
+
+// EmailDeliveryListResponseVm wraps a page of undelivered emails.
+type EmailDeliveryListResponseVm struct {
+	BaseAPIResponse
+	Data        []EmailDeliveryVm `json:"data"`
+	TotalRecord int               `json:"totalRecord"`
+}
+
+// EmailDeliveryResponseVm wraps an email and its delivery history, newest
+// attempt first.
+type EmailDeliveryResponseVm struct {
+	BaseAPIResponse
+	Data     *EmailDeliveryVm         `json:"data"`
+	Attempts []EmailDeliveryAttemptVm `json:"attempts"`
+}
@@ -0,0 +1,71 @@
+package performance
+
+import "time"
+
+// NotificationSearchModel pages a staff member's notification inbox.
+type NotificationSearchModel struct {
+	BasePagedData
+	StaffID    string `json:"-"`
+	UnreadOnly bool   `json:"unreadOnly"`
+}
+
+// NotificationMarkReadRequestModel marks notifications in the caller's inbox
+// as read.
+type NotificationMarkReadRequestModel struct {
+	NotificationIDs []int64 `json:"notificationIds" validate:"required"`
+	StaffID         string  `json:"-"`
+}
+
+// NotificationVm is one entry of a notification inbox.
+type NotificationVm struct {
+	NotificationID int64      `json:"notificationId"`
+	Type           string     `json:"type"`
+	Category       string     `json:"category"`
+	Title          string     `json:"title"`
+	Message        string     `json:"message"`
+	EntityType     string     `json:"entityType"`
+	EntityID       string     `json:"entityId"`
+	Link           string     `json:"link"`
+	IsRead         bool       `json:"isRead"`
+	ReadAt         *time.Time `json:"readAt"`
+	CreatedAt      time.Time  `json:"createdAt"`
+}
+
+// NotificationListResponseVm wraps a page of notifications, newest first,
+// with the inbox's unread count.
+type NotificationListResponseVm struct {
+	BaseAPIResponse
+	Data        []NotificationVm `json:"data"`
+	TotalRecord int              `json:"totalRecord"`
+	UnreadCount int              `json:"unreadCount"`
+}
+
+// NotificationPreferenceVm is a staff member's delivery mode for one
+// notification category, with the modes they may choose from.
+type NotificationPreferenceVm struct {
+	Category     string   `json:"category"`
+	Description  string   `json:"description"`
+	Mode         string   `json:"mode"`
+	Mandatory    bool     `json:"mandatory"`
+	AllowedModes []string `json:"allowedModes"`
+}
+
+// NotificationPreferenceListResponseVm wraps a staff member's preferences
+// for every notification category.
+type NotificationPreferenceListResponseVm struct {
+	BaseAPIResponse
+	Data []NotificationPreferenceVm `json:"data"`
+}
+
+// NotificationPreferenceItem sets the delivery mode of one category.
+type NotificationPreferenceItem struct {
+	Category string `json:"category" validate:"required"`
+	Mode     string `json:"mode"     validate:"required"`
+}
+
+// NotificationPreferenceRequestModel updates the caller's notification
+// preferences. Categories not listed keep their current mode.
+type NotificationPreferenceRequestModel struct {
+	Preferences []NotificationPreferenceItem `json:"preferences" validate:"required"`
+	StaffID     string                       `json:"-"`
+}
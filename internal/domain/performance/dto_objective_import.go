@@ -0,0 +1,71 @@
+package performance
+
+// ===========================================================================
+// Objective Import Request Models
+// ===========================================================================
+
+// ObjectiveImportRow is a cascaded objective row with the spreadsheet row it
+// came from (or its 1-based position in a JSON upload).
+type ObjectiveImportRow struct {
+	RowNumber int
+	CascadedObjectiveUploadVm
+}
+
+// ObjectiveImportRequestModel carries a cascaded objective upload. Sheet holds
+// the cells of an uploaded .xlsx/.csv file, header row first; Rows holds
+// already-parsed rows from a JSON upload. Rows without a strategy use
+// StrategyID, or the current strategy when that is empty too.
+//
+// DryRun validates without writing anything. Otherwise an upload with invalid
+// rows is rejected unless SkipInvalid is set, in which case the valid rows are
+// committed and the invalid ones skipped.
+type ObjectiveImportRequestModel struct {
+	StrategyID  string
+	Sheet       [][]string
+	Rows        []ObjectiveImportRow
+	DryRun      bool
+	SkipInvalid bool
+	CreatedBy   string
+}
+
+// ===========================================================================
+// Objective Import Response VMs
+// ===========================================================================
+
+// ObjectiveImportRowResultVm is the validation outcome of one uploaded row.
+type ObjectiveImportRowResultVm struct {
+	RowNumber           int      `json:"rowNumber"`
+	EnterpriseObjective string   `json:"enterpriseObjective"`
+	IsValid             bool     `json:"isValid"`
+	Errors              []string `json:"errors"`
+}
+
+// ObjectiveImportResponseVm is the per-row report of an objective upload and,
+// when rows were committed, how many objectives were created.
+type ObjectiveImportResponseVm struct {
+	BaseAPIResponse
+	StrategyID        string                       `json:"strategyId"`
+	DryRun            bool                         `json:"dryRun"`
+	Committed         bool                         `json:"committed"`
+	TotalRows         int                          `json:"totalRows"`
+	ValidRows         int                          `json:"validRows"`
+	InvalidRows       int                          `json:"invalidRows"`
+	ObjectivesCreated int                          `json:"objectivesCreated"`
+	Rows              []ObjectiveImportRowResultVm `json:"rows"`
+}
+
+// ObjectiveUploadTemplateVm is the cascaded objective upload template of a
+// strategy: the empty upload sheet followed by lookup sheets listing the
+// themes, categories, organogram units and job grade groups it accepts.
+type ObjectiveUploadTemplateVm struct {
+	StrategyID   string                   `json:"strategyId"`
+	StrategyName string                   `json:"strategyName"`
+	Sheets       []ObjectiveTemplateSheet `json:"sheets"`
+}
+
+// ObjectiveTemplateSheet is one worksheet of the upload template; the first
+// row is the header.
+type ObjectiveTemplateSheet struct {
+	Name string     `json:"name"`
+	Rows [][]string `json:"rows"`
+}
@@ -1070,6 +1070,9 @@ type PeriodScoreData struct {
 	ScorePercentage    float64 `json:"scorePercentage"`
 	FinalGrade         int     `json:"finalGrade"`
 	FinalGradeName     string  `json:"finalGradeName"`
+	CalibratedGrade    int     `json:"calibratedGrade"`
+	CalibratedGradeName string `json:"calibratedGradeName"`
+	IsCalibrated       bool    `json:"isCalibrated"`
 	StartDate          time.Time `json:"startDate"`
 	EndDate            time.Time `json:"endDate"`
 	OfficeID           int     `json:"officeID"`
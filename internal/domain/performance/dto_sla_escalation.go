@@ -0,0 +1,69 @@
+package performance
+
+import "time"
+
+// ===========================================================================
+// SLA Escalation Request Models
+// ===========================================================================
+
+// SLAEscalationStepRequestModel is a single step in an escalation ladder
+// payload. EscalateTo is an approver resolver; 0 escalates to nobody.
+type SLAEscalationStepRequestModel struct {
+	ThresholdPercent int    `json:"thresholdPercent" validate:"required"`
+	Name             string `json:"name"             validate:"required"`
+	NotifyAssignee   bool   `json:"notifyAssignee"`
+	EscalateTo       int    `json:"escalateTo"`
+	RoleName         string `json:"roleName"`
+}
+
+// SLAEscalationLadderRequestModel replaces the escalation ladder of a
+// feedback request type; deleting the ladder restores the built-in one.
+type SLAEscalationLadderRequestModel struct {
+	FeedbackRequestType int                             `json:"feedbackRequestType" validate:"required"`
+	Steps               []SLAEscalationStepRequestModel `json:"steps"               validate:"required"`
+	UpdatedBy           string                          `json:"-"`
+}
+
+// ===========================================================================
+// SLA Escalation Response VMs
+// ===========================================================================
+
+// SLAEscalationStepVm is the read/display DTO for an escalation step.
+type SLAEscalationStepVm struct {
+	SLAEscalationStepID string `json:"slaEscalationStepId"`
+	ThresholdPercent    int    `json:"thresholdPercent"`
+	Name                string `json:"name"`
+	NotifyAssignee      bool   `json:"notifyAssignee"`
+	EscalateTo          int    `json:"escalateTo"`
+	EscalateToName      string `json:"escalateToName"`
+	RoleName            string `json:"roleName"`
+}
+
+// SLAEscalationLadderVm is the escalation ladder of a feedback request type.
+type SLAEscalationLadderVm struct {
+	FeedbackRequestType     int                   `json:"feedbackRequestType"`
+	FeedbackRequestTypeName string                `json:"feedbackRequestTypeName"`
+	IsBuiltIn               bool                  `json:"isBuiltIn"`
+	Steps                   []SLAEscalationStepVm `json:"steps"`
+}
+
+// SLAEscalationLadderListResponseVm wraps the ladder of every feedback
+// request type.
+type SLAEscalationLadderListResponseVm struct {
+	BaseAPIResponse
+	Data        []SLAEscalationLadderVm `json:"data"`
+	TotalRecord int                     `json:"totalRecord"`
+}
+
+// FeedbackRequestEscalationVm is an escalation step that fired, or was
+// skipped, for a feedback request.
+type FeedbackRequestEscalationVm struct {
+	AssignedStaffID  string    `json:"assignedStaffId"`
+	ThresholdPercent int       `json:"thresholdPercent"`
+	StepName         string    `json:"stepName"`
+	NotifiedStaffIDs []string  `json:"notifiedStaffIds"`
+	Skipped          bool      `json:"skipped"`
+	SLAUsedPercent   int       `json:"slaUsedPercent"`
+	DueAt            time.Time `json:"dueAt"`
+	SentAt           time.Time `json:"sentAt"`
+}
@@ -0,0 +1,19 @@
+package performance
+
+import "time"
+
+// EmailDeliveryAttempt records one attempt by the mail sender to deliver an
+// email from the email service database. EmailID is the EmailObjects Id;
+// the attempts of an email, newest first, are its delivery history and the
+// latest one holds its last error.
+type EmailDeliveryAttempt struct {
+	EmailDeliveryAttemptID int64     `json:"email_delivery_attempt_id" gorm:"column:email_delivery_attempt_id;primaryKey;autoIncrement"`
+	EmailID                int       `json:"email_id"                  gorm:"column:email_id;not null;index"`
+	Attempt                int       `json:"attempt"                   gorm:"column:attempt;not null"`
+	Outcome                string    `json:"outcome"                   gorm:"column:outcome;not null"`
+	Error                  string    `json:"error"                     gorm:"column:error;type:text"`
+	AttemptedAt            time.Time `json:"attempted_at"              gorm:"column:attempted_at;not null"`
+	DurationMs             int64     `json:"duration_ms"               gorm:"column:duration_ms"`
+}
+
+func (EmailDeliveryAttempt) TableName() string { return "pms.email_delivery_attempts" }
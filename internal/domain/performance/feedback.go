@@ -31,6 +31,21 @@ type FeedbackRequestLog struct {
 
 func (FeedbackRequestLog) TableName() string { return "pms.feedback_request_logs" }
 
+// FeedbackRequestReassignment records a breached feedback request being
+// reassigned automatically, and the policy that chose the new assignee.
+// The records cap automatic reassignments per request and keep requests
+// from returning to a previous assignee.
+type FeedbackRequestReassignment struct {
+	FeedbackRequestReassignmentID string    `json:"feedback_request_reassignment_id" gorm:"column:feedback_request_reassignment_id;primaryKey"`
+	FeedbackRequestLogID          string    `json:"feedback_request_log_id"          gorm:"column:feedback_request_log_id;not null;index"`
+	FromStaffID                   string    `json:"from_staff_id"                    gorm:"column:from_staff_id;not null"`
+	ToStaffID                     string    `json:"to_staff_id"                      gorm:"column:to_staff_id;not null"`
+	Policy                        string    `json:"policy"                           gorm:"column:policy;not null;index"`
+	ReassignedAt                  time.Time `json:"reassigned_at"                    gorm:"column:reassigned_at;not null"`
+}
+
+func (FeedbackRequestReassignment) TableName() string { return "pms.feedback_request_reassignments" }
+
 // FeedbackQuestionaire defines a 360-feedback question for a PMS competency.
 type FeedbackQuestionaire struct {
 	FeedbackQuestionaireID string `json:"feedback_questionaire_id" gorm:"column:feedback_questionaire_id;primaryKey"`
@@ -134,3 +149,21 @@ type CompetencyGapClosure struct {
 }
 
 func (CompetencyGapClosure) TableName() string { return "pms.competency_gap_closures" }
+
+// CompetencyGapClosureItem records a closed-gap development plan credited
+// to a staff member's competency gap closure. Each plan is credited once,
+// to the review period its target date falls in.
+type CompetencyGapClosureItem struct {
+	CompetencyGapClosureItemID string    `json:"competency_gap_closure_item_id" gorm:"column:competency_gap_closure_item_id;primaryKey"`
+	CompetencyGapClosureID     string    `json:"competency_gap_closure_id"      gorm:"column:competency_gap_closure_id;not null;index"`
+	DevelopmentPlanID          int       `json:"development_plan_id"            gorm:"column:development_plan_id;not null;uniqueIndex"`
+	CompetencyReviewProfileID  int       `json:"competency_review_profile_id"   gorm:"column:competency_review_profile_id;not null"`
+	StaffID                    string    `json:"staff_id"                       gorm:"column:staff_id;not null"`
+	StaffName                  string    `json:"staff_name"                     gorm:"column:staff_name"`
+	ReviewPeriodID             string    `json:"review_period_id"               gorm:"column:review_period_id;not null;index"`
+	CompetencyName             string    `json:"competency_name"                gorm:"column:competency_name"`
+	Activity                   string    `json:"activity"                       gorm:"column:activity"`
+	CreditedAt                 time.Time `json:"credited_at"                    gorm:"column:credited_at;not null"`
+}
+
+func (CompetencyGapClosureItem) TableName() string { return "pms.competency_gap_closure_items" }
@@ -0,0 +1,35 @@
+package performance
+
+import "github.com/enterprise-pms/pms-api/internal/domain"
+
+// Entity types a FileUpload can be attached to.
+const (
+	FileEntityGrievance       = "grievance"
+	FileEntityFeedbackRequest = "feedback_request"
+)
+
+// FileUpload records a file held by the configured storage backend. The
+// evidence and attachment columns elsewhere in the schema store the
+// FileUploadID, never a filesystem path, so files remain reachable from any
+// replica and after the storage backend changes.
+//
+// OwnerStaffID is the staff member whose record the file supports; it is the
+// uploader until the file is attached. EntityType and EntityID name the
+// grievance or feedback request it is attached to, and are empty until then.
+type FileUpload struct {
+	FileUploadID string `json:"file_upload_id" gorm:"column:file_upload_id;primaryKey"`
+	StorageKey   string `json:"storage_key"    gorm:"column:storage_key;not null"`
+	Backend      string `json:"backend"        gorm:"column:backend;not null"`
+	OriginalName string `json:"original_name"  gorm:"column:original_name"`
+	ContentType  string `json:"content_type"   gorm:"column:content_type"`
+	SizeBytes    int64  `json:"size_bytes"     gorm:"column:size_bytes"`
+	Sha256       string `json:"sha256"         gorm:"column:sha256"`
+	Category     string `json:"category"       gorm:"column:category"`
+	UploadedBy   string `json:"uploaded_by"    gorm:"column:uploaded_by;index"`
+	OwnerStaffID string `json:"owner_staff_id" gorm:"column:owner_staff_id"`
+	EntityType   string `json:"entity_type"    gorm:"column:entity_type"`
+	EntityID     string `json:"entity_id"      gorm:"column:entity_id"`
+	domain.BaseEntity
+}
+
+func (FileUpload) TableName() string { return "pms.file_uploads" }
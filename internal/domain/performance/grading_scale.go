@@ -0,0 +1,39 @@
+package performance
+
+import (
+	"github.com/enterprise-pms/pms-api/internal/domain"
+	"github.com/enterprise-pms/pms-api/internal/domain/enums"
+)
+
+// GradingScale is a named, versioned set of percentage bands used to grade
+// period scores. Saving changes to a scale creates a new version so review
+// periods already graded against an earlier version keep their bands.
+type GradingScale struct {
+	GradingScaleID string `json:"grading_scale_id" gorm:"column:grading_scale_id;primaryKey"`
+	Name           string `json:"name"             gorm:"column:name;not null;index"`
+	Description    string `json:"description"      gorm:"column:description"`
+	Version        int    `json:"version"          gorm:"column:version;not null;default:1"`
+	domain.BaseEntity
+
+	Bands []GradingScaleBand `json:"bands" gorm:"foreignKey:GradingScaleID"`
+}
+
+func (GradingScale) TableName() string { return "pms.grading_scales" }
+
+// GradingScaleBand maps a percentage range to a grade. MinPercentage is
+// inclusive and MaxPercentage exclusive; the top band also covers scores at
+// or above its maximum.
+type GradingScaleBand struct {
+	GradingScaleBandID string                 `json:"grading_scale_band_id" gorm:"column:grading_scale_band_id;primaryKey"`
+	GradingScaleID     string                 `json:"grading_scale_id"      gorm:"column:grading_scale_id;not null;index"`
+	MinPercentage      float64                `json:"min_percentage"        gorm:"column:min_percentage;type:decimal(18,2);not null"`
+	MaxPercentage      float64                `json:"max_percentage"        gorm:"column:max_percentage;type:decimal(18,2);not null"`
+	Grade              enums.PerformanceGrade `json:"grade"                 gorm:"column:grade;not null"`
+	Label              string                 `json:"label"                 gorm:"column:label;not null"`
+	IsUnderPerforming  bool                   `json:"is_under_performing"   gorm:"column:is_under_performing;default:false"`
+	domain.BaseEntity
+
+	GradingScale *GradingScale `json:"grading_scale,omitempty" gorm:"foreignKey:GradingScaleID"`
+}
+
+func (GradingScaleBand) TableName() string { return "pms.grading_scale_bands" }
@@ -5,7 +5,8 @@ import (
 	"github.com/enterprise-pms/pms-api/internal/domain/enums"
 )
 
-// Grievance records a staff complaint during a review period.
+// Grievance records a staff complaint during a review period. The evidence
+// upload fields hold a FileUpload ID issued by POST /api/v1/files.
 type Grievance struct {
 	GrievanceID            string               `json:"grievance_id"             gorm:"column:grievance_id;primaryKey"`
 	GrievanceType          enums.GrievanceType  `json:"grievance_type"           gorm:"column:grievance_type;not null"`
@@ -28,6 +29,7 @@ type Grievance struct {
 func (Grievance) TableName() string { return "pms.grievances" }
 
 // GrievanceResolution records a mediation attempt for a grievance.
+// EvidenceUpload holds a FileUpload ID.
 type GrievanceResolution struct {
 	GrievanceResolutionID string                  `json:"grievance_resolution_id" gorm:"column:grievance_resolution_id;primaryKey"`
 	ResolutionComment     string                  `json:"resolution_comment"      gorm:"column:resolution_comment;not null"`
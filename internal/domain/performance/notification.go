@@ -0,0 +1,151 @@
+package performance
+
+import "time"
+
+// Notification types recorded on Notification.Type.
+const (
+	NotificationTypeGeneral                 = "General"
+	NotificationTypeNewRequest              = "NewRequest"
+	NotificationTypeRequestInitiated        = "RequestInitiated"
+	NotificationTypeRequestTreated          = "RequestTreated"
+	NotificationTypeRequestReassigned       = "RequestReassigned"
+	NotificationTypeGrievanceResolution     = "GrievanceResolution"
+	NotificationTypeGrievanceEscalation     = "GrievanceEscalation"
+	NotificationTypeDevelopmentPlanReminder = "DevelopmentPlanReminder"
+	NotificationTypeDevelopmentPlanUpdate   = "DevelopmentPlanUpdate"
+	NotificationTypeSLAWarning              = "SlaWarning"
+	NotificationTypeSLAEscalation           = "SlaEscalation"
+)
+
+// Notification categories. Staff choose a delivery mode per category; a
+// notification's category defaults to the one of its type.
+const (
+	NotificationCategoryApprovals           = "Approvals"
+	NotificationCategorySLAWarnings         = "SlaWarnings"
+	NotificationCategoryReviews360          = "Reviews360"
+	NotificationCategoryGrievances          = "Grievances"
+	NotificationCategoryGrievanceEscalation = "GrievanceEscalation"
+	NotificationCategoryDevelopmentPlans    = "DevelopmentPlans"
+	NotificationCategoryGeneral             = "General"
+)
+
+// Delivery modes of a notification category.
+const (
+	NotificationModeImmediate = "Immediate" // inbox and an email at once
+	NotificationModeDigest    = "Digest"    // inbox and a line in the daily digest email
+	NotificationModeInAppOnly = "InAppOnly" // inbox only
+	NotificationModeMuted     = "Muted"     // nothing
+)
+
+// NotificationCategoryInfo describes a notification category. Mandatory
+// categories must still reach the recipient's mailbox, so they cannot be
+// muted or limited to the inbox.
+type NotificationCategoryInfo struct {
+	Name        string
+	Description string
+	Mandatory   bool
+}
+
+// NotificationCategories lists the categories staff set preferences for.
+var NotificationCategories = []NotificationCategoryInfo{
+	{NotificationCategoryApprovals, "Requests assigned to you and updates on requests you raised", false},
+	{NotificationCategorySLAWarnings, "Requests about to breach or past their SLA", false},
+	{NotificationCategoryReviews360, "360 review requests and feedback", false},
+	{NotificationCategoryGrievances, "Updates on grievances you raised", false},
+	{NotificationCategoryGrievanceEscalation, "Escalation of grievances you raised", true},
+	{NotificationCategoryDevelopmentPlans, "Development plan reminders and sign-offs", false},
+	{NotificationCategoryGeneral, "Other notifications", false},
+}
+
+// LookupNotificationCategory returns the category with the given name.
+func LookupNotificationCategory(name string) (NotificationCategoryInfo, bool) {
+	for _, c := range NotificationCategories {
+		if c.Name == name {
+			return c, true
+		}
+	}
+	return NotificationCategoryInfo{}, false
+}
+
+// AllowsMode reports whether staff may choose mode for the category.
+func (c NotificationCategoryInfo) AllowsMode(mode string) bool {
+	switch mode {
+	case NotificationModeImmediate, NotificationModeDigest:
+		return true
+	case NotificationModeInAppOnly, NotificationModeMuted:
+		return !c.Mandatory
+	}
+	return false
+}
+
+// NotificationCategoryOf returns the default category of a notification type.
+func NotificationCategoryOf(notificationType string) string {
+	switch notificationType {
+	case NotificationTypeNewRequest, NotificationTypeRequestInitiated, NotificationTypeRequestTreated, NotificationTypeRequestReassigned:
+		return NotificationCategoryApprovals
+	case NotificationTypeGrievanceResolution:
+		return NotificationCategoryGrievances
+	case NotificationTypeGrievanceEscalation:
+		return NotificationCategoryGrievanceEscalation
+	case NotificationTypeDevelopmentPlanReminder, NotificationTypeDevelopmentPlanUpdate:
+		return NotificationCategoryDevelopmentPlans
+	case NotificationTypeSLAWarning, NotificationTypeSLAEscalation:
+		return NotificationCategorySLAWarnings
+	}
+	return NotificationCategoryGeneral
+}
+
+// Entity types a notification can link to.
+const (
+	NotificationEntityFeedbackRequest = "FeedbackRequest"
+	NotificationEntityGrievance       = "Grievance"
+	NotificationEntityDevelopmentPlan = "DevelopmentPlan"
+)
+
+// Notification is an entry in a staff member's in-app inbox. EntityType and
+// EntityID name the record it is about and Link is the web client route
+// that opens it. NotificationID increases monotonically, so it doubles as
+// the cursor of the live notification stream.
+type Notification struct {
+	NotificationID   int64      `json:"notification_id"    gorm:"column:notification_id;primaryKey;autoIncrement"`
+	RecipientStaffID string     `json:"recipient_staff_id" gorm:"column:recipient_staff_id;not null;index"`
+	Type             string     `json:"type"               gorm:"column:type;not null"`
+	Category         string     `json:"category"           gorm:"column:category;not null;default:'General'"`
+	Title            string     `json:"title"              gorm:"column:title;not null"`
+	Message          string     `json:"message"            gorm:"column:message;type:text"`
+	EntityType       string     `json:"entity_type"        gorm:"column:entity_type"`
+	EntityID         string     `json:"entity_id"          gorm:"column:entity_id"`
+	Link             string     `json:"link"               gorm:"column:link"`
+	IsRead           bool       `json:"is_read"            gorm:"column:is_read;not null;default:false"`
+	ReadAt           *time.Time `json:"read_at"            gorm:"column:read_at"`
+	CreatedAt        time.Time  `json:"created_at"         gorm:"column:created_at;autoCreateTime"`
+}
+
+func (Notification) TableName() string { return "pms.notifications" }
+
+// NotificationPreference is a staff member's delivery mode for one
+// notification category. Categories without a row are delivered at once.
+type NotificationPreference struct {
+	StaffID   string    `json:"staff_id"   gorm:"column:staff_id;primaryKey"`
+	Category  string    `json:"category"   gorm:"column:category;primaryKey"`
+	Mode      string    `json:"mode"       gorm:"column:mode;not null"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (NotificationPreference) TableName() string { return "pms.notification_preferences" }
+
+// NotificationDigestItem queues a notification for its recipient's next
+// digest email. EmailTo is the address the notification would have been
+// emailed to; SentAt is set once a digest has covered the item.
+type NotificationDigestItem struct {
+	NotificationDigestItemID int64      `json:"notification_digest_item_id" gorm:"column:notification_digest_item_id;primaryKey;autoIncrement"`
+	NotificationID           int64      `json:"notification_id"             gorm:"column:notification_id;not null"`
+	RecipientStaffID         string     `json:"recipient_staff_id"          gorm:"column:recipient_staff_id;not null"`
+	EmailTo                  string     `json:"email_to"                    gorm:"column:email_to"`
+	CreatedAt                time.Time  `json:"created_at"                  gorm:"column:created_at;autoCreateTime"`
+	SentAt                   *time.Time `json:"sent_at"                     gorm:"column:sent_at"`
+
+	Notification *Notification `json:"notification,omitempty" gorm:"foreignKey:NotificationID;references:NotificationID"`
+}
+
+func (NotificationDigestItem) TableName() string { return "pms.notification_digest_items" }
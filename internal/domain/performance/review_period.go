@@ -17,6 +17,7 @@ type Strategy struct {
 	StartDate        time.Time  `json:"start_date"         gorm:"column:start_date;not null"`
 	EndDate          time.Time  `json:"end_date"           gorm:"column:end_date;not null"`
 	FileImage        string     `json:"file_image"         gorm:"column:file_image"`
+	GradingScaleID   *string    `json:"grading_scale_id"   gorm:"column:grading_scale_id"`
 	domain.BaseWorkFlow
 
 	EnterpriseObjectives []EnterpriseObjective `json:"enterprise_objectives" gorm:"foreignKey:StrategyID"`
@@ -58,6 +59,7 @@ type PerformanceReviewPeriod struct {
 	MinNoOfObjectives           int                   `json:"min_no_of_objectives"            gorm:"column:min_no_of_objectives;default:1"`
 	MaxNoOfObjectives           int                   `json:"max_no_of_objectives"            gorm:"column:max_no_of_objectives"`
 	StrategyID                  string                `json:"strategy_id"                    gorm:"column:strategy_id"`
+	GradingScaleID              *string               `json:"grading_scale_id"               gorm:"column:grading_scale_id"`
 	domain.BaseWorkFlow
 
 	Strategy               *Strategy                        `json:"strategy"                gorm:"foreignKey:StrategyID"`
@@ -120,6 +122,12 @@ type PeriodScore struct {
 	LocationID        string                  `json:"location_id"         gorm:"column:location_id"`
 	HRDDeductedPoints float64                 `json:"hrd_deducted_points" gorm:"column:hrd_deducted_points;type:decimal(18,2);default:0"`
 	IsUnderPerforming bool                    `json:"is_under_performing" gorm:"column:is_under_performing;default:false"`
+	GradingScaleID    *string                 `json:"grading_scale_id"    gorm:"column:grading_scale_id"`
+	FinalGradeLabel   string                  `json:"final_grade_label"   gorm:"column:final_grade_label"`
+	CalibratedGrade   enums.PerformanceGrade  `json:"calibrated_grade"    gorm:"column:calibrated_grade"`
+	IsCalibrated      bool                    `json:"is_calibrated"       gorm:"column:is_calibrated;default:false"`
+	CalibratedBy      string                  `json:"calibrated_by"       gorm:"column:calibrated_by"`
+	DateCalibrated    *time.Time              `json:"date_calibrated"     gorm:"column:date_calibrated"`
 	domain.BaseEntity
 
 	ReviewPeriod *PerformanceReviewPeriod `json:"review_period" gorm:"foreignKey:ReviewPeriodID"`
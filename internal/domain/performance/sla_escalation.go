@@ -0,0 +1,47 @@
+package performance
+
+import (
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/domain"
+	"github.com/enterprise-pms/pms-api/internal/domain/enums"
+)
+
+// SLAEscalationStep is one rung of the database-managed escalation ladder of
+// a feedback request type. Once a pending request has used ThresholdPercent
+// of its SLA the step fires: the assignee is notified when NotifyAssignee is
+// set, and whoever EscalateTo resolves to for the assignee is notified too.
+// Types without stored steps use the built-in ladder.
+type SLAEscalationStep struct {
+	SLAEscalationStepID string                     `json:"sla_escalation_step_id" gorm:"column:sla_escalation_step_id;primaryKey"`
+	FeedbackRequestType enums.FeedbackRequestType  `json:"feedback_request_type"  gorm:"column:feedback_request_type;not null;index"`
+	ThresholdPercent    int                        `json:"threshold_percent"      gorm:"column:threshold_percent;not null"`
+	Name                string                     `json:"name"                   gorm:"column:name;not null"`
+	NotifyAssignee      bool                       `json:"notify_assignee"        gorm:"column:notify_assignee;default:false"`
+	EscalateTo          enums.ApproverResolverType `json:"escalate_to"            gorm:"column:escalate_to;default:0"`
+	RoleName            string                     `json:"role_name"              gorm:"column:role_name"`
+	domain.BaseEntity
+}
+
+func (SLAEscalationStep) TableName() string { return "pms.sla_escalation_steps" }
+
+// FeedbackRequestEscalation records an escalation step that fired for a
+// feedback request. A threshold fires at most once per assignment, the
+// assignee and the time the request was initiated with them; lower steps
+// overtaken by a higher one before the evaluator reached them are recorded
+// as skipped and never sent.
+type FeedbackRequestEscalation struct {
+	FeedbackRequestEscalationID string    `json:"feedback_request_escalation_id" gorm:"column:feedback_request_escalation_id;primaryKey"`
+	FeedbackRequestLogID        string    `json:"feedback_request_log_id"        gorm:"column:feedback_request_log_id;not null;uniqueIndex:ux_feedback_request_escalations_threshold"`
+	AssignedStaffID             string    `json:"assigned_staff_id"              gorm:"column:assigned_staff_id;not null;uniqueIndex:ux_feedback_request_escalations_threshold"`
+	TimeInitiated               time.Time `json:"time_initiated"                 gorm:"column:time_initiated;not null;uniqueIndex:ux_feedback_request_escalations_threshold"`
+	ThresholdPercent            int       `json:"threshold_percent"              gorm:"column:threshold_percent;not null;uniqueIndex:ux_feedback_request_escalations_threshold"`
+	StepName                    string    `json:"step_name"                      gorm:"column:step_name;not null"`
+	NotifiedStaffIDs            string    `json:"notified_staff_ids"             gorm:"column:notified_staff_ids"`
+	Skipped                     bool      `json:"skipped"                        gorm:"column:skipped;default:false"`
+	SLAUsedPercent              int       `json:"sla_used_percent"               gorm:"column:sla_used_percent"`
+	DueAt                       time.Time `json:"due_at"                         gorm:"column:due_at"`
+	SentAt                      time.Time `json:"sent_at"                        gorm:"column:sent_at;not null"`
+}
+
+func (FeedbackRequestEscalation) TableName() string { return "pms.feedback_request_escalations" }
@@ -48,3 +48,12 @@ type StaffLunchAttendance struct {
 
 // TableName returns the SQL Server table name for StaffLunchAttendance.
 func (StaffLunchAttendance) TableName() string { return "dbo.XXCBN_SAS_StaffLunchAttendance" }
+
+// AttendanceDay returns the day the record is for, from YearRef and DayRef
+// (the day of the year), or false when either is missing.
+func (a StaffLunchAttendance) AttendanceDay() (time.Time, bool) {
+	if a.YearRef == nil || a.DayRef == nil || *a.DayRef < 1 {
+		return time.Time{}, false
+	}
+	return time.Date(*a.YearRef, time.January, *a.DayRef, 0, 0, 0, 0, time.UTC), true
+}
@@ -0,0 +1,198 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"github.com/enterprise-pms/pms-api/internal/service"
+	"github.com/enterprise-pms/pms-api/pkg/response"
+	"github.com/rs/zerolog"
+)
+
+// AuditTrailHandler handles the record history endpoints used by HR audit
+// and grievance investigations, and the audit configuration endpoints.
+type AuditTrailHandler struct {
+	svc *service.Container
+	log zerolog.Logger
+}
+
+// NewAuditTrailHandler creates a new audit trail handler.
+func NewAuditTrailHandler(svc *service.Container, log zerolog.Logger) *AuditTrailHandler {
+	return &AuditTrailHandler{svc: svc, log: log}
+}
+
+// GetEntityTimeline handles GET /api/v1/audit-trail/{tableName}/{recordId}
+// Returns the record's change-sets, oldest first.
+func (h *AuditTrailHandler) GetEntityTimeline(w http.ResponseWriter, r *http.Request) {
+	tableName, recordID, ok := auditRecordPath(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.svc.AuditTrail.GetEntityTimeline(r.Context(), tableName, recordID)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "GetEntityTimeline").Str("table", tableName).Str("recordId", recordID).Msg("Failed to get audit timeline")
+		response.Error(w, http.StatusInternalServerError, "Failed to retrieve audit timeline")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusNotFound, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// GetEntityStateAsOf handles GET /api/v1/audit-trail/{tableName}/{recordId}/as-of?at={RFC3339}
+// Reconstructs the record's field values at the given instant.
+func (h *AuditTrailHandler) GetEntityStateAsOf(w http.ResponseWriter, r *http.Request) {
+	tableName, recordID, ok := auditRecordPath(w, r)
+	if !ok {
+		return
+	}
+	asOf, err := time.Parse(time.RFC3339, r.URL.Query().Get("at"))
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "at must be an RFC 3339 timestamp")
+		return
+	}
+
+	result, err := h.svc.AuditTrail.GetEntityStateAsOf(r.Context(), tableName, recordID, asOf.UTC())
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "GetEntityStateAsOf").Str("table", tableName).Str("recordId", recordID).Msg("Failed to reconstruct record state")
+		response.Error(w, http.StatusInternalServerError, "Failed to reconstruct record state")
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// DiffEntityStates handles GET /api/v1/audit-trail/{tableName}/{recordId}/diff?from={RFC3339}&to={RFC3339}
+// Lists the fields that changed between the two instants.
+func (h *AuditTrailHandler) DiffEntityStates(w http.ResponseWriter, r *http.Request) {
+	tableName, recordID, ok := auditRecordPath(w, r)
+	if !ok {
+		return
+	}
+	q := r.URL.Query()
+	from, err := time.Parse(time.RFC3339, q.Get("from"))
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "from must be an RFC 3339 timestamp")
+		return
+	}
+	to, err := time.Parse(time.RFC3339, q.Get("to"))
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "to must be an RFC 3339 timestamp")
+		return
+	}
+
+	result, err := h.svc.AuditTrail.DiffEntityStates(r.Context(), tableName, recordID, from.UTC(), to.UTC())
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "DiffEntityStates").Str("table", tableName).Str("recordId", recordID).Msg("Failed to diff record states")
+		response.Error(w, http.StatusInternalServerError, "Failed to diff record states")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusBadRequest, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// auditRecordPath reads the table name and record ID path values, writing a
+// 400 response when either is missing.
+func auditRecordPath(w http.ResponseWriter, r *http.Request) (string, string, bool) {
+	tableName, recordID := r.PathValue("tableName"), r.PathValue("recordId")
+	if tableName == "" || recordID == "" {
+		response.Error(w, http.StatusBadRequest, "Table name and record ID are required")
+		return "", "", false
+	}
+	return tableName, recordID, true
+}
+
+// GetAuditConfiguration handles GET /api/v1/audit-config
+// Returns the configured entities and their column rules.
+func (h *AuditTrailHandler) GetAuditConfiguration(w http.ResponseWriter, r *http.Request) {
+	result, err := h.svc.AuditConfig.GetAuditConfiguration(r.Context())
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "GetAuditConfiguration").Msg("Failed to get audit configuration")
+		response.Error(w, http.StatusInternalServerError, "Failed to retrieve audit configuration")
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// SaveAuditableEntity handles PUT /api/v1/audit-config/entities
+// Turns auditing of an entity on or off.
+func (h *AuditTrailHandler) SaveAuditableEntity(w http.ResponseWriter, r *http.Request) {
+	var req performance.AuditableEntityRequestModel
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	req.UpdatedBy = h.svc.UserContext.GetUserID(r.Context())
+
+	result, err := h.svc.AuditConfig.SaveAuditableEntity(r.Context(), &req)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "SaveAuditableEntity").Str("entity", req.EntityName).Msg("Failed to save audit configuration")
+		response.Error(w, http.StatusInternalServerError, "Failed to save audit configuration")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusBadRequest, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// SaveAuditableAttribute handles PUT /api/v1/audit-config/attributes
+// Includes or excludes one column of an entity.
+func (h *AuditTrailHandler) SaveAuditableAttribute(w http.ResponseWriter, r *http.Request) {
+	var req performance.AuditableAttributeRequestModel
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	req.UpdatedBy = h.svc.UserContext.GetUserID(r.Context())
+
+	result, err := h.svc.AuditConfig.SaveAuditableAttribute(r.Context(), &req)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "SaveAuditableAttribute").Str("entity", req.EntityName).Msg("Failed to save audit configuration")
+		response.Error(w, http.StatusInternalServerError, "Failed to save audit configuration")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusBadRequest, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// DeleteAuditableAttribute handles DELETE /api/v1/audit-config/entities/{entityName}/attributes/{attributeName}
+// Removes a column rule so the entity's flag applies to the column again.
+func (h *AuditTrailHandler) DeleteAuditableAttribute(w http.ResponseWriter, r *http.Request) {
+	entityName, attributeName := r.PathValue("entityName"), r.PathValue("attributeName")
+	if entityName == "" || attributeName == "" {
+		response.Error(w, http.StatusBadRequest, "Entity name and attribute name are required")
+		return
+	}
+	userID := h.svc.UserContext.GetUserID(r.Context())
+
+	result, err := h.svc.AuditConfig.DeleteAuditableAttribute(r.Context(), entityName, attributeName, userID)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "DeleteAuditableAttribute").Str("entity", entityName).Str("attribute", attributeName).Msg("Failed to delete audit configuration")
+		response.Error(w, http.StatusInternalServerError, "Failed to delete audit configuration")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusNotFound, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
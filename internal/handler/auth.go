@@ -1,8 +1,14 @@
 package handler
 
 import (
+	"context"
+	"crypto/subtle"
 	"encoding/json"
+	"errors"
+	"net"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/enterprise-pms/pms-api/internal/domain/auth"
 	"github.com/enterprise-pms/pms-api/internal/middleware"
@@ -25,6 +31,7 @@ func NewAuthHandler(authSvc service.AuthService, log zerolog.Logger) *AuthHandle
 
 // Login handles POST /api/v1/auth/login
 // Mirrors .NET AuthController.Login — dual-mode AD/local authentication.
+// Returns an MFA challenge instead of tokens when a second factor is required.
 func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 	var req auth.AuthenticateRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -37,7 +44,7 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	result, err := h.authSvc.AuthenticateAD(r.Context(), req.Username, req.Password)
+	result, err := h.authSvc.AuthenticateAD(withClientInfo(r), req.Username, req.Password)
 	if err != nil {
 		h.log.Warn().Err(err).Str("user", req.Username).Msg("Authentication failed")
 		response.Error(w, http.StatusUnauthorized, err.Error())
@@ -47,6 +54,112 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 	response.OK(w, result)
 }
 
+// VerifyMFA handles POST /api/v1/auth/mfa/verify
+// Completes a login that returned mfa_required by exchanging the challenge
+// token and an RSA SecurID token code for the JWT pair.
+func (h *AuthHandler) VerifyMFA(w http.ResponseWriter, r *http.Request) {
+	var req auth.MFAVerifyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if req.ChallengeToken == "" || req.TokenCode == "" {
+		response.Error(w, http.StatusBadRequest, "Challenge token and token code are required")
+		return
+	}
+
+	result, err := h.authSvc.VerifyMFA(withClientInfo(r), req.ChallengeToken, req.TokenCode)
+	if err != nil {
+		// The cause is logged only; the client gets the same answer whether
+		// the challenge or the token code was wrong.
+		h.log.Warn().Err(err).Msg("MFA verification failed")
+		response.Error(w, http.StatusUnauthorized, "MFA verification failed")
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// oidcStateCookie carries the state of a pending single sign-on login, so
+// only the browser that started a login can complete it.
+const oidcStateCookie = "pms_oidc_state"
+
+// oidcStatePath scopes the state cookie to the single sign-on endpoints.
+const oidcStatePath = "/api/v1/auth/oidc"
+
+// OIDCLogin handles GET /api/v1/auth/oidc/login
+// Starts a single sign-on login and returns the identity provider URL the
+// client sends the user to. The login state is set in an HttpOnly cookie.
+func (h *AuthHandler) OIDCLogin(w http.ResponseWriter, r *http.Request) {
+	result, err := h.authSvc.BeginOIDCLogin(r.Context())
+	if errors.Is(err, service.ErrSSODisabled) {
+		response.Error(w, http.StatusNotFound, err.Error())
+		return
+	}
+	if err != nil {
+		h.log.Error().Err(err).Msg("Failed to start SSO login")
+		response.Error(w, http.StatusServiceUnavailable, err.Error())
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcStateCookie,
+		Value:    result.State,
+		Path:     oidcStatePath,
+		Expires:  time.Unix(result.ExpiresAt, 0),
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+	})
+	response.OK(w, result)
+}
+
+// OIDCCallback handles POST /api/v1/auth/oidc/callback
+// Completes a single sign-on login with the code and state the identity
+// provider returned to the client. The state must match the cookie set by
+// OIDCLogin. Responds as Login does.
+func (h *AuthHandler) OIDCCallback(w http.ResponseWriter, r *http.Request) {
+	var req auth.OIDCCallbackRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if req.Code == "" || req.State == "" {
+		response.Error(w, http.StatusBadRequest, "Code and state are required")
+		return
+	}
+
+	matches := oidcStateMatches(r, req.State)
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcStateCookie,
+		Path:     oidcStatePath,
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+	})
+	if !matches {
+		h.log.Warn().Msg("SSO callback state does not match the browser's login")
+		response.Error(w, http.StatusUnauthorized, "invalid or expired single sign-on login")
+		return
+	}
+
+	result, err := h.authSvc.CompleteOIDCLogin(withClientInfo(r), req.Code, req.State)
+	if errors.Is(err, service.ErrSSODisabled) {
+		response.Error(w, http.StatusNotFound, err.Error())
+		return
+	}
+	if err != nil {
+		h.log.Warn().Err(err).Msg("SSO login failed")
+		response.Error(w, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	response.OK(w, result)
+}
+
 // RefreshToken handles POST /api/v1/auth/refresh
 // Exchanges a refresh token for a new access token.
 func (h *AuthHandler) RefreshToken(w http.ResponseWriter, r *http.Request) {
@@ -61,7 +174,7 @@ func (h *AuthHandler) RefreshToken(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	tokenResp, err := h.authSvc.RefreshAccessToken(r.Context(), req.RefreshToken)
+	tokenResp, err := h.authSvc.RefreshAccessToken(withClientInfo(r), req.RefreshToken)
 	if err != nil {
 		response.Error(w, http.StatusUnauthorized, "Invalid or expired refresh token")
 		return
@@ -89,3 +202,102 @@ func (h *AuthHandler) ValidateToken(w http.ResponseWriter, r *http.Request) {
 	}
 	response.OK(w, claims)
 }
+
+// Logout handles POST /api/v1/auth/logout
+// Revokes the caller's current session and its refresh token.
+func (h *AuthHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	userID, _ := r.Context().Value(middleware.UserIDKey).(string)
+	sessionID, _ := r.Context().Value(middleware.SessionIDKey).(string)
+
+	err := h.authSvc.Logout(r.Context(), userID, sessionID)
+	if err != nil && !errors.Is(err, service.ErrSessionNotFound) {
+		h.log.Error().Err(err).Str("user", userID).Msg("Logout failed")
+		response.Error(w, http.StatusInternalServerError, "Failed to log out")
+		return
+	}
+
+	response.OK(w, map[string]string{"message": "Logged out"})
+}
+
+// ListSessions handles GET /api/v1/auth/sessions
+// Returns the caller's active sessions with device, IP and last use.
+func (h *AuthHandler) ListSessions(w http.ResponseWriter, r *http.Request) {
+	userID, _ := r.Context().Value(middleware.UserIDKey).(string)
+	sessionID, _ := r.Context().Value(middleware.SessionIDKey).(string)
+
+	sessions, err := h.authSvc.ListSessions(r.Context(), userID, sessionID)
+	if err != nil {
+		h.log.Error().Err(err).Str("user", userID).Msg("Failed to list sessions")
+		response.Error(w, http.StatusInternalServerError, "Failed to retrieve sessions")
+		return
+	}
+
+	response.OK(w, sessions)
+}
+
+// RevokeSession handles DELETE /api/v1/auth/sessions/{sessionId}
+// Signs one of the caller's devices out.
+func (h *AuthHandler) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	userID, _ := r.Context().Value(middleware.UserIDKey).(string)
+	sessionID := r.PathValue("sessionId")
+	if sessionID == "" {
+		response.Error(w, http.StatusBadRequest, "Session ID is required")
+		return
+	}
+
+	err := h.authSvc.RevokeSession(r.Context(), userID, sessionID)
+	if errors.Is(err, service.ErrSessionNotFound) {
+		response.Error(w, http.StatusNotFound, "Session not found")
+		return
+	}
+	if err != nil {
+		h.log.Error().Err(err).Str("user", userID).Str("sessionId", sessionID).Msg("Failed to revoke session")
+		response.Error(w, http.StatusInternalServerError, "Failed to revoke session")
+		return
+	}
+
+	response.OK(w, map[string]string{"message": "Session revoked"})
+}
+
+// RevokeAllUserSessions handles DELETE /api/v1/auth/users/{userId}/sessions
+// Admin action that signs a user out on every device.
+func (h *AuthHandler) RevokeAllUserSessions(w http.ResponseWriter, r *http.Request) {
+	adminID, _ := r.Context().Value(middleware.UserIDKey).(string)
+	userID := r.PathValue("userId")
+	if userID == "" {
+		response.Error(w, http.StatusBadRequest, "User ID is required")
+		return
+	}
+
+	revoked, err := h.authSvc.RevokeAllSessions(r.Context(), userID, adminID)
+	if err != nil {
+		h.log.Error().Err(err).Str("user", userID).Msg("Failed to revoke sessions")
+		response.Error(w, http.StatusInternalServerError, "Failed to revoke sessions")
+		return
+	}
+
+	response.OK(w, map[string]int{"revoked_sessions": revoked})
+}
+
+// withClientInfo attaches the caller's user agent and address to the request
+// context so they are recorded on the session.
+// oidcStateMatches reports whether state is the one in the request's login
+// state cookie.
+func oidcStateMatches(r *http.Request, state string) bool {
+	cookie, err := r.Cookie(oidcStateCookie)
+	if err != nil || cookie.Value == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(state)) == 1
+}
+
+func withClientInfo(r *http.Request) context.Context {
+	ip := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		ip = host
+	}
+	if fwd := r.Header.Get("X-Forwarded-For"); fwd != "" {
+		ip = strings.TrimSpace(strings.Split(fwd, ",")[0])
+	}
+	return service.WithClientInfo(r.Context(), r.UserAgent(), ip)
+}
@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestOIDCStateMatches(t *testing.T) {
+	tests := []struct {
+		name   string
+		cookie string
+		state  string
+		want   bool
+	}{
+		{"same browser", "abc", "abc", true},
+		{"other login", "abc", "xyz", false},
+		{"no cookie", "", "abc", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/api/v1/auth/oidc/callback", nil)
+			if tt.cookie != "" {
+				r.AddCookie(&http.Cookie{Name: oidcStateCookie, Value: tt.cookie})
+			}
+			if got := oidcStateMatches(r, tt.state); got != tt.want {
+				t.Errorf("oidcStateMatches = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
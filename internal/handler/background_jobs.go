@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"github.com/enterprise-pms/pms-api/internal/service"
+	"github.com/enterprise-pms/pms-api/pkg/response"
+	"github.com/rs/zerolog"
+)
+
+// BackgroundJobHandler handles the background job queue admin endpoints that
+// replace the Hangfire dashboard.
+type BackgroundJobHandler struct {
+	svc *service.Container
+	log zerolog.Logger
+}
+
+// NewBackgroundJobHandler creates a new background job handler.
+func NewBackgroundJobHandler(svc *service.Container, log zerolog.Logger) *BackgroundJobHandler {
+	return &BackgroundJobHandler{svc: svc, log: log}
+}
+
+// ListJobs handles GET /api/v1/background-jobs?status={s}&jobType={t}&skip={n}&pageSize={n}
+// Returns a page of jobs and the number of jobs in each status.
+func (h *BackgroundJobHandler) ListJobs(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	search := performance.BackgroundJobSearchModel{JobType: q.Get("jobType")}
+	search.JobStatus, _ = strconv.Atoi(q.Get("status"))
+	search.Skip, _ = strconv.Atoi(q.Get("skip"))
+	search.PageSize, _ = strconv.Atoi(q.Get("pageSize"))
+
+	result, err := h.svc.BackgroundJob.ListJobs(r.Context(), &search)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "ListJobs").Msg("Failed to list background jobs")
+		response.Error(w, http.StatusInternalServerError, "Failed to retrieve background jobs")
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// GetJob handles GET /api/v1/background-jobs/{jobId}
+func (h *BackgroundJobHandler) GetJob(w http.ResponseWriter, r *http.Request) {
+	jobID := r.PathValue("jobId")
+	if jobID == "" {
+		response.Error(w, http.StatusBadRequest, "Job ID is required")
+		return
+	}
+
+	result, err := h.svc.BackgroundJob.GetJob(r.Context(), jobID)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "GetJob").Str("jobId", jobID).Msg("Failed to get background job")
+		response.Error(w, http.StatusInternalServerError, "Failed to retrieve background job")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusNotFound, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// RetryJob handles POST /api/v1/background-jobs/{jobId}/retry
+// Requeues a dead-lettered, cancelled or retry-pending job to run now.
+func (h *BackgroundJobHandler) RetryJob(w http.ResponseWriter, r *http.Request) {
+	jobID := r.PathValue("jobId")
+	if jobID == "" {
+		response.Error(w, http.StatusBadRequest, "Job ID is required")
+		return
+	}
+	userID := h.svc.UserContext.GetUserID(r.Context())
+
+	result, err := h.svc.BackgroundJob.RetryJob(r.Context(), jobID, userID)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "RetryJob").Str("jobId", jobID).Msg("Failed to retry background job")
+		response.Error(w, http.StatusInternalServerError, "Failed to retry background job")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusBadRequest, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// CancelJob handles POST /api/v1/background-jobs/{jobId}/cancel
+// Cancels a job that has not started running.
+func (h *BackgroundJobHandler) CancelJob(w http.ResponseWriter, r *http.Request) {
+	jobID := r.PathValue("jobId")
+	if jobID == "" {
+		response.Error(w, http.StatusBadRequest, "Job ID is required")
+		return
+	}
+	userID := h.svc.UserContext.GetUserID(r.Context())
+
+	result, err := h.svc.BackgroundJob.CancelJob(r.Context(), jobID, userID)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "CancelJob").Str("jobId", jobID).Msg("Failed to cancel background job")
+		response.Error(w, http.StatusInternalServerError, "Failed to cancel background job")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusBadRequest, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
@@ -0,0 +1,199 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"github.com/enterprise-pms/pms-api/internal/service"
+	"github.com/enterprise-pms/pms-api/pkg/response"
+	"github.com/rs/zerolog"
+)
+
+// CalibrationHandler handles grade calibration HTTP endpoints used by the
+// calibration committee and the quota setup screens.
+type CalibrationHandler struct {
+	svc *service.Container
+	log zerolog.Logger
+}
+
+// NewCalibrationHandler creates a new calibration handler.
+func NewCalibrationHandler(svc *service.Container, log zerolog.Logger) *CalibrationHandler {
+	return &CalibrationHandler{svc: svc, log: log}
+}
+
+// ============================================================
+// Quota Endpoints
+// ============================================================
+
+// GetCalibrationQuotas handles GET /api/v1/setup/calibration-quotas?reviewPeriodId=
+// Returns the global quotas plus any quotas specific to the review period.
+func (h *CalibrationHandler) GetCalibrationQuotas(w http.ResponseWriter, r *http.Request) {
+	reviewPeriodID := r.URL.Query().Get("reviewPeriodId")
+
+	result, err := h.svc.Calibration.GetCalibrationQuotas(r.Context(), reviewPeriodID)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "GetCalibrationQuotas").Msg("Failed to list calibration quotas")
+		response.Error(w, http.StatusInternalServerError, "Failed to retrieve calibration quotas")
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// SaveCalibrationQuotas handles PUT /api/v1/setup/calibration-quotas
+// Replaces the quota set for an organogram level.
+func (h *CalibrationHandler) SaveCalibrationQuotas(w http.ResponseWriter, r *http.Request) {
+	var req performance.CalibrationQuotaRequestModel
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	req.UpdatedBy = h.svc.UserContext.GetUserID(r.Context())
+
+	result, err := h.svc.Calibration.SaveCalibrationQuotas(r.Context(), &req)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "SaveCalibrationQuotas").Msg("Failed to save calibration quotas")
+		response.Error(w, http.StatusInternalServerError, "Failed to save calibration quotas")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusBadRequest, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// ============================================================
+// Session Endpoints
+// ============================================================
+
+// GetCalibrationSessions handles GET /api/v1/calibrations?reviewPeriodId=
+func (h *CalibrationHandler) GetCalibrationSessions(w http.ResponseWriter, r *http.Request) {
+	reviewPeriodID := r.URL.Query().Get("reviewPeriodId")
+	if reviewPeriodID == "" {
+		response.Error(w, http.StatusBadRequest, "Review period ID is required")
+		return
+	}
+
+	result, err := h.svc.Calibration.GetCalibrationSessions(r.Context(), reviewPeriodID)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "GetCalibrationSessions").Msg("Failed to list calibration sessions")
+		response.Error(w, http.StatusInternalServerError, "Failed to retrieve calibration sessions")
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// StartCalibration handles POST /api/v1/calibrations
+// Opens a session for one unit of a closed review period and proposes
+// grade changes against the configured quotas.
+func (h *CalibrationHandler) StartCalibration(w http.ResponseWriter, r *http.Request) {
+	var req performance.StartCalibrationRequestModel
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if req.ReviewPeriodID == "" || req.OrganogramLevel == 0 {
+		response.Error(w, http.StatusBadRequest, "Review period and organogram level are required")
+		return
+	}
+	req.RequestedBy = h.svc.UserContext.GetUserID(r.Context())
+
+	result, err := h.svc.Calibration.StartCalibration(r.Context(), &req)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "StartCalibration").Msg("Failed to start calibration")
+		response.Error(w, http.StatusInternalServerError, "Failed to start calibration")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusBadRequest, result.Message)
+		return
+	}
+
+	response.Created(w, result)
+}
+
+// GetCalibrationSession handles GET /api/v1/calibrations/{sessionId}
+// Returns the session entries and the grade distribution against quota.
+func (h *CalibrationHandler) GetCalibrationSession(w http.ResponseWriter, r *http.Request) {
+	sessionID := r.PathValue("sessionId")
+	if sessionID == "" {
+		response.Error(w, http.StatusBadRequest, "Calibration session ID is required")
+		return
+	}
+
+	result, err := h.svc.Calibration.GetCalibrationSession(r.Context(), sessionID)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "GetCalibrationSession").Str("sessionId", sessionID).Msg("Failed to get calibration session")
+		response.Error(w, http.StatusInternalServerError, "Failed to retrieve calibration session")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusNotFound, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// DecideCalibrationEntry handles PUT /api/v1/calibrations/{sessionId}/decisions
+// Accepts, overrides or rejects the grade of one entry.
+func (h *CalibrationHandler) DecideCalibrationEntry(w http.ResponseWriter, r *http.Request) {
+	sessionID := r.PathValue("sessionId")
+	if sessionID == "" {
+		response.Error(w, http.StatusBadRequest, "Calibration session ID is required")
+		return
+	}
+
+	var req performance.CalibrationDecisionRequestModel
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if req.CalibrationEntryID == "" {
+		response.Error(w, http.StatusBadRequest, "Calibration entry ID is required")
+		return
+	}
+	req.CalibrationSessionID = sessionID
+	req.DecidedBy = h.svc.UserContext.GetUserID(r.Context())
+
+	result, err := h.svc.Calibration.DecideCalibrationEntry(r.Context(), &req)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "DecideCalibrationEntry").Str("sessionId", sessionID).Msg("Failed to record calibration decision")
+		response.Error(w, http.StatusInternalServerError, "Failed to record calibration decision")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusBadRequest, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// FinalizeCalibration handles POST /api/v1/calibrations/{sessionId}/finalize
+// Writes calibrated grades to the period scores and closes the session.
+func (h *CalibrationHandler) FinalizeCalibration(w http.ResponseWriter, r *http.Request) {
+	sessionID := r.PathValue("sessionId")
+	if sessionID == "" {
+		response.Error(w, http.StatusBadRequest, "Calibration session ID is required")
+		return
+	}
+	userID := h.svc.UserContext.GetUserID(r.Context())
+
+	result, err := h.svc.Calibration.FinalizeCalibration(r.Context(), sessionID, userID)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "FinalizeCalibration").Str("sessionId", sessionID).Msg("Failed to finalise calibration")
+		response.Error(w, http.StatusInternalServerError, "Failed to finalise calibration")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusBadRequest, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
@@ -37,7 +37,7 @@ func (h *CompetencyMgtHandler) GetCompetencies(w http.ResponseWriter, r *http.Re
 	result, err := h.svc.Competency.GetCompetencies(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to get competencies")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -57,7 +57,7 @@ func (h *CompetencyMgtHandler) SaveCompetency(w http.ResponseWriter, r *http.Req
 	result, err := h.svc.Competency.SaveCompetency(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to save competency")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -77,7 +77,7 @@ func (h *CompetencyMgtHandler) ApproveCompetency(w http.ResponseWriter, r *http.
 	result, err := h.svc.Competency.ApproveCompetency(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to approve competency")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -97,7 +97,7 @@ func (h *CompetencyMgtHandler) RejectCompetency(w http.ResponseWriter, r *http.R
 	result, err := h.svc.Competency.RejectCompetency(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to reject competency")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -112,7 +112,7 @@ func (h *CompetencyMgtHandler) GetCompetencyCategories(w http.ResponseWriter, r
 	result, err := h.svc.Competency.GetCompetencyCategories(r.Context())
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to get competency categories")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -132,7 +132,7 @@ func (h *CompetencyMgtHandler) SaveCompetencyCategory(w http.ResponseWriter, r *
 	result, err := h.svc.Competency.SaveCompetencyCategory(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to save competency category")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -147,7 +147,7 @@ func (h *CompetencyMgtHandler) GetCompetencyCategoryGradings(w http.ResponseWrit
 	result, err := h.svc.Competency.GetCompetencyCategoryGradings(r.Context())
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to get competency category gradings")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -167,7 +167,7 @@ func (h *CompetencyMgtHandler) SaveCompetencyCategoryGrading(w http.ResponseWrit
 	result, err := h.svc.Competency.SaveCompetencyCategoryGrading(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to save competency category grading")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -192,7 +192,7 @@ func (h *CompetencyMgtHandler) GetCompetencyRatingDefinitions(w http.ResponseWri
 	result, err := h.svc.Competency.GetCompetencyRatingDefinitions(r.Context(), competencyId)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to get competency rating definitions")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -212,7 +212,7 @@ func (h *CompetencyMgtHandler) SaveCompetencyRatingDefinition(w http.ResponseWri
 	result, err := h.svc.Competency.SaveCompetencyRatingDefinition(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to save competency rating definition")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -227,7 +227,7 @@ func (h *CompetencyMgtHandler) GetCompetencyReviews(w http.ResponseWriter, r *ht
 	result, err := h.svc.Competency.GetCompetencyReviews(r.Context())
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to get competency reviews")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -259,7 +259,7 @@ func (h *CompetencyMgtHandler) GetCompetencyReviewByReviewer(w http.ResponseWrit
 	result, err := h.svc.Competency.GetCompetencyReviewByReviewer(r.Context(), reviewerId, reviewPeriodId)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to get competency review by reviewer")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -291,7 +291,7 @@ func (h *CompetencyMgtHandler) GetCompetencyReviewForEmployee(w http.ResponseWri
 	result, err := h.svc.Competency.GetCompetencyReviewForEmployee(r.Context(), employeeNumber, reviewPeriodId)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to get competency review for employee")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -311,7 +311,7 @@ func (h *CompetencyMgtHandler) GetCompetencyReviewDetail(w http.ResponseWriter,
 	result, err := h.svc.Competency.GetCompetencyReviewDetail(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to get competency review detail")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -331,7 +331,7 @@ func (h *CompetencyMgtHandler) SaveCompetencyReview(w http.ResponseWriter, r *ht
 	result, err := h.svc.Competency.SaveCompetencyReview(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to save competency review")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -363,7 +363,7 @@ func (h *CompetencyMgtHandler) GetCompetencyReviewProfiles(w http.ResponseWriter
 	result, err := h.svc.Competency.GetCompetencyReviewProfiles(r.Context(), employeeNumber, reviewPeriodId)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to get competency review profiles")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -400,7 +400,7 @@ func (h *CompetencyMgtHandler) GetOfficeCompetencyReviews(w http.ResponseWriter,
 	result, err := h.svc.Competency.GetOfficeCompetencyReviews(r.Context(), officeId, reviewPeriodId)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to get office competency reviews")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -458,7 +458,7 @@ func (h *CompetencyMgtHandler) GetGroupCompetencyReviewProfiles(w http.ResponseW
 	result, err := h.svc.Competency.GetGroupCompetencyReviewProfiles(r.Context(), reviewPeriodId, officeId, divisionId, departmentId)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to get group competency review profiles")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -516,7 +516,7 @@ func (h *CompetencyMgtHandler) GetCompetencyMatrixReviewProfiles(w http.Response
 	result, err := h.svc.Competency.GetCompetencyMatrixReviewProfiles(r.Context(), reviewPeriodId, officeId, divisionId, departmentId)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to get competency matrix review profiles")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -555,7 +555,7 @@ func (h *CompetencyMgtHandler) GetTechnicalCompetencyMatrixReviewProfiles(w http
 	result, err := h.svc.Competency.GetTechnicalCompetencyMatrixReviewProfiles(r.Context(), reviewPeriodId, jobRoleId)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to get technical competency matrix review profiles")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -575,7 +575,7 @@ func (h *CompetencyMgtHandler) SaveCompetencyReviewProfile(w http.ResponseWriter
 	result, err := h.svc.Competency.SaveCompetencyReviewProfile(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to save competency review profile")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -600,7 +600,7 @@ func (h *CompetencyMgtHandler) GetDevelopmentPlans(w http.ResponseWriter, r *htt
 	result, err := h.svc.Competency.GetDevelopmentPlans(r.Context(), competencyProfileReviewId)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to get development plans")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -620,7 +620,7 @@ func (h *CompetencyMgtHandler) SaveDevelopmentPlan(w http.ResponseWriter, r *htt
 	result, err := h.svc.Competency.SaveDevelopmentPlan(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to save development plan")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -635,7 +635,7 @@ func (h *CompetencyMgtHandler) GetJobRoles(w http.ResponseWriter, r *http.Reques
 	result, err := h.svc.Competency.GetJobRoles(r.Context())
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to get job roles")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -655,7 +655,7 @@ func (h *CompetencyMgtHandler) SaveJobRole(w http.ResponseWriter, r *http.Reques
 	result, err := h.svc.Competency.SaveJobRole(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to save job role")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -675,7 +675,7 @@ func (h *CompetencyMgtHandler) GetOfficeJobRoles(w http.ResponseWriter, r *http.
 	result, err := h.svc.Competency.GetOfficeJobRoles(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to get office job roles")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -695,7 +695,7 @@ func (h *CompetencyMgtHandler) SaveOfficeJobRole(w http.ResponseWriter, r *http.
 	result, err := h.svc.Competency.SaveOfficeJobRole(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to save office job role")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -715,7 +715,7 @@ func (h *CompetencyMgtHandler) GetJobRoleCompetencies(w http.ResponseWriter, r *
 	result, err := h.svc.Competency.GetJobRoleCompetencies(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to get job role competencies")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -735,7 +735,7 @@ func (h *CompetencyMgtHandler) SaveJobRoleCompetency(w http.ResponseWriter, r *h
 	result, err := h.svc.Competency.SaveJobRoleCompetency(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to save job role competency")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -750,7 +750,7 @@ func (h *CompetencyMgtHandler) GetBehavioralCompetencies(w http.ResponseWriter,
 	result, err := h.svc.Competency.GetBehavioralCompetencies(r.Context())
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to get behavioral competencies")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -770,7 +770,7 @@ func (h *CompetencyMgtHandler) SaveBehavioralCompetency(w http.ResponseWriter, r
 	result, err := h.svc.Competency.SaveBehavioralCompetency(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to save behavioral competency")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -785,7 +785,7 @@ func (h *CompetencyMgtHandler) GetJobRoleGrades(w http.ResponseWriter, r *http.R
 	result, err := h.svc.Competency.GetJobRoleGrades(r.Context())
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to get job role grades")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -805,7 +805,7 @@ func (h *CompetencyMgtHandler) SaveJobRoleGrade(w http.ResponseWriter, r *http.R
 	result, err := h.svc.Competency.SaveJobRoleGrade(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to save job role grade")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -820,7 +820,7 @@ func (h *CompetencyMgtHandler) GetJobGrades(w http.ResponseWriter, r *http.Reque
 	result, err := h.svc.Competency.GetJobGrades(r.Context())
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to get job grades")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -840,7 +840,7 @@ func (h *CompetencyMgtHandler) SaveJobGrade(w http.ResponseWriter, r *http.Reque
 	result, err := h.svc.Competency.SaveJobGrade(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to save job grade")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -855,7 +855,7 @@ func (h *CompetencyMgtHandler) GetJobGradeGroups(w http.ResponseWriter, r *http.
 	result, err := h.svc.Competency.GetJobGradeGroups(r.Context())
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to get job grade groups")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -875,7 +875,7 @@ func (h *CompetencyMgtHandler) SaveJobGradeGroup(w http.ResponseWriter, r *http.
 	result, err := h.svc.Competency.SaveJobGradeGroup(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to save job grade group")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -890,7 +890,7 @@ func (h *CompetencyMgtHandler) GetAssignJobGradeGroups(w http.ResponseWriter, r
 	result, err := h.svc.Competency.GetAssignJobGradeGroups(r.Context())
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to get assign job grade groups")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -910,7 +910,7 @@ func (h *CompetencyMgtHandler) SaveAssignJobGradeGroup(w http.ResponseWriter, r
 	result, err := h.svc.Competency.SaveAssignJobGradeGroup(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to save assign job grade group")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -925,7 +925,7 @@ func (h *CompetencyMgtHandler) GetRatings(w http.ResponseWriter, r *http.Request
 	result, err := h.svc.Competency.GetRatings(r.Context())
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to get ratings")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -945,7 +945,7 @@ func (h *CompetencyMgtHandler) SaveRating(w http.ResponseWriter, r *http.Request
 	result, err := h.svc.Competency.SaveRating(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to save rating")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -960,7 +960,7 @@ func (h *CompetencyMgtHandler) GetReviewPeriods(w http.ResponseWriter, r *http.R
 	result, err := h.svc.Competency.GetReviewPeriods(r.Context())
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to get review periods")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -980,7 +980,7 @@ func (h *CompetencyMgtHandler) SaveReviewPeriod(w http.ResponseWriter, r *http.R
 	result, err := h.svc.Competency.SaveReviewPeriod(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to save review period")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1000,7 +1000,7 @@ func (h *CompetencyMgtHandler) ApproveReviewPeriod(w http.ResponseWriter, r *htt
 	result, err := h.svc.Competency.ApproveReviewPeriod(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to approve review period")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1015,7 +1015,7 @@ func (h *CompetencyMgtHandler) GetReviewTypes(w http.ResponseWriter, r *http.Req
 	result, err := h.svc.Competency.GetReviewTypes(r.Context())
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to get review types")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1035,7 +1035,7 @@ func (h *CompetencyMgtHandler) SaveReviewType(w http.ResponseWriter, r *http.Req
 	result, err := h.svc.Competency.SaveReviewType(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to save review type")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1050,7 +1050,7 @@ func (h *CompetencyMgtHandler) GetBankYears(w http.ResponseWriter, r *http.Reque
 	result, err := h.svc.Competency.GetBankYears(r.Context())
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to get bank years")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1070,7 +1070,7 @@ func (h *CompetencyMgtHandler) SaveBankYear(w http.ResponseWriter, r *http.Reque
 	result, err := h.svc.Competency.SaveBankYear(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to save bank year")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1095,7 +1095,7 @@ func (h *CompetencyMgtHandler) GetTrainingTypes(w http.ResponseWriter, r *http.R
 	result, err := h.svc.Competency.GetTrainingTypes(r.Context(), isActive)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to get training types")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1115,7 +1115,7 @@ func (h *CompetencyMgtHandler) SaveTrainingType(w http.ResponseWriter, r *http.R
 	result, err := h.svc.Competency.SaveTrainingType(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to save training type")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1131,7 +1131,7 @@ func (h *CompetencyMgtHandler) PopulateAllReviews(w http.ResponseWriter, r *http
 	result, err := h.svc.Competency.PopulateAllReviews(r.Context())
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to populate all reviews")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1157,7 +1157,7 @@ func (h *CompetencyMgtHandler) PopulateOfficeReviews(w http.ResponseWriter, r *h
 	result, err := h.svc.Competency.PopulateOfficeReviews(r.Context(), officeId)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to populate office reviews")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1183,7 +1183,7 @@ func (h *CompetencyMgtHandler) PopulateDivisionReviews(w http.ResponseWriter, r
 	result, err := h.svc.Competency.PopulateDivisionReviews(r.Context(), divisionId)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to populate division reviews")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1209,7 +1209,7 @@ func (h *CompetencyMgtHandler) PopulateDepartmentReviews(w http.ResponseWriter,
 	result, err := h.svc.Competency.PopulateDepartmentReviews(r.Context(), departmentId)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to populate department reviews")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1230,7 +1230,7 @@ func (h *CompetencyMgtHandler) PopulateReviewsByEmployeeId(w http.ResponseWriter
 	result, err := h.svc.Competency.PopulateReviewsByEmployeeId(r.Context(), employeeNumber)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to populate reviews by employee id")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1251,7 +1251,7 @@ func (h *CompetencyMgtHandler) CalculateReviews(w http.ResponseWriter, r *http.R
 	result, err := h.svc.Competency.CalculateReviews(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to calculate reviews")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1271,7 +1271,7 @@ func (h *CompetencyMgtHandler) RecalculateReviewsProfiles(w http.ResponseWriter,
 	result, err := h.svc.Competency.RecalculateReviewsProfiles(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to recalculate reviews profiles")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1291,7 +1291,7 @@ func (h *CompetencyMgtHandler) EmailService(w http.ResponseWriter, r *http.Reque
 	result, err := h.svc.Competency.EmailService(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to send email")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1311,7 +1311,7 @@ func (h *CompetencyMgtHandler) SyncJobRoleUpdateSOA(w http.ResponseWriter, r *ht
 	result, err := h.svc.Competency.SyncJobRoleUpdateSOA(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Msg("Failed to sync job role update SOA")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
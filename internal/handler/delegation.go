@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"github.com/enterprise-pms/pms-api/internal/service"
+	"github.com/enterprise-pms/pms-api/pkg/response"
+	"github.com/rs/zerolog"
+)
+
+// DelegationHandler handles approval delegation HTTP endpoints. Every
+// endpoint acts on the delegations of the authenticated staff member.
+type DelegationHandler struct {
+	svc *service.Container
+	log zerolog.Logger
+}
+
+// NewDelegationHandler creates a new delegation handler.
+func NewDelegationHandler(svc *service.Container, log zerolog.Logger) *DelegationHandler {
+	return &DelegationHandler{svc: svc, log: log}
+}
+
+// GetMyDelegations handles GET /api/v1/delegations
+// Returns the delegations the caller has granted and the ones they hold.
+func (h *DelegationHandler) GetMyDelegations(w http.ResponseWriter, r *http.Request) {
+	staffID := h.svc.UserContext.GetUserID(r.Context())
+
+	result, err := h.svc.Delegation.GetDelegations(r.Context(), staffID)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "GetMyDelegations").Msg("Failed to list delegations")
+		response.Error(w, http.StatusInternalServerError, "Failed to retrieve delegations")
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// CreateDelegation handles POST /api/v1/delegations
+// Names a delegate who may approve, reject or return on the caller's behalf.
+func (h *DelegationHandler) CreateDelegation(w http.ResponseWriter, r *http.Request) {
+	var req performance.ApprovalDelegationRequestModel
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	req.DelegatorStaffID = h.svc.UserContext.GetUserID(r.Context())
+
+	result, err := h.svc.Delegation.CreateDelegation(r.Context(), &req)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "CreateDelegation").Msg("Failed to create delegation")
+		response.Error(w, http.StatusInternalServerError, "Failed to create delegation")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusBadRequest, result.Message)
+		return
+	}
+
+	response.Created(w, result)
+}
+
+// RevokeDelegation handles DELETE /api/v1/delegations/{delegationId}
+func (h *DelegationHandler) RevokeDelegation(w http.ResponseWriter, r *http.Request) {
+	delegationID := r.PathValue("delegationId")
+	if delegationID == "" {
+		response.Error(w, http.StatusBadRequest, "Delegation ID is required")
+		return
+	}
+	staffID := h.svc.UserContext.GetUserID(r.Context())
+
+	result, err := h.svc.Delegation.RevokeDelegation(r.Context(), delegationID, staffID)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "RevokeDelegation").Str("delegationId", delegationID).Msg("Failed to revoke delegation")
+		response.Error(w, http.StatusInternalServerError, "Failed to revoke delegation")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusNotFound, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
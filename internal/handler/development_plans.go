@@ -0,0 +1,332 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/competency"
+	"github.com/enterprise-pms/pms-api/internal/service"
+	"github.com/enterprise-pms/pms-api/pkg/response"
+	"github.com/rs/zerolog"
+)
+
+// DevelopmentPlanHandler handles the development plan lifecycle endpoints:
+// drafting from competency gaps, progress updates, evidence, supervisor
+// sign-off and completion reporting.
+// Creating and editing plans stays with CompetencyMgtHandler.
+type DevelopmentPlanHandler struct {
+	svc *service.Container
+	log zerolog.Logger
+}
+
+// NewDevelopmentPlanHandler creates a new development plan handler.
+func NewDevelopmentPlanHandler(svc *service.Container, log zerolog.Logger) *DevelopmentPlanHandler {
+	return &DevelopmentPlanHandler{svc: svc, log: log}
+}
+
+// GetDevelopmentPlan handles GET /api/v1/competency/development-plans/{planId}
+// Returns the plan with its evidence and sign-off.
+func (h *DevelopmentPlanHandler) GetDevelopmentPlan(w http.ResponseWriter, r *http.Request) {
+	planID, ok := pathPlanID(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.svc.DevelopmentPlan.GetDevelopmentPlan(r.Context(), planID)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "GetDevelopmentPlan").Int("planId", planID).Msg("Failed to get development plan")
+		serviceError(w, err, http.StatusInternalServerError, "Failed to retrieve development plan")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusNotFound, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// UpdateDevelopmentPlanStatus handles POST /api/v1/competency/development-plans/{planId}/status
+// The employee starts or completes the plan; their supervisor verifies it,
+// returns it for more work, or closes the competency gap.
+func (h *DevelopmentPlanHandler) UpdateDevelopmentPlanStatus(w http.ResponseWriter, r *http.Request) {
+	planID, ok := pathPlanID(w, r)
+	if !ok {
+		return
+	}
+
+	var req competency.DevelopmentPlanStatusRequestModel
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if req.Status == "" {
+		response.Error(w, http.StatusBadRequest, "Status is required")
+		return
+	}
+	req.DevelopmentPlanID = planID
+	req.UpdatedBy = h.svc.UserContext.GetUserID(r.Context())
+
+	result, err := h.svc.DevelopmentPlan.UpdateDevelopmentPlanStatus(r.Context(), &req)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "UpdateDevelopmentPlanStatus").Int("planId", planID).Msg("Failed to update development plan status")
+		serviceError(w, err, http.StatusInternalServerError, "Failed to update development plan status")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusBadRequest, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// AddDevelopmentPlanEvidence handles POST /api/v1/competency/development-plans/{planId}/evidence
+// Attaches a file uploaded through POST /api/v1/files.
+func (h *DevelopmentPlanHandler) AddDevelopmentPlanEvidence(w http.ResponseWriter, r *http.Request) {
+	planID, ok := pathPlanID(w, r)
+	if !ok {
+		return
+	}
+
+	var req competency.DevelopmentPlanEvidenceRequestModel
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if req.FileUploadID == "" {
+		response.Error(w, http.StatusBadRequest, "File upload ID is required")
+		return
+	}
+	req.DevelopmentPlanID = planID
+	req.UploadedBy = h.svc.UserContext.GetUserID(r.Context())
+
+	result, err := h.svc.DevelopmentPlan.AddDevelopmentPlanEvidence(r.Context(), &req)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "AddDevelopmentPlanEvidence").Int("planId", planID).Msg("Failed to attach development plan evidence")
+		serviceError(w, err, http.StatusInternalServerError, "Failed to attach evidence")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusBadRequest, result.Message)
+		return
+	}
+
+	response.Created(w, result)
+}
+
+// RemoveDevelopmentPlanEvidence handles DELETE /api/v1/competency/development-plans/{planId}/evidence/{evidenceId}
+func (h *DevelopmentPlanHandler) RemoveDevelopmentPlanEvidence(w http.ResponseWriter, r *http.Request) {
+	planID, ok := pathPlanID(w, r)
+	if !ok {
+		return
+	}
+	evidenceID, err := strconv.Atoi(r.PathValue("evidenceId"))
+	if err != nil || evidenceID <= 0 {
+		response.Error(w, http.StatusBadRequest, "Invalid evidence ID")
+		return
+	}
+
+	result, err := h.svc.DevelopmentPlan.RemoveDevelopmentPlanEvidence(r.Context(), planID, evidenceID)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "RemoveDevelopmentPlanEvidence").Int("planId", planID).Int("evidenceId", evidenceID).Msg("Failed to remove development plan evidence")
+		serviceError(w, err, http.StatusInternalServerError, "Failed to remove evidence")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusBadRequest, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// GetDevelopmentPlanCompletion handles GET /api/v1/competency/development-plans/completion?groupBy=&reviewPeriodId=&officeId=&divisionId=&departmentId=
+// Counts plans by status for each office, division or department.
+func (h *DevelopmentPlanHandler) GetDevelopmentPlanCompletion(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	search := competency.DevelopmentPlanCompletionSearchModel{GroupBy: q.Get("groupBy")}
+	for _, p := range []struct {
+		name string
+		dst  **int
+	}{
+		{"reviewPeriodId", &search.ReviewPeriodID},
+		{"officeId", &search.OfficeID},
+		{"divisionId", &search.DivisionID},
+		{"departmentId", &search.DepartmentID},
+	} {
+		v := q.Get(p.name)
+		if v == "" {
+			continue
+		}
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			response.Error(w, http.StatusBadRequest, "Invalid "+p.name)
+			return
+		}
+		*p.dst = &n
+	}
+
+	result, err := h.svc.DevelopmentPlan.GetDevelopmentPlanCompletion(r.Context(), &search)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "GetDevelopmentPlanCompletion").Msg("Failed to report development plan completion")
+		serviceError(w, err, http.StatusInternalServerError, "Failed to retrieve development plan completion")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusBadRequest, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// ============================================================
+// Drafting from competency gaps
+// ============================================================
+
+// GetDevelopmentPlanRules handles GET /api/v1/competency/development-plan-rules
+func (h *DevelopmentPlanHandler) GetDevelopmentPlanRules(w http.ResponseWriter, r *http.Request) {
+	result, err := h.svc.DevelopmentPlan.GetDevelopmentPlanRules(r.Context())
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "GetDevelopmentPlanRules").Msg("Failed to list development plan rules")
+		response.Error(w, http.StatusInternalServerError, "Failed to retrieve development plan rules")
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// SaveDevelopmentPlanRule handles POST /api/v1/competency/development-plan-rules
+// Creates a rule, or updates it when developmentPlanRuleId is set.
+func (h *DevelopmentPlanHandler) SaveDevelopmentPlanRule(w http.ResponseWriter, r *http.Request) {
+	var req competency.DevelopmentPlanRuleVm
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if req.TrainingTypeName == "" {
+		response.Error(w, http.StatusBadRequest, "Training type is required")
+		return
+	}
+	userID := h.svc.UserContext.GetUserID(r.Context())
+
+	result, err := h.svc.DevelopmentPlan.SaveDevelopmentPlanRule(r.Context(), &req, userID)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "SaveDevelopmentPlanRule").Msg("Failed to save development plan rule")
+		response.Error(w, http.StatusInternalServerError, "Failed to save development plan rule")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusBadRequest, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// DeleteDevelopmentPlanRule handles DELETE /api/v1/competency/development-plan-rules/{ruleId}
+func (h *DevelopmentPlanHandler) DeleteDevelopmentPlanRule(w http.ResponseWriter, r *http.Request) {
+	ruleID, err := strconv.Atoi(r.PathValue("ruleId"))
+	if err != nil || ruleID <= 0 {
+		response.Error(w, http.StatusBadRequest, "Invalid development plan rule ID")
+		return
+	}
+	userID := h.svc.UserContext.GetUserID(r.Context())
+
+	result, err := h.svc.DevelopmentPlan.DeleteDevelopmentPlanRule(r.Context(), ruleID, userID)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "DeleteDevelopmentPlanRule").Int("ruleId", ruleID).Msg("Failed to delete development plan rule")
+		response.Error(w, http.StatusInternalServerError, "Failed to delete development plan rule")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusNotFound, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// DraftDevelopmentPlans handles POST /api/v1/competency/development-plans/draft
+// Drafts plans from the rules for review profiles with a gap and no plan.
+func (h *DevelopmentPlanHandler) DraftDevelopmentPlans(w http.ResponseWriter, r *http.Request) {
+	var req competency.DevelopmentPlanDraftRequestModel
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	req.RequestedBy = h.svc.UserContext.GetUserID(r.Context())
+
+	result, err := h.svc.DevelopmentPlan.DraftDevelopmentPlans(r.Context(), &req)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "DraftDevelopmentPlans").Msg("Failed to draft development plans")
+		serviceError(w, err, http.StatusInternalServerError, "Failed to draft development plans")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusBadRequest, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// GetDevelopmentPlanDrafts handles GET /api/v1/competency/development-plans/drafts?employeeNumber=&reviewPeriodId=
+// Lists the drafted plans awaiting the caller's confirmation.
+func (h *DevelopmentPlanHandler) GetDevelopmentPlanDrafts(w http.ResponseWriter, r *http.Request) {
+	search := competency.DevelopmentPlanDraftSearchModel{EmployeeNumber: r.URL.Query().Get("employeeNumber")}
+	if rpStr := r.URL.Query().Get("reviewPeriodId"); rpStr != "" {
+		rp, err := strconv.Atoi(rpStr)
+		if err != nil {
+			response.Error(w, http.StatusBadRequest, "Invalid reviewPeriodId")
+			return
+		}
+		search.ReviewPeriodID = &rp
+	}
+
+	result, err := h.svc.DevelopmentPlan.GetDevelopmentPlanDrafts(r.Context(), &search)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "GetDevelopmentPlanDrafts").Msg("Failed to list drafted development plans")
+		serviceError(w, err, http.StatusInternalServerError, "Failed to retrieve drafted development plans")
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// DecideDevelopmentPlanDrafts handles POST /api/v1/competency/development-plans/drafts/decide
+// Confirms and discards drafted plans in bulk.
+func (h *DevelopmentPlanHandler) DecideDevelopmentPlanDrafts(w http.ResponseWriter, r *http.Request) {
+	var req competency.DevelopmentPlanDraftDecisionRequestModel
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	req.DecidedBy = h.svc.UserContext.GetUserID(r.Context())
+
+	result, err := h.svc.DevelopmentPlan.DecideDevelopmentPlanDrafts(r.Context(), &req)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "DecideDevelopmentPlanDrafts").Msg("Failed to decide drafted development plans")
+		serviceError(w, err, http.StatusInternalServerError, "Failed to confirm drafted development plans")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusBadRequest, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// pathPlanID reads the {planId} path value, writing a 400 when it is not a
+// valid ID.
+func pathPlanID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	planID, err := strconv.Atoi(r.PathValue("planId"))
+	if err != nil || planID <= 0 {
+		response.Error(w, http.StatusBadRequest, "Invalid development plan ID")
+		return 0, false
+	}
+	return planID, true
+}
@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"github.com/enterprise-pms/pms-api/internal/service"
+	"github.com/enterprise-pms/pms-api/pkg/response"
+	"github.com/rs/zerolog"
+)
+
+// EmailDeliveryHandler handles the admin endpoints for outbound mail that
+// the mail sender could not deliver.
+type EmailDeliveryHandler struct {
+	svc *service.Container
+	log zerolog.Logger
+}
+
+// NewEmailDeliveryHandler creates a new email delivery handler.
+func NewEmailDeliveryHandler(svc *service.Container, log zerolog.Logger) *EmailDeliveryHandler {
+	return &EmailDeliveryHandler{svc: svc, log: log}
+}
+
+// ListUndeliveredEmails handles GET /api/v1/emails/undelivered?skip={n}&pageSize={n}
+// Returns failed, bounced and stuck emails with their last delivery error.
+func (h *EmailDeliveryHandler) ListUndeliveredEmails(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	search := performance.EmailDeliverySearchModel{}
+	search.Skip, _ = strconv.Atoi(q.Get("skip"))
+	search.PageSize, _ = strconv.Atoi(q.Get("pageSize"))
+
+	result, err := h.svc.EmailDelivery.ListUndeliveredEmails(r.Context(), &search)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "ListUndeliveredEmails").Msg("Failed to list undelivered emails")
+		response.Error(w, http.StatusInternalServerError, "Failed to retrieve undelivered emails")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusServiceUnavailable, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// GetEmailDelivery handles GET /api/v1/emails/{emailId}/attempts
+// Returns an email and its delivery attempt history.
+func (h *EmailDeliveryHandler) GetEmailDelivery(w http.ResponseWriter, r *http.Request) {
+	emailID, err := strconv.Atoi(r.PathValue("emailId"))
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "A numeric email ID is required")
+		return
+	}
+
+	result, err := h.svc.EmailDelivery.GetEmailDelivery(r.Context(), emailID)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "GetEmailDelivery").Int("emailId", emailID).Msg("Failed to get email delivery history")
+		response.Error(w, http.StatusInternalServerError, "Failed to retrieve email delivery history")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusNotFound, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// ResendEmail handles POST /api/v1/emails/{emailId}/resend
+// Requeues a failed, bounced or stuck email for immediate delivery.
+func (h *EmailDeliveryHandler) ResendEmail(w http.ResponseWriter, r *http.Request) {
+	emailID, err := strconv.Atoi(r.PathValue("emailId"))
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "A numeric email ID is required")
+		return
+	}
+
+	h.resend(w, r, []int{emailID})
+}
+
+// ResendEmails handles POST /api/v1/emails/resend
+// Requeues the failed, bounced or stuck emails among emailIds.
+func (h *EmailDeliveryHandler) ResendEmails(w http.ResponseWriter, r *http.Request) {
+	var req performance.EmailResendRequestModel
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	h.resend(w, r, req.EmailIDs)
+}
+
+func (h *EmailDeliveryHandler) resend(w http.ResponseWriter, r *http.Request, emailIDs []int) {
+	req := performance.EmailResendRequestModel{
+		EmailIDs:    emailIDs,
+		RequestedBy: h.svc.UserContext.GetUserID(r.Context()),
+	}
+
+	result, err := h.svc.EmailDelivery.ResendEmails(r.Context(), &req)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "ResendEmails").Ints("emailIds", emailIDs).Msg("Failed to resend emails")
+		response.Error(w, http.StatusInternalServerError, "Failed to resend emails")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusBadRequest, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
@@ -0,0 +1,25 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/enterprise-pms/pms-api/internal/service"
+	"github.com/enterprise-pms/pms-api/pkg/response"
+)
+
+// serviceError writes the response for a failed service call. Requests for
+// staff or units outside the caller's data scope get 403 and references to
+// files the caller may not attach get 400, whatever the handler's usual
+// failure status; other errors get status and message.
+func serviceError(w http.ResponseWriter, err error, status int, message string) {
+	switch {
+	case errors.Is(err, service.ErrOutOfScope):
+		response.Error(w, http.StatusForbidden, service.ErrOutOfScope.Error())
+		return
+	case errors.Is(err, service.ErrInvalidFileReference):
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Error(w, status, message)
+}
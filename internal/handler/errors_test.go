@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/enterprise-pms/pms-api/internal/service"
+)
+
+func TestServiceError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"out of scope", service.ErrOutOfScope, http.StatusForbidden},
+		{"wrapped out of scope", fmt.Errorf("loading: %w", service.ErrOutOfScope), http.StatusForbidden},
+		{"invalid file reference", fmt.Errorf("%w: file F1 is attached to another record", service.ErrInvalidFileReference), http.StatusBadRequest},
+		{"other", errors.New("boom"), http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			serviceError(rec, tt.err, http.StatusInternalServerError, tt.err.Error())
+			if rec.Code != tt.want {
+				t.Errorf("status = %d, want %d", rec.Code, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"github.com/enterprise-pms/pms-api/internal/service"
+	"github.com/enterprise-pms/pms-api/pkg/response"
+	"github.com/enterprise-pms/pms-api/pkg/storage"
+	"github.com/rs/zerolog"
+)
+
+// maxUploadRequestBytes bounds the whole multipart request body. The
+// service applies the configured per-file limit on top of this.
+const maxUploadRequestBytes = 64 << 20
+
+// FileHandler handles file upload and signed download endpoints.
+type FileHandler struct {
+	svc *service.Container
+	log zerolog.Logger
+}
+
+// NewFileHandler creates a new file handler.
+func NewFileHandler(svc *service.Container, log zerolog.Logger) *FileHandler {
+	return &FileHandler{svc: svc, log: log}
+}
+
+// UploadFile handles POST /api/v1/files?category=
+// Accepts a multipart/form-data body with the content in the "file" part and
+// returns the stored file's metadata. The returned fileUploadId is what
+// evidence and attachment fields reference.
+func (h *FileHandler) UploadFile(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxUploadRequestBytes)
+	reader, err := r.MultipartReader()
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "Request must be multipart/form-data")
+		return
+	}
+
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			response.Error(w, http.StatusBadRequest, "Invalid multipart body")
+			return
+		}
+		if part.FormName() != "file" {
+			part.Close()
+			continue
+		}
+
+		req := performance.FileUploadRequestModel{
+			FileName:   part.FileName(),
+			Category:   r.URL.Query().Get("category"),
+			UploadedBy: h.svc.UserContext.GetUserID(r.Context()),
+		}
+		result, err := h.svc.FileStorage.Upload(r.Context(), &req, part)
+		part.Close()
+		if err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				response.Error(w, http.StatusRequestEntityTooLarge, "Upload is too large")
+				return
+			}
+			h.log.Error().Err(err).Str("action", "UploadFile").Msg("Failed to upload file")
+			response.Error(w, http.StatusInternalServerError, "Failed to upload file")
+			return
+		}
+		if result.HasError {
+			response.Error(w, http.StatusBadRequest, result.Message)
+			return
+		}
+
+		response.Created(w, result)
+		return
+	}
+
+	response.Error(w, http.StatusBadRequest, "A \"file\" part is required")
+}
+
+// GetFileInfo handles GET /api/v1/files/{fileId}
+// Returns the metadata of a stored file.
+func (h *FileHandler) GetFileInfo(w http.ResponseWriter, r *http.Request) {
+	fileID := r.PathValue("fileId")
+	if fileID == "" {
+		response.Error(w, http.StatusBadRequest, "File ID is required")
+		return
+	}
+
+	result, err := h.svc.FileStorage.GetFileInfo(r.Context(), fileID)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "GetFileInfo").Str("fileId", fileID).Msg("Failed to get file")
+		serviceError(w, err, http.StatusInternalServerError, "Failed to retrieve file")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusNotFound, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// GetDownloadURL handles GET /api/v1/files/{fileId}/download-url
+// Issues a signed, time-limited download link for the calling user.
+func (h *FileHandler) GetDownloadURL(w http.ResponseWriter, r *http.Request) {
+	fileID := r.PathValue("fileId")
+	if fileID == "" {
+		response.Error(w, http.StatusBadRequest, "File ID is required")
+		return
+	}
+	userID := h.svc.UserContext.GetUserID(r.Context())
+
+	result, err := h.svc.FileStorage.GetDownloadURL(r.Context(), fileID, userID)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "GetDownloadURL").Str("fileId", fileID).Msg("Failed to issue download URL")
+		serviceError(w, err, http.StatusInternalServerError, "Failed to issue download URL")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusNotFound, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// DownloadFile handles GET /api/v1/files/{fileId}/download?expires=&signature=
+// Streams the file when the signature is valid, unexpired and was issued to
+// the calling user.
+func (h *FileHandler) DownloadFile(w http.ResponseWriter, r *http.Request) {
+	fileID := r.PathValue("fileId")
+	if fileID == "" {
+		response.Error(w, http.StatusBadRequest, "File ID is required")
+		return
+	}
+	expires, err := strconv.ParseInt(r.URL.Query().Get("expires"), 10, 64)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid expires parameter")
+		return
+	}
+	userID := h.svc.UserContext.GetUserID(r.Context())
+
+	upload, body, err := h.svc.FileStorage.OpenDownload(r.Context(), fileID, userID, expires, r.URL.Query().Get("signature"))
+	switch {
+	case errors.Is(err, storage.ErrInvalidSignature):
+		response.Error(w, http.StatusForbidden, "Invalid download link")
+		return
+	case errors.Is(err, storage.ErrURLExpired):
+		response.Error(w, http.StatusGone, "Download link has expired")
+		return
+	case errors.Is(err, service.ErrFileNotFound):
+		response.Error(w, http.StatusNotFound, "File not found")
+		return
+	case errors.Is(err, service.ErrOutOfScope):
+		response.Error(w, http.StatusForbidden, service.ErrOutOfScope.Error())
+		return
+	case err != nil:
+		h.log.Error().Err(err).Str("action", "DownloadFile").Str("fileId", fileID).Msg("Failed to open file")
+		response.Error(w, http.StatusInternalServerError, "Failed to download file")
+		return
+	}
+	defer body.Close()
+
+	w.Header().Set("Content-Type", upload.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(upload.SizeBytes, 10))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": upload.OriginalName}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Cache-Control", "private, no-store")
+	w.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(w, body); err != nil {
+		h.log.Warn().Err(err).Str("fileId", fileID).Msg("File download interrupted")
+	}
+}
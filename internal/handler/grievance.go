@@ -85,7 +85,7 @@ func (h *GrievanceHandler) RaiseNewGrievance(w http.ResponseWriter, r *http.Requ
 	result, err := h.svc.Grievance.RaiseNewGrievance(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "RaiseNewGrievance").Msg("Failed to raise new grievance")
-		response.Error(w, http.StatusInternalServerError, "Failed to raise grievance")
+		serviceError(w, err, http.StatusInternalServerError, "Failed to raise grievance")
 		return
 	}
 
@@ -109,7 +109,7 @@ func (h *GrievanceHandler) UpdateGrievance(w http.ResponseWriter, r *http.Reques
 	result, err := h.svc.Grievance.UpdateGrievance(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "UpdateGrievance").Msg("Failed to update grievance")
-		response.Error(w, http.StatusInternalServerError, "Failed to update grievance")
+		serviceError(w, err, http.StatusInternalServerError, "Failed to update grievance")
 		return
 	}
 
@@ -138,7 +138,7 @@ func (h *GrievanceHandler) CreateGrievanceResolution(w http.ResponseWriter, r *h
 	result, err := h.svc.Grievance.CreateGrievanceResolution(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "CreateGrievanceResolution").Msg("Failed to create grievance resolution")
-		response.Error(w, http.StatusInternalServerError, "Failed to create grievance resolution")
+		serviceError(w, err, http.StatusInternalServerError, "Failed to create grievance resolution")
 		return
 	}
 
@@ -162,7 +162,7 @@ func (h *GrievanceHandler) UpdateGrievanceResolution(w http.ResponseWriter, r *h
 	result, err := h.svc.Grievance.UpdateGrievanceResolution(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "UpdateGrievanceResolution").Msg("Failed to update grievance resolution")
-		response.Error(w, http.StatusInternalServerError, "Failed to update grievance resolution")
+		serviceError(w, err, http.StatusInternalServerError, "Failed to update grievance resolution")
 		return
 	}
 
@@ -181,7 +181,7 @@ func (h *GrievanceHandler) GetStaffGrievances(w http.ResponseWriter, r *http.Req
 	result, err := h.svc.Grievance.GetStaffGrievances(r.Context(), staffID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetStaffGrievances").Str("staffId", staffID).Msg("Failed to get staff grievances")
-		response.Error(w, http.StatusInternalServerError, "Failed to retrieve staff grievances")
+		serviceError(w, err, http.StatusInternalServerError, "Failed to retrieve staff grievances")
 		return
 	}
 
@@ -194,7 +194,7 @@ func (h *GrievanceHandler) GetGrievancesReport(w http.ResponseWriter, r *http.Re
 	result, err := h.svc.Grievance.GetGrievancesReport(r.Context())
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetGrievancesReport").Msg("Failed to get grievances report")
-		response.Error(w, http.StatusInternalServerError, "Failed to retrieve grievances report")
+		serviceError(w, err, http.StatusInternalServerError, "Failed to retrieve grievances report")
 		return
 	}
 
@@ -0,0 +1,302 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"github.com/enterprise-pms/pms-api/internal/middleware"
+	"github.com/enterprise-pms/pms-api/internal/service"
+	"github.com/enterprise-pms/pms-api/pkg/response"
+	"github.com/rs/zerolog"
+)
+
+// notificationStreamPoll is how often a live stream re-reads the inbox.
+// Notifications written by this instance arrive at once; ones written by
+// other replicas arrive within this interval. Each poll also keeps idle
+// proxies from closing the connection.
+const notificationStreamPoll = 15 * time.Second
+
+// NotificationHandler handles the in-app notification inbox. Every endpoint
+// acts on the inbox of the authenticated staff member.
+type NotificationHandler struct {
+	svc  *service.Container
+	log  zerolog.Logger
+	poll time.Duration
+}
+
+// NewNotificationHandler creates a new notification handler.
+func NewNotificationHandler(svc *service.Container, log zerolog.Logger) *NotificationHandler {
+	return &NotificationHandler{svc: svc, log: log, poll: notificationStreamPoll}
+}
+
+// ListNotifications handles GET /api/v1/notifications?unreadOnly={bool}&skip={n}&pageSize={n}
+// Returns the caller's notifications, newest first, with their unread count.
+func (h *NotificationHandler) ListNotifications(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	search := performance.NotificationSearchModel{StaffID: h.svc.UserContext.GetUserID(r.Context())}
+	search.UnreadOnly, _ = strconv.ParseBool(q.Get("unreadOnly"))
+	search.Skip, _ = strconv.Atoi(q.Get("skip"))
+	search.PageSize, _ = strconv.Atoi(q.Get("pageSize"))
+
+	result, err := h.svc.Notification.ListNotifications(r.Context(), &search)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "ListNotifications").Msg("Failed to list notifications")
+		response.Error(w, http.StatusInternalServerError, "Failed to retrieve notifications")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusServiceUnavailable, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// MarkNotificationRead handles POST /api/v1/notifications/{notificationId}/read
+func (h *NotificationHandler) MarkNotificationRead(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(r.PathValue("notificationId"), 10, 64)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "A numeric notification ID is required")
+		return
+	}
+
+	h.markRead(w, r, []int64{id})
+}
+
+// MarkNotificationsRead handles POST /api/v1/notifications/read
+// Marks the notifications listed in notificationIds as read.
+func (h *NotificationHandler) MarkNotificationsRead(w http.ResponseWriter, r *http.Request) {
+	var req performance.NotificationMarkReadRequestModel
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	h.markRead(w, r, req.NotificationIDs)
+}
+
+func (h *NotificationHandler) markRead(w http.ResponseWriter, r *http.Request, ids []int64) {
+	req := performance.NotificationMarkReadRequestModel{
+		NotificationIDs: ids,
+		StaffID:         h.svc.UserContext.GetUserID(r.Context()),
+	}
+
+	result, err := h.svc.Notification.MarkNotificationsRead(r.Context(), &req)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "MarkNotificationsRead").Msg("Failed to mark notifications read")
+		response.Error(w, http.StatusInternalServerError, "Failed to mark notifications as read")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusBadRequest, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// MarkAllNotificationsRead handles POST /api/v1/notifications/read-all
+func (h *NotificationHandler) MarkAllNotificationsRead(w http.ResponseWriter, r *http.Request) {
+	staffID := h.svc.UserContext.GetUserID(r.Context())
+
+	result, err := h.svc.Notification.MarkAllNotificationsRead(r.Context(), staffID)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "MarkAllNotificationsRead").Msg("Failed to mark all notifications read")
+		response.Error(w, http.StatusInternalServerError, "Failed to mark notifications as read")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusServiceUnavailable, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// GetNotificationPreferences handles GET /api/v1/notifications/preferences
+// Returns the caller's delivery mode for every notification category.
+func (h *NotificationHandler) GetNotificationPreferences(w http.ResponseWriter, r *http.Request) {
+	staffID := h.svc.UserContext.GetUserID(r.Context())
+
+	result, err := h.svc.Notification.GetNotificationPreferences(r.Context(), staffID)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "GetNotificationPreferences").Msg("Failed to get notification preferences")
+		response.Error(w, http.StatusInternalServerError, "Failed to retrieve notification preferences")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusServiceUnavailable, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// UpdateNotificationPreferences handles PUT /api/v1/notifications/preferences
+// Sets the caller's delivery mode for the listed categories.
+func (h *NotificationHandler) UpdateNotificationPreferences(w http.ResponseWriter, r *http.Request) {
+	var req performance.NotificationPreferenceRequestModel
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	req.StaffID = h.svc.UserContext.GetUserID(r.Context())
+
+	result, err := h.svc.Notification.UpdateNotificationPreferences(r.Context(), &req)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "UpdateNotificationPreferences").Msg("Failed to update notification preferences")
+		response.Error(w, http.StatusInternalServerError, "Failed to update notification preferences")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusBadRequest, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// StreamNotifications handles GET /api/v1/notifications/stream
+// Streams the caller's new notifications as Server-Sent Events. The stream
+// opens with an "unread" event carrying the unread count, then sends a
+// "notification" event per new notification with its ID as the event ID,
+// so a client reconnecting with Last-Event-ID receives what it missed.
+// The endpoint needs the bearer token like any other, so browsers must
+// use a fetch-based EventSource that can send the Authorization header.
+// The stream re-checks the token's session and expiry on every poll and
+// ends with a "session_ended" event once either is no longer valid; the
+// client must reconnect with a fresh token.
+func (h *NotificationHandler) StreamNotifications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	staffID := h.svc.UserContext.GetUserID(ctx)
+	sessionID, _ := ctx.Value(middleware.SessionIDKey).(string)
+	expiry, _ := ctx.Value(middleware.TokenExpiryKey).(time.Time)
+
+	// Subscribe before reading the cursor so nothing written in between is missed.
+	wake, cancel := h.svc.Notification.Subscribe(staffID)
+	defer cancel()
+
+	latest, err := h.svc.Notification.ListNotifications(ctx, &performance.NotificationSearchModel{
+		BasePagedData: performance.BasePagedData{PageSize: 1},
+		StaffID:       staffID,
+	})
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "StreamNotifications").Msg("Failed to open notification stream")
+		response.Error(w, http.StatusInternalServerError, "Failed to open notification stream")
+		return
+	}
+	if latest.HasError {
+		response.Error(w, http.StatusServiceUnavailable, latest.Message)
+		return
+	}
+
+	cursor, err := strconv.ParseInt(r.Header.Get("Last-Event-ID"), 10, 64)
+	if err != nil || cursor < 0 {
+		cursor = 0
+		if len(latest.Data) > 0 {
+			cursor = latest.Data[0].NotificationID
+		}
+	}
+
+	rc := http.NewResponseController(w)
+	// The stream outlives the server's write timeout.
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		h.log.Warn().Err(err).Msg("Failed to clear write deadline for notification stream")
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	fmt.Fprintf(w, "retry: %d\n\n", (5 * time.Second).Milliseconds())
+	if err := writeSSE(w, "", "unread", map[string]int{"unreadCount": latest.UnreadCount}); err != nil {
+		return
+	}
+
+	ticker := time.NewTicker(h.poll)
+	defer ticker.Stop()
+
+	for {
+		if err := rc.Flush(); err != nil {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case _, ok := <-wake:
+			if !ok {
+				return
+			}
+		case <-ticker.C:
+		}
+
+		if !h.streamSessionValid(ctx, sessionID, expiry) {
+			_ = writeSSE(w, "", "session_ended", map[string]string{"message": "Session has ended, reconnect with a new token"})
+			_ = rc.Flush()
+			return
+		}
+
+		notifications, err := h.svc.Notification.ListNotificationsAfter(ctx, staffID, cursor)
+		if err != nil {
+			if ctx.Err() == nil {
+				h.log.Error().Err(err).Str("action", "StreamNotifications").Msg("Failed to read new notifications")
+			}
+			return
+		}
+		if len(notifications) == 0 {
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+			continue
+		}
+		for _, n := range notifications {
+			if err := writeSSE(w, strconv.FormatInt(n.NotificationID, 10), "notification", n); err != nil {
+				return
+			}
+			cursor = n.NotificationID
+		}
+	}
+}
+
+// streamSessionValid reports whether the token a stream was opened with is
+// still unexpired and its session, if it has one, still active.
+func (h *NotificationHandler) streamSessionValid(ctx context.Context, sessionID string, expiry time.Time) bool {
+	if !expiry.IsZero() && !time.Now().Before(expiry) {
+		return false
+	}
+	if sessionID == "" || h.svc.Auth == nil {
+		return true
+	}
+	active, err := h.svc.Auth.IsSessionActive(ctx, sessionID)
+	if err != nil {
+		if ctx.Err() == nil {
+			h.log.Error().Err(err).Str("session_id", sessionID).Msg("Failed to check session of notification stream")
+		}
+		return false
+	}
+	return active
+}
+
+// writeSSE writes one Server-Sent Event with a JSON payload.
+func writeSSE(w http.ResponseWriter, id, event string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if id != "" {
+		if _, err := fmt.Fprintf(w, "id: %s\n", id); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data)
+	return err
+}
@@ -0,0 +1,230 @@
+package handler
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"github.com/enterprise-pms/pms-api/internal/middleware"
+	"github.com/enterprise-pms/pms-api/internal/service"
+	"github.com/rs/zerolog"
+)
+
+type fakeUserContext struct {
+	service.UserContextService
+	userID string
+}
+
+func (f fakeUserContext) GetUserID(context.Context) string { return f.userID }
+
+// fakeInbox is an in-memory notification inbox for one staff member.
+type fakeInbox struct {
+	service.NotificationService
+
+	mu    sync.Mutex
+	rows  []performance.NotificationVm
+	wake  chan struct{}
+	after []int64 // cursors passed to ListNotificationsAfter
+}
+
+func (f *fakeInbox) Subscribe(string) (<-chan struct{}, func()) { return f.wake, func() {} }
+
+func (f *fakeInbox) ListNotifications(_ context.Context, search *performance.NotificationSearchModel) (performance.NotificationListResponseVm, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	resp := performance.NotificationListResponseVm{UnreadCount: len(f.rows)}
+	for i := len(f.rows) - 1; i >= 0 && len(resp.Data) < search.PageSize; i-- {
+		resp.Data = append(resp.Data, f.rows[i])
+	}
+	return resp, nil
+}
+
+func (f *fakeInbox) ListNotificationsAfter(_ context.Context, _ string, afterID int64) ([]performance.NotificationVm, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.after = append(f.after, afterID)
+	var out []performance.NotificationVm
+	for _, n := range f.rows {
+		if n.NotificationID > afterID {
+			out = append(out, n)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeInbox) add(n performance.NotificationVm) {
+	f.mu.Lock()
+	f.rows = append(f.rows, n)
+	f.mu.Unlock()
+	f.wake <- struct{}{}
+}
+
+// readEvent reads one Server-Sent Event, skipping comments and retry hints.
+func readEvent(t *testing.T, r *bufio.Reader) (id, event, data string) {
+	t.Helper()
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			t.Fatalf("reading stream: %v", err)
+		}
+		line = strings.TrimRight(line, "\n")
+		switch {
+		case line == "":
+			if event != "" {
+				return id, event, data
+			}
+		case strings.HasPrefix(line, "id: "):
+			id = strings.TrimPrefix(line, "id: ")
+		case strings.HasPrefix(line, "event: "):
+			event = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			data = strings.TrimPrefix(line, "data: ")
+		}
+	}
+}
+
+func TestStreamNotifications(t *testing.T) {
+	inbox := &fakeInbox{
+		rows: []performance.NotificationVm{{NotificationID: 7, Title: "old"}},
+		wake: make(chan struct{}, 1),
+	}
+	h := NewNotificationHandler(&service.Container{
+		Notification: inbox,
+		UserContext:  fakeUserContext{userID: "1001"},
+	}, zerolog.Nop())
+	h.poll = time.Hour
+	srv := httptest.NewServer(http.HandlerFunc(h.StreamNotifications))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("Content-Type = %q", ct)
+	}
+	r := bufio.NewReader(resp.Body)
+
+	if _, event, data := readEvent(t, r); event != "unread" || data != `{"unreadCount":1}` {
+		t.Fatalf("first event = %s %s, want the unread count", event, data)
+	}
+
+	// Only notifications newer than the one already in the inbox are sent.
+	inbox.add(performance.NotificationVm{NotificationID: 8, Title: "New request"})
+	id, event, data := readEvent(t, r)
+	if id != "8" || event != "notification" || !strings.Contains(data, `"title":"New request"`) {
+		t.Fatalf("event = %s %s %s, want notification 8", id, event, data)
+	}
+	inbox.mu.Lock()
+	if len(inbox.after) != 1 || inbox.after[0] != 7 {
+		t.Errorf("cursors = %v, want [7]", inbox.after)
+	}
+	inbox.mu.Unlock()
+
+	// Closing the subscription ends the stream.
+	close(inbox.wake)
+	if _, err := r.ReadString('\n'); err == nil {
+		t.Error("stream still open after the subscription closed")
+	}
+}
+
+func TestStreamNotificationsResumesFromLastEventID(t *testing.T) {
+	inbox := &fakeInbox{
+		rows: []performance.NotificationVm{{NotificationID: 3}, {NotificationID: 4}, {NotificationID: 5}},
+		wake: make(chan struct{}, 1),
+	}
+	h := NewNotificationHandler(&service.Container{
+		Notification: inbox,
+		UserContext:  fakeUserContext{userID: "1001"},
+	}, zerolog.Nop())
+	h.poll = time.Hour
+	srv := httptest.NewServer(http.HandlerFunc(h.StreamNotifications))
+	defer srv.Close()
+
+	req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+	req.Header.Set("Last-Event-ID", "3")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	r := bufio.NewReader(resp.Body)
+
+	readEvent(t, r) // unread count
+	inbox.wake <- struct{}{}
+	for _, want := range []string{"4", "5"} {
+		if id, _, _ := readEvent(t, r); id != want {
+			t.Errorf("event id = %s, want %s", id, want)
+		}
+	}
+	close(inbox.wake)
+}
+
+// fakeSessions reports a single session as active until it is revoked.
+type fakeSessions struct {
+	service.AuthService
+
+	mu      sync.Mutex
+	revoked bool
+}
+
+func (f *fakeSessions) IsSessionActive(context.Context, string) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return !f.revoked, nil
+}
+
+func TestStreamNotificationsEndsWithSession(t *testing.T) {
+	tests := []struct {
+		name   string
+		expiry time.Duration
+		revoke bool
+	}{
+		{"revoked session", time.Hour, true},
+		{"expired token", -time.Second, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inbox := &fakeInbox{wake: make(chan struct{}, 1)}
+			sessions := &fakeSessions{}
+			h := NewNotificationHandler(&service.Container{
+				Auth:         sessions,
+				Notification: inbox,
+				UserContext:  fakeUserContext{userID: "1001"},
+			}, zerolog.Nop())
+			h.poll = time.Hour
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				ctx := context.WithValue(r.Context(), middleware.SessionIDKey, "s1")
+				ctx = context.WithValue(ctx, middleware.TokenExpiryKey, time.Now().Add(tt.expiry))
+				h.StreamNotifications(w, r.WithContext(ctx))
+			}))
+			defer srv.Close()
+
+			resp, err := http.Get(srv.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			r := bufio.NewReader(resp.Body)
+			readEvent(t, r) // unread count
+
+			sessions.mu.Lock()
+			sessions.revoked = tt.revoke
+			sessions.mu.Unlock()
+			inbox.wake <- struct{}{}
+			if _, event, _ := readEvent(t, r); event != "session_ended" {
+				t.Fatalf("event = %s, want session_ended", event)
+			}
+			if _, err := r.ReadString('\n'); err == nil {
+				t.Error("stream still open after the session ended")
+			}
+		})
+	}
+}
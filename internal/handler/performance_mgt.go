@@ -1,12 +1,19 @@
 package handler
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
+	"io"
+	"mime"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/enterprise-pms/pms-api/internal/domain/enums"
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
 	"github.com/enterprise-pms/pms-api/internal/service"
+	"github.com/enterprise-pms/pms-api/pkg/excel"
 	"github.com/enterprise-pms/pms-api/pkg/response"
 	"github.com/rs/zerolog"
 )
@@ -379,7 +386,7 @@ func (h *PerformanceMgtHandler) GetBankStrategies(w http.ResponseWriter, r *http
 	result, err := h.svc.Performance.GetStrategies(r.Context())
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetBankStrategies").Msg("failed to get bank strategies")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -396,7 +403,7 @@ func (h *PerformanceMgtHandler) GetBankStrategicThemes(w http.ResponseWriter, r
 		result, err := h.svc.Performance.GetStrategicThemesById(r.Context(), strategyID)
 		if err != nil {
 			h.log.Error().Err(err).Str("action", "GetBankStrategicThemesById").Msg("failed to get strategic themes by strategy id")
-			response.Error(w, http.StatusBadRequest, err.Error())
+			serviceError(w, err, http.StatusBadRequest, err.Error())
 			return
 		}
 		response.OK(w, result)
@@ -406,7 +413,7 @@ func (h *PerformanceMgtHandler) GetBankStrategicThemes(w http.ResponseWriter, r
 	result, err := h.svc.Performance.GetStrategicThemes(r.Context())
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetBankStrategicThemes").Msg("failed to get strategic themes")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -418,7 +425,7 @@ func (h *PerformanceMgtHandler) GetEnterpriseObjectives(w http.ResponseWriter, r
 	result, err := h.svc.Performance.GetEnterpriseObjectives(r.Context())
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetEnterpriseObjectives").Msg("failed to get enterprise objectives")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -430,7 +437,7 @@ func (h *PerformanceMgtHandler) GetDepartmentObjectives(w http.ResponseWriter, r
 	result, err := h.svc.Performance.GetDepartmentObjectives(r.Context())
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetDepartmentObjectives").Msg("failed to get department objectives")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -451,7 +458,7 @@ func (h *PerformanceMgtHandler) GetDivisionObjectives(w http.ResponseWriter, r *
 		result, err := h.svc.Performance.GetDivisionObjectivesByDivisionId(r.Context(), divisionID)
 		if err != nil {
 			h.log.Error().Err(err).Str("action", "GetDivisionObjectivesByDivisionId").Msg("failed to get division objectives by division id")
-			response.Error(w, http.StatusBadRequest, err.Error())
+			serviceError(w, err, http.StatusBadRequest, err.Error())
 			return
 		}
 		response.OK(w, result)
@@ -461,7 +468,7 @@ func (h *PerformanceMgtHandler) GetDivisionObjectives(w http.ResponseWriter, r *
 	result, err := h.svc.Performance.GetDivisionObjectives(r.Context())
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetDivisionObjectives").Msg("failed to get division objectives")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -482,7 +489,7 @@ func (h *PerformanceMgtHandler) GetOfficeObjectives(w http.ResponseWriter, r *ht
 		result, err := h.svc.Performance.GetOfficeObjectivesByOfficeId(r.Context(), officeID)
 		if err != nil {
 			h.log.Error().Err(err).Str("action", "GetOfficeObjectivesByOfficeId").Msg("failed to get office objectives by office id")
-			response.Error(w, http.StatusBadRequest, err.Error())
+			serviceError(w, err, http.StatusBadRequest, err.Error())
 			return
 		}
 		response.OK(w, result)
@@ -492,7 +499,7 @@ func (h *PerformanceMgtHandler) GetOfficeObjectives(w http.ResponseWriter, r *ht
 	result, err := h.svc.Performance.GetOfficeObjectives(r.Context())
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetOfficeObjectives").Msg("failed to get office objectives")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -504,7 +511,7 @@ func (h *PerformanceMgtHandler) GetEvaluationOptions(w http.ResponseWriter, r *h
 	result, err := h.svc.Performance.GetEvaluationOptions(r.Context())
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetEvaluationOptions").Msg("failed to get evaluation options")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -516,7 +523,7 @@ func (h *PerformanceMgtHandler) GetFeedbackQuestionnaires(w http.ResponseWriter,
 	result, err := h.svc.Performance.GetFeedbackQuestionnaires(r.Context())
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetFeedbackQuestionnaires").Msg("failed to get feedback questionnaires")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -528,7 +535,7 @@ func (h *PerformanceMgtHandler) GetPmsCompetencies(w http.ResponseWriter, r *htt
 	result, err := h.svc.Performance.GetPmsCompetencies(r.Context())
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetPmsCompetencies").Msg("failed to get pms competencies")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -540,7 +547,7 @@ func (h *PerformanceMgtHandler) GetObjectiveCategories(w http.ResponseWriter, r
 	result, err := h.svc.Performance.GetObjectiveCategories(r.Context())
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetObjectiveCategories").Msg("failed to get objective categories")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -558,7 +565,7 @@ func (h *PerformanceMgtHandler) GetCategoryDefinitions(w http.ResponseWriter, r
 	result, err := h.svc.Performance.GetCategoryDefinitions(r.Context(), categoryID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetCategoryDefinitions").Msg("failed to get category definitions")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -584,7 +591,7 @@ func (h *PerformanceMgtHandler) GetObjectiveWorkProductDefinitions(w http.Respon
 	result, err := h.svc.Performance.GetObjectiveWorkProductDefinitions(r.Context(), objectiveID, objectiveLevel)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetObjectiveWorkProductDefinitions").Msg("failed to get objective work product definitions")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -596,7 +603,7 @@ func (h *PerformanceMgtHandler) GetAllWorkProductDefinitions(w http.ResponseWrit
 	result, err := h.svc.Performance.GetAllWorkProductDefinitions(r.Context())
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetAllWorkProductDefinitions").Msg("failed to get all work product definitions")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -621,7 +628,7 @@ func (h *PerformanceMgtHandler) GetAllPaginatedWorkProductDefinitions(w http.Res
 	result, err := h.svc.Performance.GetAllPaginatedWorkProductDefinitions(r.Context(), pageIndex, pageSize, search)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetAllPaginatedWorkProductDefinitions").Msg("failed to get paginated work product definitions")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -633,7 +640,7 @@ func (h *PerformanceMgtHandler) GetConsolidatedObjectives(w http.ResponseWriter,
 	result, err := h.svc.Performance.GetConsolidatedObjectives(r.Context())
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetConsolidatedObjectives").Msg("failed to get consolidated objectives")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -695,7 +702,7 @@ func (h *PerformanceMgtHandler) GetConsolidatedObjectivesPaginated(w http.Respon
 	result, err := h.svc.Performance.GetConsolidatedObjectivesPaginated(r.Context(), params)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetConsolidatedObjectivesPaginated").Msg("failed to get paginated consolidated objectives")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -722,7 +729,7 @@ func (h *PerformanceMgtHandler) CreateStrategy(w http.ResponseWriter, r *http.Re
 	result, err := h.svc.Performance.CreateStrategy(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "CreateStrategy").Msg("failed to create strategy")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.Created(w, result)
@@ -745,7 +752,7 @@ func (h *PerformanceMgtHandler) UpdateStrategy(w http.ResponseWriter, r *http.Re
 	result, err := h.svc.Performance.UpdateStrategy(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "UpdateStrategy").Msg("failed to update strategy")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -768,7 +775,7 @@ func (h *PerformanceMgtHandler) CreateStrategicTheme(w http.ResponseWriter, r *h
 	result, err := h.svc.Performance.CreateStrategicTheme(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "CreateStrategicTheme").Msg("failed to create strategic theme")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.Created(w, result)
@@ -791,30 +798,185 @@ func (h *PerformanceMgtHandler) UpdateStrategicTheme(w http.ResponseWriter, r *h
 	result, err := h.svc.Performance.UpdateStrategicTheme(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "UpdateStrategicTheme").Msg("failed to update strategic theme")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
 }
 
-// UploadObjectives handles POST /api/v1/performance/objectives/upload
-// Mirrors .NET UploadObjectives — bulk upload of cascaded objectives.
+// UploadObjectives handles POST /api/v1/performance/objectives/upload?strategyId=&dryRun=&skipInvalid=
+// Mirrors .NET UploadObjectives — bulk upload of cascaded objectives. The body
+// is either a JSON array of rows or multipart/form-data with an .xlsx or .csv
+// file, laid out like the upload template, in the "file" part. Every row is
+// validated first: dryRun=true returns the per-row report without importing,
+// and skipInvalid=true imports the valid rows even when others fail.
 func (h *PerformanceMgtHandler) UploadObjectives(w http.ResponseWriter, r *http.Request) {
-	var req []CascadedObjectiveUploadRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		response.Error(w, http.StatusBadRequest, "Invalid request body")
-		return
+	q := r.URL.Query()
+	req := performance.ObjectiveImportRequestModel{
+		StrategyID: q.Get("strategyId"),
+		CreatedBy:  h.svc.UserContext.GetUserID(r.Context()),
+	}
+	for name, dst := range map[string]*bool{"dryRun": &req.DryRun, "skipInvalid": &req.SkipInvalid} {
+		if v := q.Get(name); v != "" {
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				response.Error(w, http.StatusBadRequest, "Invalid "+name)
+				return
+			}
+			*dst = b
+		}
 	}
 
-	result, err := h.svc.Performance.ProcessObjectivesUpload(r.Context(), req)
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType == "multipart/form-data" {
+		sheet, ok := h.readObjectiveUploadFile(w, r)
+		if !ok {
+			return
+		}
+		req.Sheet = sheet
+	} else {
+		var rows []CascadedObjectiveUploadRequest
+		if err := json.NewDecoder(r.Body).Decode(&rows); err != nil {
+			response.Error(w, http.StatusBadRequest, "Invalid request body")
+			return
+		}
+		req.Rows = make([]performance.ObjectiveImportRow, 0, len(rows))
+		for i, row := range rows {
+			req.Rows = append(req.Rows, performance.ObjectiveImportRow{
+				RowNumber: i + 1,
+				CascadedObjectiveUploadVm: performance.CascadedObjectiveUploadVm{
+					StrategyID:       row.StrategyID,
+					StrategicThemeID: row.StrategicThemeID,
+					EObjName:         row.EObjName,
+					EObjDesc:         row.EObjDesc,
+					EObjKPI:          row.EObjKPI,
+					EObjTarget:       row.EObjTarget,
+					EObjCategory:     row.EObjCategory,
+					Dept:             row.Dept,
+					DeptObjName:      row.DeptObjName,
+					DeptObjDesc:      row.DeptObjDesc,
+					DeptObjKPI:       row.DeptObjKPI,
+					DeptObjTarget:    row.DeptObjTarget,
+					Division:         row.Division,
+					DivObjName:       row.DivObjName,
+					DivObjDesc:       row.DivObjDesc,
+					DivObjKPI:        row.DivObjKPI,
+					DivObjTarget:     row.DivObjTarget,
+					Office:           row.Office,
+					OffObjName:       row.OffObjName,
+					OffObjDesc:       row.OffObjDesc,
+					OffObjKPI:        row.OffObjKPI,
+					OffObjTarget:     row.OffObjTarget,
+					JobGradeGroup:    row.JobGradeGroup,
+				},
+			})
+		}
+	}
+
+	result, err := h.svc.Performance.ImportObjectives(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "UploadObjectives").Msg("failed to upload objectives")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
 }
 
+// readObjectiveUploadFile reads the spreadsheet in the "file" part of a
+// multipart objective upload. It writes the error response itself and
+// returns false when there is no readable file.
+func (h *PerformanceMgtHandler) readObjectiveUploadFile(w http.ResponseWriter, r *http.Request) ([][]string, bool) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxUploadRequestBytes)
+	reader, err := r.MultipartReader()
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "Request must be multipart/form-data")
+		return nil, false
+	}
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			response.Error(w, http.StatusBadRequest, "Invalid multipart body")
+			return nil, false
+		}
+		if part.FormName() != "file" {
+			part.Close()
+			continue
+		}
+
+		sheet, err := excel.ReadRows(part, part.FileName())
+		part.Close()
+		if err != nil {
+			var tooLarge *http.MaxBytesError
+			switch {
+			case errors.As(err, &tooLarge):
+				response.Error(w, http.StatusRequestEntityTooLarge, "Upload is too large")
+			case errors.Is(err, excel.ErrUnsupportedFormat), errors.Is(err, excel.ErrTooManyRows):
+				response.Error(w, http.StatusBadRequest, err.Error())
+			default:
+				h.log.Warn().Err(err).Str("action", "UploadObjectives").Msg("unreadable objective upload")
+				response.Error(w, http.StatusBadRequest, "Could not read the uploaded spreadsheet")
+			}
+			return nil, false
+		}
+		return sheet, true
+	}
+	response.Error(w, http.StatusBadRequest, "A \"file\" part is required")
+	return nil, false
+}
+
+// GetObjectiveUploadTemplate handles GET /api/v1/performance/objectives/upload/template?strategyId=&format=xlsx|csv
+// Downloads the cascaded objective upload template of a strategy (the current
+// one by default). The workbook carries lookup sheets of the strategy's themes,
+// the categories, the organogram and job grade groups; the CSV is the header only.
+func (h *PerformanceMgtHandler) GetObjectiveUploadTemplate(w http.ResponseWriter, r *http.Request) {
+	format := strings.ToLower(r.URL.Query().Get("format"))
+	if format == "" {
+		format = "xlsx"
+	}
+	if format != "xlsx" && format != "csv" {
+		response.Error(w, http.StatusBadRequest, "format must be xlsx or csv")
+		return
+	}
+
+	tpl, err := h.svc.Performance.GetObjectiveUploadTemplate(r.Context(), r.URL.Query().Get("strategyId"))
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "GetObjectiveUploadTemplate").Msg("failed to build objective upload template")
+		serviceError(w, err, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var buf bytes.Buffer
+	contentType := excel.ContentTypeCSV
+	if format == "csv" {
+		err = excel.WriteCSV(&buf, tpl.Sheets[0].Rows)
+	} else {
+		contentType = excel.ContentTypeXLSX
+		sheets := make([]excel.Sheet, 0, len(tpl.Sheets))
+		for _, s := range tpl.Sheets {
+			sheets = append(sheets, excel.Sheet{Name: s.Name, Rows: s.Rows})
+		}
+		err = excel.WriteXLSX(&buf, sheets)
+	}
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "GetObjectiveUploadTemplate").Msg("failed to write objective upload template")
+		response.Error(w, http.StatusInternalServerError, "Failed to generate template")
+		return
+	}
+
+	fileName := "objectives-upload-template-" + tpl.StrategyID + "." + format
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Length", strconv.Itoa(buf.Len()))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileName}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Cache-Control", "private, no-store")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		h.log.Warn().Err(err).Msg("objective upload template download interrupted")
+	}
+}
+
 // DeActivateObjectives handles POST /api/v1/performance/objectives/deactivate
 // Mirrors .NET DeActivateObjectives.
 func (h *PerformanceMgtHandler) DeActivateObjectives(w http.ResponseWriter, r *http.Request) {
@@ -827,7 +989,7 @@ func (h *PerformanceMgtHandler) DeActivateObjectives(w http.ResponseWriter, r *h
 	result, err := h.svc.Performance.DeActivateOrReactivateObjectives(r.Context(), req, true)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "DeActivateObjectives").Msg("failed to deactivate objectives")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -845,7 +1007,7 @@ func (h *PerformanceMgtHandler) ReActivateObjectives(w http.ResponseWriter, r *h
 	result, err := h.svc.Performance.DeActivateOrReactivateObjectives(r.Context(), req, false)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ReActivateObjectives").Msg("failed to reactivate objectives")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -863,7 +1025,7 @@ func (h *PerformanceMgtHandler) SaveEvaluationOptions(w http.ResponseWriter, r *
 	result, err := h.svc.Performance.SaveEvaluationOptions(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "SaveEvaluationOptions").Msg("failed to save evaluation options")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -881,7 +1043,7 @@ func (h *PerformanceMgtHandler) SaveFeedbackQuestionnaires(w http.ResponseWriter
 	result, err := h.svc.Performance.SaveFeedbackQuestionnaires(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "SaveFeedbackQuestionnaires").Msg("failed to save feedback questionnaires")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -899,7 +1061,7 @@ func (h *PerformanceMgtHandler) SaveWorkProductDefinition(w http.ResponseWriter,
 	result, err := h.svc.Performance.SaveWorkProductDefinitions(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "SaveWorkProductDefinition").Msg("failed to save work product definitions")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -917,7 +1079,7 @@ func (h *PerformanceMgtHandler) SaveFeedbackQuestionnaireOptions(w http.Response
 	result, err := h.svc.Performance.SaveFeedbackQuestionnaireOptions(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "SaveFeedbackQuestionnaireOptions").Msg("failed to save feedback questionnaire options")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -940,7 +1102,7 @@ func (h *PerformanceMgtHandler) CreateEnterpriseObjective(w http.ResponseWriter,
 	result, err := h.svc.Performance.CreateEnterpriseObjective(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "CreateEnterpriseObjective").Msg("failed to create enterprise objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.Created(w, result)
@@ -963,7 +1125,7 @@ func (h *PerformanceMgtHandler) UpdateEnterpriseObjective(w http.ResponseWriter,
 	result, err := h.svc.Performance.UpdateEnterpriseObjective(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "UpdateEnterpriseObjective").Msg("failed to update enterprise objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -986,7 +1148,7 @@ func (h *PerformanceMgtHandler) CreatePmsCompetency(w http.ResponseWriter, r *ht
 	result, err := h.svc.Performance.CreatePmsCompetency(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "CreatePmsCompetency").Msg("failed to create pms competency")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.Created(w, result)
@@ -1009,7 +1171,7 @@ func (h *PerformanceMgtHandler) UpdatePmsCompetency(w http.ResponseWriter, r *ht
 	result, err := h.svc.Performance.UpdatePmsCompetency(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "UpdatePmsCompetency").Msg("failed to update pms competency")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1032,7 +1194,7 @@ func (h *PerformanceMgtHandler) CreateDepartmentObjective(w http.ResponseWriter,
 	result, err := h.svc.Performance.CreateDepartmentObjective(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "CreateDepartmentObjective").Msg("failed to create department objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.Created(w, result)
@@ -1055,7 +1217,7 @@ func (h *PerformanceMgtHandler) UpdateDepartmentObjective(w http.ResponseWriter,
 	result, err := h.svc.Performance.UpdateDepartmentObjective(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "UpdateDepartmentObjective").Msg("failed to update department objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1078,7 +1240,7 @@ func (h *PerformanceMgtHandler) CreateDivisionObjective(w http.ResponseWriter, r
 	result, err := h.svc.Performance.CreateDivisionObjective(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "CreateDivisionObjective").Msg("failed to create division objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.Created(w, result)
@@ -1101,7 +1263,7 @@ func (h *PerformanceMgtHandler) UpdateDivisionObjective(w http.ResponseWriter, r
 	result, err := h.svc.Performance.UpdateDivisionObjective(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "UpdateDivisionObjective").Msg("failed to update division objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1124,7 +1286,7 @@ func (h *PerformanceMgtHandler) CreateOfficeObjective(w http.ResponseWriter, r *
 	result, err := h.svc.Performance.CreateOfficeObjective(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "CreateOfficeObjective").Msg("failed to create office objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.Created(w, result)
@@ -1147,7 +1309,7 @@ func (h *PerformanceMgtHandler) UpdateOfficeObjective(w http.ResponseWriter, r *
 	result, err := h.svc.Performance.UpdateOfficeObjective(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "UpdateOfficeObjective").Msg("failed to update office objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1170,7 +1332,7 @@ func (h *PerformanceMgtHandler) CreateObjectiveCategory(w http.ResponseWriter, r
 	result, err := h.svc.Performance.CreateObjectiveCategory(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "CreateObjectiveCategory").Msg("failed to create objective category")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.Created(w, result)
@@ -1193,7 +1355,7 @@ func (h *PerformanceMgtHandler) UpdateObjectiveCategory(w http.ResponseWriter, r
 	result, err := h.svc.Performance.UpdateObjectiveCategory(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "UpdateObjectiveCategory").Msg("failed to update objective category")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1216,7 +1378,7 @@ func (h *PerformanceMgtHandler) CreateCategoryDefinition(w http.ResponseWriter,
 	result, err := h.svc.Performance.CreateCategoryDefinition(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "CreateCategoryDefinition").Msg("failed to create category definition")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.Created(w, result)
@@ -1239,7 +1401,7 @@ func (h *PerformanceMgtHandler) UpdateCategoryDefinition(w http.ResponseWriter,
 	result, err := h.svc.Performance.UpdateCategoryDefinition(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "UpdateCategoryDefinition").Msg("failed to update category definition")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1266,7 +1428,7 @@ func (h *PerformanceMgtHandler) ApproveRecords(w http.ResponseWriter, r *http.Re
 	result, err := h.svc.Performance.ApproveRecords(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ApproveRecords").Msg("failed to approve records")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1289,7 +1451,7 @@ func (h *PerformanceMgtHandler) RejectRecords(w http.ResponseWriter, r *http.Req
 	result, err := h.svc.Performance.RejectRecords(r.Context(), req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "RejectRecords").Msg("failed to reject records")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
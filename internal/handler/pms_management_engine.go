@@ -7,7 +7,6 @@ import (
 
 	"github.com/enterprise-pms/pms-api/internal/domain/enums"
 	"github.com/enterprise-pms/pms-api/internal/domain/performance"
-	"github.com/enterprise-pms/pms-api/internal/middleware"
 	"github.com/enterprise-pms/pms-api/internal/service"
 	"github.com/enterprise-pms/pms-api/pkg/response"
 	"github.com/rs/zerolog"
@@ -305,7 +304,7 @@ func (h *PmsEngineHandler) SaveDraftProject(w http.ResponseWriter, r *http.Reque
 	result, err := h.svc.Performance.ProjectSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "SaveDraftProject").Msg("Failed to save draft project")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -323,7 +322,7 @@ func (h *PmsEngineHandler) AddProject(w http.ResponseWriter, r *http.Request) {
 	result, err := h.svc.Performance.ProjectSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "AddProject").Msg("Failed to add project")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.Created(w, result)
@@ -341,7 +340,7 @@ func (h *PmsEngineHandler) SubmitDraftProject(w http.ResponseWriter, r *http.Req
 	result, err := h.svc.Performance.ProjectSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "SubmitDraftProject").Msg("Failed to submit draft project")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -358,7 +357,7 @@ func (h *PmsEngineHandler) ApproveProject(w http.ResponseWriter, r *http.Request
 	result, err := h.svc.Performance.ProjectSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ApproveProject").Msg("Failed to approve project")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -375,7 +374,7 @@ func (h *PmsEngineHandler) RejectProject(w http.ResponseWriter, r *http.Request)
 	result, err := h.svc.Performance.ProjectSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "RejectProject").Msg("Failed to reject project")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -392,7 +391,7 @@ func (h *PmsEngineHandler) ReturnProject(w http.ResponseWriter, r *http.Request)
 	result, err := h.svc.Performance.ProjectSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ReturnProject").Msg("Failed to return project")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -409,7 +408,7 @@ func (h *PmsEngineHandler) ReSubmitProject(w http.ResponseWriter, r *http.Reques
 	result, err := h.svc.Performance.ProjectSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ReSubmitProject").Msg("Failed to resubmit project")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -426,7 +425,7 @@ func (h *PmsEngineHandler) UpdateProject(w http.ResponseWriter, r *http.Request)
 	result, err := h.svc.Performance.ProjectSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "UpdateProject").Msg("Failed to update project")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -443,7 +442,7 @@ func (h *PmsEngineHandler) CancelProject(w http.ResponseWriter, r *http.Request)
 	result, err := h.svc.Performance.ProjectSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "CancelProject").Msg("Failed to cancel project")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -459,7 +458,7 @@ func (h *PmsEngineHandler) GetProjects(w http.ResponseWriter, r *http.Request) {
 		result, err := h.svc.Performance.GetProjectsByManager(r.Context(), staffID)
 		if err != nil {
 			h.log.Error().Err(err).Str("action", "GetProjects").Str("staffId", staffID).Msg("Failed to get projects by manager")
-			response.Error(w, http.StatusBadRequest, err.Error())
+			serviceError(w, err, http.StatusBadRequest, err.Error())
 			return
 		}
 		response.OK(w, result)
@@ -469,7 +468,7 @@ func (h *PmsEngineHandler) GetProjects(w http.ResponseWriter, r *http.Request) {
 	result, err := h.svc.Performance.GetProjects(r.Context())
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetProjects").Msg("Failed to get projects")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -487,7 +486,7 @@ func (h *PmsEngineHandler) GetProjectDetails(w http.ResponseWriter, r *http.Requ
 	result, err := h.svc.Performance.GetProject(r.Context(), projectID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetProjectDetails").Str("projectId", projectID).Msg("Failed to get project details")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -503,7 +502,7 @@ func (h *PmsEngineHandler) AddProjectObjective(w http.ResponseWriter, r *http.Re
 	result, err := h.svc.Performance.ProjectObjectiveSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "AddProjectObjective").Msg("Failed to add project objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.Created(w, result)
@@ -520,7 +519,7 @@ func (h *PmsEngineHandler) AddProjectMember(w http.ResponseWriter, r *http.Reque
 	result, err := h.svc.Performance.ProjectMembersSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "AddProjectMember").Msg("Failed to add project member")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.Created(w, result)
@@ -538,7 +537,7 @@ func (h *PmsEngineHandler) GetProjectMembers(w http.ResponseWriter, r *http.Requ
 	result, err := h.svc.Performance.GetProjectMembers(r.Context(), projectID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetProjectMembers").Str("projectId", projectID).Msg("Failed to get project members")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -556,7 +555,7 @@ func (h *PmsEngineHandler) GetProjectObjectives(w http.ResponseWriter, r *http.R
 	result, err := h.svc.Performance.GetProjectObjectives(r.Context(), projectID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetProjectObjectives").Str("projectId", projectID).Msg("Failed to get project objectives")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -573,7 +572,7 @@ func (h *PmsEngineHandler) CloseProject(w http.ResponseWriter, r *http.Request)
 	result, err := h.svc.Performance.ProjectSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "CloseProject").Msg("Failed to close project")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -590,7 +589,7 @@ func (h *PmsEngineHandler) PauseProject(w http.ResponseWriter, r *http.Request)
 	result, err := h.svc.Performance.ProjectSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "PauseProject").Msg("Failed to pause project")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -607,7 +606,7 @@ func (h *PmsEngineHandler) GetProjectsByManager(w http.ResponseWriter, r *http.R
 	result, err := h.svc.Performance.GetProjectsByManager(r.Context(), managerID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetProjectsByManager").Str("managerId", managerID).Msg("Failed to get projects by manager")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -624,7 +623,7 @@ func (h *PmsEngineHandler) GetProjectsAssigned(w http.ResponseWriter, r *http.Re
 	result, err := h.svc.Performance.GetProjectsAssigned(r.Context(), staffID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetProjectsAssigned").Str("staffId", staffID).Msg("Failed to get assigned projects")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -641,7 +640,7 @@ func (h *PmsEngineHandler) GetStaffProjects(w http.ResponseWriter, r *http.Reque
 	result, err := h.svc.Performance.GetStaffProjects(r.Context(), staffID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetStaffProjects").Str("staffId", staffID).Msg("Failed to get staff projects")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -659,7 +658,7 @@ func (h *PmsEngineHandler) GetProjectWorkProductStaffList(w http.ResponseWriter,
 	result, err := h.svc.Performance.GetProjectWorkProductStaffList(r.Context(), projectID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetProjectWorkProductStaffList").Str("projectId", projectID).Msg("Failed to get project work product staff list")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -676,7 +675,7 @@ func (h *PmsEngineHandler) SaveDraftProjectMember(w http.ResponseWriter, r *http
 	result, err := h.svc.Performance.ProjectMembersSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "SaveDraftProjectMember").Msg("Failed to save draft project member")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -693,7 +692,7 @@ func (h *PmsEngineHandler) SubmitDraftProjectMember(w http.ResponseWriter, r *ht
 	result, err := h.svc.Performance.ProjectMembersSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "SubmitDraftProjectMember").Msg("Failed to submit draft project member")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -710,7 +709,7 @@ func (h *PmsEngineHandler) AcceptProjectMember(w http.ResponseWriter, r *http.Re
 	result, err := h.svc.Performance.ProjectMembersSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "AcceptProjectMember").Msg("Failed to accept project member")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -727,7 +726,7 @@ func (h *PmsEngineHandler) ApproveProjectMember(w http.ResponseWriter, r *http.R
 	result, err := h.svc.Performance.ProjectMembersSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ApproveProjectMember").Msg("Failed to approve project member")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -744,7 +743,7 @@ func (h *PmsEngineHandler) CancelProjectMember(w http.ResponseWriter, r *http.Re
 	result, err := h.svc.Performance.ProjectMembersSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "CancelProjectMember").Msg("Failed to cancel project member")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -761,7 +760,7 @@ func (h *PmsEngineHandler) CancelProjectObjective(w http.ResponseWriter, r *http
 	result, err := h.svc.Performance.ProjectObjectiveSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "CancelProjectObjective").Msg("Failed to cancel project objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -776,7 +775,7 @@ func (h *PmsEngineHandler) ChangeAdhocAssignmentLead(w http.ResponseWriter, r *h
 	}
 	if err := h.svc.Performance.ChangeProjectLead(r.Context(), &req); err != nil {
 		h.log.Error().Err(err).Str("action", "ChangeAdhocAssignmentLead").Msg("Failed to change project lead")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, map[string]string{"message": "Project lead changed successfully"})
@@ -797,7 +796,7 @@ func (h *PmsEngineHandler) ValidateStaffEligibilityForAdhoc(w http.ResponseWrite
 	result, err := h.svc.Performance.ValidateStaffEligibilityForAdhoc(r.Context(), staffID, reviewPeriodID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ValidateStaffEligibilityForAdhoc").Str("staffId", staffID).Msg("Failed to validate staff eligibility")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -816,7 +815,7 @@ func (h *PmsEngineHandler) SaveDraftCommittee(w http.ResponseWriter, r *http.Req
 	result, err := h.svc.Performance.CommitteeSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "SaveDraftCommittee").Msg("Failed to save draft committee")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -833,7 +832,7 @@ func (h *PmsEngineHandler) AddCommittee(w http.ResponseWriter, r *http.Request)
 	result, err := h.svc.Performance.CommitteeSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "AddCommittee").Msg("Failed to add committee")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.Created(w, result)
@@ -850,7 +849,7 @@ func (h *PmsEngineHandler) SubmitDraftCommittee(w http.ResponseWriter, r *http.R
 	result, err := h.svc.Performance.CommitteeSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "SubmitDraftCommittee").Msg("Failed to submit draft committee")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -867,7 +866,7 @@ func (h *PmsEngineHandler) ApproveCommittee(w http.ResponseWriter, r *http.Reque
 	result, err := h.svc.Performance.CommitteeSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ApproveCommittee").Msg("Failed to approve committee")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -884,7 +883,7 @@ func (h *PmsEngineHandler) RejectCommittee(w http.ResponseWriter, r *http.Reques
 	result, err := h.svc.Performance.CommitteeSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "RejectCommittee").Msg("Failed to reject committee")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -901,7 +900,7 @@ func (h *PmsEngineHandler) ReturnCommittee(w http.ResponseWriter, r *http.Reques
 	result, err := h.svc.Performance.CommitteeSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ReturnCommittee").Msg("Failed to return committee")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -918,7 +917,7 @@ func (h *PmsEngineHandler) ReSubmitCommittee(w http.ResponseWriter, r *http.Requ
 	result, err := h.svc.Performance.CommitteeSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ReSubmitCommittee").Msg("Failed to resubmit committee")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -935,7 +934,7 @@ func (h *PmsEngineHandler) UpdateCommittee(w http.ResponseWriter, r *http.Reques
 	result, err := h.svc.Performance.CommitteeSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "UpdateCommittee").Msg("Failed to update committee")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -952,7 +951,7 @@ func (h *PmsEngineHandler) CancelCommittee(w http.ResponseWriter, r *http.Reques
 	result, err := h.svc.Performance.CommitteeSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "CancelCommittee").Msg("Failed to cancel committee")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -968,7 +967,7 @@ func (h *PmsEngineHandler) GetCommittees(w http.ResponseWriter, r *http.Request)
 		result, err := h.svc.Performance.GetCommitteesByChairperson(r.Context(), chairpersonID)
 		if err != nil {
 			h.log.Error().Err(err).Str("action", "GetCommittees").Str("chairpersonId", chairpersonID).Msg("Failed to get committees by chairperson")
-			response.Error(w, http.StatusBadRequest, err.Error())
+			serviceError(w, err, http.StatusBadRequest, err.Error())
 			return
 		}
 		response.OK(w, result)
@@ -978,7 +977,7 @@ func (h *PmsEngineHandler) GetCommittees(w http.ResponseWriter, r *http.Request)
 	result, err := h.svc.Performance.GetCommittees(r.Context())
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetCommittees").Msg("Failed to get committees")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -996,7 +995,7 @@ func (h *PmsEngineHandler) GetCommitteeDetails(w http.ResponseWriter, r *http.Re
 	result, err := h.svc.Performance.GetCommittee(r.Context(), committeeID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetCommitteeDetails").Str("committeeId", committeeID).Msg("Failed to get committee details")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1013,7 +1012,7 @@ func (h *PmsEngineHandler) AddCommitteeMember(w http.ResponseWriter, r *http.Req
 	result, err := h.svc.Performance.CommitteeMembersSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "AddCommitteeMember").Msg("Failed to add committee member")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.Created(w, result)
@@ -1029,7 +1028,7 @@ func (h *PmsEngineHandler) AddCommitteeObjective(w http.ResponseWriter, r *http.
 	result, err := h.svc.Performance.CommitteeObjectiveSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "AddCommitteeObjective").Msg("Failed to add committee objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.Created(w, result)
@@ -1046,7 +1045,7 @@ func (h *PmsEngineHandler) CloseCommittee(w http.ResponseWriter, r *http.Request
 	result, err := h.svc.Performance.CommitteeSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "CloseCommittee").Msg("Failed to close committee")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1063,7 +1062,7 @@ func (h *PmsEngineHandler) PauseCommittee(w http.ResponseWriter, r *http.Request
 	result, err := h.svc.Performance.CommitteeSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "PauseCommittee").Msg("Failed to pause committee")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1080,7 +1079,7 @@ func (h *PmsEngineHandler) GetCommitteesByChairperson(w http.ResponseWriter, r *
 	result, err := h.svc.Performance.GetCommitteesByChairperson(r.Context(), chairpersonID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetCommitteesByChairperson").Str("chairpersonId", chairpersonID).Msg("Failed to get committees by chairperson")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1098,7 +1097,7 @@ func (h *PmsEngineHandler) GetCommitteeMembers(w http.ResponseWriter, r *http.Re
 	result, err := h.svc.Performance.GetCommitteeMembers(r.Context(), committeeID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetCommitteeMembers").Str("committeeId", committeeID).Msg("Failed to get committee members")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1115,7 +1114,7 @@ func (h *PmsEngineHandler) GetCommitteesAssigned(w http.ResponseWriter, r *http.
 	result, err := h.svc.Performance.GetCommitteesAssigned(r.Context(), staffID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetCommitteesAssigned").Str("staffId", staffID).Msg("Failed to get assigned committees")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1132,7 +1131,7 @@ func (h *PmsEngineHandler) GetStaffCommittees(w http.ResponseWriter, r *http.Req
 	result, err := h.svc.Performance.GetStaffCommittees(r.Context(), staffID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetStaffCommittees").Str("staffId", staffID).Msg("Failed to get staff committees")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1150,7 +1149,7 @@ func (h *PmsEngineHandler) GetCommitteeWorkProductStaffList(w http.ResponseWrite
 	result, err := h.svc.Performance.GetCommitteeWorkProductStaffList(r.Context(), committeeID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetCommitteeWorkProductStaffList").Str("committeeId", committeeID).Msg("Failed to get committee work product staff list")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1168,7 +1167,7 @@ func (h *PmsEngineHandler) GetCommitteeObjectives(w http.ResponseWriter, r *http
 	result, err := h.svc.Performance.GetCommitteeObjectives(r.Context(), committeeID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetCommitteeObjectives").Str("committeeId", committeeID).Msg("Failed to get committee objectives")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1185,7 +1184,7 @@ func (h *PmsEngineHandler) SaveDraftCommitteeMember(w http.ResponseWriter, r *ht
 	result, err := h.svc.Performance.CommitteeMembersSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "SaveDraftCommitteeMember").Msg("Failed to save draft committee member")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1202,7 +1201,7 @@ func (h *PmsEngineHandler) SubmitDraftCommitteeMember(w http.ResponseWriter, r *
 	result, err := h.svc.Performance.CommitteeMembersSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "SubmitDraftCommitteeMember").Msg("Failed to submit draft committee member")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1219,7 +1218,7 @@ func (h *PmsEngineHandler) CancelCommitteeMember(w http.ResponseWriter, r *http.
 	result, err := h.svc.Performance.CommitteeMembersSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "CancelCommitteeMember").Msg("Failed to cancel committee member")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1236,7 +1235,7 @@ func (h *PmsEngineHandler) CancelCommitteeObjective(w http.ResponseWriter, r *ht
 	result, err := h.svc.Performance.CommitteeObjectiveSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "CancelCommitteeObjective").Msg("Failed to cancel committee objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1251,7 +1250,7 @@ func (h *PmsEngineHandler) ChangeCommitteeChairperson(w http.ResponseWriter, r *
 	}
 	if err := h.svc.Performance.ChangeCommitteeChairperson(r.Context(), &req); err != nil {
 		h.log.Error().Err(err).Str("action", "ChangeCommitteeChairperson").Msg("Failed to change committee chairperson")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, map[string]string{"message": "Committee chairperson changed successfully"})
@@ -1270,7 +1269,7 @@ func (h *PmsEngineHandler) SaveDraftWorkProduct(w http.ResponseWriter, r *http.R
 	result, err := h.svc.Performance.WorkProductSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "SaveDraftWorkProduct").Msg("Failed to save draft work product")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1287,7 +1286,7 @@ func (h *PmsEngineHandler) AddWorkProduct(w http.ResponseWriter, r *http.Request
 	result, err := h.svc.Performance.WorkProductSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "AddWorkProduct").Msg("Failed to add work product")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.Created(w, result)
@@ -1304,7 +1303,7 @@ func (h *PmsEngineHandler) SubmitDraftWorkProduct(w http.ResponseWriter, r *http
 	result, err := h.svc.Performance.WorkProductSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "SubmitDraftWorkProduct").Msg("Failed to submit draft work product")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1321,7 +1320,7 @@ func (h *PmsEngineHandler) ApproveWorkProduct(w http.ResponseWriter, r *http.Req
 	result, err := h.svc.Performance.WorkProductSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ApproveWorkProduct").Msg("Failed to approve work product")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1338,7 +1337,7 @@ func (h *PmsEngineHandler) RejectWorkProduct(w http.ResponseWriter, r *http.Requ
 	result, err := h.svc.Performance.WorkProductSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "RejectWorkProduct").Msg("Failed to reject work product")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1355,7 +1354,7 @@ func (h *PmsEngineHandler) ReturnWorkProduct(w http.ResponseWriter, r *http.Requ
 	result, err := h.svc.Performance.WorkProductSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ReturnWorkProduct").Msg("Failed to return work product")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1372,7 +1371,7 @@ func (h *PmsEngineHandler) ReSubmitWorkProduct(w http.ResponseWriter, r *http.Re
 	result, err := h.svc.Performance.WorkProductSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ReSubmitWorkProduct").Msg("Failed to resubmit work product")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1389,7 +1388,7 @@ func (h *PmsEngineHandler) UpdateWorkProduct(w http.ResponseWriter, r *http.Requ
 	result, err := h.svc.Performance.WorkProductSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "UpdateWorkProduct").Msg("Failed to update work product")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1406,7 +1405,7 @@ func (h *PmsEngineHandler) CancelWorkProduct(w http.ResponseWriter, r *http.Requ
 	result, err := h.svc.Performance.WorkProductSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "CancelWorkProduct").Msg("Failed to cancel work product")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1423,7 +1422,7 @@ func (h *PmsEngineHandler) PauseWorkProduct(w http.ResponseWriter, r *http.Reque
 	result, err := h.svc.Performance.WorkProductSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "PauseWorkProduct").Msg("Failed to pause work product")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1440,7 +1439,7 @@ func (h *PmsEngineHandler) ResumeWorkProduct(w http.ResponseWriter, r *http.Requ
 	result, err := h.svc.Performance.WorkProductSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ResumeWorkProduct").Msg("Failed to resume work product")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1460,7 +1459,7 @@ func (h *PmsEngineHandler) GetStaffWorkProducts(w http.ResponseWriter, r *http.R
 		result, err := h.svc.Performance.GetStaffWorkProducts(r.Context(), staffID, reviewPeriodID)
 		if err != nil {
 			h.log.Error().Err(err).Str("action", "GetStaffWorkProducts").Str("staffId", staffID).Msg("Failed to get staff work products")
-			response.Error(w, http.StatusBadRequest, err.Error())
+			serviceError(w, err, http.StatusBadRequest, err.Error())
 			return
 		}
 		response.OK(w, result)
@@ -1470,7 +1469,7 @@ func (h *PmsEngineHandler) GetStaffWorkProducts(w http.ResponseWriter, r *http.R
 	result, err := h.svc.Performance.GetAllStaffWorkProducts(r.Context(), staffID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetStaffWorkProducts").Str("staffId", staffID).Msg("Failed to get all staff work products")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1488,7 +1487,7 @@ func (h *PmsEngineHandler) GetWorkProductDetails(w http.ResponseWriter, r *http.
 	result, err := h.svc.Performance.GetWorkProduct(r.Context(), workProductID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetWorkProductDetails").Str("workProductId", workProductID).Msg("Failed to get work product details")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1504,7 +1503,7 @@ func (h *PmsEngineHandler) AssignWorkProduct(w http.ResponseWriter, r *http.Requ
 	result, err := h.svc.Performance.ProjectAssignedWorkProductSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "AssignWorkProduct").Msg("Failed to assign work product")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1521,7 +1520,7 @@ func (h *PmsEngineHandler) GetAssignedWorkProducts(w http.ResponseWriter, r *htt
 	result, err := h.svc.Performance.GetProjectsAssigned(r.Context(), staffID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetAssignedWorkProducts").Str("staffId", staffID).Msg("Failed to get assigned work products")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1537,7 +1536,7 @@ func (h *PmsEngineHandler) EvaluateWorkProduct(w http.ResponseWriter, r *http.Re
 	result, err := h.svc.Performance.WorkProductEvaluation(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "EvaluateWorkProduct").Msg("Failed to evaluate work product")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1554,7 +1553,7 @@ func (h *PmsEngineHandler) CompleteWorkProduct(w http.ResponseWriter, r *http.Re
 	result, err := h.svc.Performance.WorkProductSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "CompleteWorkProduct").Msg("Failed to complete work product")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1571,7 +1570,7 @@ func (h *PmsEngineHandler) SuspendWorkProduct(w http.ResponseWriter, r *http.Req
 	result, err := h.svc.Performance.WorkProductSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "SuspendWorkProduct").Msg("Failed to suspend work product")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1588,7 +1587,7 @@ func (h *PmsEngineHandler) ReInstateWorkProduct(w http.ResponseWriter, r *http.R
 	result, err := h.svc.Performance.WorkProductSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ReInstateWorkProduct").Msg("Failed to reinstate work product")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1607,7 +1606,7 @@ func (h *PmsEngineHandler) SaveDraftProjectWorkProduct(w http.ResponseWriter, r
 	result, err := h.svc.Performance.ProjectAssignedWorkProductSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "SaveDraftProjectWorkProduct").Msg("Failed to save draft project work product")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1624,7 +1623,7 @@ func (h *PmsEngineHandler) AddProjectWorkProduct(w http.ResponseWriter, r *http.
 	result, err := h.svc.Performance.ProjectAssignedWorkProductSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "AddProjectWorkProduct").Msg("Failed to add project work product")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.Created(w, result)
@@ -1641,7 +1640,7 @@ func (h *PmsEngineHandler) SubmitDraftProjectWorkProduct(w http.ResponseWriter,
 	result, err := h.svc.Performance.ProjectAssignedWorkProductSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "SubmitDraftProjectWorkProduct").Msg("Failed to submit draft project work product")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1658,7 +1657,7 @@ func (h *PmsEngineHandler) ApproveProjectWorkProduct(w http.ResponseWriter, r *h
 	result, err := h.svc.Performance.ProjectAssignedWorkProductSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ApproveProjectWorkProduct").Msg("Failed to approve project work product")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1675,7 +1674,7 @@ func (h *PmsEngineHandler) RejectProjectWorkProduct(w http.ResponseWriter, r *ht
 	result, err := h.svc.Performance.ProjectAssignedWorkProductSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "RejectProjectWorkProduct").Msg("Failed to reject project work product")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1692,7 +1691,7 @@ func (h *PmsEngineHandler) ReturnProjectWorkProduct(w http.ResponseWriter, r *ht
 	result, err := h.svc.Performance.ProjectAssignedWorkProductSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ReturnProjectWorkProduct").Msg("Failed to return project work product")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1709,7 +1708,7 @@ func (h *PmsEngineHandler) ReSubmitProjectWorkProduct(w http.ResponseWriter, r *
 	result, err := h.svc.Performance.ProjectAssignedWorkProductSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ReSubmitProjectWorkProduct").Msg("Failed to resubmit project work product")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1726,7 +1725,7 @@ func (h *PmsEngineHandler) CancelProjectWorkProduct(w http.ResponseWriter, r *ht
 	result, err := h.svc.Performance.ProjectAssignedWorkProductSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "CancelProjectWorkProduct").Msg("Failed to cancel project work product")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1743,7 +1742,7 @@ func (h *PmsEngineHandler) CloseProjectWorkProduct(w http.ResponseWriter, r *htt
 	result, err := h.svc.Performance.ProjectAssignedWorkProductSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "CloseProjectWorkProduct").Msg("Failed to close project work product")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1762,7 +1761,7 @@ func (h *PmsEngineHandler) SaveDraftCommitteeWorkProduct(w http.ResponseWriter,
 	result, err := h.svc.Performance.CommitteeAssignedWorkProductSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "SaveDraftCommitteeWorkProduct").Msg("Failed to save draft committee work product")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1779,7 +1778,7 @@ func (h *PmsEngineHandler) AddCommitteeWorkProduct(w http.ResponseWriter, r *htt
 	result, err := h.svc.Performance.CommitteeAssignedWorkProductSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "AddCommitteeWorkProduct").Msg("Failed to add committee work product")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.Created(w, result)
@@ -1796,7 +1795,7 @@ func (h *PmsEngineHandler) SubmitDraftCommitteeWorkProduct(w http.ResponseWriter
 	result, err := h.svc.Performance.CommitteeAssignedWorkProductSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "SubmitDraftCommitteeWorkProduct").Msg("Failed to submit draft committee work product")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1813,7 +1812,7 @@ func (h *PmsEngineHandler) ApproveCommitteeWorkProduct(w http.ResponseWriter, r
 	result, err := h.svc.Performance.CommitteeAssignedWorkProductSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ApproveCommitteeWorkProduct").Msg("Failed to approve committee work product")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1830,7 +1829,7 @@ func (h *PmsEngineHandler) RejectCommitteeWorkProduct(w http.ResponseWriter, r *
 	result, err := h.svc.Performance.CommitteeAssignedWorkProductSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "RejectCommitteeWorkProduct").Msg("Failed to reject committee work product")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1847,7 +1846,7 @@ func (h *PmsEngineHandler) ReturnCommitteeWorkProduct(w http.ResponseWriter, r *
 	result, err := h.svc.Performance.CommitteeAssignedWorkProductSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ReturnCommitteeWorkProduct").Msg("Failed to return committee work product")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1864,7 +1863,7 @@ func (h *PmsEngineHandler) ReSubmitCommitteeWorkProduct(w http.ResponseWriter, r
 	result, err := h.svc.Performance.CommitteeAssignedWorkProductSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ReSubmitCommitteeWorkProduct").Msg("Failed to resubmit committee work product")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1881,7 +1880,7 @@ func (h *PmsEngineHandler) CancelCommitteeWorkProduct(w http.ResponseWriter, r *
 	result, err := h.svc.Performance.CommitteeAssignedWorkProductSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "CancelCommitteeWorkProduct").Msg("Failed to cancel committee work product")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1898,7 +1897,7 @@ func (h *PmsEngineHandler) CloseCommitteeWorkProduct(w http.ResponseWriter, r *h
 	result, err := h.svc.Performance.CommitteeAssignedWorkProductSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "CloseCommitteeWorkProduct").Msg("Failed to close committee work product")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1918,7 +1917,7 @@ func (h *PmsEngineHandler) GetProjectAssignedWorkProductDetails(w http.ResponseW
 	result, err := h.svc.Performance.GetWorkProduct(r.Context(), id)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetProjectAssignedWorkProductDetails").Str("id", id).Msg("Failed to get project assigned work product details")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1935,7 +1934,7 @@ func (h *PmsEngineHandler) GetProjectAssignedWorkProducts(w http.ResponseWriter,
 	result, err := h.svc.Performance.GetProjectAssignedWorkProducts(r.Context(), projectID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetProjectAssignedWorkProducts").Str("projectId", projectID).Msg("Failed to get project assigned work products")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1952,7 +1951,7 @@ func (h *PmsEngineHandler) GetProjectWorkProduct(w http.ResponseWriter, r *http.
 	result, err := h.svc.Performance.GetWorkProduct(r.Context(), workProductID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetProjectWorkProduct").Str("workProductId", workProductID).Msg("Failed to get project work product")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1969,7 +1968,7 @@ func (h *PmsEngineHandler) GetAllProjectWorkProducts(w http.ResponseWriter, r *h
 	result, err := h.svc.Performance.GetProjectWorkProducts(r.Context(), projectID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetAllProjectWorkProducts").Str("projectId", projectID).Msg("Failed to get all project work products")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -1986,7 +1985,7 @@ func (h *PmsEngineHandler) GetStaffProjectWorkProducts(w http.ResponseWriter, r
 	result, err := h.svc.Performance.GetProjectWorkProducts(r.Context(), projectID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetStaffProjectWorkProducts").Str("projectId", projectID).Msg("Failed to get staff project work products")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2004,7 +2003,7 @@ func (h *PmsEngineHandler) GetCommitteeAssignedWorkProductDetails(w http.Respons
 	result, err := h.svc.Performance.GetWorkProduct(r.Context(), id)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetCommitteeAssignedWorkProductDetails").Str("id", id).Msg("Failed to get committee assigned work product details")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2021,7 +2020,7 @@ func (h *PmsEngineHandler) GetCommitteeAssignedWorkProducts(w http.ResponseWrite
 	result, err := h.svc.Performance.GetCommitteeAssignedWorkProducts(r.Context(), committeeID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetCommitteeAssignedWorkProducts").Str("committeeId", committeeID).Msg("Failed to get committee assigned work products")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2038,7 +2037,7 @@ func (h *PmsEngineHandler) GetCommitteeWorkProduct(w http.ResponseWriter, r *htt
 	result, err := h.svc.Performance.GetWorkProduct(r.Context(), workProductID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetCommitteeWorkProduct").Str("workProductId", workProductID).Msg("Failed to get committee work product")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2055,7 +2054,7 @@ func (h *PmsEngineHandler) GetAllCommitteeWorkProducts(w http.ResponseWriter, r
 	result, err := h.svc.Performance.GetCommitteeWorkProducts(r.Context(), committeeID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetAllCommitteeWorkProducts").Str("committeeId", committeeID).Msg("Failed to get all committee work products")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2072,7 +2071,7 @@ func (h *PmsEngineHandler) GetStaffCommitteeWorkProducts(w http.ResponseWriter,
 	result, err := h.svc.Performance.GetCommitteeWorkProducts(r.Context(), committeeID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetStaffCommitteeWorkProducts").Str("committeeId", committeeID).Msg("Failed to get staff committee work products")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2093,7 +2092,7 @@ func (h *PmsEngineHandler) GetOperationalWorkProducts(w http.ResponseWriter, r *
 	result, err := h.svc.Performance.GetStaffWorkProducts(r.Context(), staffID, reviewPeriodID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetOperationalWorkProducts").Str("staffId", staffID).Msg("Failed to get operational work products")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2110,7 +2109,7 @@ func (h *PmsEngineHandler) GetObjectiveWorkProducts(w http.ResponseWriter, r *ht
 	result, err := h.svc.Performance.GetObjectiveWorkProducts(r.Context(), objectiveID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetObjectiveWorkProducts").Str("objectiveId", objectiveID).Msg("Failed to get objective work products")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2127,7 +2126,7 @@ func (h *PmsEngineHandler) GetAllStaffWorkProducts(w http.ResponseWriter, r *htt
 	result, err := h.svc.Performance.GetAllStaffWorkProducts(r.Context(), staffID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetAllStaffWorkProducts").Str("staffId", staffID).Msg("Failed to get all staff work products")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2146,7 +2145,7 @@ func (h *PmsEngineHandler) AddWorkProductTask(w http.ResponseWriter, r *http.Req
 	result, err := h.svc.Performance.WorkProductTaskSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "AddWorkProductTask").Msg("Failed to add work product task")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.Created(w, result)
@@ -2163,7 +2162,7 @@ func (h *PmsEngineHandler) UpdateWorkProductTask(w http.ResponseWriter, r *http.
 	result, err := h.svc.Performance.WorkProductTaskSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "UpdateWorkProductTask").Msg("Failed to update work product task")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2180,7 +2179,7 @@ func (h *PmsEngineHandler) CancelWorkProductTask(w http.ResponseWriter, r *http.
 	result, err := h.svc.Performance.WorkProductTaskSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "CancelWorkProductTask").Msg("Failed to cancel work product task")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2197,7 +2196,7 @@ func (h *PmsEngineHandler) CompleteWorkProductTask(w http.ResponseWriter, r *htt
 	result, err := h.svc.Performance.WorkProductTaskSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "CompleteWorkProductTask").Msg("Failed to complete work product task")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2215,7 +2214,7 @@ func (h *PmsEngineHandler) GetWorkProductTaskDetail(w http.ResponseWriter, r *ht
 	result, err := h.svc.Performance.GetWorkProductTaskDetail(r.Context(), taskID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetWorkProductTaskDetail").Str("taskId", taskID).Msg("Failed to get work product task detail")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2232,7 +2231,7 @@ func (h *PmsEngineHandler) GetWorkProductTasks(w http.ResponseWriter, r *http.Re
 	result, err := h.svc.Performance.GetWorkProductTasks(r.Context(), workProductID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetWorkProductTasks").Str("workProductId", workProductID).Msg("Failed to get work product tasks")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2251,7 +2250,7 @@ func (h *PmsEngineHandler) AddWorkProductEvaluation(w http.ResponseWriter, r *ht
 	result, err := h.svc.Performance.WorkProductEvaluation(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "AddWorkProductEvaluation").Msg("Failed to add work product evaluation")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.Created(w, result)
@@ -2268,7 +2267,7 @@ func (h *PmsEngineHandler) UpdateWorkProductEvaluation(w http.ResponseWriter, r
 	result, err := h.svc.Performance.WorkProductEvaluation(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "UpdateWorkProductEvaluation").Msg("Failed to update work product evaluation")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2285,7 +2284,7 @@ func (h *PmsEngineHandler) GetWorkProductEvaluation(w http.ResponseWriter, r *ht
 	result, err := h.svc.Performance.GetWorkProductEvaluation(r.Context(), workProductID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetWorkProductEvaluation").Str("workProductId", workProductID).Msg("Failed to get work product evaluation")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2302,7 +2301,7 @@ func (h *PmsEngineHandler) InitiateWorkProductReEvaluation(w http.ResponseWriter
 	result, err := h.svc.Performance.InitiateWorkProductReEvaluation(r.Context(), workProductID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "InitiateWorkProductReEvaluation").Str("workProductId", workProductID).Msg("Failed to initiate re-evaluation")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2323,7 +2322,7 @@ func (h *PmsEngineHandler) ReCalculateWorkProductPoints(w http.ResponseWriter, r
 	result, err := h.svc.Performance.ReCalculateWorkProductPoints(r.Context(), staffID, reviewPeriodID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ReCalculateWorkProductPoints").Str("staffId", staffID).Msg("Failed to recalculate work product points")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2342,7 +2341,7 @@ func (h *PmsEngineHandler) SaveDraftEvaluation(w http.ResponseWriter, r *http.Re
 	result, err := h.svc.Performance.ReviewPeriodObjectiveEvaluation(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "SaveDraftEvaluation").Msg("Failed to save draft evaluation")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2359,7 +2358,7 @@ func (h *PmsEngineHandler) AddEvaluation(w http.ResponseWriter, r *http.Request)
 	result, err := h.svc.Performance.ReviewPeriodObjectiveEvaluation(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "AddEvaluation").Msg("Failed to add evaluation")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.Created(w, result)
@@ -2376,7 +2375,7 @@ func (h *PmsEngineHandler) SubmitDraftEvaluation(w http.ResponseWriter, r *http.
 	result, err := h.svc.Performance.ReviewPeriodObjectiveEvaluation(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "SubmitDraftEvaluation").Msg("Failed to submit draft evaluation")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2393,7 +2392,7 @@ func (h *PmsEngineHandler) ApproveEvaluation(w http.ResponseWriter, r *http.Requ
 	result, err := h.svc.Performance.ReviewPeriodObjectiveEvaluation(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ApproveEvaluation").Msg("Failed to approve evaluation")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2410,7 +2409,7 @@ func (h *PmsEngineHandler) RejectEvaluation(w http.ResponseWriter, r *http.Reque
 	result, err := h.svc.Performance.ReviewPeriodObjectiveEvaluation(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "RejectEvaluation").Msg("Failed to reject evaluation")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2427,7 +2426,7 @@ func (h *PmsEngineHandler) GetStaffEvaluations(w http.ResponseWriter, r *http.Re
 	result, err := h.svc.Performance.GetReviewPeriodObjectiveEvaluations(r.Context(), reviewPeriodID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetStaffEvaluations").Str("reviewPeriodId", reviewPeriodID).Msg("Failed to get staff evaluations")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2444,7 +2443,7 @@ func (h *PmsEngineHandler) RequestFeedback(w http.ResponseWriter, r *http.Reques
 	}
 	if err := h.svc.Performance.TreatAssignedRequest(r.Context(), &req); err != nil {
 		h.log.Error().Err(err).Str("action", "RequestFeedback").Msg("Failed to request feedback")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.Created(w, map[string]string{"message": "Feedback requested successfully"})
@@ -2461,7 +2460,7 @@ func (h *PmsEngineHandler) GetFeedbackRequests(w http.ResponseWriter, r *http.Re
 	result, err := h.svc.Performance.GetRequests(r.Context(), staffID, nil, nil)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetFeedbackRequests").Str("staffId", staffID).Msg("Failed to get feedback requests")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2476,7 +2475,7 @@ func (h *PmsEngineHandler) ProcessFeedback(w http.ResponseWriter, r *http.Reques
 	}
 	if err := h.svc.Performance.TreatAssignedRequest(r.Context(), &req); err != nil {
 		h.log.Error().Err(err).Str("action", "ProcessFeedback").Msg("Failed to process feedback")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, map[string]string{"message": "Feedback processed successfully"})
@@ -2493,7 +2492,7 @@ func (h *PmsEngineHandler) GetPendingFeedbackActions(w http.ResponseWriter, r *h
 	result, err := h.svc.Performance.GetPendingRequests(r.Context(), staffID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetPendingFeedbackActions").Str("staffId", staffID).Msg("Failed to get pending feedback actions")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2512,7 +2511,7 @@ func (h *PmsEngineHandler) GetPerformanceScore(w http.ResponseWriter, r *http.Re
 	result, err := h.svc.Performance.GetPerformanceScore(r.Context(), staffID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetPerformanceScore").Str("staffId", staffID).Msg("Failed to get performance score")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2530,7 +2529,7 @@ func (h *PmsEngineHandler) GetDashboardStats(w http.ResponseWriter, r *http.Requ
 	result, err := h.svc.Performance.GetDashboardStats(r.Context(), staffID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetDashboardStats").Str("staffId", staffID).Msg("Failed to get dashboard stats")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2557,7 +2556,7 @@ func (h *PmsEngineHandler) GetPerformanceSummary(w http.ResponseWriter, r *http.
 	result, err := h.svc.Performance.GetOrganogramPerformanceSummaryStatistics(r.Context(), referenceID, reviewPeriodID, enums.OrganogramLevel(level))
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetPerformanceSummary").Str("reviewPeriodId", reviewPeriodID).Msg("Failed to get performance summary")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2575,7 +2574,7 @@ func (h *PmsEngineHandler) SaveDraftIndividualPlannedObjective(w http.ResponseWr
 	result, err := h.svc.ReviewPeriod.SaveDraftIndividualPlannedObjective(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "SaveDraftIndividualPlannedObjective").Msg("Failed to save draft individual objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2591,7 +2590,7 @@ func (h *PmsEngineHandler) AddIndividualPlannedObjective(w http.ResponseWriter,
 	result, err := h.svc.ReviewPeriod.AddIndividualPlannedObjective(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "AddIndividualPlannedObjective").Msg("Failed to add individual objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.Created(w, result)
@@ -2607,7 +2606,7 @@ func (h *PmsEngineHandler) SubmitDraftIndividualObjective(w http.ResponseWriter,
 	result, err := h.svc.ReviewPeriod.SubmitDraftIndividualPlannedObjective(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "SubmitDraftIndividualObjective").Msg("Failed to submit draft individual objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2623,7 +2622,7 @@ func (h *PmsEngineHandler) ApproveIndividualObjective(w http.ResponseWriter, r *
 	result, err := h.svc.ReviewPeriod.ApproveIndividualPlannedObjective(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ApproveIndividualObjective").Msg("Failed to approve individual objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2639,7 +2638,7 @@ func (h *PmsEngineHandler) RejectIndividualObjective(w http.ResponseWriter, r *h
 	result, err := h.svc.ReviewPeriod.RejectIndividualPlannedObjective(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "RejectIndividualObjective").Msg("Failed to reject individual objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2655,7 +2654,7 @@ func (h *PmsEngineHandler) ReturnIndividualObjective(w http.ResponseWriter, r *h
 	result, err := h.svc.ReviewPeriod.ReturnIndividualPlannedObjective(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ReturnIndividualObjective").Msg("Failed to return individual objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2671,7 +2670,7 @@ func (h *PmsEngineHandler) CancelIndividualObjective(w http.ResponseWriter, r *h
 	result, err := h.svc.ReviewPeriod.CancelIndividualPlannedObjective(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "CancelIndividualObjective").Msg("Failed to cancel individual objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2693,7 +2692,7 @@ func (h *PmsEngineHandler) GetStaffIndividualObjectives(w http.ResponseWriter, r
 	result, err := h.svc.ReviewPeriod.GetStaffIndividualPlannedObjectives(r.Context(), staffID, reviewPeriodID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetStaffIndividualObjectives").Str("staffId", staffID).Msg("Failed to get staff individual objectives")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2711,7 +2710,7 @@ func (h *PmsEngineHandler) Trigger360Review(w http.ResponseWriter, r *http.Reque
 	result, err := h.svc.ReviewPeriod.AddReviewPeriod360Review(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "Trigger360Review").Msg("Failed to trigger 360 review")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.Created(w, result)
@@ -2727,7 +2726,7 @@ func (h *PmsEngineHandler) Initiate360Review(w http.ResponseWriter, r *http.Requ
 	result, err := h.svc.Performance.Initiate360Review(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "Initiate360Review").Msg("Failed to initiate 360 review")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2743,7 +2742,7 @@ func (h *PmsEngineHandler) Complete360ReviewForStaff(w http.ResponseWriter, r *h
 	result, err := h.svc.Performance.Complete360Review(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "Complete360ReviewForStaff").Msg("Failed to complete 360 review")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2761,7 +2760,7 @@ func (h *PmsEngineHandler) Add360Rating(w http.ResponseWriter, r *http.Request)
 	result, err := h.svc.Performance.CompetencyRatingSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "Add360Rating").Msg("Failed to add 360 rating")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.Created(w, result)
@@ -2777,7 +2776,7 @@ func (h *PmsEngineHandler) Update360Rating(w http.ResponseWriter, r *http.Reques
 	result, err := h.svc.Performance.CompetencyRatingSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "Update360Rating").Msg("Failed to update 360 rating")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2794,7 +2793,7 @@ func (h *PmsEngineHandler) ReviewerComplete360Review(w http.ResponseWriter, r *h
 	result, err := h.svc.Performance.CompetencyReviewerSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ReviewerComplete360Review").Msg("Failed to complete reviewer 360 review")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2812,7 +2811,7 @@ func (h *PmsEngineHandler) GetCompetencyReviewDetail(w http.ResponseWriter, r *h
 	result, err := h.svc.Performance.GetCompetencyReviewFeedback(r.Context(), feedbackID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetCompetencyReviewDetail").Str("feedbackId", feedbackID).Msg("Failed to get competency review detail")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2828,7 +2827,7 @@ func (h *PmsEngineHandler) GetCompetencyReviewFeedbackDetails(w http.ResponseWri
 	result, err := h.svc.Performance.GetCompetencyReviewFeedbackDetails(r.Context(), feedbackID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetCompetencyReviewFeedbackDetails").Str("feedbackId", feedbackID).Msg("Failed to get competency review feedback details")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2844,7 +2843,7 @@ func (h *PmsEngineHandler) GetAllCompetencyReviewFeedbacksByReviewPeriod(w http.
 	result, err := h.svc.Performance.GetAllCompetencyReviewFeedbacks(r.Context(), staffID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetAllCompetencyReviewFeedbacksByReviewPeriod").Str("staffId", staffID).Msg("Failed to get competency review feedbacks")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2860,7 +2859,7 @@ func (h *PmsEngineHandler) GetAllMyReviewedCompetencies(w http.ResponseWriter, r
 	result, err := h.svc.Performance.GetCompetencyReviews(r.Context(), reviewerStaffID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetAllMyReviewedCompetencies").Str("reviewerStaffId", reviewerStaffID).Msg("Failed to get reviewed competencies")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2876,7 +2875,7 @@ func (h *PmsEngineHandler) GetCompetenciesToReview(w http.ResponseWriter, r *htt
 	result, err := h.svc.Performance.GetCompetencyReviews(r.Context(), reviewerStaffID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetCompetenciesToReview").Str("reviewerStaffId", reviewerStaffID).Msg("Failed to get competencies to review")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2893,7 +2892,7 @@ func (h *PmsEngineHandler) GetReviewerFeedbackDetails(w http.ResponseWriter, r *
 	result, err := h.svc.Performance.GetReviewerFeedbackDetails(r.Context(), reviewerID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetReviewerFeedbackDetails").Str("reviewerId", reviewerID).Msg("Failed to get reviewer feedback details")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2909,7 +2908,7 @@ func (h *PmsEngineHandler) GetQuestionnaire(w http.ResponseWriter, r *http.Reque
 	result, err := h.svc.Performance.GetQuestionnaire(r.Context(), staffID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetQuestionnaire").Str("staffId", staffID).Msg("Failed to get questionnaire")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2927,7 +2926,7 @@ func (h *PmsEngineHandler) GetStaffRequests(w http.ResponseWriter, r *http.Reque
 	result, err := h.svc.Performance.GetRequests(r.Context(), staffID, nil, nil)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetStaffRequests").Str("staffId", staffID).Msg("Failed to get staff requests")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2947,7 +2946,7 @@ func (h *PmsEngineHandler) GetBreachedRequests(w http.ResponseWriter, r *http.Re
 	result, err := h.svc.Performance.GetBreachedRequests(r.Context(), staffID, reviewPeriodID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetBreachedRequests").Str("staffId", staffID).Msg("Failed to get breached requests")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2967,7 +2966,7 @@ func (h *PmsEngineHandler) GetStaffRequestsByStatus(w http.ResponseWriter, r *ht
 	result, err := h.svc.Performance.GetRequests(r.Context(), staffID, nil, &status)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetStaffRequestsByStatus").Str("staffId", staffID).Str("status", status).Msg("Failed to get staff requests by status")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -2983,7 +2982,7 @@ func (h *PmsEngineHandler) GetAllRequests(w http.ResponseWriter, r *http.Request
 	result, err := h.svc.Performance.GetRequestsByOwner(r.Context(), staffID, nil)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetAllRequests").Str("staffId", staffID).Msg("Failed to get all requests")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -3003,7 +3002,7 @@ func (h *PmsEngineHandler) GetRequestsByStatus(w http.ResponseWriter, r *http.Re
 	result, err := h.svc.Performance.GetRequests(r.Context(), staffID, nil, &status)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetRequestsByStatus").Str("staffId", staffID).Str("status", status).Msg("Failed to get requests by status")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -3020,7 +3019,7 @@ func (h *PmsEngineHandler) GetRequestDetails(w http.ResponseWriter, r *http.Requ
 	result, err := h.svc.Performance.GetRequestDetails(r.Context(), requestID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetRequestDetails").Str("requestId", requestID).Msg("Failed to get request details")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -3040,7 +3039,7 @@ func (h *PmsEngineHandler) ReassignRequest(w http.ResponseWriter, r *http.Reques
 	}
 	if err := h.svc.Performance.ReassignRequest(r.Context(), req.RequestID, req.NewAssignedStaffID); err != nil {
 		h.log.Error().Err(err).Str("action", "ReassignRequest").Str("requestId", req.RequestID).Msg("Failed to reassign request")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, map[string]string{"message": "Request reassigned successfully"})
@@ -3059,7 +3058,7 @@ func (h *PmsEngineHandler) ReassignSelfRequest(w http.ResponseWriter, r *http.Re
 	}
 	if err := h.svc.Performance.ReassignSelfRequest(r.Context(), req.RequestID, req.CurrentStaffID, req.NewAssignedStaffID); err != nil {
 		h.log.Error().Err(err).Str("action", "ReassignSelfRequest").Str("requestId", req.RequestID).Msg("Failed to self-reassign request")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, map[string]string{"message": "Request reassigned successfully"})
@@ -3076,12 +3075,27 @@ func (h *PmsEngineHandler) CloseRequest(w http.ResponseWriter, r *http.Request)
 	}
 	if err := h.svc.Performance.CloseRequest(r.Context(), req.RequestID); err != nil {
 		h.log.Error().Err(err).Str("action", "CloseRequest").Str("requestId", req.RequestID).Msg("Failed to close request")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, map[string]string{"message": "Request closed successfully"})
 }
 
+// UpdateFeedbackRequest handles POST /api/v1/pms-engine/feedback/requests/update
+// Mirrors .NET UpdateRequest — records the assignee's comment or attachment.
+func (h *PmsEngineHandler) UpdateFeedbackRequest(w http.ResponseWriter, r *http.Request) {
+	var req performance.UpdateFeedbackRequestModel
+	if !h.decodeJSON(w, r, &req) {
+		return
+	}
+	if err := h.svc.Performance.UpdateRequest(r.Context(), req.RequestID, req.Comment, req.Attachment); err != nil {
+		h.log.Error().Err(err).Str("action", "UpdateFeedbackRequest").Str("requestId", req.RequestID).Msg("Failed to update request")
+		serviceError(w, err, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.OK(w, map[string]string{"message": "Request updated successfully"})
+}
+
 // TreatAssignedRequest handles POST /api/v1/pms-engine/feedback/requests/treat
 // Mirrors .NET TreatAssignedRequest — treats (processes) an assigned feedback request.
 func (h *PmsEngineHandler) TreatAssignedRequest(w http.ResponseWriter, r *http.Request) {
@@ -3091,7 +3105,7 @@ func (h *PmsEngineHandler) TreatAssignedRequest(w http.ResponseWriter, r *http.R
 	}
 	if err := h.svc.Performance.TreatAssignedRequest(r.Context(), &req); err != nil {
 		h.log.Error().Err(err).Str("action", "TreatAssignedRequest").Str("requestId", req.RequestID).Msg("Failed to treat assigned request")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, map[string]string{"message": "Request treated successfully"})
@@ -3109,7 +3123,23 @@ func (h *PmsEngineHandler) CompetencyGapClosureSetup(w http.ResponseWriter, r *h
 	result, err := h.svc.Performance.CompetencyGapClosureSetup(r.Context(), &req)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "CompetencyGapClosureSetup").Msg("Failed to setup competency gap closure")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.OK(w, result)
+}
+
+// GetCompetencyGapClosureReport handles GET /api/v1/pms-engine/competency-review/gap-closure/report?reviewPeriodId=X
+// Lists the closed development plans credited as competency gap closures in the period.
+func (h *PmsEngineHandler) GetCompetencyGapClosureReport(w http.ResponseWriter, r *http.Request) {
+	reviewPeriodID := h.requiredQuery(w, r, "reviewPeriodId")
+	if reviewPeriodID == "" {
+		return
+	}
+	result, err := h.svc.Performance.GetCompetencyGapClosureReport(r.Context(), reviewPeriodID)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "GetCompetencyGapClosureReport").Msg("Failed to get competency gap closure report")
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -3127,7 +3157,7 @@ func (h *PmsEngineHandler) GetRequestStatistics(w http.ResponseWriter, r *http.R
 	result, err := h.svc.Performance.GetRequestStatistics(r.Context(), staffID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetRequestStatistics").Str("staffId", staffID).Msg("Failed to get request statistics")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -3143,7 +3173,7 @@ func (h *PmsEngineHandler) GetStaffPerformanceStatistics(w http.ResponseWriter,
 	result, err := h.svc.Performance.GetStaffPerformanceStatistics(r.Context(), staffID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetStaffPerformanceStatistics").Str("staffId", staffID).Msg("Failed to get performance statistics")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -3159,7 +3189,7 @@ func (h *PmsEngineHandler) GetStaffWorkProductsStatistics(w http.ResponseWriter,
 	result, err := h.svc.Performance.GetStaffWorkProductsStatistics(r.Context(), staffID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetStaffWorkProductsStatistics").Str("staffId", staffID).Msg("Failed to get work products statistics")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -3175,7 +3205,7 @@ func (h *PmsEngineHandler) GetStaffWorkProductsDetailsStatistics(w http.Response
 	result, err := h.svc.Performance.GetStaffWorkProductsDetailsStatistics(r.Context(), staffID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetStaffWorkProductsDetailsStatistics").Str("staffId", staffID).Msg("Failed to get work products details statistics")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -3197,7 +3227,7 @@ func (h *PmsEngineHandler) GetStaffPerformanceScoreCardStatistics(w http.Respons
 	result, err := h.svc.Performance.GetStaffPerformanceScoreCardStatistics(r.Context(), staffID, reviewPeriodID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetStaffPerformanceScoreCardStatistics").Str("staffId", staffID).Msg("Failed to get scorecard statistics")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -3222,7 +3252,7 @@ func (h *PmsEngineHandler) GetStaffAnnualPerformanceScoreCardStatistics(w http.R
 	result, err := h.svc.Performance.GetStaffAnnualPerformanceScoreCardStatistics(r.Context(), staffID, year)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetStaffAnnualPerformanceScoreCardStatistics").Str("staffId", staffID).Int("year", year).Msg("Failed to get annual scorecard")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -3242,7 +3272,7 @@ func (h *PmsEngineHandler) GetSubordinatesStaffPerformanceScoreCardStatistics(w
 	result, err := h.svc.Performance.GetSubordinatesStaffPerformanceScoreCardStatistics(r.Context(), managerID, reviewPeriodID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetSubordinatesScoreCard").Str("managerId", managerID).Msg("Failed to get subordinates scorecard")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -3274,7 +3304,7 @@ func (h *PmsEngineHandler) GetOrganogramPerformanceSummaryStatistics(w http.Resp
 	result, err := h.svc.Performance.GetOrganogramPerformanceSummaryStatistics(r.Context(), referenceID, reviewPeriodID, level)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetOrganogramPerformanceSummary").Str("referenceId", referenceID).Msg("Failed to get organogram performance summary")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -3304,7 +3334,7 @@ func (h *PmsEngineHandler) GetOrganogramPerformanceSummaryListStatistics(w http.
 	result, err := h.svc.Performance.GetOrganogramPerformanceSummaryListStatistics(r.Context(), headOfUnitID, reviewPeriodID, level)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetOrganogramPerformanceSummaryList").Str("headOfUnitId", headOfUnitID).Msg("Failed to get organogram performance summary list")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -3326,7 +3356,7 @@ func (h *PmsEngineHandler) GetPeriodScoreDetails(w http.ResponseWriter, r *http.
 	result, err := h.svc.Performance.GetPeriodScoreDetails(r.Context(), reviewPeriodID, staffID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetPeriodScoreDetails").Str("staffId", staffID).Str("reviewPeriodId", reviewPeriodID).Msg("Failed to get period score details")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -3342,7 +3372,7 @@ func (h *PmsEngineHandler) GetPeriodScores(w http.ResponseWriter, r *http.Reques
 	result, err := h.svc.Performance.GetPeriodScores(r.Context(), reviewPeriodID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetPeriodScores").Str("reviewPeriodId", reviewPeriodID).Msg("Failed to get period scores")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -3358,7 +3388,7 @@ func (h *PmsEngineHandler) GetStaffReviewPeriods(w http.ResponseWriter, r *http.
 	result, err := h.svc.Performance.GetStaffReviewPeriods(r.Context(), staffID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetStaffReviewPeriods").Str("staffId", staffID).Msg("Failed to get staff review periods")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -3372,7 +3402,7 @@ func (h *PmsEngineHandler) GetAuditLogs(w http.ResponseWriter, r *http.Request)
 	result, err := h.svc.Performance.GetAuditLogs(r.Context())
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetAuditLogs").Msg("Failed to get audit logs")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -3394,7 +3424,7 @@ func (h *PmsEngineHandler) GetAuditLogDetails(w http.ResponseWriter, r *http.Req
 	result, err := h.svc.Performance.GetAuditLogDetails(r.Context(), id)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetAuditLogDetails").Int("id", id).Msg("Failed to get audit log details")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -3422,7 +3452,7 @@ func (h *PmsEngineHandler) GetLineManagerEmployees(w http.ResponseWriter, r *htt
 	result, err := h.svc.Performance.GetLineManagerEmployees(r.Context(), staffID, category)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetLineManagerEmployees").Str("staffId", staffID).Msg("Failed to get line manager employees")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -3448,7 +3478,7 @@ func (h *PmsEngineHandler) GetAdhocAssignmentEmployees(w http.ResponseWriter, r
 	result, err := h.svc.Performance.GetAdhocAssignmentEmployees(r.Context(), leadStaffID, category)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetAdhocAssignmentEmployees").Str("leadStaffId", leadStaffID).Msg("Failed to get adhoc assignment employees")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -3464,7 +3494,7 @@ func (h *PmsEngineHandler) GetMyStaff(w http.ResponseWriter, r *http.Request) {
 	result, err := h.svc.Performance.GetMyStaff(r.Context(), managerID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetMyStaff").Str("managerId", managerID).Msg("Failed to get my staff")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -3491,7 +3521,7 @@ func (h *PmsEngineHandler) ResetUserPassword(w http.ResponseWriter, r *http.Requ
 	result, err := h.svc.Performance.ResetUserPassword(r.Context(), req.Username, req.Password, req.IPAddress, req.DeviceName)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ResetUserPassword").Str("username", req.Username).Msg("Failed to reset user password")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 	response.OK(w, result)
@@ -3499,232 +3529,234 @@ func (h *PmsEngineHandler) ResetUserPassword(w http.ResponseWriter, r *http.Requ
 
 // =================== ROUTE REGISTRATION ====================================
 
-// RegisterRoutes registers all PMS engine routes on the given mux.
-// Uses Go 1.22+ method-aware patterns. All routes require JWT authentication.
-func (h *PmsEngineHandler) RegisterRoutes(mux *http.ServeMux, mw *middleware.Stack) {
+// RegisterRoutes registers all PMS engine routes on the given route table.
+// Uses Go 1.22+ method-aware patterns. Each route is guarded by its entry in
+// routePolicies.
+func (h *PmsEngineHandler) RegisterRoutes(routes *routeTable) {
 	base := "/api/v1/pms-engine"
-	jwt := func(hf http.HandlerFunc) http.Handler { return mw.JWTAuth(http.HandlerFunc(hf)) }
 
 	// --- Project Management ---
-	mux.Handle("POST "+base+"/projects/draft", jwt(h.SaveDraftProject))
-	mux.Handle("POST "+base+"/projects", jwt(h.AddProject))
-	mux.Handle("POST "+base+"/projects/submit-draft", jwt(h.SubmitDraftProject))
-	mux.Handle("POST "+base+"/projects/approve", jwt(h.ApproveProject))
-	mux.Handle("POST "+base+"/projects/reject", jwt(h.RejectProject))
-	mux.Handle("POST "+base+"/projects/return", jwt(h.ReturnProject))
-	mux.Handle("POST "+base+"/projects/resubmit", jwt(h.ReSubmitProject))
-	mux.Handle("PUT "+base+"/projects", jwt(h.UpdateProject))
-	mux.Handle("POST "+base+"/projects/cancel", jwt(h.CancelProject))
-	mux.Handle("GET "+base+"/projects", jwt(h.GetProjects))
-	mux.Handle("GET "+base+"/projects/{projectId}", jwt(h.GetProjectDetails))
-	mux.Handle("POST "+base+"/projects/objectives", jwt(h.AddProjectObjective))
-	mux.Handle("POST "+base+"/projects/members", jwt(h.AddProjectMember))
-	mux.Handle("GET "+base+"/projects/{projectId}/members", jwt(h.GetProjectMembers))
-	mux.Handle("GET "+base+"/projects/{projectId}/objectives", jwt(h.GetProjectObjectives))
-	mux.Handle("POST "+base+"/projects/close", jwt(h.CloseProject))
-	mux.Handle("POST "+base+"/projects/pause", jwt(h.PauseProject))
-	mux.Handle("GET "+base+"/projects/by-manager", jwt(h.GetProjectsByManager))
-	mux.Handle("GET "+base+"/projects/assigned", jwt(h.GetProjectsAssigned))
-	mux.Handle("GET "+base+"/projects/staff", jwt(h.GetStaffProjects))
-	mux.Handle("GET "+base+"/projects/{projectId}/work-product-staff", jwt(h.GetProjectWorkProductStaffList))
-	mux.Handle("POST "+base+"/projects/members/draft", jwt(h.SaveDraftProjectMember))
-	mux.Handle("POST "+base+"/projects/members/submit-draft", jwt(h.SubmitDraftProjectMember))
-	mux.Handle("POST "+base+"/projects/members/accept", jwt(h.AcceptProjectMember))
-	mux.Handle("POST "+base+"/projects/members/approve", jwt(h.ApproveProjectMember))
-	mux.Handle("POST "+base+"/projects/members/cancel", jwt(h.CancelProjectMember))
-	mux.Handle("POST "+base+"/projects/objectives/cancel", jwt(h.CancelProjectObjective))
-	mux.Handle("POST "+base+"/projects/change-lead", jwt(h.ChangeAdhocAssignmentLead))
-	mux.Handle("GET "+base+"/projects/validate-eligibility", jwt(h.ValidateStaffEligibilityForAdhoc))
+	routes.handle("POST "+base+"/projects/draft", h.SaveDraftProject)
+	routes.handle("POST "+base+"/projects", h.AddProject)
+	routes.handle("POST "+base+"/projects/submit-draft", h.SubmitDraftProject)
+	routes.handle("POST "+base+"/projects/approve", h.ApproveProject)
+	routes.handle("POST "+base+"/projects/reject", h.RejectProject)
+	routes.handle("POST "+base+"/projects/return", h.ReturnProject)
+	routes.handle("POST "+base+"/projects/resubmit", h.ReSubmitProject)
+	routes.handle("PUT "+base+"/projects", h.UpdateProject)
+	routes.handle("POST "+base+"/projects/cancel", h.CancelProject)
+	routes.handle("GET "+base+"/projects", h.GetProjects)
+	routes.handle("GET "+base+"/projects/{projectId}", h.GetProjectDetails)
+	routes.handle("POST "+base+"/projects/objectives", h.AddProjectObjective)
+	routes.handle("POST "+base+"/projects/members", h.AddProjectMember)
+	routes.handle("GET "+base+"/projects/{projectId}/members", h.GetProjectMembers)
+	routes.handle("GET "+base+"/projects/{projectId}/objectives", h.GetProjectObjectives)
+	routes.handle("POST "+base+"/projects/close", h.CloseProject)
+	routes.handle("POST "+base+"/projects/pause", h.PauseProject)
+	routes.handle("GET "+base+"/projects/by-manager", h.GetProjectsByManager)
+	routes.handle("GET "+base+"/projects/assigned", h.GetProjectsAssigned)
+	routes.handle("GET "+base+"/projects/staff", h.GetStaffProjects)
+	routes.handle("GET "+base+"/projects/{projectId}/work-product-staff", h.GetProjectWorkProductStaffList)
+	routes.handle("POST "+base+"/projects/members/draft", h.SaveDraftProjectMember)
+	routes.handle("POST "+base+"/projects/members/submit-draft", h.SubmitDraftProjectMember)
+	routes.handle("POST "+base+"/projects/members/accept", h.AcceptProjectMember)
+	routes.handle("POST "+base+"/projects/members/approve", h.ApproveProjectMember)
+	routes.handle("POST "+base+"/projects/members/cancel", h.CancelProjectMember)
+	routes.handle("POST "+base+"/projects/objectives/cancel", h.CancelProjectObjective)
+	routes.handle("POST "+base+"/projects/change-lead", h.ChangeAdhocAssignmentLead)
+	routes.handle("GET "+base+"/projects/validate-eligibility", h.ValidateStaffEligibilityForAdhoc)
 
 	// --- Committee Management ---
-	mux.Handle("POST "+base+"/committees/draft", jwt(h.SaveDraftCommittee))
-	mux.Handle("POST "+base+"/committees", jwt(h.AddCommittee))
-	mux.Handle("POST "+base+"/committees/submit-draft", jwt(h.SubmitDraftCommittee))
-	mux.Handle("POST "+base+"/committees/approve", jwt(h.ApproveCommittee))
-	mux.Handle("POST "+base+"/committees/reject", jwt(h.RejectCommittee))
-	mux.Handle("POST "+base+"/committees/return", jwt(h.ReturnCommittee))
-	mux.Handle("POST "+base+"/committees/resubmit", jwt(h.ReSubmitCommittee))
-	mux.Handle("PUT "+base+"/committees", jwt(h.UpdateCommittee))
-	mux.Handle("POST "+base+"/committees/cancel", jwt(h.CancelCommittee))
-	mux.Handle("GET "+base+"/committees", jwt(h.GetCommittees))
-	mux.Handle("GET "+base+"/committees/{committeeId}", jwt(h.GetCommitteeDetails))
-	mux.Handle("POST "+base+"/committees/members", jwt(h.AddCommitteeMember))
-	mux.Handle("POST "+base+"/committees/objectives", jwt(h.AddCommitteeObjective))
-	mux.Handle("POST "+base+"/committees/close", jwt(h.CloseCommittee))
-	mux.Handle("POST "+base+"/committees/pause", jwt(h.PauseCommittee))
-	mux.Handle("GET "+base+"/committees/by-chairperson", jwt(h.GetCommitteesByChairperson))
-	mux.Handle("GET "+base+"/committees/{committeeId}/members", jwt(h.GetCommitteeMembers))
-	mux.Handle("GET "+base+"/committees/assigned", jwt(h.GetCommitteesAssigned))
-	mux.Handle("GET "+base+"/committees/staff", jwt(h.GetStaffCommittees))
-	mux.Handle("GET "+base+"/committees/{committeeId}/work-product-staff", jwt(h.GetCommitteeWorkProductStaffList))
-	mux.Handle("GET "+base+"/committees/{committeeId}/objectives", jwt(h.GetCommitteeObjectives))
-	mux.Handle("POST "+base+"/committees/members/draft", jwt(h.SaveDraftCommitteeMember))
-	mux.Handle("POST "+base+"/committees/members/submit-draft", jwt(h.SubmitDraftCommitteeMember))
-	mux.Handle("POST "+base+"/committees/members/cancel", jwt(h.CancelCommitteeMember))
-	mux.Handle("POST "+base+"/committees/objectives/cancel", jwt(h.CancelCommitteeObjective))
-	mux.Handle("POST "+base+"/committees/change-chairperson", jwt(h.ChangeCommitteeChairperson))
+	routes.handle("POST "+base+"/committees/draft", h.SaveDraftCommittee)
+	routes.handle("POST "+base+"/committees", h.AddCommittee)
+	routes.handle("POST "+base+"/committees/submit-draft", h.SubmitDraftCommittee)
+	routes.handle("POST "+base+"/committees/approve", h.ApproveCommittee)
+	routes.handle("POST "+base+"/committees/reject", h.RejectCommittee)
+	routes.handle("POST "+base+"/committees/return", h.ReturnCommittee)
+	routes.handle("POST "+base+"/committees/resubmit", h.ReSubmitCommittee)
+	routes.handle("PUT "+base+"/committees", h.UpdateCommittee)
+	routes.handle("POST "+base+"/committees/cancel", h.CancelCommittee)
+	routes.handle("GET "+base+"/committees", h.GetCommittees)
+	routes.handle("GET "+base+"/committees/{committeeId}", h.GetCommitteeDetails)
+	routes.handle("POST "+base+"/committees/members", h.AddCommitteeMember)
+	routes.handle("POST "+base+"/committees/objectives", h.AddCommitteeObjective)
+	routes.handle("POST "+base+"/committees/close", h.CloseCommittee)
+	routes.handle("POST "+base+"/committees/pause", h.PauseCommittee)
+	routes.handle("GET "+base+"/committees/by-chairperson", h.GetCommitteesByChairperson)
+	routes.handle("GET "+base+"/committees/{committeeId}/members", h.GetCommitteeMembers)
+	routes.handle("GET "+base+"/committees/assigned", h.GetCommitteesAssigned)
+	routes.handle("GET "+base+"/committees/staff", h.GetStaffCommittees)
+	routes.handle("GET "+base+"/committees/{committeeId}/work-product-staff", h.GetCommitteeWorkProductStaffList)
+	routes.handle("GET "+base+"/committees/{committeeId}/objectives", h.GetCommitteeObjectives)
+	routes.handle("POST "+base+"/committees/members/draft", h.SaveDraftCommitteeMember)
+	routes.handle("POST "+base+"/committees/members/submit-draft", h.SubmitDraftCommitteeMember)
+	routes.handle("POST "+base+"/committees/members/cancel", h.CancelCommitteeMember)
+	routes.handle("POST "+base+"/committees/objectives/cancel", h.CancelCommitteeObjective)
+	routes.handle("POST "+base+"/committees/change-chairperson", h.ChangeCommitteeChairperson)
 
 	// --- Work Product Management ---
-	mux.Handle("POST "+base+"/work-products/draft", jwt(h.SaveDraftWorkProduct))
-	mux.Handle("POST "+base+"/work-products", jwt(h.AddWorkProduct))
-	mux.Handle("POST "+base+"/work-products/submit-draft", jwt(h.SubmitDraftWorkProduct))
-	mux.Handle("POST "+base+"/work-products/approve", jwt(h.ApproveWorkProduct))
-	mux.Handle("POST "+base+"/work-products/reject", jwt(h.RejectWorkProduct))
-	mux.Handle("POST "+base+"/work-products/return", jwt(h.ReturnWorkProduct))
-	mux.Handle("POST "+base+"/work-products/resubmit", jwt(h.ReSubmitWorkProduct))
-	mux.Handle("PUT "+base+"/work-products", jwt(h.UpdateWorkProduct))
-	mux.Handle("POST "+base+"/work-products/cancel", jwt(h.CancelWorkProduct))
-	mux.Handle("POST "+base+"/work-products/pause", jwt(h.PauseWorkProduct))
-	mux.Handle("POST "+base+"/work-products/resume", jwt(h.ResumeWorkProduct))
-	mux.Handle("GET "+base+"/work-products", jwt(h.GetStaffWorkProducts))
-	mux.Handle("GET "+base+"/work-products/{workProductId}", jwt(h.GetWorkProductDetails))
-	mux.Handle("POST "+base+"/work-products/assign", jwt(h.AssignWorkProduct))
-	mux.Handle("GET "+base+"/work-products/assigned", jwt(h.GetAssignedWorkProducts))
-	mux.Handle("POST "+base+"/work-products/evaluate", jwt(h.EvaluateWorkProduct))
-	mux.Handle("POST "+base+"/work-products/complete", jwt(h.CompleteWorkProduct))
-	mux.Handle("POST "+base+"/work-products/suspend", jwt(h.SuspendWorkProduct))
-	mux.Handle("POST "+base+"/work-products/reinstate", jwt(h.ReInstateWorkProduct))
+	routes.handle("POST "+base+"/work-products/draft", h.SaveDraftWorkProduct)
+	routes.handle("POST "+base+"/work-products", h.AddWorkProduct)
+	routes.handle("POST "+base+"/work-products/submit-draft", h.SubmitDraftWorkProduct)
+	routes.handle("POST "+base+"/work-products/approve", h.ApproveWorkProduct)
+	routes.handle("POST "+base+"/work-products/reject", h.RejectWorkProduct)
+	routes.handle("POST "+base+"/work-products/return", h.ReturnWorkProduct)
+	routes.handle("POST "+base+"/work-products/resubmit", h.ReSubmitWorkProduct)
+	routes.handle("PUT "+base+"/work-products", h.UpdateWorkProduct)
+	routes.handle("POST "+base+"/work-products/cancel", h.CancelWorkProduct)
+	routes.handle("POST "+base+"/work-products/pause", h.PauseWorkProduct)
+	routes.handle("POST "+base+"/work-products/resume", h.ResumeWorkProduct)
+	routes.handle("GET "+base+"/work-products", h.GetStaffWorkProducts)
+	routes.handle("GET "+base+"/work-products/{workProductId}", h.GetWorkProductDetails)
+	routes.handle("POST "+base+"/work-products/assign", h.AssignWorkProduct)
+	routes.handle("GET "+base+"/work-products/assigned", h.GetAssignedWorkProducts)
+	routes.handle("POST "+base+"/work-products/evaluate", h.EvaluateWorkProduct)
+	routes.handle("POST "+base+"/work-products/complete", h.CompleteWorkProduct)
+	routes.handle("POST "+base+"/work-products/suspend", h.SuspendWorkProduct)
+	routes.handle("POST "+base+"/work-products/reinstate", h.ReInstateWorkProduct)
 
 	// --- Project Assigned Work Products ---
-	mux.Handle("POST "+base+"/work-products/project/draft", jwt(h.SaveDraftProjectWorkProduct))
-	mux.Handle("POST "+base+"/work-products/project", jwt(h.AddProjectWorkProduct))
-	mux.Handle("POST "+base+"/work-products/project/submit-draft", jwt(h.SubmitDraftProjectWorkProduct))
-	mux.Handle("POST "+base+"/work-products/project/approve", jwt(h.ApproveProjectWorkProduct))
-	mux.Handle("POST "+base+"/work-products/project/reject", jwt(h.RejectProjectWorkProduct))
-	mux.Handle("POST "+base+"/work-products/project/return", jwt(h.ReturnProjectWorkProduct))
-	mux.Handle("POST "+base+"/work-products/project/resubmit", jwt(h.ReSubmitProjectWorkProduct))
-	mux.Handle("POST "+base+"/work-products/project/cancel", jwt(h.CancelProjectWorkProduct))
-	mux.Handle("POST "+base+"/work-products/project/close", jwt(h.CloseProjectWorkProduct))
+	routes.handle("POST "+base+"/work-products/project/draft", h.SaveDraftProjectWorkProduct)
+	routes.handle("POST "+base+"/work-products/project", h.AddProjectWorkProduct)
+	routes.handle("POST "+base+"/work-products/project/submit-draft", h.SubmitDraftProjectWorkProduct)
+	routes.handle("POST "+base+"/work-products/project/approve", h.ApproveProjectWorkProduct)
+	routes.handle("POST "+base+"/work-products/project/reject", h.RejectProjectWorkProduct)
+	routes.handle("POST "+base+"/work-products/project/return", h.ReturnProjectWorkProduct)
+	routes.handle("POST "+base+"/work-products/project/resubmit", h.ReSubmitProjectWorkProduct)
+	routes.handle("POST "+base+"/work-products/project/cancel", h.CancelProjectWorkProduct)
+	routes.handle("POST "+base+"/work-products/project/close", h.CloseProjectWorkProduct)
 
 	// --- Committee Assigned Work Products ---
-	mux.Handle("POST "+base+"/work-products/committee/draft", jwt(h.SaveDraftCommitteeWorkProduct))
-	mux.Handle("POST "+base+"/work-products/committee", jwt(h.AddCommitteeWorkProduct))
-	mux.Handle("POST "+base+"/work-products/committee/submit-draft", jwt(h.SubmitDraftCommitteeWorkProduct))
-	mux.Handle("POST "+base+"/work-products/committee/approve", jwt(h.ApproveCommitteeWorkProduct))
-	mux.Handle("POST "+base+"/work-products/committee/reject", jwt(h.RejectCommitteeWorkProduct))
-	mux.Handle("POST "+base+"/work-products/committee/return", jwt(h.ReturnCommitteeWorkProduct))
-	mux.Handle("POST "+base+"/work-products/committee/resubmit", jwt(h.ReSubmitCommitteeWorkProduct))
-	mux.Handle("POST "+base+"/work-products/committee/cancel", jwt(h.CancelCommitteeWorkProduct))
-	mux.Handle("POST "+base+"/work-products/committee/close", jwt(h.CloseCommitteeWorkProduct))
+	routes.handle("POST "+base+"/work-products/committee/draft", h.SaveDraftCommitteeWorkProduct)
+	routes.handle("POST "+base+"/work-products/committee", h.AddCommitteeWorkProduct)
+	routes.handle("POST "+base+"/work-products/committee/submit-draft", h.SubmitDraftCommitteeWorkProduct)
+	routes.handle("POST "+base+"/work-products/committee/approve", h.ApproveCommitteeWorkProduct)
+	routes.handle("POST "+base+"/work-products/committee/reject", h.RejectCommitteeWorkProduct)
+	routes.handle("POST "+base+"/work-products/committee/return", h.ReturnCommitteeWorkProduct)
+	routes.handle("POST "+base+"/work-products/committee/resubmit", h.ReSubmitCommitteeWorkProduct)
+	routes.handle("POST "+base+"/work-products/committee/cancel", h.CancelCommitteeWorkProduct)
+	routes.handle("POST "+base+"/work-products/committee/close", h.CloseCommitteeWorkProduct)
 
 	// --- Work Product Retrieval ---
-	mux.Handle("GET "+base+"/work-products/project/{id}", jwt(h.GetProjectAssignedWorkProductDetails))
-	mux.Handle("GET "+base+"/work-products/project", jwt(h.GetProjectAssignedWorkProducts))
-	mux.Handle("GET "+base+"/work-products/project/single", jwt(h.GetProjectWorkProduct))
-	mux.Handle("GET "+base+"/work-products/project/all", jwt(h.GetAllProjectWorkProducts))
-	mux.Handle("GET "+base+"/work-products/project/staff", jwt(h.GetStaffProjectWorkProducts))
-	mux.Handle("GET "+base+"/work-products/committee/{id}", jwt(h.GetCommitteeAssignedWorkProductDetails))
-	mux.Handle("GET "+base+"/work-products/committee", jwt(h.GetCommitteeAssignedWorkProducts))
-	mux.Handle("GET "+base+"/work-products/committee/single", jwt(h.GetCommitteeWorkProduct))
-	mux.Handle("GET "+base+"/work-products/committee/all", jwt(h.GetAllCommitteeWorkProducts))
-	mux.Handle("GET "+base+"/work-products/committee/staff", jwt(h.GetStaffCommitteeWorkProducts))
-	mux.Handle("GET "+base+"/work-products/operational", jwt(h.GetOperationalWorkProducts))
-	mux.Handle("GET "+base+"/work-products/by-objective", jwt(h.GetObjectiveWorkProducts))
-	mux.Handle("GET "+base+"/work-products/all", jwt(h.GetAllStaffWorkProducts))
+	routes.handle("GET "+base+"/work-products/project/{id}", h.GetProjectAssignedWorkProductDetails)
+	routes.handle("GET "+base+"/work-products/project", h.GetProjectAssignedWorkProducts)
+	routes.handle("GET "+base+"/work-products/project/single", h.GetProjectWorkProduct)
+	routes.handle("GET "+base+"/work-products/project/all", h.GetAllProjectWorkProducts)
+	routes.handle("GET "+base+"/work-products/project/staff", h.GetStaffProjectWorkProducts)
+	routes.handle("GET "+base+"/work-products/committee/{id}", h.GetCommitteeAssignedWorkProductDetails)
+	routes.handle("GET "+base+"/work-products/committee", h.GetCommitteeAssignedWorkProducts)
+	routes.handle("GET "+base+"/work-products/committee/single", h.GetCommitteeWorkProduct)
+	routes.handle("GET "+base+"/work-products/committee/all", h.GetAllCommitteeWorkProducts)
+	routes.handle("GET "+base+"/work-products/committee/staff", h.GetStaffCommitteeWorkProducts)
+	routes.handle("GET "+base+"/work-products/operational", h.GetOperationalWorkProducts)
+	routes.handle("GET "+base+"/work-products/by-objective", h.GetObjectiveWorkProducts)
+	routes.handle("GET "+base+"/work-products/all", h.GetAllStaffWorkProducts)
 
 	// --- Work Product Tasks ---
-	mux.Handle("POST "+base+"/work-products/tasks", jwt(h.AddWorkProductTask))
-	mux.Handle("PUT "+base+"/work-products/tasks", jwt(h.UpdateWorkProductTask))
-	mux.Handle("POST "+base+"/work-products/tasks/cancel", jwt(h.CancelWorkProductTask))
-	mux.Handle("POST "+base+"/work-products/tasks/complete", jwt(h.CompleteWorkProductTask))
-	mux.Handle("GET "+base+"/work-products/tasks/{taskId}", jwt(h.GetWorkProductTaskDetail))
-	mux.Handle("GET "+base+"/work-products/tasks/by-product", jwt(h.GetWorkProductTasks))
+	routes.handle("POST "+base+"/work-products/tasks", h.AddWorkProductTask)
+	routes.handle("PUT "+base+"/work-products/tasks", h.UpdateWorkProductTask)
+	routes.handle("POST "+base+"/work-products/tasks/cancel", h.CancelWorkProductTask)
+	routes.handle("POST "+base+"/work-products/tasks/complete", h.CompleteWorkProductTask)
+	routes.handle("GET "+base+"/work-products/tasks/{taskId}", h.GetWorkProductTaskDetail)
+	routes.handle("GET "+base+"/work-products/tasks/by-product", h.GetWorkProductTasks)
 
 	// --- Work Product Evaluation ---
-	mux.Handle("POST "+base+"/work-products/evaluation", jwt(h.AddWorkProductEvaluation))
-	mux.Handle("PUT "+base+"/work-products/evaluation", jwt(h.UpdateWorkProductEvaluation))
-	mux.Handle("GET "+base+"/work-products/evaluation/by-product", jwt(h.GetWorkProductEvaluation))
-	mux.Handle("POST "+base+"/work-products/re-evaluate", jwt(h.InitiateWorkProductReEvaluation))
-	mux.Handle("POST "+base+"/work-products/recalculate", jwt(h.ReCalculateWorkProductPoints))
+	routes.handle("POST "+base+"/work-products/evaluation", h.AddWorkProductEvaluation)
+	routes.handle("PUT "+base+"/work-products/evaluation", h.UpdateWorkProductEvaluation)
+	routes.handle("GET "+base+"/work-products/evaluation/by-product", h.GetWorkProductEvaluation)
+	routes.handle("POST "+base+"/work-products/re-evaluate", h.InitiateWorkProductReEvaluation)
+	routes.handle("POST "+base+"/work-products/recalculate", h.ReCalculateWorkProductPoints)
 
 	// --- Period Objective Evaluation ---
-	mux.Handle("POST "+base+"/evaluations/draft", jwt(h.SaveDraftEvaluation))
-	mux.Handle("POST "+base+"/evaluations", jwt(h.AddEvaluation))
-	mux.Handle("POST "+base+"/evaluations/submit-draft", jwt(h.SubmitDraftEvaluation))
-	mux.Handle("POST "+base+"/evaluations/approve", jwt(h.ApproveEvaluation))
-	mux.Handle("POST "+base+"/evaluations/reject", jwt(h.RejectEvaluation))
-	mux.Handle("GET "+base+"/evaluations", jwt(h.GetStaffEvaluations))
+	routes.handle("POST "+base+"/evaluations/draft", h.SaveDraftEvaluation)
+	routes.handle("POST "+base+"/evaluations", h.AddEvaluation)
+	routes.handle("POST "+base+"/evaluations/submit-draft", h.SubmitDraftEvaluation)
+	routes.handle("POST "+base+"/evaluations/approve", h.ApproveEvaluation)
+	routes.handle("POST "+base+"/evaluations/reject", h.RejectEvaluation)
+	routes.handle("GET "+base+"/evaluations", h.GetStaffEvaluations)
 
 	// --- Feedback ---
-	mux.Handle("POST "+base+"/feedback/request", jwt(h.RequestFeedback))
-	mux.Handle("GET "+base+"/feedback/requests", jwt(h.GetFeedbackRequests))
-	mux.Handle("POST "+base+"/feedback/process", jwt(h.ProcessFeedback))
-	mux.Handle("GET "+base+"/feedback/pending", jwt(h.GetPendingFeedbackActions))
+	routes.handle("POST "+base+"/feedback/request", h.RequestFeedback)
+	routes.handle("GET "+base+"/feedback/requests", h.GetFeedbackRequests)
+	routes.handle("POST "+base+"/feedback/process", h.ProcessFeedback)
+	routes.handle("GET "+base+"/feedback/pending", h.GetPendingFeedbackActions)
 
 	// --- Scoring ---
-	mux.Handle("GET "+base+"/scores", jwt(h.GetPerformanceScore))
-	mux.Handle("GET "+base+"/dashboard", jwt(h.GetDashboardStats))
-	mux.Handle("GET "+base+"/scores/summary", jwt(h.GetPerformanceSummary))
+	routes.handle("GET "+base+"/scores", h.GetPerformanceScore)
+	routes.handle("GET "+base+"/dashboard", h.GetDashboardStats)
+	routes.handle("GET "+base+"/scores/summary", h.GetPerformanceSummary)
 
 	// --- Period Objective Planning (Individual Objectives) ---
-	mux.Handle("POST "+base+"/individual-objectives/draft", jwt(h.SaveDraftIndividualPlannedObjective))
-	mux.Handle("POST "+base+"/individual-objectives", jwt(h.AddIndividualPlannedObjective))
-	mux.Handle("POST "+base+"/individual-objectives/submit-draft", jwt(h.SubmitDraftIndividualObjective))
-	mux.Handle("POST "+base+"/individual-objectives/approve", jwt(h.ApproveIndividualObjective))
-	mux.Handle("POST "+base+"/individual-objectives/reject", jwt(h.RejectIndividualObjective))
-	mux.Handle("POST "+base+"/individual-objectives/return", jwt(h.ReturnIndividualObjective))
-	mux.Handle("POST "+base+"/individual-objectives/cancel", jwt(h.CancelIndividualObjective))
-	mux.Handle("GET "+base+"/individual-objectives", jwt(h.GetStaffIndividualObjectives))
+	routes.handle("POST "+base+"/individual-objectives/draft", h.SaveDraftIndividualPlannedObjective)
+	routes.handle("POST "+base+"/individual-objectives", h.AddIndividualPlannedObjective)
+	routes.handle("POST "+base+"/individual-objectives/submit-draft", h.SubmitDraftIndividualObjective)
+	routes.handle("POST "+base+"/individual-objectives/approve", h.ApproveIndividualObjective)
+	routes.handle("POST "+base+"/individual-objectives/reject", h.RejectIndividualObjective)
+	routes.handle("POST "+base+"/individual-objectives/return", h.ReturnIndividualObjective)
+	routes.handle("POST "+base+"/individual-objectives/cancel", h.CancelIndividualObjective)
+	routes.handle("GET "+base+"/individual-objectives", h.GetStaffIndividualObjectives)
 
 	// --- 360 Review ---
-	mux.Handle("POST "+base+"/360-review/trigger", jwt(h.Trigger360Review))
-	mux.Handle("POST "+base+"/360-review/initiate", jwt(h.Initiate360Review))
-	mux.Handle("POST "+base+"/360-review/complete", jwt(h.Complete360ReviewForStaff))
-	mux.Handle("POST "+base+"/360-review/rating", jwt(h.Add360Rating))
-	mux.Handle("PUT "+base+"/360-review/rating", jwt(h.Update360Rating))
-	mux.Handle("POST "+base+"/360-review/reviewer-complete", jwt(h.ReviewerComplete360Review))
+	routes.handle("POST "+base+"/360-review/trigger", h.Trigger360Review)
+	routes.handle("POST "+base+"/360-review/initiate", h.Initiate360Review)
+	routes.handle("POST "+base+"/360-review/complete", h.Complete360ReviewForStaff)
+	routes.handle("POST "+base+"/360-review/rating", h.Add360Rating)
+	routes.handle("PUT "+base+"/360-review/rating", h.Update360Rating)
+	routes.handle("POST "+base+"/360-review/reviewer-complete", h.ReviewerComplete360Review)
 
 	// --- Competency Review ---
-	mux.Handle("GET "+base+"/competency-review/feedback-details", jwt(h.GetCompetencyReviewFeedbackDetails))
-	mux.Handle("GET "+base+"/competency-review/detail", jwt(h.GetCompetencyReviewDetail))
-	mux.Handle("GET "+base+"/competency-review/feedbacks", jwt(h.GetAllCompetencyReviewFeedbacksByReviewPeriod))
-	mux.Handle("GET "+base+"/competency-review/my-reviewed", jwt(h.GetAllMyReviewedCompetencies))
-	mux.Handle("GET "+base+"/competency-review/to-review", jwt(h.GetCompetenciesToReview))
-	mux.Handle("GET "+base+"/competency-review/reviewer/{reviewerId}", jwt(h.GetReviewerFeedbackDetails))
-	mux.Handle("GET "+base+"/competency-review/questionnaire", jwt(h.GetQuestionnaire))
-	mux.Handle("POST "+base+"/competency-review/gap-closure", jwt(h.CompetencyGapClosureSetup))
+	routes.handle("GET "+base+"/competency-review/feedback-details", h.GetCompetencyReviewFeedbackDetails)
+	routes.handle("GET "+base+"/competency-review/detail", h.GetCompetencyReviewDetail)
+	routes.handle("GET "+base+"/competency-review/feedbacks", h.GetAllCompetencyReviewFeedbacksByReviewPeriod)
+	routes.handle("GET "+base+"/competency-review/my-reviewed", h.GetAllMyReviewedCompetencies)
+	routes.handle("GET "+base+"/competency-review/to-review", h.GetCompetenciesToReview)
+	routes.handle("GET "+base+"/competency-review/reviewer/{reviewerId}", h.GetReviewerFeedbackDetails)
+	routes.handle("GET "+base+"/competency-review/questionnaire", h.GetQuestionnaire)
+	routes.handle("POST "+base+"/competency-review/gap-closure", h.CompetencyGapClosureSetup)
+	routes.handle("GET "+base+"/competency-review/gap-closure/report", h.GetCompetencyGapClosureReport)
 
 	// --- Feedback Requests (Extended) ---
-	mux.Handle("GET "+base+"/feedback/requests/staff", jwt(h.GetStaffRequests))
-	mux.Handle("GET "+base+"/feedback/requests/breached", jwt(h.GetBreachedRequests))
-	mux.Handle("GET "+base+"/feedback/requests/staff/by-status", jwt(h.GetStaffRequestsByStatus))
-	mux.Handle("GET "+base+"/feedback/requests/all", jwt(h.GetAllRequests))
-	mux.Handle("GET "+base+"/feedback/requests/by-status", jwt(h.GetRequestsByStatus))
-	mux.Handle("GET "+base+"/feedback/requests/{requestId}", jwt(h.GetRequestDetails))
-	mux.Handle("POST "+base+"/feedback/requests/reassign", jwt(h.ReassignRequest))
-	mux.Handle("POST "+base+"/feedback/requests/reassign-self", jwt(h.ReassignSelfRequest))
-	mux.Handle("POST "+base+"/feedback/requests/close", jwt(h.CloseRequest))
-	mux.Handle("POST "+base+"/feedback/requests/treat", jwt(h.TreatAssignedRequest))
+	routes.handle("GET "+base+"/feedback/requests/staff", h.GetStaffRequests)
+	routes.handle("GET "+base+"/feedback/requests/breached", h.GetBreachedRequests)
+	routes.handle("GET "+base+"/feedback/requests/staff/by-status", h.GetStaffRequestsByStatus)
+	routes.handle("GET "+base+"/feedback/requests/all", h.GetAllRequests)
+	routes.handle("GET "+base+"/feedback/requests/by-status", h.GetRequestsByStatus)
+	routes.handle("GET "+base+"/feedback/requests/{requestId}", h.GetRequestDetails)
+	routes.handle("POST "+base+"/feedback/requests/reassign", h.ReassignRequest)
+	routes.handle("POST "+base+"/feedback/requests/reassign-self", h.ReassignSelfRequest)
+	routes.handle("POST "+base+"/feedback/requests/close", h.CloseRequest)
+	routes.handle("POST "+base+"/feedback/requests/treat", h.TreatAssignedRequest)
+	routes.handle("POST "+base+"/feedback/requests/update", h.UpdateFeedbackRequest)
 
 	// --- Dashboard & Statistics ---
-	mux.Handle("GET "+base+"/stats/requests", jwt(h.GetRequestStatistics))
-	mux.Handle("GET "+base+"/stats/performance", jwt(h.GetStaffPerformanceStatistics))
-	mux.Handle("GET "+base+"/stats/work-products", jwt(h.GetStaffWorkProductsStatistics))
-	mux.Handle("GET "+base+"/stats/work-products-details", jwt(h.GetStaffWorkProductsDetailsStatistics))
+	routes.handle("GET "+base+"/stats/requests", h.GetRequestStatistics)
+	routes.handle("GET "+base+"/stats/performance", h.GetStaffPerformanceStatistics)
+	routes.handle("GET "+base+"/stats/work-products", h.GetStaffWorkProductsStatistics)
+	routes.handle("GET "+base+"/stats/work-products-details", h.GetStaffWorkProductsDetailsStatistics)
 
 	// --- ScoreCard Statistics ---
-	mux.Handle("GET "+base+"/scorecard", jwt(h.GetStaffPerformanceScoreCardStatistics))
-	mux.Handle("GET "+base+"/scorecard/annual", jwt(h.GetStaffAnnualPerformanceScoreCardStatistics))
-	mux.Handle("GET "+base+"/scorecard/subordinates", jwt(h.GetSubordinatesStaffPerformanceScoreCardStatistics))
+	routes.handle("GET "+base+"/scorecard", h.GetStaffPerformanceScoreCardStatistics)
+	routes.handle("GET "+base+"/scorecard/annual", h.GetStaffAnnualPerformanceScoreCardStatistics)
+	routes.handle("GET "+base+"/scorecard/subordinates", h.GetSubordinatesStaffPerformanceScoreCardStatistics)
 
 	// --- Organogram Performance ---
-	mux.Handle("GET "+base+"/organogram-performance/list", jwt(h.GetOrganogramPerformanceSummaryListStatistics))
-	mux.Handle("GET "+base+"/organogram-performance", jwt(h.GetOrganogramPerformanceSummaryStatistics))
+	routes.handle("GET "+base+"/organogram-performance/list", h.GetOrganogramPerformanceSummaryListStatistics)
+	routes.handle("GET "+base+"/organogram-performance", h.GetOrganogramPerformanceSummaryStatistics)
 
 	// --- Period Scores ---
-	mux.Handle("GET "+base+"/period-scores/all", jwt(h.GetPeriodScores))
-	mux.Handle("GET "+base+"/period-scores", jwt(h.GetPeriodScoreDetails))
-	mux.Handle("GET "+base+"/staff-review-periods", jwt(h.GetStaffReviewPeriods))
+	routes.handle("GET "+base+"/period-scores/all", h.GetPeriodScores)
+	routes.handle("GET "+base+"/period-scores", h.GetPeriodScoreDetails)
+	routes.handle("GET "+base+"/staff-review-periods", h.GetStaffReviewPeriods)
 
 	// --- Audit Logs ---
-	mux.Handle("GET "+base+"/audit-logs/{id}", jwt(h.GetAuditLogDetails))
-	mux.Handle("GET "+base+"/audit-logs", jwt(h.GetAuditLogs))
+	routes.handle("GET "+base+"/audit-logs/{id}", h.GetAuditLogDetails)
+	routes.handle("GET "+base+"/audit-logs", h.GetAuditLogs)
 
 	// --- Line Manager & Staff ---
-	mux.Handle("GET "+base+"/line-manager-employees", jwt(h.GetLineManagerEmployees))
-	mux.Handle("GET "+base+"/adhoc-employees", jwt(h.GetAdhocAssignmentEmployees))
-	mux.Handle("GET "+base+"/my-staff", jwt(h.GetMyStaff))
+	routes.handle("GET "+base+"/line-manager-employees", h.GetLineManagerEmployees)
+	routes.handle("GET "+base+"/adhoc-employees", h.GetAdhocAssignmentEmployees)
+	routes.handle("GET "+base+"/my-staff", h.GetMyStaff)
 
 	// --- Password Management (AllowAnonymous) ---
-	mux.Handle("POST "+base+"/reset-password", http.HandlerFunc(h.ResetUserPassword))
+	routes.handle("POST "+base+"/reset-password", h.ResetUserPassword)
 }
@@ -3,6 +3,7 @@ package handler
 import (
 	"encoding/json"
 	"net/http"
+	"strconv"
 
 	"github.com/enterprise-pms/pms-api/internal/domain/performance"
 	"github.com/enterprise-pms/pms-api/internal/service"
@@ -219,3 +220,322 @@ func (h *PmsSetupHandler) ListAllPmsConfigurations(w http.ResponseWriter, r *htt
 
 	response.OK(w, result)
 }
+
+// ============================================================
+// Approval Chain Endpoints
+// ============================================================
+
+// ListApprovalChains handles GET /api/v1/setup/approval-chains
+// Returns stored approval chains plus the built-in chain for every entity
+// type that has no active stored chain.
+func (h *PmsSetupHandler) ListApprovalChains(w http.ResponseWriter, r *http.Request) {
+	result, err := h.svc.ApprovalChain.GetApprovalChains(r.Context())
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "ListApprovalChains").Msg("Failed to list approval chains")
+		response.Error(w, http.StatusInternalServerError, "Failed to retrieve approval chains")
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// GetApprovalChain handles GET /api/v1/setup/approval-chains/{chainId}
+func (h *PmsSetupHandler) GetApprovalChain(w http.ResponseWriter, r *http.Request) {
+	chainID := r.PathValue("chainId")
+	if chainID == "" {
+		response.Error(w, http.StatusBadRequest, "Approval chain ID is required")
+		return
+	}
+
+	result, err := h.svc.ApprovalChain.GetApprovalChain(r.Context(), chainID)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "GetApprovalChain").Str("chainId", chainID).Msg("Failed to get approval chain")
+		response.Error(w, http.StatusInternalServerError, "Failed to retrieve approval chain")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusNotFound, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// AddApprovalChain handles POST /api/v1/setup/approval-chains
+func (h *PmsSetupHandler) AddApprovalChain(w http.ResponseWriter, r *http.Request) {
+	h.saveApprovalChain(w, r, false)
+}
+
+// UpdateApprovalChain handles PUT /api/v1/setup/approval-chains
+func (h *PmsSetupHandler) UpdateApprovalChain(w http.ResponseWriter, r *http.Request) {
+	h.saveApprovalChain(w, r, true)
+}
+
+func (h *PmsSetupHandler) saveApprovalChain(w http.ResponseWriter, r *http.Request, isUpdate bool) {
+	var req performance.ApprovalChainRequestModel
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if isUpdate && req.ApprovalChainID == "" {
+		response.Error(w, http.StatusBadRequest, "Approval chain ID is required")
+		return
+	}
+	if !isUpdate {
+		req.ApprovalChainID = ""
+	}
+
+	if req.Name == "" || req.EntityType == 0 {
+		response.Error(w, http.StatusBadRequest, "Name and entity type are required")
+		return
+	}
+
+	req.UpdatedBy = h.svc.UserContext.GetUserID(r.Context())
+
+	result, err := h.svc.ApprovalChain.SaveApprovalChain(r.Context(), &req)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "SaveApprovalChain").Msg("Failed to save approval chain")
+		response.Error(w, http.StatusInternalServerError, "Failed to save approval chain")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusBadRequest, result.Message)
+		return
+	}
+
+	if isUpdate {
+		response.OK(w, result)
+		return
+	}
+	response.Created(w, result)
+}
+
+// DeleteApprovalChain handles DELETE /api/v1/setup/approval-chains/{chainId}
+// The entity type falls back to the built-in chain once its chain is removed.
+func (h *PmsSetupHandler) DeleteApprovalChain(w http.ResponseWriter, r *http.Request) {
+	chainID := r.PathValue("chainId")
+	if chainID == "" {
+		response.Error(w, http.StatusBadRequest, "Approval chain ID is required")
+		return
+	}
+
+	result, err := h.svc.ApprovalChain.DeleteApprovalChain(r.Context(), chainID)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "DeleteApprovalChain").Str("chainId", chainID).Msg("Failed to delete approval chain")
+		response.Error(w, http.StatusInternalServerError, "Failed to delete approval chain")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusNotFound, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// ============================================================
+// Grading Scale Endpoints
+// ============================================================
+
+// ListGradingScales handles GET /api/v1/setup/grading-scales
+// Returns every stored scale version plus the built-in default scale.
+func (h *PmsSetupHandler) ListGradingScales(w http.ResponseWriter, r *http.Request) {
+	result, err := h.svc.GradingScale.GetGradingScales(r.Context())
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "ListGradingScales").Msg("Failed to list grading scales")
+		response.Error(w, http.StatusInternalServerError, "Failed to retrieve grading scales")
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// GetGradingScale handles GET /api/v1/setup/grading-scales/{scaleId}
+func (h *PmsSetupHandler) GetGradingScale(w http.ResponseWriter, r *http.Request) {
+	scaleID := r.PathValue("scaleId")
+	if scaleID == "" {
+		response.Error(w, http.StatusBadRequest, "Grading scale ID is required")
+		return
+	}
+
+	result, err := h.svc.GradingScale.GetGradingScale(r.Context(), scaleID)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "GetGradingScale").Str("scaleId", scaleID).Msg("Failed to get grading scale")
+		response.Error(w, http.StatusInternalServerError, "Failed to retrieve grading scale")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusNotFound, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// AddGradingScale handles POST /api/v1/setup/grading-scales
+func (h *PmsSetupHandler) AddGradingScale(w http.ResponseWriter, r *http.Request) {
+	h.saveGradingScale(w, r, false)
+}
+
+// UpdateGradingScale handles PUT /api/v1/setup/grading-scales
+// Creates a new version of the referenced scale.
+func (h *PmsSetupHandler) UpdateGradingScale(w http.ResponseWriter, r *http.Request) {
+	h.saveGradingScale(w, r, true)
+}
+
+func (h *PmsSetupHandler) saveGradingScale(w http.ResponseWriter, r *http.Request, isUpdate bool) {
+	var req performance.GradingScaleRequestModel
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if isUpdate && req.GradingScaleID == "" {
+		response.Error(w, http.StatusBadRequest, "Grading scale ID is required")
+		return
+	}
+	if !isUpdate {
+		req.GradingScaleID = ""
+		if req.Name == "" {
+			response.Error(w, http.StatusBadRequest, "Name is required")
+			return
+		}
+	}
+
+	req.UpdatedBy = h.svc.UserContext.GetUserID(r.Context())
+
+	result, err := h.svc.GradingScale.SaveGradingScale(r.Context(), &req)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "SaveGradingScale").Msg("Failed to save grading scale")
+		response.Error(w, http.StatusInternalServerError, "Failed to save grading scale")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusBadRequest, result.Message)
+		return
+	}
+
+	response.Created(w, result)
+}
+
+// DeleteGradingScale handles DELETE /api/v1/setup/grading-scales/{scaleId}
+// Scales attached to a strategy or review period cannot be deleted.
+func (h *PmsSetupHandler) DeleteGradingScale(w http.ResponseWriter, r *http.Request) {
+	scaleID := r.PathValue("scaleId")
+	if scaleID == "" {
+		response.Error(w, http.StatusBadRequest, "Grading scale ID is required")
+		return
+	}
+
+	result, err := h.svc.GradingScale.DeleteGradingScale(r.Context(), scaleID)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "DeleteGradingScale").Str("scaleId", scaleID).Msg("Failed to delete grading scale")
+		response.Error(w, http.StatusInternalServerError, "Failed to delete grading scale")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusBadRequest, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// AssignGradingScale handles PUT /api/v1/setup/grading-scales/assignment
+// Attaches a scale to a review period or strategy; an empty scale ID detaches it.
+func (h *PmsSetupHandler) AssignGradingScale(w http.ResponseWriter, r *http.Request) {
+	var req performance.GradingScaleAssignmentRequestModel
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	req.UpdatedBy = h.svc.UserContext.GetUserID(r.Context())
+
+	result, err := h.svc.GradingScale.AssignGradingScale(r.Context(), &req)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "AssignGradingScale").Msg("Failed to assign grading scale")
+		response.Error(w, http.StatusInternalServerError, "Failed to assign grading scale")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusBadRequest, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// ============================================================
+// SLA Escalation Endpoints
+// ============================================================
+
+// ListSLAEscalationLadders handles GET /api/v1/setup/sla-escalations
+// Returns the escalation ladder of every feedback request type, stored or
+// built-in.
+func (h *PmsSetupHandler) ListSLAEscalationLadders(w http.ResponseWriter, r *http.Request) {
+	result, err := h.svc.SLAEscalation.GetSLAEscalationLadders(r.Context())
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "ListSLAEscalationLadders").Msg("Failed to list SLA escalation ladders")
+		response.Error(w, http.StatusInternalServerError, "Failed to retrieve SLA escalation ladders")
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// SaveSLAEscalationLadder handles PUT /api/v1/setup/sla-escalations
+// Replaces the escalation ladder of a feedback request type.
+func (h *PmsSetupHandler) SaveSLAEscalationLadder(w http.ResponseWriter, r *http.Request) {
+	var req performance.SLAEscalationLadderRequestModel
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if req.FeedbackRequestType == 0 || len(req.Steps) == 0 {
+		response.Error(w, http.StatusBadRequest, "Feedback request type and at least one step are required")
+		return
+	}
+
+	req.UpdatedBy = h.svc.UserContext.GetUserID(r.Context())
+
+	result, err := h.svc.SLAEscalation.SaveSLAEscalationLadder(r.Context(), &req)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "SaveSLAEscalationLadder").Msg("Failed to save SLA escalation ladder")
+		response.Error(w, http.StatusInternalServerError, "Failed to save SLA escalation ladder")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusBadRequest, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// DeleteSLAEscalationLadder handles DELETE /api/v1/setup/sla-escalations/{feedbackRequestType}
+// The feedback request type falls back to the built-in ladder once its ladder
+// is removed.
+func (h *PmsSetupHandler) DeleteSLAEscalationLadder(w http.ResponseWriter, r *http.Request) {
+	requestType, err := strconv.Atoi(r.PathValue("feedbackRequestType"))
+	if err != nil || requestType <= 0 {
+		response.Error(w, http.StatusBadRequest, "Invalid feedback request type")
+		return
+	}
+	userID := h.svc.UserContext.GetUserID(r.Context())
+
+	result, err := h.svc.SLAEscalation.DeleteSLAEscalationLadder(r.Context(), requestType, userID)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "DeleteSLAEscalationLadder").Int("feedbackRequestType", requestType).Msg("Failed to delete SLA escalation ladder")
+		response.Error(w, http.StatusInternalServerError, "Failed to delete SLA escalation ladder")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusNotFound, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
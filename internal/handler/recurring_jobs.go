@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"github.com/enterprise-pms/pms-api/internal/service"
+	"github.com/enterprise-pms/pms-api/pkg/response"
+	"github.com/rs/zerolog"
+)
+
+// RecurringJobHandler handles the admin endpoints that control the
+// scheduler's recurring jobs.
+type RecurringJobHandler struct {
+	svc *service.Container
+	log zerolog.Logger
+}
+
+// NewRecurringJobHandler creates a new recurring job handler.
+func NewRecurringJobHandler(svc *service.Container, log zerolog.Logger) *RecurringJobHandler {
+	return &RecurringJobHandler{svc: svc, log: log}
+}
+
+// ListRecurringJobs handles GET /api/v1/recurring-jobs
+// Returns every recurring job with its schedule and last/next run.
+func (h *RecurringJobHandler) ListRecurringJobs(w http.ResponseWriter, r *http.Request) {
+	result, err := h.svc.RecurringJob.ListRecurringJobs(r.Context())
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "ListRecurringJobs").Msg("Failed to list recurring jobs")
+		response.Error(w, http.StatusInternalServerError, "Failed to retrieve recurring jobs")
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// UpdateSchedule handles PUT /api/v1/recurring-jobs/{jobName}/schedule
+// Replaces the cron schedule of a job.
+func (h *RecurringJobHandler) UpdateSchedule(w http.ResponseWriter, r *http.Request) {
+	jobName := r.PathValue("jobName")
+	if jobName == "" {
+		response.Error(w, http.StatusBadRequest, "Job name is required")
+		return
+	}
+
+	var req performance.RecurringJobScheduleRequestModel
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	req.JobName = jobName
+	req.UpdatedBy = h.svc.UserContext.GetUserID(r.Context())
+
+	result, err := h.svc.RecurringJob.UpdateSchedule(r.Context(), &req)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", "UpdateSchedule").Str("jobName", jobName).Msg("Failed to update recurring job schedule")
+		response.Error(w, http.StatusInternalServerError, "Failed to update recurring job schedule")
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusBadRequest, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// PauseJob handles POST /api/v1/recurring-jobs/{jobName}/pause
+func (h *RecurringJobHandler) PauseJob(w http.ResponseWriter, r *http.Request) {
+	h.control(w, r, "PauseJob", "Failed to pause recurring job", h.svc.RecurringJob.PauseJob)
+}
+
+// ResumeJob handles POST /api/v1/recurring-jobs/{jobName}/resume
+func (h *RecurringJobHandler) ResumeJob(w http.ResponseWriter, r *http.Request) {
+	h.control(w, r, "ResumeJob", "Failed to resume recurring job", h.svc.RecurringJob.ResumeJob)
+}
+
+// TriggerJob handles POST /api/v1/recurring-jobs/{jobName}/trigger
+// Requests a run now, outside the job's schedule.
+func (h *RecurringJobHandler) TriggerJob(w http.ResponseWriter, r *http.Request) {
+	h.control(w, r, "TriggerJob", "Failed to trigger recurring job", h.svc.RecurringJob.TriggerJob)
+}
+
+// control runs a job action that takes only the job name and the caller.
+func (h *RecurringJobHandler) control(
+	w http.ResponseWriter,
+	r *http.Request,
+	action, failure string,
+	fn func(ctx context.Context, jobName, requestedBy string) (performance.ResponseVm, error),
+) {
+	jobName := r.PathValue("jobName")
+	if jobName == "" {
+		response.Error(w, http.StatusBadRequest, "Job name is required")
+		return
+	}
+	userID := h.svc.UserContext.GetUserID(r.Context())
+
+	result, err := fn(r.Context(), jobName, userID)
+	if err != nil {
+		h.log.Error().Err(err).Str("action", action).Str("jobName", jobName).Msg(failure)
+		response.Error(w, http.StatusInternalServerError, failure)
+		return
+	}
+	if result.HasError {
+		response.Error(w, http.StatusBadRequest, result.Message)
+		return
+	}
+
+	response.OK(w, result)
+}
@@ -136,7 +136,7 @@ func (h *ReviewPeriodHandler) SaveDraftReviewPeriod(w http.ResponseWriter, r *ht
 	result, err := h.svc.ReviewPeriod.SaveDraftReviewPeriod(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "SaveDraftReviewPeriod").Msg("Failed to save draft review period")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -155,7 +155,7 @@ func (h *ReviewPeriodHandler) AddReviewPeriod(w http.ResponseWriter, r *http.Req
 	result, err := h.svc.ReviewPeriod.AddReviewPeriod(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "AddReviewPeriod").Msg("Failed to add review period")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -174,7 +174,7 @@ func (h *ReviewPeriodHandler) SubmitDraftReviewPeriod(w http.ResponseWriter, r *
 	result, err := h.svc.ReviewPeriod.SubmitDraftReviewPeriod(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "SubmitDraftReviewPeriod").Msg("Failed to submit draft review period")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -193,7 +193,7 @@ func (h *ReviewPeriodHandler) ApproveReviewPeriod(w http.ResponseWriter, r *http
 	result, err := h.svc.ReviewPeriod.ApproveReviewPeriod(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ApproveReviewPeriod").Msg("Failed to approve review period")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -212,7 +212,7 @@ func (h *ReviewPeriodHandler) RejectReviewPeriod(w http.ResponseWriter, r *http.
 	result, err := h.svc.ReviewPeriod.RejectReviewPeriod(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "RejectReviewPeriod").Msg("Failed to reject review period")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -231,7 +231,7 @@ func (h *ReviewPeriodHandler) ReturnReviewPeriod(w http.ResponseWriter, r *http.
 	result, err := h.svc.ReviewPeriod.ReturnReviewPeriod(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ReturnReviewPeriod").Msg("Failed to return review period")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -250,7 +250,7 @@ func (h *ReviewPeriodHandler) ReSubmitReviewPeriod(w http.ResponseWriter, r *htt
 	result, err := h.svc.ReviewPeriod.ReSubmitReviewPeriod(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ReSubmitReviewPeriod").Msg("Failed to resubmit review period")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -269,7 +269,7 @@ func (h *ReviewPeriodHandler) UpdateReviewPeriod(w http.ResponseWriter, r *http.
 	result, err := h.svc.ReviewPeriod.UpdateReviewPeriod(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "UpdateReviewPeriod").Msg("Failed to update review period")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -288,7 +288,7 @@ func (h *ReviewPeriodHandler) CancelReviewPeriod(w http.ResponseWriter, r *http.
 	result, err := h.svc.ReviewPeriod.CancelReviewPeriod(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "CancelReviewPeriod").Msg("Failed to cancel review period")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -307,7 +307,7 @@ func (h *ReviewPeriodHandler) CloseReviewPeriod(w http.ResponseWriter, r *http.R
 	result, err := h.svc.ReviewPeriod.CloseReviewPeriod(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "CloseReviewPeriod").Msg("Failed to close review period")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -330,7 +330,7 @@ func (h *ReviewPeriodHandler) EnableObjectivePlanning(w http.ResponseWriter, r *
 	result, err := h.svc.ReviewPeriod.EnableObjectivePlanning(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "EnableObjectivePlanning").Msg("Failed to enable objective planning")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -349,7 +349,7 @@ func (h *ReviewPeriodHandler) DisableObjectivePlanning(w http.ResponseWriter, r
 	result, err := h.svc.ReviewPeriod.DisableObjectivePlanning(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "DisableObjectivePlanning").Msg("Failed to disable objective planning")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -368,7 +368,7 @@ func (h *ReviewPeriodHandler) EnableWorkProductPlanning(w http.ResponseWriter, r
 	result, err := h.svc.ReviewPeriod.EnableWorkProductPlanning(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "EnableWorkProductPlanning").Msg("Failed to enable work product planning")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -387,7 +387,7 @@ func (h *ReviewPeriodHandler) DisableWorkProductPlanning(w http.ResponseWriter,
 	result, err := h.svc.ReviewPeriod.DisableWorkProductPlanning(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "DisableWorkProductPlanning").Msg("Failed to disable work product planning")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -406,7 +406,7 @@ func (h *ReviewPeriodHandler) EnableWorkProductEvaluation(w http.ResponseWriter,
 	result, err := h.svc.ReviewPeriod.EnableWorkProductEvaluation(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "EnableWorkProductEvaluation").Msg("Failed to enable work product evaluation")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -425,7 +425,7 @@ func (h *ReviewPeriodHandler) DisableWorkProductEvaluation(w http.ResponseWriter
 	result, err := h.svc.ReviewPeriod.DisableWorkProductEvaluation(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "DisableWorkProductEvaluation").Msg("Failed to disable work product evaluation")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -442,7 +442,7 @@ func (h *ReviewPeriodHandler) GetActiveReviewPeriod(w http.ResponseWriter, r *ht
 	result, err := h.svc.ReviewPeriod.GetActiveReviewPeriod(r.Context())
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetActiveReviewPeriod").Msg("Failed to get active review period")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -461,7 +461,7 @@ func (h *ReviewPeriodHandler) GetStaffActiveReviewPeriod(w http.ResponseWriter,
 	result, err := h.svc.ReviewPeriod.GetStaffActiveReviewPeriod(r.Context(), staffID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetStaffActiveReviewPeriod").Str("staffId", staffID).Msg("Failed to get staff active review period")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -480,7 +480,7 @@ func (h *ReviewPeriodHandler) GetReviewPeriodDetails(w http.ResponseWriter, r *h
 	result, err := h.svc.ReviewPeriod.GetReviewPeriodDetails(r.Context(), reviewPeriodID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetReviewPeriodDetails").Str("reviewPeriodId", reviewPeriodID).Msg("Failed to get review period details")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -509,7 +509,7 @@ func (h *ReviewPeriodHandler) SaveDraftReviewPeriodObjective(w http.ResponseWrit
 	result, err := h.svc.ReviewPeriod.SaveDraftReviewPeriodObjective(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "SaveDraftReviewPeriodObjective").Msg("Failed to save draft review period objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -534,7 +534,7 @@ func (h *ReviewPeriodHandler) AddReviewPeriodObjective(w http.ResponseWriter, r
 	result, err := h.svc.ReviewPeriod.AddReviewPeriodObjective(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "AddReviewPeriodObjective").Msg("Failed to add review period objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -558,7 +558,7 @@ func (h *ReviewPeriodHandler) SubmitDraftReviewPeriodObjective(w http.ResponseWr
 	result, err := h.svc.ReviewPeriod.SubmitDraftReviewPeriodObjective(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "SubmitDraftReviewPeriodObjective").Msg("Failed to submit draft review period objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -582,7 +582,7 @@ func (h *ReviewPeriodHandler) CancelReviewPeriodObjective(w http.ResponseWriter,
 	result, err := h.svc.ReviewPeriod.CancelReviewPeriodObjective(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "CancelReviewPeriodObjective").Msg("Failed to cancel review period objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -601,7 +601,7 @@ func (h *ReviewPeriodHandler) GetReviewPeriodObjectives(w http.ResponseWriter, r
 	result, err := h.svc.ReviewPeriod.GetReviewPeriodObjectives(r.Context(), reviewPeriodID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetReviewPeriodObjectives").Str("reviewPeriodId", reviewPeriodID).Msg("Failed to get review period objectives")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -624,7 +624,7 @@ func (h *ReviewPeriodHandler) SaveDraftReviewPeriodObjectiveCategoryDefinition(w
 	result, err := h.svc.ReviewPeriod.SaveDraftCategoryDefinition(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "SaveDraftReviewPeriodObjectiveCategoryDefinition").Msg("Failed to save draft category definition")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -643,7 +643,7 @@ func (h *ReviewPeriodHandler) AddReviewPeriodObjectiveCategoryDefinition(w http.
 	result, err := h.svc.ReviewPeriod.AddCategoryDefinition(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "AddReviewPeriodObjectiveCategoryDefinition").Msg("Failed to add category definition")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -662,7 +662,7 @@ func (h *ReviewPeriodHandler) SubmitDraftReviewPeriodObjectiveCategoryDefinition
 	result, err := h.svc.ReviewPeriod.SubmitDraftCategoryDefinition(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "SubmitDraftReviewPeriodObjectiveCategoryDefinition").Msg("Failed to submit draft category definition")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -681,7 +681,7 @@ func (h *ReviewPeriodHandler) ApproveReviewPeriodObjectiveCategoryDefinition(w h
 	result, err := h.svc.ReviewPeriod.ApproveCategoryDefinition(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ApproveReviewPeriodObjectiveCategoryDefinition").Msg("Failed to approve category definition")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -700,7 +700,7 @@ func (h *ReviewPeriodHandler) RejectReviewPeriodObjectiveCategoryDefinition(w ht
 	result, err := h.svc.ReviewPeriod.RejectCategoryDefinition(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "RejectReviewPeriodObjectiveCategoryDefinition").Msg("Failed to reject category definition")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -723,7 +723,7 @@ func (h *ReviewPeriodHandler) AddReviewPeriodExtension(w http.ResponseWriter, r
 	result, err := h.svc.ReviewPeriod.AddReviewPeriodExtension(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "AddReviewPeriodExtension").Msg("Failed to add review period extension")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -742,7 +742,7 @@ func (h *ReviewPeriodHandler) GetReviewPeriodExtensions(w http.ResponseWriter, r
 	result, err := h.svc.ReviewPeriod.GetReviewPeriodExtensions(r.Context(), reviewPeriodID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetReviewPeriodExtensions").Str("reviewPeriodId", reviewPeriodID).Msg("Failed to get review period extensions")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -760,7 +760,7 @@ func (h *ReviewPeriodHandler) SaveDraftReviewPeriodExtension(w http.ResponseWrit
 	result, err := h.svc.ReviewPeriod.SaveDraftReviewPeriodExtension(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "SaveDraftReviewPeriodExtension").Msg("Failed to save draft extension")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -778,7 +778,7 @@ func (h *ReviewPeriodHandler) SubmitDraftReviewPeriodExtension(w http.ResponseWr
 	result, err := h.svc.ReviewPeriod.SubmitDraftReviewPeriodExtension(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "SubmitDraftReviewPeriodExtension").Msg("Failed to submit draft extension")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -796,7 +796,7 @@ func (h *ReviewPeriodHandler) ApproveReviewPeriodExtension(w http.ResponseWriter
 	result, err := h.svc.ReviewPeriod.ApproveReviewPeriodExtension(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ApproveReviewPeriodExtension").Msg("Failed to approve extension")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -814,7 +814,7 @@ func (h *ReviewPeriodHandler) RejectReviewPeriodExtension(w http.ResponseWriter,
 	result, err := h.svc.ReviewPeriod.RejectReviewPeriodExtension(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "RejectReviewPeriodExtension").Msg("Failed to reject extension")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -832,7 +832,7 @@ func (h *ReviewPeriodHandler) ReturnReviewPeriodExtension(w http.ResponseWriter,
 	result, err := h.svc.ReviewPeriod.ReturnReviewPeriodExtension(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ReturnReviewPeriodExtension").Msg("Failed to return extension")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -850,7 +850,7 @@ func (h *ReviewPeriodHandler) ReSubmitReviewPeriodExtension(w http.ResponseWrite
 	result, err := h.svc.ReviewPeriod.ReSubmitReviewPeriodExtension(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ReSubmitReviewPeriodExtension").Msg("Failed to re-submit extension")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -868,7 +868,7 @@ func (h *ReviewPeriodHandler) UpdateReviewPeriodExtension(w http.ResponseWriter,
 	result, err := h.svc.ReviewPeriod.UpdateReviewPeriodExtension(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "UpdateReviewPeriodExtension").Msg("Failed to update extension")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -886,7 +886,7 @@ func (h *ReviewPeriodHandler) CancelReviewPeriodExtension(w http.ResponseWriter,
 	result, err := h.svc.ReviewPeriod.CancelReviewPeriodExtension(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "CancelReviewPeriodExtension").Msg("Failed to cancel extension")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -904,7 +904,7 @@ func (h *ReviewPeriodHandler) CloseReviewPeriodExtension(w http.ResponseWriter,
 	result, err := h.svc.ReviewPeriod.CloseReviewPeriodExtension(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "CloseReviewPeriodExtension").Msg("Failed to close extension")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -916,7 +916,7 @@ func (h *ReviewPeriodHandler) GetAllReviewPeriodExtensions(w http.ResponseWriter
 	result, err := h.svc.ReviewPeriod.GetAllReviewPeriodExtensions(r.Context())
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetAllReviewPeriodExtensions").Msg("Failed to get all review period extensions")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -938,7 +938,7 @@ func (h *ReviewPeriodHandler) AddReviewPeriod360Review(w http.ResponseWriter, r
 	result, err := h.svc.ReviewPeriod.AddReviewPeriod360Review(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "AddReviewPeriod360Review").Msg("Failed to add 360 review")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -956,7 +956,7 @@ func (h *ReviewPeriodHandler) GetReviewPeriod360Reviews(w http.ResponseWriter, r
 	result, err := h.svc.ReviewPeriod.GetReviewPeriod360Reviews(r.Context(), reviewPeriodID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetReviewPeriod360Reviews").Str("reviewPeriodId", reviewPeriodID).Msg("Failed to get 360 reviews")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -979,7 +979,7 @@ func (h *ReviewPeriodHandler) SaveDraftIndividualPlannedObjective(w http.Respons
 	result, err := h.svc.ReviewPeriod.SaveDraftIndividualPlannedObjective(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "SaveDraftIndividualPlannedObjective").Msg("Failed to save draft individual planned objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -998,7 +998,7 @@ func (h *ReviewPeriodHandler) AddIndividualPlannedObjective(w http.ResponseWrite
 	result, err := h.svc.ReviewPeriod.AddIndividualPlannedObjective(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "AddIndividualPlannedObjective").Msg("Failed to add individual planned objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -1017,7 +1017,7 @@ func (h *ReviewPeriodHandler) SubmitDraftIndividualPlannedObjective(w http.Respo
 	result, err := h.svc.ReviewPeriod.SubmitDraftIndividualPlannedObjective(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "SubmitDraftIndividualPlannedObjective").Msg("Failed to submit draft individual planned objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -1036,7 +1036,7 @@ func (h *ReviewPeriodHandler) ApproveIndividualPlannedObjective(w http.ResponseW
 	result, err := h.svc.ReviewPeriod.ApproveIndividualPlannedObjective(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ApproveIndividualPlannedObjective").Msg("Failed to approve individual planned objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -1055,7 +1055,7 @@ func (h *ReviewPeriodHandler) RejectIndividualPlannedObjective(w http.ResponseWr
 	result, err := h.svc.ReviewPeriod.RejectIndividualPlannedObjective(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "RejectIndividualPlannedObjective").Msg("Failed to reject individual planned objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -1074,7 +1074,7 @@ func (h *ReviewPeriodHandler) ReturnIndividualPlannedObjective(w http.ResponseWr
 	result, err := h.svc.ReviewPeriod.ReturnIndividualPlannedObjective(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ReturnIndividualPlannedObjective").Msg("Failed to return individual planned objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -1093,7 +1093,7 @@ func (h *ReviewPeriodHandler) CancelIndividualPlannedObjective(w http.ResponseWr
 	result, err := h.svc.ReviewPeriod.CancelIndividualPlannedObjective(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "CancelIndividualPlannedObjective").Msg("Failed to cancel individual planned objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -1114,7 +1114,7 @@ func (h *ReviewPeriodHandler) GetStaffIndividualPlannedObjectives(w http.Respons
 	result, err := h.svc.ReviewPeriod.GetStaffIndividualPlannedObjectives(r.Context(), staffID, reviewPeriodID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetStaffIndividualPlannedObjectives").Str("staffId", staffID).Str("reviewPeriodId", reviewPeriodID).Msg("Failed to get staff individual planned objectives")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -1132,7 +1132,7 @@ func (h *ReviewPeriodHandler) AcceptIndividualPlannedObjective(w http.ResponseWr
 	result, err := h.svc.ReviewPeriod.AcceptIndividualPlannedObjective(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "AcceptIndividualPlannedObjective").Msg("Failed to accept individual planned objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -1150,7 +1150,7 @@ func (h *ReviewPeriodHandler) ReInstateIndividualPlannedObjective(w http.Respons
 	result, err := h.svc.ReviewPeriod.ReInstateIndividualPlannedObjective(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ReInstateIndividualPlannedObjective").Msg("Failed to reinstate individual planned objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -1168,7 +1168,7 @@ func (h *ReviewPeriodHandler) PauseIndividualPlannedObjective(w http.ResponseWri
 	result, err := h.svc.ReviewPeriod.PauseIndividualPlannedObjective(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "PauseIndividualPlannedObjective").Msg("Failed to pause individual planned objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -1186,7 +1186,7 @@ func (h *ReviewPeriodHandler) SuspendIndividualPlannedObjective(w http.ResponseW
 	result, err := h.svc.ReviewPeriod.SuspendIndividualPlannedObjective(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "SuspendIndividualPlannedObjective").Msg("Failed to suspend individual planned objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -1204,7 +1204,7 @@ func (h *ReviewPeriodHandler) ResumeIndividualPlannedObjective(w http.ResponseWr
 	result, err := h.svc.ReviewPeriod.ResumeIndividualPlannedObjective(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ResumeIndividualPlannedObjective").Msg("Failed to resume individual planned objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -1222,7 +1222,7 @@ func (h *ReviewPeriodHandler) ReSubmitIndividualPlannedObjective(w http.Response
 	result, err := h.svc.ReviewPeriod.ReSubmitIndividualPlannedObjective(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ReSubmitIndividualPlannedObjective").Msg("Failed to re-submit individual planned objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -1244,7 +1244,7 @@ func (h *ReviewPeriodHandler) CreatePeriodObjectiveEvaluation(w http.ResponseWri
 	result, err := h.svc.ReviewPeriod.CreatePeriodObjectiveEvaluation(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "CreatePeriodObjectiveEvaluation").Msg("Failed to create period objective evaluation")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -1262,7 +1262,7 @@ func (h *ReviewPeriodHandler) CreatePeriodObjectiveDepartmentEvaluation(w http.R
 	result, err := h.svc.ReviewPeriod.CreatePeriodObjectiveDepartmentEvaluation(r.Context(), &vm)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "CreatePeriodObjectiveDepartmentEvaluation").Msg("Failed to create department objective evaluation")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -1280,7 +1280,7 @@ func (h *ReviewPeriodHandler) GetPeriodObjectiveEvaluations(w http.ResponseWrite
 	result, err := h.svc.ReviewPeriod.GetPeriodObjectiveEvaluations(r.Context(), reviewPeriodID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetPeriodObjectiveEvaluations").Str("reviewPeriodId", reviewPeriodID).Msg("Failed to get period objective evaluations")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -1298,7 +1298,7 @@ func (h *ReviewPeriodHandler) GetPeriodObjectiveDepartmentEvaluations(w http.Res
 	result, err := h.svc.ReviewPeriod.GetPeriodObjectiveDepartmentEvaluations(r.Context(), reviewPeriodID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetPeriodObjectiveDepartmentEvaluations").Str("reviewPeriodId", reviewPeriodID).Msg("Failed to get department objective evaluations")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -1322,7 +1322,7 @@ func (h *ReviewPeriodHandler) GetStaffPeriodScore(w http.ResponseWriter, r *http
 	result, err := h.svc.ReviewPeriod.GetStaffPeriodScore(r.Context(), staffID, reviewPeriodID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetStaffPeriodScore").Str("staffId", staffID).Str("reviewPeriodId", reviewPeriodID).Msg("Failed to get staff period score")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -1339,7 +1339,7 @@ func (h *ReviewPeriodHandler) GetReviewPeriods(w http.ResponseWriter, r *http.Re
 	result, err := h.svc.ReviewPeriod.GetReviewPeriods(r.Context())
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetReviewPeriods").Msg("Failed to get review periods")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -1358,7 +1358,7 @@ func (h *ReviewPeriodHandler) GetReviewPeriodCategoryDefinitions(w http.Response
 	result, err := h.svc.ReviewPeriod.GetReviewPeriodCategoryDefinitions(r.Context(), reviewPeriodID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetReviewPeriodCategoryDefinitions").Str("reviewPeriodId", reviewPeriodID).Msg("Failed to get category definitions")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -1377,7 +1377,7 @@ func (h *ReviewPeriodHandler) GetReviewPeriodObjectivesWithCategoryDefinitions(w
 	result, err := h.svc.ReviewPeriod.GetReviewPeriodObjectivesWithCategoryDefinitions(r.Context(), reviewPeriodID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetReviewPeriodObjectivesWithCategoryDefinitions").Str("reviewPeriodId", reviewPeriodID).Msg("Failed to get objectives with category definitions")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -1396,7 +1396,7 @@ func (h *ReviewPeriodHandler) GetAllPlannedOperationalObjectives(w http.Response
 	result, err := h.svc.ReviewPeriod.GetAllPlannedOperationalObjectives(r.Context(), reviewPeriodID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetAllPlannedOperationalObjectives").Str("reviewPeriodId", reviewPeriodID).Msg("Failed to get all planned operational objectives")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -1424,7 +1424,7 @@ func (h *ReviewPeriodHandler) GetObjectivesByWorkproductStatus(w http.ResponseWr
 	result, err := h.svc.ReviewPeriod.GetObjectivesByWorkproductStatus(r.Context(), reviewPeriodID, staffID, enums.Status(statusInt))
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetObjectivesByWorkproductStatus").Msg("Failed to get objectives by workproduct status")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -1443,7 +1443,7 @@ func (h *ReviewPeriodHandler) GetPlannedObjective(w http.ResponseWriter, r *http
 	result, err := h.svc.ReviewPeriod.GetPlannedObjective(r.Context(), plannedObjectiveID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetPlannedObjective").Str("plannedObjectiveId", plannedObjectiveID).Msg("Failed to get planned objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -1470,7 +1470,7 @@ func (h *ReviewPeriodHandler) GetEnterpriseObjectiveByLevel(w http.ResponseWrite
 	result, err := h.svc.ReviewPeriod.GetEnterpriseObjectiveByLevel(r.Context(), objectiveID, level)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "GetEnterpriseObjectiveByLevel").Str("objectiveId", objectiveID).Msg("Failed to get enterprise objective")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -1491,7 +1491,7 @@ func (h *ReviewPeriodHandler) ArchiveCancelledObjectives(w http.ResponseWriter,
 	result, err := h.svc.ReviewPeriod.ArchiveCancelledObjectives(r.Context(), staffID, reviewPeriodID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ArchiveCancelledObjectives").Str("staffId", staffID).Str("reviewPeriodId", reviewPeriodID).Msg("Failed to archive cancelled objectives")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -1512,7 +1512,7 @@ func (h *ReviewPeriodHandler) ArchiveCancelledWorkProducts(w http.ResponseWriter
 	result, err := h.svc.ReviewPeriod.ArchiveCancelledWorkProducts(r.Context(), staffID, reviewPeriodID)
 	if err != nil {
 		h.log.Error().Err(err).Str("action", "ArchiveCancelledWorkProducts").Str("staffId", staffID).Str("reviewPeriodId", reviewPeriodID).Msg("Failed to archive cancelled work products")
-		response.Error(w, http.StatusBadRequest, err.Error())
+		serviceError(w, err, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -0,0 +1,560 @@
+package handler
+
+import "github.com/enterprise-pms/pms-api/internal/domain/auth"
+
+// Route policies that are not a permission name.
+const (
+	// publicRoute routes are served without a JWT.
+	publicRoute = "public"
+	// authenticatedOnly routes are open to any signed-in user. They are
+	// reserved for self-service and reference-data endpoints.
+	authenticatedOnly = "authenticated"
+)
+
+// routePolicies maps every /api/v1 route pattern to the policy that guards
+// it: publicRoute, authenticatedOnly or the auth.Perm* permission a caller's
+// token must carry. NewRouter refuses to start when a registered route has
+// no entry here, or an entry names a route or permission that does not
+// exist.
+var routePolicies = map[string]string{
+	// Auth
+	"POST /api/v1/auth/login":                     publicRoute,
+	"POST /api/v1/auth/mfa/verify":                publicRoute,
+	"GET /api/v1/auth/oidc/login":                 publicRoute,
+	"POST /api/v1/auth/oidc/callback":             publicRoute,
+	"POST /api/v1/auth/refresh":                   publicRoute,
+	"GET /api/v1/auth/validate":                   authenticatedOnly,
+	"POST /api/v1/auth/logout":                    authenticatedOnly,
+	"GET /api/v1/auth/sessions":                   authenticatedOnly,
+	"DELETE /api/v1/auth/sessions/{sessionId}":    authenticatedOnly,
+	"DELETE /api/v1/auth/users/{userId}/sessions": auth.PermUsersManage,
+
+	// Performance Management
+	"GET /api/v1/performance/strategies":                         auth.PermPerformanceSetupView,
+	"POST /api/v1/performance/strategies":                        auth.PermPerformanceSetupManage,
+	"PUT /api/v1/performance/strategies":                         auth.PermPerformanceSetupManage,
+	"GET /api/v1/performance/strategic-themes":                   auth.PermPerformanceSetupView,
+	"POST /api/v1/performance/strategic-themes":                  auth.PermPerformanceSetupManage,
+	"PUT /api/v1/performance/strategic-themes":                   auth.PermPerformanceSetupManage,
+	"GET /api/v1/performance/objectives/enterprise":              auth.PermObjectivesView,
+	"POST /api/v1/performance/objectives/enterprise":             auth.PermObjectivesManage,
+	"PUT /api/v1/performance/objectives/enterprise":              auth.PermObjectivesManage,
+	"GET /api/v1/performance/objectives/department":              auth.PermObjectivesView,
+	"POST /api/v1/performance/objectives/department":             auth.PermObjectivesManage,
+	"PUT /api/v1/performance/objectives/department":              auth.PermObjectivesManage,
+	"GET /api/v1/performance/objectives/division":                auth.PermObjectivesView,
+	"POST /api/v1/performance/objectives/division":               auth.PermObjectivesManage,
+	"PUT /api/v1/performance/objectives/division":                auth.PermObjectivesManage,
+	"GET /api/v1/performance/objectives/office":                  auth.PermObjectivesView,
+	"POST /api/v1/performance/objectives/office":                 auth.PermObjectivesManage,
+	"PUT /api/v1/performance/objectives/office":                  auth.PermObjectivesManage,
+	"GET /api/v1/performance/objectives/consolidated":            auth.PermObjectivesView,
+	"GET /api/v1/performance/objectives/consolidated/paginated":  auth.PermObjectivesView,
+	"GET /api/v1/performance/objective-categories":               auth.PermPerformanceSetupView,
+	"POST /api/v1/performance/objective-categories":              auth.PermPerformanceSetupManage,
+	"PUT /api/v1/performance/objective-categories":               auth.PermPerformanceSetupManage,
+	"GET /api/v1/performance/category-definitions":               auth.PermPerformanceSetupView,
+	"POST /api/v1/performance/category-definitions":              auth.PermPerformanceSetupManage,
+	"PUT /api/v1/performance/category-definitions":               auth.PermPerformanceSetupManage,
+	"GET /api/v1/performance/evaluation-options":                 auth.PermPerformanceSetupView,
+	"POST /api/v1/performance/evaluation-options":                auth.PermPerformanceSetupManage,
+	"GET /api/v1/performance/feedback-questionnaires":            auth.PermPerformanceSetupView,
+	"POST /api/v1/performance/feedback-questionnaires":           auth.PermPerformanceSetupManage,
+	"POST /api/v1/performance/feedback-questionnaire-options":    auth.PermPerformanceSetupManage,
+	"GET /api/v1/performance/competencies":                       auth.PermPerformanceSetupView,
+	"POST /api/v1/performance/competencies":                      auth.PermPerformanceSetupManage,
+	"PUT /api/v1/performance/competencies":                       auth.PermPerformanceSetupManage,
+	"GET /api/v1/performance/work-product-definitions":           auth.PermPerformanceSetupView,
+	"GET /api/v1/performance/work-product-definitions/all":       auth.PermPerformanceSetupView,
+	"GET /api/v1/performance/work-product-definitions/paginated": auth.PermPerformanceSetupView,
+	"POST /api/v1/performance/work-product-definitions":          auth.PermPerformanceSetupManage,
+	"POST /api/v1/performance/objectives/upload":                 auth.PermObjectivesManage,
+	"GET /api/v1/performance/objectives/upload/template":         auth.PermObjectivesManage,
+	"POST /api/v1/performance/objectives/deactivate":             auth.PermObjectivesManage,
+	"POST /api/v1/performance/objectives/reactivate":             auth.PermObjectivesManage,
+	"POST /api/v1/performance/approve":                           auth.PermObjectivesApprove,
+	"POST /api/v1/performance/reject":                            auth.PermObjectivesApprove,
+	"GET /api/v1/performance/enums/objective-levels":             authenticatedOnly,
+	"GET /api/v1/performance/enums/extension-target-types":       authenticatedOnly,
+	"GET /api/v1/performance/enums/evaluation-types":             authenticatedOnly,
+	"GET /api/v1/performance/enums/work-product-types":           authenticatedOnly,
+	"GET /api/v1/performance/enums/grievance-types":              authenticatedOnly,
+	"GET /api/v1/performance/enums/feedback-request-types":       authenticatedOnly,
+	"GET /api/v1/performance/enums/performance-grades":           authenticatedOnly,
+	"GET /api/v1/performance/enums/review-period-ranges":         authenticatedOnly,
+	"GET /api/v1/performance/enums/statuses":                     authenticatedOnly,
+
+	// Review Periods
+	"POST /api/v1/review-periods/draft":                                      auth.PermReviewPeriodManage,
+	"POST /api/v1/review-periods":                                            auth.PermReviewPeriodManage,
+	"POST /api/v1/review-periods/submit-draft":                               auth.PermReviewPeriodManage,
+	"POST /api/v1/review-periods/approve":                                    auth.PermReviewPeriodApprove,
+	"POST /api/v1/review-periods/reject":                                     auth.PermReviewPeriodApprove,
+	"POST /api/v1/review-periods/return":                                     auth.PermReviewPeriodApprove,
+	"POST /api/v1/review-periods/resubmit":                                   auth.PermReviewPeriodManage,
+	"PUT /api/v1/review-periods":                                             auth.PermReviewPeriodManage,
+	"POST /api/v1/review-periods/cancel":                                     auth.PermReviewPeriodManage,
+	"POST /api/v1/review-periods/close":                                      auth.PermReviewPeriodManage,
+	"POST /api/v1/review-periods/enable-objective-planning":                  auth.PermReviewPeriodManage,
+	"POST /api/v1/review-periods/disable-objective-planning":                 auth.PermReviewPeriodManage,
+	"POST /api/v1/review-periods/enable-work-product-planning":               auth.PermReviewPeriodManage,
+	"POST /api/v1/review-periods/disable-work-product-planning":              auth.PermReviewPeriodManage,
+	"POST /api/v1/review-periods/enable-work-product-evaluation":             auth.PermReviewPeriodManage,
+	"POST /api/v1/review-periods/disable-work-product-evaluation":            auth.PermReviewPeriodManage,
+	"GET /api/v1/review-periods/all":                                         auth.PermReviewPeriodView,
+	"GET /api/v1/review-periods/active":                                      auth.PermReviewPeriodView,
+	"GET /api/v1/review-periods/staff-active":                                auth.PermObjectivePlanningPlan,
+	"GET /api/v1/review-periods/planned-objective":                           auth.PermObjectivePlanningPlan,
+	"GET /api/v1/review-periods/enterprise-objective":                        auth.PermObjectivePlanningPlan,
+	"GET /api/v1/review-periods/objectives-by-status":                        auth.PermObjectivePlanningPlan,
+	"GET /api/v1/review-periods/{reviewPeriodId}/category-definitions":       auth.PermReviewPeriodView,
+	"GET /api/v1/review-periods/{reviewPeriodId}/objectives-with-categories": auth.PermReviewPeriodView,
+	"GET /api/v1/review-periods/{reviewPeriodId}/planned-objectives":         auth.PermReviewPeriodView,
+	"GET /api/v1/review-periods/{reviewPeriodId}":                            auth.PermReviewPeriodView,
+	"POST /api/v1/review-periods/objectives/draft":                           auth.PermReviewPeriodManage,
+	"POST /api/v1/review-periods/objectives":                                 auth.PermReviewPeriodManage,
+	"POST /api/v1/review-periods/objectives/submit-draft":                    auth.PermReviewPeriodManage,
+	"POST /api/v1/review-periods/objectives/cancel":                          auth.PermReviewPeriodManage,
+	"GET /api/v1/review-periods/{reviewPeriodId}/objectives":                 auth.PermReviewPeriodView,
+	"POST /api/v1/review-periods/category-definitions/draft":                 auth.PermReviewPeriodManage,
+	"POST /api/v1/review-periods/category-definitions":                       auth.PermReviewPeriodManage,
+	"POST /api/v1/review-periods/category-definitions/submit-draft":          auth.PermReviewPeriodManage,
+	"POST /api/v1/review-periods/category-definitions/approve":               auth.PermReviewPeriodApprove,
+	"POST /api/v1/review-periods/category-definitions/reject":                auth.PermReviewPeriodApprove,
+	"POST /api/v1/review-periods/extensions/draft":                           auth.PermReviewPeriodManage,
+	"POST /api/v1/review-periods/extensions/submit-draft":                    auth.PermReviewPeriodManage,
+	"POST /api/v1/review-periods/extensions/approve":                         auth.PermReviewPeriodApprove,
+	"POST /api/v1/review-periods/extensions/reject":                          auth.PermReviewPeriodApprove,
+	"POST /api/v1/review-periods/extensions/return":                          auth.PermReviewPeriodApprove,
+	"POST /api/v1/review-periods/extensions/resubmit":                        auth.PermReviewPeriodManage,
+	"POST /api/v1/review-periods/extensions/cancel":                          auth.PermReviewPeriodManage,
+	"POST /api/v1/review-periods/extensions/close":                           auth.PermReviewPeriodManage,
+	"PUT /api/v1/review-periods/extensions":                                  auth.PermReviewPeriodManage,
+	"POST /api/v1/review-periods/extensions":                                 auth.PermReviewPeriodManage,
+	"GET /api/v1/review-periods/extensions/all":                              auth.PermReviewPeriodView,
+	"GET /api/v1/review-periods/{reviewPeriodId}/extensions":                 auth.PermReviewPeriodView,
+	"POST /api/v1/review-periods/360-reviews":                                auth.PermReviewPeriodManage,
+	"GET /api/v1/review-periods/{reviewPeriodId}/360-reviews":                auth.PermReviewPeriodView,
+	"POST /api/v1/review-periods/individual-objectives/draft":                auth.PermObjectivePlanningPlan,
+	"POST /api/v1/review-periods/individual-objectives":                      auth.PermObjectivePlanningPlan,
+	"POST /api/v1/review-periods/individual-objectives/submit-draft":         auth.PermObjectivePlanningPlan,
+	"POST /api/v1/review-periods/individual-objectives/approve":              auth.PermObjectivePlanningApprove,
+	"POST /api/v1/review-periods/individual-objectives/reject":               auth.PermObjectivePlanningApprove,
+	"POST /api/v1/review-periods/individual-objectives/return":               auth.PermObjectivePlanningApprove,
+	"POST /api/v1/review-periods/individual-objectives/cancel":               auth.PermObjectivePlanningPlan,
+	"POST /api/v1/review-periods/individual-objectives/accept":               auth.PermObjectivePlanningPlan,
+	"POST /api/v1/review-periods/individual-objectives/reinstate":            auth.PermObjectivePlanningApprove,
+	"POST /api/v1/review-periods/individual-objectives/pause":                auth.PermObjectivePlanningApprove,
+	"POST /api/v1/review-periods/individual-objectives/suspend":              auth.PermObjectivePlanningApprove,
+	"POST /api/v1/review-periods/individual-objectives/resume":               auth.PermObjectivePlanningApprove,
+	"POST /api/v1/review-periods/individual-objectives/resubmit":             auth.PermObjectivePlanningPlan,
+	"GET /api/v1/review-periods/individual-objectives":                       auth.PermObjectivePlanningPlan,
+	"POST /api/v1/review-periods/evaluations":                                auth.PermEvaluationSubmit,
+	"POST /api/v1/review-periods/evaluations/department":                     auth.PermEvaluationSubmit,
+	"GET /api/v1/review-periods/{reviewPeriodId}/evaluations":                auth.PermReviewPeriodView,
+	"GET /api/v1/review-periods/{reviewPeriodId}/evaluations/department":     auth.PermReviewPeriodView,
+	"GET /api/v1/review-periods/scores":                                      auth.PermPerformanceView,
+	"POST /api/v1/review-periods/archive-objectives":                         auth.PermReviewPeriodManage,
+	"POST /api/v1/review-periods/archive-workproducts":                       auth.PermReviewPeriodManage,
+
+	// Competency Management
+	"GET /api/v1/competency/competencies":                     auth.PermCompetencyView,
+	"POST /api/v1/competency/competencies":                    auth.PermCompetencyManage,
+	"POST /api/v1/competency/competencies/approve":            auth.PermCompetencyApprove,
+	"POST /api/v1/competency/competencies/reject":             auth.PermCompetencyApprove,
+	"GET /api/v1/competency/categories":                       auth.PermCompetencyView,
+	"POST /api/v1/competency/categories":                      auth.PermCompetencyManage,
+	"GET /api/v1/competency/category-gradings":                auth.PermCompetencyView,
+	"POST /api/v1/competency/category-gradings":               auth.PermCompetencyManage,
+	"GET /api/v1/competency/rating-definitions":               auth.PermCompetencyView,
+	"POST /api/v1/competency/rating-definitions":              auth.PermCompetencyManage,
+	"GET /api/v1/competency/reviews":                          auth.PermCompetencyView,
+	"GET /api/v1/competency/reviews/by-reviewer":              auth.PermCompetencyView,
+	"GET /api/v1/competency/reviews/for-employee":             auth.PermCompetencyView,
+	"GET /api/v1/competency/reviews/detail":                   auth.PermCompetencyView,
+	"POST /api/v1/competency/reviews":                         auth.PermCompetencyReview,
+	"GET /api/v1/competency/reviews/by-office":                auth.PermCompetencyView,
+	"GET /api/v1/competency/review-profiles":                  auth.PermCompetencyView,
+	"GET /api/v1/competency/review-profiles/group":            auth.PermCompetencyView,
+	"GET /api/v1/competency/review-profiles/matrix":           auth.PermCompetencyView,
+	"GET /api/v1/competency/review-profiles/technical-matrix": auth.PermCompetencyView,
+	"POST /api/v1/competency/review-profiles":                 auth.PermCompetencyManage,
+	"GET /api/v1/competency/development-plans":                auth.PermCompetencyView,
+	"POST /api/v1/competency/development-plans":               auth.PermCompetencyReview,
+	"GET /api/v1/competency/job-roles":                        auth.PermCompetencyView,
+	"POST /api/v1/competency/job-roles":                       auth.PermCompetencyManage,
+	"GET /api/v1/competency/office-job-roles":                 auth.PermCompetencyView,
+	"POST /api/v1/competency/office-job-roles":                auth.PermCompetencyManage,
+	"GET /api/v1/competency/job-role-competencies":            auth.PermCompetencyView,
+	"POST /api/v1/competency/job-role-competencies":           auth.PermCompetencyManage,
+	"GET /api/v1/competency/behavioral":                       auth.PermCompetencyView,
+	"POST /api/v1/competency/behavioral":                      auth.PermCompetencyManage,
+	"GET /api/v1/competency/job-role-grades":                  auth.PermCompetencyView,
+	"POST /api/v1/competency/job-role-grades":                 auth.PermCompetencyManage,
+	"GET /api/v1/competency/job-grades":                       auth.PermCompetencyView,
+	"POST /api/v1/competency/job-grades":                      auth.PermCompetencyManage,
+	"GET /api/v1/competency/job-grade-groups":                 auth.PermCompetencyView,
+	"POST /api/v1/competency/job-grade-groups":                auth.PermCompetencyManage,
+	"GET /api/v1/competency/assign-job-grade-groups":          auth.PermCompetencyView,
+	"POST /api/v1/competency/assign-job-grade-groups":         auth.PermCompetencyManage,
+	"GET /api/v1/competency/ratings":                          auth.PermCompetencyView,
+	"POST /api/v1/competency/ratings":                         auth.PermCompetencyManage,
+	"GET /api/v1/competency/review-periods":                   auth.PermCompetencyView,
+	"POST /api/v1/competency/review-periods":                  auth.PermCompetencyManage,
+	"POST /api/v1/competency/review-periods/approve":          auth.PermCompetencyApprove,
+	"GET /api/v1/competency/review-types":                     auth.PermCompetencyView,
+	"POST /api/v1/competency/review-types":                    auth.PermCompetencyManage,
+	"GET /api/v1/competency/bank-years":                       auth.PermCompetencyView,
+	"POST /api/v1/competency/bank-years":                      auth.PermCompetencyManage,
+	"GET /api/v1/competency/training-types":                   auth.PermCompetencyView,
+	"POST /api/v1/competency/training-types":                  auth.PermCompetencyManage,
+	"POST /api/v1/competency/populate/all-reviews":            auth.PermCompetencyManage,
+	"POST /api/v1/competency/populate/office-reviews":         auth.PermCompetencyManage,
+	"POST /api/v1/competency/populate/division-reviews":       auth.PermCompetencyManage,
+	"POST /api/v1/competency/populate/department-reviews":     auth.PermCompetencyManage,
+	"POST /api/v1/competency/populate/employee-reviews":       auth.PermCompetencyManage,
+	"POST /api/v1/competency/calculate-reviews":               auth.PermCompetencyManage,
+	"POST /api/v1/competency/recalculate-review-profiles":     auth.PermCompetencyManage,
+	"POST /api/v1/competency/email-service":                   auth.PermCompetencyManage,
+	"POST /api/v1/competency/sync-job-role-soa":               auth.PermCompetencyManage,
+
+	// Development plan lifecycle
+	"GET /api/v1/competency/development-plans/completion":                        auth.PermCompetencyView,
+	"GET /api/v1/competency/development-plans/{planId}":                          auth.PermCompetencyView,
+	"POST /api/v1/competency/development-plans/{planId}/status":                  auth.PermCompetencyReview,
+	"POST /api/v1/competency/development-plans/{planId}/evidence":                auth.PermCompetencyReview,
+	"DELETE /api/v1/competency/development-plans/{planId}/evidence/{evidenceId}": auth.PermCompetencyReview,
+	"POST /api/v1/competency/development-plans/draft":                            auth.PermCompetencyManage,
+	"GET /api/v1/competency/development-plans/drafts":                            auth.PermCompetencyReview,
+	"POST /api/v1/competency/development-plans/drafts/decide":                    auth.PermCompetencyReview,
+	"GET /api/v1/competency/development-plan-rules":                              auth.PermCompetencyView,
+	"POST /api/v1/competency/development-plan-rules":                             auth.PermCompetencyManage,
+	"DELETE /api/v1/competency/development-plan-rules/{ruleId}":                  auth.PermCompetencyManage,
+
+	// Grievances
+	"POST /api/v1/grievances":            auth.PermGrievanceRaise,
+	"PUT /api/v1/grievances":             auth.PermGrievanceRaise,
+	"POST /api/v1/grievances/resolution": auth.PermGrievanceResolve,
+	"PUT /api/v1/grievances/resolution":  auth.PermGrievanceResolve,
+	"GET /api/v1/grievances/staff":       auth.PermGrievanceRaise,
+	"GET /api/v1/grievances/report":      auth.PermGrievanceReport,
+
+	// Approval delegations
+	"GET /api/v1/delegations":                   authenticatedOnly,
+	"POST /api/v1/delegations":                  authenticatedOnly,
+	"DELETE /api/v1/delegations/{delegationId}": authenticatedOnly,
+
+	// Notification inbox
+	"GET /api/v1/notifications":                        authenticatedOnly,
+	"GET /api/v1/notifications/stream":                 authenticatedOnly,
+	"POST /api/v1/notifications/read":                  authenticatedOnly,
+	"POST /api/v1/notifications/read-all":              authenticatedOnly,
+	"POST /api/v1/notifications/{notificationId}/read": authenticatedOnly,
+	"GET /api/v1/notifications/preferences":            authenticatedOnly,
+	"PUT /api/v1/notifications/preferences":            authenticatedOnly,
+
+	// Calibration
+	"GET /api/v1/calibrations":                       auth.PermCalibrationManage,
+	"POST /api/v1/calibrations":                      auth.PermCalibrationManage,
+	"GET /api/v1/calibrations/{sessionId}":           auth.PermCalibrationManage,
+	"PUT /api/v1/calibrations/{sessionId}/decisions": auth.PermCalibrationManage,
+	"POST /api/v1/calibrations/{sessionId}/finalize": auth.PermCalibrationManage,
+	"GET /api/v1/setup/calibration-quotas":           auth.PermCalibrationManage,
+	"PUT /api/v1/setup/calibration-quotas":           auth.PermSettingsManage,
+
+	// Background and recurring jobs
+	"GET /api/v1/background-jobs":                   auth.PermJobsManage,
+	"GET /api/v1/background-jobs/{jobId}":           auth.PermJobsManage,
+	"POST /api/v1/background-jobs/{jobId}/retry":    auth.PermJobsManage,
+	"POST /api/v1/background-jobs/{jobId}/cancel":   auth.PermJobsManage,
+	"GET /api/v1/recurring-jobs":                    auth.PermJobsManage,
+	"PUT /api/v1/recurring-jobs/{jobName}/schedule": auth.PermJobsManage,
+	"POST /api/v1/recurring-jobs/{jobName}/pause":   auth.PermJobsManage,
+	"POST /api/v1/recurring-jobs/{jobName}/resume":  auth.PermJobsManage,
+	"POST /api/v1/recurring-jobs/{jobName}/trigger": auth.PermJobsManage,
+	"GET /api/v1/emails/undelivered":                auth.PermJobsManage,
+	"GET /api/v1/emails/{emailId}/attempts":         auth.PermJobsManage,
+	"POST /api/v1/emails/{emailId}/resend":          auth.PermJobsManage,
+	"POST /api/v1/emails/resend":                    auth.PermJobsManage,
+
+	// Audit trail
+	"GET /api/v1/audit-trail/{tableName}/{recordId}":                               auth.PermAuditTrailView,
+	"GET /api/v1/audit-trail/{tableName}/{recordId}/as-of":                         auth.PermAuditTrailView,
+	"GET /api/v1/audit-trail/{tableName}/{recordId}/diff":                          auth.PermAuditTrailView,
+	"GET /api/v1/audit-config":                                                     auth.PermAuditConfigManage,
+	"PUT /api/v1/audit-config/entities":                                            auth.PermAuditConfigManage,
+	"PUT /api/v1/audit-config/attributes":                                          auth.PermAuditConfigManage,
+	"DELETE /api/v1/audit-config/entities/{entityName}/attributes/{attributeName}": auth.PermAuditConfigManage,
+
+	// Files
+	"POST /api/v1/files":                      authenticatedOnly,
+	"GET /api/v1/files/{fileId}":              authenticatedOnly,
+	"GET /api/v1/files/{fileId}/download-url": authenticatedOnly,
+	"GET /api/v1/files/{fileId}/download":     authenticatedOnly,
+
+	// PMS Setup
+	"POST /api/v1/setup/settings":                     auth.PermSettingsManage,
+	"PUT /api/v1/setup/settings":                      auth.PermSettingsManage,
+	"GET /api/v1/setup/settings/{settingId}":          auth.PermSettingsManage,
+	"GET /api/v1/setup/settings":                      auth.PermSettingsManage,
+	"POST /api/v1/setup/pms-configurations":           auth.PermSettingsManage,
+	"PUT /api/v1/setup/pms-configurations":            auth.PermSettingsManage,
+	"GET /api/v1/setup/pms-configurations/{configId}": auth.PermSettingsManage,
+	"GET /api/v1/setup/pms-configurations":            auth.PermSettingsManage,
+	"GET /api/v1/setup/approval-chains":               auth.PermSettingsManage,
+	"GET /api/v1/setup/approval-chains/{chainId}":     auth.PermSettingsManage,
+	"POST /api/v1/setup/approval-chains":              auth.PermSettingsManage,
+	"PUT /api/v1/setup/approval-chains":               auth.PermSettingsManage,
+	"DELETE /api/v1/setup/approval-chains/{chainId}":  auth.PermSettingsManage,
+	"GET /api/v1/setup/grading-scales":                auth.PermSettingsManage,
+	"GET /api/v1/setup/grading-scales/{scaleId}":      auth.PermSettingsManage,
+	"POST /api/v1/setup/grading-scales":               auth.PermSettingsManage,
+	"PUT /api/v1/setup/grading-scales":                auth.PermSettingsManage,
+	"PUT /api/v1/setup/grading-scales/assignment":     auth.PermSettingsManage,
+	"DELETE /api/v1/setup/grading-scales/{scaleId}":   auth.PermSettingsManage,
+
+	// SLA escalation ladders
+	"GET /api/v1/setup/sla-escalations":                          auth.PermSettingsManage,
+	"PUT /api/v1/setup/sla-escalations":                          auth.PermSettingsManage,
+	"DELETE /api/v1/setup/sla-escalations/{feedbackRequestType}": auth.PermSettingsManage,
+
+	// Organogram
+	"GET /api/v1/organogram/directorates":    auth.PermOrganogramView,
+	"POST /api/v1/organogram/directorates":   auth.PermOrganogramManage,
+	"GET /api/v1/organogram/departments":     auth.PermOrganogramView,
+	"POST /api/v1/organogram/departments":    auth.PermOrganogramManage,
+	"GET /api/v1/organogram/divisions":       auth.PermOrganogramView,
+	"POST /api/v1/organogram/divisions":      auth.PermOrganogramManage,
+	"GET /api/v1/organogram/offices":         auth.PermOrganogramView,
+	"POST /api/v1/organogram/offices":        auth.PermOrganogramManage,
+	"GET /api/v1/organogram/erp/departments": auth.PermOrganogramView,
+	"GET /api/v1/organogram/erp/divisions":   auth.PermOrganogramView,
+	"GET /api/v1/organogram/erp/offices":     auth.PermOrganogramView,
+
+	// Role Management
+	"GET /api/v1/rolemgmt/permissions":           auth.PermPermissionsManage,
+	"GET /api/v1/rolemgmt/roles-with-permission": auth.PermPermissionsManage,
+	"POST /api/v1/rolemgmt/permissions":          auth.PermPermissionsManage,
+	"DELETE /api/v1/rolemgmt/permissions":        auth.PermPermissionsManage,
+
+	// Staff Management
+	"POST /api/v1/staff":                auth.PermUsersManage,
+	"GET /api/v1/staff":                 auth.PermUsersManage,
+	"GET /api/v1/staff/roles":           auth.PermUsersManage,
+	"POST /api/v1/staff/roles":          auth.PermUsersManage,
+	"DELETE /api/v1/staff/roles":        auth.PermUsersManage,
+	"POST /api/v1/staff/roles/assign":   auth.PermUsersManage,
+	"DELETE /api/v1/staff/roles/remove": auth.PermUsersManage,
+	"GET /api/v1/staff/roles/by-staff":  auth.PermUsersManage,
+
+	// Employee Information
+	"GET /api/v1/employees":                                auth.PermEmployeesView,
+	"GET /api/v1/employees/head-subordinates":              auth.PermEmployeesView,
+	"GET /api/v1/employees/subordinates":                   auth.PermEmployeesView,
+	"GET /api/v1/employees/peers":                          auth.PermEmployeesView,
+	"GET /api/v1/employees/by-department":                  auth.PermEmployeesView,
+	"GET /api/v1/employees/by-division":                    auth.PermEmployeesView,
+	"GET /api/v1/employees/by-office":                      auth.PermEmployeesView,
+	"GET /api/v1/employees/all":                            auth.PermEmployeesView,
+	"GET /api/v1/employees/seed-organization":              auth.PermOrganogramManage,
+	"GET /api/v1/employees/staff-id-mask":                  auth.PermEmployeesView,
+	"POST /api/v1/employees/staff-job-role":                auth.PermJobRoleRequest,
+	"GET /api/v1/employees/staff-job-role":                 auth.PermJobRoleRequest,
+	"POST /api/v1/employees/job-roles-by-office":           auth.PermEmployeesView,
+	"GET /api/v1/employees/staff-job-role-requests":        auth.PermJobRoleApprove,
+	"POST /api/v1/employees/approve-reject-staff-job-role": auth.PermJobRoleApprove,
+
+	// Enum Lists
+	"GET /api/v1/enums/objective-levels":       authenticatedOnly,
+	"GET /api/v1/enums/extension-target-types": authenticatedOnly,
+	"GET /api/v1/enums/evaluation-types":       authenticatedOnly,
+	"GET /api/v1/enums/work-product-types":     authenticatedOnly,
+	"GET /api/v1/enums/grievance-types":        authenticatedOnly,
+	"GET /api/v1/enums/feedback-request-types": authenticatedOnly,
+	"GET /api/v1/enums/performance-grades":     authenticatedOnly,
+	"GET /api/v1/enums/review-period-ranges":   authenticatedOnly,
+	"GET /api/v1/enums/statuses":               authenticatedOnly,
+
+	// PMS Management Engine
+	"POST /api/v1/pms-engine/projects/draft":                             auth.PermAdhocManage,
+	"POST /api/v1/pms-engine/projects":                                   auth.PermAdhocManage,
+	"POST /api/v1/pms-engine/projects/submit-draft":                      auth.PermAdhocManage,
+	"POST /api/v1/pms-engine/projects/approve":                           auth.PermAdhocApprove,
+	"POST /api/v1/pms-engine/projects/reject":                            auth.PermAdhocApprove,
+	"POST /api/v1/pms-engine/projects/return":                            auth.PermAdhocApprove,
+	"POST /api/v1/pms-engine/projects/resubmit":                          auth.PermAdhocManage,
+	"PUT /api/v1/pms-engine/projects":                                    auth.PermAdhocManage,
+	"POST /api/v1/pms-engine/projects/cancel":                            auth.PermAdhocManage,
+	"GET /api/v1/pms-engine/projects":                                    auth.PermAdhocView,
+	"GET /api/v1/pms-engine/projects/{projectId}":                        auth.PermAdhocView,
+	"POST /api/v1/pms-engine/projects/objectives":                        auth.PermAdhocManage,
+	"POST /api/v1/pms-engine/projects/members":                           auth.PermAdhocManage,
+	"GET /api/v1/pms-engine/projects/{projectId}/members":                auth.PermAdhocView,
+	"GET /api/v1/pms-engine/projects/{projectId}/objectives":             auth.PermAdhocView,
+	"POST /api/v1/pms-engine/projects/close":                             auth.PermAdhocManage,
+	"POST /api/v1/pms-engine/projects/pause":                             auth.PermAdhocManage,
+	"GET /api/v1/pms-engine/projects/by-manager":                         auth.PermAdhocView,
+	"GET /api/v1/pms-engine/projects/assigned":                           auth.PermAdhocView,
+	"GET /api/v1/pms-engine/projects/staff":                              auth.PermAdhocView,
+	"GET /api/v1/pms-engine/projects/{projectId}/work-product-staff":     auth.PermAdhocView,
+	"POST /api/v1/pms-engine/projects/members/draft":                     auth.PermAdhocManage,
+	"POST /api/v1/pms-engine/projects/members/submit-draft":              auth.PermAdhocManage,
+	"POST /api/v1/pms-engine/projects/members/accept":                    auth.PermAdhocManage,
+	"POST /api/v1/pms-engine/projects/members/approve":                   auth.PermAdhocApprove,
+	"POST /api/v1/pms-engine/projects/members/cancel":                    auth.PermAdhocManage,
+	"POST /api/v1/pms-engine/projects/objectives/cancel":                 auth.PermAdhocManage,
+	"POST /api/v1/pms-engine/projects/change-lead":                       auth.PermAdhocManage,
+	"GET /api/v1/pms-engine/projects/validate-eligibility":               auth.PermAdhocView,
+	"POST /api/v1/pms-engine/committees/draft":                           auth.PermAdhocManage,
+	"POST /api/v1/pms-engine/committees":                                 auth.PermAdhocManage,
+	"POST /api/v1/pms-engine/committees/submit-draft":                    auth.PermAdhocManage,
+	"POST /api/v1/pms-engine/committees/approve":                         auth.PermAdhocApprove,
+	"POST /api/v1/pms-engine/committees/reject":                          auth.PermAdhocApprove,
+	"POST /api/v1/pms-engine/committees/return":                          auth.PermAdhocApprove,
+	"POST /api/v1/pms-engine/committees/resubmit":                        auth.PermAdhocManage,
+	"PUT /api/v1/pms-engine/committees":                                  auth.PermAdhocManage,
+	"POST /api/v1/pms-engine/committees/cancel":                          auth.PermAdhocManage,
+	"GET /api/v1/pms-engine/committees":                                  auth.PermAdhocView,
+	"GET /api/v1/pms-engine/committees/{committeeId}":                    auth.PermAdhocView,
+	"POST /api/v1/pms-engine/committees/members":                         auth.PermAdhocManage,
+	"POST /api/v1/pms-engine/committees/objectives":                      auth.PermAdhocManage,
+	"POST /api/v1/pms-engine/committees/close":                           auth.PermAdhocManage,
+	"POST /api/v1/pms-engine/committees/pause":                           auth.PermAdhocManage,
+	"GET /api/v1/pms-engine/committees/by-chairperson":                   auth.PermAdhocView,
+	"GET /api/v1/pms-engine/committees/{committeeId}/members":            auth.PermAdhocView,
+	"GET /api/v1/pms-engine/committees/assigned":                         auth.PermAdhocView,
+	"GET /api/v1/pms-engine/committees/staff":                            auth.PermAdhocView,
+	"GET /api/v1/pms-engine/committees/{committeeId}/work-product-staff": auth.PermAdhocView,
+	"GET /api/v1/pms-engine/committees/{committeeId}/objectives":         auth.PermAdhocView,
+	"POST /api/v1/pms-engine/committees/members/draft":                   auth.PermAdhocManage,
+	"POST /api/v1/pms-engine/committees/members/submit-draft":            auth.PermAdhocManage,
+	"POST /api/v1/pms-engine/committees/members/cancel":                  auth.PermAdhocManage,
+	"POST /api/v1/pms-engine/committees/objectives/cancel":               auth.PermAdhocManage,
+	"POST /api/v1/pms-engine/committees/change-chairperson":              auth.PermAdhocManage,
+	"POST /api/v1/pms-engine/work-products/draft":                        auth.PermWorkProductsManage,
+	"POST /api/v1/pms-engine/work-products":                              auth.PermWorkProductsManage,
+	"POST /api/v1/pms-engine/work-products/submit-draft":                 auth.PermWorkProductsManage,
+	"POST /api/v1/pms-engine/work-products/approve":                      auth.PermWorkProductsApprove,
+	"POST /api/v1/pms-engine/work-products/reject":                       auth.PermWorkProductsApprove,
+	"POST /api/v1/pms-engine/work-products/return":                       auth.PermWorkProductsApprove,
+	"POST /api/v1/pms-engine/work-products/resubmit":                     auth.PermWorkProductsManage,
+	"PUT /api/v1/pms-engine/work-products":                               auth.PermWorkProductsManage,
+	"POST /api/v1/pms-engine/work-products/cancel":                       auth.PermWorkProductsManage,
+	"POST /api/v1/pms-engine/work-products/pause":                        auth.PermWorkProductsApprove,
+	"POST /api/v1/pms-engine/work-products/resume":                       auth.PermWorkProductsApprove,
+	"GET /api/v1/pms-engine/work-products":                               auth.PermWorkProductsManage,
+	"GET /api/v1/pms-engine/work-products/{workProductId}":               auth.PermWorkProductsManage,
+	"POST /api/v1/pms-engine/work-products/assign":                       auth.PermWorkProductsApprove,
+	"GET /api/v1/pms-engine/work-products/assigned":                      auth.PermWorkProductsManage,
+	"POST /api/v1/pms-engine/work-products/evaluate":                     auth.PermWorkProductsApprove,
+	"POST /api/v1/pms-engine/work-products/complete":                     auth.PermWorkProductsManage,
+	"POST /api/v1/pms-engine/work-products/suspend":                      auth.PermWorkProductsApprove,
+	"POST /api/v1/pms-engine/work-products/reinstate":                    auth.PermWorkProductsApprove,
+	"POST /api/v1/pms-engine/work-products/project/draft":                auth.PermWorkProductsManage,
+	"POST /api/v1/pms-engine/work-products/project":                      auth.PermWorkProductsManage,
+	"POST /api/v1/pms-engine/work-products/project/submit-draft":         auth.PermWorkProductsManage,
+	"POST /api/v1/pms-engine/work-products/project/approve":              auth.PermWorkProductsApprove,
+	"POST /api/v1/pms-engine/work-products/project/reject":               auth.PermWorkProductsApprove,
+	"POST /api/v1/pms-engine/work-products/project/return":               auth.PermWorkProductsApprove,
+	"POST /api/v1/pms-engine/work-products/project/resubmit":             auth.PermWorkProductsManage,
+	"POST /api/v1/pms-engine/work-products/project/cancel":               auth.PermWorkProductsManage,
+	"POST /api/v1/pms-engine/work-products/project/close":                auth.PermWorkProductsApprove,
+	"POST /api/v1/pms-engine/work-products/committee/draft":              auth.PermWorkProductsManage,
+	"POST /api/v1/pms-engine/work-products/committee":                    auth.PermWorkProductsManage,
+	"POST /api/v1/pms-engine/work-products/committee/submit-draft":       auth.PermWorkProductsManage,
+	"POST /api/v1/pms-engine/work-products/committee/approve":            auth.PermWorkProductsApprove,
+	"POST /api/v1/pms-engine/work-products/committee/reject":             auth.PermWorkProductsApprove,
+	"POST /api/v1/pms-engine/work-products/committee/return":             auth.PermWorkProductsApprove,
+	"POST /api/v1/pms-engine/work-products/committee/resubmit":           auth.PermWorkProductsManage,
+	"POST /api/v1/pms-engine/work-products/committee/cancel":             auth.PermWorkProductsManage,
+	"POST /api/v1/pms-engine/work-products/committee/close":              auth.PermWorkProductsApprove,
+	"GET /api/v1/pms-engine/work-products/project/{id}":                  auth.PermWorkProductsManage,
+	"GET /api/v1/pms-engine/work-products/project":                       auth.PermWorkProductsManage,
+	"GET /api/v1/pms-engine/work-products/project/single":                auth.PermWorkProductsManage,
+	"GET /api/v1/pms-engine/work-products/project/all":                   auth.PermWorkProductsManage,
+	"GET /api/v1/pms-engine/work-products/project/staff":                 auth.PermWorkProductsManage,
+	"GET /api/v1/pms-engine/work-products/committee/{id}":                auth.PermWorkProductsManage,
+	"GET /api/v1/pms-engine/work-products/committee":                     auth.PermWorkProductsManage,
+	"GET /api/v1/pms-engine/work-products/committee/single":              auth.PermWorkProductsManage,
+	"GET /api/v1/pms-engine/work-products/committee/all":                 auth.PermWorkProductsManage,
+	"GET /api/v1/pms-engine/work-products/committee/staff":               auth.PermWorkProductsManage,
+	"GET /api/v1/pms-engine/work-products/operational":                   auth.PermWorkProductsManage,
+	"GET /api/v1/pms-engine/work-products/by-objective":                  auth.PermWorkProductsManage,
+	"GET /api/v1/pms-engine/work-products/all":                           auth.PermWorkProductsManage,
+	"POST /api/v1/pms-engine/work-products/tasks":                        auth.PermWorkProductsManage,
+	"PUT /api/v1/pms-engine/work-products/tasks":                         auth.PermWorkProductsManage,
+	"POST /api/v1/pms-engine/work-products/tasks/cancel":                 auth.PermWorkProductsManage,
+	"POST /api/v1/pms-engine/work-products/tasks/complete":               auth.PermWorkProductsManage,
+	"GET /api/v1/pms-engine/work-products/tasks/{taskId}":                auth.PermWorkProductsManage,
+	"GET /api/v1/pms-engine/work-products/tasks/by-product":              auth.PermWorkProductsManage,
+	"POST /api/v1/pms-engine/work-products/evaluation":                   auth.PermWorkProductsApprove,
+	"PUT /api/v1/pms-engine/work-products/evaluation":                    auth.PermWorkProductsApprove,
+	"GET /api/v1/pms-engine/work-products/evaluation/by-product":         auth.PermWorkProductsManage,
+	"POST /api/v1/pms-engine/work-products/re-evaluate":                  auth.PermWorkProductsApprove,
+	"POST /api/v1/pms-engine/work-products/recalculate":                  auth.PermReviewPeriodManage,
+	"POST /api/v1/pms-engine/evaluations/draft":                          auth.PermEvaluationSubmit,
+	"POST /api/v1/pms-engine/evaluations":                                auth.PermEvaluationSubmit,
+	"POST /api/v1/pms-engine/evaluations/submit-draft":                   auth.PermEvaluationSubmit,
+	"POST /api/v1/pms-engine/evaluations/approve":                        auth.PermEvaluationApprove,
+	"POST /api/v1/pms-engine/evaluations/reject":                         auth.PermEvaluationApprove,
+	"GET /api/v1/pms-engine/evaluations":                                 auth.PermPerformanceView,
+	"POST /api/v1/pms-engine/feedback/request":                           auth.PermFeedbackParticipate,
+	"GET /api/v1/pms-engine/feedback/requests":                           auth.PermFeedbackParticipate,
+	"POST /api/v1/pms-engine/feedback/process":                           auth.PermFeedbackParticipate,
+	"GET /api/v1/pms-engine/feedback/pending":                            auth.PermFeedbackParticipate,
+	"GET /api/v1/pms-engine/scores":                                      auth.PermPerformanceView,
+	"GET /api/v1/pms-engine/dashboard":                                   auth.PermPerformanceView,
+	"GET /api/v1/pms-engine/scores/summary":                              auth.PermPerformanceView,
+	"POST /api/v1/pms-engine/individual-objectives/draft":                auth.PermObjectivePlanningPlan,
+	"POST /api/v1/pms-engine/individual-objectives":                      auth.PermObjectivePlanningPlan,
+	"POST /api/v1/pms-engine/individual-objectives/submit-draft":         auth.PermObjectivePlanningPlan,
+	"POST /api/v1/pms-engine/individual-objectives/approve":              auth.PermObjectivePlanningApprove,
+	"POST /api/v1/pms-engine/individual-objectives/reject":               auth.PermObjectivePlanningApprove,
+	"POST /api/v1/pms-engine/individual-objectives/return":               auth.PermObjectivePlanningApprove,
+	"POST /api/v1/pms-engine/individual-objectives/cancel":               auth.PermObjectivePlanningPlan,
+	"GET /api/v1/pms-engine/individual-objectives":                       auth.PermObjectivePlanningPlan,
+	"POST /api/v1/pms-engine/360-review/trigger":                         auth.PermReview360Manage,
+	"POST /api/v1/pms-engine/360-review/initiate":                        auth.PermReview360Manage,
+	"POST /api/v1/pms-engine/360-review/complete":                        auth.PermCompetencyReview,
+	"POST /api/v1/pms-engine/360-review/rating":                          auth.PermCompetencyReview,
+	"PUT /api/v1/pms-engine/360-review/rating":                           auth.PermCompetencyReview,
+	"POST /api/v1/pms-engine/360-review/reviewer-complete":               auth.PermCompetencyReview,
+	"GET /api/v1/pms-engine/competency-review/feedback-details":          auth.PermCompetencyView,
+	"GET /api/v1/pms-engine/competency-review/detail":                    auth.PermCompetencyView,
+	"GET /api/v1/pms-engine/competency-review/feedbacks":                 auth.PermCompetencyView,
+	"GET /api/v1/pms-engine/competency-review/my-reviewed":               auth.PermCompetencyView,
+	"GET /api/v1/pms-engine/competency-review/to-review":                 auth.PermCompetencyView,
+	"GET /api/v1/pms-engine/competency-review/reviewer/{reviewerId}":     auth.PermCompetencyView,
+	"GET /api/v1/pms-engine/competency-review/questionnaire":             auth.PermCompetencyView,
+	"POST /api/v1/pms-engine/competency-review/gap-closure":              auth.PermCompetencyManage,
+	"GET /api/v1/pms-engine/competency-review/gap-closure/report":        auth.PermCompetencyView,
+	"GET /api/v1/pms-engine/feedback/requests/staff":                     auth.PermFeedbackParticipate,
+	"GET /api/v1/pms-engine/feedback/requests/breached":                  auth.PermFeedbackManage,
+	"GET /api/v1/pms-engine/feedback/requests/staff/by-status":           auth.PermFeedbackParticipate,
+	"GET /api/v1/pms-engine/feedback/requests/all":                       auth.PermFeedbackManage,
+	"GET /api/v1/pms-engine/feedback/requests/by-status":                 auth.PermFeedbackManage,
+	"GET /api/v1/pms-engine/feedback/requests/{requestId}":               auth.PermFeedbackParticipate,
+	"POST /api/v1/pms-engine/feedback/requests/reassign":                 auth.PermFeedbackManage,
+	"POST /api/v1/pms-engine/feedback/requests/reassign-self":            auth.PermFeedbackParticipate,
+	"POST /api/v1/pms-engine/feedback/requests/close":                    auth.PermFeedbackManage,
+	"POST /api/v1/pms-engine/feedback/requests/treat":                    auth.PermFeedbackParticipate,
+	"POST /api/v1/pms-engine/feedback/requests/update":                   auth.PermFeedbackParticipate,
+	"GET /api/v1/pms-engine/stats/requests":                              auth.PermFeedbackParticipate,
+	"GET /api/v1/pms-engine/stats/performance":                           auth.PermPerformanceView,
+	"GET /api/v1/pms-engine/stats/work-products":                         auth.PermPerformanceView,
+	"GET /api/v1/pms-engine/stats/work-products-details":                 auth.PermPerformanceView,
+	"GET /api/v1/pms-engine/scorecard":                                   auth.PermPerformanceView,
+	"GET /api/v1/pms-engine/scorecard/annual":                            auth.PermPerformanceView,
+	"GET /api/v1/pms-engine/scorecard/subordinates":                      auth.PermPerformanceReports,
+	"GET /api/v1/pms-engine/organogram-performance/list":                 auth.PermPerformanceReports,
+	"GET /api/v1/pms-engine/organogram-performance":                      auth.PermPerformanceReports,
+	"GET /api/v1/pms-engine/period-scores/all":                           auth.PermPerformanceReports,
+	"GET /api/v1/pms-engine/period-scores":                               auth.PermPerformanceView,
+	"GET /api/v1/pms-engine/staff-review-periods":                        auth.PermPerformanceView,
+	"GET /api/v1/pms-engine/audit-logs/{id}":                             auth.PermAuditTrailView,
+	"GET /api/v1/pms-engine/audit-logs":                                  auth.PermAuditTrailView,
+	"GET /api/v1/pms-engine/line-manager-employees":                      auth.PermEmployeesView,
+	"GET /api/v1/pms-engine/adhoc-employees":                             auth.PermEmployeesView,
+	"GET /api/v1/pms-engine/my-staff":                                    auth.PermEmployeesView,
+	"POST /api/v1/pms-engine/reset-password":                             publicRoute,
+}
@@ -2,7 +2,10 @@ package handler
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"sort"
+	"strings"
 
 	"github.com/enterprise-pms/pms-api/internal/config"
 	"github.com/enterprise-pms/pms-api/internal/domain/auth"
@@ -17,14 +20,72 @@ func jwtProtect(mw *middleware.Stack, h http.HandlerFunc) http.Handler {
 	return mw.JWTAuth(http.HandlerFunc(h))
 }
 
-// jwtRoleProtect wraps a handler with JWT authentication + role-based middleware.
-func jwtRoleProtect(mw *middleware.Stack, h http.HandlerFunc, roles ...string) http.Handler {
-	return mw.JWTAuth(mw.RequireRole(roles...)(http.HandlerFunc(h)))
+// jwtPermissionProtect wraps a handler with JWT authentication + permission-based middleware.
+func jwtPermissionProtect(mw *middleware.Stack, h http.HandlerFunc, permissions ...string) http.Handler {
+	return mw.JWTAuth(mw.RequirePermission(permissions...)(http.HandlerFunc(h)))
 }
 
-// NewRouter sets up all HTTP routes and middleware chains.
-func NewRouter(svc *service.Container, mw *middleware.Stack, cfg *config.Config, log zerolog.Logger) http.Handler {
+// routeTable registers /api/v1 routes behind the policy routePolicies gives
+// them and records the routes that have none.
+type routeTable struct {
+	mux        *http.ServeMux
+	mw         *middleware.Stack
+	registered map[string]bool
+	unmapped   []string
+}
+
+func newRouteTable(mux *http.ServeMux, mw *middleware.Stack) *routeTable {
+	return &routeTable{mux: mux, mw: mw, registered: make(map[string]bool)}
+}
+
+// handle registers h for pattern. A route without a policy is not served.
+func (t *routeTable) handle(pattern string, h http.HandlerFunc) {
+	t.registered[pattern] = true
+	policy, ok := routePolicies[pattern]
+	switch {
+	case !ok:
+		t.unmapped = append(t.unmapped, pattern)
+	case policy == publicRoute:
+		t.mux.Handle(pattern, h)
+	case policy == authenticatedOnly:
+		t.mux.Handle(pattern, jwtProtect(t.mw, h))
+	default:
+		t.mux.Handle(pattern, jwtPermissionProtect(t.mw, h, policy))
+	}
+}
+
+// check reports routes registered without a policy, policies for routes
+// that were never registered and policies naming an unknown permission.
+func (t *routeTable) check() error {
+	known := make(map[string]bool)
+	for _, p := range auth.Permissions() {
+		known[p.Name] = true
+	}
+
+	var problems []string
+	for _, pattern := range t.unmapped {
+		problems = append(problems, fmt.Sprintf("%s has no policy", pattern))
+	}
+	for pattern, policy := range routePolicies {
+		if !t.registered[pattern] {
+			problems = append(problems, fmt.Sprintf("policy for unregistered route %s", pattern))
+		}
+		if policy != publicRoute && policy != authenticatedOnly && !known[policy] {
+			problems = append(problems, fmt.Sprintf("%s requires unknown permission %q", pattern, policy))
+		}
+	}
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return fmt.Errorf("route policy check failed:\n  %s", strings.Join(problems, "\n  "))
+	}
+	return nil
+}
+
+// NewRouter sets up all HTTP routes and middleware chains. It fails when
+// the routes and routePolicies disagree.
+func NewRouter(svc *service.Container, mw *middleware.Stack, cfg *config.Config, log zerolog.Logger) (http.Handler, error) {
 	mux := http.NewServeMux()
+	routes := newRouteTable(mux, mw)
 
 	// Health check — no auth required
 	mux.HandleFunc("GET /health", healthCheck)
@@ -36,11 +97,18 @@ func NewRouter(svc *service.Container, mw *middleware.Stack, cfg *config.Config,
 	// Auth routes — public (no JWT required)
 	// ----------------------------------------------------------------
 	authHandler := NewAuthHandler(svc.Auth, log)
-	mux.HandleFunc("POST /api/v1/auth/login", authHandler.Login)
-	mux.HandleFunc("POST /api/v1/auth/refresh", authHandler.RefreshToken)
+	routes.handle("POST /api/v1/auth/login", authHandler.Login)
+	routes.handle("POST /api/v1/auth/mfa/verify", authHandler.VerifyMFA)
+	routes.handle("GET /api/v1/auth/oidc/login", authHandler.OIDCLogin)
+	routes.handle("POST /api/v1/auth/oidc/callback", authHandler.OIDCCallback)
+	routes.handle("POST /api/v1/auth/refresh", authHandler.RefreshToken)
 
 	// Auth routes — JWT required
-	mux.Handle("GET /api/v1/auth/validate", jwtProtect(mw, authHandler.ValidateToken))
+	routes.handle("GET /api/v1/auth/validate", authHandler.ValidateToken)
+	routes.handle("POST /api/v1/auth/logout", authHandler.Logout)
+	routes.handle("GET /api/v1/auth/sessions", authHandler.ListSessions)
+	routes.handle("DELETE /api/v1/auth/sessions/{sessionId}", authHandler.RevokeSession)
+	routes.handle("DELETE /api/v1/auth/users/{userId}/sessions", authHandler.RevokeAllUserSessions)
 
 	// ----------------------------------------------------------------
 	// Performance Management routes — JWT required
@@ -48,95 +116,96 @@ func NewRouter(svc *service.Container, mw *middleware.Stack, cfg *config.Config,
 	perfHandler := NewPerformanceMgtHandler(svc, log)
 
 	// -- Strategies --
-	mux.Handle("GET /api/v1/performance/strategies", jwtProtect(mw, perfHandler.GetBankStrategies))
-	mux.Handle("POST /api/v1/performance/strategies", jwtProtect(mw, perfHandler.CreateStrategy))
-	mux.Handle("PUT /api/v1/performance/strategies", jwtProtect(mw, perfHandler.UpdateStrategy))
+	routes.handle("GET /api/v1/performance/strategies", perfHandler.GetBankStrategies)
+	routes.handle("POST /api/v1/performance/strategies", perfHandler.CreateStrategy)
+	routes.handle("PUT /api/v1/performance/strategies", perfHandler.UpdateStrategy)
 
 	// -- Strategic Themes --
-	mux.Handle("GET /api/v1/performance/strategic-themes", jwtProtect(mw, perfHandler.GetBankStrategicThemes))
-	mux.Handle("POST /api/v1/performance/strategic-themes", jwtProtect(mw, perfHandler.CreateStrategicTheme))
-	mux.Handle("PUT /api/v1/performance/strategic-themes", jwtProtect(mw, perfHandler.UpdateStrategicTheme))
+	routes.handle("GET /api/v1/performance/strategic-themes", perfHandler.GetBankStrategicThemes)
+	routes.handle("POST /api/v1/performance/strategic-themes", perfHandler.CreateStrategicTheme)
+	routes.handle("PUT /api/v1/performance/strategic-themes", perfHandler.UpdateStrategicTheme)
 
 	// -- Enterprise Objectives --
-	mux.Handle("GET /api/v1/performance/objectives/enterprise", jwtProtect(mw, perfHandler.GetEnterpriseObjectives))
-	mux.Handle("POST /api/v1/performance/objectives/enterprise", jwtProtect(mw, perfHandler.CreateEnterpriseObjective))
-	mux.Handle("PUT /api/v1/performance/objectives/enterprise", jwtProtect(mw, perfHandler.UpdateEnterpriseObjective))
+	routes.handle("GET /api/v1/performance/objectives/enterprise", perfHandler.GetEnterpriseObjectives)
+	routes.handle("POST /api/v1/performance/objectives/enterprise", perfHandler.CreateEnterpriseObjective)
+	routes.handle("PUT /api/v1/performance/objectives/enterprise", perfHandler.UpdateEnterpriseObjective)
 
 	// -- Department Objectives --
-	mux.Handle("GET /api/v1/performance/objectives/department", jwtProtect(mw, perfHandler.GetDepartmentObjectives))
-	mux.Handle("POST /api/v1/performance/objectives/department", jwtProtect(mw, perfHandler.CreateDepartmentObjective))
-	mux.Handle("PUT /api/v1/performance/objectives/department", jwtProtect(mw, perfHandler.UpdateDepartmentObjective))
+	routes.handle("GET /api/v1/performance/objectives/department", perfHandler.GetDepartmentObjectives)
+	routes.handle("POST /api/v1/performance/objectives/department", perfHandler.CreateDepartmentObjective)
+	routes.handle("PUT /api/v1/performance/objectives/department", perfHandler.UpdateDepartmentObjective)
 
 	// -- Division Objectives --
-	mux.Handle("GET /api/v1/performance/objectives/division", jwtProtect(mw, perfHandler.GetDivisionObjectives))
-	mux.Handle("POST /api/v1/performance/objectives/division", jwtProtect(mw, perfHandler.CreateDivisionObjective))
-	mux.Handle("PUT /api/v1/performance/objectives/division", jwtProtect(mw, perfHandler.UpdateDivisionObjective))
+	routes.handle("GET /api/v1/performance/objectives/division", perfHandler.GetDivisionObjectives)
+	routes.handle("POST /api/v1/performance/objectives/division", perfHandler.CreateDivisionObjective)
+	routes.handle("PUT /api/v1/performance/objectives/division", perfHandler.UpdateDivisionObjective)
 
 	// -- Office Objectives --
-	mux.Handle("GET /api/v1/performance/objectives/office", jwtProtect(mw, perfHandler.GetOfficeObjectives))
-	mux.Handle("POST /api/v1/performance/objectives/office", jwtProtect(mw, perfHandler.CreateOfficeObjective))
-	mux.Handle("PUT /api/v1/performance/objectives/office", jwtProtect(mw, perfHandler.UpdateOfficeObjective))
+	routes.handle("GET /api/v1/performance/objectives/office", perfHandler.GetOfficeObjectives)
+	routes.handle("POST /api/v1/performance/objectives/office", perfHandler.CreateOfficeObjective)
+	routes.handle("PUT /api/v1/performance/objectives/office", perfHandler.UpdateOfficeObjective)
 
 	// -- Consolidated Objectives --
-	mux.Handle("GET /api/v1/performance/objectives/consolidated", jwtProtect(mw, perfHandler.GetConsolidatedObjectives))
-	mux.Handle("GET /api/v1/performance/objectives/consolidated/paginated", jwtProtect(mw, perfHandler.GetConsolidatedObjectivesPaginated))
+	routes.handle("GET /api/v1/performance/objectives/consolidated", perfHandler.GetConsolidatedObjectives)
+	routes.handle("GET /api/v1/performance/objectives/consolidated/paginated", perfHandler.GetConsolidatedObjectivesPaginated)
 
 	// -- Objective Categories --
-	mux.Handle("GET /api/v1/performance/objective-categories", jwtProtect(mw, perfHandler.GetObjectiveCategories))
-	mux.Handle("POST /api/v1/performance/objective-categories", jwtProtect(mw, perfHandler.CreateObjectiveCategory))
-	mux.Handle("PUT /api/v1/performance/objective-categories", jwtProtect(mw, perfHandler.UpdateObjectiveCategory))
+	routes.handle("GET /api/v1/performance/objective-categories", perfHandler.GetObjectiveCategories)
+	routes.handle("POST /api/v1/performance/objective-categories", perfHandler.CreateObjectiveCategory)
+	routes.handle("PUT /api/v1/performance/objective-categories", perfHandler.UpdateObjectiveCategory)
 
 	// -- Category Definitions --
-	mux.Handle("GET /api/v1/performance/category-definitions", jwtProtect(mw, perfHandler.GetCategoryDefinitions))
-	mux.Handle("POST /api/v1/performance/category-definitions", jwtProtect(mw, perfHandler.CreateCategoryDefinition))
-	mux.Handle("PUT /api/v1/performance/category-definitions", jwtProtect(mw, perfHandler.UpdateCategoryDefinition))
+	routes.handle("GET /api/v1/performance/category-definitions", perfHandler.GetCategoryDefinitions)
+	routes.handle("POST /api/v1/performance/category-definitions", perfHandler.CreateCategoryDefinition)
+	routes.handle("PUT /api/v1/performance/category-definitions", perfHandler.UpdateCategoryDefinition)
 
 	// -- Evaluation Options --
-	mux.Handle("GET /api/v1/performance/evaluation-options", jwtProtect(mw, perfHandler.GetEvaluationOptions))
-	mux.Handle("POST /api/v1/performance/evaluation-options", jwtProtect(mw, perfHandler.SaveEvaluationOptions))
+	routes.handle("GET /api/v1/performance/evaluation-options", perfHandler.GetEvaluationOptions)
+	routes.handle("POST /api/v1/performance/evaluation-options", perfHandler.SaveEvaluationOptions)
 
 	// -- Feedback Questionnaires --
-	mux.Handle("GET /api/v1/performance/feedback-questionnaires", jwtProtect(mw, perfHandler.GetFeedbackQuestionnaires))
-	mux.Handle("POST /api/v1/performance/feedback-questionnaires", jwtProtect(mw, perfHandler.SaveFeedbackQuestionnaires))
-	mux.Handle("POST /api/v1/performance/feedback-questionnaire-options", jwtProtect(mw, perfHandler.SaveFeedbackQuestionnaireOptions))
+	routes.handle("GET /api/v1/performance/feedback-questionnaires", perfHandler.GetFeedbackQuestionnaires)
+	routes.handle("POST /api/v1/performance/feedback-questionnaires", perfHandler.SaveFeedbackQuestionnaires)
+	routes.handle("POST /api/v1/performance/feedback-questionnaire-options", perfHandler.SaveFeedbackQuestionnaireOptions)
 
 	// -- PMS Competencies --
-	mux.Handle("GET /api/v1/performance/competencies", jwtProtect(mw, perfHandler.GetPmsCompetencies))
-	mux.Handle("POST /api/v1/performance/competencies", jwtProtect(mw, perfHandler.CreatePmsCompetency))
-	mux.Handle("PUT /api/v1/performance/competencies", jwtProtect(mw, perfHandler.UpdatePmsCompetency))
+	routes.handle("GET /api/v1/performance/competencies", perfHandler.GetPmsCompetencies)
+	routes.handle("POST /api/v1/performance/competencies", perfHandler.CreatePmsCompetency)
+	routes.handle("PUT /api/v1/performance/competencies", perfHandler.UpdatePmsCompetency)
 
 	// -- Work Product Definitions --
-	mux.Handle("GET /api/v1/performance/work-product-definitions", jwtProtect(mw, perfHandler.GetObjectiveWorkProductDefinitions))
-	mux.Handle("GET /api/v1/performance/work-product-definitions/all", jwtProtect(mw, perfHandler.GetAllWorkProductDefinitions))
-	mux.Handle("GET /api/v1/performance/work-product-definitions/paginated", jwtProtect(mw, perfHandler.GetAllPaginatedWorkProductDefinitions))
-	mux.Handle("POST /api/v1/performance/work-product-definitions", jwtProtect(mw, perfHandler.SaveWorkProductDefinition))
+	routes.handle("GET /api/v1/performance/work-product-definitions", perfHandler.GetObjectiveWorkProductDefinitions)
+	routes.handle("GET /api/v1/performance/work-product-definitions/all", perfHandler.GetAllWorkProductDefinitions)
+	routes.handle("GET /api/v1/performance/work-product-definitions/paginated", perfHandler.GetAllPaginatedWorkProductDefinitions)
+	routes.handle("POST /api/v1/performance/work-product-definitions", perfHandler.SaveWorkProductDefinition)
 
 	// -- Objectives Upload / Activation --
-	mux.Handle("POST /api/v1/performance/objectives/upload", jwtProtect(mw, perfHandler.UploadObjectives))
-	mux.Handle("POST /api/v1/performance/objectives/deactivate", jwtProtect(mw, perfHandler.DeActivateObjectives))
-	mux.Handle("POST /api/v1/performance/objectives/reactivate", jwtProtect(mw, perfHandler.ReActivateObjectives))
+	routes.handle("POST /api/v1/performance/objectives/upload", perfHandler.UploadObjectives)
+	routes.handle("GET /api/v1/performance/objectives/upload/template", perfHandler.GetObjectiveUploadTemplate)
+	routes.handle("POST /api/v1/performance/objectives/deactivate", perfHandler.DeActivateObjectives)
+	routes.handle("POST /api/v1/performance/objectives/reactivate", perfHandler.ReActivateObjectives)
 
 	// -- Approve / Reject --
-	mux.Handle("POST /api/v1/performance/approve", jwtProtect(mw, perfHandler.ApproveRecords))
-	mux.Handle("POST /api/v1/performance/reject", jwtProtect(mw, perfHandler.RejectRecords))
+	routes.handle("POST /api/v1/performance/approve", perfHandler.ApproveRecords)
+	routes.handle("POST /api/v1/performance/reject", perfHandler.RejectRecords)
 
 	// -- Performance Enum Select Lists --
-	mux.Handle("GET /api/v1/performance/enums/objective-levels", jwtProtect(mw, perfHandler.GetObjectiveLevels))
-	mux.Handle("GET /api/v1/performance/enums/extension-target-types", jwtProtect(mw, perfHandler.GetExtensionTargetTypes))
-	mux.Handle("GET /api/v1/performance/enums/evaluation-types", jwtProtect(mw, perfHandler.GetEvaluationTypes))
-	mux.Handle("GET /api/v1/performance/enums/work-product-types", jwtProtect(mw, perfHandler.GetWorkProductTypes))
-	mux.Handle("GET /api/v1/performance/enums/grievance-types", jwtProtect(mw, perfHandler.GetGrievanceTypes))
-	mux.Handle("GET /api/v1/performance/enums/feedback-request-types", jwtProtect(mw, perfHandler.GetFeedBackRequestTypes))
-	mux.Handle("GET /api/v1/performance/enums/performance-grades", jwtProtect(mw, perfHandler.GetPerformanceGrades))
-	mux.Handle("GET /api/v1/performance/enums/review-period-ranges", jwtProtect(mw, perfHandler.GetReviewPeriodRange))
-	mux.Handle("GET /api/v1/performance/enums/statuses", jwtProtect(mw, perfHandler.GetStatuses))
+	routes.handle("GET /api/v1/performance/enums/objective-levels", perfHandler.GetObjectiveLevels)
+	routes.handle("GET /api/v1/performance/enums/extension-target-types", perfHandler.GetExtensionTargetTypes)
+	routes.handle("GET /api/v1/performance/enums/evaluation-types", perfHandler.GetEvaluationTypes)
+	routes.handle("GET /api/v1/performance/enums/work-product-types", perfHandler.GetWorkProductTypes)
+	routes.handle("GET /api/v1/performance/enums/grievance-types", perfHandler.GetGrievanceTypes)
+	routes.handle("GET /api/v1/performance/enums/feedback-request-types", perfHandler.GetFeedBackRequestTypes)
+	routes.handle("GET /api/v1/performance/enums/performance-grades", perfHandler.GetPerformanceGrades)
+	routes.handle("GET /api/v1/performance/enums/review-period-ranges", perfHandler.GetReviewPeriodRange)
+	routes.handle("GET /api/v1/performance/enums/statuses", perfHandler.GetStatuses)
 
 	// ----------------------------------------------------------------
 	// PMS Management Engine routes — JWT required
 	// (PmsEngineHandler has its own RegisterRoutes method)
 	// ----------------------------------------------------------------
 	pmsEngineHandler := NewPmsEngineHandler(svc, log)
-	pmsEngineHandler.RegisterRoutes(mux, mw)
+	pmsEngineHandler.RegisterRoutes(routes)
 
 	// ----------------------------------------------------------------
 	// Review Period routes — JWT required
@@ -144,97 +213,97 @@ func NewRouter(svc *service.Container, mw *middleware.Stack, cfg *config.Config,
 	rpHandler := NewReviewPeriodHandler(svc, log)
 
 	// -- Review Period Lifecycle --
-	mux.Handle("POST /api/v1/review-periods/draft", jwtProtect(mw, rpHandler.SaveDraftReviewPeriod))
-	mux.Handle("POST /api/v1/review-periods", jwtProtect(mw, rpHandler.AddReviewPeriod))
-	mux.Handle("POST /api/v1/review-periods/submit-draft", jwtProtect(mw, rpHandler.SubmitDraftReviewPeriod))
-	mux.Handle("POST /api/v1/review-periods/approve", jwtProtect(mw, rpHandler.ApproveReviewPeriod))
-	mux.Handle("POST /api/v1/review-periods/reject", jwtProtect(mw, rpHandler.RejectReviewPeriod))
-	mux.Handle("POST /api/v1/review-periods/return", jwtProtect(mw, rpHandler.ReturnReviewPeriod))
-	mux.Handle("POST /api/v1/review-periods/resubmit", jwtProtect(mw, rpHandler.ReSubmitReviewPeriod))
-	mux.Handle("PUT /api/v1/review-periods", jwtProtect(mw, rpHandler.UpdateReviewPeriod))
-	mux.Handle("POST /api/v1/review-periods/cancel", jwtProtect(mw, rpHandler.CancelReviewPeriod))
-	mux.Handle("POST /api/v1/review-periods/close", jwtProtect(mw, rpHandler.CloseReviewPeriod))
+	routes.handle("POST /api/v1/review-periods/draft", rpHandler.SaveDraftReviewPeriod)
+	routes.handle("POST /api/v1/review-periods", rpHandler.AddReviewPeriod)
+	routes.handle("POST /api/v1/review-periods/submit-draft", rpHandler.SubmitDraftReviewPeriod)
+	routes.handle("POST /api/v1/review-periods/approve", rpHandler.ApproveReviewPeriod)
+	routes.handle("POST /api/v1/review-periods/reject", rpHandler.RejectReviewPeriod)
+	routes.handle("POST /api/v1/review-periods/return", rpHandler.ReturnReviewPeriod)
+	routes.handle("POST /api/v1/review-periods/resubmit", rpHandler.ReSubmitReviewPeriod)
+	routes.handle("PUT /api/v1/review-periods", rpHandler.UpdateReviewPeriod)
+	routes.handle("POST /api/v1/review-periods/cancel", rpHandler.CancelReviewPeriod)
+	routes.handle("POST /api/v1/review-periods/close", rpHandler.CloseReviewPeriod)
 
 	// -- Review Period Toggles --
-	mux.Handle("POST /api/v1/review-periods/enable-objective-planning", jwtProtect(mw, rpHandler.EnableObjectivePlanning))
-	mux.Handle("POST /api/v1/review-periods/disable-objective-planning", jwtProtect(mw, rpHandler.DisableObjectivePlanning))
-	mux.Handle("POST /api/v1/review-periods/enable-work-product-planning", jwtProtect(mw, rpHandler.EnableWorkProductPlanning))
-	mux.Handle("POST /api/v1/review-periods/disable-work-product-planning", jwtProtect(mw, rpHandler.DisableWorkProductPlanning))
-	mux.Handle("POST /api/v1/review-periods/enable-work-product-evaluation", jwtProtect(mw, rpHandler.EnableWorkProductEvaluation))
-	mux.Handle("POST /api/v1/review-periods/disable-work-product-evaluation", jwtProtect(mw, rpHandler.DisableWorkProductEvaluation))
+	routes.handle("POST /api/v1/review-periods/enable-objective-planning", rpHandler.EnableObjectivePlanning)
+	routes.handle("POST /api/v1/review-periods/disable-objective-planning", rpHandler.DisableObjectivePlanning)
+	routes.handle("POST /api/v1/review-periods/enable-work-product-planning", rpHandler.EnableWorkProductPlanning)
+	routes.handle("POST /api/v1/review-periods/disable-work-product-planning", rpHandler.DisableWorkProductPlanning)
+	routes.handle("POST /api/v1/review-periods/enable-work-product-evaluation", rpHandler.EnableWorkProductEvaluation)
+	routes.handle("POST /api/v1/review-periods/disable-work-product-evaluation", rpHandler.DisableWorkProductEvaluation)
 
 	// -- Review Period Queries --
-	mux.Handle("GET /api/v1/review-periods/all", jwtProtect(mw, rpHandler.GetReviewPeriods))
-	mux.Handle("GET /api/v1/review-periods/active", jwtProtect(mw, rpHandler.GetActiveReviewPeriod))
-	mux.Handle("GET /api/v1/review-periods/staff-active", jwtProtect(mw, rpHandler.GetStaffActiveReviewPeriod))
-	mux.Handle("GET /api/v1/review-periods/planned-objective", jwtProtect(mw, rpHandler.GetPlannedObjective))
-	mux.Handle("GET /api/v1/review-periods/enterprise-objective", jwtProtect(mw, rpHandler.GetEnterpriseObjectiveByLevel))
-	mux.Handle("GET /api/v1/review-periods/objectives-by-status", jwtProtect(mw, rpHandler.GetObjectivesByWorkproductStatus))
-	mux.Handle("GET /api/v1/review-periods/{reviewPeriodId}/category-definitions", jwtProtect(mw, rpHandler.GetReviewPeriodCategoryDefinitions))
-	mux.Handle("GET /api/v1/review-periods/{reviewPeriodId}/objectives-with-categories", jwtProtect(mw, rpHandler.GetReviewPeriodObjectivesWithCategoryDefinitions))
-	mux.Handle("GET /api/v1/review-periods/{reviewPeriodId}/planned-objectives", jwtProtect(mw, rpHandler.GetAllPlannedOperationalObjectives))
-	mux.Handle("GET /api/v1/review-periods/{reviewPeriodId}", jwtProtect(mw, rpHandler.GetReviewPeriodDetails))
+	routes.handle("GET /api/v1/review-periods/all", rpHandler.GetReviewPeriods)
+	routes.handle("GET /api/v1/review-periods/active", rpHandler.GetActiveReviewPeriod)
+	routes.handle("GET /api/v1/review-periods/staff-active", rpHandler.GetStaffActiveReviewPeriod)
+	routes.handle("GET /api/v1/review-periods/planned-objective", rpHandler.GetPlannedObjective)
+	routes.handle("GET /api/v1/review-periods/enterprise-objective", rpHandler.GetEnterpriseObjectiveByLevel)
+	routes.handle("GET /api/v1/review-periods/objectives-by-status", rpHandler.GetObjectivesByWorkproductStatus)
+	routes.handle("GET /api/v1/review-periods/{reviewPeriodId}/category-definitions", rpHandler.GetReviewPeriodCategoryDefinitions)
+	routes.handle("GET /api/v1/review-periods/{reviewPeriodId}/objectives-with-categories", rpHandler.GetReviewPeriodObjectivesWithCategoryDefinitions)
+	routes.handle("GET /api/v1/review-periods/{reviewPeriodId}/planned-objectives", rpHandler.GetAllPlannedOperationalObjectives)
+	routes.handle("GET /api/v1/review-periods/{reviewPeriodId}", rpHandler.GetReviewPeriodDetails)
 
 	// -- Review Period Objectives --
-	mux.Handle("POST /api/v1/review-periods/objectives/draft", jwtProtect(mw, rpHandler.SaveDraftReviewPeriodObjective))
-	mux.Handle("POST /api/v1/review-periods/objectives", jwtProtect(mw, rpHandler.AddReviewPeriodObjective))
-	mux.Handle("POST /api/v1/review-periods/objectives/submit-draft", jwtProtect(mw, rpHandler.SubmitDraftReviewPeriodObjective))
-	mux.Handle("POST /api/v1/review-periods/objectives/cancel", jwtProtect(mw, rpHandler.CancelReviewPeriodObjective))
-	mux.Handle("GET /api/v1/review-periods/{reviewPeriodId}/objectives", jwtProtect(mw, rpHandler.GetReviewPeriodObjectives))
+	routes.handle("POST /api/v1/review-periods/objectives/draft", rpHandler.SaveDraftReviewPeriodObjective)
+	routes.handle("POST /api/v1/review-periods/objectives", rpHandler.AddReviewPeriodObjective)
+	routes.handle("POST /api/v1/review-periods/objectives/submit-draft", rpHandler.SubmitDraftReviewPeriodObjective)
+	routes.handle("POST /api/v1/review-periods/objectives/cancel", rpHandler.CancelReviewPeriodObjective)
+	routes.handle("GET /api/v1/review-periods/{reviewPeriodId}/objectives", rpHandler.GetReviewPeriodObjectives)
 
 	// -- Review Period Objective Category Definitions --
-	mux.Handle("POST /api/v1/review-periods/category-definitions/draft", jwtProtect(mw, rpHandler.SaveDraftReviewPeriodObjectiveCategoryDefinition))
-	mux.Handle("POST /api/v1/review-periods/category-definitions", jwtProtect(mw, rpHandler.AddReviewPeriodObjectiveCategoryDefinition))
-	mux.Handle("POST /api/v1/review-periods/category-definitions/submit-draft", jwtProtect(mw, rpHandler.SubmitDraftReviewPeriodObjectiveCategoryDefinition))
-	mux.Handle("POST /api/v1/review-periods/category-definitions/approve", jwtProtect(mw, rpHandler.ApproveReviewPeriodObjectiveCategoryDefinition))
-	mux.Handle("POST /api/v1/review-periods/category-definitions/reject", jwtProtect(mw, rpHandler.RejectReviewPeriodObjectiveCategoryDefinition))
+	routes.handle("POST /api/v1/review-periods/category-definitions/draft", rpHandler.SaveDraftReviewPeriodObjectiveCategoryDefinition)
+	routes.handle("POST /api/v1/review-periods/category-definitions", rpHandler.AddReviewPeriodObjectiveCategoryDefinition)
+	routes.handle("POST /api/v1/review-periods/category-definitions/submit-draft", rpHandler.SubmitDraftReviewPeriodObjectiveCategoryDefinition)
+	routes.handle("POST /api/v1/review-periods/category-definitions/approve", rpHandler.ApproveReviewPeriodObjectiveCategoryDefinition)
+	routes.handle("POST /api/v1/review-periods/category-definitions/reject", rpHandler.RejectReviewPeriodObjectiveCategoryDefinition)
 
 	// -- Review Period Extensions (full lifecycle) --
-	mux.Handle("POST /api/v1/review-periods/extensions/draft", jwtProtect(mw, rpHandler.SaveDraftReviewPeriodExtension))
-	mux.Handle("POST /api/v1/review-periods/extensions/submit-draft", jwtProtect(mw, rpHandler.SubmitDraftReviewPeriodExtension))
-	mux.Handle("POST /api/v1/review-periods/extensions/approve", jwtProtect(mw, rpHandler.ApproveReviewPeriodExtension))
-	mux.Handle("POST /api/v1/review-periods/extensions/reject", jwtProtect(mw, rpHandler.RejectReviewPeriodExtension))
-	mux.Handle("POST /api/v1/review-periods/extensions/return", jwtProtect(mw, rpHandler.ReturnReviewPeriodExtension))
-	mux.Handle("POST /api/v1/review-periods/extensions/resubmit", jwtProtect(mw, rpHandler.ReSubmitReviewPeriodExtension))
-	mux.Handle("POST /api/v1/review-periods/extensions/cancel", jwtProtect(mw, rpHandler.CancelReviewPeriodExtension))
-	mux.Handle("POST /api/v1/review-periods/extensions/close", jwtProtect(mw, rpHandler.CloseReviewPeriodExtension))
-	mux.Handle("PUT /api/v1/review-periods/extensions", jwtProtect(mw, rpHandler.UpdateReviewPeriodExtension))
-	mux.Handle("POST /api/v1/review-periods/extensions", jwtProtect(mw, rpHandler.AddReviewPeriodExtension))
-	mux.Handle("GET /api/v1/review-periods/extensions/all", jwtProtect(mw, rpHandler.GetAllReviewPeriodExtensions))
-	mux.Handle("GET /api/v1/review-periods/{reviewPeriodId}/extensions", jwtProtect(mw, rpHandler.GetReviewPeriodExtensions))
+	routes.handle("POST /api/v1/review-periods/extensions/draft", rpHandler.SaveDraftReviewPeriodExtension)
+	routes.handle("POST /api/v1/review-periods/extensions/submit-draft", rpHandler.SubmitDraftReviewPeriodExtension)
+	routes.handle("POST /api/v1/review-periods/extensions/approve", rpHandler.ApproveReviewPeriodExtension)
+	routes.handle("POST /api/v1/review-periods/extensions/reject", rpHandler.RejectReviewPeriodExtension)
+	routes.handle("POST /api/v1/review-periods/extensions/return", rpHandler.ReturnReviewPeriodExtension)
+	routes.handle("POST /api/v1/review-periods/extensions/resubmit", rpHandler.ReSubmitReviewPeriodExtension)
+	routes.handle("POST /api/v1/review-periods/extensions/cancel", rpHandler.CancelReviewPeriodExtension)
+	routes.handle("POST /api/v1/review-periods/extensions/close", rpHandler.CloseReviewPeriodExtension)
+	routes.handle("PUT /api/v1/review-periods/extensions", rpHandler.UpdateReviewPeriodExtension)
+	routes.handle("POST /api/v1/review-periods/extensions", rpHandler.AddReviewPeriodExtension)
+	routes.handle("GET /api/v1/review-periods/extensions/all", rpHandler.GetAllReviewPeriodExtensions)
+	routes.handle("GET /api/v1/review-periods/{reviewPeriodId}/extensions", rpHandler.GetReviewPeriodExtensions)
 
 	// -- Review Period 360 Reviews --
-	mux.Handle("POST /api/v1/review-periods/360-reviews", jwtProtect(mw, rpHandler.AddReviewPeriod360Review))
-	mux.Handle("GET /api/v1/review-periods/{reviewPeriodId}/360-reviews", jwtProtect(mw, rpHandler.GetReviewPeriod360Reviews))
+	routes.handle("POST /api/v1/review-periods/360-reviews", rpHandler.AddReviewPeriod360Review)
+	routes.handle("GET /api/v1/review-periods/{reviewPeriodId}/360-reviews", rpHandler.GetReviewPeriod360Reviews)
 
 	// -- Individual Planned Objectives --
-	mux.Handle("POST /api/v1/review-periods/individual-objectives/draft", jwtProtect(mw, rpHandler.SaveDraftIndividualPlannedObjective))
-	mux.Handle("POST /api/v1/review-periods/individual-objectives", jwtProtect(mw, rpHandler.AddIndividualPlannedObjective))
-	mux.Handle("POST /api/v1/review-periods/individual-objectives/submit-draft", jwtProtect(mw, rpHandler.SubmitDraftIndividualPlannedObjective))
-	mux.Handle("POST /api/v1/review-periods/individual-objectives/approve", jwtProtect(mw, rpHandler.ApproveIndividualPlannedObjective))
-	mux.Handle("POST /api/v1/review-periods/individual-objectives/reject", jwtProtect(mw, rpHandler.RejectIndividualPlannedObjective))
-	mux.Handle("POST /api/v1/review-periods/individual-objectives/return", jwtProtect(mw, rpHandler.ReturnIndividualPlannedObjective))
-	mux.Handle("POST /api/v1/review-periods/individual-objectives/cancel", jwtProtect(mw, rpHandler.CancelIndividualPlannedObjective))
-	mux.Handle("POST /api/v1/review-periods/individual-objectives/accept", jwtProtect(mw, rpHandler.AcceptIndividualPlannedObjective))
-	mux.Handle("POST /api/v1/review-periods/individual-objectives/reinstate", jwtProtect(mw, rpHandler.ReInstateIndividualPlannedObjective))
-	mux.Handle("POST /api/v1/review-periods/individual-objectives/pause", jwtProtect(mw, rpHandler.PauseIndividualPlannedObjective))
-	mux.Handle("POST /api/v1/review-periods/individual-objectives/suspend", jwtProtect(mw, rpHandler.SuspendIndividualPlannedObjective))
-	mux.Handle("POST /api/v1/review-periods/individual-objectives/resume", jwtProtect(mw, rpHandler.ResumeIndividualPlannedObjective))
-	mux.Handle("POST /api/v1/review-periods/individual-objectives/resubmit", jwtProtect(mw, rpHandler.ReSubmitIndividualPlannedObjective))
-	mux.Handle("GET /api/v1/review-periods/individual-objectives", jwtProtect(mw, rpHandler.GetStaffIndividualPlannedObjectives))
+	routes.handle("POST /api/v1/review-periods/individual-objectives/draft", rpHandler.SaveDraftIndividualPlannedObjective)
+	routes.handle("POST /api/v1/review-periods/individual-objectives", rpHandler.AddIndividualPlannedObjective)
+	routes.handle("POST /api/v1/review-periods/individual-objectives/submit-draft", rpHandler.SubmitDraftIndividualPlannedObjective)
+	routes.handle("POST /api/v1/review-periods/individual-objectives/approve", rpHandler.ApproveIndividualPlannedObjective)
+	routes.handle("POST /api/v1/review-periods/individual-objectives/reject", rpHandler.RejectIndividualPlannedObjective)
+	routes.handle("POST /api/v1/review-periods/individual-objectives/return", rpHandler.ReturnIndividualPlannedObjective)
+	routes.handle("POST /api/v1/review-periods/individual-objectives/cancel", rpHandler.CancelIndividualPlannedObjective)
+	routes.handle("POST /api/v1/review-periods/individual-objectives/accept", rpHandler.AcceptIndividualPlannedObjective)
+	routes.handle("POST /api/v1/review-periods/individual-objectives/reinstate", rpHandler.ReInstateIndividualPlannedObjective)
+	routes.handle("POST /api/v1/review-periods/individual-objectives/pause", rpHandler.PauseIndividualPlannedObjective)
+	routes.handle("POST /api/v1/review-periods/individual-objectives/suspend", rpHandler.SuspendIndividualPlannedObjective)
+	routes.handle("POST /api/v1/review-periods/individual-objectives/resume", rpHandler.ResumeIndividualPlannedObjective)
+	routes.handle("POST /api/v1/review-periods/individual-objectives/resubmit", rpHandler.ReSubmitIndividualPlannedObjective)
+	routes.handle("GET /api/v1/review-periods/individual-objectives", rpHandler.GetStaffIndividualPlannedObjectives)
 
 	// -- Period Objective Evaluations --
-	mux.Handle("POST /api/v1/review-periods/evaluations", jwtProtect(mw, rpHandler.CreatePeriodObjectiveEvaluation))
-	mux.Handle("POST /api/v1/review-periods/evaluations/department", jwtProtect(mw, rpHandler.CreatePeriodObjectiveDepartmentEvaluation))
-	mux.Handle("GET /api/v1/review-periods/{reviewPeriodId}/evaluations", jwtProtect(mw, rpHandler.GetPeriodObjectiveEvaluations))
-	mux.Handle("GET /api/v1/review-periods/{reviewPeriodId}/evaluations/department", jwtProtect(mw, rpHandler.GetPeriodObjectiveDepartmentEvaluations))
+	routes.handle("POST /api/v1/review-periods/evaluations", rpHandler.CreatePeriodObjectiveEvaluation)
+	routes.handle("POST /api/v1/review-periods/evaluations/department", rpHandler.CreatePeriodObjectiveDepartmentEvaluation)
+	routes.handle("GET /api/v1/review-periods/{reviewPeriodId}/evaluations", rpHandler.GetPeriodObjectiveEvaluations)
+	routes.handle("GET /api/v1/review-periods/{reviewPeriodId}/evaluations/department", rpHandler.GetPeriodObjectiveDepartmentEvaluations)
 
 	// -- Period Scores --
-	mux.Handle("GET /api/v1/review-periods/scores", jwtProtect(mw, rpHandler.GetStaffPeriodScore))
+	routes.handle("GET /api/v1/review-periods/scores", rpHandler.GetStaffPeriodScore)
 
 	// -- Archive Operations --
-	mux.Handle("POST /api/v1/review-periods/archive-objectives", jwtProtect(mw, rpHandler.ArchiveCancelledObjectives))
-	mux.Handle("POST /api/v1/review-periods/archive-workproducts", jwtProtect(mw, rpHandler.ArchiveCancelledWorkProducts))
+	routes.handle("POST /api/v1/review-periods/archive-objectives", rpHandler.ArchiveCancelledObjectives)
+	routes.handle("POST /api/v1/review-periods/archive-workproducts", rpHandler.ArchiveCancelledWorkProducts)
 
 	// ----------------------------------------------------------------
 	// Competency Management routes — JWT required
@@ -242,125 +311,241 @@ func NewRouter(svc *service.Container, mw *middleware.Stack, cfg *config.Config,
 	compHandler := NewCompetencyMgtHandler(svc, log)
 
 	// -- Competencies --
-	mux.Handle("GET /api/v1/competency/competencies", jwtProtect(mw, compHandler.GetCompetencies))
-	mux.Handle("POST /api/v1/competency/competencies", jwtProtect(mw, compHandler.SaveCompetency))
-	mux.Handle("POST /api/v1/competency/competencies/approve", jwtProtect(mw, compHandler.ApproveCompetency))
-	mux.Handle("POST /api/v1/competency/competencies/reject", jwtProtect(mw, compHandler.RejectCompetency))
+	routes.handle("GET /api/v1/competency/competencies", compHandler.GetCompetencies)
+	routes.handle("POST /api/v1/competency/competencies", compHandler.SaveCompetency)
+	routes.handle("POST /api/v1/competency/competencies/approve", compHandler.ApproveCompetency)
+	routes.handle("POST /api/v1/competency/competencies/reject", compHandler.RejectCompetency)
 
 	// -- Competency Categories --
-	mux.Handle("GET /api/v1/competency/categories", jwtProtect(mw, compHandler.GetCompetencyCategories))
-	mux.Handle("POST /api/v1/competency/categories", jwtProtect(mw, compHandler.SaveCompetencyCategory))
+	routes.handle("GET /api/v1/competency/categories", compHandler.GetCompetencyCategories)
+	routes.handle("POST /api/v1/competency/categories", compHandler.SaveCompetencyCategory)
 
 	// -- Competency Category Gradings --
-	mux.Handle("GET /api/v1/competency/category-gradings", jwtProtect(mw, compHandler.GetCompetencyCategoryGradings))
-	mux.Handle("POST /api/v1/competency/category-gradings", jwtProtect(mw, compHandler.SaveCompetencyCategoryGrading))
+	routes.handle("GET /api/v1/competency/category-gradings", compHandler.GetCompetencyCategoryGradings)
+	routes.handle("POST /api/v1/competency/category-gradings", compHandler.SaveCompetencyCategoryGrading)
 
 	// -- Competency Rating Definitions --
-	mux.Handle("GET /api/v1/competency/rating-definitions", jwtProtect(mw, compHandler.GetCompetencyRatingDefinitions))
-	mux.Handle("POST /api/v1/competency/rating-definitions", jwtProtect(mw, compHandler.SaveCompetencyRatingDefinition))
+	routes.handle("GET /api/v1/competency/rating-definitions", compHandler.GetCompetencyRatingDefinitions)
+	routes.handle("POST /api/v1/competency/rating-definitions", compHandler.SaveCompetencyRatingDefinition)
 
 	// -- Competency Reviews --
-	mux.Handle("GET /api/v1/competency/reviews", jwtProtect(mw, compHandler.GetCompetencyReviews))
-	mux.Handle("GET /api/v1/competency/reviews/by-reviewer", jwtProtect(mw, compHandler.GetCompetencyReviewByReviewer))
-	mux.Handle("GET /api/v1/competency/reviews/for-employee", jwtProtect(mw, compHandler.GetCompetencyReviewForEmployee))
-	mux.Handle("GET /api/v1/competency/reviews/detail", jwtProtect(mw, compHandler.GetCompetencyReviewDetail))
-	mux.Handle("POST /api/v1/competency/reviews", jwtProtect(mw, compHandler.SaveCompetencyReview))
-	mux.Handle("GET /api/v1/competency/reviews/by-office", jwtProtect(mw, compHandler.GetOfficeCompetencyReviews))
+	routes.handle("GET /api/v1/competency/reviews", compHandler.GetCompetencyReviews)
+	routes.handle("GET /api/v1/competency/reviews/by-reviewer", compHandler.GetCompetencyReviewByReviewer)
+	routes.handle("GET /api/v1/competency/reviews/for-employee", compHandler.GetCompetencyReviewForEmployee)
+	routes.handle("GET /api/v1/competency/reviews/detail", compHandler.GetCompetencyReviewDetail)
+	routes.handle("POST /api/v1/competency/reviews", compHandler.SaveCompetencyReview)
+	routes.handle("GET /api/v1/competency/reviews/by-office", compHandler.GetOfficeCompetencyReviews)
 
 	// -- Competency Review Profiles --
-	mux.Handle("GET /api/v1/competency/review-profiles", jwtProtect(mw, compHandler.GetCompetencyReviewProfiles))
-	mux.Handle("GET /api/v1/competency/review-profiles/group", jwtProtect(mw, compHandler.GetGroupCompetencyReviewProfiles))
-	mux.Handle("GET /api/v1/competency/review-profiles/matrix", jwtProtect(mw, compHandler.GetCompetencyMatrixReviewProfiles))
-	mux.Handle("GET /api/v1/competency/review-profiles/technical-matrix", jwtProtect(mw, compHandler.GetTechnicalCompetencyMatrixReviewProfiles))
-	mux.Handle("POST /api/v1/competency/review-profiles", jwtProtect(mw, compHandler.SaveCompetencyReviewProfile))
+	routes.handle("GET /api/v1/competency/review-profiles", compHandler.GetCompetencyReviewProfiles)
+	routes.handle("GET /api/v1/competency/review-profiles/group", compHandler.GetGroupCompetencyReviewProfiles)
+	routes.handle("GET /api/v1/competency/review-profiles/matrix", compHandler.GetCompetencyMatrixReviewProfiles)
+	routes.handle("GET /api/v1/competency/review-profiles/technical-matrix", compHandler.GetTechnicalCompetencyMatrixReviewProfiles)
+	routes.handle("POST /api/v1/competency/review-profiles", compHandler.SaveCompetencyReviewProfile)
 
 	// -- Development Plans --
-	mux.Handle("GET /api/v1/competency/development-plans", jwtProtect(mw, compHandler.GetDevelopmentPlans))
-	mux.Handle("POST /api/v1/competency/development-plans", jwtProtect(mw, compHandler.SaveDevelopmentPlan))
+	routes.handle("GET /api/v1/competency/development-plans", compHandler.GetDevelopmentPlans)
+	routes.handle("POST /api/v1/competency/development-plans", compHandler.SaveDevelopmentPlan)
+
+	devPlanHandler := NewDevelopmentPlanHandler(svc, log)
+	routes.handle("GET /api/v1/competency/development-plans/completion", devPlanHandler.GetDevelopmentPlanCompletion)
+	routes.handle("GET /api/v1/competency/development-plans/{planId}", devPlanHandler.GetDevelopmentPlan)
+	routes.handle("POST /api/v1/competency/development-plans/{planId}/status", devPlanHandler.UpdateDevelopmentPlanStatus)
+	routes.handle("POST /api/v1/competency/development-plans/{planId}/evidence", devPlanHandler.AddDevelopmentPlanEvidence)
+	routes.handle("DELETE /api/v1/competency/development-plans/{planId}/evidence/{evidenceId}", devPlanHandler.RemoveDevelopmentPlanEvidence)
+	routes.handle("POST /api/v1/competency/development-plans/draft", devPlanHandler.DraftDevelopmentPlans)
+	routes.handle("GET /api/v1/competency/development-plans/drafts", devPlanHandler.GetDevelopmentPlanDrafts)
+	routes.handle("POST /api/v1/competency/development-plans/drafts/decide", devPlanHandler.DecideDevelopmentPlanDrafts)
+	routes.handle("GET /api/v1/competency/development-plan-rules", devPlanHandler.GetDevelopmentPlanRules)
+	routes.handle("POST /api/v1/competency/development-plan-rules", devPlanHandler.SaveDevelopmentPlanRule)
+	routes.handle("DELETE /api/v1/competency/development-plan-rules/{ruleId}", devPlanHandler.DeleteDevelopmentPlanRule)
 
 	// -- Job Roles --
-	mux.Handle("GET /api/v1/competency/job-roles", jwtProtect(mw, compHandler.GetJobRoles))
-	mux.Handle("POST /api/v1/competency/job-roles", jwtProtect(mw, compHandler.SaveJobRole))
-	mux.Handle("GET /api/v1/competency/office-job-roles", jwtProtect(mw, compHandler.GetOfficeJobRoles))
-	mux.Handle("POST /api/v1/competency/office-job-roles", jwtProtect(mw, compHandler.SaveOfficeJobRole))
-	mux.Handle("GET /api/v1/competency/job-role-competencies", jwtProtect(mw, compHandler.GetJobRoleCompetencies))
-	mux.Handle("POST /api/v1/competency/job-role-competencies", jwtProtect(mw, compHandler.SaveJobRoleCompetency))
+	routes.handle("GET /api/v1/competency/job-roles", compHandler.GetJobRoles)
+	routes.handle("POST /api/v1/competency/job-roles", compHandler.SaveJobRole)
+	routes.handle("GET /api/v1/competency/office-job-roles", compHandler.GetOfficeJobRoles)
+	routes.handle("POST /api/v1/competency/office-job-roles", compHandler.SaveOfficeJobRole)
+	routes.handle("GET /api/v1/competency/job-role-competencies", compHandler.GetJobRoleCompetencies)
+	routes.handle("POST /api/v1/competency/job-role-competencies", compHandler.SaveJobRoleCompetency)
 
 	// -- Behavioral Competencies --
-	mux.Handle("GET /api/v1/competency/behavioral", jwtProtect(mw, compHandler.GetBehavioralCompetencies))
-	mux.Handle("POST /api/v1/competency/behavioral", jwtProtect(mw, compHandler.SaveBehavioralCompetency))
+	routes.handle("GET /api/v1/competency/behavioral", compHandler.GetBehavioralCompetencies)
+	routes.handle("POST /api/v1/competency/behavioral", compHandler.SaveBehavioralCompetency)
 
 	// -- Job Role Grades --
-	mux.Handle("GET /api/v1/competency/job-role-grades", jwtProtect(mw, compHandler.GetJobRoleGrades))
-	mux.Handle("POST /api/v1/competency/job-role-grades", jwtProtect(mw, compHandler.SaveJobRoleGrade))
+	routes.handle("GET /api/v1/competency/job-role-grades", compHandler.GetJobRoleGrades)
+	routes.handle("POST /api/v1/competency/job-role-grades", compHandler.SaveJobRoleGrade)
 
 	// -- Job Grades --
-	mux.Handle("GET /api/v1/competency/job-grades", jwtProtect(mw, compHandler.GetJobGrades))
-	mux.Handle("POST /api/v1/competency/job-grades", jwtProtect(mw, compHandler.SaveJobGrade))
+	routes.handle("GET /api/v1/competency/job-grades", compHandler.GetJobGrades)
+	routes.handle("POST /api/v1/competency/job-grades", compHandler.SaveJobGrade)
 
 	// -- Job Grade Groups --
-	mux.Handle("GET /api/v1/competency/job-grade-groups", jwtProtect(mw, compHandler.GetJobGradeGroups))
-	mux.Handle("POST /api/v1/competency/job-grade-groups", jwtProtect(mw, compHandler.SaveJobGradeGroup))
-	mux.Handle("GET /api/v1/competency/assign-job-grade-groups", jwtProtect(mw, compHandler.GetAssignJobGradeGroups))
-	mux.Handle("POST /api/v1/competency/assign-job-grade-groups", jwtProtect(mw, compHandler.SaveAssignJobGradeGroup))
+	routes.handle("GET /api/v1/competency/job-grade-groups", compHandler.GetJobGradeGroups)
+	routes.handle("POST /api/v1/competency/job-grade-groups", compHandler.SaveJobGradeGroup)
+	routes.handle("GET /api/v1/competency/assign-job-grade-groups", compHandler.GetAssignJobGradeGroups)
+	routes.handle("POST /api/v1/competency/assign-job-grade-groups", compHandler.SaveAssignJobGradeGroup)
 
 	// -- Ratings --
-	mux.Handle("GET /api/v1/competency/ratings", jwtProtect(mw, compHandler.GetRatings))
-	mux.Handle("POST /api/v1/competency/ratings", jwtProtect(mw, compHandler.SaveRating))
+	routes.handle("GET /api/v1/competency/ratings", compHandler.GetRatings)
+	routes.handle("POST /api/v1/competency/ratings", compHandler.SaveRating)
 
 	// -- Competency Review Periods --
-	mux.Handle("GET /api/v1/competency/review-periods", jwtProtect(mw, compHandler.GetReviewPeriods))
-	mux.Handle("POST /api/v1/competency/review-periods", jwtProtect(mw, compHandler.SaveReviewPeriod))
-	mux.Handle("POST /api/v1/competency/review-periods/approve", jwtProtect(mw, compHandler.ApproveReviewPeriod))
+	routes.handle("GET /api/v1/competency/review-periods", compHandler.GetReviewPeriods)
+	routes.handle("POST /api/v1/competency/review-periods", compHandler.SaveReviewPeriod)
+	routes.handle("POST /api/v1/competency/review-periods/approve", compHandler.ApproveReviewPeriod)
 
 	// -- Review Types --
-	mux.Handle("GET /api/v1/competency/review-types", jwtProtect(mw, compHandler.GetReviewTypes))
-	mux.Handle("POST /api/v1/competency/review-types", jwtProtect(mw, compHandler.SaveReviewType))
+	routes.handle("GET /api/v1/competency/review-types", compHandler.GetReviewTypes)
+	routes.handle("POST /api/v1/competency/review-types", compHandler.SaveReviewType)
 
 	// -- Bank Years --
-	mux.Handle("GET /api/v1/competency/bank-years", jwtProtect(mw, compHandler.GetBankYears))
-	mux.Handle("POST /api/v1/competency/bank-years", jwtProtect(mw, compHandler.SaveBankYear))
+	routes.handle("GET /api/v1/competency/bank-years", compHandler.GetBankYears)
+	routes.handle("POST /api/v1/competency/bank-years", compHandler.SaveBankYear)
 
 	// -- Training Types --
-	mux.Handle("GET /api/v1/competency/training-types", jwtProtect(mw, compHandler.GetTrainingTypes))
-	mux.Handle("POST /api/v1/competency/training-types", jwtProtect(mw, compHandler.SaveTrainingType))
+	routes.handle("GET /api/v1/competency/training-types", compHandler.GetTrainingTypes)
+	routes.handle("POST /api/v1/competency/training-types", compHandler.SaveTrainingType)
 
 	// -- Population & Calculation --
-	mux.Handle("POST /api/v1/competency/populate/all-reviews", jwtProtect(mw, compHandler.PopulateAllReviews))
-	mux.Handle("POST /api/v1/competency/populate/office-reviews", jwtProtect(mw, compHandler.PopulateOfficeReviews))
-	mux.Handle("POST /api/v1/competency/populate/division-reviews", jwtProtect(mw, compHandler.PopulateDivisionReviews))
-	mux.Handle("POST /api/v1/competency/populate/department-reviews", jwtProtect(mw, compHandler.PopulateDepartmentReviews))
-	mux.Handle("POST /api/v1/competency/populate/employee-reviews", jwtProtect(mw, compHandler.PopulateReviewsByEmployeeId))
-	mux.Handle("POST /api/v1/competency/calculate-reviews", jwtProtect(mw, compHandler.CalculateReviews))
-	mux.Handle("POST /api/v1/competency/recalculate-review-profiles", jwtProtect(mw, compHandler.RecalculateReviewsProfiles))
-	mux.Handle("POST /api/v1/competency/email-service", jwtProtect(mw, compHandler.EmailService))
-	mux.Handle("POST /api/v1/competency/sync-job-role-soa", jwtProtect(mw, compHandler.SyncJobRoleUpdateSOA))
+	routes.handle("POST /api/v1/competency/populate/all-reviews", compHandler.PopulateAllReviews)
+	routes.handle("POST /api/v1/competency/populate/office-reviews", compHandler.PopulateOfficeReviews)
+	routes.handle("POST /api/v1/competency/populate/division-reviews", compHandler.PopulateDivisionReviews)
+	routes.handle("POST /api/v1/competency/populate/department-reviews", compHandler.PopulateDepartmentReviews)
+	routes.handle("POST /api/v1/competency/populate/employee-reviews", compHandler.PopulateReviewsByEmployeeId)
+	routes.handle("POST /api/v1/competency/calculate-reviews", compHandler.CalculateReviews)
+	routes.handle("POST /api/v1/competency/recalculate-review-profiles", compHandler.RecalculateReviewsProfiles)
+	routes.handle("POST /api/v1/competency/email-service", compHandler.EmailService)
+	routes.handle("POST /api/v1/competency/sync-job-role-soa", compHandler.SyncJobRoleUpdateSOA)
 
 	// ----------------------------------------------------------------
 	// Grievance Management routes — JWT required
 	// ----------------------------------------------------------------
 	grievanceHandler := NewGrievanceHandler(svc, log)
 
-	mux.Handle("POST /api/v1/grievances", jwtProtect(mw, grievanceHandler.RaiseNewGrievance))
-	mux.Handle("PUT /api/v1/grievances", jwtProtect(mw, grievanceHandler.UpdateGrievance))
-	mux.Handle("POST /api/v1/grievances/resolution", jwtProtect(mw, grievanceHandler.CreateGrievanceResolution))
-	mux.Handle("PUT /api/v1/grievances/resolution", jwtProtect(mw, grievanceHandler.UpdateGrievanceResolution))
-	mux.Handle("GET /api/v1/grievances/staff", jwtProtect(mw, grievanceHandler.GetStaffGrievances))
-	mux.Handle("GET /api/v1/grievances/report", jwtProtect(mw, grievanceHandler.GetGrievancesReport))
+	routes.handle("POST /api/v1/grievances", grievanceHandler.RaiseNewGrievance)
+	routes.handle("PUT /api/v1/grievances", grievanceHandler.UpdateGrievance)
+	routes.handle("POST /api/v1/grievances/resolution", grievanceHandler.CreateGrievanceResolution)
+	routes.handle("PUT /api/v1/grievances/resolution", grievanceHandler.UpdateGrievanceResolution)
+	routes.handle("GET /api/v1/grievances/staff", grievanceHandler.GetStaffGrievances)
+	routes.handle("GET /api/v1/grievances/report", grievanceHandler.GetGrievancesReport)
+
+	// ----------------------------------------------------------------
+	// Approval Delegation routes — JWT required
+	// ----------------------------------------------------------------
+	delegationHandler := NewDelegationHandler(svc, log)
+
+	routes.handle("GET /api/v1/delegations", delegationHandler.GetMyDelegations)
+	routes.handle("POST /api/v1/delegations", delegationHandler.CreateDelegation)
+	routes.handle("DELETE /api/v1/delegations/{delegationId}", delegationHandler.RevokeDelegation)
+
+	// ----------------------------------------------------------------
+	// Calibration routes — Calibration.Manage
+	// ----------------------------------------------------------------
+	calibrationHandler := NewCalibrationHandler(svc, log)
+
+	routes.handle("GET /api/v1/calibrations", calibrationHandler.GetCalibrationSessions)
+	routes.handle("POST /api/v1/calibrations", calibrationHandler.StartCalibration)
+	routes.handle("GET /api/v1/calibrations/{sessionId}", calibrationHandler.GetCalibrationSession)
+	routes.handle("PUT /api/v1/calibrations/{sessionId}/decisions", calibrationHandler.DecideCalibrationEntry)
+	routes.handle("POST /api/v1/calibrations/{sessionId}/finalize", calibrationHandler.FinalizeCalibration)
+	routes.handle("GET /api/v1/setup/calibration-quotas", calibrationHandler.GetCalibrationQuotas)
+	routes.handle("PUT /api/v1/setup/calibration-quotas", calibrationHandler.SaveCalibrationQuotas)
+
+	// ----------------------------------------------------------------
+	// Background job routes — Jobs.Manage (replaces the Hangfire dashboard)
+	// ----------------------------------------------------------------
+	jobHandler := NewBackgroundJobHandler(svc, log)
+
+	routes.handle("GET /api/v1/background-jobs", jobHandler.ListJobs)
+	routes.handle("GET /api/v1/background-jobs/{jobId}", jobHandler.GetJob)
+	routes.handle("POST /api/v1/background-jobs/{jobId}/retry", jobHandler.RetryJob)
+	routes.handle("POST /api/v1/background-jobs/{jobId}/cancel", jobHandler.CancelJob)
+
+	// ----------------------------------------------------------------
+	// Recurring job routes — Jobs.Manage
+	// ----------------------------------------------------------------
+	recurringHandler := NewRecurringJobHandler(svc, log)
+
+	routes.handle("GET /api/v1/recurring-jobs", recurringHandler.ListRecurringJobs)
+	routes.handle("PUT /api/v1/recurring-jobs/{jobName}/schedule", recurringHandler.UpdateSchedule)
+	routes.handle("POST /api/v1/recurring-jobs/{jobName}/pause", recurringHandler.PauseJob)
+	routes.handle("POST /api/v1/recurring-jobs/{jobName}/resume", recurringHandler.ResumeJob)
+	routes.handle("POST /api/v1/recurring-jobs/{jobName}/trigger", recurringHandler.TriggerJob)
+
+	// ----------------------------------------------------------------
+	// Notification inbox routes — JWT required
+	// ----------------------------------------------------------------
+	notificationHandler := NewNotificationHandler(svc, log)
+
+	routes.handle("GET /api/v1/notifications", notificationHandler.ListNotifications)
+	routes.handle("GET /api/v1/notifications/stream", notificationHandler.StreamNotifications)
+	routes.handle("POST /api/v1/notifications/read", notificationHandler.MarkNotificationsRead)
+	routes.handle("POST /api/v1/notifications/read-all", notificationHandler.MarkAllNotificationsRead)
+	routes.handle("POST /api/v1/notifications/{notificationId}/read", notificationHandler.MarkNotificationRead)
+	routes.handle("GET /api/v1/notifications/preferences", notificationHandler.GetNotificationPreferences)
+	routes.handle("PUT /api/v1/notifications/preferences", notificationHandler.UpdateNotificationPreferences)
+
+	// ----------------------------------------------------------------
+	// Email delivery routes — Jobs.Manage
+	// ----------------------------------------------------------------
+	emailHandler := NewEmailDeliveryHandler(svc, log)
+
+	routes.handle("GET /api/v1/emails/undelivered", emailHandler.ListUndeliveredEmails)
+	routes.handle("GET /api/v1/emails/{emailId}/attempts", emailHandler.GetEmailDelivery)
+	routes.handle("POST /api/v1/emails/{emailId}/resend", emailHandler.ResendEmail)
+	routes.handle("POST /api/v1/emails/resend", emailHandler.ResendEmails)
+
+	// ----------------------------------------------------------------
+	// Audit trail routes — AuditTrail.View; configuration AuditConfig.Manage
+	// ----------------------------------------------------------------
+	auditHandler := NewAuditTrailHandler(svc, log)
+
+	routes.handle("GET /api/v1/audit-trail/{tableName}/{recordId}", auditHandler.GetEntityTimeline)
+	routes.handle("GET /api/v1/audit-trail/{tableName}/{recordId}/as-of", auditHandler.GetEntityStateAsOf)
+	routes.handle("GET /api/v1/audit-trail/{tableName}/{recordId}/diff", auditHandler.DiffEntityStates)
+	routes.handle("GET /api/v1/audit-config", auditHandler.GetAuditConfiguration)
+	routes.handle("PUT /api/v1/audit-config/entities", auditHandler.SaveAuditableEntity)
+	routes.handle("PUT /api/v1/audit-config/attributes", auditHandler.SaveAuditableAttribute)
+	routes.handle("DELETE /api/v1/audit-config/entities/{entityName}/attributes/{attributeName}", auditHandler.DeleteAuditableAttribute)
+
+	// ----------------------------------------------------------------
+	// File routes — evidence and attachments; any authenticated user
+	// ----------------------------------------------------------------
+	fileHandler := NewFileHandler(svc, log)
+
+	routes.handle("POST /api/v1/files", fileHandler.UploadFile)
+	routes.handle("GET /api/v1/files/{fileId}", fileHandler.GetFileInfo)
+	routes.handle("GET /api/v1/files/{fileId}/download-url", fileHandler.GetDownloadURL)
+	routes.handle("GET /api/v1/files/{fileId}/download", fileHandler.DownloadFile)
 
 	// ----------------------------------------------------------------
-	// PMS Setup routes — Admin only
+	// PMS Setup routes — Settings.Manage
 	// ----------------------------------------------------------------
 	setupHandler := NewPmsSetupHandler(svc, log)
 
-	mux.Handle("POST /api/v1/setup/settings", jwtRoleProtect(mw, setupHandler.AddSetting, auth.RoleAdmin, auth.RoleSuperAdmin))
-	mux.Handle("PUT /api/v1/setup/settings", jwtRoleProtect(mw, setupHandler.UpdateSetting, auth.RoleAdmin, auth.RoleSuperAdmin))
-	mux.Handle("GET /api/v1/setup/settings/{settingId}", jwtRoleProtect(mw, setupHandler.GetSettingDetails, auth.RoleAdmin, auth.RoleSuperAdmin))
-	mux.Handle("GET /api/v1/setup/settings", jwtRoleProtect(mw, setupHandler.ListAllSettings, auth.RoleAdmin, auth.RoleSuperAdmin))
-	mux.Handle("POST /api/v1/setup/pms-configurations", jwtRoleProtect(mw, setupHandler.AddPmsConfiguration, auth.RoleAdmin, auth.RoleSuperAdmin))
-	mux.Handle("PUT /api/v1/setup/pms-configurations", jwtRoleProtect(mw, setupHandler.UpdatePmsConfiguration, auth.RoleAdmin, auth.RoleSuperAdmin))
-	mux.Handle("GET /api/v1/setup/pms-configurations/{configId}", jwtRoleProtect(mw, setupHandler.GetPmsConfigurationDetails, auth.RoleAdmin, auth.RoleSuperAdmin))
-	mux.Handle("GET /api/v1/setup/pms-configurations", jwtRoleProtect(mw, setupHandler.ListAllPmsConfigurations, auth.RoleAdmin, auth.RoleSuperAdmin))
+	routes.handle("POST /api/v1/setup/settings", setupHandler.AddSetting)
+	routes.handle("PUT /api/v1/setup/settings", setupHandler.UpdateSetting)
+	routes.handle("GET /api/v1/setup/settings/{settingId}", setupHandler.GetSettingDetails)
+	routes.handle("GET /api/v1/setup/settings", setupHandler.ListAllSettings)
+	routes.handle("POST /api/v1/setup/pms-configurations", setupHandler.AddPmsConfiguration)
+	routes.handle("PUT /api/v1/setup/pms-configurations", setupHandler.UpdatePmsConfiguration)
+	routes.handle("GET /api/v1/setup/pms-configurations/{configId}", setupHandler.GetPmsConfigurationDetails)
+	routes.handle("GET /api/v1/setup/pms-configurations", setupHandler.ListAllPmsConfigurations)
+	routes.handle("GET /api/v1/setup/approval-chains", setupHandler.ListApprovalChains)
+	routes.handle("GET /api/v1/setup/approval-chains/{chainId}", setupHandler.GetApprovalChain)
+	routes.handle("POST /api/v1/setup/approval-chains", setupHandler.AddApprovalChain)
+	routes.handle("PUT /api/v1/setup/approval-chains", setupHandler.UpdateApprovalChain)
+	routes.handle("DELETE /api/v1/setup/approval-chains/{chainId}", setupHandler.DeleteApprovalChain)
+	routes.handle("GET /api/v1/setup/grading-scales", setupHandler.ListGradingScales)
+	routes.handle("GET /api/v1/setup/grading-scales/{scaleId}", setupHandler.GetGradingScale)
+	routes.handle("POST /api/v1/setup/grading-scales", setupHandler.AddGradingScale)
+	routes.handle("PUT /api/v1/setup/grading-scales", setupHandler.UpdateGradingScale)
+	routes.handle("PUT /api/v1/setup/grading-scales/assignment", setupHandler.AssignGradingScale)
+	routes.handle("DELETE /api/v1/setup/grading-scales/{scaleId}", setupHandler.DeleteGradingScale)
+	routes.handle("GET /api/v1/setup/sla-escalations", setupHandler.ListSLAEscalationLadders)
+	routes.handle("PUT /api/v1/setup/sla-escalations", setupHandler.SaveSLAEscalationLadder)
+	routes.handle("DELETE /api/v1/setup/sla-escalations/{feedbackRequestType}", setupHandler.DeleteSLAEscalationLadder)
 
 	// ----------------------------------------------------------------
 	// Organogram routes — JWT required
@@ -368,83 +553,87 @@ func NewRouter(svc *service.Container, mw *middleware.Stack, cfg *config.Config,
 	orgHandler := NewOrganogramHandler(svc.Organogram, svc.ErpEmployee, log)
 
 	// -- Directorates --
-	mux.Handle("GET /api/v1/organogram/directorates", jwtProtect(mw, orgHandler.GetDirectorates))
-	mux.Handle("POST /api/v1/organogram/directorates", jwtProtect(mw, orgHandler.SaveDirectorate))
+	routes.handle("GET /api/v1/organogram/directorates", orgHandler.GetDirectorates)
+	routes.handle("POST /api/v1/organogram/directorates", orgHandler.SaveDirectorate)
 
 	// -- Departments --
-	mux.Handle("GET /api/v1/organogram/departments", jwtProtect(mw, orgHandler.GetDepartments))
-	mux.Handle("POST /api/v1/organogram/departments", jwtProtect(mw, orgHandler.SaveDepartment))
+	routes.handle("GET /api/v1/organogram/departments", orgHandler.GetDepartments)
+	routes.handle("POST /api/v1/organogram/departments", orgHandler.SaveDepartment)
 
 	// -- Divisions --
-	mux.Handle("GET /api/v1/organogram/divisions", jwtProtect(mw, orgHandler.GetDivisions))
-	mux.Handle("POST /api/v1/organogram/divisions", jwtProtect(mw, orgHandler.SaveDivision))
+	routes.handle("GET /api/v1/organogram/divisions", orgHandler.GetDivisions)
+	routes.handle("POST /api/v1/organogram/divisions", orgHandler.SaveDivision)
 
 	// -- Offices --
-	mux.Handle("GET /api/v1/organogram/offices", jwtProtect(mw, orgHandler.GetOffices))
-	mux.Handle("POST /api/v1/organogram/offices", jwtProtect(mw, orgHandler.SaveOffice))
+	routes.handle("GET /api/v1/organogram/offices", orgHandler.GetOffices)
+	routes.handle("POST /api/v1/organogram/offices", orgHandler.SaveOffice)
 
 	// -- ERP Organogram --
-	mux.Handle("GET /api/v1/organogram/erp/departments", jwtProtect(mw, orgHandler.GetErpDepartments))
-	mux.Handle("GET /api/v1/organogram/erp/divisions", jwtProtect(mw, orgHandler.GetErpDivisions))
-	mux.Handle("GET /api/v1/organogram/erp/offices", jwtProtect(mw, orgHandler.GetErpOffices))
+	routes.handle("GET /api/v1/organogram/erp/departments", orgHandler.GetErpDepartments)
+	routes.handle("GET /api/v1/organogram/erp/divisions", orgHandler.GetErpDivisions)
+	routes.handle("GET /api/v1/organogram/erp/offices", orgHandler.GetErpOffices)
 
 	// ----------------------------------------------------------------
 	// Role Management routes — JWT required
 	// ----------------------------------------------------------------
 	roleMgtHandler := NewRoleMgtHandler(svc.RoleMgt, log)
 
-	mux.Handle("GET /api/v1/rolemgmt/permissions", jwtProtect(mw, roleMgtHandler.GetPermissions))
-	mux.Handle("GET /api/v1/rolemgmt/roles-with-permission", jwtProtect(mw, roleMgtHandler.GetAllRolesWithPermission))
-	mux.Handle("POST /api/v1/rolemgmt/permissions", jwtProtect(mw, roleMgtHandler.AddPermissionToRole))
-	mux.Handle("DELETE /api/v1/rolemgmt/permissions", jwtProtect(mw, roleMgtHandler.RemovePermissionFromRole))
+	routes.handle("GET /api/v1/rolemgmt/permissions", roleMgtHandler.GetPermissions)
+	routes.handle("GET /api/v1/rolemgmt/roles-with-permission", roleMgtHandler.GetAllRolesWithPermission)
+	routes.handle("POST /api/v1/rolemgmt/permissions", roleMgtHandler.AddPermissionToRole)
+	routes.handle("DELETE /api/v1/rolemgmt/permissions", roleMgtHandler.RemovePermissionFromRole)
 
 	// ----------------------------------------------------------------
 	// Staff Management routes — JWT required
 	// ----------------------------------------------------------------
 	staffsHandler := NewStaffsHandler(svc.StaffMgt, log)
 
-	mux.Handle("POST /api/v1/staff", jwtProtect(mw, staffsHandler.AddStaff))
-	mux.Handle("GET /api/v1/staff", jwtProtect(mw, staffsHandler.GetAllStaffs))
-	mux.Handle("GET /api/v1/staff/roles", jwtProtect(mw, staffsHandler.GetAllRoles))
-	mux.Handle("POST /api/v1/staff/roles", jwtProtect(mw, staffsHandler.AddRole))
-	mux.Handle("DELETE /api/v1/staff/roles", jwtProtect(mw, staffsHandler.DeleteRole))
-	mux.Handle("POST /api/v1/staff/roles/assign", jwtProtect(mw, staffsHandler.AddStaffToRole))
-	mux.Handle("DELETE /api/v1/staff/roles/remove", jwtProtect(mw, staffsHandler.RemoveStaffFromRole))
-	mux.Handle("GET /api/v1/staff/roles/by-staff", jwtProtect(mw, staffsHandler.GetStaffRoles))
+	routes.handle("POST /api/v1/staff", staffsHandler.AddStaff)
+	routes.handle("GET /api/v1/staff", staffsHandler.GetAllStaffs)
+	routes.handle("GET /api/v1/staff/roles", staffsHandler.GetAllRoles)
+	routes.handle("POST /api/v1/staff/roles", staffsHandler.AddRole)
+	routes.handle("DELETE /api/v1/staff/roles", staffsHandler.DeleteRole)
+	routes.handle("POST /api/v1/staff/roles/assign", staffsHandler.AddStaffToRole)
+	routes.handle("DELETE /api/v1/staff/roles/remove", staffsHandler.RemoveStaffFromRole)
+	routes.handle("GET /api/v1/staff/roles/by-staff", staffsHandler.GetStaffRoles)
 
 	// ----------------------------------------------------------------
 	// Employee Information routes — JWT required
 	// ----------------------------------------------------------------
 	empHandler := NewEmployeeInformationHandler(svc.ErpEmployee, log)
 
-	mux.Handle("GET /api/v1/employees", jwtProtect(mw, empHandler.GetEmployeeDetail))
-	mux.Handle("GET /api/v1/employees/head-subordinates", jwtProtect(mw, empHandler.GetHeadSubordinates))
-	mux.Handle("GET /api/v1/employees/subordinates", jwtProtect(mw, empHandler.GetEmployeeSubordinates))
-	mux.Handle("GET /api/v1/employees/peers", jwtProtect(mw, empHandler.GetEmployeePeers))
-	mux.Handle("GET /api/v1/employees/by-department", jwtProtect(mw, empHandler.GetAllByDepartment))
-	mux.Handle("GET /api/v1/employees/by-division", jwtProtect(mw, empHandler.GetAllByDivision))
-	mux.Handle("GET /api/v1/employees/by-office", jwtProtect(mw, empHandler.GetAllByOffice))
-	mux.Handle("GET /api/v1/employees/all", jwtProtect(mw, empHandler.GetAllEmployees))
-	mux.Handle("GET /api/v1/employees/seed-organization", jwtRoleProtect(mw, empHandler.SeedOrganizationData, auth.RoleAdmin, auth.RoleSuperAdmin))
-	mux.Handle("GET /api/v1/employees/staff-id-mask", jwtProtect(mw, empHandler.GetStaffIDMaskDetail))
-	mux.Handle("POST /api/v1/employees/staff-job-role", jwtProtect(mw, empHandler.UpdateStaffJobRole))
-	mux.Handle("GET /api/v1/employees/staff-job-role", jwtProtect(mw, empHandler.GetStaffJobRoleById))
-	mux.Handle("POST /api/v1/employees/job-roles-by-office", jwtProtect(mw, empHandler.GetJobRolesByOffice))
-	mux.Handle("GET /api/v1/employees/staff-job-role-requests", jwtProtect(mw, empHandler.GetStaffJobRoleRequests))
-	mux.Handle("POST /api/v1/employees/approve-reject-staff-job-role", jwtProtect(mw, empHandler.ApproveRejectStaffJobRole))
+	routes.handle("GET /api/v1/employees", empHandler.GetEmployeeDetail)
+	routes.handle("GET /api/v1/employees/head-subordinates", empHandler.GetHeadSubordinates)
+	routes.handle("GET /api/v1/employees/subordinates", empHandler.GetEmployeeSubordinates)
+	routes.handle("GET /api/v1/employees/peers", empHandler.GetEmployeePeers)
+	routes.handle("GET /api/v1/employees/by-department", empHandler.GetAllByDepartment)
+	routes.handle("GET /api/v1/employees/by-division", empHandler.GetAllByDivision)
+	routes.handle("GET /api/v1/employees/by-office", empHandler.GetAllByOffice)
+	routes.handle("GET /api/v1/employees/all", empHandler.GetAllEmployees)
+	routes.handle("GET /api/v1/employees/seed-organization", empHandler.SeedOrganizationData)
+	routes.handle("GET /api/v1/employees/staff-id-mask", empHandler.GetStaffIDMaskDetail)
+	routes.handle("POST /api/v1/employees/staff-job-role", empHandler.UpdateStaffJobRole)
+	routes.handle("GET /api/v1/employees/staff-job-role", empHandler.GetStaffJobRoleById)
+	routes.handle("POST /api/v1/employees/job-roles-by-office", empHandler.GetJobRolesByOffice)
+	routes.handle("GET /api/v1/employees/staff-job-role-requests", empHandler.GetStaffJobRoleRequests)
+	routes.handle("POST /api/v1/employees/approve-reject-staff-job-role", empHandler.ApproveRejectStaffJobRole)
 
 	// ----------------------------------------------------------------
 	// Enum Lists routes — JWT required (standalone functions)
 	// ----------------------------------------------------------------
-	mux.Handle("GET /api/v1/enums/objective-levels", jwtProtect(mw, GetObjectiveLevels))
-	mux.Handle("GET /api/v1/enums/extension-target-types", jwtProtect(mw, GetExtensionTargetTypes))
-	mux.Handle("GET /api/v1/enums/evaluation-types", jwtProtect(mw, GetEvaluationTypes))
-	mux.Handle("GET /api/v1/enums/work-product-types", jwtProtect(mw, GetWorkProductTypes))
-	mux.Handle("GET /api/v1/enums/grievance-types", jwtProtect(mw, GetGrievanceTypes))
-	mux.Handle("GET /api/v1/enums/feedback-request-types", jwtProtect(mw, GetFeedbackRequestTypes))
-	mux.Handle("GET /api/v1/enums/performance-grades", jwtProtect(mw, GetPerformanceGrades))
-	mux.Handle("GET /api/v1/enums/review-period-ranges", jwtProtect(mw, GetReviewPeriodRanges))
-	mux.Handle("GET /api/v1/enums/statuses", jwtProtect(mw, GetStatuses))
+	routes.handle("GET /api/v1/enums/objective-levels", GetObjectiveLevels)
+	routes.handle("GET /api/v1/enums/extension-target-types", GetExtensionTargetTypes)
+	routes.handle("GET /api/v1/enums/evaluation-types", GetEvaluationTypes)
+	routes.handle("GET /api/v1/enums/work-product-types", GetWorkProductTypes)
+	routes.handle("GET /api/v1/enums/grievance-types", GetGrievanceTypes)
+	routes.handle("GET /api/v1/enums/feedback-request-types", GetFeedbackRequestTypes)
+	routes.handle("GET /api/v1/enums/performance-grades", GetPerformanceGrades)
+	routes.handle("GET /api/v1/enums/review-period-ranges", GetReviewPeriodRanges)
+	routes.handle("GET /api/v1/enums/statuses", GetStatuses)
+
+	if err := routes.check(); err != nil {
+		return nil, err
+	}
 
 	// Apply global middleware chain (outermost first)
 	var handler http.Handler = mux
@@ -454,7 +643,7 @@ func NewRouter(svc *service.Container, mw *middleware.Stack, cfg *config.Config,
 	handler = mw.Recover(handler)
 	handler = mw.RequestLogger(handler)
 
-	return handler
+	return handler, nil
 }
 
 func healthCheck(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/config"
+	"github.com/enterprise-pms/pms-api/internal/domain/auth"
+	"github.com/enterprise-pms/pms-api/internal/middleware"
+	"github.com/enterprise-pms/pms-api/internal/service"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/rs/zerolog"
+)
+
+func testRouterConfig() *config.Config {
+	return &config.Config{JWT: config.JWTConfig{Secret: "test-secret", Issuer: "pms", Audience: "pms"}}
+}
+
+func TestRoutePoliciesCoverEveryRoute(t *testing.T) {
+	cfg := testRouterConfig()
+	if _, err := NewRouter(&service.Container{}, middleware.New(cfg, zerolog.Nop(), nil), cfg, zerolog.Nop()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRouteTableCheck(t *testing.T) {
+	cfg := testRouterConfig()
+	routes := newRouteTable(http.NewServeMux(), middleware.New(cfg, zerolog.Nop(), nil))
+	routes.handle("GET /api/v1/not-in-the-table", func(http.ResponseWriter, *http.Request) {})
+	if err := routes.check(); err == nil {
+		t.Fatal("check passed with an unmapped route and unregistered policies")
+	}
+}
+
+func TestRouteTableEnforcesPermission(t *testing.T) {
+	cfg := testRouterConfig()
+	mux := http.NewServeMux()
+	routes := newRouteTable(mux, middleware.New(cfg, zerolog.Nop(), nil))
+	routes.handle("POST /api/v1/review-periods/approve", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	token := func(perms ...string) string {
+		claims := jwt.MapClaims{
+			"sub":         "u1",
+			"iss":         cfg.JWT.Issuer,
+			"aud":         cfg.JWT.Audience,
+			"exp":         time.Now().Add(time.Minute).Unix(),
+			"permissions": perms,
+		}
+		s, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(cfg.JWT.Secret))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+
+	tests := []struct {
+		name  string
+		perms []string
+		want  int
+	}{
+		{"no permissions", nil, http.StatusForbidden},
+		{"other permission", []string{auth.PermReviewPeriodView}, http.StatusForbidden},
+		{"required permission", []string{auth.PermReviewPeriodView, auth.PermReviewPeriodApprove}, http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/review-periods/approve", nil)
+			req.Header.Set("Authorization", "Bearer "+token(tt.perms...))
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, req)
+			if rec.Code != tt.want {
+				t.Errorf("status = %d, want %d", rec.Code, tt.want)
+			}
+		})
+	}
+}
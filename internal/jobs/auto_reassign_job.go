@@ -8,52 +8,58 @@ import (
 	"github.com/rs/zerolog"
 )
 
-// AutoReassignJob auto-reassigns breached feedback requests to the assigned
-// staff's supervisor. Mirrors .NET AutoReassignRequestBackgroundService which
-// runs every 10 minutes.
+// AutoReassignJob auto-reassigns breached feedback requests to the target
+// chosen by the configured reassignment policies. Mirrors .NET
+// AutoReassignRequestBackgroundService which runs every 10 minutes.
 //
 // .NET source: Services/CompetencyApp.BusinessLogic/Concretes/AutoReassignRequestBackgroundService.cs
 //
 // Logic:
 //  1. Check ENABLE_AUTO_REASSIGN_REQUEST_BACKGROUND_SERVICE global setting.
 //  2. Get all pending feedback requests.
-//  3. Filter for breached requests (IsBreached == true).
-//  4. For each breached request, dispatch AutoReassignAndLogRequest via worker pool.
+//  3. Filter for SLA-bound, breached requests (HasSLA && IsBreached).
+//  4. For each breached request, dispatch AutoReassignAndLogRequest via the job queue.
 type AutoReassignJob struct {
-	svc        *service.Container
-	workerPool *WorkerPool
-	log        zerolog.Logger
+	svc   *service.Container
+	queue *JobQueue
+	log   zerolog.Logger
 }
 
 // NewAutoReassignJob creates a new auto-reassignment background job.
 func NewAutoReassignJob(
 	svc *service.Container,
-	workerPool *WorkerPool,
+	queue *JobQueue,
 	log zerolog.Logger,
 ) *AutoReassignJob {
 	return &AutoReassignJob{
-		svc:        svc,
-		workerPool: workerPool,
-		log:        log.With().Str("job", "auto_reassign").Logger(),
+		svc:   svc,
+		queue: queue,
+		log:   log.With().Str("job", "auto_reassign").Logger(),
 	}
 }
 
-// Run executes the auto-reassignment check. Called by the cron scheduler.
+// Run executes the auto-reassignment check outside the scheduler.
 // Implements the cron.Job interface.
 func (j *AutoReassignJob) Run() {
-	ctx := context.Background()
+	if err := j.Execute(service.WithSystemScope(context.Background())); err != nil {
+		j.log.Error().Err(err).Msg("auto-reassignment check failed")
+	}
+}
 
+// Execute runs the auto-reassignment check. Called by the scheduler, which
+// records the returned error as the job's last error.
+func (j *AutoReassignJob) Execute(ctx context.Context) error {
 	// Check if the background service is enabled.
 	// Mirrors: var enableService = await _globalSetting.GetBooleanValue("ENABLE_AUTO_REASSIGN_REQUEST_BACKGROUND_SERVICE");
 	if j.svc.GlobalSetting != nil {
 		enabled, err := j.svc.GlobalSetting.GetBoolValue(ctx, "ENABLE_AUTO_REASSIGN_REQUEST_BACKGROUND_SERVICE")
 		if err != nil {
 			j.log.Debug().Err(err).Msg("could not read ENABLE_AUTO_REASSIGN_REQUEST_BACKGROUND_SERVICE, defaulting to disabled")
-			return
+			return nil
 		}
 		if !enabled {
 			j.log.Debug().Msg("auto-reassign background service is disabled")
-			return
+			return nil
 		}
 	}
 
@@ -64,37 +70,34 @@ func (j *AutoReassignJob) Run() {
 	// Then filters: pendingRequests.Where(x => x.IsBreached == true)
 	if j.svc.Performance == nil {
 		j.log.Warn().Msg("performance service not available, skipping auto-reassignment")
-		return
+		return nil
 	}
 
 	// GetPendingRequests returns all pending feedback requests for all staff (empty staffID = all).
 	result, err := j.svc.Performance.GetPendingRequests(ctx, "")
 	if err != nil {
-		j.log.Error().Err(err).Msg("failed to get pending requests")
-		return
+		return fmt.Errorf("getting pending requests: %w", err)
 	}
 
-	// The result contains breached request IDs. Extract and dispatch each one.
-	// The actual type assertion depends on the concrete return type from GetPendingRequests.
-	// When the service is fully implemented, the response will include a list of
-	// breached FeedbackRequestLogIds.
-	_ = result // Will be used when response type is finalized.
-
-	// Placeholder: When breached requests are identified, dispatch each one:
-	// for _, requestID := range breachedRequestIDs {
-	//     j.dispatchReassignment(requestID)
-	// }
+	breached := 0
+	for _, r := range result.Requests {
+		if !r.HasSLA || !r.IsBreached {
+			continue
+		}
+		j.dispatchReassignment(ctx, r.FeedbackRequestLogID)
+		breached++
+	}
 
-	j.log.Info().Msg("auto-reassignment check completed")
+	j.log.Info().Int("breached", breached).Msg("auto-reassignment check completed")
+	return nil
 }
 
-// dispatchReassignment queues a single request reassignment via the worker pool.
+// dispatchReassignment queues a single request reassignment on the job queue.
+// The request ID is the idempotency key, so a breached request still waiting
+// from a previous run is not queued twice.
 // Maps to .NET: BackgroundJob.Enqueue(() => _performanceManagementService.AutoReassignAndLogRequestAsync(request.FeedbackRequestLogId))
-func (j *AutoReassignJob) dispatchReassignment(requestID string) {
-	j.workerPool.Enqueue(Job{
-		Name: fmt.Sprintf("AutoReassign:%s", requestID),
-		Fn: func(ctx context.Context) error {
-			return j.svc.Performance.AutoReassignAndLogRequest(ctx, requestID)
-		},
-	})
+func (j *AutoReassignJob) dispatchReassignment(ctx context.Context, requestID string) {
+	if _, err := j.queue.Enqueue(ctx, autoReassignJob(requestID)); err != nil {
+		j.log.Error().Err(err).Str("requestId", requestID).Msg("failed to queue request reassignment")
+	}
 }
@@ -22,66 +22,72 @@ import (
 //     - CompetencyCategoryName != "leadership"
 //     - Has DevelopmentPlans with TaskStatus = "closedgap"
 //     - DevelopmentPlan.TargetDate within the review period date range
-//  5. For each qualifying profile, dispatch CompetencyGapClosureSetup.
+//     - The plan has not already been credited
+//  5. For each staff member with qualifying plans, queue
+//     CreditCompetencyGapClosure, which credits the plans to their gap
+//     closure and rescores it.
 type CompetencyClosureJob struct {
-	svc        *service.Container
-	workerPool *WorkerPool
-	log        zerolog.Logger
+	svc   *service.Container
+	queue *JobQueue
+	log   zerolog.Logger
 }
 
 // NewCompetencyClosureJob creates a new competency closure background job.
 func NewCompetencyClosureJob(
 	svc *service.Container,
-	workerPool *WorkerPool,
+	queue *JobQueue,
 	log zerolog.Logger,
 ) *CompetencyClosureJob {
 	return &CompetencyClosureJob{
-		svc:        svc,
-		workerPool: workerPool,
-		log:        log.With().Str("job", "competency_closure").Logger(),
+		svc:   svc,
+		queue: queue,
+		log:   log.With().Str("job", "competency_closure").Logger(),
 	}
 }
 
-// Run executes the competency gap closure check. Called by the cron scheduler.
+// Run executes the competency gap closure check outside the scheduler.
 // Implements the cron.Job interface.
 func (j *CompetencyClosureJob) Run() {
-	ctx := context.Background()
+	if err := j.Execute(service.WithSystemScope(context.Background())); err != nil {
+		j.log.Error().Err(err).Msg("competency gap closure check failed")
+	}
+}
 
+// Execute runs the competency gap closure check. Called by the scheduler,
+// which records the returned error as the job's last error.
+func (j *CompetencyClosureJob) Execute(ctx context.Context) error {
 	// Check if the background service is enabled.
 	// Mirrors: var enableService = await _globalSetting.GetBooleanValue("ENABLE_COMPETENCY_CLOSURE_BACKGROUND_SERVICE");
 	if j.svc.GlobalSetting != nil {
 		enabled, err := j.svc.GlobalSetting.GetBoolValue(ctx, "ENABLE_COMPETENCY_CLOSURE_BACKGROUND_SERVICE")
 		if err != nil {
 			j.log.Debug().Err(err).Msg("could not read ENABLE_COMPETENCY_CLOSURE_BACKGROUND_SERVICE, defaulting to disabled")
-			return
+			return nil
 		}
 		if !enabled {
 			j.log.Debug().Msg("competency closure background service is disabled")
-			return
+			return nil
 		}
 	}
 
 	j.log.Info().Msg("running competency gap closure check")
 
-	// The .NET implementation:
-	// 1. Gets the active review period from PerformanceReviewPeriods
-	// 2. Queries CompetencyReviewProfiles with DevelopmentPlans that have closedgap status
-	// 3. Gets the ObjectiveCategory matching COMPETENCY_GAP_CLOSURE_CATEGORY ("C")
-	// 4. For each profile, enqueues BackgroundJob.Enqueue(() => _performanceManagementService.CompetencyGapClosureSetup(request, OperationTypes.Add))
-	//
-	// When PerformanceManagementService.CompetencyGapClosureSetup is fully implemented,
-	// this job will query for eligible profiles and dispatch each one via the worker pool:
-	//
-	// profiles := findEligibleProfiles(ctx)
-	// for _, profile := range profiles {
-	//     j.workerPool.Enqueue(Job{
-	//         Name: "CompetencyGapClosure:" + profile.EmployeeNumber,
-	//         Fn: func(ctx context.Context) error {
-	//             _, err := j.svc.Performance.CompetencyGapClosureSetup(ctx, req)
-	//             return err
-	//         },
-	//     })
-	// }
+	// Mirrors the .NET query for closed-gap profiles in the active period,
+	// less plans already credited, so re-runs queue nothing new.
+	pending, err := j.svc.Performance.GetPendingCompetencyGapClosures(ctx)
+	if err != nil {
+		return err
+	}
+
+	queued := 0
+	for i := range pending {
+		if _, err := j.queue.Enqueue(ctx, competencyGapClosureJob(&pending[i])); err != nil {
+			j.log.Error().Err(err).Str("staffID", pending[i].StaffID).Msg("failed to queue competency gap closure")
+			continue
+		}
+		queued++
+	}
 
-	j.log.Info().Msg("competency gap closure check completed")
+	j.log.Info().Int("staff", len(pending)).Int("queued", queued).Msg("competency gap closure check completed")
+	return nil
 }
@@ -0,0 +1,47 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/enterprise-pms/pms-api/internal/service"
+	"github.com/rs/zerolog"
+)
+
+// DevelopmentPlanReminderJob reminds staff of open development plans
+// approaching or past their target date, on the days listed in
+// competency.reminder_days_before and competency.reminder_days_after. It
+// runs daily at 08:00 unless jobs.schedules.development_plan_reminders or
+// pms.recurring_jobs says otherwise.
+type DevelopmentPlanReminderJob struct {
+	svc *service.Container
+	log zerolog.Logger
+}
+
+// NewDevelopmentPlanReminderJob creates a new development plan reminder job.
+func NewDevelopmentPlanReminderJob(svc *service.Container, log zerolog.Logger) *DevelopmentPlanReminderJob {
+	return &DevelopmentPlanReminderJob{
+		svc: svc,
+		log: log.With().Str("job", "development_plan_reminders").Logger(),
+	}
+}
+
+// Run sends the reminders outside the scheduler.
+// Implements the cron.Job interface.
+func (j *DevelopmentPlanReminderJob) Run() {
+	if err := j.Execute(service.WithSystemScope(context.Background())); err != nil {
+		j.log.Error().Err(err).Msg("development plan reminder run failed")
+	}
+}
+
+// Execute sends the reminders due today. Called by the scheduler, which
+// records the returned error as the job's last error.
+func (j *DevelopmentPlanReminderJob) Execute(ctx context.Context) error {
+	if j.svc.DevelopmentPlan == nil {
+		return nil
+	}
+	reminded, err := j.svc.DevelopmentPlan.SendDevelopmentPlanReminders(ctx)
+	if reminded > 0 {
+		j.log.Info().Int("reminded", reminded).Msg("development plan reminders sent")
+	}
+	return err
+}
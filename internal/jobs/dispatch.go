@@ -2,94 +2,133 @@ package jobs
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/enterprise-pms/pms-api/internal/domain/performance"
 )
 
 // ---------------------------------------------------------------------------
-// Typed dispatch helpers — wrap service method calls into Job structs for
-// the worker pool. These replace Hangfire's BackgroundJob.Enqueue() calls
-// found across the .NET controllers and background services.
+// Typed dispatch helpers — persist service method calls as jobs on the
+// queue. These replace Hangfire's BackgroundJob.Enqueue() calls found across
+// the .NET controllers and background services. Each helper has a matching
+// handler registered in registerHandlers.
 // ---------------------------------------------------------------------------
 
-// DispatchCompetencyGapClosure queues a competency gap closure setup.
+// Job types understood by the queue.
+const (
+	JobTypeCompetencyGapClosure      = "CompetencyGapClosure"
+	JobTypeAutoReassign              = "AutoReassign"
+	JobTypeInitiate360Review         = "Initiate360Review"
+	JobTypeCloseReviewPeriodRequests = "CloseReviewPeriodRequests"
+	JobTypeWorkProductSetup          = "WorkProductSetup"
+	JobTypeWorkProductEvaluation     = "WorkProductEvaluation"
+)
+
+// registerHandlers binds every job type to the service method it runs.
+func (s *Scheduler) registerHandlers() {
+	s.queue.Register(JobTypeCompetencyGapClosure, decodeInto(func(ctx context.Context, req performance.CompetencyGapClosureRequestModel) error {
+		_, err := s.svc.Performance.CreditCompetencyGapClosure(ctx, &req)
+		return err
+	}))
+	s.queue.Register(JobTypeAutoReassign, decodeInto(func(ctx context.Context, requestID string) error {
+		return s.svc.Performance.AutoReassignAndLogRequest(ctx, requestID)
+	}))
+	s.queue.Register(JobTypeInitiate360Review, decodeInto(func(ctx context.Context, req performance.Initiate360ReviewRequestModel) error {
+		_, err := s.svc.Performance.Initiate360Review(ctx, &req)
+		return err
+	}))
+	s.queue.Register(JobTypeCloseReviewPeriodRequests, decodeInto(func(ctx context.Context, reviewPeriodID string) error {
+		return s.svc.Performance.CloseReviewPeriodRequests(ctx, reviewPeriodID)
+	}))
+	s.queue.Register(JobTypeWorkProductSetup, decodeInto(func(ctx context.Context, req performance.WorkProductRequestModel) error {
+		_, err := s.svc.Performance.WorkProductSetup(ctx, &req)
+		return err
+	}))
+	s.queue.Register(JobTypeWorkProductEvaluation, decodeInto(func(ctx context.Context, req performance.WorkProductEvaluationRequestModel) error {
+		_, err := s.svc.Performance.WorkProductEvaluation(ctx, &req)
+		return err
+	}))
+}
+
+// competencyGapClosureJob builds the job that credits a staff member's closed
+// development plans to their competency gap closure.
+func competencyGapClosureJob(req *performance.CompetencyGapClosureRequestModel) Job {
+	return Job{
+		Type:           JobTypeCompetencyGapClosure,
+		Payload:        req,
+		IdempotencyKey: JobTypeCompetencyGapClosure + ":" + req.ReviewPeriodID + ":" + req.StaffID,
+	}
+}
+
+// autoReassignJob builds the job for a feedback request reassignment.
+func autoReassignJob(requestID string) Job {
+	return Job{
+		Type:           JobTypeAutoReassign,
+		Payload:        requestID,
+		IdempotencyKey: JobTypeAutoReassign + ":" + requestID,
+	}
+}
+
+// DispatchCompetencyGapClosure queues a competency gap closure credit.
 // .NET: BackgroundJob.Enqueue(() => _performanceManagementService.CompetencyGapClosureSetup(request, OperationTypes.Add))
-func (s *Scheduler) DispatchCompetencyGapClosure(req *performance.CompetencyGapClosureRequestModel) {
-	s.workerPool.Enqueue(Job{
-		Name: fmt.Sprintf("CompetencyGapClosure:%s", req.StaffID),
-		Fn: func(ctx context.Context) error {
-			_, err := s.svc.Performance.CompetencyGapClosureSetup(ctx, req)
-			return err
-		},
-	})
+func (s *Scheduler) DispatchCompetencyGapClosure(ctx context.Context, req *performance.CompetencyGapClosureRequestModel) (string, error) {
+	return s.queue.Enqueue(ctx, competencyGapClosureJob(req))
 }
 
 // DispatchAutoReassign queues a feedback request reassignment.
 // .NET: BackgroundJob.Enqueue(() => _performanceManagementService.AutoReassignAndLogRequestAsync(requestId))
-func (s *Scheduler) DispatchAutoReassign(requestID string) {
-	s.workerPool.Enqueue(Job{
-		Name: fmt.Sprintf("AutoReassign:%s", requestID),
-		Fn: func(ctx context.Context) error {
-			return s.svc.Performance.AutoReassignAndLogRequest(ctx, requestID)
-		},
-	})
+func (s *Scheduler) DispatchAutoReassign(ctx context.Context, requestID string) (string, error) {
+	return s.queue.Enqueue(ctx, autoReassignJob(requestID))
 }
 
 // DispatchInitiate360Review queues a 360-degree review initiation.
 // .NET: BackgroundJob.Enqueue(() => _performanceManagementService.Initiate360Review(request))
 // Queue: pmsexecutions
-func (s *Scheduler) DispatchInitiate360Review(req *performance.Initiate360ReviewRequestModel) {
-	s.workerPool.Enqueue(Job{
-		Name: fmt.Sprintf("Initiate360Review:%s", req.ReviewPeriodID),
-		Fn: func(ctx context.Context) error {
-			_, err := s.svc.Performance.Initiate360Review(ctx, req)
-			return err
-		},
+func (s *Scheduler) DispatchInitiate360Review(ctx context.Context, req *performance.Initiate360ReviewRequestModel) (string, error) {
+	return s.queue.Enqueue(ctx, Job{
+		Type:           JobTypeInitiate360Review,
+		Queue:          "pmsexecutions",
+		Payload:        req,
+		IdempotencyKey: JobTypeInitiate360Review + ":" + req.ReviewPeriodID,
 	})
 }
 
 // DispatchCloseReviewPeriodRequests queues closing all requests for a review period.
 // .NET: BackgroundJob.Enqueue(() => _performanceManagementService.CloseReviewPeriodRequests(reviewPeriodId))
 // Queue: requestclosure
-func (s *Scheduler) DispatchCloseReviewPeriodRequests(reviewPeriodID string) {
-	s.workerPool.Enqueue(Job{
-		Name: fmt.Sprintf("CloseReviewPeriodRequests:%s", reviewPeriodID),
-		Fn: func(ctx context.Context) error {
-			return s.svc.Performance.CloseReviewPeriodRequests(ctx, reviewPeriodID)
-		},
+func (s *Scheduler) DispatchCloseReviewPeriodRequests(ctx context.Context, reviewPeriodID string) (string, error) {
+	return s.queue.Enqueue(ctx, Job{
+		Type:           JobTypeCloseReviewPeriodRequests,
+		Queue:          "requestclosure",
+		Payload:        reviewPeriodID,
+		IdempotencyKey: JobTypeCloseReviewPeriodRequests + ":" + reviewPeriodID,
 	})
 }
 
 // DispatchWorkProductSetup queues a work product creation/update.
 // .NET: BackgroundJob.Enqueue(() => _performanceManagementService.WorkProductSetup(request, operationType))
 // Queue: workproductsexecution
-func (s *Scheduler) DispatchWorkProductSetup(req *performance.WorkProductRequestModel) {
-	s.workerPool.Enqueue(Job{
-		Name: fmt.Sprintf("WorkProductSetup:%s", req.StaffID),
-		Fn: func(ctx context.Context) error {
-			_, err := s.svc.Performance.WorkProductSetup(ctx, req)
-			return err
-		},
+func (s *Scheduler) DispatchWorkProductSetup(ctx context.Context, req *performance.WorkProductRequestModel) (string, error) {
+	return s.queue.Enqueue(ctx, Job{
+		Type:    JobTypeWorkProductSetup,
+		Queue:   "workproductsexecution",
+		Payload: req,
 	})
 }
 
 // DispatchWorkProductEvaluation queues a work product evaluation.
 // .NET: BackgroundJob.Enqueue(() => _performanceManagementService.WorkProductEvaluation(request, operationType))
 // Queue: workproductsevaluations
-func (s *Scheduler) DispatchWorkProductEvaluation(req *performance.WorkProductEvaluationRequestModel) {
-	s.workerPool.Enqueue(Job{
-		Name: "WorkProductEvaluation:" + req.WorkProductID,
-		Fn: func(ctx context.Context) error {
-			_, err := s.svc.Performance.WorkProductEvaluation(ctx, req)
-			return err
-		},
+func (s *Scheduler) DispatchWorkProductEvaluation(ctx context.Context, req *performance.WorkProductEvaluationRequestModel) (string, error) {
+	return s.queue.Enqueue(ctx, Job{
+		Type:           JobTypeWorkProductEvaluation,
+		Queue:          "workproductsevaluations",
+		Payload:        req,
+		IdempotencyKey: JobTypeWorkProductEvaluation + ":" + req.WorkProductID,
 	})
 }
 
-// Enqueue exposes the worker pool's Enqueue method for ad-hoc job dispatch.
-// This allows handlers and services to submit custom jobs without needing
-// typed dispatch helpers.
-func (s *Scheduler) Enqueue(job Job) {
-	s.workerPool.Enqueue(job)
+// Enqueue exposes the job queue for ad-hoc dispatch of any registered job
+// type without a typed dispatch helper.
+func (s *Scheduler) Enqueue(ctx context.Context, job Job) (string, error) {
+	return s.queue.Enqueue(ctx, job)
 }
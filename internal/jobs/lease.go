@@ -0,0 +1,207 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/repository"
+	"github.com/enterprise-pms/pms-api/internal/service"
+	"github.com/rs/zerolog"
+)
+
+// leaderLease is the lease held by the replica that runs the cron scheduler.
+const leaderLease = "scheduler:leader"
+
+// instanceID identifies this process among the API replicas.
+func instanceID() string {
+	host, _ := os.Hostname()
+	return fmt.Sprintf("%s:%d", host, os.Getpid())
+}
+
+// leaseStore is the part of the lease repository the guard and the leader
+// elector use.
+type leaseStore interface {
+	TryAcquire(ctx context.Context, name, holder string, ttl time.Duration) (bool, error)
+	Release(ctx context.Context, name, holder string) error
+}
+
+// LeaseGuard runs work under a named cluster-wide lease so that it executes
+// on at most one replica at a time. When several PM2 instances share the
+// database, this is what stops recurring jobs and mail batches running twice.
+type LeaseGuard struct {
+	repo   leaseStore
+	holder string
+	ttl    time.Duration
+	log    zerolog.Logger
+}
+
+// NewLeaseGuard creates a lease guard for holder. A non-positive ttl
+// defaults to one minute.
+func NewLeaseGuard(repo *repository.LeaseRepository, holder string, ttl time.Duration, log zerolog.Logger) *LeaseGuard {
+	if ttl <= 0 {
+		ttl = time.Minute
+	}
+	return &LeaseGuard{
+		repo:   repo,
+		holder: holder,
+		ttl:    ttl,
+		log:    log.With().Str("component", "lease_guard").Logger(),
+	}
+}
+
+// Do runs fn while holding the named lease, renewing it every third of the
+// TTL. It returns false without running fn when another replica holds the
+// lease. The context passed to fn is cancelled if the lease is lost.
+func (g *LeaseGuard) Do(ctx context.Context, name string, fn func(ctx context.Context)) bool {
+	ok, err := g.repo.TryAcquire(ctx, name, g.holder, g.ttl)
+	if err != nil {
+		g.log.Error().Err(err).Str("lease", name).Msg("failed to acquire lease")
+		return false
+	}
+	if !ok {
+		g.log.Debug().Str("lease", name).Msg("lease held by another replica, skipping")
+		return false
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go g.heartbeat(runCtx, cancel, name, done)
+
+	defer func() {
+		close(done)
+		cancel()
+		if err := g.repo.Release(context.Background(), name, g.holder); err != nil {
+			g.log.Warn().Err(err).Str("lease", name).Msg("failed to release lease")
+		}
+	}()
+
+	fn(runCtx)
+	return true
+}
+
+// heartbeat renews the lease until done is closed, cancelling the run if
+// the lease cannot be renewed.
+func (g *LeaseGuard) heartbeat(ctx context.Context, cancel context.CancelFunc, name string, done <-chan struct{}) {
+	ticker := time.NewTicker(g.ttl / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			ok, err := g.repo.TryAcquire(ctx, name, g.holder, g.ttl)
+			if err != nil || !ok {
+				g.log.Error().Err(err).Str("lease", name).Msg("lost lease, cancelling run")
+				cancel()
+				return
+			}
+		}
+	}
+}
+
+// LeaderElector keeps one replica in the cluster marked as scheduler leader.
+// The leader renews its lease every third of the TTL; if it dies, the lease
+// lapses and the next replica to poll becomes leader.
+type LeaderElector struct {
+	repo   leaseStore
+	holder string
+	ttl    time.Duration
+	leader atomic.Bool
+	log    zerolog.Logger
+}
+
+// NewLeaderElector creates a leader elector for holder. A non-positive ttl
+// defaults to one minute.
+func NewLeaderElector(repo *repository.LeaseRepository, holder string, ttl time.Duration, log zerolog.Logger) *LeaderElector {
+	if ttl <= 0 {
+		ttl = time.Minute
+	}
+	return &LeaderElector{
+		repo:   repo,
+		holder: holder,
+		ttl:    ttl,
+		log:    log.With().Str("component", "leader_elector").Str("instance", holder).Logger(),
+	}
+}
+
+// IsLeader reports whether this replica currently holds scheduler leadership.
+func (e *LeaderElector) IsLeader() bool {
+	return e.leader.Load()
+}
+
+// Run campaigns for leadership until ctx is cancelled, then steps down.
+func (e *LeaderElector) Run(ctx context.Context) {
+	e.campaign(ctx)
+
+	ticker := time.NewTicker(e.ttl / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			if e.leader.Swap(false) {
+				if err := e.repo.Release(context.Background(), leaderLease, e.holder); err != nil {
+					e.log.Warn().Err(err).Msg("failed to release scheduler leadership")
+				}
+				e.log.Info().Msg("stepped down as scheduler leader")
+			}
+			return
+		case <-ticker.C:
+			e.campaign(ctx)
+		}
+	}
+}
+
+func (e *LeaderElector) campaign(ctx context.Context) {
+	ok, err := e.repo.TryAcquire(ctx, leaderLease, e.holder, e.ttl)
+	if err != nil {
+		if ctx.Err() == nil {
+			e.log.Error().Err(err).Msg("leader election failed")
+		}
+		// Without a renewal we cannot be sure we are still leader.
+		ok = false
+	}
+	if was := e.leader.Swap(ok); was != ok {
+		if ok {
+			e.log.Info().Msg("elected scheduler leader")
+		} else {
+			e.log.Warn().Msg("lost scheduler leadership")
+		}
+	}
+}
+
+// clusterJob wraps a recurring job so that it only runs on the scheduler
+// leader and under its own lease, which also covers the overlap while
+// leadership moves between replicas. Each run's outcome is stored on the
+// job's pms.recurring_jobs row.
+type clusterJob struct {
+	name    string
+	job     RecurringJob
+	elector *LeaderElector
+	guard   *LeaseGuard
+	repo    *repository.RecurringJobRepository
+	log     zerolog.Logger
+}
+
+// Run implements cron.Job.
+func (c *clusterJob) Run() {
+	if !c.elector.IsLeader() {
+		c.log.Debug().Str("job", c.name).Msg("not scheduler leader, skipping")
+		return
+	}
+	c.guard.Do(service.WithSystemScope(context.Background()), "cron:"+c.name, func(ctx context.Context) {
+		started := time.Now().UTC()
+		var lastError string
+		if err := c.job.Execute(ctx); err != nil {
+			c.log.Error().Err(err).Str("job", c.name).Msg("recurring job failed")
+			lastError = err.Error()
+		}
+		if err := c.repo.RecordRun(context.Background(), c.name, started, time.Since(started), lastError); err != nil {
+			c.log.Error().Err(err).Str("job", c.name).Msg("failed to record recurring job run")
+		}
+	})
+}
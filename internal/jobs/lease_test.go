@@ -0,0 +1,250 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+type fakeLease struct {
+	holder    string
+	expiresAt time.Time
+}
+
+// fakeLeaseStore keeps pms.job_leases rows in memory and applies the same
+// rule as the repository: a lease can be taken by its holder, or by anyone
+// once it has expired. Its clock only moves when advance is called.
+type fakeLeaseStore struct {
+	mu         sync.Mutex
+	now        time.Time
+	leases     map[string]fakeLease
+	acquireErr error
+}
+
+func newFakeLeaseStore() *fakeLeaseStore {
+	return &fakeLeaseStore{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), leases: make(map[string]fakeLease)}
+}
+
+func (f *fakeLeaseStore) TryAcquire(ctx context.Context, name, holder string, ttl time.Duration) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.acquireErr != nil {
+		return false, f.acquireErr
+	}
+	if l, ok := f.leases[name]; ok && l.holder != holder && !l.expiresAt.Before(f.now) {
+		return false, nil
+	}
+	f.leases[name] = fakeLease{holder: holder, expiresAt: f.now.Add(ttl)}
+	return true, nil
+}
+
+func (f *fakeLeaseStore) Release(ctx context.Context, name, holder string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if l, ok := f.leases[name]; ok && l.holder == holder {
+		// The repository sets expires_at to NOW(), which is already in the
+		// past for any later statement.
+		l.expiresAt = f.now.Add(-time.Nanosecond)
+		f.leases[name] = l
+	}
+	return nil
+}
+
+// set stores a lease for holder that expires after ttl.
+func (f *fakeLeaseStore) set(name, holder string, ttl time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.leases[name] = fakeLease{holder: holder, expiresAt: f.now.Add(ttl)}
+}
+
+func (f *fakeLeaseStore) failAcquire(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.acquireErr = err
+}
+
+func (f *fakeLeaseStore) advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = f.now.Add(d)
+}
+
+// live reports whether holder has an unexpired lease on name.
+func (f *fakeLeaseStore) live(name, holder string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	l, ok := f.leases[name]
+	return ok && l.holder == holder && l.expiresAt.After(f.now)
+}
+
+func TestLeaseGuardDo(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(store *fakeLeaseStore)
+		wantRan bool
+	}{
+		{name: "free lease", wantRan: true},
+		{
+			name:    "own lease",
+			setup:   func(store *fakeLeaseStore) { store.set("digest", "a", time.Minute) },
+			wantRan: true,
+		},
+		{
+			name:    "live lease of another replica",
+			setup:   func(store *fakeLeaseStore) { store.set("digest", "b", time.Minute) },
+			wantRan: false,
+		},
+		{
+			name:    "expired lease of another replica",
+			setup:   func(store *fakeLeaseStore) { store.set("digest", "b", -time.Second) },
+			wantRan: true,
+		},
+		{
+			name:    "acquire error",
+			setup:   func(store *fakeLeaseStore) { store.failAcquire(errors.New("connection refused")) },
+			wantRan: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newFakeLeaseStore()
+			if tt.setup != nil {
+				tt.setup(store)
+			}
+			guard := &LeaseGuard{repo: store, holder: "a", ttl: time.Hour, log: zerolog.Nop()}
+
+			var ran, heldDuringRun bool
+			got := guard.Do(context.Background(), "digest", func(ctx context.Context) {
+				ran = true
+				heldDuringRun = store.live("digest", "a")
+			})
+			if got != tt.wantRan || ran != tt.wantRan {
+				t.Fatalf("Do = %v (ran %v), want %v", got, ran, tt.wantRan)
+			}
+			if ran && !heldDuringRun {
+				t.Error("lease was not held while the work ran")
+			}
+			if store.live("digest", "a") {
+				t.Error("lease still held after Do returned")
+			}
+		})
+	}
+}
+
+func TestLeaseGuardHeartbeat(t *testing.T) {
+	tests := []struct {
+		name          string
+		loseLease     func(store *fakeLeaseStore)
+		wantCancelled bool
+	}{
+		{name: "lease renewed", wantCancelled: false},
+		{
+			name:          "lease taken over",
+			loseLease:     func(store *fakeLeaseStore) { store.set("mail", "b", time.Hour) },
+			wantCancelled: true,
+		},
+		{
+			name:          "renewal fails",
+			loseLease:     func(store *fakeLeaseStore) { store.failAcquire(errors.New("connection reset")) },
+			wantCancelled: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newFakeLeaseStore()
+			// A 30ms TTL renews every 10ms.
+			guard := &LeaseGuard{repo: store, holder: "a", ttl: 30 * time.Millisecond, log: zerolog.Nop()}
+
+			var cancelled bool
+			guard.Do(context.Background(), "mail", func(ctx context.Context) {
+				if tt.loseLease != nil {
+					tt.loseLease(store)
+				}
+				select {
+				case <-ctx.Done():
+					cancelled = true
+				case <-time.After(200 * time.Millisecond):
+				}
+			})
+			if cancelled != tt.wantCancelled {
+				t.Fatalf("run cancelled = %v, want %v", cancelled, tt.wantCancelled)
+			}
+		})
+	}
+
+	t.Run("release leaves the new holder's lease alone", func(t *testing.T) {
+		store := newFakeLeaseStore()
+		guard := &LeaseGuard{repo: store, holder: "a", ttl: 30 * time.Millisecond, log: zerolog.Nop()}
+		guard.Do(context.Background(), "mail", func(ctx context.Context) {
+			store.set("mail", "b", time.Hour)
+			<-ctx.Done()
+		})
+		if !store.live("mail", "b") {
+			t.Fatal("releasing a lost lease should not release the new holder's lease")
+		}
+	})
+}
+
+func TestLeaderElectorHandover(t *testing.T) {
+	store := newFakeLeaseStore()
+	a := &LeaderElector{repo: store, holder: "a", ttl: time.Hour, log: zerolog.Nop()}
+	b := &LeaderElector{repo: store, holder: "b", ttl: time.Hour, log: zerolog.Nop()}
+	ctx := context.Background()
+
+	// a is elected when it starts and b cannot take over a live lease.
+	runCtx, stop := context.WithCancel(ctx)
+	stopped := make(chan struct{})
+	go func() {
+		a.Run(runCtx)
+		close(stopped)
+	}()
+	deadline := time.Now().Add(time.Second)
+	for !a.IsLeader() {
+		if time.Now().After(deadline) {
+			t.Fatal("a was not elected leader")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	b.campaign(ctx)
+	if b.IsLeader() {
+		t.Fatal("b became leader while a holds a live lease")
+	}
+
+	// When a shuts down it releases leadership, and b takes it at its next poll.
+	stop()
+	<-stopped
+	if a.IsLeader() {
+		t.Fatal("a is still leader after stepping down")
+	}
+	b.campaign(ctx)
+	if !b.IsLeader() {
+		t.Fatal("b was not elected after a stepped down")
+	}
+
+	// If b stops renewing, as when its process dies, a takes over once the
+	// lease expires and b finds out at its next renewal.
+	a.campaign(ctx)
+	if a.IsLeader() {
+		t.Fatal("a became leader before b's lease expired")
+	}
+	store.advance(time.Hour + time.Second)
+	a.campaign(ctx)
+	if !a.IsLeader() {
+		t.Fatal("a was not elected after b's lease expired")
+	}
+	b.campaign(ctx)
+	if b.IsLeader() {
+		t.Fatal("b is still leader after a took over")
+	}
+
+	// A failed renewal drops leadership rather than assuming it is still held.
+	store.failAcquire(errors.New("connection refused"))
+	a.campaign(ctx)
+	if a.IsLeader() {
+		t.Fatal("a is still leader after a failed renewal")
+	}
+}
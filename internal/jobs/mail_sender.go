@@ -2,10 +2,14 @@ package jobs
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strings"
 	"time"
 
 	"github.com/enterprise-pms/pms-api/internal/config"
+	"github.com/enterprise-pms/pms-api/internal/domain/erp"
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
 	"github.com/enterprise-pms/pms-api/internal/repository"
 	"github.com/rs/zerolog"
 	gomail "github.com/wneessen/go-mail"
@@ -13,32 +17,90 @@ import (
 
 // MailSenderWorker polls the EmailObjects table for emails with Status='New'
 // and delivers them via SMTP. This replaces the .NET MailSender.SendEmailAsync
-// and the separate mail-sender process that picks up queued emails.
+// and the separate mail-sender process that picks up queued emails. Batches
+// run under a cluster-wide lease so only one replica sends at a time.
+//
+// Each batch shares one SMTP connection. A failed send is retried with
+// exponential backoff until EmailConfig.MaxAttempts is reached and the email
+// is marked Failed; a permanent SMTP rejection marks it Bounced at once.
+// Every attempt is recorded in pms.email_delivery_attempts.
 type MailSenderWorker struct {
-	emailRepo *repository.EmailRepository
-	cfg       config.EmailConfig
-	log       zerolog.Logger
-	interval  time.Duration
-	batchSize int
+	emailRepo   emailStore
+	deliveries  deliveryStore
+	guard       *LeaseGuard
+	cfg         config.EmailConfig
+	log         zerolog.Logger
+	interval    time.Duration
+	batchSize   int
+	maxAttempts int
+	baseDelay   time.Duration
+	maxDelay    time.Duration
 }
 
+// emailStore is the part of the email repository the mail sender uses.
+type emailStore interface {
+	GetNewEmails(ctx context.Context, limit int) ([]erp.EmailObject, error)
+	MarkEmailProcessing(ctx context.Context, id int) error
+	UpdateEmailStatus(ctx context.Context, id int, status string, actualSendDate *time.Time) error
+	RecordFailedAttempt(ctx context.Context, id int, status string, retryIn time.Duration) error
+}
+
+// deliveryStore records delivery attempts.
+type deliveryStore interface {
+	RecordAttempt(ctx context.Context, attempt *performance.EmailDeliveryAttempt) error
+}
+
+// Delivery attempt outcomes recorded on EmailDeliveryAttempt.Outcome.
+const (
+	deliverySent    = "Sent"
+	deliveryRetry   = "Retry"
+	deliveryFailed  = "Failed"
+	deliveryBounced = "Bounced"
+)
+
 // NewMailSenderWorker creates a new SMTP mail sender worker.
 func NewMailSenderWorker(
 	emailRepo *repository.EmailRepository,
+	deliveries *repository.EmailDeliveryRepository,
 	cfg config.EmailConfig,
 	interval time.Duration,
+	guard *LeaseGuard,
 	log zerolog.Logger,
 ) *MailSenderWorker {
 	if interval <= 0 {
 		interval = 30 * time.Second
 	}
-	return &MailSenderWorker{
-		emailRepo: emailRepo,
-		cfg:       cfg,
-		log:       log.With().Str("component", "mail_sender").Logger(),
-		interval:  interval,
-		batchSize: 50,
+	w := &MailSenderWorker{
+		guard:       guard,
+		cfg:         cfg,
+		log:         log.With().Str("component", "mail_sender").Logger(),
+		interval:    interval,
+		batchSize:   cfg.BatchSize,
+		maxAttempts: cfg.MaxAttempts,
+		baseDelay:   cfg.RetryBaseDelay,
+		maxDelay:    cfg.RetryMaxDelay,
+	}
+	// Leave the stores nil rather than holding nil pointers, so the nil
+	// checks in processBatch and recordAttempt still work.
+	if emailRepo != nil {
+		w.emailRepo = emailRepo
+	}
+	if deliveries != nil {
+		w.deliveries = deliveries
+	}
+	if w.batchSize <= 0 {
+		w.batchSize = 50
 	}
+	if w.maxAttempts <= 0 {
+		w.maxAttempts = 5
+	}
+	if w.baseDelay <= 0 {
+		w.baseDelay = time.Minute
+	}
+	if w.maxDelay < w.baseDelay {
+		w.maxDelay = time.Hour
+	}
+	return w
 }
 
 // Run starts the polling loop. It blocks until the context is cancelled.
@@ -47,7 +109,11 @@ func (w *MailSenderWorker) Run(ctx context.Context) {
 		Dur("interval", w.interval).
 		Str("smtp_server", w.cfg.SMTPServer).
 		Int("smtp_port", w.cfg.SMTPPort).
+		Int("max_attempts", w.maxAttempts).
 		Msg("mail sender worker started")
+	if w.cfg.SenderEmail == "" {
+		w.log.Warn().Msg("email.sender_email is not set, emails without a From address will fail")
+	}
 
 	ticker := time.NewTicker(w.interval)
 	defer ticker.Stop()
@@ -58,7 +124,11 @@ func (w *MailSenderWorker) Run(ctx context.Context) {
 			w.log.Info().Msg("mail sender worker stopping")
 			return
 		case <-ticker.C:
-			w.processBatch(ctx)
+			if w.guard == nil {
+				w.processBatch(ctx)
+				continue
+			}
+			w.guard.Do(ctx, "mail_sender:batch", w.processBatch)
 		}
 	}
 }
@@ -80,7 +150,11 @@ func (w *MailSenderWorker) processBatch(ctx context.Context) {
 
 	w.log.Info().Int("count", len(emails)).Msg("processing email batch")
 
-	for _, email := range emails {
+	conn := newSMTPConn(w.cfg)
+	defer conn.close()
+
+	for i := range emails {
+		email := &emails[i]
 		select {
 		case <-ctx.Done():
 			return
@@ -93,94 +167,214 @@ func (w *MailSenderWorker) processBatch(ctx context.Context) {
 			continue
 		}
 
-		if err := w.sendEmail(email.To, email.CC, email.BCC, email.Subject, email.Body); err != nil {
-			w.log.Error().Err(err).
-				Int("id", email.ID).
-				Str("to", email.To).
-				Msg("failed to send email via SMTP")
-			_ = w.emailRepo.UpdateEmailStatus(ctx, email.ID, "Failed", nil)
-			continue
+		started := time.Now()
+		sendErr := w.deliver(ctx, conn, email)
+		w.recordAttempt(ctx, email, sendErr, started)
+
+		// The rest of the batch stays New for the next poll.
+		if conn.unreachable() {
+			w.log.Warn().Err(sendErr).Msg("SMTP server unreachable, ending batch")
+			return
 		}
+	}
+}
+
+// deliver builds an email and sends it over conn.
+func (w *MailSenderWorker) deliver(ctx context.Context, conn *smtpConn, email *erp.EmailObject) error {
+	msg, err := w.newMessage(email)
+	if err != nil {
+		return err
+	}
+	return conn.send(ctx, msg)
+}
+
+// recordAttempt moves the email to the status its attempt earned and
+// appends the attempt to its delivery history. The outcome is recorded even
+// when the batch was cancelled, as the message may already have gone out.
+func (w *MailSenderWorker) recordAttempt(ctx context.Context, email *erp.EmailObject, sendErr error, started time.Time) {
+	ctx = context.WithoutCancel(ctx)
+	attempt := email.NoOfRetry + 1
+	outcome, retryIn := deliveryOutcome(sendErr, attempt, w.maxAttempts, w.baseDelay, w.maxDelay)
 
+	var err error
+	switch outcome {
+	case deliverySent:
 		now := time.Now().UTC()
-		if err := w.emailRepo.UpdateEmailStatus(ctx, email.ID, "Sent", &now); err != nil {
-			w.log.Error().Err(err).Int("id", email.ID).Msg("failed to update email status to Sent")
-		} else {
-			w.log.Info().Int("id", email.ID).Str("to", email.To).Msg("email sent successfully")
+		err = w.emailRepo.UpdateEmailStatus(ctx, email.ID, erp.EmailStatusSent, &now)
+	case deliveryRetry:
+		err = w.emailRepo.RecordFailedAttempt(ctx, email.ID, erp.EmailStatusNew, retryIn)
+	case deliveryBounced:
+		err = w.emailRepo.RecordFailedAttempt(ctx, email.ID, erp.EmailStatusBounced, 0)
+	default:
+		err = w.emailRepo.RecordFailedAttempt(ctx, email.ID, erp.EmailStatusFailed, 0)
+	}
+	if err != nil {
+		w.log.Error().Err(err).Int("id", email.ID).Str("outcome", outcome).Msg("failed to update email status")
+	}
+
+	if w.deliveries != nil {
+		record := &performance.EmailDeliveryAttempt{
+			EmailID:     email.ID,
+			Attempt:     attempt,
+			Outcome:     outcome,
+			AttemptedAt: started.UTC(),
+			DurationMs:  time.Since(started).Milliseconds(),
+		}
+		if sendErr != nil {
+			record.Error = sendErr.Error()
+		}
+		if err := w.deliveries.RecordAttempt(ctx, record); err != nil {
+			w.log.Error().Err(err).Int("id", email.ID).Msg("failed to record delivery attempt")
+		}
+	}
+
+	event := w.log.Info()
+	if sendErr != nil {
+		event = w.log.Warn().Err(sendErr)
+	}
+	event.Int("id", email.ID).
+		Str("to", email.To).
+		Int("attempt", attempt).
+		Str("outcome", outcome).
+		Dur("retry_in", retryIn).
+		Msg("email delivery attempted")
+}
+
+// deliveryOutcome classifies the attempt-th send of an email. Messages that
+// cannot be built fail at once, permanent SMTP rejections bounce, and other
+// errors are retried after retryDelay until maxAttempts is reached.
+func deliveryOutcome(err error, attempt, maxAttempts int, base, max time.Duration) (string, time.Duration) {
+	if err == nil {
+		return deliverySent, 0
+	}
+	var permanent permanentError
+	if errors.As(err, &permanent) {
+		return deliveryFailed, 0
+	}
+	var sendErr *gomail.SendError
+	if errors.As(err, &sendErr) && !sendErr.IsTemp() {
+		switch sendErr.Reason {
+		case gomail.ErrSMTPMailFrom, gomail.ErrSMTPRcptTo, gomail.ErrSMTPData, gomail.ErrSMTPDataClose:
+			return deliveryBounced, 0
 		}
 	}
+	if attempt >= maxAttempts {
+		return deliveryFailed, 0
+	}
+	return deliveryRetry, retryDelay(base, max, attempt)
 }
 
-// sendEmail delivers a single email via SMTP using go-mail.
-// Mirrors the .NET MailSender.SendEmailAsync method.
-func (w *MailSenderWorker) sendEmail(to, cc, bcc, subject, body string) error {
+// newMessage builds the SMTP message for an email. The sender is the
+// email's From address, or email.sender_email when it has none. Errors are
+// permanent: the same email would fail again.
+func (w *MailSenderWorker) newMessage(email *erp.EmailObject) (*gomail.Msg, error) {
 	msg := gomail.NewMsg()
 
-	// From
-	from := w.cfg.SenderEmail
+	from := strings.TrimSpace(email.From)
 	if from == "" {
-		from = "pms@cbn.gov.ng"
+		from = w.cfg.SenderEmail
 	}
-	if err := msg.From(from); err != nil {
-		return err
+	if from == "" {
+		return nil, Permanent(errors.New("no sender address: the email has no From and email.sender_email is not set"))
 	}
 	if w.cfg.SenderDisplay != "" {
 		if err := msg.FromFormat(w.cfg.SenderDisplay, from); err != nil {
-			return err
+			return nil, Permanent(err)
 		}
+	} else if err := msg.From(from); err != nil {
+		return nil, Permanent(err)
 	}
 
 	// To (may be semicolon or comma separated)
-	toAddrs := splitAddresses(to)
+	toAddrs := splitAddresses(email.To)
 	if len(toAddrs) == 0 {
-		w.log.Warn().Msg("no recipient address, skipping email")
-		return nil
+		return nil, Permanent(errors.New("no recipient address"))
 	}
 	if err := msg.To(toAddrs...); err != nil {
-		return err
+		return nil, Permanent(err)
 	}
-
-	// CC
-	if cc != "" {
-		ccAddrs := splitAddresses(cc)
-		if len(ccAddrs) > 0 {
-			if err := msg.Cc(ccAddrs...); err != nil {
-				return err
-			}
+	if ccAddrs := splitAddresses(email.CC); len(ccAddrs) > 0 {
+		if err := msg.Cc(ccAddrs...); err != nil {
+			return nil, Permanent(err)
+		}
+	}
+	if bccAddrs := splitAddresses(email.BCC); len(bccAddrs) > 0 {
+		if err := msg.Bcc(bccAddrs...); err != nil {
+			return nil, Permanent(err)
 		}
 	}
 
-	// BCC
-	if bcc != "" {
-		bccAddrs := splitAddresses(bcc)
-		if len(bccAddrs) > 0 {
-			if err := msg.Bcc(bccAddrs...); err != nil {
-				return err
-			}
+	msg.Subject(email.Subject)
+	msg.SetBodyString(gomail.TypeTextHTML, email.Body)
+	return msg, nil
+}
+
+// smtpConn is an SMTP connection shared by the messages of one batch. It
+// dials on first use and redials after the connection breaks. A failed dial
+// marks the server unreachable for the rest of the batch.
+type smtpConn struct {
+	cfg     config.EmailConfig
+	client  *gomail.Client
+	dialErr error
+}
+
+func newSMTPConn(cfg config.EmailConfig) *smtpConn {
+	return &smtpConn{cfg: cfg}
+}
+
+func (c *smtpConn) send(ctx context.Context, msg *gomail.Msg) error {
+	if c.client == nil {
+		client, err := newSMTPClient(c.cfg)
+		if err != nil {
+			c.dialErr = err
+			return fmt.Errorf("configuring SMTP client: %w", err)
+		}
+		if err := client.DialWithContext(ctx); err != nil {
+			c.dialErr = err
+			return fmt.Errorf("connecting to SMTP server: %w", err)
 		}
+		c.client = client
 	}
 
-	msg.Subject(subject)
-	msg.SetBodyString(gomail.TypeTextHTML, body)
+	err := c.client.Send(msg)
+	var sendErr *gomail.SendError
+	if err != nil && (!errors.As(err, &sendErr) || sendErr.Reason == gomail.ErrConnCheck) {
+		c.close()
+	}
+	return err
+}
+
+// unreachable reports whether the last dial failed.
+func (c *smtpConn) unreachable() bool {
+	return c.dialErr != nil
+}
 
-	// Create SMTP client
-	port := w.cfg.SMTPPort
+func (c *smtpConn) close() {
+	if c.client != nil {
+		_ = c.client.Close()
+		c.client = nil
+	}
+}
+
+// newSMTPClient creates a go-mail client for the configured server. SMTP
+// AUTH is only used when a sender password is configured.
+func newSMTPClient(cfg config.EmailConfig) (*gomail.Client, error) {
+	port := cfg.SMTPPort
 	if port == 0 {
 		port = 25
 	}
-
-	client, err := gomail.NewClient(w.cfg.SMTPServer,
+	opts := []gomail.Option{
 		gomail.WithPort(port),
-		gomail.WithSMTPAuth(gomail.SMTPAuthPlain),
-		gomail.WithUsername(w.cfg.SenderEmail),
-		gomail.WithPassword(w.cfg.SenderPassword),
 		gomail.WithTLSPolicy(gomail.TLSOpportunistic),
-	)
-	if err != nil {
-		return err
 	}
-
-	return client.DialAndSend(msg)
+	if cfg.SenderPassword != "" {
+		opts = append(opts,
+			gomail.WithSMTPAuth(gomail.SMTPAuthPlain),
+			gomail.WithUsername(cfg.SenderEmail),
+			gomail.WithPassword(cfg.SenderPassword),
+		)
+	}
+	return gomail.NewClient(cfg.SMTPServer, opts...)
 }
 
 // splitAddresses splits a string of email addresses separated by semicolons
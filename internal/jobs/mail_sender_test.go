@@ -0,0 +1,353 @@
+package jobs
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/config"
+	"github.com/enterprise-pms/pms-api/internal/domain/erp"
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"github.com/rs/zerolog"
+)
+
+// smtpSink is a local SMTP server that accepts mail for any recipient
+// except those containing "bounce" (550) or "busy" (451).
+type smtpSink struct {
+	ln net.Listener
+
+	mu        sync.Mutex
+	conns     int
+	delivered []string // RCPT TO of each accepted message
+
+	// onCommand, when set, sees each command before it is answered.
+	onCommand func(cmd string)
+}
+
+func newSMTPSink(t *testing.T) *smtpSink {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listening: %v", err)
+	}
+	s := &smtpSink{ln: ln}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			c, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			s.mu.Lock()
+			s.conns++
+			s.mu.Unlock()
+			go s.serve(c)
+		}
+	}()
+	return s
+}
+
+func (s *smtpSink) serve(c net.Conn) {
+	defer c.Close()
+	r := bufio.NewReader(c)
+	reply := func(line string) { c.Write([]byte(line + "\r\n")) }
+
+	reply("220 sink ESMTP")
+	var rcpt []string
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		cmd := strings.ToUpper(strings.TrimSpace(line))
+		if s.onCommand != nil {
+			s.onCommand(cmd)
+		}
+		switch {
+		case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+			reply("250-sink")
+			reply("250 8BITMIME")
+		case strings.HasPrefix(cmd, "MAIL FROM"):
+			rcpt = nil
+			reply("250 OK")
+		case strings.HasPrefix(cmd, "RCPT TO"):
+			switch {
+			case strings.Contains(cmd, "BOUNCE"):
+				reply("550 no such user")
+			case strings.Contains(cmd, "BUSY"):
+				reply("451 try again later")
+			default:
+				rcpt = append(rcpt, strings.ToLower(strings.TrimSpace(line[len("RCPT TO:"):])))
+				reply("250 OK")
+			}
+		case cmd == "DATA":
+			reply("354 go ahead")
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if l == ".\r\n" {
+					break
+				}
+			}
+			s.mu.Lock()
+			s.delivered = append(s.delivered, strings.Join(rcpt, ","))
+			s.mu.Unlock()
+			reply("250 queued")
+		case cmd == "NOOP", cmd == "RSET":
+			reply("250 OK")
+		case cmd == "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 not implemented")
+		}
+	}
+}
+
+func (s *smtpSink) config() config.EmailConfig {
+	addr := s.ln.Addr().(*net.TCPAddr)
+	return config.EmailConfig{
+		SMTPServer:  addr.IP.String(),
+		SMTPPort:    addr.Port,
+		SenderEmail: "pms@bank.local",
+	}
+}
+
+func (s *smtpSink) stats() (conns int, delivered []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.conns, append([]string(nil), s.delivered...)
+}
+
+func TestSMTPConnReusesConnection(t *testing.T) {
+	sink := newSMTPSink(t)
+	w := NewMailSenderWorker(nil, nil, sink.config(), 0, nil, zerolog.Nop())
+	conn := newSMTPConn(w.cfg)
+	defer conn.close()
+
+	for _, to := range []string{"a@bank.local", "b@bank.local", "c@bank.local"} {
+		if err := w.deliver(context.Background(), conn, &erp.EmailObject{To: to, Subject: "hi", Body: "<p>hi</p>"}); err != nil {
+			t.Fatalf("deliver to %s: %v", to, err)
+		}
+	}
+
+	conns, delivered := sink.stats()
+	if conns != 1 {
+		t.Errorf("connections = %d, want 1", conns)
+	}
+	if len(delivered) != 3 {
+		t.Errorf("delivered = %v, want 3 messages", delivered)
+	}
+}
+
+func TestDeliveryOutcome(t *testing.T) {
+	sink := newSMTPSink(t)
+	w := NewMailSenderWorker(nil, nil, sink.config(), 0, nil, zerolog.Nop())
+	conn := newSMTPConn(w.cfg)
+	defer conn.close()
+
+	send := func(to string) error {
+		return w.deliver(context.Background(), conn, &erp.EmailObject{To: to, Subject: "hi", Body: "hi"})
+	}
+
+	bounceErr := send("bounce@bank.local")
+	busyErr := send("busy@bank.local")
+	if bounceErr == nil || busyErr == nil {
+		t.Fatalf("rejections not reported: bounce=%v busy=%v", bounceErr, busyErr)
+	}
+	// A rejected recipient does not cost the connection.
+	if err := send("ok@bank.local"); err != nil {
+		t.Fatalf("deliver after rejection: %v", err)
+	}
+	if conns, _ := sink.stats(); conns != 1 {
+		t.Errorf("connections = %d, want 1", conns)
+	}
+
+	base, max := time.Minute, time.Hour
+	tests := []struct {
+		name      string
+		err       error
+		attempt   int
+		want      string
+		wantDelay time.Duration
+	}{
+		{"sent", nil, 1, deliverySent, 0},
+		{"permanent rejection", bounceErr, 1, deliveryBounced, 0},
+		{"temporary rejection", busyErr, 1, deliveryRetry, base},
+		{"temporary rejection backs off", busyErr, 3, deliveryRetry, 4 * base},
+		{"attempts exhausted", busyErr, 5, deliveryFailed, 0},
+		{"unbuildable message", Permanent(errors.New("no recipient")), 1, deliveryFailed, 0},
+		{"network error", errors.New("connection refused"), 2, deliveryRetry, 2 * base},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, delay := deliveryOutcome(tt.err, tt.attempt, 5, base, max)
+			if got != tt.want || delay != tt.wantDelay {
+				t.Errorf("deliveryOutcome = (%s, %v), want (%s, %v)", got, delay, tt.want, tt.wantDelay)
+			}
+		})
+	}
+}
+
+func TestSMTPConnUnreachable(t *testing.T) {
+	sink := newSMTPSink(t)
+	cfg := sink.config()
+	sink.ln.Close()
+
+	w := NewMailSenderWorker(nil, nil, cfg, 0, nil, zerolog.Nop())
+	conn := newSMTPConn(cfg)
+	err := w.deliver(context.Background(), conn, &erp.EmailObject{To: "a@bank.local"})
+	if err == nil {
+		t.Fatal("deliver succeeded against a closed server")
+	}
+	if !conn.unreachable() {
+		t.Error("unreachable() = false after failed dial")
+	}
+	if got, _ := deliveryOutcome(err, 1, 5, time.Minute, time.Hour); got != deliveryRetry {
+		t.Errorf("outcome = %s, want %s", got, deliveryRetry)
+	}
+}
+
+func TestNewMessageSender(t *testing.T) {
+	w := NewMailSenderWorker(nil, nil, config.EmailConfig{SenderEmail: "pms@bank.local"}, 0, nil, zerolog.Nop())
+
+	msg, err := w.newMessage(&erp.EmailObject{To: "a@bank.local; b@bank.local"})
+	if err != nil {
+		t.Fatalf("newMessage: %v", err)
+	}
+	if from := msg.GetFromString(); len(from) != 1 || !strings.Contains(from[0], "pms@bank.local") {
+		t.Errorf("From = %v, want the configured sender", from)
+	}
+	if to := msg.GetToString(); len(to) != 2 {
+		t.Errorf("To = %v, want 2 recipients", to)
+	}
+
+	msg, err = w.newMessage(&erp.EmailObject{From: "hr@bank.local", To: "a@bank.local"})
+	if err != nil {
+		t.Fatalf("newMessage: %v", err)
+	}
+	if from := msg.GetFromString(); len(from) != 1 || !strings.Contains(from[0], "hr@bank.local") {
+		t.Errorf("From = %v, want the email's own sender", from)
+	}
+
+	w.cfg.SenderEmail = ""
+	_, err = w.newMessage(&erp.EmailObject{To: "a@bank.local"})
+	var permanent permanentError
+	if !errors.As(err, &permanent) {
+		t.Errorf("newMessage without a sender = %v, want a permanent error", err)
+	}
+}
+
+// fakeEmailStore keeps EmailObjects rows in memory. Like a database driver,
+// it refuses writes made with a cancelled context.
+type fakeEmailStore struct {
+	mu       sync.Mutex
+	emails   []erp.EmailObject
+	status   map[int]string
+	outcomes map[int]string // delivery attempt outcome per email
+}
+
+func newFakeEmailStore(emails ...erp.EmailObject) *fakeEmailStore {
+	return &fakeEmailStore{emails: emails, status: make(map[int]string), outcomes: make(map[int]string)}
+}
+
+func (f *fakeEmailStore) GetNewEmails(ctx context.Context, limit int) ([]erp.EmailObject, error) {
+	return append([]erp.EmailObject(nil), f.emails...), ctx.Err()
+}
+
+func (f *fakeEmailStore) MarkEmailProcessing(ctx context.Context, id int) error {
+	return f.set(ctx, id, erp.EmailStatusProcessing)
+}
+
+func (f *fakeEmailStore) UpdateEmailStatus(ctx context.Context, id int, status string, actualSendDate *time.Time) error {
+	return f.set(ctx, id, status)
+}
+
+func (f *fakeEmailStore) RecordFailedAttempt(ctx context.Context, id int, status string, retryIn time.Duration) error {
+	return f.set(ctx, id, status)
+}
+
+func (f *fakeEmailStore) RecordAttempt(ctx context.Context, attempt *performance.EmailDeliveryAttempt) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.outcomes[attempt.EmailID] = attempt.Outcome
+	return nil
+}
+
+func (f *fakeEmailStore) set(ctx context.Context, id int, status string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.status[id] = status
+	return nil
+}
+
+func TestProcessBatchRecordsOutcomeAfterCancel(t *testing.T) {
+	tests := []struct {
+		name        string
+		email       erp.EmailObject
+		wantStatus  string
+		wantOutcome string
+	}{
+		{
+			name:        "sent",
+			email:       erp.EmailObject{ID: 1, To: "a@bank.local", NoOfRetry: 0},
+			wantStatus:  erp.EmailStatusSent,
+			wantOutcome: deliverySent,
+		},
+		{
+			name:        "failed on final attempt",
+			email:       erp.EmailObject{ID: 1, To: "busy@bank.local", NoOfRetry: 4},
+			wantStatus:  erp.EmailStatusFailed,
+			wantOutcome: deliveryFailed,
+		},
+		{
+			name:        "bounced",
+			email:       erp.EmailObject{ID: 1, To: "bounce@bank.local", NoOfRetry: 0},
+			wantStatus:  erp.EmailStatusBounced,
+			wantOutcome: deliveryBounced,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			// The batch is cancelled, as when the lease is lost, while the
+			// first message is being sent.
+			sink := newSMTPSink(t)
+			sink.onCommand = func(cmd string) {
+				if strings.HasPrefix(cmd, "RCPT TO") {
+					cancel()
+				}
+			}
+			store := newFakeEmailStore(tt.email, erp.EmailObject{ID: 2, To: "b@bank.local"})
+			w := NewMailSenderWorker(nil, nil, sink.config(), 0, nil, zerolog.Nop())
+			w.emailRepo, w.deliveries = store, store
+
+			w.processBatch(ctx)
+
+			if got := store.status[1]; got != tt.wantStatus {
+				t.Errorf("status = %q, want %q", got, tt.wantStatus)
+			}
+			if got := store.outcomes[1]; got != tt.wantOutcome {
+				t.Errorf("delivery attempt outcome = %q, want %q", got, tt.wantOutcome)
+			}
+			if got, ok := store.status[2]; ok {
+				t.Errorf("email after the cancellation was picked up (status %q), want it left New", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/enterprise-pms/pms-api/internal/service"
+	"github.com/rs/zerolog"
+)
+
+// NotificationDigestJob emails each staff member who chose the daily digest
+// for a notification category one summary of the notifications queued for
+// it since the last run. It runs daily at 07:00 unless
+// jobs.schedules.notification_digest or pms.recurring_jobs says otherwise.
+type NotificationDigestJob struct {
+	svc *service.Container
+	log zerolog.Logger
+}
+
+// NewNotificationDigestJob creates a new notification digest job.
+func NewNotificationDigestJob(svc *service.Container, log zerolog.Logger) *NotificationDigestJob {
+	return &NotificationDigestJob{
+		svc: svc,
+		log: log.With().Str("job", "notification_digest").Logger(),
+	}
+}
+
+// Run sends the digests outside the scheduler.
+// Implements the cron.Job interface.
+func (j *NotificationDigestJob) Run() {
+	if err := j.Execute(service.WithSystemScope(context.Background())); err != nil {
+		j.log.Error().Err(err).Msg("notification digest run failed")
+	}
+}
+
+// Execute sends the pending digests. Called by the scheduler, which records
+// the returned error as the job's last error.
+func (j *NotificationDigestJob) Execute(ctx context.Context) error {
+	if j.svc.Notification == nil {
+		return nil
+	}
+	sent, err := j.svc.Notification.SendDigests(ctx)
+	if sent > 0 {
+		j.log.Info().Int("sent", sent).Msg("notification digests sent")
+	}
+	return err
+}
@@ -0,0 +1,302 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/config"
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"github.com/enterprise-pms/pms-api/internal/repository"
+	"github.com/enterprise-pms/pms-api/internal/service"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+// HandlerFunc executes one persisted job. payload is the JSON the job was
+// enqueued with.
+type HandlerFunc func(ctx context.Context, payload json.RawMessage) error
+
+// Job describes a unit of work to enqueue on the persistent job queue.
+// This replaces Hangfire's BackgroundJob.Enqueue pattern from .NET.
+type Job struct {
+	Type           string      // Registered handler name.
+	Queue          string      // Hangfire queue the work used to run on; informational.
+	Payload        interface{} // JSON-encoded and passed to the handler.
+	IdempotencyKey string      // Optional; deduplicates against pending jobs.
+	MaxAttempts    int         // Optional; defaults to JobsConfig.MaxAttempts.
+	RunAt          time.Time   // Optional; defaults to now.
+}
+
+// permanentError marks a job failure that retrying cannot fix.
+type permanentError struct{ err error }
+
+func (e permanentError) Error() string { return e.err.Error() }
+func (e permanentError) Unwrap() error { return e.err }
+
+// Permanent wraps err so the queue dead-letters the job instead of retrying it.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return permanentError{err: err}
+}
+
+// JobQueue runs jobs persisted in pms.background_jobs on a fixed number of
+// worker goroutines. Jobs survive restarts; failures are retried with
+// exponential backoff until MaxAttempts, then dead-lettered. It replaces the
+// in-memory WorkerPool and the Hangfire queues (pmsexecutions,
+// feedbackreviews, workproductsevaluations, etc.).
+type JobQueue struct {
+	repo     *repository.JobRepository
+	handlers map[string]HandlerFunc
+	mu       sync.RWMutex
+
+	workers      int
+	pollInterval time.Duration
+	timeout      time.Duration
+	maxAttempts  int
+	baseDelay    time.Duration
+	maxDelay     time.Duration
+	instance     string
+
+	wake   chan struct{}
+	wg     sync.WaitGroup
+	ctx    context.Context
+	cancel context.CancelFunc
+	log    zerolog.Logger
+}
+
+// NewJobQueue creates a job queue backed by repo, filling unset settings
+// with defaults.
+func NewJobQueue(repo *repository.JobRepository, cfg config.JobsConfig, log zerolog.Logger) *JobQueue {
+	q := &JobQueue{
+		repo:         repo,
+		handlers:     make(map[string]HandlerFunc),
+		workers:      cfg.WorkerPoolSize,
+		pollInterval: cfg.PollInterval,
+		timeout:      cfg.JobTimeout,
+		maxAttempts:  cfg.MaxAttempts,
+		baseDelay:    cfg.RetryBaseDelay,
+		maxDelay:     cfg.RetryMaxDelay,
+		wake:         make(chan struct{}, 1),
+		log:          log.With().Str("component", "job_queue").Logger(),
+	}
+	if q.workers <= 0 {
+		q.workers = 5
+	}
+	if q.pollInterval <= 0 {
+		q.pollInterval = 5 * time.Second
+	}
+	if q.timeout <= 0 {
+		q.timeout = 30 * time.Minute
+	}
+	if q.maxAttempts <= 0 {
+		q.maxAttempts = 5
+	}
+	if q.baseDelay <= 0 {
+		q.baseDelay = 30 * time.Second
+	}
+	if q.maxDelay < q.baseDelay {
+		q.maxDelay = time.Hour
+	}
+	q.instance = instanceID()
+	q.ctx, q.cancel = context.WithCancel(service.WithSystemScope(context.Background()))
+	return q
+}
+
+// Register binds a handler to a job type. It must be called before Start.
+func (q *JobQueue) Register(jobType string, h HandlerFunc) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.handlers[jobType] = h
+}
+
+// Enqueue persists job and returns its ID. When a pending job already holds
+// the idempotency key, that job's ID is returned and nothing is enqueued.
+func (q *JobQueue) Enqueue(ctx context.Context, job Job) (string, error) {
+	payload, err := json.Marshal(job.Payload)
+	if err != nil {
+		return "", fmt.Errorf("encoding %s payload: %w", job.Type, err)
+	}
+	maxAttempts := job.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = q.maxAttempts
+	}
+	runAt := job.RunAt
+	if runAt.IsZero() {
+		runAt = time.Now().UTC()
+	}
+
+	record := performance.BackgroundJob{
+		BackgroundJobID: uuid.New().String(),
+		JobType:         job.Type,
+		Queue:           job.Queue,
+		Payload:         string(payload),
+		IdempotencyKey:  job.IdempotencyKey,
+		MaxAttempts:     maxAttempts,
+		RunAt:           runAt,
+	}
+	created, err := q.repo.Enqueue(ctx, &record)
+	if err != nil {
+		return "", err
+	}
+	if !created {
+		q.log.Debug().Str("job", job.Type).Str("key", job.IdempotencyKey).Str("id", record.BackgroundJobID).Msg("job already pending, not enqueued")
+		return record.BackgroundJobID, nil
+	}
+
+	q.log.Debug().Str("job", job.Type).Str("id", record.BackgroundJobID).Msg("job enqueued")
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+	return record.BackgroundJobID, nil
+}
+
+// Start launches the worker goroutines.
+func (q *JobQueue) Start() {
+	q.log.Info().Int("workers", q.workers).Str("instance", q.instance).Msg("starting job queue")
+	for i := 0; i < q.workers; i++ {
+		q.wg.Add(1)
+		go q.worker(fmt.Sprintf("%s/%d", q.instance, i))
+	}
+}
+
+// Shutdown stops polling, cancels in-flight jobs and waits for the workers
+// to record their outcome. Cancelled jobs are retried like any failure.
+func (q *JobQueue) Shutdown() {
+	q.log.Info().Msg("shutting down job queue")
+	q.cancel()
+	q.wg.Wait()
+	q.log.Info().Msg("job queue stopped")
+}
+
+func (q *JobQueue) worker(workerID string) {
+	defer q.wg.Done()
+	q.log.Debug().Str("worker_id", workerID).Msg("worker started")
+
+	ticker := time.NewTicker(q.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		// Drain every due job before waiting for the next tick.
+		for q.ctx.Err() == nil && q.runNext(workerID) {
+		}
+		select {
+		case <-q.ctx.Done():
+			q.log.Debug().Str("worker_id", workerID).Msg("worker stopped")
+			return
+		case <-ticker.C:
+		case <-q.wake:
+		}
+	}
+}
+
+// runNext claims and executes one due job. It reports whether a job was run.
+func (q *JobQueue) runNext(workerID string) bool {
+	job, err := q.repo.ClaimNext(q.ctx, workerID, time.Now().UTC(), q.timeout)
+	if err != nil {
+		if q.ctx.Err() == nil {
+			q.log.Error().Err(err).Str("worker_id", workerID).Msg("failed to claim job")
+		}
+		return false
+	}
+	if job == nil {
+		return false
+	}
+
+	log := q.log.With().Str("worker_id", workerID).Str("job", job.JobType).Str("id", job.BackgroundJobID).Int("attempt", job.Attempts).Logger()
+
+	var runErr error
+	if job.Attempts > job.MaxAttempts {
+		// The worker running the final attempt died before recording it.
+		runErr = Permanent(errors.New("lease expired on final attempt"))
+	} else {
+		log.Info().Msg("executing job")
+		runErr = q.execute(job)
+	}
+
+	// Record the outcome even when shutdown cancelled the job.
+	ctx := context.Background()
+	now := time.Now().UTC()
+	if runErr == nil {
+		if err := q.repo.MarkSucceeded(ctx, job.BackgroundJobID, workerID, now); err != nil {
+			log.Error().Err(err).Msg("failed to record job success")
+		}
+		log.Info().Msg("job completed")
+		return true
+	}
+
+	var retryAt *time.Time
+	var permanent permanentError
+	if !errors.As(runErr, &permanent) && job.Attempts < job.MaxAttempts {
+		at := now.Add(retryDelay(q.baseDelay, q.maxDelay, job.Attempts))
+		retryAt = &at
+	}
+	if err := q.repo.MarkFailed(ctx, job.BackgroundJobID, workerID, runErr.Error(), now, retryAt); err != nil {
+		log.Error().Err(err).Msg("failed to record job failure")
+	}
+	if retryAt != nil {
+		log.Warn().Err(runErr).Time("retry_at", *retryAt).Msg("job failed, retry scheduled")
+	} else {
+		log.Error().Err(runErr).Msg("job failed, moved to dead letter")
+	}
+	return true
+}
+
+func (q *JobQueue) execute(job *performance.BackgroundJob) (err error) {
+	q.mu.RLock()
+	handler, ok := q.handlers[job.JobType]
+	q.mu.RUnlock()
+	if !ok {
+		return Permanent(fmt.Errorf("no handler registered for job type %q", job.JobType))
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			q.log.Error().
+				Str("job", job.JobType).
+				Interface("panic", r).
+				Str("stack", string(debug.Stack())).
+				Msg("job panicked")
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(q.ctx, q.timeout)
+	defer cancel()
+	return handler(ctx, json.RawMessage(job.Payload))
+}
+
+// retryDelay is the backoff before retrying a job that failed its
+// attempt-th run: base doubled per previous attempt, capped at max.
+func retryDelay(base, max time.Duration, attempt int) time.Duration {
+	delay := base
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= max {
+			return max
+		}
+	}
+	if delay > max {
+		return max
+	}
+	return delay
+}
+
+// decodeInto adapts a typed job function to a HandlerFunc. Payloads that
+// cannot be decoded are dead-lettered.
+func decodeInto[T any](fn func(ctx context.Context, req T) error) HandlerFunc {
+	return func(ctx context.Context, payload json.RawMessage) error {
+		var req T
+		if err := json.Unmarshal(payload, &req); err != nil {
+			return Permanent(fmt.Errorf("decoding payload: %w", err))
+		}
+		return fn(ctx, req)
+	}
+}
@@ -0,0 +1,66 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRetryDelay(t *testing.T) {
+	base, max := 30*time.Second, 5*time.Minute
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{1, 30 * time.Second},
+		{2, time.Minute},
+		{3, 2 * time.Minute},
+		{4, 4 * time.Minute},
+		{5, 5 * time.Minute},
+		{50, 5 * time.Minute},
+	}
+	for _, tt := range tests {
+		if got := retryDelay(base, max, tt.attempt); got != tt.want {
+			t.Errorf("retryDelay(attempt %d) = %s, want %s", tt.attempt, got, tt.want)
+		}
+	}
+}
+
+func TestPermanent(t *testing.T) {
+	if Permanent(nil) != nil {
+		t.Fatal("Permanent(nil) should be nil")
+	}
+
+	cause := errors.New("bad input")
+	err := Permanent(cause)
+	var permanent permanentError
+	if !errors.As(err, &permanent) {
+		t.Fatal("Permanent error should be detectable with errors.As")
+	}
+	if !errors.Is(err, cause) {
+		t.Error("Permanent error should unwrap to its cause")
+	}
+}
+
+func TestDecodeInto(t *testing.T) {
+	var got string
+	h := decodeInto(func(ctx context.Context, id string) error {
+		got = id
+		return nil
+	})
+
+	if err := h(context.Background(), json.RawMessage(`"RP-1"`)); err != nil {
+		t.Fatalf("handler returned %v", err)
+	}
+	if got != "RP-1" {
+		t.Errorf("decoded %q, want RP-1", got)
+	}
+
+	err := h(context.Background(), json.RawMessage(`{"not":"a string"}`))
+	var permanent permanentError
+	if !errors.As(err, &permanent) {
+		t.Errorf("undecodable payload returned %v, want a permanent error", err)
+	}
+}
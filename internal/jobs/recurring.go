@@ -0,0 +1,178 @@
+package jobs
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"github.com/robfig/cron/v3"
+	"github.com/rs/zerolog"
+)
+
+// RecurringJob is a cron job whose outcome the scheduler records.
+type RecurringJob interface {
+	Execute(ctx context.Context) error
+}
+
+// recurringEntry is a registered recurring job and its current cron entry.
+type recurringEntry struct {
+	description     string
+	defaultSchedule string
+	job             *clusterJob
+	entryID         cron.EntryID
+	schedule        string // Schedule of the live cron entry; empty when not scheduled.
+}
+
+// recurringJobStore is the part of the recurring job repository the
+// scheduler's sync loop uses.
+type recurringJobStore interface {
+	Seed(ctx context.Context, jobs []performance.RecurringJob) error
+	List(ctx context.Context) ([]performance.RecurringJob, error)
+	SetNextRun(ctx context.Context, name string, next *time.Time) error
+	ClaimTrigger(ctx context.Context, name string, requestedAt time.Time) (bool, error)
+}
+
+// recurringJobs keeps the cron entries of every recurring job in line with
+// pms.recurring_jobs, so that schedule changes, pauses and trigger requests
+// made through the admin API reach every replica without a restart.
+type recurringJobs struct {
+	cron    *cron.Cron
+	repo    recurringJobStore
+	elector *LeaderElector
+	entries map[string]*recurringEntry
+	mu      sync.Mutex
+	log     zerolog.Logger
+}
+
+func newRecurringJobs(c *cron.Cron, repo recurringJobStore, elector *LeaderElector, log zerolog.Logger) *recurringJobs {
+	return &recurringJobs{
+		cron:    c,
+		repo:    repo,
+		elector: elector,
+		entries: make(map[string]*recurringEntry),
+		log:     log.With().Str("component", "recurring_jobs").Logger(),
+	}
+}
+
+// add registers a job under name. defaultSchedule is only used to seed the
+// job's row the first time it is registered.
+func (r *recurringJobs) add(name, description, defaultSchedule string, job *clusterJob) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries[name] = &recurringEntry{description: description, defaultSchedule: defaultSchedule, job: job}
+}
+
+// seed creates rows for registered jobs that have none yet.
+func (r *recurringJobs) seed(ctx context.Context) error {
+	r.mu.Lock()
+	rows := make([]performance.RecurringJob, 0, len(r.entries))
+	for name, e := range r.entries {
+		rows = append(rows, performance.RecurringJob{
+			JobName:     name,
+			Description: e.description,
+			Schedule:    e.defaultSchedule,
+			IsEnabled:   true,
+		})
+	}
+	r.mu.Unlock()
+	return r.repo.Seed(ctx, rows)
+}
+
+// run reconciles immediately and then every interval until ctx is cancelled.
+func (r *recurringJobs) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.sync(ctx)
+		}
+	}
+}
+
+// sync applies each job's stored schedule and enable flag to its cron entry
+// and, on the leader, publishes next-run times and starts requested runs.
+func (r *recurringJobs) sync(ctx context.Context) {
+	rows, err := r.repo.List(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			r.log.Error().Err(err).Msg("failed to load recurring jobs")
+		}
+		return
+	}
+	leader := r.elector.IsLeader()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, row := range rows {
+		e, ok := r.entries[row.JobName]
+		if !ok {
+			continue
+		}
+		r.apply(row, e)
+
+		if !leader {
+			continue
+		}
+		var next *time.Time
+		if e.schedule != "" {
+			if at := r.cron.Entry(e.entryID).Next; !at.IsZero() {
+				next = &at
+			}
+		}
+		if !sameTime(next, row.NextRunAt) {
+			if err := r.repo.SetNextRun(ctx, row.JobName, next); err != nil {
+				r.log.Error().Err(err).Str("job", row.JobName).Msg("failed to store next run time")
+			}
+		}
+		if row.TriggerRequestedAt != nil {
+			claimed, err := r.repo.ClaimTrigger(ctx, row.JobName, *row.TriggerRequestedAt)
+			if err != nil {
+				r.log.Error().Err(err).Str("job", row.JobName).Msg("failed to claim trigger request")
+				continue
+			}
+			if claimed {
+				r.log.Info().Str("job", row.JobName).Str("requestedBy", row.TriggerRequestedBy).Msg("running recurring job on request")
+				go e.job.Run()
+			}
+		}
+	}
+}
+
+// apply replaces the cron entry of e when the stored schedule or enable flag
+// differs from what is live.
+func (r *recurringJobs) apply(row performance.RecurringJob, e *recurringEntry) {
+	want := ""
+	if row.IsEnabled {
+		want = row.Schedule
+	}
+	if want == e.schedule {
+		return
+	}
+
+	if e.schedule != "" {
+		r.cron.Remove(e.entryID)
+		e.schedule = ""
+	}
+	if want == "" {
+		r.log.Info().Str("job", row.JobName).Msg("recurring job paused")
+		return
+	}
+	id, err := r.cron.AddJob(want, e.job)
+	if err != nil {
+		r.log.Error().Err(err).Str("job", row.JobName).Str("schedule", want).Msg("invalid schedule, job not scheduled")
+		return
+	}
+	e.entryID, e.schedule = id, want
+	r.log.Info().Str("job", row.JobName).Str("schedule", want).Msg("recurring job scheduled")
+}
+
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Truncate(time.Second).Equal(b.Truncate(time.Second))
+}
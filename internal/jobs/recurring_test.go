@@ -0,0 +1,103 @@
+package jobs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"github.com/robfig/cron/v3"
+	"github.com/rs/zerolog"
+)
+
+// fakeRecurringStore keeps pms.recurring_jobs rows in memory.
+type fakeRecurringStore struct {
+	rows    []performance.RecurringJob
+	claims  []string
+	nextSet int
+}
+
+func (f *fakeRecurringStore) Seed(ctx context.Context, jobs []performance.RecurringJob) error {
+	return nil
+}
+
+func (f *fakeRecurringStore) List(ctx context.Context) ([]performance.RecurringJob, error) {
+	return append([]performance.RecurringJob(nil), f.rows...), nil
+}
+
+func (f *fakeRecurringStore) SetNextRun(ctx context.Context, name string, next *time.Time) error {
+	f.nextSet++
+	for i := range f.rows {
+		if f.rows[i].JobName == name {
+			f.rows[i].NextRunAt = next
+		}
+	}
+	return nil
+}
+
+func (f *fakeRecurringStore) ClaimTrigger(ctx context.Context, name string, requestedAt time.Time) (bool, error) {
+	f.claims = append(f.claims, name)
+	return false, nil
+}
+
+func TestRecurringJobsSync(t *testing.T) {
+	c := cron.New()
+	c.Start()
+	defer c.Stop()
+
+	elector := &LeaderElector{}
+	elector.leader.Store(true)
+	store := &fakeRecurringStore{rows: []performance.RecurringJob{
+		{JobName: "digest", Schedule: "@every 1h", IsEnabled: true},
+		{JobName: "unregistered", Schedule: "@every 1h", IsEnabled: true},
+	}}
+	jobs := newRecurringJobs(c, store, elector, zerolog.Nop())
+	jobs.add("digest", "Notification digest", "@daily", &clusterJob{name: "digest", elector: elector, log: zerolog.Nop()})
+	entry := jobs.entries["digest"]
+	ctx := context.Background()
+
+	jobs.sync(ctx)
+	if entry.schedule != "@every 1h" || len(c.Entries()) != 1 {
+		t.Fatalf("after first sync: schedule %q with %d cron entries, want @every 1h with 1", entry.schedule, len(c.Entries()))
+	}
+	if store.rows[0].NextRunAt == nil {
+		t.Fatal("leader should publish the next run time")
+	}
+
+	// Unchanged rows leave the entry and the stored next run alone.
+	first, written := entry.entryID, store.nextSet
+	jobs.sync(ctx)
+	if entry.entryID != first || store.nextSet != written {
+		t.Errorf("unchanged sync replaced the entry or rewrote the next run (%d writes, want %d)", store.nextSet, written)
+	}
+
+	store.rows[0].Schedule = "@every 2h"
+	jobs.sync(ctx)
+	if entry.schedule != "@every 2h" || entry.entryID == first || len(c.Entries()) != 1 {
+		t.Errorf("rescheduled job: schedule %q with %d cron entries, want @every 2h with 1", entry.schedule, len(c.Entries()))
+	}
+
+	store.rows[0].IsEnabled = false
+	jobs.sync(ctx)
+	if entry.schedule != "" || len(c.Entries()) != 0 {
+		t.Errorf("paused job still scheduled: %q with %d cron entries", entry.schedule, len(c.Entries()))
+	}
+	if store.rows[0].NextRunAt != nil {
+		t.Error("paused job should have no next run time")
+	}
+
+	// Followers apply schedules but leave next-run times and trigger
+	// requests to the leader.
+	elector.leader.Store(false)
+	requested := time.Now()
+	store.rows[0].IsEnabled = true
+	store.rows[0].TriggerRequestedAt = &requested
+	written = store.nextSet
+	jobs.sync(ctx)
+	if entry.schedule != "@every 2h" || len(c.Entries()) != 1 {
+		t.Errorf("follower did not resume the job: %q with %d cron entries", entry.schedule, len(c.Entries()))
+	}
+	if store.nextSet != written || len(store.claims) != 0 {
+		t.Errorf("follower wrote %d next runs and claimed %v", store.nextSet-written, store.claims)
+	}
+}
@@ -2,6 +2,7 @@ package jobs
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/enterprise-pms/pms-api/internal/service"
 	"github.com/rs/zerolog"
@@ -31,22 +32,28 @@ func NewReviewPeriodJob(svc *service.Container, log zerolog.Logger) *ReviewPerio
 	}
 }
 
-// Run executes the review period closure check. Called by the cron scheduler.
+// Run executes the review period closure check outside the scheduler.
 // Implements the cron.Job interface.
 func (j *ReviewPeriodJob) Run() {
-	ctx := context.Background()
+	if err := j.Execute(service.WithSystemScope(context.Background())); err != nil {
+		j.log.Error().Err(err).Msg("review period closure check failed")
+	}
+}
 
+// Execute runs the review period closure check. Called by the scheduler,
+// which records the returned error as the job's last error.
+func (j *ReviewPeriodJob) Execute(ctx context.Context) error {
 	// Check if the background service is enabled via global settings.
 	// Mirrors: var enableService = await _globalSetting.GetBooleanValue("ENABLE_REVIEW_PERIOD_BACKGROUND_SERVICE");
 	if j.svc.GlobalSetting != nil {
 		enabled, err := j.svc.GlobalSetting.GetBoolValue(ctx, "ENABLE_REVIEW_PERIOD_BACKGROUND_SERVICE")
 		if err != nil {
 			j.log.Debug().Err(err).Msg("could not read ENABLE_REVIEW_PERIOD_BACKGROUND_SERVICE, defaulting to disabled")
-			return
+			return nil
 		}
 		if !enabled {
 			j.log.Debug().Msg("review period background service is disabled")
-			return
+			return nil
 		}
 	}
 
@@ -60,9 +67,10 @@ func (j *ReviewPeriodJob) Run() {
 	// and close operations internally. For now we call the available interface method.
 	if j.svc.ReviewPeriod != nil {
 		if _, err := j.svc.ReviewPeriod.CloseReviewPeriod(ctx, nil); err != nil {
-			j.log.Error().Err(err).Msg("failed to close expired review periods")
+			return fmt.Errorf("closing expired review periods: %w", err)
 		}
 	}
 
 	j.log.Info().Msg("review period closure check completed")
+	return nil
 }
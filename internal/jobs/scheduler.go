@@ -2,6 +2,7 @@ package jobs
 
 import (
 	"context"
+	"time"
 
 	"github.com/enterprise-pms/pms-api/internal/config"
 	"github.com/enterprise-pms/pms-api/internal/repository"
@@ -11,17 +12,20 @@ import (
 )
 
 // Scheduler manages all background jobs: cron-based recurring tasks and
-// on-demand jobs via a worker pool. It replaces the .NET Hangfire server
-// and BackgroundService hosted services.
+// on-demand jobs via the persistent job queue. It replaces the .NET Hangfire
+// server and BackgroundService hosted services.
 //
 // .NET equivalents:
 //   - Hangfire RecurringJob → cron.AddJob with SkipIfStillRunning
-//   - Hangfire BackgroundJob.Enqueue → WorkerPool.Enqueue
-//   - BackgroundService (hosted services) → cron jobs at @every 10m
-//   - Hangfire queues (pmsexecutions, etc.) → single WorkerPool
+//   - Hangfire BackgroundJob.Enqueue → JobQueue.Enqueue
+//   - BackgroundService (hosted services) → cron jobs in pms.recurring_jobs
+//   - Hangfire queues (pmsexecutions, etc.) → pms.background_jobs table
 type Scheduler struct {
 	cron       *cron.Cron
-	workerPool *WorkerPool
+	queue      *JobQueue
+	elector    *LeaderElector
+	guard      *LeaseGuard
+	recurring  *recurringJobs
 	mailSender *MailSenderWorker
 	svc        *service.Container
 	repos      *repository.Container
@@ -46,23 +50,24 @@ func NewScheduler(
 }
 
 // Start initializes and starts all background workers:
-//  1. Worker pool for on-demand job dispatch.
-//  2. Cron scheduler with 3 recurring jobs (@every 10m).
+//  1. Job queue workers for on-demand job dispatch.
+//  2. Cron scheduler with 6 recurring jobs on per-job schedules.
 //  3. Mail sender worker (polls for Status='New' emails).
 func (s *Scheduler) Start(ctx context.Context) {
 	ctx, s.cancel = context.WithCancel(ctx)
 
-	// --- Worker Pool ---
-	poolSize := s.cfg.Jobs.WorkerPoolSize
-	if poolSize <= 0 {
-		poolSize = 5
-	}
-	queueSize := s.cfg.Jobs.WorkerQueueSize
-	if queueSize <= 0 {
-		queueSize = 100
-	}
-	s.workerPool = NewWorkerPool(poolSize, queueSize, s.log)
-	s.workerPool.Start()
+	// --- Job Queue ---
+	s.queue = NewJobQueue(s.repos.Jobs, s.cfg.Jobs, s.log)
+	s.registerHandlers()
+	s.queue.Start()
+
+	// --- Cluster coordination ---
+	// Every replica runs the cron scheduler, but only the elected leader
+	// executes recurring jobs, each under its own lease.
+	holder := instanceID()
+	s.guard = NewLeaseGuard(s.repos.Leases, holder, s.cfg.Jobs.LeaseTTL, s.log)
+	s.elector = NewLeaderElector(s.repos.Leases, holder, s.cfg.Jobs.LeaseTTL, s.log)
+	go s.elector.Run(ctx)
 
 	// --- Cron Scheduler ---
 	// Use SkipIfStillRunning to prevent overlapping executions when a job
@@ -73,33 +78,40 @@ func (s *Scheduler) Start(ctx context.Context) {
 		cron.WithChain(cron.SkipIfStillRunning(cronLogger)),
 	)
 
-	schedule := s.cfg.Jobs.CronSchedule
-	if schedule == "" {
-		schedule = "@every 10m"
-	}
-
 	// Register recurring jobs — mirrors .NET BackgroundService registrations.
-	reviewPeriodJob := NewReviewPeriodJob(s.svc, s.log)
-	competencyClosureJob := NewCompetencyClosureJob(s.svc, s.workerPool, s.log)
-	autoReassignJob := NewAutoReassignJob(s.svc, s.workerPool, s.log)
-
-	if _, err := s.cron.AddJob(schedule, reviewPeriodJob); err != nil {
-		s.log.Error().Err(err).Msg("failed to register review period job")
+	// Each job runs on its own schedule, stored in pms.recurring_jobs and
+	// reconciled every SyncInterval so admin changes apply without a restart.
+	s.recurring = newRecurringJobs(s.cron, s.repos.Recurring, s.elector, s.log)
+	s.addRecurring("review_period_closure", "Closes expired review periods and extensions",
+		NewReviewPeriodJob(s.svc, s.log))
+	s.addRecurring("competency_closure", "Creates gap closure objectives for closed competency gaps",
+		NewCompetencyClosureJob(s.svc, s.queue, s.log))
+	s.addRecurring("auto_reassign", "Reassigns breached feedback requests using the reassignment policies",
+		NewAutoReassignJob(s.svc, s.queue, s.log))
+	s.addRecurring("notification_digest", "Emails staff their daily notification digest",
+		NewNotificationDigestJob(s.svc, s.log))
+	s.addRecurring("development_plan_reminders", "Reminds staff of development plans due or overdue",
+		NewDevelopmentPlanReminderJob(s.svc, s.log))
+	s.addRecurring("sla_escalations", "Sends SLA warnings and escalates overdue feedback requests",
+		NewSLAEscalationJob(s.svc, s.log))
+
+	if err := s.recurring.seed(ctx); err != nil {
+		s.log.Error().Err(err).Msg("failed to seed recurring jobs")
 	}
-	if _, err := s.cron.AddJob(schedule, competencyClosureJob); err != nil {
-		s.log.Error().Err(err).Msg("failed to register competency closure job")
-	}
-	if _, err := s.cron.AddJob(schedule, autoReassignJob); err != nil {
-		s.log.Error().Err(err).Msg("failed to register auto-reassign job")
+	s.recurring.sync(ctx)
+	syncInterval := s.cfg.Jobs.SyncInterval
+	if syncInterval <= 0 {
+		syncInterval = 15 * time.Second
 	}
+	go s.recurring.run(ctx, syncInterval)
 
 	s.cron.Start()
-	s.log.Info().Str("schedule", schedule).Msg("cron scheduler started with 3 recurring jobs")
+	s.log.Info().Dur("sync_interval", syncInterval).Msg("cron scheduler started with 6 recurring jobs")
 
 	// --- Mail Sender Worker ---
 	if s.repos.Email != nil {
 		interval := s.cfg.Jobs.MailSenderInterval
-		s.mailSender = NewMailSenderWorker(s.repos.Email, s.cfg.Email, interval, s.log)
+		s.mailSender = NewMailSenderWorker(s.repos.Email, s.repos.Deliveries, s.cfg.Email, interval, s.guard, s.log)
 		go s.mailSender.Run(ctx)
 		s.log.Info().Msg("mail sender worker started")
 	} else {
@@ -113,7 +125,7 @@ func (s *Scheduler) Start(ctx context.Context) {
 // The shutdown sequence is:
 //  1. Stop accepting new cron triggers.
 //  2. Cancel the context (stops mail sender and in-flight jobs).
-//  3. Wait for the worker pool to drain.
+//  3. Wait for the job queue workers to record in-flight jobs.
 func (s *Scheduler) Stop() {
 	s.log.Info().Msg("stopping scheduler")
 
@@ -124,19 +136,51 @@ func (s *Scheduler) Stop() {
 		s.log.Info().Msg("cron scheduler stopped")
 	}
 
-	// Cancel context to stop mail sender and worker pool.
+	// Cancel context to stop mail sender.
 	if s.cancel != nil {
 		s.cancel()
 	}
 
-	// Drain worker pool.
-	if s.workerPool != nil {
-		s.workerPool.Shutdown()
+	// Stop job queue workers.
+	if s.queue != nil {
+		s.queue.Shutdown()
 	}
 
 	s.log.Info().Msg("scheduler stopped")
 }
 
+// defaultSchedules seeds jobs that should not run on JobsConfig.CronSchedule
+// when JobsConfig.Schedules does not list them.
+var defaultSchedules = map[string]string{
+	"notification_digest":        "0 7 * * *",    // daily at 07:00
+	"development_plan_reminders": "0 8 * * *",    // daily at 08:00
+	"sla_escalations":            "*/30 * * * *", // every 30 minutes
+}
+
+// addRecurring registers a recurring job that runs once cluster-wide per
+// trigger. Its schedule is seeded from JobsConfig.Schedules[name], falling
+// back to the job's default schedule and then JobsConfig.CronSchedule.
+func (s *Scheduler) addRecurring(name, description string, job RecurringJob) {
+	schedule := s.cfg.Jobs.Schedules[name]
+	if schedule == "" {
+		schedule = defaultSchedules[name]
+	}
+	if schedule == "" {
+		schedule = s.cfg.Jobs.CronSchedule
+	}
+	if schedule == "" {
+		schedule = "@every 10m"
+	}
+	s.recurring.add(name, description, schedule, &clusterJob{
+		name:    name,
+		job:     job,
+		elector: s.elector,
+		guard:   s.guard,
+		repo:    s.repos.Recurring,
+		log:     s.log,
+	})
+}
+
 // cronLogger adapts zerolog to the cron.Logger interface.
 type cronLogAdapter struct {
 	log zerolog.Logger
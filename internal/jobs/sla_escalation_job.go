@@ -0,0 +1,46 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/enterprise-pms/pms-api/internal/service"
+	"github.com/rs/zerolog"
+)
+
+// SLAEscalationJob walks pending feedback requests up their SLA escalation
+// ladders, warning assignees before the SLA is breached and escalating once
+// it is. It runs every 30 minutes unless jobs.schedules.sla_escalations or
+// pms.recurring_jobs says otherwise.
+type SLAEscalationJob struct {
+	svc *service.Container
+	log zerolog.Logger
+}
+
+// NewSLAEscalationJob creates a new SLA escalation job.
+func NewSLAEscalationJob(svc *service.Container, log zerolog.Logger) *SLAEscalationJob {
+	return &SLAEscalationJob{
+		svc: svc,
+		log: log.With().Str("job", "sla_escalations").Logger(),
+	}
+}
+
+// Run sends the escalations outside the scheduler.
+// Implements the cron.Job interface.
+func (j *SLAEscalationJob) Run() {
+	if err := j.Execute(service.WithSystemScope(context.Background())); err != nil {
+		j.log.Error().Err(err).Msg("SLA escalation run failed")
+	}
+}
+
+// Execute fires the escalation steps pending requests have reached. Called
+// by the scheduler, which records the returned error as the job's last error.
+func (j *SLAEscalationJob) Execute(ctx context.Context) error {
+	if j.svc.SLAEscalation == nil {
+		return nil
+	}
+	fired, err := j.svc.SLAEscalation.SendSLAEscalations(ctx)
+	if fired > 0 {
+		j.log.Info().Int("fired", fired).Msg("SLA escalations sent")
+	}
+	return err
+}
@@ -22,17 +22,28 @@ const (
 	RolesKey              contextKey = "roles"
 	PermissionsKey        contextKey = "permissions"
 	OrganizationalUnitKey contextKey = "organizational_unit"
+	SessionIDKey          contextKey = "session_id"
+	TokenExpiryKey        contextKey = "token_expiry"
 )
 
+// SessionValidator reports whether the session an access token was issued
+// for is still active. It lets JWTAuth reject tokens of revoked sessions and
+// deactivated users before they expire.
+type SessionValidator interface {
+	IsSessionActive(ctx context.Context, sessionID string) (bool, error)
+}
+
 // Stack holds all middleware instances.
 type Stack struct {
-	cfg *config.Config
-	log zerolog.Logger
+	cfg      *config.Config
+	log      zerolog.Logger
+	sessions SessionValidator
 }
 
-// New creates a middleware stack.
-func New(cfg *config.Config, log zerolog.Logger) *Stack {
-	return &Stack{cfg: cfg, log: log}
+// New creates a middleware stack. sessions may be nil, in which case
+// JWTAuth accepts any validly signed, unexpired token.
+func New(cfg *config.Config, log zerolog.Logger, sessions SessionValidator) *Stack {
+	return &Stack{cfg: cfg, log: log, sessions: sessions}
 }
 
 // RequestLogger logs every HTTP request with method, path, status, and duration.
@@ -77,7 +88,11 @@ func (s *Stack) CORS(next http.Handler) http.Handler {
 			origin := r.Header.Get("Origin")
 			for _, allowed := range s.cfg.CORS.AllowedOrigins {
 				if allowed == origin {
+					// Listed origins may send cookies, which the
+					// single sign-on callback needs for its login state.
 					w.Header().Set("Access-Control-Allow-Origin", origin)
+					w.Header().Set("Access-Control-Allow-Credentials", "true")
+					w.Header().Add("Vary", "Origin")
 					break
 				}
 			}
@@ -154,8 +169,26 @@ func (s *Stack) JWTAuth(next http.Handler) http.Handler {
 			return
 		}
 
-		// Inject claims into context
+		// Reject tokens whose session was revoked. Tokens issued before
+		// sessions existed carry no sid and remain valid until they expire.
 		ctx := r.Context()
+		if sid, ok := claims["sid"].(string); ok && sid != "" {
+			if s.sessions != nil {
+				active, err := s.sessions.IsSessionActive(ctx, sid)
+				if err != nil {
+					s.log.Error().Err(err).Str("session_id", sid).Msg("Failed to check session")
+					response.Error(w, http.StatusServiceUnavailable, "Unable to validate session")
+					return
+				}
+				if !active {
+					response.Error(w, http.StatusUnauthorized, "Session has been revoked")
+					return
+				}
+			}
+			ctx = context.WithValue(ctx, SessionIDKey, sid)
+		}
+
+		// Inject claims into context
 		if userID, ok := claims["sub"].(string); ok {
 			ctx = context.WithValue(ctx, UserIDKey, userID)
 		}
@@ -187,6 +220,9 @@ func (s *Stack) JWTAuth(next http.Handler) http.Handler {
 		if ou, ok := claims["organizational_unit"].(string); ok {
 			ctx = context.WithValue(ctx, OrganizationalUnitKey, ou)
 		}
+		if exp, err := claims.GetExpirationTime(); err == nil && exp != nil {
+			ctx = context.WithValue(ctx, TokenExpiryKey, exp.Time)
+		}
 
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
@@ -266,3 +302,9 @@ func (w *statusWriter) WriteHeader(code int) {
 	w.status = code
 	w.ResponseWriter.WriteHeader(code)
 }
+
+// Unwrap lets http.ResponseController reach the underlying writer, e.g. to
+// flush a streaming response.
+func (w *statusWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -0,0 +1,135 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/config"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/rs/zerolog"
+)
+
+// fakeSessions answers IsSessionActive from a map and records the sessions
+// it was asked about.
+type fakeSessions struct {
+	active  map[string]bool
+	err     error
+	checked []string
+}
+
+func (f *fakeSessions) IsSessionActive(ctx context.Context, sessionID string) (bool, error) {
+	f.checked = append(f.checked, sessionID)
+	if f.err != nil {
+		return false, f.err
+	}
+	return f.active[sessionID], nil
+}
+
+func signedToken(t *testing.T, cfg *config.Config, sid string) string {
+	t.Helper()
+	claims := jwt.MapClaims{
+		"sub": "u1",
+		"iss": cfg.JWT.Issuer,
+		"aud": cfg.JWT.Audience,
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}
+	if sid != "" {
+		claims["sid"] = sid
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(cfg.JWT.Secret))
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	return token
+}
+
+func TestJWTAuthSessions(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.JWT.Secret = "test-secret"
+	cfg.JWT.Issuer = "pms-api"
+	cfg.JWT.Audience = "pms-web"
+
+	tests := []struct {
+		name        string
+		sid         string
+		sessions    *fakeSessions
+		wantStatus  int
+		wantChecked bool
+	}{
+		{
+			name:        "active session",
+			sid:         "s1",
+			sessions:    &fakeSessions{active: map[string]bool{"s1": true}},
+			wantStatus:  http.StatusOK,
+			wantChecked: true,
+		},
+		{
+			name:        "revoked session",
+			sid:         "s1",
+			sessions:    &fakeSessions{active: map[string]bool{"s1": false}},
+			wantStatus:  http.StatusUnauthorized,
+			wantChecked: true,
+		},
+		{
+			name:        "session check fails",
+			sid:         "s1",
+			sessions:    &fakeSessions{err: errors.New("connection refused")},
+			wantStatus:  http.StatusServiceUnavailable,
+			wantChecked: true,
+		},
+		{
+			name:       "token issued before sessions",
+			sid:        "",
+			sessions:   &fakeSessions{},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "no session validator",
+			sid:        "s1",
+			wantStatus: http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var validator SessionValidator
+			if tt.sessions != nil {
+				validator = tt.sessions
+			}
+			stack := New(cfg, zerolog.Nop(), validator)
+
+			var gotUser, gotSession interface{}
+			handler := stack.JWTAuth(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotUser = r.Context().Value(UserIDKey)
+				gotSession = r.Context().Value(SessionIDKey)
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/me", nil)
+			req.Header.Set("Authorization", "Bearer "+signedToken(t, cfg, tt.sid))
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.sessions != nil && (len(tt.sessions.checked) > 0) != tt.wantChecked {
+				t.Errorf("sessions checked = %v, want checked %v", tt.sessions.checked, tt.wantChecked)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			if gotUser != "u1" {
+				t.Errorf("user ID in context = %v, want u1", gotUser)
+			}
+			if tt.sid != "" && gotSession != tt.sid {
+				t.Errorf("session ID in context = %v, want %s", gotSession, tt.sid)
+			}
+			if tt.sid == "" && gotSession != nil {
+				t.Errorf("session ID in context = %v, want none", gotSession)
+			}
+		})
+	}
+}
@@ -1,22 +1,43 @@
 package repository
 
 import (
+	"context"
+	"database/sql/driver"
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/enterprise-pms/pms-api/internal/domain/audit"
 	"github.com/enterprise-pms/pms-api/internal/domain/enums"
 	"github.com/rs/zerolog"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
 )
 
+// auditRulesTTL is how long the audit configuration is cached before it is
+// read again, which is how changes made on another replica arrive here.
+const auditRulesTTL = time.Minute
+
+// pendingAuditKey carries the changes captured before an update to the
+// after-update callback.
+const pendingAuditKey = "audit:pending"
+
 // AuditInterceptor provides GORM callbacks that automatically log entity changes.
 // This mirrors the .NET SaveAuditLog() / UpdateAuditLogRecordId() pattern in SaveChangesAsync.
+// Which tables and columns are logged is driven by pmsaudit.auditable_entities
+// and pmsaudit.auditable_attributes; scores and grades are always logged.
 type AuditInterceptor struct {
 	db  *gorm.DB
 	log zerolog.Logger
+
+	mu       sync.Mutex
+	rules    *auditRules
+	loadedAt time.Time
 }
 
 // NewAuditInterceptor creates an interceptor and registers GORM callbacks.
@@ -28,210 +49,451 @@ func NewAuditInterceptor(db *gorm.DB, log zerolog.Logger) *AuditInterceptor {
 
 func (ai *AuditInterceptor) register() {
 	ai.db.Callback().Create().After("gorm:create").Register("audit:after_create", ai.afterCreate)
+	ai.db.Callback().Update().Before("gorm:update").Register("audit:before_update", ai.beforeUpdate)
 	ai.db.Callback().Update().After("gorm:update").Register("audit:after_update", ai.afterUpdate)
 	ai.db.Callback().Delete().After("gorm:delete").Register("audit:after_delete", ai.afterDelete)
 }
 
-func (ai *AuditInterceptor) afterCreate(db *gorm.DB) {
-	if db.Error != nil || db.Statement == nil || db.Statement.Model == nil {
-		return
+// ReloadRules re-reads the audit configuration. Call it after changing the
+// configuration so this replica applies it immediately; other replicas pick
+// it up within auditRulesTTL.
+func (ai *AuditInterceptor) ReloadRules(ctx context.Context) error {
+	rules, err := loadAuditRules(ctx, NewAuditRepository(ai.db))
+	if err != nil {
+		return err
 	}
-	ai.logAudit(db, enums.AuditEventAdded)
+	ai.mu.Lock()
+	ai.rules, ai.loadedAt = rules, time.Now()
+	ai.mu.Unlock()
+	return nil
 }
 
-func (ai *AuditInterceptor) afterUpdate(db *gorm.DB) {
-	if db.Error != nil || db.Statement == nil || db.Statement.Model == nil {
-		return
+// currentRules returns the cached configuration, reloading it when stale.
+// If reloading fails the previous rules stay in force.
+func (ai *AuditInterceptor) currentRules() *auditRules {
+	ai.mu.Lock()
+	rules, stale := ai.rules, time.Since(ai.loadedAt) > auditRulesTTL
+	ai.mu.Unlock()
+	if !stale {
+		return rules
+	}
+	if err := ai.ReloadRules(context.Background()); err != nil {
+		ai.log.Error().Err(err).Msg("Failed to reload audit configuration")
+		ai.mu.Lock()
+		ai.loadedAt = time.Now() // Retry after the TTL rather than on every write.
+		ai.mu.Unlock()
+		return rules
 	}
-	ai.logAudit(db, enums.AuditEventModified)
+	ai.mu.Lock()
+	defer ai.mu.Unlock()
+	return ai.rules
 }
 
-func (ai *AuditInterceptor) afterDelete(db *gorm.DB) {
-	if db.Error != nil || db.Statement == nil || db.Statement.Model == nil {
+func (ai *AuditInterceptor) afterCreate(db *gorm.DB) {
+	if !ai.audited(db) {
 		return
 	}
-	ai.logAudit(db, enums.AuditEventDeleted)
+	ai.logNewRecords(db)
 }
 
-func (ai *AuditInterceptor) logAudit(db *gorm.DB, eventType enums.AuditEventType) {
-	stmt := db.Statement
-	if stmt.Schema == nil {
+func (ai *AuditInterceptor) beforeUpdate(db *gorm.DB) {
+	if !ai.audited(db) {
 		return
 	}
+	ai.captureUpdate(db)
+}
 
-	tableName := stmt.Schema.Table
-
-	// Skip auditing the audit log table itself to prevent infinite recursion.
-	if tableName == "pmsaudit.audit_logs" {
+func (ai *AuditInterceptor) afterUpdate(db *gorm.DB) {
+	if db.Error != nil || db.Statement == nil {
 		return
 	}
-	model := stmt.Model
-	if model == nil {
+	ai.logModifiedRecord(db)
+}
+
+func (ai *AuditInterceptor) afterDelete(db *gorm.DB) {
+	if !ai.audited(db) {
 		return
 	}
+	ai.logDeletedRecord(db)
+}
+
+// audited reports whether the statement writes to a table the interceptor
+// logs at all.
+func (ai *AuditInterceptor) audited(db *gorm.DB) bool {
+	if db.Error != nil || db.Statement == nil || db.Statement.Schema == nil {
+		return false
+	}
+	// Skip auditing the audit log table itself to prevent infinite recursion.
+	return db.Statement.Schema.Table != audit.AuditLog{}.TableName()
+}
 
-	// Extract the user from context if available
-	username := "SYSTEM"
+// auditUser extracts the user from the statement context, defaulting to SYSTEM.
+func auditUser(db *gorm.DB) string {
 	if ctx := db.Statement.Context; ctx != nil {
 		if u, ok := ctx.Value(auditUserKey{}).(string); ok && u != "" {
-			username = u
+			return u
 		}
 	}
+	return "SYSTEM"
+}
 
+func (ai *AuditInterceptor) logNewRecords(db *gorm.DB) {
+	stmt := db.Statement
+	rules := ai.currentRules()
+	pk := recordIDField(stmt.Schema)
 	now := time.Now().UTC()
+	user := auditUser(db)
 
-	switch eventType {
-	case enums.AuditEventAdded:
-		// Log all non-zero fields for new records
-		ai.logNewRecord(tableName, model, username, now)
+	var entries []audit.AuditLog
+	for _, rv := range statementRecords(stmt.ReflectValue) {
+		recordID := fieldString(stmt.Context, pk, rv)
+		for _, f := range stmt.Schema.Fields {
+			if f.DBName == "" || !rules.fieldAudited(stmt.Schema.Table, stmt.Schema.Name, f.DBName, f.Name) {
+				continue
+			}
+			value, zero := f.ValueOf(stmt.Context, rv)
+			if zero {
+				continue
+			}
+			entries = append(entries, audit.AuditLog{
+				UserName:          user,
+				AuditEventDateUTC: now,
+				AuditEventType:    enums.AuditEventAdded,
+				AuditTableName:    stmt.Schema.Table,
+				RecordID:          recordID,
+				FieldName:         f.DBName,
+				NewValue:          formatAuditValue(value),
+			})
+		}
+	}
+	ai.saveAuditEntries(entries)
+}
 
-	case enums.AuditEventModified:
-		// Log changed fields from GORM's changed map
-		ai.logModifiedRecord(db, tableName, model, username, now)
+// pendingAudit is an update's audited columns and the records it targets,
+// captured before it runs.
+type pendingAudit struct {
+	columns   []string
+	newValues map[string]string
+	records   []pendingRecord
+	user      string
+}
 
-	case enums.AuditEventDeleted:
-		ai.saveAuditEntry(audit.AuditLog{
-			UserName:          username,
-			AuditEventDateUTC: now,
-			AuditEventType:    eventType,
-			AuditTableName:    tableName,
-			RecordID:          extractRecordID(model),
-			FieldName:         "soft_deleted",
-			OriginalValue:     "false",
-			NewValue:          "true",
-		})
-	}
+// pendingRecord is one record an update targets and its audited columns'
+// values before the update.
+type pendingRecord struct {
+	recordID  string
+	oldValues map[string]string
 }
 
-func (ai *AuditInterceptor) logNewRecord(tableName string, model interface{}, username string, now time.Time) {
-	v := reflect.ValueOf(model)
-	if v.Kind() == reflect.Ptr {
-		v = v.Elem()
+// captureUpdate records which audited columns an update assigns, and the
+// current values of every record it targets, for logModifiedRecord. Updates
+// that do not target a record by primary key are resolved to their records
+// through the statement's own WHERE clause; if that fails the update is
+// rejected rather than run unaudited.
+func (ai *AuditInterceptor) captureUpdate(db *gorm.DB) {
+	stmt := db.Statement
+	pk := recordIDField(stmt.Schema)
+	if pk == nil {
+		ai.log.Debug().Str("table", stmt.Schema.Table).Msg("Update target has no primary key, not audited")
+		return
+	}
+
+	rules := ai.currentRules()
+	pending := &pendingAudit{
+		newValues: make(map[string]string),
+		user:      auditUser(db),
+	}
+	for column, value := range updatedValues(stmt) {
+		f := stmt.Schema.LookUpField(column)
+		if f == nil || !rules.fieldAudited(stmt.Schema.Table, stmt.Schema.Name, f.DBName, f.Name) {
+			continue
+		}
+		pending.columns = append(pending.columns, f.DBName)
+		pending.newValues[f.DBName] = formatAuditValue(value)
 	}
-	if v.Kind() != reflect.Struct {
+	if len(pending.columns) == 0 {
 		return
 	}
 
-	recordID := extractRecordID(model)
-	t := v.Type()
+	// Read the current values on the statement's own connection so that
+	// changes made earlier in the same transaction are seen.
+	query := db.Session(&gorm.Session{NewDB: true, SkipHooks: true}).
+		Table(stmt.Schema.Table).
+		Select(append([]string{pk.DBName}, pending.columns...))
+	recordID := statementRecordID(stmt)
+	if recordID != "" {
+		query = query.Where(clause.Eq{Column: clause.Column{Name: pk.DBName}, Value: recordID})
+	} else if c, ok := stmt.Clauses["WHERE"]; ok {
+		where, ok := c.Expression.(clause.Where)
+		if !ok {
+			db.AddError(fmt.Errorf("audit: cannot resolve the records updated in %s", stmt.Schema.Table))
+			return
+		}
+		query = query.Clauses(where)
+	} else {
+		db.AddError(fmt.Errorf("audit: update of %s has no WHERE clause", stmt.Schema.Table))
+		return
+	}
 
-	for i := 0; i < t.NumField(); i++ {
-		field := t.Field(i)
-		if shouldSkipAuditField(field.Name) {
-			continue
+	var current []map[string]interface{}
+	if err := query.Find(&current).Error; err != nil {
+		if recordID == "" {
+			db.AddError(fmt.Errorf("audit: resolving the records updated in %s: %w", stmt.Schema.Table, err))
+			return
 		}
-		fieldVal := v.Field(i)
-		if fieldVal.IsZero() {
-			continue
+		ai.log.Warn().Err(err).Str("table", stmt.Schema.Table).Str("record_id", recordID).Msg("Failed to read original values for audit")
+	}
+	for _, row := range current {
+		record := pendingRecord{
+			recordID:  formatAuditValue(row[pk.DBName]),
+			oldValues: make(map[string]string, len(pending.columns)),
+		}
+		for _, column := range pending.columns {
+			record.oldValues[column] = formatAuditValue(row[column])
 		}
-		newVal := formatValue(fieldVal)
-		ai.saveAuditEntry(audit.AuditLog{
-			UserName:          username,
-			AuditEventDateUTC: now,
-			AuditEventType:    enums.AuditEventAdded,
-			AuditTableName:    tableName,
-			RecordID:          recordID,
-			FieldName:         field.Name,
-			OriginalValue:     "",
-			NewValue:          newVal,
-		})
-	}
-}
-
-func (ai *AuditInterceptor) logModifiedRecord(db *gorm.DB, tableName string, model interface{}, username string, now time.Time) {
-	recordID := extractRecordID(model)
-
-	// GORM stores changed columns in Statement.Changed
-	if db.Statement.Changed() {
-		for col, change := range db.Statement.Clauses {
-			_ = change // iterate changed values
-			ai.saveAuditEntry(audit.AuditLog{
-				UserName:          username,
+		pending.records = append(pending.records, record)
+	}
+	if recordID != "" && len(pending.records) == 0 {
+		pending.records = []pendingRecord{{recordID: recordID}}
+	}
+	db.InstanceSet(pendingAuditKey, pending)
+}
+
+func (ai *AuditInterceptor) logModifiedRecord(db *gorm.DB) {
+	v, ok := db.InstanceGet(pendingAuditKey)
+	if !ok || db.RowsAffected == 0 {
+		return
+	}
+	pending := v.(*pendingAudit)
+	now := time.Now().UTC()
+
+	var entries []audit.AuditLog
+	for _, record := range pending.records {
+		for _, column := range pending.columns {
+			oldValue, newValue := record.oldValues[column], pending.newValues[column]
+			if oldValue == newValue {
+				continue
+			}
+			entries = append(entries, audit.AuditLog{
+				UserName:          pending.user,
 				AuditEventDateUTC: now,
 				AuditEventType:    enums.AuditEventModified,
-				AuditTableName:    tableName,
-				RecordID:          recordID,
-				FieldName:         col,
+				AuditTableName:    db.Statement.Schema.Table,
+				RecordID:          record.recordID,
+				FieldName:         column,
+				OriginalValue:     oldValue,
+				NewValue:          newValue,
 			})
 		}
 	}
+	ai.saveAuditEntries(entries)
+}
+
+func (ai *AuditInterceptor) logDeletedRecord(db *gorm.DB) {
+	stmt := db.Statement
+	if !ai.currentRules().fieldAudited(stmt.Schema.Table, stmt.Schema.Name, "soft_deleted", "SoftDeleted") {
+		return
+	}
+	ai.saveAuditEntries([]audit.AuditLog{{
+		UserName:          auditUser(db),
+		AuditEventDateUTC: time.Now().UTC(),
+		AuditEventType:    enums.AuditEventDeleted,
+		AuditTableName:    stmt.Schema.Table,
+		RecordID:          statementRecordID(stmt),
+		FieldName:         "soft_deleted",
+		OriginalValue:     "false",
+		NewValue:          "true",
+	}})
 }
 
-func (ai *AuditInterceptor) saveAuditEntry(entry audit.AuditLog) {
-	if err := ai.db.Create(&entry).Error; err != nil {
+func (ai *AuditInterceptor) saveAuditEntries(entries []audit.AuditLog) {
+	if len(entries) == 0 {
+		return
+	}
+	if err := ai.db.CreateInBatches(&entries, 100).Error; err != nil {
 		ai.log.Error().Err(err).
-			Str("table", entry.AuditTableName).
-			Str("record_id", entry.RecordID).
+			Str("table", entries[0].AuditTableName).
+			Str("record_id", entries[0].RecordID).
 			Msg("Failed to save audit log entry")
 	}
 }
 
-// extractRecordID gets the primary key value from a model.
-func extractRecordID(model interface{}) string {
-	v := reflect.ValueOf(model)
-	if v.Kind() == reflect.Ptr {
-		v = v.Elem()
+// recordIDField is the field whose value identifies a record in the audit
+// log: the entity's own key (work_product_id) in preference to the
+// auto-increment id every BaseEntity carries.
+func recordIDField(s *schema.Schema) *schema.Field {
+	for _, f := range s.PrimaryFields {
+		if f.DBName != "id" {
+			return f
+		}
 	}
-	if v.Kind() != reflect.Struct {
+	return s.PrioritizedPrimaryField
+}
+
+// statementRecordID identifies the single record a statement writes, from
+// the model's key or else an equality on the key in the WHERE clause. It
+// returns "" when the statement may touch several records.
+func statementRecordID(stmt *gorm.Statement) string {
+	pk := recordIDField(stmt.Schema)
+	if pk == nil {
 		return ""
 	}
-
-	// Try common PK field names
-	for _, pkName := range []string{"ID", "Id"} {
-		f := v.FieldByName(pkName)
-		if f.IsValid() && !f.IsZero() {
-			return fmt.Sprintf("%v", f.Interface())
+	if rv := reflect.Indirect(stmt.ReflectValue); rv.Kind() == reflect.Struct {
+		if id := fieldString(stmt.Context, pk, rv); id != "" {
+			return id
 		}
 	}
 
-	// Scan for any field ending in "ID" that is tagged as primaryKey
-	t := v.Type()
-	for i := 0; i < t.NumField(); i++ {
-		tag := t.Field(i).Tag.Get("gorm")
-		if contains(tag, "primaryKey") {
-			return fmt.Sprintf("%v", v.Field(i).Interface())
+	c, ok := stmt.Clauses["WHERE"]
+	if !ok {
+		return ""
+	}
+	where, ok := c.Expression.(clause.Where)
+	if !ok {
+		return ""
+	}
+	for _, expr := range where.Exprs {
+		switch e := expr.(type) {
+		case clause.Eq:
+			if columnName(e.Column) == pk.DBName {
+				return formatAuditValue(e.Value)
+			}
+		case clause.Expr:
+			sql := strings.ReplaceAll(strings.ToLower(e.SQL), `"`, "")
+			if len(e.Vars) == 1 && strings.Join(strings.Fields(sql), " ") == pk.DBName+" = ?" {
+				return formatAuditValue(e.Vars[0])
+			}
 		}
 	}
 	return ""
 }
 
-func contains(s, substr string) bool {
-	return len(s) >= len(substr) && searchString(s, substr)
+func columnName(column interface{}) string {
+	switch c := column.(type) {
+	case string:
+		return c
+	case clause.Column:
+		return c.Name
+	}
+	return ""
+}
+
+// statementRecords returns the struct values a create statement wrote.
+func statementRecords(rv reflect.Value) []reflect.Value {
+	rv = reflect.Indirect(rv)
+	switch rv.Kind() {
+	case reflect.Struct:
+		return []reflect.Value{rv}
+	case reflect.Slice, reflect.Array:
+		records := make([]reflect.Value, 0, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			if elem := reflect.Indirect(rv.Index(i)); elem.Kind() == reflect.Struct {
+				records = append(records, elem)
+			}
+		}
+		return records
+	}
+	return nil
 }
 
-func searchString(s, substr string) bool {
-	for i := 0; i <= len(s)-len(substr); i++ {
-		if s[i:i+len(substr)] == substr {
-			return true
+// updatedValues returns the values an update assigns, keyed by column or
+// field name. Struct updates only assign non-zero fields.
+func updatedValues(stmt *gorm.Statement) map[string]interface{} {
+	switch dest := stmt.Dest.(type) {
+	case map[string]interface{}:
+		return dest
+	case *map[string]interface{}:
+		return *dest
+	}
+	rv := reflect.Indirect(reflect.ValueOf(stmt.Dest))
+	if rv.Kind() != reflect.Struct {
+		return nil
+	}
+	values := make(map[string]interface{})
+	for _, f := range stmt.Schema.Fields {
+		if f.DBName == "" {
+			continue
+		}
+		if value, zero := f.ValueOf(stmt.Context, rv); !zero {
+			values[f.DBName] = value
 		}
 	}
-	return false
+	return values
 }
 
-func shouldSkipAuditField(name string) bool {
-	skip := map[string]bool{
-		"ID": true, "Id": true, "CreatedAt": true, "UpdatedAt": true,
-		"CreatedBy": true, "UpdatedBy": true, "SoftDeleted": true,
-		"DateCreated": true, "DateUpdated": true, "RecordStatus": true,
+func fieldString(ctx context.Context, f *schema.Field, rv reflect.Value) string {
+	if f == nil {
+		return ""
+	}
+	value, zero := f.ValueOf(ctx, rv)
+	if zero {
+		return ""
 	}
-	return skip[name]
+	return formatAuditValue(value)
 }
 
-func formatValue(v reflect.Value) string {
-	if !v.IsValid() || v.IsZero() {
+// formatAuditValue renders a column value the way it is stored, so that
+// values written by the application compare equal to values read back:
+// enums as their number, times in RFC 3339 UTC.
+func formatAuditValue(v interface{}) string {
+	if v == nil {
 		return ""
 	}
-	switch v.Kind() {
-	case reflect.Struct, reflect.Map, reflect.Slice:
-		b, err := json.Marshal(v.Interface())
+	if e, ok := v.(clause.Expr); ok {
+		return e.SQL
+	}
+	if valuer, ok := v.(driver.Valuer); ok {
+		dv, err := valuer.Value()
+		if err != nil || dv == nil {
+			return ""
+		}
+		v = dv
+	}
+
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return ""
+		}
+		rv = rv.Elem()
+	}
+	if t, ok := rv.Interface().(time.Time); ok {
+		if t.IsZero() {
+			return ""
+		}
+		return t.UTC().Format(time.RFC3339)
+	}
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'f', -1, 64)
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool())
+	case reflect.String:
+		return rv.String()
+	case reflect.Slice:
+		if b, ok := rv.Interface().([]byte); ok {
+			return string(b)
+		}
+	}
+	if rv.Kind() == reflect.Struct || rv.Kind() == reflect.Map || rv.Kind() == reflect.Slice {
+		b, err := json.Marshal(rv.Interface())
 		if err != nil {
-			return fmt.Sprintf("%v", v.Interface())
+			return fmt.Sprintf("%v", rv.Interface())
 		}
 		return string(b)
-	default:
-		return fmt.Sprintf("%v", v.Interface())
 	}
+	return fmt.Sprintf("%v", rv.Interface())
 }
 
 // auditUserKey is a context key for passing the current user to audit callbacks.
 type auditUserKey struct{}
+
+// WithAuditUser returns a context whose writes are attributed to user in the
+// audit log. Pass the result to db.WithContext.
+func WithAuditUser(ctx context.Context, user string) context.Context {
+	return context.WithValue(ctx, auditUserKey{}, user)
+}
@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/audit"
+	"gorm.io/gorm"
+)
+
+// AuditRepository reads the field-level change history in
+// pmsaudit.audit_logs and manages the audit configuration in
+// pmsaudit.auditable_entities and pmsaudit.auditable_attributes.
+type AuditRepository struct {
+	db *gorm.DB
+}
+
+// NewAuditRepository creates a new audit repository.
+func NewAuditRepository(db *gorm.DB) *AuditRepository {
+	return &AuditRepository{db: db}
+}
+
+// ListByRecord returns the audit rows of one record in the order they were
+// written. A non-nil until excludes rows written after it.
+func (r *AuditRepository) ListByRecord(ctx context.Context, tableName, recordID string, until *time.Time) ([]audit.AuditLog, error) {
+	var logs []audit.AuditLog
+	q := r.db.WithContext(ctx).
+		Where("table_name = ? AND record_id = ? AND soft_deleted = ?", tableName, recordID, false)
+	if until != nil {
+		q = q.Where("audit_event_date_utc <= ?", *until)
+	}
+	if err := q.Order("audit_event_date_utc, id").Find(&logs).Error; err != nil {
+		return nil, fmt.Errorf("auditRepo.ListByRecord: %w", err)
+	}
+	return logs, nil
+}
+
+// ListAuditableEntities returns the entity-level audit configuration.
+func (r *AuditRepository) ListAuditableEntities(ctx context.Context) ([]audit.AuditableEntity, error) {
+	var entities []audit.AuditableEntity
+	if err := r.db.WithContext(ctx).Where("soft_deleted = ?", false).Order("entity_name").Find(&entities).Error; err != nil {
+		return nil, fmt.Errorf("auditRepo.ListAuditableEntities: %w", err)
+	}
+	return entities, nil
+}
+
+// ListAuditableAttributes returns the attribute-level audit configuration.
+func (r *AuditRepository) ListAuditableAttributes(ctx context.Context) ([]audit.AuditableAttribute, error) {
+	var attributes []audit.AuditableAttribute
+	if err := r.db.WithContext(ctx).Where("soft_deleted = ?", false).Order("attribute_name").Find(&attributes).Error; err != nil {
+		return nil, fmt.Errorf("auditRepo.ListAuditableAttributes: %w", err)
+	}
+	return attributes, nil
+}
+
+// GetAuditableEntity returns an entity's configuration, or nil when it has
+// none.
+func (r *AuditRepository) GetAuditableEntity(ctx context.Context, entityName string) (*audit.AuditableEntity, error) {
+	var entity audit.AuditableEntity
+	err := r.db.WithContext(ctx).Where("entity_name = ? AND soft_deleted = ?", entityName, false).Take(&entity).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("auditRepo.GetAuditableEntity: %w", err)
+	}
+	return &entity, nil
+}
+
+// SaveAuditableEntity creates an entity's configuration or updates its
+// audit flag, and returns the stored row.
+func (r *AuditRepository) SaveAuditableEntity(ctx context.Context, entityName string, enabled bool, user string) (*audit.AuditableEntity, error) {
+	existing, err := r.GetAuditableEntity(ctx, entityName)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		entity := audit.AuditableEntity{EntityName: entityName, EnableAudit: enabled}
+		entity.CreatedBy = user
+		if err := r.db.WithContext(ctx).Create(&entity).Error; err != nil {
+			return nil, fmt.Errorf("auditRepo.SaveAuditableEntity: %w", err)
+		}
+		return &entity, nil
+	}
+	if existing.EnableAudit == enabled {
+		return existing, nil
+	}
+	err = r.db.WithContext(ctx).Model(&audit.AuditableEntity{}).
+		Where("entity_name = ?", entityName).
+		Updates(map[string]interface{}{"enable_audit": enabled, "updated_by": user}).Error
+	if err != nil {
+		return nil, fmt.Errorf("auditRepo.SaveAuditableEntity: %w", err)
+	}
+	existing.EnableAudit = enabled
+	return existing, nil
+}
+
+// SaveAuditableAttribute creates an attribute rule under entityID or
+// updates its audit flag.
+func (r *AuditRepository) SaveAuditableAttribute(ctx context.Context, entityID int, attributeName string, enabled bool, user string) error {
+	var existing audit.AuditableAttribute
+	err := r.db.WithContext(ctx).
+		Where("auditable_entity_id = ? AND attribute_name = ? AND soft_deleted = ?", entityID, attributeName, false).
+		Take(&existing).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		attribute := audit.AuditableAttribute{AuditableEntityID: entityID, AttributeName: attributeName, EnableAudit: enabled}
+		attribute.CreatedBy = user
+		if err := r.db.WithContext(ctx).Create(&attribute).Error; err != nil {
+			return fmt.Errorf("auditRepo.SaveAuditableAttribute: %w", err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("auditRepo.SaveAuditableAttribute: %w", err)
+	}
+	if existing.EnableAudit == enabled {
+		return nil
+	}
+	err = r.db.WithContext(ctx).Model(&audit.AuditableAttribute{}).
+		Where("id = ?", existing.ID).
+		Updates(map[string]interface{}{"enable_audit": enabled, "updated_by": user}).Error
+	if err != nil {
+		return fmt.Errorf("auditRepo.SaveAuditableAttribute: %w", err)
+	}
+	return nil
+}
+
+// DeleteAuditableAttribute soft-deletes an attribute rule so the entity's
+// flag applies to the attribute again. It reports whether a rule existed.
+func (r *AuditRepository) DeleteAuditableAttribute(ctx context.Context, entityID int, attributeName, user string) (bool, error) {
+	res := r.db.WithContext(ctx).Model(&audit.AuditableAttribute{}).
+		Where("auditable_entity_id = ? AND attribute_name = ? AND soft_deleted = ?", entityID, attributeName, false).
+		Updates(map[string]interface{}{"soft_deleted": true, "updated_by": user})
+	if res.Error != nil {
+		return false, fmt.Errorf("auditRepo.DeleteAuditableAttribute: %w", res.Error)
+	}
+	return res.RowsAffected > 0, nil
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/audit"
+)
+
+// auditRules is the audit configuration from pmsaudit.auditable_entities and
+// pmsaudit.auditable_attributes, keyed by lower-cased name. An entity is
+// matched by table name (pms.work_products) or model name (WorkProduct); an
+// attribute by column name (work_product_status) or field name
+// (WorkProductStatus).
+type auditRules struct {
+	entities map[string]*auditEntityRule
+}
+
+type auditEntityRule struct {
+	enabled    bool
+	attributes map[string]bool
+}
+
+// defaultSkippedColumns are bookkeeping columns left out of the audit log
+// unless an attribute rule enables them.
+var defaultSkippedColumns = map[string]bool{
+	"id": true, "created_at": true, "updated_at": true, "created_by": true,
+	"updated_by": true, "date_created": true, "date_updated": true,
+}
+
+// AlwaysAudited reports whether a column holds a score or grade. These are
+// audited whatever the configuration says.
+func AlwaysAudited(column string) bool {
+	c := strings.ToLower(column)
+	if strings.HasSuffix(c, "_id") {
+		return false
+	}
+	return strings.Contains(c, "score") || strings.Contains(c, "grade")
+}
+
+func newAuditRules(entities []audit.AuditableEntity, attributes []audit.AuditableAttribute) *auditRules {
+	r := &auditRules{entities: make(map[string]*auditEntityRule, len(entities))}
+	byID := make(map[int]*auditEntityRule, len(entities))
+	for _, e := range entities {
+		rule := &auditEntityRule{enabled: e.EnableAudit, attributes: make(map[string]bool)}
+		r.entities[strings.ToLower(e.EntityName)] = rule
+		byID[e.ID] = rule
+	}
+	for _, a := range attributes {
+		if rule, ok := byID[a.AuditableEntityID]; ok {
+			rule.attributes[strings.ToLower(a.AttributeName)] = a.EnableAudit
+		}
+	}
+	return r
+}
+
+// loadAuditRules reads the active audit configuration.
+func loadAuditRules(ctx context.Context, repo *AuditRepository) (*auditRules, error) {
+	entities, err := repo.ListAuditableEntities(ctx)
+	if err != nil {
+		return nil, err
+	}
+	attributes, err := repo.ListAuditableAttributes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newAuditRules(entities, attributes), nil
+}
+
+func (r *auditRules) entity(table, model string) *auditEntityRule {
+	if r == nil {
+		return nil
+	}
+	if rule, ok := r.entities[strings.ToLower(table)]; ok {
+		return rule
+	}
+	return r.entities[strings.ToLower(model)]
+}
+
+// fieldAudited decides whether a change to one column is logged. Scores and
+// grades always are; otherwise an attribute rule wins, then the default
+// skip list, then the entity's flag. Unconfigured entities are audited.
+func (r *auditRules) fieldAudited(table, model, column, field string) bool {
+	if AlwaysAudited(column) {
+		return true
+	}
+	rule := r.entity(table, model)
+	if rule != nil {
+		if enabled, ok := rule.attributes[strings.ToLower(column)]; ok {
+			return enabled
+		}
+		if enabled, ok := rule.attributes[strings.ToLower(field)]; ok {
+			return enabled
+		}
+	}
+	if defaultSkippedColumns[column] {
+		return false
+	}
+	return rule == nil || rule.enabled
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/domain"
+	"github.com/enterprise-pms/pms-api/internal/domain/audit"
+	"github.com/enterprise-pms/pms-api/internal/domain/enums"
+)
+
+func TestAuditRulesFieldAudited(t *testing.T) {
+	rules := newAuditRules(
+		[]audit.AuditableEntity{
+			{BaseEntity: domain.BaseEntity{ID: 1}, EntityName: "pms.feedback_request_logs", EnableAudit: false},
+			{BaseEntity: domain.BaseEntity{ID: 2}, EntityName: "WorkProduct", EnableAudit: true},
+		},
+		[]audit.AuditableAttribute{
+			{AuditableEntityID: 1, AttributeName: "comment", EnableAudit: true},
+			{AuditableEntityID: 2, AttributeName: "Description", EnableAudit: false},
+			{AuditableEntityID: 2, AttributeName: "updated_by", EnableAudit: true},
+		},
+	)
+
+	tests := []struct {
+		name                        string
+		table, model, column, field string
+		want                        bool
+	}{
+		{"unconfigured entity", "pms.period_objectives", "PeriodObjective", "name", "Name", true},
+		{"unconfigured bookkeeping column", "pms.period_objectives", "PeriodObjective", "updated_by", "UpdatedBy", false},
+		{"status change", "pms.period_objectives", "PeriodObjective", "record_status", "RecordStatus", true},
+		{"disabled entity", "pms.feedback_request_logs", "FeedbackRequestLog", "time_initiated", "TimeInitiated", false},
+		{"enabled attribute on disabled entity", "pms.feedback_request_logs", "FeedbackRequestLog", "comment", "Comment", true},
+		{"entity matched by model name", "pms.work_products", "WorkProduct", "name", "Name", true},
+		{"disabled attribute matched by field name", "pms.work_products", "WorkProduct", "description", "Description", false},
+		{"attribute overrides skip list", "pms.work_products", "WorkProduct", "updated_by", "UpdatedBy", true},
+		{"grade on disabled entity", "pms.feedback_request_logs", "FeedbackRequestLog", "final_grade", "FinalGrade", true},
+		{"score on disabled entity", "pms.feedback_request_logs", "FeedbackRequestLog", "total_outcome_score", "TotalOutcomeScore", true},
+		{"score key is not a score", "pms.feedback_request_logs", "FeedbackRequestLog", "period_score_id", "PeriodScoreID", false},
+	}
+	for _, tt := range tests {
+		if got := rules.fieldAudited(tt.table, tt.model, tt.column, tt.field); got != tt.want {
+			t.Errorf("%s: fieldAudited(%s, %s) = %v, want %v", tt.name, tt.table, tt.column, got, tt.want)
+		}
+	}
+
+	var none *auditRules
+	if !none.fieldAudited("pms.work_products", "WorkProduct", "name", "Name") {
+		t.Error("without loaded rules every non-bookkeeping column should be audited")
+	}
+}
+
+func TestFormatAuditValue(t *testing.T) {
+	at := time.Date(2026, 3, 1, 10, 30, 0, 0, time.FixedZone("WAT", 3600))
+	var nilTime *time.Time
+	tests := []struct {
+		value interface{}
+		want  string
+	}{
+		{nil, ""},
+		{"draft", "draft"},
+		{42, "42"},
+		{int64(7), "7"},
+		{3.5, "3.5"},
+		{true, "true"},
+		{enums.AuditEventModified, "3"},
+		{at, "2026-03-01T09:30:00Z"},
+		{&at, "2026-03-01T09:30:00Z"},
+		{nilTime, ""},
+		{[]byte("raw"), "raw"},
+	}
+	for _, tt := range tests {
+		if got := formatAuditValue(tt.value); got != tt.want {
+			t.Errorf("formatAuditValue(%#v) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
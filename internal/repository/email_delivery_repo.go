@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"gorm.io/gorm"
+)
+
+// EmailDeliveryRepository provides data access for the mail sender's
+// per-attempt delivery history in pms.email_delivery_attempts.
+type EmailDeliveryRepository struct {
+	db *gorm.DB
+}
+
+// NewEmailDeliveryRepository creates a new email delivery repository.
+func NewEmailDeliveryRepository(db *gorm.DB) *EmailDeliveryRepository {
+	return &EmailDeliveryRepository{db: db}
+}
+
+// RecordAttempt appends an attempt to an email's delivery history.
+func (r *EmailDeliveryRepository) RecordAttempt(ctx context.Context, attempt *performance.EmailDeliveryAttempt) error {
+	if err := r.db.WithContext(ctx).Create(attempt).Error; err != nil {
+		return fmt.Errorf("emailDeliveryRepo.RecordAttempt: %w", err)
+	}
+	return nil
+}
+
+// ListByEmail returns the delivery attempts of an email, newest first.
+func (r *EmailDeliveryRepository) ListByEmail(ctx context.Context, emailID int) ([]performance.EmailDeliveryAttempt, error) {
+	var attempts []performance.EmailDeliveryAttempt
+	err := r.db.WithContext(ctx).
+		Where("email_id = ?", emailID).
+		Order("attempted_at DESC, email_delivery_attempt_id DESC").
+		Find(&attempts).Error
+	if err != nil {
+		return nil, fmt.Errorf("emailDeliveryRepo.ListByEmail: %w", err)
+	}
+	return attempts, nil
+}
+
+// LatestByEmails returns the most recent attempt of each email that has
+// one, keyed by email ID.
+func (r *EmailDeliveryRepository) LatestByEmails(ctx context.Context, emailIDs []int) (map[int]performance.EmailDeliveryAttempt, error) {
+	result := make(map[int]performance.EmailDeliveryAttempt, len(emailIDs))
+	if len(emailIDs) == 0 {
+		return result, nil
+	}
+	var attempts []performance.EmailDeliveryAttempt
+	err := r.db.WithContext(ctx).
+		Raw(`SELECT DISTINCT ON (email_id) * FROM pms.email_delivery_attempts
+			 WHERE email_id IN ? ORDER BY email_id, attempted_at DESC, email_delivery_attempt_id DESC`, emailIDs).
+		Scan(&attempts).Error
+	if err != nil {
+		return nil, fmt.Errorf("emailDeliveryRepo.LatestByEmails: %w", err)
+	}
+	for _, a := range attempts {
+		result[a.EmailID] = a
+	}
+	return result, nil
+}
@@ -82,9 +82,9 @@ func (r *EmailRepository) MarkEmailProcessing(ctx context.Context, id int) error
 		return fmt.Errorf("emailRepo.MarkEmailProcessing: Email database not configured")
 	}
 	result, err := r.db.ExecContext(ctx,
-		`UPDATE dbo.EmailObjects SET Status = 'Processing', LastUpdatedDate = @p1
-		 WHERE Id = @p2 AND Status = 'New'`,
-		time.Now().UTC(), id)
+		`UPDATE dbo.EmailObjects SET Status = @p1, LastUpdatedDate = @p2
+		 WHERE Id = @p3 AND Status = @p4`,
+		erp.EmailStatusProcessing, time.Now().UTC(), id, erp.EmailStatusNew)
 	if err != nil {
 		return fmt.Errorf("emailRepo.MarkEmailProcessing: %w", err)
 	}
@@ -109,3 +109,86 @@ func (r *EmailRepository) UpdateEmailStatus(ctx context.Context, id int, status
 	}
 	return nil
 }
+
+// RecordFailedAttempt counts a failed delivery attempt and moves the email to
+// status. When retryIn is positive the email is due again that long from now,
+// measured on the database clock that GetNewEmails compares against.
+func (r *EmailRepository) RecordFailedAttempt(ctx context.Context, id int, status string, retryIn time.Duration) error {
+	if r.db == nil {
+		return fmt.Errorf("emailRepo.RecordFailedAttempt: Email database not configured")
+	}
+	query := `UPDATE dbo.EmailObjects SET Status = @p1, NoOfRetry = NoOfRetry + 1, LastUpdatedDate = @p2
+		WHERE Id = @p3`
+	args := []interface{}{status, time.Now().UTC(), id}
+	if retryIn > 0 {
+		query = `UPDATE dbo.EmailObjects SET Status = @p1, NoOfRetry = NoOfRetry + 1, LastUpdatedDate = @p2,
+			ExpectedSendDate = DATEADD(second, @p4, GETDATE())
+			WHERE Id = @p3`
+		args = append(args, int(retryIn/time.Second))
+	}
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("emailRepo.RecordFailedAttempt: %w", err)
+	}
+	return nil
+}
+
+// undeliveredFilter matches failed and bounced emails and emails left
+// Processing since before @p1, which a crashed sender never finished.
+const undeliveredFilter = `(Status IN ('Failed', 'Bounced') OR (Status = 'Processing' AND LastUpdatedDate < @p1))`
+
+// ListUndelivered returns a page of undelivered emails, most recently
+// updated first, without their bodies, and the total number of them.
+func (r *EmailRepository) ListUndelivered(ctx context.Context, stuckBefore time.Time, skip, take int) ([]erp.EmailObject, int, error) {
+	if r.db == nil {
+		return nil, 0, fmt.Errorf("emailRepo.ListUndelivered: Email database not configured")
+	}
+	var total int
+	if err := r.db.GetContext(ctx, &total,
+		`SELECT COUNT(*) FROM dbo.EmailObjects WHERE `+undeliveredFilter, stuckBefore); err != nil {
+		return nil, 0, fmt.Errorf("emailRepo.ListUndelivered: %w", err)
+	}
+	var results []erp.EmailObject
+	err := r.db.SelectContext(ctx, &results,
+		`SELECT Id, [From], [To], CC, BCC, Subject, Status, NoOfRetry, ExpectedSendDate, ActualSendDate,
+			Action, AppSource, CreatedBy, DateCreated, LastUpdatedBy, LastUpdatedDate, EmailGuid
+		 FROM dbo.EmailObjects WHERE `+undeliveredFilter+`
+		 ORDER BY LastUpdatedDate DESC, Id DESC
+		 OFFSET @p2 ROWS FETCH NEXT @p3 ROWS ONLY`, stuckBefore, skip, take)
+	if err != nil {
+		return nil, 0, fmt.Errorf("emailRepo.ListUndelivered: %w", err)
+	}
+	return results, total, nil
+}
+
+// GetEmailByID returns an email, or nil when it does not exist.
+func (r *EmailRepository) GetEmailByID(ctx context.Context, id int) (*erp.EmailObject, error) {
+	if r.db == nil {
+		return nil, fmt.Errorf("emailRepo.GetEmailByID: Email database not configured")
+	}
+	var results []erp.EmailObject
+	if err := r.db.SelectContext(ctx, &results, `SELECT * FROM dbo.EmailObjects WHERE Id = @p1`, id); err != nil {
+		return nil, fmt.Errorf("emailRepo.GetEmailByID: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, nil
+	}
+	return &results[0], nil
+}
+
+// Requeue makes an undelivered email due now with a fresh attempt budget.
+// It reports false when the email is not failed, bounced or stuck.
+func (r *EmailRepository) Requeue(ctx context.Context, id int, stuckBefore time.Time, requestedBy string) (bool, error) {
+	if r.db == nil {
+		return false, fmt.Errorf("emailRepo.Requeue: Email database not configured")
+	}
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE dbo.EmailObjects SET Status = @p2, NoOfRetry = 0, ExpectedSendDate = NULL,
+		 LastUpdatedBy = @p3, LastUpdatedDate = @p4
+		 WHERE Id = @p5 AND `+undeliveredFilter,
+		stuckBefore, erp.EmailStatusNew, requestedBy, time.Now().UTC(), id)
+	if err != nil {
+		return false, fmt.Errorf("emailRepo.Requeue: %w", err)
+	}
+	rows, _ := result.RowsAffected()
+	return rows > 0, nil
+}
@@ -44,6 +44,35 @@ func (r *ErpRepository) GetEmployeeByID(ctx context.Context, employeeID string)
 	return &emp, nil
 }
 
+// erpMaxParams keeps IN lists below SQL Server's 2100-parameter limit.
+const erpMaxParams = 1000
+
+// GetEmployeesByIDs retrieves the employees with the given employee numbers,
+// querying in batches. Numbers with no employee are left out.
+func (r *ErpRepository) GetEmployeesByIDs(ctx context.Context, employeeIDs []string) ([]erp.EmployeeDetails, error) {
+	if r.db == nil {
+		return nil, fmt.Errorf("erpRepo.GetEmployeesByIDs: ERP database not configured")
+	}
+	var result []erp.EmployeeDetails
+	for start := 0; start < len(employeeIDs); start += erpMaxParams {
+		batch := employeeIDs[start:min(start+erpMaxParams, len(employeeIDs))]
+		params := make([]string, len(batch))
+		args := make([]interface{}, len(batch))
+		for i, id := range batch {
+			params[i] = "@p" + strconv.Itoa(i+1)
+			args[i] = id
+		}
+		var emps []erp.EmployeeDetails
+		err := r.db.SelectContext(ctx, &emps,
+			`SELECT * FROM dbo.EmployeeDetails WHERE EmployeeNumber IN (`+strings.Join(params, ", ")+`)`, args...)
+		if err != nil {
+			return nil, fmt.Errorf("erpRepo.GetEmployeesByIDs: %w", err)
+		}
+		result = append(result, emps...)
+	}
+	return result, nil
+}
+
 // GetEmployeeByUserName retrieves a single employee by username.
 func (r *ErpRepository) GetEmployeeByUserName(ctx context.Context, userName string) (*erp.EmployeeDetails, error) {
 	if r.db == nil {
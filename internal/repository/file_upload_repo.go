@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"gorm.io/gorm"
+)
+
+// FileUploadRepository provides data access for stored file metadata in
+// pms.file_uploads.
+type FileUploadRepository struct {
+	db *gorm.DB
+}
+
+// NewFileUploadRepository creates a new file upload repository.
+func NewFileUploadRepository(db *gorm.DB) *FileUploadRepository {
+	return &FileUploadRepository{db: db}
+}
+
+func (r *FileUploadRepository) base(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).Where("soft_deleted = ?", false)
+}
+
+// Create inserts the metadata for a newly stored file.
+func (r *FileUploadRepository) Create(ctx context.Context, upload *performance.FileUpload) error {
+	if err := r.db.WithContext(ctx).Create(upload).Error; err != nil {
+		return fmt.Errorf("fileUploadRepo.Create: %w", err)
+	}
+	return nil
+}
+
+// GetByID returns a file upload, or nil when it does not exist.
+func (r *FileUploadRepository) GetByID(ctx context.Context, fileUploadID string) (*performance.FileUpload, error) {
+	var upload performance.FileUpload
+	err := r.base(ctx).First(&upload, "file_upload_id = ?", fileUploadID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("fileUploadRepo.GetByID: %w", err)
+	}
+	return &upload, nil
+}
@@ -0,0 +1,236 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/enums"
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// JobRepository provides data access for the persistent background job
+// queue. Claims use FOR UPDATE SKIP LOCKED so any number of workers, in any
+// number of processes, can poll the same table without double-processing.
+type JobRepository struct {
+	db *gorm.DB
+}
+
+// NewJobRepository creates a new background job repository.
+func NewJobRepository(db *gorm.DB) *JobRepository {
+	return &JobRepository{db: db}
+}
+
+func (r *JobRepository) base(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).Where("soft_deleted = ?", false)
+}
+
+// pendingStatuses are the states in which a job still holds its idempotency key.
+var pendingStatuses = []enums.BackgroundJobStatus{
+	enums.BackgroundJobStatusEnqueued,
+	enums.BackgroundJobStatusProcessing,
+	enums.BackgroundJobStatusAwaitingRetry,
+}
+
+// Enqueue inserts job. When a pending job already holds the same idempotency
+// key nothing is inserted, job is overwritten with the existing row and
+// created is false.
+func (r *JobRepository) Enqueue(ctx context.Context, job *performance.BackgroundJob) (bool, error) {
+	res := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(job)
+	if res.Error != nil {
+		return false, fmt.Errorf("jobRepo.Enqueue: %w", res.Error)
+	}
+	if res.RowsAffected > 0 || job.IdempotencyKey == "" {
+		return true, nil
+	}
+
+	existing, err := r.GetPendingByIdempotencyKey(ctx, job.IdempotencyKey)
+	if err != nil {
+		return false, fmt.Errorf("jobRepo.Enqueue: %w", err)
+	}
+	*job = *existing
+	return false, nil
+}
+
+// GetPendingByIdempotencyKey returns the pending job holding key.
+func (r *JobRepository) GetPendingByIdempotencyKey(ctx context.Context, key string) (*performance.BackgroundJob, error) {
+	var job performance.BackgroundJob
+	err := r.base(ctx).
+		Where("idempotency_key = ? AND job_status IN ?", key, pendingStatuses).
+		First(&job).Error
+	if err != nil {
+		return nil, fmt.Errorf("jobRepo.GetPendingByIdempotencyKey: %w", err)
+	}
+	return &job, nil
+}
+
+// ClaimNext locks the oldest due job for workerID until now+lease and counts
+// the attempt. Jobs whose previous lease expired (their worker died) are
+// claimed again. It returns nil when no job is due.
+func (r *JobRepository) ClaimNext(ctx context.Context, workerID string, now time.Time, lease time.Duration) (*performance.BackgroundJob, error) {
+	var job performance.BackgroundJob
+	res := r.db.WithContext(ctx).Raw(`
+		UPDATE pms.background_jobs
+		SET job_status = ?, attempts = attempts + 1, locked_by = ?, locked_until = ?,
+		    started_at = ?, updated_at = ?
+		WHERE background_job_id = (
+			SELECT background_job_id FROM pms.background_jobs
+			WHERE soft_deleted = FALSE
+			  AND ((job_status IN (?, ?) AND run_at <= ?)
+			       OR (job_status = ? AND locked_until < ?))
+			ORDER BY run_at, id
+			FOR UPDATE SKIP LOCKED
+			LIMIT 1
+		)
+		RETURNING *`,
+		enums.BackgroundJobStatusProcessing, workerID, now.Add(lease), now, now,
+		enums.BackgroundJobStatusEnqueued, enums.BackgroundJobStatusAwaitingRetry, now,
+		enums.BackgroundJobStatusProcessing, now,
+	).Scan(&job)
+	if res.Error != nil {
+		return nil, fmt.Errorf("jobRepo.ClaimNext: %w", res.Error)
+	}
+	if res.RowsAffected == 0 || job.BackgroundJobID == "" {
+		return nil, nil
+	}
+	return &job, nil
+}
+
+// MarkSucceeded completes a job still held by workerID.
+func (r *JobRepository) MarkSucceeded(ctx context.Context, jobID, workerID string, now time.Time) error {
+	err := r.db.WithContext(ctx).Model(&performance.BackgroundJob{}).
+		Where("background_job_id = ? AND locked_by = ? AND job_status = ?", jobID, workerID, enums.BackgroundJobStatusProcessing).
+		Updates(map[string]interface{}{
+			"job_status":   enums.BackgroundJobStatusSucceeded,
+			"locked_until": nil,
+			"completed_at": now,
+			"last_error":   "",
+		}).Error
+	if err != nil {
+		return fmt.Errorf("jobRepo.MarkSucceeded: %w", err)
+	}
+	return nil
+}
+
+// MarkFailed records a failed attempt of a job still held by workerID. A nil
+// retryAt dead-letters the job; otherwise it is scheduled to run again.
+func (r *JobRepository) MarkFailed(ctx context.Context, jobID, workerID, lastError string, now time.Time, retryAt *time.Time) error {
+	updates := map[string]interface{}{
+		"job_status":   enums.BackgroundJobStatusDeadLettered,
+		"locked_until": nil,
+		"last_error":   lastError,
+		"completed_at": now,
+	}
+	if retryAt != nil {
+		updates["job_status"] = enums.BackgroundJobStatusAwaitingRetry
+		updates["run_at"] = *retryAt
+		updates["completed_at"] = nil
+	}
+	err := r.db.WithContext(ctx).Model(&performance.BackgroundJob{}).
+		Where("background_job_id = ? AND locked_by = ? AND job_status = ?", jobID, workerID, enums.BackgroundJobStatusProcessing).
+		Updates(updates).Error
+	if err != nil {
+		return fmt.Errorf("jobRepo.MarkFailed: %w", err)
+	}
+	return nil
+}
+
+// GetByID returns a job, or nil when it does not exist.
+func (r *JobRepository) GetByID(ctx context.Context, jobID string) (*performance.BackgroundJob, error) {
+	var job performance.BackgroundJob
+	err := r.base(ctx).First(&job, "background_job_id = ?", jobID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("jobRepo.GetByID: %w", err)
+	}
+	return &job, nil
+}
+
+// List returns a page of jobs, most recently scheduled first, and the total
+// number of jobs matching the filters. A zero status or empty jobType is
+// not filtered on.
+func (r *JobRepository) List(ctx context.Context, status enums.BackgroundJobStatus, jobType string, offset, limit int) ([]performance.BackgroundJob, int64, error) {
+	q := r.base(ctx).Model(&performance.BackgroundJob{})
+	if status != 0 {
+		q = q.Where("job_status = ?", status)
+	}
+	if jobType != "" {
+		q = q.Where("job_type = ?", jobType)
+	}
+
+	var total int64
+	if err := q.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("jobRepo.List: %w", err)
+	}
+	var jobs []performance.BackgroundJob
+	if err := q.Order("run_at DESC, id DESC").Offset(offset).Limit(limit).Find(&jobs).Error; err != nil {
+		return nil, 0, fmt.Errorf("jobRepo.List: %w", err)
+	}
+	return jobs, total, nil
+}
+
+// CountByStatus returns the number of jobs in each status.
+func (r *JobRepository) CountByStatus(ctx context.Context) (map[enums.BackgroundJobStatus]int, error) {
+	var rows []struct {
+		JobStatus enums.BackgroundJobStatus
+		Total     int
+	}
+	err := r.base(ctx).Model(&performance.BackgroundJob{}).
+		Select("job_status, COUNT(*) AS total").
+		Group("job_status").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("jobRepo.CountByStatus: %w", err)
+	}
+	counts := make(map[enums.BackgroundJobStatus]int, len(rows))
+	for _, row := range rows {
+		counts[row.JobStatus] = row.Total
+	}
+	return counts, nil
+}
+
+// Requeue makes a dead-lettered, cancelled or retry-pending job due now with
+// a fresh attempt budget. It reports whether the job was in such a state.
+func (r *JobRepository) Requeue(ctx context.Context, jobID, updatedBy string, now time.Time) (bool, error) {
+	res := r.db.WithContext(ctx).Model(&performance.BackgroundJob{}).
+		Where("background_job_id = ? AND job_status IN ?", jobID, []enums.BackgroundJobStatus{
+			enums.BackgroundJobStatusDeadLettered,
+			enums.BackgroundJobStatusCancelled,
+			enums.BackgroundJobStatusAwaitingRetry,
+		}).
+		Updates(map[string]interface{}{
+			"job_status":   enums.BackgroundJobStatusEnqueued,
+			"attempts":     0,
+			"run_at":       now,
+			"completed_at": nil,
+			"updated_by":   updatedBy,
+		})
+	if res.Error != nil {
+		return false, fmt.Errorf("jobRepo.Requeue: %w", res.Error)
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// Cancel stops a job that has not started running. It reports whether the
+// job was enqueued or awaiting retry.
+func (r *JobRepository) Cancel(ctx context.Context, jobID, updatedBy string, now time.Time) (bool, error) {
+	res := r.db.WithContext(ctx).Model(&performance.BackgroundJob{}).
+		Where("background_job_id = ? AND job_status IN ?", jobID, []enums.BackgroundJobStatus{
+			enums.BackgroundJobStatusEnqueued,
+			enums.BackgroundJobStatusAwaitingRetry,
+		}).
+		Updates(map[string]interface{}{
+			"job_status":   enums.BackgroundJobStatusCancelled,
+			"completed_at": now,
+			"updated_by":   updatedBy,
+		})
+	if res.Error != nil {
+		return false, fmt.Errorf("jobRepo.Cancel: %w", res.Error)
+	}
+	return res.RowsAffected > 0, nil
+}
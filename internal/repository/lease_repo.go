@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"gorm.io/gorm"
+)
+
+// LeaseRepository manages cluster-wide leases in pms.job_leases. Expiry is
+// computed from the database clock so replicas with skewed clocks agree on
+// when a lease has lapsed.
+type LeaseRepository struct {
+	db *gorm.DB
+}
+
+// NewLeaseRepository creates a new lease repository.
+func NewLeaseRepository(db *gorm.DB) *LeaseRepository {
+	return &LeaseRepository{db: db}
+}
+
+// TryAcquire takes or renews the named lease for holder until ttl from now.
+// It succeeds when the lease is free, has expired or is already held by
+// holder, and reports whether holder now owns the lease.
+func (r *LeaseRepository) TryAcquire(ctx context.Context, name, holder string, ttl time.Duration) (bool, error) {
+	res := r.db.WithContext(ctx).Exec(`
+		INSERT INTO pms.job_leases (lease_name, holder, acquired_at, expires_at)
+		VALUES (?, ?, NOW(), NOW() + make_interval(secs => ?))
+		ON CONFLICT (lease_name) DO UPDATE
+		SET holder = EXCLUDED.holder,
+		    acquired_at = CASE WHEN pms.job_leases.holder = EXCLUDED.holder
+		                       THEN pms.job_leases.acquired_at ELSE NOW() END,
+		    expires_at = EXCLUDED.expires_at
+		WHERE pms.job_leases.holder = EXCLUDED.holder
+		   OR pms.job_leases.expires_at < NOW()`,
+		name, holder, ttl.Seconds(),
+	)
+	if res.Error != nil {
+		return false, fmt.Errorf("leaseRepo.TryAcquire: %w", res.Error)
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// Release gives up the named lease if holder still owns it.
+func (r *LeaseRepository) Release(ctx context.Context, name, holder string) error {
+	err := r.db.WithContext(ctx).Model(&performance.JobLease{}).
+		Where("lease_name = ? AND holder = ?", name, holder).
+		Update("expires_at", gorm.Expr("NOW()")).Error
+	if err != nil {
+		return fmt.Errorf("leaseRepo.Release: %w", err)
+	}
+	return nil
+}
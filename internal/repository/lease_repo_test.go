@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type leaseRow struct {
+	holder     string
+	acquiredAt time.Time
+	expiresAt  time.Time
+}
+
+// leaseTable is a database/sql driver that keeps pms.job_leases in memory.
+// It understands the two statements LeaseRepository sends, and evaluates the
+// upsert's ON CONFLICT ... WHERE clause predicate by predicate, so a change
+// to the takeover rule either shows up in these tests or fails them. Every
+// statement advances the clock by a microsecond, as NOW() does between
+// transactions.
+type leaseTable struct {
+	now  time.Time
+	rows map[string]leaseRow
+}
+
+func (t *leaseTable) Connect(ctx context.Context) (driver.Conn, error) { return leaseConn{t}, nil }
+func (t *leaseTable) Driver() driver.Driver                            { return nil }
+
+type leaseConn struct{ table *leaseTable }
+
+func (c leaseConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, fmt.Errorf("prepare not supported")
+}
+func (c leaseConn) Close() error              { return nil }
+func (c leaseConn) Begin() (driver.Tx, error) { return leaseTx{}, nil }
+
+type leaseTx struct{}
+
+func (leaseTx) Commit() error   { return nil }
+func (leaseTx) Rollback() error { return nil }
+
+func (c leaseConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	t := c.table
+	t.now = t.now.Add(time.Microsecond)
+	query = strings.Join(strings.Fields(query), " ")
+
+	switch {
+	case strings.HasPrefix(query, "INSERT INTO pms.job_leases"):
+		name, holder := args[0].Value.(string), args[1].Value.(string)
+		ttl := time.Duration(args[2].Value.(float64) * float64(time.Second))
+		existing, ok := t.rows[name]
+		if !ok {
+			t.rows[name] = leaseRow{holder: holder, acquiredAt: t.now, expiresAt: t.now.Add(ttl)}
+			return driver.RowsAffected(1), nil
+		}
+		_, where, found := strings.Cut(query, " WHERE ")
+		if !found {
+			return nil, fmt.Errorf("upsert has no conflict WHERE clause")
+		}
+		update := false
+		for _, predicate := range strings.Split(where, " OR ") {
+			switch predicate {
+			case "pms.job_leases.holder = EXCLUDED.holder":
+				update = update || existing.holder == holder
+			case "pms.job_leases.expires_at < NOW()":
+				update = update || existing.expiresAt.Before(t.now)
+			default:
+				return nil, fmt.Errorf("unexpected conflict predicate %q", predicate)
+			}
+		}
+		if !update {
+			return driver.RowsAffected(0), nil
+		}
+		acquiredAt := t.now
+		if existing.holder == holder {
+			acquiredAt = existing.acquiredAt
+		}
+		t.rows[name] = leaseRow{holder: holder, acquiredAt: acquiredAt, expiresAt: t.now.Add(ttl)}
+		return driver.RowsAffected(1), nil
+
+	case strings.HasPrefix(query, `UPDATE "pms"."job_leases" SET "expires_at"=NOW()`):
+		name, holder := args[0].Value.(string), args[1].Value.(string)
+		if row, ok := t.rows[name]; ok && row.holder == holder {
+			row.expiresAt = t.now
+			t.rows[name] = row
+			return driver.RowsAffected(1), nil
+		}
+		return driver.RowsAffected(0), nil
+	}
+	return nil, fmt.Errorf("unexpected statement %q", query)
+}
+
+func newTestLeaseRepository(t *testing.T, table *leaseTable) *LeaseRepository {
+	t.Helper()
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sql.OpenDB(table)}), &gorm.Config{
+		DisableAutomaticPing: true,
+		Logger:               logger.Discard,
+	})
+	if err != nil {
+		t.Fatalf("open gorm: %v", err)
+	}
+	return NewLeaseRepository(db)
+}
+
+func TestLeaseRepositoryTryAcquire(t *testing.T) {
+	start := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	held := func(holder string, acquired, expires time.Duration) map[string]leaseRow {
+		return map[string]leaseRow{"digest": {holder: holder, acquiredAt: start.Add(acquired), expiresAt: start.Add(expires)}}
+	}
+
+	tests := []struct {
+		name         string
+		rows         map[string]leaseRow
+		holder       string
+		want         bool
+		wantHolder   string
+		keepAcquired bool
+	}{
+		{name: "free lease", holder: "a", want: true, wantHolder: "a"},
+		{name: "renew own live lease", rows: held("a", -time.Minute, time.Minute), holder: "a", want: true, wantHolder: "a", keepAcquired: true},
+		{name: "renew own expired lease", rows: held("a", -time.Hour, -time.Minute), holder: "a", want: true, wantHolder: "a", keepAcquired: true},
+		{name: "refuse live lease of another holder", rows: held("b", -time.Minute, time.Minute), holder: "a", want: false, wantHolder: "b", keepAcquired: true},
+		{name: "take over expired lease of another holder", rows: held("b", -time.Hour, -time.Minute), holder: "a", want: true, wantHolder: "a"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := &leaseTable{now: start, rows: make(map[string]leaseRow)}
+			for name, row := range tt.rows {
+				table.rows[name] = row
+			}
+			repo := newTestLeaseRepository(t, table)
+
+			got, err := repo.TryAcquire(context.Background(), "digest", tt.holder, time.Minute)
+			if err != nil {
+				t.Fatalf("TryAcquire: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("TryAcquire = %v, want %v", got, tt.want)
+			}
+			row := table.rows["digest"]
+			if row.holder != tt.wantHolder {
+				t.Errorf("holder = %q, want %q", row.holder, tt.wantHolder)
+			}
+			if tt.keepAcquired && !row.acquiredAt.Equal(tt.rows["digest"].acquiredAt) {
+				t.Errorf("acquired_at = %s, want it kept at %s", row.acquiredAt, tt.rows["digest"].acquiredAt)
+			}
+			if !tt.keepAcquired && !row.acquiredAt.After(start) {
+				t.Errorf("acquired_at = %s, want it reset to the acquiring statement's NOW()", row.acquiredAt)
+			}
+			if tt.want && !row.expiresAt.After(start.Add(time.Minute-time.Second)) {
+				t.Errorf("expires_at = %s, want about a minute after %s", row.expiresAt, start)
+			}
+		})
+	}
+}
+
+func TestLeaseRepositoryRelease(t *testing.T) {
+	start := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	table := &leaseTable{now: start, rows: make(map[string]leaseRow)}
+	repo := newTestLeaseRepository(t, table)
+	ctx := context.Background()
+
+	if ok, err := repo.TryAcquire(ctx, "scheduler:leader", "a", time.Hour); err != nil || !ok {
+		t.Fatalf("a TryAcquire = %v, %v; want true", ok, err)
+	}
+
+	// Only the holder can release a lease.
+	if err := repo.Release(ctx, "scheduler:leader", "b"); err != nil {
+		t.Fatalf("b Release: %v", err)
+	}
+	if ok, err := repo.TryAcquire(ctx, "scheduler:leader", "b", time.Hour); err != nil || ok {
+		t.Fatalf("b TryAcquire after its own release = %v, %v; want false", ok, err)
+	}
+
+	// Once released, another holder can take the lease straight away.
+	if err := repo.Release(ctx, "scheduler:leader", "a"); err != nil {
+		t.Fatalf("a Release: %v", err)
+	}
+	if ok, err := repo.TryAcquire(ctx, "scheduler:leader", "b", time.Hour); err != nil || !ok {
+		t.Fatalf("b TryAcquire after a released = %v, %v; want true", ok, err)
+	}
+	if holder := table.rows["scheduler:leader"].holder; holder != "b" {
+		t.Fatalf("holder = %q, want b", holder)
+	}
+}
@@ -29,6 +29,10 @@ func AutoMigrateAll(db *gorm.DB) error {
 
 		// ── Auth (CoreSchema) ────────────────────────────────────────────
 		&auth.RefreshToken{},
+		&auth.MFAChallenge{},
+		&auth.UserSession{},
+		&auth.OIDCLoginState{},
+		&auth.UserIdPRole{},
 
 		// ── Organogram (CoreSchema) ─────────────────────────────────────
 		&organogram.Directorate{},
@@ -44,6 +48,9 @@ func AutoMigrateAll(db *gorm.DB) error {
 		&competency.CompetencyReview{},
 		&competency.CompetencyReviewProfile{},
 		&competency.DevelopmentPlan{},
+		&competency.DevelopmentPlanEvidence{},
+		&competency.DevelopmentPlanReminder{},
+		&competency.DevelopmentPlanRule{},
 		&competency.JobRole{},
 		&competency.JobGrade{},
 		&competency.JobGradeGroup{},
@@ -88,6 +95,7 @@ func AutoMigrateAll(db *gorm.DB) error {
 		&performance.CompetencyReviewer{},
 		&performance.CompetencyReviewerRating{},
 		&performance.CompetencyGapClosure{},
+		&performance.CompetencyGapClosureItem{},
 		&performance.Grievance{},
 		&performance.GrievanceResolution{},
 		&performance.Project{},
@@ -104,6 +112,25 @@ func AutoMigrateAll(db *gorm.DB) error {
 		&performance.Setting{},
 		&performance.WorkProductDefinition{},
 		&performance.CascadedWorkProduct{},
+		&performance.ApprovalChain{},
+		&performance.ApprovalChainStage{},
+		&performance.ApprovalDelegation{},
+		&performance.GradingScale{},
+		&performance.GradingScaleBand{},
+		&performance.CalibrationQuota{},
+		&performance.CalibrationSession{},
+		&performance.CalibrationEntry{},
+		&performance.BackgroundJob{},
+		&performance.JobLease{},
+		&performance.RecurringJob{},
+		&performance.EmailDeliveryAttempt{},
+		&performance.Notification{},
+		&performance.NotificationPreference{},
+		&performance.NotificationDigestItem{},
+		&performance.SLAEscalationStep{},
+		&performance.FeedbackRequestEscalation{},
+		&performance.FeedbackRequestReassignment{},
+		&performance.FileUpload{},
 
 		// ── Audit (pmsaudit schema) ─────────────────────────────────────
 		&audit.AuditLog{},
@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// NotificationRepository provides data access for the in-app notification
+// inbox in pms.notifications, the staff's notification preferences and the
+// daily digest queue. Inbox queries are scoped to one recipient.
+type NotificationRepository struct {
+	db *gorm.DB
+}
+
+// NewNotificationRepository creates a new notification repository.
+func NewNotificationRepository(db *gorm.DB) *NotificationRepository {
+	return &NotificationRepository{db: db}
+}
+
+// Create adds notifications to their recipients' inboxes.
+func (r *NotificationRepository) Create(ctx context.Context, notifications []performance.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+	if err := r.db.WithContext(ctx).Create(&notifications).Error; err != nil {
+		return fmt.Errorf("notificationRepo.Create: %w", err)
+	}
+	return nil
+}
+
+// List returns a page of a staff member's notifications, newest first, and
+// the number of notifications matching the filter.
+func (r *NotificationRepository) List(ctx context.Context, staffID string, unreadOnly bool, skip, take int) ([]performance.Notification, int64, error) {
+	query := r.db.WithContext(ctx).Model(&performance.Notification{}).Where("recipient_staff_id = ?", staffID)
+	if unreadOnly {
+		query = query.Where("is_read = ?", false)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("notificationRepo.List: %w", err)
+	}
+	var notifications []performance.Notification
+	if err := query.Order("notification_id DESC").Offset(skip).Limit(take).Find(&notifications).Error; err != nil {
+		return nil, 0, fmt.Errorf("notificationRepo.List: %w", err)
+	}
+	return notifications, total, nil
+}
+
+// ListAfter returns up to limit of a staff member's notifications with an ID
+// above afterID, oldest first.
+func (r *NotificationRepository) ListAfter(ctx context.Context, staffID string, afterID int64, limit int) ([]performance.Notification, error) {
+	var notifications []performance.Notification
+	err := r.db.WithContext(ctx).
+		Where("recipient_staff_id = ? AND notification_id > ?", staffID, afterID).
+		Order("notification_id").
+		Limit(limit).
+		Find(&notifications).Error
+	if err != nil {
+		return nil, fmt.Errorf("notificationRepo.ListAfter: %w", err)
+	}
+	return notifications, nil
+}
+
+// CountUnread returns the number of unread notifications of a staff member.
+func (r *NotificationRepository) CountUnread(ctx context.Context, staffID string) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&performance.Notification{}).
+		Where("recipient_staff_id = ? AND is_read = ?", staffID, false).
+		Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("notificationRepo.CountUnread: %w", err)
+	}
+	return count, nil
+}
+
+// MarkRead marks the given unread notifications of a staff member as read
+// and returns how many changed. IDs belonging to other staff are ignored.
+func (r *NotificationRepository) MarkRead(ctx context.Context, staffID string, ids []int64, at time.Time) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	result := r.db.WithContext(ctx).Model(&performance.Notification{}).
+		Where("recipient_staff_id = ? AND notification_id IN ? AND is_read = ?", staffID, ids, false).
+		Updates(map[string]interface{}{"is_read": true, "read_at": at})
+	if result.Error != nil {
+		return 0, fmt.Errorf("notificationRepo.MarkRead: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// MarkAllRead marks every unread notification of a staff member as read and
+// returns how many changed.
+func (r *NotificationRepository) MarkAllRead(ctx context.Context, staffID string, at time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Model(&performance.Notification{}).
+		Where("recipient_staff_id = ? AND is_read = ?", staffID, false).
+		Updates(map[string]interface{}{"is_read": true, "read_at": at})
+	if result.Error != nil {
+		return 0, fmt.Errorf("notificationRepo.MarkAllRead: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// GetPreferences returns a staff member's stored notification preferences.
+func (r *NotificationRepository) GetPreferences(ctx context.Context, staffID string) ([]performance.NotificationPreference, error) {
+	var prefs []performance.NotificationPreference
+	if err := r.db.WithContext(ctx).Where("staff_id = ?", staffID).Find(&prefs).Error; err != nil {
+		return nil, fmt.Errorf("notificationRepo.GetPreferences: %w", err)
+	}
+	return prefs, nil
+}
+
+// SavePreferences inserts or replaces notification preferences.
+func (r *NotificationRepository) SavePreferences(ctx context.Context, prefs []performance.NotificationPreference) error {
+	if len(prefs) == 0 {
+		return nil
+	}
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "staff_id"}, {Name: "category"}},
+		DoUpdates: clause.AssignmentColumns([]string{"mode", "updated_at"}),
+	}).Create(&prefs).Error
+	if err != nil {
+		return fmt.Errorf("notificationRepo.SavePreferences: %w", err)
+	}
+	return nil
+}
+
+// QueueDigestItems adds notifications to their recipients' next digest.
+func (r *NotificationRepository) QueueDigestItems(ctx context.Context, items []performance.NotificationDigestItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+	if err := r.db.WithContext(ctx).Create(&items).Error; err != nil {
+		return fmt.Errorf("notificationRepo.QueueDigestItems: %w", err)
+	}
+	return nil
+}
+
+// PendingDigestItems returns the digest items not yet sent, with their
+// notifications, ordered by recipient and then notification.
+func (r *NotificationRepository) PendingDigestItems(ctx context.Context) ([]performance.NotificationDigestItem, error) {
+	var items []performance.NotificationDigestItem
+	err := r.db.WithContext(ctx).
+		Preload("Notification").
+		Where("sent_at IS NULL").
+		Order("recipient_staff_id, notification_id").
+		Find(&items).Error
+	if err != nil {
+		return nil, fmt.Errorf("notificationRepo.PendingDigestItems: %w", err)
+	}
+	return items, nil
+}
+
+// MarkDigestItemsSent records that a digest covered the given items.
+func (r *NotificationRepository) MarkDigestItemsSent(ctx context.Context, ids []int64, at time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	err := r.db.WithContext(ctx).Model(&performance.NotificationDigestItem{}).
+		Where("notification_digest_item_id IN ? AND sent_at IS NULL", ids).
+		Update("sent_at", at).Error
+	if err != nil {
+		return fmt.Errorf("notificationRepo.MarkDigestItemsSent: %w", err)
+	}
+	return nil
+}
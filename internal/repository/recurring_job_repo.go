@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// RecurringJobRepository provides data access for cron job schedules and
+// run status in pms.recurring_jobs.
+type RecurringJobRepository struct {
+	db *gorm.DB
+}
+
+// NewRecurringJobRepository creates a new recurring job repository.
+func NewRecurringJobRepository(db *gorm.DB) *RecurringJobRepository {
+	return &RecurringJobRepository{db: db}
+}
+
+func (r *RecurringJobRepository) base(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).Where("soft_deleted = ?", false)
+}
+
+// Seed inserts jobs that have no row yet. Existing rows, and any schedule
+// an administrator has set on them, are left untouched.
+func (r *RecurringJobRepository) Seed(ctx context.Context, jobs []performance.RecurringJob) error {
+	if len(jobs) == 0 {
+		return nil
+	}
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&jobs).Error; err != nil {
+		return fmt.Errorf("recurringJobRepo.Seed: %w", err)
+	}
+	return nil
+}
+
+// List returns every recurring job ordered by name.
+func (r *RecurringJobRepository) List(ctx context.Context) ([]performance.RecurringJob, error) {
+	var jobs []performance.RecurringJob
+	if err := r.base(ctx).Order("job_name").Find(&jobs).Error; err != nil {
+		return nil, fmt.Errorf("recurringJobRepo.List: %w", err)
+	}
+	return jobs, nil
+}
+
+// GetByName returns a recurring job, or nil when it does not exist.
+func (r *RecurringJobRepository) GetByName(ctx context.Context, name string) (*performance.RecurringJob, error) {
+	var job performance.RecurringJob
+	err := r.base(ctx).First(&job, "job_name = ?", name).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("recurringJobRepo.GetByName: %w", err)
+	}
+	return &job, nil
+}
+
+// RecordRun stores the outcome of a run. An empty lastError marks success.
+func (r *RecurringJobRepository) RecordRun(ctx context.Context, name string, startedAt time.Time, duration time.Duration, lastError string) error {
+	err := r.db.WithContext(ctx).Model(&performance.RecurringJob{}).
+		Where("job_name = ?", name).
+		Updates(map[string]interface{}{
+			"last_run_at":        startedAt,
+			"last_duration_ms":   duration.Milliseconds(),
+			"last_run_succeeded": lastError == "",
+			"last_error":         lastError,
+		}).Error
+	if err != nil {
+		return fmt.Errorf("recurringJobRepo.RecordRun: %w", err)
+	}
+	return nil
+}
+
+// SetNextRun stores when the job is next due; nil clears it for paused jobs.
+func (r *RecurringJobRepository) SetNextRun(ctx context.Context, name string, next *time.Time) error {
+	err := r.db.WithContext(ctx).Model(&performance.RecurringJob{}).
+		Where("job_name = ?", name).
+		UpdateColumn("next_run_at", next).Error
+	if err != nil {
+		return fmt.Errorf("recurringJobRepo.SetNextRun: %w", err)
+	}
+	return nil
+}
+
+// ClaimTrigger clears the trigger request observed at requestedAt. It
+// reports false when another replica already claimed it.
+func (r *RecurringJobRepository) ClaimTrigger(ctx context.Context, name string, requestedAt time.Time) (bool, error) {
+	res := r.db.WithContext(ctx).Model(&performance.RecurringJob{}).
+		Where("job_name = ? AND trigger_requested_at = ?", name, requestedAt).
+		UpdateColumn("trigger_requested_at", nil)
+	if res.Error != nil {
+		return false, fmt.Errorf("recurringJobRepo.ClaimTrigger: %w", res.Error)
+	}
+	return res.RowsAffected > 0, nil
+}
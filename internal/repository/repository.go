@@ -33,6 +33,13 @@ type Container struct {
 	Project     *ProjectRepository
 	Feedback    *FeedbackRepository
 	Grievance   *GrievanceRepository
+	Jobs        *JobRepository
+	Leases      *LeaseRepository
+	Recurring   *RecurringJobRepository
+	AuditLogs   *AuditRepository
+	Files       *FileUploadRepository
+	Deliveries  *EmailDeliveryRepository
+	Inbox       *NotificationRepository
 
 	// ── Multi-database repositories (sqlx-based, SQL Server) ───────────
 	Erp   *ErpRepository
@@ -70,6 +77,13 @@ func New(cfg *config.Config, log zerolog.Logger) (*Container, error) {
 	c.Project = NewProjectRepository(dm.CoreGorm)
 	c.Feedback = NewFeedbackRepository(dm.CoreGorm)
 	c.Grievance = NewGrievanceRepository(dm.CoreGorm)
+	c.Jobs = NewJobRepository(dm.CoreGorm)
+	c.Leases = NewLeaseRepository(dm.CoreGorm)
+	c.Recurring = NewRecurringJobRepository(dm.CoreGorm)
+	c.AuditLogs = NewAuditRepository(dm.CoreGorm)
+	c.Files = NewFileUploadRepository(dm.CoreGorm)
+	c.Deliveries = NewEmailDeliveryRepository(dm.CoreGorm)
+	c.Inbox = NewNotificationRepository(dm.CoreGorm)
 
 	// Initialize multi-database repositories (sqlx — SQL Server, optional)
 	c.Erp = NewErpRepository(dm.ErpSQL)
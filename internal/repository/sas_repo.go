@@ -66,13 +66,9 @@ func (r *SasRepository) GetStaffAttendanceByDepartment(ctx context.Context, dept
 }
 
 // GetStaffLeaveDaysBetween retrieves approved leave (absence) records for an employee
-// between two dates, excluding the "present" attendance status.
-// Mirrors the .NET query on StaffLunchAttendances:
-//
-//	WHERE EmployeeNumber = staffID
-//	  AND ApprovedDate IS NOT NULL
-//	  AND ApprovedDate >= startDate AND ApprovedDate <= endDate
-//	  AND AttendanceStatus != presentAbsenceID
+// whose attendance day, YearRef and DayRef (day of the year), falls between
+// two dates, excluding the "present" attendance status. When the leave was
+// approved does not matter.
 func (r *SasRepository) GetStaffLeaveDaysBetween(ctx context.Context, employeeNumber string, startDate, endDate time.Time, presentAbsenceID int) ([]sas.StaffLunchAttendance, error) {
 	if r.db == nil {
 		return nil, fmt.Errorf("sasRepo.GetStaffLeaveDaysBetween: SAS database not configured")
@@ -82,8 +78,9 @@ func (r *SasRepository) GetStaffLeaveDaysBetween(ctx context.Context, employeeNu
 		`SELECT * FROM dbo.XXCBN_SAS_StaffLunchAttendance
 		 WHERE EmployeeNumber = @p1
 		   AND ApprovedDate IS NOT NULL
-		   AND ApprovedDate >= @p2
-		   AND ApprovedDate <= @p3
+		   AND YearRef BETWEEN YEAR(@p2) AND YEAR(@p3)
+		   AND DayRef IS NOT NULL
+		   AND DATEADD(day, DayRef - 1, DATEFROMPARTS(YearRef, 1, 1)) BETWEEN CAST(@p2 AS date) AND CAST(@p3 AS date)
 		   AND AttendanceStatus != @p4`,
 		employeeNumber, startDate, endDate, presentAbsenceID)
 	if err != nil {
@@ -0,0 +1,478 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/enterprise-pms/pms-api/internal/config"
+	"github.com/enterprise-pms/pms-api/internal/domain/auth"
+	"github.com/enterprise-pms/pms-api/internal/domain/enums"
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"github.com/enterprise-pms/pms-api/internal/repository"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+)
+
+// ---------------------------------------------------------------------------
+// approvalChainService manages database-stored approval chains and turns them
+// into WorkflowEngine rule sets at runtime. When no active chain exists for an
+// entity type the built-in single-level chain is used, so existing behaviour
+// is unchanged until an administrator configures a chain.
+// ---------------------------------------------------------------------------
+
+// approvalPendingStatuses are the statuses a chain stage may wait in.
+var approvalPendingStatuses = map[enums.Status]struct{}{
+	enums.StatusPendingApproval:     {},
+	enums.StatusPendingHODReview:    {},
+	enums.StatusPendingBUHeadReview: {},
+	enums.StatusPendingHRDReview:    {},
+	enums.StatusPendingHRDApproval:  {},
+}
+
+type approvalChainService struct {
+	db      *gorm.DB
+	erpRepo *repository.ErpRepository
+	log     zerolog.Logger
+}
+
+func newApprovalChainService(repos *repository.Container, cfg *config.Config, log zerolog.Logger) ApprovalChainService {
+	return &approvalChainService{
+		db:      repos.GormDB,
+		erpRepo: repos.Erp,
+		log:     log.With().Str("service", "approval_chain").Logger(),
+	}
+}
+
+// ==========================================================================
+// Chain CRUD
+// ==========================================================================
+
+// GetApprovalChains lists every stored chain followed by the built-in chain
+// for each entity type that has no active stored chain.
+func (s *approvalChainService) GetApprovalChains(ctx context.Context) (performance.ApprovalChainListResponseVm, error) {
+	resp := performance.ApprovalChainListResponseVm{}
+
+	var chains []performance.ApprovalChain
+	if err := s.db.WithContext(ctx).
+		Preload("Stages", "soft_deleted = ?", false).
+		Where("soft_deleted = ?", false).
+		Order("entity_type, name").
+		Find(&chains).Error; err != nil {
+		return resp, fmt.Errorf("listing approval chains: %w", err)
+	}
+
+	covered := make(map[enums.ApprovalEntityType]bool)
+	for _, c := range chains {
+		resp.Data = append(resp.Data, toApprovalChainVm(c))
+		if c.IsActive {
+			covered[c.EntityType] = true
+		}
+	}
+	for _, et := range approvalEntityTypes() {
+		if !covered[et] {
+			resp.Data = append(resp.Data, builtInApprovalChainVm(et))
+		}
+	}
+
+	resp.TotalRecord = len(resp.Data)
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// GetApprovalChain returns a single stored chain by ID.
+func (s *approvalChainService) GetApprovalChain(ctx context.Context, chainID string) (performance.ApprovalChainResponseVm, error) {
+	resp := performance.ApprovalChainResponseVm{}
+
+	chain, err := s.loadChain(ctx, chainID)
+	if err != nil {
+		return resp, err
+	}
+	if chain == nil {
+		resp.HasError = true
+		resp.Message = fmt.Sprintf("approval chain %s not found", chainID)
+		return resp, nil
+	}
+
+	vm := toApprovalChainVm(*chain)
+	resp.Data = &vm
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// SaveApprovalChain creates or updates a chain and replaces its stages. When
+// the chain is saved as active, any other active chain for the same entity
+// type is deactivated in the same transaction.
+func (s *approvalChainService) SaveApprovalChain(ctx context.Context, req *performance.ApprovalChainRequestModel) (performance.ResponseVm, error) {
+	resp := performance.ResponseVm{}
+
+	entityType := enums.ApprovalEntityType(req.EntityType)
+	if entityType.String() == "Unknown" {
+		resp.HasError = true
+		resp.Message = fmt.Sprintf("unknown entity type %d", req.EntityType)
+		return resp, nil
+	}
+	if err := validateApprovalChainStages(req.Stages); err != nil {
+		resp.HasError = true
+		resp.Message = err.Error()
+		return resp, nil
+	}
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var chain performance.ApprovalChain
+		if req.ApprovalChainID != "" {
+			if err := tx.Where("approval_chain_id = ? AND soft_deleted = ?", req.ApprovalChainID, false).
+				First(&chain).Error; err != nil {
+				return fmt.Errorf("approval chain %s not found: %w", req.ApprovalChainID, err)
+			}
+		} else {
+			chain.ApprovalChainID = GenerateID()
+			chain.CreatedBy = req.UpdatedBy
+		}
+
+		chain.Name = req.Name
+		chain.Description = req.Description
+		chain.EntityType = entityType
+		chain.IsActive = req.IsActive
+		chain.UpdatedBy = req.UpdatedBy
+
+		if req.IsActive {
+			if err := tx.Model(&performance.ApprovalChain{}).
+				Where("entity_type = ? AND approval_chain_id <> ? AND is_active = ?", entityType, chain.ApprovalChainID, true).
+				Updates(map[string]interface{}{"is_active": false, "updated_by": req.UpdatedBy}).Error; err != nil {
+				return fmt.Errorf("deactivating existing chains: %w", err)
+			}
+		}
+
+		if err := tx.Save(&chain).Error; err != nil {
+			return fmt.Errorf("saving approval chain: %w", err)
+		}
+		// Save ignores a false is_active on insert because of the column default.
+		if err := tx.Model(&chain).Update("is_active", req.IsActive).Error; err != nil {
+			return fmt.Errorf("setting approval chain state: %w", err)
+		}
+
+		if err := tx.Where("approval_chain_id = ?", chain.ApprovalChainID).
+			Delete(&performance.ApprovalChainStage{}).Error; err != nil {
+			return fmt.Errorf("clearing approval chain stages: %w", err)
+		}
+		for _, st := range req.Stages {
+			stage := performance.ApprovalChainStage{
+				ApprovalChainStageID: GenerateID(),
+				ApprovalChainID:      chain.ApprovalChainID,
+				StageOrder:           st.StageOrder,
+				Name:                 st.Name,
+				ApproverResolver:     enums.ApproverResolverType(st.ApproverResolver),
+				RoleName:             st.RoleName,
+				PendingStatus:        enums.Status(st.PendingStatus),
+				AllowReturn:          st.AllowReturn,
+			}
+			stage.CreatedBy = req.UpdatedBy
+			if err := tx.Create(&stage).Error; err != nil {
+				return fmt.Errorf("saving approval chain stage: %w", err)
+			}
+		}
+
+		resp.ID = chain.ApprovalChainID
+		return nil
+	})
+	if err != nil {
+		s.log.Error().Err(err).Str("action", "SAVE_APPROVAL_CHAIN").Msg("failed to save approval chain")
+		return resp, err
+	}
+
+	s.log.Info().Str("chainId", resp.ID).Str("entityType", entityType.String()).Msg("approval chain saved")
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// DeleteApprovalChain soft-deletes a chain; the entity type reverts to the
+// built-in chain unless another active chain exists.
+func (s *approvalChainService) DeleteApprovalChain(ctx context.Context, chainID string) (performance.ResponseVm, error) {
+	resp := performance.ResponseVm{ID: chainID}
+
+	result := s.db.WithContext(ctx).Model(&performance.ApprovalChain{}).
+		Where("approval_chain_id = ? AND soft_deleted = ?", chainID, false).
+		Updates(map[string]interface{}{"soft_deleted": true, "is_active": false})
+	if result.Error != nil {
+		return resp, fmt.Errorf("deleting approval chain: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		resp.HasError = true
+		resp.Message = fmt.Sprintf("approval chain %s not found", chainID)
+		return resp, nil
+	}
+
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// ==========================================================================
+// Runtime resolution
+// ==========================================================================
+
+// GetActiveStages returns the stages of the active chain for an entity type,
+// falling back to the built-in single-level chain.
+func (s *approvalChainService) GetActiveStages(ctx context.Context, entityType enums.ApprovalEntityType) ([]performance.ApprovalChainStage, error) {
+	var chain performance.ApprovalChain
+	err := s.db.WithContext(ctx).
+		Preload("Stages", func(db *gorm.DB) *gorm.DB {
+			return db.Where("soft_deleted = ?", false).Order("stage_order")
+		}).
+		Where("entity_type = ? AND is_active = ? AND soft_deleted = ?", entityType, true, false).
+		First(&chain).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return builtInApprovalChainStages(), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("loading active approval chain: %w", err)
+	}
+	if len(chain.Stages) == 0 {
+		return builtInApprovalChainStages(), nil
+	}
+	return chain.Stages, nil
+}
+
+// ResolveWorkflowEngine builds a WorkflowEngine from the active chain for the
+// given entity type.
+func (s *approvalChainService) ResolveWorkflowEngine(ctx context.Context, entityType enums.ApprovalEntityType) (*WorkflowEngine, error) {
+	stages, err := s.GetActiveStages(ctx, entityType)
+	if err != nil {
+		return nil, err
+	}
+	return NewChainWorkflowEngine(toApprovalStages(stages), s.log), nil
+}
+
+// nextApprovalStatus is the nil-safe entry point used by the submit, approve,
+// reject and return paths: it applies op to a record of entityType sitting in
+// recordStatus under the active chain. A non-final approval yields the next
+// stage's pending status; the final approval yields StatusApprovedAndActive,
+// which callers map onto the status their entity treats as live.
+func nextApprovalStatus(ctx context.Context, svc ApprovalChainService, entityType enums.ApprovalEntityType, recordStatus string, op enums.OperationType) (enums.Status, error) {
+	engine := NewBaseWorkflowEngine(zerolog.Nop())
+	if svc != nil {
+		var err error
+		if engine, err = svc.ResolveWorkflowEngine(ctx, entityType); err != nil {
+			return 0, err
+		}
+	}
+	return applyWorkflowOperation(engine, recordStatus, op)
+}
+
+// applyWorkflowOperation returns the status engine moves a record in
+// recordStatus to when op is applied.
+func applyWorkflowOperation(engine *WorkflowEngine, recordStatus string, op enums.OperationType) (enums.Status, error) {
+	from, ok := enums.ParseStatus(recordStatus)
+	if !ok {
+		return 0, &WorkflowTransitionError{Reason: fmt.Sprintf("unknown record status %q", recordStatus)}
+	}
+	to, ok := engine.NextStatus(from, op)
+	if !ok {
+		return 0, &WorkflowTransitionError{From: from, Reason: fmt.Sprintf("%s is not allowed in this status", op)}
+	}
+	return to, nil
+}
+
+// ResolveStageApprovers returns the staff IDs allowed to act on a record that
+// is waiting in pendingStatus. ownerStaffID is the staff member who owns the
+// record; hierarchy-based resolvers are evaluated relative to them.
+func (s *approvalChainService) ResolveStageApprovers(ctx context.Context, entityType enums.ApprovalEntityType, pendingStatus enums.Status, ownerStaffID string) ([]string, error) {
+	stages, err := s.GetActiveStages(ctx, entityType)
+	if err != nil {
+		return nil, err
+	}
+	for _, st := range stages {
+		if st.PendingStatus == pendingStatus {
+			return s.resolveApprovers(ctx, st, ownerStaffID)
+		}
+	}
+	return nil, &WorkflowTransitionError{From: pendingStatus, To: pendingStatus, Reason: "status is not a stage of the active approval chain"}
+}
+
+// ResolveApprovers returns who a resolver designates for ownerStaffID outside
+// any chain, e.g. to escalate a feedback request to the assignee's line
+// manager or to HRD.
+func (s *approvalChainService) ResolveApprovers(ctx context.Context, resolver enums.ApproverResolverType, roleName, ownerStaffID string) ([]string, error) {
+	return s.resolveApprovers(ctx, performance.ApprovalChainStage{ApproverResolver: resolver, RoleName: roleName}, ownerStaffID)
+}
+
+func (s *approvalChainService) resolveApprovers(ctx context.Context, stage performance.ApprovalChainStage, ownerStaffID string) ([]string, error) {
+	switch stage.ApproverResolver {
+	case enums.ApproverResolverHrdRole:
+		return staffInRole(ctx, s.db, auth.RoleHRD)
+	case enums.ApproverResolverNamedRole:
+		return staffInRole(ctx, s.db, stage.RoleName)
+	}
+
+	if s.erpRepo == nil {
+		return nil, fmt.Errorf("resolving %s approver: ERP database not configured", stage.ApproverResolver)
+	}
+	emp, err := s.erpRepo.GetEmployeeByID(ctx, ownerStaffID)
+	if err != nil {
+		return nil, fmt.Errorf("resolving approver for %s: %w", ownerStaffID, err)
+	}
+
+	var approver string
+	switch stage.ApproverResolver {
+	case enums.ApproverResolverLineManager:
+		approver = emp.SupervisorID
+	case enums.ApproverResolverHeadOfOffice:
+		approver = emp.HeadOfOfficeID
+	case enums.ApproverResolverHeadOfDivision:
+		approver = emp.HeadOfDivID
+	case enums.ApproverResolverHeadOfDepartment:
+		approver = emp.HeadOfDeptID
+	}
+	if approver == "" {
+		return nil, nil
+	}
+	return []string{approver}, nil
+}
+
+// staffInRole lists the user IDs, which are staff IDs, of the active users
+// holding a role.
+func staffInRole(ctx context.Context, db *gorm.DB, roleName string) ([]string, error) {
+	var userIDs []string
+	err := db.WithContext(ctx).
+		Table(`"CoreSchema".asp_net_user_roles ur`).
+		Joins(`JOIN "CoreSchema".asp_net_roles r ON r.id = ur.role_id`).
+		Joins(`JOIN "CoreSchema".asp_net_users u ON u.id = ur.user_id`).
+		Where("r.name = ? AND u.is_active = ?", roleName, true).
+		Pluck("u.id", &userIDs).Error
+	if err != nil {
+		return nil, fmt.Errorf("listing staff in role %s: %w", roleName, err)
+	}
+	return userIDs, nil
+}
+
+func (s *approvalChainService) loadChain(ctx context.Context, chainID string) (*performance.ApprovalChain, error) {
+	var chain performance.ApprovalChain
+	err := s.db.WithContext(ctx).
+		Preload("Stages", func(db *gorm.DB) *gorm.DB {
+			return db.Where("soft_deleted = ?", false).Order("stage_order")
+		}).
+		Where("approval_chain_id = ? AND soft_deleted = ?", chainID, false).
+		First(&chain).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("loading approval chain: %w", err)
+	}
+	return &chain, nil
+}
+
+// ==========================================================================
+// Validation & mapping helpers
+// ==========================================================================
+
+// validateApprovalChainStages enforces that a chain has at least one stage,
+// that stage orders run 1..n without gaps, that each stage waits in a
+// distinct pending status and that named-role stages name a role.
+func validateApprovalChainStages(stages []performance.ApprovalChainStageRequestModel) error {
+	if len(stages) == 0 {
+		return fmt.Errorf("%w: at least one stage is required", ErrInvalidApprovalChain)
+	}
+
+	sorted := make([]performance.ApprovalChainStageRequestModel, len(stages))
+	copy(sorted, stages)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].StageOrder < sorted[j].StageOrder })
+
+	seen := make(map[enums.Status]bool)
+	for i, st := range sorted {
+		if st.StageOrder != i+1 {
+			return fmt.Errorf("%w: stage orders must run from 1 to %d without gaps", ErrInvalidApprovalChain, len(stages))
+		}
+		status := enums.Status(st.PendingStatus)
+		if _, ok := approvalPendingStatuses[status]; !ok {
+			return fmt.Errorf("%w: stage %d uses %s which is not a pending status", ErrInvalidApprovalChain, st.StageOrder, status)
+		}
+		if seen[status] {
+			return fmt.Errorf("%w: pending status %s is used by more than one stage", ErrInvalidApprovalChain, status)
+		}
+		seen[status] = true
+
+		resolver := enums.ApproverResolverType(st.ApproverResolver)
+		if resolver.String() == "Unknown" {
+			return fmt.Errorf("%w: stage %d has unknown approver resolver %d", ErrInvalidApprovalChain, st.StageOrder, st.ApproverResolver)
+		}
+		if resolver == enums.ApproverResolverNamedRole && st.RoleName == "" {
+			return fmt.Errorf("%w: stage %d must name a role", ErrInvalidApprovalChain, st.StageOrder)
+		}
+	}
+	return nil
+}
+
+// toApprovalStages converts stored stages to engine stages in stage order.
+func toApprovalStages(stages []performance.ApprovalChainStage) []ApprovalStage {
+	sorted := make([]performance.ApprovalChainStage, len(stages))
+	copy(sorted, stages)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].StageOrder < sorted[j].StageOrder })
+
+	out := make([]ApprovalStage, 0, len(sorted))
+	for _, st := range sorted {
+		out = append(out, ApprovalStage{Name: st.Name, PendingStatus: st.PendingStatus, AllowReturn: st.AllowReturn})
+	}
+	return out
+}
+
+// builtInApprovalChainStages mirrors BaseApprovalStages as stored stages.
+func builtInApprovalChainStages() []performance.ApprovalChainStage {
+	return []performance.ApprovalChainStage{{
+		StageOrder:       1,
+		Name:             "Line Manager",
+		ApproverResolver: enums.ApproverResolverLineManager,
+		PendingStatus:    enums.StatusPendingApproval,
+		AllowReturn:      true,
+	}}
+}
+
+func approvalEntityTypes() []enums.ApprovalEntityType {
+	return []enums.ApprovalEntityType{
+		enums.ApprovalEntityWorkProduct,
+		enums.ApprovalEntityIndividualPlannedObjective,
+		enums.ApprovalEntityProject,
+		enums.ApprovalEntityCommittee,
+		enums.ApprovalEntityReviewPeriodExtension,
+	}
+}
+
+func builtInApprovalChainVm(entityType enums.ApprovalEntityType) performance.ApprovalChainVm {
+	vm := toApprovalChainVm(performance.ApprovalChain{
+		Name:       "Built-in line manager approval",
+		EntityType: entityType,
+		Stages:     builtInApprovalChainStages(),
+	})
+	vm.IsActive = true
+	vm.IsBuiltIn = true
+	return vm
+}
+
+func toApprovalChainVm(c performance.ApprovalChain) performance.ApprovalChainVm {
+	vm := performance.ApprovalChainVm{
+		BaseEntityVm:    toBaseEntityVm(c.BaseEntity),
+		ApprovalChainID: c.ApprovalChainID,
+		Name:            c.Name,
+		Description:     c.Description,
+		EntityType:      int(c.EntityType),
+		EntityTypeName:  c.EntityType.String(),
+	}
+	stages := make([]performance.ApprovalChainStage, len(c.Stages))
+	copy(stages, c.Stages)
+	sort.Slice(stages, func(i, j int) bool { return stages[i].StageOrder < stages[j].StageOrder })
+	for _, st := range stages {
+		vm.Stages = append(vm.Stages, performance.ApprovalChainStageVm{
+			ApprovalChainStageID: st.ApprovalChainStageID,
+			StageOrder:           st.StageOrder,
+			Name:                 st.Name,
+			ApproverResolver:     int(st.ApproverResolver),
+			ApproverResolverName: st.ApproverResolver.String(),
+			RoleName:             st.RoleName,
+			PendingStatus:        int(st.PendingStatus),
+			PendingStatusName:    st.PendingStatus.String(),
+			AllowReturn:          st.AllowReturn,
+		})
+	}
+	return vm
+}
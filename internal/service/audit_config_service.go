@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/enterprise-pms/pms-api/internal/config"
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"github.com/enterprise-pms/pms-api/internal/repository"
+	"github.com/rs/zerolog"
+)
+
+// ---------------------------------------------------------------------------
+// auditConfigService manages which entities and columns the audit
+// interceptor logs. Entities without configuration are audited in full;
+// scores and grades are audited whatever the configuration says. Changes
+// apply on this replica at once and on the others within a minute.
+// ---------------------------------------------------------------------------
+
+type auditConfigService struct {
+	auditRepo   *repository.AuditRepository
+	interceptor *repository.AuditInterceptor
+	log         zerolog.Logger
+}
+
+func newAuditConfigService(repos *repository.Container, cfg *config.Config, log zerolog.Logger) AuditConfigService {
+	return &auditConfigService{
+		auditRepo:   repos.AuditLogs,
+		interceptor: repos.Audit,
+		log:         log.With().Str("service", "audit_config").Logger(),
+	}
+}
+
+// GetAuditConfiguration returns every configured entity with its column
+// rules.
+func (s *auditConfigService) GetAuditConfiguration(ctx context.Context) (performance.AuditableEntityListResponseVm, error) {
+	resp := performance.AuditableEntityListResponseVm{}
+
+	entities, err := s.auditRepo.ListAuditableEntities(ctx)
+	if err != nil {
+		return resp, err
+	}
+	attributes, err := s.auditRepo.ListAuditableAttributes(ctx)
+	if err != nil {
+		return resp, err
+	}
+
+	byEntity := make(map[int][]performance.AuditableAttributeVm)
+	for _, a := range attributes {
+		byEntity[a.AuditableEntityID] = append(byEntity[a.AuditableEntityID], performance.AuditableAttributeVm{
+			AttributeName: a.AttributeName,
+			EnableAudit:   a.EnableAudit,
+		})
+	}
+	for _, e := range entities {
+		resp.Data = append(resp.Data, performance.AuditableEntityVm{
+			BaseEntityVm: toBaseEntityVm(e.BaseEntity),
+			EntityName:   e.EntityName,
+			EnableAudit:  e.EnableAudit,
+			Attributes:   byEntity[e.ID],
+		})
+	}
+	resp.TotalRecord = len(resp.Data)
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// SaveAuditableEntity turns auditing of an entity on or off.
+func (s *auditConfigService) SaveAuditableEntity(ctx context.Context, req *performance.AuditableEntityRequestModel) (performance.ResponseVm, error) {
+	entityName := strings.TrimSpace(req.EntityName)
+	resp := performance.ResponseVm{ID: entityName}
+	if entityName == "" {
+		resp.HasError = true
+		resp.Message = "entity name is required"
+		return resp, nil
+	}
+
+	if _, err := s.auditRepo.SaveAuditableEntity(repository.WithAuditUser(ctx, req.UpdatedBy), entityName, req.EnableAudit, req.UpdatedBy); err != nil {
+		s.log.Error().Err(err).Str("action", "SAVE_AUDITABLE_ENTITY").Str("entity", entityName).Msg("failed to save audit configuration")
+		return resp, err
+	}
+
+	s.log.Info().Str("entity", entityName).Bool("enableAudit", req.EnableAudit).Str("updatedBy", req.UpdatedBy).Msg("entity audit configuration saved")
+	s.reloadRules(ctx)
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// SaveAuditableAttribute overrides the entity's audit flag for one column,
+// configuring the entity as audited if it has no configuration yet. Scores
+// and grades cannot be excluded.
+func (s *auditConfigService) SaveAuditableAttribute(ctx context.Context, req *performance.AuditableAttributeRequestModel) (performance.ResponseVm, error) {
+	entityName, attributeName := strings.TrimSpace(req.EntityName), strings.TrimSpace(req.AttributeName)
+	resp := performance.ResponseVm{ID: entityName}
+	if entityName == "" || attributeName == "" {
+		resp.HasError = true
+		resp.Message = "entity name and attribute name are required"
+		return resp, nil
+	}
+	if !req.EnableAudit && repository.AlwaysAudited(attributeName) {
+		resp.HasError = true
+		resp.Message = fmt.Sprintf("%s holds a score or grade and is always audited", attributeName)
+		return resp, nil
+	}
+
+	auditCtx := repository.WithAuditUser(ctx, req.UpdatedBy)
+	entity, err := s.auditRepo.GetAuditableEntity(ctx, entityName)
+	if err != nil {
+		return resp, err
+	}
+	if entity == nil {
+		if entity, err = s.auditRepo.SaveAuditableEntity(auditCtx, entityName, true, req.UpdatedBy); err != nil {
+			s.log.Error().Err(err).Str("action", "SAVE_AUDITABLE_ATTRIBUTE").Str("entity", entityName).Msg("failed to save audit configuration")
+			return resp, err
+		}
+	}
+	if err := s.auditRepo.SaveAuditableAttribute(auditCtx, entity.ID, attributeName, req.EnableAudit, req.UpdatedBy); err != nil {
+		s.log.Error().Err(err).Str("action", "SAVE_AUDITABLE_ATTRIBUTE").Str("entity", entityName).Str("attribute", attributeName).Msg("failed to save audit configuration")
+		return resp, err
+	}
+
+	s.log.Info().Str("entity", entityName).Str("attribute", attributeName).Bool("enableAudit", req.EnableAudit).Str("updatedBy", req.UpdatedBy).Msg("attribute audit configuration saved")
+	s.reloadRules(ctx)
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// DeleteAuditableAttribute removes a column rule so the entity's flag
+// applies to the column again.
+func (s *auditConfigService) DeleteAuditableAttribute(ctx context.Context, entityName, attributeName, deletedBy string) (performance.ResponseVm, error) {
+	resp := performance.ResponseVm{ID: entityName}
+
+	entity, err := s.auditRepo.GetAuditableEntity(ctx, entityName)
+	if err != nil {
+		return resp, err
+	}
+	deleted := false
+	if entity != nil {
+		deleted, err = s.auditRepo.DeleteAuditableAttribute(repository.WithAuditUser(ctx, deletedBy), entity.ID, attributeName, deletedBy)
+		if err != nil {
+			s.log.Error().Err(err).Str("action", "DELETE_AUDITABLE_ATTRIBUTE").Str("entity", entityName).Str("attribute", attributeName).Msg("failed to delete audit configuration")
+			return resp, err
+		}
+	}
+	if !deleted {
+		resp.HasError = true
+		resp.Message = fmt.Sprintf("no audit rule for %s.%s", entityName, attributeName)
+		return resp, nil
+	}
+
+	s.log.Info().Str("entity", entityName).Str("attribute", attributeName).Str("deletedBy", deletedBy).Msg("attribute audit configuration removed")
+	s.reloadRules(ctx)
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// reloadRules applies a configuration change on this replica immediately.
+func (s *auditConfigService) reloadRules(ctx context.Context) {
+	if s.interceptor == nil {
+		return
+	}
+	if err := s.interceptor.ReloadRules(ctx); err != nil {
+		s.log.Warn().Err(err).Msg("failed to reload audit configuration; it will refresh on its own")
+	}
+}
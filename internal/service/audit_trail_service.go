@@ -0,0 +1,225 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/config"
+	"github.com/enterprise-pms/pms-api/internal/domain/audit"
+	"github.com/enterprise-pms/pms-api/internal/domain/enums"
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"github.com/enterprise-pms/pms-api/internal/repository"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm/schema"
+)
+
+// ---------------------------------------------------------------------------
+// auditTrailService turns the one-row-per-field history in
+// pmsaudit.audit_logs into something investigators can read: the change-sets
+// of a record in order, the record's state as of any point in time, and the
+// fields that differ between two points in time.
+// ---------------------------------------------------------------------------
+
+// changeSetWindow is how far apart rows written by the same user for the same
+// record may be and still belong to one save. The audit interceptor stamps
+// every field of a save with one timestamp; explicit audit writes inside a
+// transaction are microseconds apart.
+const changeSetWindow = time.Second
+
+type auditTrailService struct {
+	auditRepo *repository.AuditRepository
+	log       zerolog.Logger
+}
+
+func newAuditTrailService(repos *repository.Container, cfg *config.Config, log zerolog.Logger) AuditTrailService {
+	return &auditTrailService{
+		auditRepo: repos.AuditLogs,
+		log:       log.With().Str("service", "audit_trail").Logger(),
+	}
+}
+
+// GetEntityTimeline returns the change history of a record grouped into
+// change-sets, oldest first.
+func (s *auditTrailService) GetEntityTimeline(ctx context.Context, tableName, recordID string) (performance.EntityTimelineResponseVm, error) {
+	resp := performance.EntityTimelineResponseVm{TableName: tableName, RecordID: recordID}
+
+	logs, err := s.auditRepo.ListByRecord(ctx, tableName, recordID, nil)
+	if err != nil {
+		return resp, err
+	}
+	if len(logs) == 0 {
+		resp.HasError = true
+		resp.Message = fmt.Sprintf("no audit history for %s %s", tableName, recordID)
+		return resp, nil
+	}
+
+	resp.Data = groupChangeSets(logs)
+	resp.TotalRecord = len(resp.Data)
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// GetEntityStateAsOf reconstructs the field values of a record at asOf by
+// replaying its audit history up to that instant.
+func (s *auditTrailService) GetEntityStateAsOf(ctx context.Context, tableName, recordID string, asOf time.Time) (performance.EntityStateResponseVm, error) {
+	resp := performance.EntityStateResponseVm{}
+
+	logs, err := s.auditRepo.ListByRecord(ctx, tableName, recordID, &asOf)
+	if err != nil {
+		return resp, err
+	}
+
+	state := reconstructState(logs)
+	resp.Data = &performance.EntityStateVm{
+		TableName:     tableName,
+		RecordID:      recordID,
+		AsOf:          asOf,
+		Exists:        state.exists,
+		IsDeleted:     state.deleted(),
+		LastChangedAt: state.lastChangedAt,
+		LastChangedBy: state.lastChangedBy,
+		Fields:        state.fields,
+	}
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// DiffEntityStates lists the fields of a record whose values at to differ
+// from their values at from.
+func (s *auditTrailService) DiffEntityStates(ctx context.Context, tableName, recordID string, from, to time.Time) (performance.EntityDiffResponseVm, error) {
+	resp := performance.EntityDiffResponseVm{}
+	if !from.Before(to) {
+		resp.HasError = true
+		resp.Message = "from must be earlier than to"
+		return resp, nil
+	}
+
+	logs, err := s.auditRepo.ListByRecord(ctx, tableName, recordID, &to)
+	if err != nil {
+		return resp, err
+	}
+
+	var before []audit.AuditLog
+	for _, l := range logs {
+		if l.AuditEventDateUTC.After(from) {
+			break
+		}
+		before = append(before, l)
+	}
+
+	resp.Data = &performance.EntityDiffVm{
+		TableName: tableName,
+		RecordID:  recordID,
+		From:      from,
+		To:        to,
+		Changes:   diffStates(reconstructState(before).fields, reconstructState(logs).fields),
+	}
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// groupChangeSets folds audit rows, ordered oldest first, into change-sets.
+// Consecutive rows belong to the same change-set when they share the user
+// and event type, fall within changeSetWindow of its first row and do not
+// repeat one of its fields.
+func groupChangeSets(logs []audit.AuditLog) []performance.AuditChangeSetVm {
+	var sets []performance.AuditChangeSetVm
+	var fields map[string]bool
+	for _, l := range logs {
+		field := auditFieldName(l.FieldName)
+		n := len(sets)
+		if n == 0 ||
+			sets[n-1].ChangedBy != l.UserName ||
+			sets[n-1].AuditEventType != int(l.AuditEventType) ||
+			l.AuditEventDateUTC.Sub(sets[n-1].ChangedAt) > changeSetWindow ||
+			fields[field] {
+			sets = append(sets, performance.AuditChangeSetVm{
+				ChangedBy:          l.UserName,
+				ChangedAt:          l.AuditEventDateUTC,
+				AuditEventType:     int(l.AuditEventType),
+				AuditEventTypeName: l.AuditEventType.String(),
+			})
+			fields = make(map[string]bool)
+			n++
+		}
+		fields[field] = true
+		sets[n-1].Changes = append(sets[n-1].Changes, performance.AuditFieldChangeVm{
+			FieldName:     field,
+			OriginalValue: l.OriginalValue,
+			NewValue:      l.NewValue,
+		})
+	}
+	return sets
+}
+
+// entityState is a record's state replayed from its audit rows.
+type entityState struct {
+	exists        bool
+	lastChangedAt *time.Time
+	lastChangedBy string
+	fields        map[string]string
+}
+
+func (e entityState) deleted() bool {
+	return e.fields["soft_deleted"] == "true"
+}
+
+// reconstructState replays audit rows, ordered oldest first, into the field
+// values they leave behind. Deletions are recorded as soft_deleted = true.
+func reconstructState(logs []audit.AuditLog) entityState {
+	state := entityState{fields: make(map[string]string)}
+	for _, l := range logs {
+		state.exists = true
+		at := l.AuditEventDateUTC
+		state.lastChangedAt = &at
+		state.lastChangedBy = l.UserName
+
+		field := auditFieldName(l.FieldName)
+		if l.AuditEventType == enums.AuditEventDeleted && field == "" {
+			field = "soft_deleted"
+		}
+		if field == "" {
+			continue
+		}
+		state.fields[field] = l.NewValue
+	}
+	return state
+}
+
+// diffStates returns the fields whose values differ between before and
+// after, ordered by field name.
+func diffStates(before, after map[string]string) []performance.AuditFieldChangeVm {
+	names := make(map[string]bool, len(after))
+	for name := range before {
+		names[name] = true
+	}
+	for name := range after {
+		names[name] = true
+	}
+
+	var changes []performance.AuditFieldChangeVm
+	for name := range names {
+		if before[name] != after[name] {
+			changes = append(changes, performance.AuditFieldChangeVm{
+				FieldName:     name,
+				OriginalValue: before[name],
+				NewValue:      after[name],
+			})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].FieldName < changes[j].FieldName })
+	return changes
+}
+
+// auditFieldName maps a logged field to its column name. The interceptor
+// has logged Go field names (ReviewPeriodID) for new records while explicit
+// audit writes use column names (review_period_id); both must land on the
+// same key when a record is replayed.
+func auditFieldName(name string) string {
+	if name == "" {
+		return ""
+	}
+	return schema.NamingStrategy{}.ColumnName("", name)
+}
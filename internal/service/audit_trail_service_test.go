@@ -0,0 +1,94 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/audit"
+	"github.com/enterprise-pms/pms-api/internal/domain/enums"
+)
+
+func auditRow(user string, at time.Time, event enums.AuditEventType, field, oldValue, newValue string) audit.AuditLog {
+	return audit.AuditLog{
+		UserName:          user,
+		AuditEventDateUTC: at,
+		AuditEventType:    event,
+		FieldName:         field,
+		OriginalValue:     oldValue,
+		NewValue:          newValue,
+	}
+}
+
+func workProductHistory() []audit.AuditLog {
+	t0 := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	return []audit.AuditLog{
+		auditRow("jdoe", t0, enums.AuditEventAdded, "Name", "", "Quarterly report"),
+		auditRow("jdoe", t0, enums.AuditEventAdded, "WorkProductStatus", "", "1"),
+		auditRow("mgr", t0.Add(time.Hour), enums.AuditEventModified, "work_product_status", "1", "4"),
+		auditRow("mgr", t0.Add(time.Hour+time.Millisecond), enums.AuditEventModified, "approver_comment", "", "ok"),
+		auditRow("mgr", t0.Add(time.Hour+2*time.Millisecond), enums.AuditEventModified, "work_product_status", "4", "5"),
+		auditRow("admin", t0.Add(48*time.Hour), enums.AuditEventDeleted, "soft_deleted", "false", "true"),
+	}
+}
+
+func TestGroupChangeSets(t *testing.T) {
+	sets := groupChangeSets(workProductHistory())
+
+	wantSizes := []int{2, 2, 1, 1}
+	if len(sets) != len(wantSizes) {
+		t.Fatalf("got %d change-sets, want %d", len(sets), len(wantSizes))
+	}
+	for i, n := range wantSizes {
+		if len(sets[i].Changes) != n {
+			t.Errorf("change-set %d has %d changes, want %d", i, len(sets[i].Changes), n)
+		}
+	}
+	if got := sets[0].Changes[1].FieldName; got != "work_product_status" {
+		t.Errorf("interceptor field name normalised to %q, want work_product_status", got)
+	}
+	if sets[3].AuditEventTypeName != "Deleted" || sets[3].ChangedBy != "admin" {
+		t.Errorf("last change-set = %+v, want a Deleted change by admin", sets[3])
+	}
+}
+
+func TestReconstructState(t *testing.T) {
+	logs := workProductHistory()
+
+	state := reconstructState(logs[:2])
+	if !state.exists || state.deleted() {
+		t.Fatalf("state after creation: exists=%v deleted=%v", state.exists, state.deleted())
+	}
+	if state.fields["work_product_status"] != "1" || state.fields["name"] != "Quarterly report" {
+		t.Errorf("state after creation = %v", state.fields)
+	}
+
+	state = reconstructState(logs)
+	if state.fields["work_product_status"] != "5" || !state.deleted() || state.lastChangedBy != "admin" {
+		t.Errorf("final state = %v (lastChangedBy %s)", state.fields, state.lastChangedBy)
+	}
+
+	if empty := reconstructState(nil); empty.exists || empty.lastChangedAt != nil {
+		t.Error("state with no history should not exist")
+	}
+}
+
+func TestDiffStates(t *testing.T) {
+	before := map[string]string{"name": "A", "status": "1", "comment": "x"}
+	after := map[string]string{"name": "A", "status": "4", "approver": "mgr"}
+
+	changes := diffStates(before, after)
+	want := []struct{ field, from, to string }{
+		{"approver", "", "mgr"},
+		{"comment", "x", ""},
+		{"status", "1", "4"},
+	}
+	if len(changes) != len(want) {
+		t.Fatalf("got %d changes, want %d: %+v", len(changes), len(want), changes)
+	}
+	for i, w := range want {
+		c := changes[i]
+		if c.FieldName != w.field || c.OriginalValue != w.from || c.NewValue != w.to {
+			t.Errorf("change %d = %+v, want %s: %q -> %q", i, c, w.field, w.from, w.to)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"time"
 
@@ -20,14 +21,17 @@ import (
 // JWT token issuance, and dynamic role assignment.
 // This mirrors the .NET AuthController's login flow.
 type authService struct {
-	db      *gorm.DB
-	users   *UserManagementService
-	jwt     *JWTService
-	ad      ActiveDirectoryService
-	gs      GlobalSettingService
-	erpSQL  *repository.Container
-	cfg     *config.Config
-	log     zerolog.Logger
+	db       *gorm.DB
+	users    *UserManagementService
+	jwt      *JWTService
+	ad       ActiveDirectoryService
+	gs       GlobalSettingService
+	rsa      RSAAuthService
+	oidc     *oidcClient
+	erpSQL   *repository.Container
+	sessions *sessionCache
+	cfg      *config.Config
+	log      zerolog.Logger
 }
 
 func newAuthService(repos *repository.Container, cfg *config.Config, log zerolog.Logger) AuthService {
@@ -37,14 +41,17 @@ func newAuthService(repos *repository.Container, cfg *config.Config, log zerolog
 	gsSvc := newGlobalSettingService(repos, log)
 
 	return &authService{
-		db:     repos.GormDB,
-		users:  users,
-		jwt:    jwtSvc,
-		ad:     adSvc,
-		gs:     gsSvc,
-		erpSQL: repos,
-		cfg:    cfg,
-		log:    log,
+		db:       repos.GormDB,
+		users:    users,
+		jwt:      jwtSvc,
+		ad:       adSvc,
+		gs:       gsSvc,
+		rsa:      newRSAAuthService(cfg.RSA, gsSvc, log),
+		oidc:     newOIDCClient(cfg.OIDC),
+		erpSQL:   repos,
+		sessions: newSessionCache(),
+		cfg:      cfg,
+		log:      log,
 	}
 }
 
@@ -53,7 +60,8 @@ func newAuthService(repos *repository.Container, cfg *config.Config, log zerolog
 //  2. If AD auth fails or is disabled, fall back to local DB password verification.
 //
 // On success it performs dynamic role assignment and returns an AuthenticateResponse
-// with JWT access and refresh tokens.
+// with JWT access and refresh tokens. When the user must complete a second
+// factor it returns an MFAChallengeResponse instead; see VerifyMFA.
 func (s *authService) AuthenticateAD(ctx context.Context, username, password string) (interface{}, error) {
 	// Check if AD authentication is enabled
 	adEnabled, _ := s.gs.GetBoolValue(ctx, auth.SettingEnableADAuth)
@@ -98,15 +106,7 @@ func (s *authService) AuthenticateAD(ctx context.Context, username, password str
 			return nil, fmt.Errorf("user account is deactivated")
 		}
 		if !s.users.VerifyPassword(user, password) {
-			maxAttempts, _ := s.gs.GetIntValue(ctx, auth.SettingMaxFailedAttempts)
-			lockoutMin, _ := s.gs.GetIntValue(ctx, auth.SettingLockoutDuration)
-			if maxAttempts <= 0 {
-				maxAttempts = 5
-			}
-			if lockoutMin <= 0 {
-				lockoutMin = 15
-			}
-			_ = s.users.IncrementAccessFailedCount(ctx, user, maxAttempts, lockoutMin)
+			s.recordFailedAttempt(ctx, user)
 			return nil, fmt.Errorf("invalid username or password")
 		}
 		authenticated = true
@@ -116,9 +116,6 @@ func (s *authService) AuthenticateAD(ctx context.Context, username, password str
 		return nil, fmt.Errorf("authentication failed")
 	}
 
-	// Reset failed attempts on success
-	_ = s.users.ResetAccessFailedCount(ctx, user)
-
 	// Dynamic role assignment (mirrors .NET AuthController role logic)
 	roles, err := s.resolveRoles(ctx, user)
 	if err != nil {
@@ -126,10 +123,44 @@ func (s *authService) AuthenticateAD(ctx context.Context, username, password str
 		roles = []string{auth.RoleStaff}
 	}
 
+	// Second factor: the failed-attempt counter is only reset once the
+	// token code is verified, so MFA failures count towards lockout.
+	if s.mfaRequired(ctx, user, roles) {
+		return s.issueMFAChallenge(ctx, user)
+	}
+
+	// Reset failed attempts on success
+	_ = s.users.ResetAccessFailedCount(ctx, user)
+
+	return s.issueSession(ctx, user, roles)
+}
+
+// recordFailedAttempt counts a failed password or token code towards the
+// user's lockout.
+func (s *authService) recordFailedAttempt(ctx context.Context, user *identity.ApplicationUser) {
+	maxAttempts, _ := s.gs.GetIntValue(ctx, auth.SettingMaxFailedAttempts)
+	lockoutMin, _ := s.gs.GetIntValue(ctx, auth.SettingLockoutDuration)
+	if maxAttempts <= 0 {
+		maxAttempts = 5
+	}
+	if lockoutMin <= 0 {
+		lockoutMin = 15
+	}
+	_ = s.users.IncrementAccessFailedCount(ctx, user, maxAttempts, lockoutMin)
+}
+
+// issueSession opens a session for an authenticated user, generates its
+// access and refresh tokens and builds the login response.
+func (s *authService) issueSession(ctx context.Context, user *identity.ApplicationUser, roles []string) (*auth.AuthenticateResponse, error) {
+	session, err := s.openSession(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Resolve permissions from role-permission junction table
 	permissions, permErr := s.users.GetPermissionsByRoles(ctx, roles)
 	if permErr != nil {
-		s.log.Warn().Err(permErr).Str("user", username).Msg("Failed to resolve permissions")
+		s.log.Warn().Err(permErr).Str("user", user.UserName).Msg("Failed to resolve permissions")
 	}
 
 	// Resolve organizational unit from ERP data
@@ -144,6 +175,7 @@ func (s *authService) AuthenticateAD(ctx context.Context, username, password str
 	// Generate tokens with all claims: UserId, Email, FullName, Roles, Permissions, OrganizationalUnit
 	claims := TokenClaims{
 		UserID:             user.ID,
+		SessionID:          session.ID,
 		Email:              user.Email,
 		Name:               user.FullName(),
 		Roles:              roles,
@@ -161,7 +193,7 @@ func (s *authService) AuthenticateAD(ctx context.Context, username, password str
 	}
 
 	// Persist the hashed refresh token in the database for validation and revocation.
-	if err := s.storeRefreshToken(ctx, user.ID, refreshToken); err != nil {
+	if err := s.storeRefreshToken(ctx, user.ID, session.ID, refreshToken); err != nil {
 		return nil, fmt.Errorf("storing refresh token: %w", err)
 	}
 
@@ -179,7 +211,7 @@ func (s *authService) AuthenticateAD(ctx context.Context, username, password str
 		ExpiresAt:          expiresAt,
 	}
 
-	s.log.Info().Str("user", username).Strs("roles", roles).Msg("User authenticated")
+	s.log.Info().Str("user", user.UserName).Strs("roles", roles).Msg("User authenticated")
 	return resp, nil
 }
 
@@ -192,8 +224,14 @@ func (s *authService) GenerateTokenPair(ctx context.Context, userID string, role
 
 	permissions, _ := s.users.GetPermissionsByRoles(ctx, roles)
 
+	session, err := s.openSession(ctx, user.ID)
+	if err != nil {
+		return "", "", err
+	}
+
 	claims := TokenClaims{
 		UserID:      user.ID,
+		SessionID:   session.ID,
 		Email:       user.Email,
 		Name:        user.FullName(),
 		Roles:       roles,
@@ -212,7 +250,7 @@ func (s *authService) GenerateTokenPair(ctx context.Context, userID string, role
 	}
 
 	// Persist the hashed refresh token in the database.
-	if err := s.storeRefreshToken(ctx, user.ID, refreshToken); err != nil {
+	if err := s.storeRefreshToken(ctx, user.ID, session.ID, refreshToken); err != nil {
 		return "", "", fmt.Errorf("storing refresh token: %w", err)
 	}
 
@@ -226,6 +264,8 @@ func (s *authService) ValidateToken(ctx context.Context, token string) (interfac
 
 // RefreshAccessToken validates a refresh token against the database, issues a
 // new JWT access token, and rotates the refresh token (revoke old, create new).
+// Refresh tokens are single-use: presenting one that was already rotated
+// means it was copied, so the whole session it belongs to is revoked.
 func (s *authService) RefreshAccessToken(ctx context.Context, refreshToken string) (*auth.TokenResponse, error) {
 	tokenHash := hashToken(refreshToken)
 
@@ -241,7 +281,7 @@ func (s *authService) RefreshAccessToken(ctx context.Context, refreshToken strin
 
 	// Validate the token is still usable.
 	if stored.Revoked {
-		s.log.Warn().Str("user_id", stored.UserID).Msg("Attempt to use revoked refresh token")
+		s.revokeReusedTokenSession(ctx, &stored)
 		return nil, fmt.Errorf("refresh token has been revoked")
 	}
 	if stored.IsExpired() {
@@ -249,6 +289,20 @@ func (s *authService) RefreshAccessToken(ctx context.Context, refreshToken strin
 		return nil, fmt.Errorf("refresh token has expired")
 	}
 
+	// Rotate: revoke the presented token before anything else so that two
+	// concurrent refreshes with the same token cannot both succeed.
+	rotated := s.db.WithContext(ctx).Model(&auth.RefreshToken{}).
+		Where("id = ? AND revoked = ?", stored.ID, false).
+		Update("revoked", true)
+	if rotated.Error != nil {
+		s.log.Error().Err(rotated.Error).Str("token_id", stored.ID).Msg("Failed to revoke old refresh token")
+		return nil, fmt.Errorf("revoking old refresh token: %w", rotated.Error)
+	}
+	if rotated.RowsAffected == 0 {
+		s.revokeReusedTokenSession(ctx, &stored)
+		return nil, fmt.Errorf("refresh token has been revoked")
+	}
+
 	// Load the user associated with the token.
 	user, err := s.users.FindByID(ctx, stored.UserID)
 	if err != nil {
@@ -261,6 +315,13 @@ func (s *authService) RefreshAccessToken(ctx context.Context, refreshToken strin
 		return nil, fmt.Errorf("user account is deactivated")
 	}
 
+	// Continue the token's session; tokens issued before sessions existed
+	// get a new one.
+	sessionID, err := s.touchSession(ctx, &stored)
+	if err != nil {
+		return nil, err
+	}
+
 	// Resolve roles and permissions for the new access token.
 	roles, err := s.resolveRoles(ctx, user)
 	if err != nil {
@@ -279,6 +340,7 @@ func (s *authService) RefreshAccessToken(ctx context.Context, refreshToken strin
 	expiryMinutes, _ := s.gs.GetIntValue(ctx, auth.SettingTokenExpiryMinutes)
 	claims := TokenClaims{
 		UserID:             user.ID,
+		SessionID:          sessionID,
 		Email:              user.Email,
 		Name:               user.FullName(),
 		Roles:              roles,
@@ -290,18 +352,12 @@ func (s *authService) RefreshAccessToken(ctx context.Context, refreshToken strin
 		return nil, fmt.Errorf("generating new access token: %w", err)
 	}
 
-	// Rotate: revoke the old refresh token and issue a new one.
-	if err := s.db.WithContext(ctx).Model(&stored).Update("revoked", true).Error; err != nil {
-		s.log.Error().Err(err).Str("token_id", stored.ID).Msg("Failed to revoke old refresh token")
-		return nil, fmt.Errorf("revoking old refresh token: %w", err)
-	}
-
 	newRefreshToken, err := s.jwt.GenerateRefreshToken()
 	if err != nil {
 		return nil, fmt.Errorf("generating new refresh token: %w", err)
 	}
 
-	if err := s.storeRefreshToken(ctx, user.ID, newRefreshToken); err != nil {
+	if err := s.storeRefreshToken(ctx, user.ID, sessionID, newRefreshToken); err != nil {
 		return nil, fmt.Errorf("storing rotated refresh token: %w", err)
 	}
 
@@ -314,6 +370,50 @@ func (s *authService) RefreshAccessToken(ctx context.Context, refreshToken strin
 	}, nil
 }
 
+// revokeReusedTokenSession handles a refresh token presented after it was
+// rotated or revoked by revoking every token in its session.
+func (s *authService) revokeReusedTokenSession(ctx context.Context, stored *auth.RefreshToken) {
+	s.log.Warn().Str("user_id", stored.UserID).Str("session_id", stored.SessionID).Msg("Refresh token reuse detected, revoking session")
+	if stored.SessionID == "" {
+		return
+	}
+	if _, err := s.revokeSessions(ctx, auth.SessionRevokedReuse, "id = ?", stored.SessionID); err != nil && !errors.Is(err, ErrSessionNotFound) {
+		s.log.Error().Err(err).Str("session_id", stored.SessionID).Msg("Failed to revoke session after refresh token reuse")
+	}
+}
+
+// touchSession checks that the token's session is still active, records the
+// refresh on it and extends its expiry. It returns the session ID.
+func (s *authService) touchSession(ctx context.Context, stored *auth.RefreshToken) (string, error) {
+	if stored.SessionID == "" {
+		session, err := s.openSession(ctx, stored.UserID)
+		if err != nil {
+			return "", err
+		}
+		return session.ID, nil
+	}
+
+	info := clientInfoFrom(ctx)
+	now := time.Now().UTC()
+	updates := map[string]interface{}{
+		"last_used_at": now,
+		"expires_at":   now.Add(s.refreshTokenExpiry()),
+	}
+	if info.IPAddress != "" {
+		updates["ip_address"] = info.IPAddress
+	}
+	result := s.db.WithContext(ctx).Model(&auth.UserSession{}).
+		Where("id = ? AND revoked_at IS NULL", stored.SessionID).
+		Updates(updates)
+	if result.Error != nil {
+		return "", fmt.Errorf("updating session: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return "", fmt.Errorf("session has been revoked")
+	}
+	return stored.SessionID, nil
+}
+
 // hashToken produces a hex-encoded SHA-256 digest of the plaintext token.
 func hashToken(plaintext string) string {
 	h := sha256.Sum256([]byte(plaintext))
@@ -322,15 +422,13 @@ func hashToken(plaintext string) string {
 
 // storeRefreshToken hashes the plaintext refresh token and persists it
 // in the database. The expiry is derived from the JWT config.
-func (s *authService) storeRefreshToken(ctx context.Context, userID, plaintext string) error {
-	expiry := s.cfg.JWT.RefreshTokenExpiry
-	if expiry <= 0 {
-		expiry = 7 * 24 * time.Hour // default 7 days
-	}
+func (s *authService) storeRefreshToken(ctx context.Context, userID, sessionID, plaintext string) error {
+	expiry := s.refreshTokenExpiry()
 
 	rt := auth.RefreshToken{
 		ID:        uuid.NewString(),
 		UserID:    userID,
+		SessionID: sessionID,
 		Token:     hashToken(plaintext),
 		ExpiresAt: time.Now().UTC().Add(expiry),
 		CreatedAt: time.Now().UTC(),
@@ -360,9 +458,9 @@ func (s *authService) provisionADUser(ctx context.Context, username string) (*id
 	}
 
 	user := &identity.ApplicationUser{
-		ID:       username, // Use sAMAccountName as the ID for AD users
-		UserName: username,
-		Email:    adUser.Email,
+		ID:        username, // Use sAMAccountName as the ID for AD users
+		UserName:  username,
+		Email:     adUser.Email,
 		FirstName: adUser.FirstName,
 		LastName:  adUser.LastName,
 		IsActive:  true,
@@ -384,9 +482,15 @@ func (s *authService) resolveRoles(ctx context.Context, user *identity.Applicati
 		return nil, err
 	}
 
-	// If no roles assigned, default to Staff
-	if len(roles) == 0 {
-		roles = append(roles, auth.RoleStaff)
+	roles = withStaffRole(roles)
+
+	// Roles granted through IdP groups at the user's last SSO login
+	if s.cfg.OIDC.Enabled {
+		idpRoles, err := s.idpRoles(ctx, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		roles = mergeRoles(roles, idpRoles)
 	}
 
 	// Dynamic role resolution from ERP data (if ERP DB is available)
@@ -398,6 +502,14 @@ func (s *authService) resolveRoles(ctx context.Context, user *identity.Applicati
 	return roles, nil
 }
 
+// withStaffRole adds Staff to a user's persisted roles. Every user is a
+// member of staff, and the self-service permissions (one's own objectives,
+// scores, feedback and grievances) are granted to that role only, so admin
+// and HR users keep access to their own data.
+func withStaffRole(roles []string) []string {
+	return mergeRoles(roles, []string{auth.RoleStaff})
+}
+
 // resolveOrgHierarchyRoles queries the ERP database to determine if the user
 // holds a leadership position (HeadOfOffice, HeadOfDivision, HeadOfDepartment, Supervisor).
 // Mirrors the .NET AuthController's ERP-based role assignment.
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/auth"
+)
+
+func TestWithStaffRoleKeepsSelfService(t *testing.T) {
+	for _, persisted := range [][]string{
+		nil,
+		{auth.RoleAdmin},
+		{auth.RoleHrAdmin, auth.RoleSecurityAdmin},
+		{auth.RoleHRD, auth.RoleStaff},
+	} {
+		roles := withStaffRole(persisted)
+		for _, role := range persisted {
+			if !slices.Contains(roles, role) {
+				t.Errorf("withStaffRole(%v) dropped %s", persisted, role)
+			}
+		}
+		// Feedback.Participate and Performance.View guard self-service
+		// routes such as POST /api/v1/pms-engine/feedback/requests/treat.
+		for _, perm := range auth.Permissions() {
+			if perm.Name != auth.PermFeedbackParticipate && perm.Name != auth.PermPerformanceView {
+				continue
+			}
+			if !slices.ContainsFunc(perm.DefaultRoles, func(r string) bool { return slices.Contains(roles, r) }) {
+				t.Errorf("roles %v resolved from %v are not granted %s", roles, persisted, perm.Name)
+			}
+		}
+	}
+}
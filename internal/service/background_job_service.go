@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/config"
+	"github.com/enterprise-pms/pms-api/internal/domain/enums"
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"github.com/enterprise-pms/pms-api/internal/repository"
+	"github.com/rs/zerolog"
+)
+
+// ---------------------------------------------------------------------------
+// backgroundJobService gives administrators visibility of the persistent job
+// queue in pms.background_jobs: listing jobs by status, retrying failed or
+// dead-lettered jobs and cancelling jobs that have not started. The queue
+// workers themselves live in the jobs package.
+// ---------------------------------------------------------------------------
+
+type backgroundJobService struct {
+	jobRepo *repository.JobRepository
+	log     zerolog.Logger
+}
+
+func newBackgroundJobService(repos *repository.Container, cfg *config.Config, log zerolog.Logger) BackgroundJobService {
+	return &backgroundJobService{
+		jobRepo: repos.Jobs,
+		log:     log.With().Str("service", "background_job").Logger(),
+	}
+}
+
+// ListJobs returns a page of jobs matching the search filters together with
+// the number of jobs in each status.
+func (s *backgroundJobService) ListJobs(ctx context.Context, search *performance.BackgroundJobSearchModel) (performance.BackgroundJobListResponseVm, error) {
+	resp := performance.BackgroundJobListResponseVm{}
+
+	jobs, total, err := s.jobRepo.List(ctx, enums.BackgroundJobStatus(search.JobStatus), search.JobType, search.Skip, pageSize(search.PageSize))
+	if err != nil {
+		return resp, err
+	}
+	counts, err := s.jobRepo.CountByStatus(ctx)
+	if err != nil {
+		return resp, err
+	}
+
+	for _, j := range jobs {
+		resp.Data = append(resp.Data, toBackgroundJobVm(j))
+	}
+	resp.TotalRecord = int(total)
+	resp.StatusCounts = make(map[string]int, len(counts))
+	for status, n := range counts {
+		resp.StatusCounts[status.String()] = n
+	}
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// GetJob returns a single job including its payload and last error.
+func (s *backgroundJobService) GetJob(ctx context.Context, jobID string) (performance.BackgroundJobResponseVm, error) {
+	resp := performance.BackgroundJobResponseVm{}
+
+	job, err := s.jobRepo.GetByID(ctx, jobID)
+	if err != nil {
+		return resp, err
+	}
+	if job == nil {
+		resp.HasError = true
+		resp.Message = fmt.Sprintf("background job %s not found", jobID)
+		return resp, nil
+	}
+
+	vm := toBackgroundJobVm(*job)
+	resp.Data = &vm
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// RetryJob makes a dead-lettered, cancelled or retry-pending job due now
+// with a fresh attempt budget.
+func (s *backgroundJobService) RetryJob(ctx context.Context, jobID, requestedBy string) (performance.ResponseVm, error) {
+	resp := performance.ResponseVm{ID: jobID}
+
+	job, err := s.jobRepo.GetByID(ctx, jobID)
+	if err != nil {
+		return resp, err
+	}
+	if job == nil {
+		resp.HasError = true
+		resp.Message = fmt.Sprintf("background job %s not found", jobID)
+		return resp, nil
+	}
+	if job.IdempotencyKey != "" && !job.IsPending() {
+		if other, err := s.jobRepo.GetPendingByIdempotencyKey(ctx, job.IdempotencyKey); err == nil {
+			resp.HasError = true
+			resp.Message = fmt.Sprintf("job %s with the same idempotency key is already pending", other.BackgroundJobID)
+			return resp, nil
+		}
+	}
+
+	ok, err := s.jobRepo.Requeue(repository.WithAuditUser(ctx, requestedBy), jobID, requestedBy, time.Now().UTC())
+	if err != nil {
+		s.log.Error().Err(err).Str("action", "RETRY_JOB").Str("jobId", jobID).Msg("failed to retry background job")
+		return resp, err
+	}
+	if !ok {
+		resp.HasError = true
+		resp.Message = fmt.Sprintf("a %s job cannot be retried", job.JobStatus)
+		return resp, nil
+	}
+
+	s.log.Info().Str("jobId", jobID).Str("jobType", job.JobType).Str("requestedBy", requestedBy).Msg("background job requeued")
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// CancelJob stops a job that is enqueued or awaiting retry. Running jobs
+// cannot be cancelled.
+func (s *backgroundJobService) CancelJob(ctx context.Context, jobID, requestedBy string) (performance.ResponseVm, error) {
+	resp := performance.ResponseVm{ID: jobID}
+
+	job, err := s.jobRepo.GetByID(ctx, jobID)
+	if err != nil {
+		return resp, err
+	}
+	if job == nil {
+		resp.HasError = true
+		resp.Message = fmt.Sprintf("background job %s not found", jobID)
+		return resp, nil
+	}
+
+	ok, err := s.jobRepo.Cancel(repository.WithAuditUser(ctx, requestedBy), jobID, requestedBy, time.Now().UTC())
+	if err != nil {
+		s.log.Error().Err(err).Str("action", "CANCEL_JOB").Str("jobId", jobID).Msg("failed to cancel background job")
+		return resp, err
+	}
+	if !ok {
+		resp.HasError = true
+		resp.Message = fmt.Sprintf("a %s job cannot be cancelled", job.JobStatus)
+		return resp, nil
+	}
+
+	s.log.Info().Str("jobId", jobID).Str("jobType", job.JobType).Str("requestedBy", requestedBy).Msg("background job cancelled")
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+func toBackgroundJobVm(j performance.BackgroundJob) performance.BackgroundJobVm {
+	return performance.BackgroundJobVm{
+		BaseEntityVm:    toBaseEntityVm(j.BaseEntity),
+		BackgroundJobID: j.BackgroundJobID,
+		JobType:         j.JobType,
+		Queue:           j.Queue,
+		Payload:         j.Payload,
+		IdempotencyKey:  j.IdempotencyKey,
+		JobStatus:       int(j.JobStatus),
+		JobStatusName:   j.JobStatus.String(),
+		Attempts:        j.Attempts,
+		MaxAttempts:     j.MaxAttempts,
+		RunAt:           j.RunAt,
+		LockedBy:        j.LockedBy,
+		LockedUntil:     j.LockedUntil,
+		LastError:       j.LastError,
+		StartedAt:       j.StartedAt,
+		CompletedAt:     j.CompletedAt,
+	}
+}
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/config"
+)
+
+// businessCalendarHorizon bounds how many days the calendar walks when
+// adding business time, so a calendar with every day closed cannot loop.
+const businessCalendarHorizon = 3 * 366
+
+// businessCalendar measures time in working hours: only the span between the
+// opening and closing time of a working weekday counts, and closed dates
+// (public holidays, absences) are skipped entirely.
+type businessCalendar struct {
+	loc      *time.Location
+	workdays [7]bool
+	open     int // minutes after midnight
+	close    int
+	closed   map[string]bool // "2006-01-02" in loc
+}
+
+// newBusinessCalendar builds a calendar from the SLA configuration, falling
+// back to Monday to Friday, 08:00 to 17:00 for missing or invalid values.
+func newBusinessCalendar(cfg config.SLAConfig) (businessCalendar, error) {
+	c := businessCalendar{loc: time.Local, open: 8 * 60, close: 17 * 60}
+	var errs []string
+
+	if cfg.Timezone != "" {
+		if loc, err := time.LoadLocation(cfg.Timezone); err == nil {
+			c.loc = loc
+		} else {
+			errs = append(errs, fmt.Sprintf("timezone %q: %v", cfg.Timezone, err))
+		}
+	}
+
+	for _, name := range cfg.WorkingDays {
+		day, ok := parseWeekday(name)
+		if !ok {
+			errs = append(errs, fmt.Sprintf("unknown working day %q", name))
+			continue
+		}
+		c.workdays[day] = true
+	}
+	if c.workdays == [7]bool{} {
+		for d := time.Monday; d <= time.Friday; d++ {
+			c.workdays[d] = true
+		}
+	}
+
+	open, okOpen := parseClock(cfg.DayStart)
+	closeAt, okClose := parseClock(cfg.DayEnd)
+	switch {
+	case cfg.DayStart == "" && cfg.DayEnd == "":
+	case !okOpen || !okClose || closeAt <= open:
+		errs = append(errs, fmt.Sprintf("working hours %q to %q", cfg.DayStart, cfg.DayEnd))
+	default:
+		c.open, c.close = open, closeAt
+	}
+
+	if len(errs) > 0 {
+		return c, fmt.Errorf("sla calendar: %s", strings.Join(errs, "; "))
+	}
+	return c, nil
+}
+
+// withClosedDays returns a copy of the calendar with the given dates closed.
+func (c businessCalendar) withClosedDays(days ...time.Time) businessCalendar {
+	closed := make(map[string]bool, len(c.closed)+len(days))
+	for k := range c.closed {
+		closed[k] = true
+	}
+	for _, d := range days {
+		closed[d.In(c.loc).Format("2006-01-02")] = true
+	}
+	c.closed = closed
+	return c
+}
+
+// workingDay is the length of one full working day.
+func (c businessCalendar) workingDay() time.Duration {
+	return time.Duration(c.close-c.open) * time.Minute
+}
+
+// hours returns the working hours of the day containing t, or false when
+// the day is not a working day.
+func (c businessCalendar) hours(t time.Time) (time.Time, time.Time, bool) {
+	t = t.In(c.loc)
+	if !c.workdays[t.Weekday()] || c.closed[t.Format("2006-01-02")] {
+		return time.Time{}, time.Time{}, false
+	}
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, c.open, 0, 0, c.loc), time.Date(y, m, d, 0, c.close, 0, 0, c.loc), true
+}
+
+// nextDay returns midnight of the day after the one containing t.
+func (c businessCalendar) nextDay(t time.Time) time.Time {
+	y, m, d := t.In(c.loc).Date()
+	return time.Date(y, m, d+1, 0, 0, 0, 0, c.loc)
+}
+
+// Elapsed returns the business time between from and to.
+func (c businessCalendar) Elapsed(from, to time.Time) time.Duration {
+	var total time.Duration
+	for day := from; day.Before(to); day = c.nextDay(day) {
+		open, closeAt, ok := c.hours(day)
+		if !ok {
+			continue
+		}
+		if from.After(open) {
+			open = from
+		}
+		if to.Before(closeAt) {
+			closeAt = to
+		}
+		if closeAt.After(open) {
+			total += closeAt.Sub(open)
+		}
+	}
+	return total
+}
+
+// Add returns the moment d of business time after from.
+func (c businessCalendar) Add(from time.Time, d time.Duration) time.Time {
+	day := from
+	for i := 0; i < businessCalendarHorizon; i, day = i+1, c.nextDay(day) {
+		open, closeAt, ok := c.hours(day)
+		if !ok || !closeAt.After(from) {
+			continue
+		}
+		if from.After(open) {
+			open = from
+		}
+		left := closeAt.Sub(open)
+		if d <= left {
+			return open.Add(d)
+		}
+		d -= left
+	}
+	return day
+}
+
+func parseWeekday(name string) (time.Weekday, bool) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(strings.TrimSpace(name), d.String()) {
+			return d, true
+		}
+	}
+	return 0, false
+}
+
+// parseClock parses "15:04" into minutes after midnight.
+func parseClock(s string) (int, bool) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, false
+	}
+	return t.Hour()*60 + t.Minute(), true
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/config"
+)
+
+func testCalendar(t *testing.T) businessCalendar {
+	t.Helper()
+	c, err := newBusinessCalendar(config.SLAConfig{
+		WorkingDays: []string{"Monday", "Tuesday", "wednesday", "Thursday", "Friday"},
+		DayStart:    "08:00",
+		DayEnd:      "17:00",
+		Timezone:    "UTC",
+	})
+	if err != nil {
+		t.Fatalf("newBusinessCalendar: %v", err)
+	}
+	return c
+}
+
+// at returns a time in May 2026; the 11th is a Monday.
+func at(day, hour, minute int) time.Time {
+	return time.Date(2026, 5, day, hour, minute, 0, 0, time.UTC)
+}
+
+func TestNewBusinessCalendarInvalid(t *testing.T) {
+	c, err := newBusinessCalendar(config.SLAConfig{WorkingDays: []string{"Funday"}, DayStart: "17:00", DayEnd: "08:00"})
+	if err == nil {
+		t.Fatal("expected an error for an unknown day and inverted hours")
+	}
+	if c.workingDay() != 9*time.Hour || !c.workdays[time.Monday] || c.workdays[time.Saturday] {
+		t.Errorf("invalid settings did not fall back to the defaults: %+v", c)
+	}
+}
+
+func TestBusinessCalendarElapsed(t *testing.T) {
+	c := testCalendar(t)
+	holiday := c.withClosedDays(at(13, 0, 0))
+
+	tests := []struct {
+		name     string
+		calendar businessCalendar
+		from, to time.Time
+		want     time.Duration
+	}{
+		{"same day", c, at(11, 9, 0), at(11, 12, 30), 3*time.Hour + 30*time.Minute},
+		{"before opening to after closing", c, at(11, 6, 0), at(11, 20, 0), 9 * time.Hour},
+		{"overnight", c, at(11, 16, 0), at(12, 9, 0), 2 * time.Hour},
+		{"over the weekend", c, at(15, 16, 0), at(18, 9, 0), 2 * time.Hour},
+		{"weekend only", c, at(16, 9, 0), at(17, 18, 0), 0},
+		{"full week", c, at(11, 8, 0), at(18, 8, 0), 45 * time.Hour},
+		{"skips holiday", holiday, at(12, 16, 0), at(14, 9, 0), 2 * time.Hour},
+		{"reversed", c, at(12, 9, 0), at(11, 9, 0), 0},
+	}
+	for _, tt := range tests {
+		if got := tt.calendar.Elapsed(tt.from, tt.to); got != tt.want {
+			t.Errorf("%s: Elapsed = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestBusinessCalendarAdd(t *testing.T) {
+	c := testCalendar(t)
+	holiday := c.withClosedDays(at(13, 0, 0))
+
+	tests := []struct {
+		name     string
+		calendar businessCalendar
+		from     time.Time
+		d        time.Duration
+		want     time.Time
+	}{
+		{"within the day", c, at(11, 9, 0), 3 * time.Hour, at(11, 12, 0)},
+		{"ends at closing", c, at(11, 8, 0), 9 * time.Hour, at(11, 17, 0)},
+		{"rolls to next day", c, at(11, 16, 0), 2 * time.Hour, at(12, 9, 0)},
+		{"starts before opening", c, at(11, 5, 0), time.Hour, at(11, 9, 0)},
+		{"starts after closing", c, at(11, 19, 0), time.Hour, at(12, 9, 0)},
+		{"rolls over the weekend", c, at(15, 16, 0), 2 * time.Hour, at(18, 9, 0)},
+		{"starts on the weekend", c, at(16, 12, 0), 0, at(18, 8, 0)},
+		{"skips holiday", holiday, at(12, 16, 0), 2 * time.Hour, at(14, 9, 0)},
+	}
+	for _, tt := range tests {
+		if got := tt.calendar.Add(tt.from, tt.d); !got.Equal(tt.want) {
+			t.Errorf("%s: Add = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestMeasureSLA(t *testing.T) {
+	c := testCalendar(t)
+	leave := c.withClosedDays(at(18, 0, 0), at(19, 0, 0))
+	initiated := at(11, 10, 0) // Monday
+	done := func(tm time.Time) *time.Time { return &tm }
+
+	tests := []struct {
+		name      string
+		calendar  businessCalendar
+		completed *time.Time
+		now       time.Time
+		due       time.Time
+		breached  bool
+	}{
+		// 168 hours is seven working days, due the Wednesday after next.
+		{"pending within SLA", c, nil, at(19, 12, 0), at(20, 10, 0), false},
+		{"pending past due", c, nil, at(20, 10, 0), at(20, 10, 0), true},
+		{"leave moves the due time", leave, nil, at(20, 10, 0), at(22, 10, 0), false},
+		{"completed in time", c, done(at(20, 9, 0)), at(25, 9, 0), at(20, 10, 0), false},
+		{"completed late", c, done(at(20, 11, 0)), at(25, 9, 0), at(20, 10, 0), true},
+		{"completed late without leave", leave, done(at(21, 11, 0)), at(25, 9, 0), at(22, 10, 0), false},
+	}
+	for _, tt := range tests {
+		st := measureSLA(tt.calendar, initiated, tt.completed, 168, tt.now)
+		if st.Allowed != 63*time.Hour {
+			t.Errorf("%s: allowed %s, want 63h", tt.name, st.Allowed)
+		}
+		if !st.DueAt.Equal(tt.due) || st.IsBreached != tt.breached {
+			t.Errorf("%s: due %s breached %v, want %s %v", tt.name, st.DueAt, st.IsBreached, tt.due, tt.breached)
+		}
+	}
+}
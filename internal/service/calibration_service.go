@@ -0,0 +1,968 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/config"
+	"github.com/enterprise-pms/pms-api/internal/domain/audit"
+	"github.com/enterprise-pms/pms-api/internal/domain/enums"
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"github.com/enterprise-pms/pms-api/internal/repository"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+)
+
+// ---------------------------------------------------------------------------
+// calibrationService compares the grade distribution of an organisational
+// unit in a closed review period against configured quotas, proposes grade
+// changes that bring it within quota and records the calibration committee's
+// decisions. Finalising a session writes the calibrated grade next to the
+// raw FinalGrade on each PeriodScore; every change is written to the audit log.
+// ---------------------------------------------------------------------------
+
+type calibrationService struct {
+	db         *gorm.DB
+	erpRepo    *repository.ErpRepository
+	gradingSvc GradingScaleService
+	log        zerolog.Logger
+}
+
+func newCalibrationService(repos *repository.Container, cfg *config.Config, log zerolog.Logger, gradingSvc GradingScaleService) CalibrationService {
+	return &calibrationService{
+		db:         repos.GormDB,
+		erpRepo:    repos.Erp,
+		gradingSvc: gradingSvc,
+		log:        log.With().Str("service", "calibration").Logger(),
+	}
+}
+
+// ==========================================================================
+// Quotas
+// ==========================================================================
+
+// GetCalibrationQuotas lists the quotas that apply to every period together
+// with any quotas specific to reviewPeriodID.
+func (s *calibrationService) GetCalibrationQuotas(ctx context.Context, reviewPeriodID string) (performance.CalibrationQuotaListResponseVm, error) {
+	resp := performance.CalibrationQuotaListResponseVm{}
+
+	var quotas []performance.CalibrationQuota
+	if err := s.db.WithContext(ctx).
+		Where("review_period_id IN ? AND soft_deleted = ?", []string{"", reviewPeriodID}, false).
+		Order("review_period_id, organogram_level, grade DESC").
+		Find(&quotas).Error; err != nil {
+		return resp, fmt.Errorf("listing calibration quotas: %w", err)
+	}
+
+	for _, q := range quotas {
+		resp.Data = append(resp.Data, toCalibrationQuotaVm(q))
+	}
+	resp.TotalRecord = len(resp.Data)
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// SaveCalibrationQuotas replaces the quota set for one organogram level,
+// either globally or for a single review period.
+func (s *calibrationService) SaveCalibrationQuotas(ctx context.Context, req *performance.CalibrationQuotaRequestModel) (performance.ResponseVm, error) {
+	resp := performance.ResponseVm{}
+
+	level := enums.OrganogramLevel(req.OrganogramLevel)
+	if !calibrationLevelSupported(level) {
+		resp.HasError = true
+		resp.Message = fmt.Sprintf("calibration is not supported at organogram level %d", req.OrganogramLevel)
+		return resp, nil
+	}
+	if err := validateCalibrationQuotas(req.Quotas); err != nil {
+		resp.HasError = true
+		resp.Message = err.Error()
+		return resp, nil
+	}
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("review_period_id = ? AND organogram_level = ?", req.ReviewPeriodID, level).
+			Delete(&performance.CalibrationQuota{}).Error; err != nil {
+			return fmt.Errorf("clearing calibration quotas: %w", err)
+		}
+		for _, q := range req.Quotas {
+			quota := performance.CalibrationQuota{
+				CalibrationQuotaID: GenerateID(),
+				ReviewPeriodID:     req.ReviewPeriodID,
+				OrganogramLevel:    level,
+				Grade:              enums.PerformanceGrade(q.Grade),
+				MinPercentage:      q.MinPercentage,
+				MaxPercentage:      q.MaxPercentage,
+			}
+			quota.CreatedBy = req.UpdatedBy
+			if err := tx.Create(&quota).Error; err != nil {
+				return fmt.Errorf("saving calibration quota: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		s.log.Error().Err(err).Str("action", "SAVE_CALIBRATION_QUOTAS").Msg("failed to save calibration quotas")
+		return resp, err
+	}
+
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// ==========================================================================
+// Sessions
+// ==========================================================================
+
+// StartCalibration opens a session for one unit of a closed review period,
+// snapshots every period score in scope and proposes grade changes that
+// bring the distribution within quota.
+func (s *calibrationService) StartCalibration(ctx context.Context, req *performance.StartCalibrationRequestModel) (performance.ResponseVm, error) {
+	resp := performance.ResponseVm{}
+
+	level := enums.OrganogramLevel(req.OrganogramLevel)
+	if !calibrationLevelSupported(level) {
+		resp.HasError = true
+		resp.Message = fmt.Sprintf("calibration is not supported at organogram level %d", req.OrganogramLevel)
+		return resp, nil
+	}
+	referenceID := req.ReferenceID
+	if level == enums.OrganogramLevelBankwide {
+		referenceID = ""
+	} else if referenceID == "" {
+		resp.HasError = true
+		resp.Message = fmt.Sprintf("a %s reference is required", level)
+		return resp, nil
+	}
+
+	var period performance.PerformanceReviewPeriod
+	if err := s.db.WithContext(ctx).Where("period_id = ?", req.ReviewPeriodID).First(&period).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			resp.HasError = true
+			resp.Message = fmt.Sprintf("review period %s not found", req.ReviewPeriodID)
+			return resp, nil
+		}
+		return resp, fmt.Errorf("loading review period: %w", err)
+	}
+	if period.RecordStatus != enums.StatusClosed.String() {
+		resp.HasError = true
+		resp.Message = "calibration can only run on a closed review period"
+		return resp, nil
+	}
+
+	var existing int64
+	if err := s.db.WithContext(ctx).Model(&performance.CalibrationSession{}).
+		Where("review_period_id = ? AND organogram_level = ? AND reference_id = ? AND soft_deleted = ?",
+			req.ReviewPeriodID, level, referenceID, false).
+		Count(&existing).Error; err != nil {
+		return resp, fmt.Errorf("checking existing calibration session: %w", err)
+	}
+	if existing > 0 {
+		resp.HasError = true
+		resp.Message = "a calibration session already exists for this unit and review period"
+		return resp, nil
+	}
+
+	scores, referenceName, err := s.scoresInScope(ctx, req.ReviewPeriodID, level, referenceID)
+	if err != nil {
+		return resp, err
+	}
+	if len(scores) == 0 {
+		resp.HasError = true
+		resp.Message = "no period scores found for this unit"
+		return resp, nil
+	}
+
+	quotas, err := s.effectiveQuotas(ctx, req.ReviewPeriodID, level)
+	if err != nil {
+		return resp, err
+	}
+	scale, err := s.gradeScale(ctx, period)
+	if err != nil {
+		return resp, err
+	}
+
+	entries := make([]calibrationEntry, 0, len(scores))
+	for _, ps := range scores {
+		entries = append(entries, calibrationEntry{
+			PeriodScoreID:   ps.PeriodScoreID,
+			StaffID:         ps.StaffID,
+			ScorePercentage: ps.ScorePercentage,
+			Grade:           ps.FinalGrade,
+		})
+	}
+	proposals := proposeCalibration(entries, quotas, scale)
+
+	session := performance.CalibrationSession{
+		CalibrationSessionID: GenerateID(),
+		ReviewPeriodID:       req.ReviewPeriodID,
+		OrganogramLevel:      level,
+		ReferenceID:          referenceID,
+		ReferenceName:        referenceName,
+		SessionStatus:        enums.CalibrationStatusOpen,
+		TotalStaff:           len(entries),
+	}
+	session.CreatedBy = req.RequestedBy
+
+	scoreIDs := make([]string, 0, len(entries))
+	for _, e := range entries {
+		scoreIDs = append(scoreIDs, e.PeriodScoreID)
+	}
+
+	overlap := ""
+	auditCtx := repository.WithAuditUser(ctx, req.RequestedBy)
+	err = s.db.WithContext(auditCtx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		if overlap, err = overlappingCalibrationSession(tx, req.ReviewPeriodID, "", scoreIDs); err != nil || overlap != "" {
+			return err
+		}
+		if err := tx.Create(&session).Error; err != nil {
+			return fmt.Errorf("saving calibration session: %w", err)
+		}
+		for _, p := range proposals {
+			entry := performance.CalibrationEntry{
+				CalibrationEntryID:   GenerateID(),
+				CalibrationSessionID: session.CalibrationSessionID,
+				PeriodScoreID:        p.PeriodScoreID,
+				StaffID:              p.StaffID,
+				ScorePercentage:      p.ScorePercentage,
+				OriginalGrade:        p.Grade,
+				ProposedGrade:        p.ProposedGrade,
+				CalibratedGrade:      p.Grade,
+				Decision:             enums.CalibrationDecisionRetained,
+				ProposalReason:       p.Reason,
+			}
+			if p.ProposedGrade != p.Grade {
+				entry.Decision = enums.CalibrationDecisionProposed
+			}
+			entry.CreatedBy = req.RequestedBy
+			if err := tx.Create(&entry).Error; err != nil {
+				return fmt.Errorf("saving calibration entry: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		s.log.Error().Err(err).Str("action", "START_CALIBRATION").Msg("failed to start calibration")
+		return resp, err
+	}
+	if overlap != "" {
+		resp.HasError = true
+		resp.Message = fmt.Sprintf("staff in this unit are already calibrated by the %s session for this review period", overlap)
+		return resp, nil
+	}
+
+	s.log.Info().
+		Str("sessionId", session.CalibrationSessionID).
+		Str("reviewPeriodId", req.ReviewPeriodID).
+		Str("level", level.String()).
+		Str("referenceId", referenceID).
+		Int("staff", len(entries)).
+		Msg("calibration session started")
+
+	resp.ID = session.CalibrationSessionID
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// GetCalibrationSessions lists the sessions of a review period without
+// their entries.
+func (s *calibrationService) GetCalibrationSessions(ctx context.Context, reviewPeriodID string) (performance.CalibrationSessionListResponseVm, error) {
+	resp := performance.CalibrationSessionListResponseVm{}
+
+	var sessions []performance.CalibrationSession
+	if err := s.db.WithContext(ctx).
+		Preload("ReviewPeriod").
+		Where("review_period_id = ? AND soft_deleted = ?", reviewPeriodID, false).
+		Order("organogram_level, reference_name").
+		Find(&sessions).Error; err != nil {
+		return resp, fmt.Errorf("listing calibration sessions: %w", err)
+	}
+
+	for _, cs := range sessions {
+		var pending int64
+		s.db.WithContext(ctx).Model(&performance.CalibrationEntry{}).
+			Where("calibration_session_id = ? AND decision = ?", cs.CalibrationSessionID, enums.CalibrationDecisionProposed).
+			Count(&pending)
+		vm := toCalibrationSessionVm(cs)
+		vm.PendingDecisions = int(pending)
+		resp.Data = append(resp.Data, vm)
+	}
+	resp.TotalRecord = len(resp.Data)
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// GetCalibrationSession returns a session with its entries and the grade
+// distribution before and after calibration.
+func (s *calibrationService) GetCalibrationSession(ctx context.Context, sessionID string) (performance.CalibrationSessionResponseVm, error) {
+	resp := performance.CalibrationSessionResponseVm{}
+
+	session, err := s.loadSession(ctx, sessionID)
+	if err != nil {
+		return resp, err
+	}
+	if session == nil {
+		resp.HasError = true
+		resp.Message = fmt.Sprintf("calibration session %s not found", sessionID)
+		return resp, nil
+	}
+
+	quotas, err := s.effectiveQuotas(ctx, session.ReviewPeriodID, session.OrganogramLevel)
+	if err != nil {
+		return resp, err
+	}
+	scale := DefaultGradeScale()
+	if session.ReviewPeriod != nil {
+		if scale, err = s.gradeScale(ctx, *session.ReviewPeriod); err != nil {
+			return resp, err
+		}
+	}
+
+	vm := toCalibrationSessionVm(*session)
+	for _, e := range session.Entries {
+		if e.Decision == enums.CalibrationDecisionProposed {
+			vm.PendingDecisions++
+		}
+		vm.Entries = append(vm.Entries, toCalibrationEntryVm(e, scale))
+	}
+	vm.Distribution = calibrationDistribution(session.Entries, quotas, scale)
+
+	resp.Data = &vm
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// DecideCalibrationEntry records the committee's decision on one entry of an
+// open session. Decisions may be revised until the session is finalised.
+func (s *calibrationService) DecideCalibrationEntry(ctx context.Context, req *performance.CalibrationDecisionRequestModel) (performance.ResponseVm, error) {
+	resp := performance.ResponseVm{ID: req.CalibrationEntryID}
+
+	var entry performance.CalibrationEntry
+	err := s.db.WithContext(ctx).
+		Preload("CalibrationSession").
+		Where("calibration_entry_id = ? AND calibration_session_id = ?", req.CalibrationEntryID, req.CalibrationSessionID).
+		First(&entry).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		resp.HasError = true
+		resp.Message = fmt.Sprintf("calibration entry %s not found", req.CalibrationEntryID)
+		return resp, nil
+	}
+	if err != nil {
+		return resp, fmt.Errorf("loading calibration entry: %w", err)
+	}
+	if entry.CalibrationSession == nil || entry.CalibrationSession.SessionStatus != enums.CalibrationStatusOpen {
+		resp.HasError = true
+		resp.Message = "calibration session is not open"
+		return resp, nil
+	}
+
+	calibrated, msg := resolveCalibrationDecision(entry, req)
+	if msg != "" {
+		resp.HasError = true
+		resp.Message = msg
+		return resp, nil
+	}
+
+	decision := enums.CalibrationDecision(req.Decision)
+	now := time.Now()
+	previous := describeCalibrationEntry(entry.Decision, entry.CalibratedGrade, entry.Justification)
+	auditCtx := repository.WithAuditUser(ctx, req.DecidedBy)
+	err = s.db.WithContext(auditCtx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&performance.CalibrationEntry{}).
+			Where("calibration_entry_id = ?", entry.CalibrationEntryID).
+			Updates(map[string]interface{}{
+				"decision":         decision,
+				"calibrated_grade": calibrated,
+				"justification":    req.Justification,
+				"decided_by":       req.DecidedBy,
+				"date_decided":     now,
+				"updated_by":       req.DecidedBy,
+			}).Error; err != nil {
+			return fmt.Errorf("saving calibration decision: %w", err)
+		}
+		return writeCalibrationAudit(tx, req.DecidedBy, entry.TableName(), entry.CalibrationEntryID,
+			"calibration_decision", previous, describeCalibrationEntry(decision, calibrated, req.Justification))
+	})
+	if err != nil {
+		s.log.Error().Err(err).Str("action", "DECIDE_CALIBRATION_ENTRY").Msg("failed to record calibration decision")
+		return resp, err
+	}
+
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// FinalizeCalibration writes the calibrated grade of every entry to its
+// PeriodScore and closes the session. All proposals must have a decision.
+func (s *calibrationService) FinalizeCalibration(ctx context.Context, sessionID, finalizedBy string) (performance.ResponseVm, error) {
+	resp := performance.ResponseVm{ID: sessionID}
+
+	session, err := s.loadSession(ctx, sessionID)
+	if err != nil {
+		return resp, err
+	}
+	if session == nil {
+		resp.HasError = true
+		resp.Message = fmt.Sprintf("calibration session %s not found", sessionID)
+		return resp, nil
+	}
+	if session.SessionStatus != enums.CalibrationStatusOpen {
+		resp.HasError = true
+		resp.Message = "calibration session is already finalised"
+		return resp, nil
+	}
+	pending := 0
+	for _, e := range session.Entries {
+		if e.Decision == enums.CalibrationDecisionProposed {
+			pending++
+		}
+	}
+	if pending > 0 {
+		resp.HasError = true
+		resp.Message = fmt.Sprintf("%d proposed grade change(s) are still awaiting a decision", pending)
+		return resp, nil
+	}
+
+	scoreIDs := make([]string, 0, len(session.Entries))
+	for _, e := range session.Entries {
+		scoreIDs = append(scoreIDs, e.PeriodScoreID)
+	}
+
+	now := time.Now()
+	overlap := ""
+	auditCtx := repository.WithAuditUser(ctx, finalizedBy)
+	err = s.db.WithContext(auditCtx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		if overlap, err = overlappingCalibrationSession(tx, session.ReviewPeriodID, sessionID, scoreIDs); err != nil || overlap != "" {
+			return err
+		}
+		for _, e := range session.Entries {
+			if err := tx.Model(&performance.PeriodScore{}).
+				Where("period_score_id = ?", e.PeriodScoreID).
+				Updates(map[string]interface{}{
+					"calibrated_grade": e.CalibratedGrade,
+					"is_calibrated":    true,
+					"calibrated_by":    finalizedBy,
+					"date_calibrated":  now,
+					"updated_by":       finalizedBy,
+				}).Error; err != nil {
+				return fmt.Errorf("saving calibrated grade for %s: %w", e.StaffID, err)
+			}
+			if e.CalibratedGrade == e.OriginalGrade {
+				continue
+			}
+			if err := writeCalibrationAudit(tx, finalizedBy, performance.PeriodScore{}.TableName(), e.PeriodScoreID,
+				"calibration_decision", e.OriginalGrade.String(),
+				describeCalibrationEntry(e.Decision, e.CalibratedGrade, e.Justification)); err != nil {
+				return err
+			}
+		}
+		return tx.Model(&performance.CalibrationSession{}).
+			Where("calibration_session_id = ?", sessionID).
+			Updates(map[string]interface{}{
+				"session_status": enums.CalibrationStatusFinalized,
+				"finalized_by":   finalizedBy,
+				"date_finalized": now,
+				"updated_by":     finalizedBy,
+			}).Error
+	})
+	if err != nil {
+		s.log.Error().Err(err).Str("action", "FINALIZE_CALIBRATION").Msg("failed to finalise calibration")
+		return resp, err
+	}
+	if overlap != "" {
+		resp.HasError = true
+		resp.Message = fmt.Sprintf("staff in this session are also calibrated by the %s session for this review period", overlap)
+		return resp, nil
+	}
+
+	s.log.Info().Str("sessionId", sessionID).Str("finalizedBy", finalizedBy).Msg("calibration session finalised")
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// ==========================================================================
+// Scope & quota resolution
+// ==========================================================================
+
+// scoresInScope returns the period scores of the unit being calibrated and
+// the unit's display name. Office scope uses PeriodScore.OfficeID; division
+// and department scope resolve staff through the ERP.
+func (s *calibrationService) scoresInScope(ctx context.Context, reviewPeriodID string, level enums.OrganogramLevel, referenceID string) ([]performance.PeriodScore, string, error) {
+	q := s.db.WithContext(ctx).Where("review_period_id = ? AND soft_deleted = ?", reviewPeriodID, false)
+
+	var referenceName string
+	switch level {
+	case enums.OrganogramLevelBankwide:
+		referenceName = "Bankwide"
+	case enums.OrganogramLevelOffice:
+		officeID, err := strconv.Atoi(referenceID)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid office reference %q: %w", referenceID, err)
+		}
+		q = q.Where("office_id = ?", officeID)
+		if s.erpRepo != nil {
+			if staff, err := s.erpRepo.GetByOfficeID(ctx, officeID); err == nil && len(staff) > 0 {
+				referenceName = staff[0].Office
+			}
+		}
+	case enums.OrganogramLevelDivision, enums.OrganogramLevelDepartment:
+		if s.erpRepo == nil {
+			return nil, "", fmt.Errorf("resolving %s staff: ERP database not configured", level)
+		}
+		unitID, err := strconv.Atoi(referenceID)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid %s reference %q: %w", level, referenceID, err)
+		}
+		staffIDs, name, err := s.unitStaff(ctx, level, unitID)
+		if err != nil {
+			return nil, "", err
+		}
+		if len(staffIDs) == 0 {
+			return nil, name, nil
+		}
+		referenceName = name
+		q = q.Where("staff_id IN ?", staffIDs)
+	}
+
+	var scores []performance.PeriodScore
+	if err := q.Find(&scores).Error; err != nil {
+		return nil, "", fmt.Errorf("loading period scores: %w", err)
+	}
+	return scores, referenceName, nil
+}
+
+func (s *calibrationService) unitStaff(ctx context.Context, level enums.OrganogramLevel, unitID int) ([]string, string, error) {
+	fetch := s.erpRepo.GetByDivisionID
+	if level == enums.OrganogramLevelDepartment {
+		fetch = s.erpRepo.GetByDepartmentID
+	}
+	staff, err := fetch(ctx, unitID)
+	if err != nil {
+		return nil, "", fmt.Errorf("resolving %s staff: %w", level, err)
+	}
+
+	ids := make([]string, 0, len(staff))
+	var name string
+	for _, emp := range staff {
+		ids = append(ids, emp.EmployeeNumber)
+		if name == "" {
+			name = emp.Division
+			if level == enums.OrganogramLevelDepartment {
+				name = emp.Department
+			}
+		}
+	}
+	return ids, name, nil
+}
+
+// effectiveQuotas returns the period-specific quotas for the level, or the
+// global quotas when the period has none.
+func (s *calibrationService) effectiveQuotas(ctx context.Context, reviewPeriodID string, level enums.OrganogramLevel) (map[enums.PerformanceGrade]gradeQuota, error) {
+	var rows []performance.CalibrationQuota
+	if err := s.db.WithContext(ctx).
+		Where("review_period_id IN ? AND organogram_level = ? AND soft_deleted = ?", []string{"", reviewPeriodID}, level, false).
+		Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("loading calibration quotas: %w", err)
+	}
+
+	specific := false
+	for _, r := range rows {
+		if r.ReviewPeriodID != "" {
+			specific = true
+			break
+		}
+	}
+	quotas := make(map[enums.PerformanceGrade]gradeQuota)
+	for _, r := range rows {
+		if (r.ReviewPeriodID != "") == specific {
+			quotas[r.Grade] = gradeQuota{Min: r.MinPercentage, Max: r.MaxPercentage}
+		}
+	}
+	return quotas, nil
+}
+
+// gradeScale resolves the grading scale the period's scores were graded with.
+func (s *calibrationService) gradeScale(ctx context.Context, period performance.PerformanceReviewPeriod) (GradeScale, error) {
+	if s.gradingSvc == nil {
+		return DefaultGradeScale(), nil
+	}
+	scale, err := s.gradingSvc.ResolveForReviewPeriod(ctx, period)
+	if err != nil {
+		return scale, fmt.Errorf("resolving grading scale: %w", err)
+	}
+	return scale, nil
+}
+
+// overlappingCalibrationSession returns the reference name of an open or
+// finalised session of the review period, other than excludeID, that already
+// holds any of the period scores, or "" when the scopes are disjoint.
+func overlappingCalibrationSession(tx *gorm.DB, reviewPeriodID, excludeID string, periodScoreIDs []string) (string, error) {
+	if len(periodScoreIDs) == 0 {
+		return "", nil
+	}
+	var sessions []performance.CalibrationSession
+	if err := tx.Model(&performance.CalibrationSession{}).
+		Select("calibration_session_id", "organogram_level", "reference_name").
+		Where("review_period_id = ? AND calibration_session_id <> ? AND soft_deleted = ?", reviewPeriodID, excludeID, false).
+		Where("session_status IN ?", []enums.CalibrationStatus{enums.CalibrationStatusOpen, enums.CalibrationStatusFinalized}).
+		Where("calibration_session_id IN (?)", tx.Model(&performance.CalibrationEntry{}).
+			Select("calibration_session_id").
+			Where("period_score_id IN ? AND soft_deleted = ?", periodScoreIDs, false)).
+		Limit(1).
+		Find(&sessions).Error; err != nil {
+		return "", fmt.Errorf("checking overlapping calibration sessions: %w", err)
+	}
+	if len(sessions) == 0 {
+		return "", nil
+	}
+	if sessions[0].ReferenceName != "" {
+		return sessions[0].ReferenceName, nil
+	}
+	return fmt.Sprintf("%s %s", sessions[0].OrganogramLevel, sessions[0].CalibrationSessionID), nil
+}
+
+func (s *calibrationService) loadSession(ctx context.Context, sessionID string) (*performance.CalibrationSession, error) {
+	var session performance.CalibrationSession
+	err := s.db.WithContext(ctx).
+		Preload("ReviewPeriod").
+		Preload("Entries", func(db *gorm.DB) *gorm.DB {
+			return db.Where("soft_deleted = ?", false).Order("score_percentage DESC")
+		}).
+		Where("calibration_session_id = ? AND soft_deleted = ?", sessionID, false).
+		First(&session).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("loading calibration session: %w", err)
+	}
+	return &session, nil
+}
+
+// ==========================================================================
+// Proposal engine
+// ==========================================================================
+
+// calibrationEntry is a period score as seen by the proposal engine.
+type calibrationEntry struct {
+	PeriodScoreID   string
+	StaffID         string
+	ScorePercentage float64
+	Grade           enums.PerformanceGrade
+}
+
+// calibrationProposal is an entry with the grade the quotas call for.
+type calibrationProposal struct {
+	calibrationEntry
+	ProposedGrade enums.PerformanceGrade
+	Reason        string
+}
+
+// gradeQuota bounds the percentage of staff in one grade.
+type gradeQuota struct {
+	Min float64
+	Max float64
+}
+
+// proposeCalibration moves the lowest-scoring staff down one grade of the
+// scale at a time until every grade is within its quota. Ceilings are applied
+// from the top grade down, then floors from the bottom grade up by pulling
+// the lowest-scoring staff from the grades above. Staff are never moved up.
+func proposeCalibration(entries []calibrationEntry, quotas map[enums.PerformanceGrade]gradeQuota, scale GradeScale) []calibrationProposal {
+	out := make([]calibrationProposal, len(entries))
+	for i, e := range entries {
+		out[i] = calibrationProposal{calibrationEntry: e, ProposedGrade: e.Grade}
+	}
+	n := float64(len(entries))
+	if n == 0 {
+		return out
+	}
+
+	// ascending returns indexes of proposals matching keep, lowest score first.
+	ascending := func(keep func(calibrationProposal) bool) []int {
+		var idx []int
+		for i := range out {
+			if keep(out[i]) {
+				idx = append(idx, i)
+			}
+		}
+		sort.SliceStable(idx, func(a, b int) bool {
+			pa, pb := out[idx[a]], out[idx[b]]
+			if pa.ScorePercentage != pb.ScorePercentage {
+				return pa.ScorePercentage < pb.ScorePercentage
+			}
+			return pa.StaffID < pb.StaffID
+		})
+		return idx
+	}
+
+	grades := scaleGrades(scale)
+	for i := len(grades) - 1; i > 0; i-- {
+		g := grades[i]
+		q, ok := quotas[g]
+		if !ok {
+			continue
+		}
+		allowed := int(math.Floor(q.Max*n/100 + 1e-9))
+		members := ascending(func(p calibrationProposal) bool { return p.ProposedGrade == g })
+		for j := 0; j < len(members)-allowed; j++ {
+			p := &out[members[j]]
+			p.ProposedGrade = grades[i-1]
+			p.Reason = fmt.Sprintf("%s is capped at %s%% of staff", scaleGradeLabel(scale, g), strconv.FormatFloat(q.Max, 'f', -1, 64))
+		}
+	}
+
+	for _, g := range grades[:len(grades)-1] {
+		q, ok := quotas[g]
+		if !ok || q.Min <= 0 {
+			continue
+		}
+		required := int(math.Ceil(q.Min*n/100 - 1e-9))
+		have := len(ascending(func(p calibrationProposal) bool { return p.ProposedGrade == g }))
+		above := ascending(func(p calibrationProposal) bool { return p.ProposedGrade > g })
+		for i := 0; i < required-have && i < len(above); i++ {
+			p := &out[above[i]]
+			p.ProposedGrade = g
+			p.Reason = fmt.Sprintf("%s requires at least %s%% of staff", scaleGradeLabel(scale, g), strconv.FormatFloat(q.Min, 'f', -1, 64))
+		}
+	}
+
+	return out
+}
+
+// scaleGrades returns the distinct grades of a scale's bands, lowest first.
+func scaleGrades(scale GradeScale) []enums.PerformanceGrade {
+	bands := scale.Bands
+	if len(bands) == 0 {
+		bands = DefaultGradeScale().Bands
+	}
+	seen := make(map[enums.PerformanceGrade]bool)
+	var grades []enums.PerformanceGrade
+	for _, b := range bands {
+		if !seen[b.Grade] {
+			seen[b.Grade] = true
+			grades = append(grades, b.Grade)
+		}
+	}
+	sort.Slice(grades, func(i, j int) bool { return grades[i] < grades[j] })
+	return grades
+}
+
+// scaleGradeLabel is the label the scale gives a grade, or the grade's name
+// when the scale has no band for it.
+func scaleGradeLabel(scale GradeScale, grade enums.PerformanceGrade) string {
+	for _, b := range scale.Bands {
+		if b.Grade == grade && b.Label != "" {
+			return b.Label
+		}
+	}
+	return grade.String()
+}
+
+// calibrationDistribution summarises entries per grade of the scale before
+// and after calibration, highest grade first.
+func calibrationDistribution(entries []performance.CalibrationEntry, quotas map[enums.PerformanceGrade]gradeQuota, scale GradeScale) []performance.GradeDistributionVm {
+	total := float64(len(entries))
+	actual := make(map[enums.PerformanceGrade]int)
+	calibrated := make(map[enums.PerformanceGrade]int)
+	for _, e := range entries {
+		actual[e.OriginalGrade]++
+		calibrated[e.ProjectedGrade()]++
+	}
+
+	pct := func(count int) float64 {
+		if total == 0 {
+			return 0
+		}
+		return math.Round(float64(count)/total*10000) / 100
+	}
+
+	var out []performance.GradeDistributionVm
+	grades := scaleGrades(scale)
+	for i := len(grades) - 1; i >= 0; i-- {
+		g := grades[i]
+		q, ok := quotas[g]
+		if !ok {
+			q = gradeQuota{Min: 0, Max: 100}
+		}
+		d := performance.GradeDistributionVm{
+			Grade:                int(g),
+			GradeName:            scaleGradeLabel(scale, g),
+			MinPercentage:        q.Min,
+			MaxPercentage:        q.Max,
+			ActualCount:          actual[g],
+			ActualPercentage:     pct(actual[g]),
+			CalibratedCount:      calibrated[g],
+			CalibratedPercentage: pct(calibrated[g]),
+		}
+		allowedMax := int(math.Floor(q.Max*total/100 + 1e-9))
+		requiredMin := int(math.Ceil(q.Min*total/100 - 1e-9))
+		d.WithinQuota = d.CalibratedCount <= allowedMax && d.CalibratedCount >= requiredMin
+		out = append(out, d)
+	}
+	return out
+}
+
+// ==========================================================================
+// Validation, audit & mapping helpers
+// ==========================================================================
+
+func calibrationLevelSupported(level enums.OrganogramLevel) bool {
+	switch level {
+	case enums.OrganogramLevelBankwide, enums.OrganogramLevelDepartment,
+		enums.OrganogramLevelDivision, enums.OrganogramLevelOffice:
+		return true
+	}
+	return false
+}
+
+// validateCalibrationQuotas checks each quota names a known grade once, has
+// 0 <= min <= max <= 100, and that the minimums can be met together.
+func validateCalibrationQuotas(quotas []performance.CalibrationQuotaItemRequestModel) error {
+	seen := make(map[int]bool)
+	var minTotal float64
+	for _, q := range quotas {
+		grade := enums.PerformanceGrade(q.Grade)
+		if grade < enums.PerformanceGradeProbation || grade > enums.PerformanceGradeExemplary {
+			return fmt.Errorf("%w: unknown grade %d", ErrInvalidCalibrationQuota, q.Grade)
+		}
+		if seen[q.Grade] {
+			return fmt.Errorf("%w: %s has more than one quota", ErrInvalidCalibrationQuota, grade)
+		}
+		seen[q.Grade] = true
+		if q.MinPercentage < 0 || q.MaxPercentage > 100 || q.MinPercentage > q.MaxPercentage {
+			return fmt.Errorf("%w: %s quota must satisfy 0 <= min <= max <= 100", ErrInvalidCalibrationQuota, grade)
+		}
+		minTotal += q.MinPercentage
+	}
+	if minTotal > 100 {
+		return fmt.Errorf("%w: minimum percentages add up to more than 100%%", ErrInvalidCalibrationQuota)
+	}
+	return nil
+}
+
+// resolveCalibrationDecision returns the grade an entry will carry under the
+// requested decision, or a validation message.
+func resolveCalibrationDecision(entry performance.CalibrationEntry, req *performance.CalibrationDecisionRequestModel) (enums.PerformanceGrade, string) {
+	switch enums.CalibrationDecision(req.Decision) {
+	case enums.CalibrationDecisionAccepted:
+		if entry.ProposedGrade == entry.OriginalGrade {
+			return 0, "no grade change was proposed for this staff member"
+		}
+		return entry.ProposedGrade, ""
+	case enums.CalibrationDecisionRejected:
+		if entry.ProposedGrade == entry.OriginalGrade {
+			return 0, "no grade change was proposed for this staff member"
+		}
+		if req.Justification == "" {
+			return 0, "a justification is required to reject a proposed grade"
+		}
+		return entry.OriginalGrade, ""
+	case enums.CalibrationDecisionOverridden:
+		grade := enums.PerformanceGrade(req.CalibratedGrade)
+		if grade < enums.PerformanceGradeProbation || grade > enums.PerformanceGradeExemplary {
+			return 0, fmt.Sprintf("unknown grade %d", req.CalibratedGrade)
+		}
+		if req.Justification == "" {
+			return 0, "a justification is required to override a grade"
+		}
+		return grade, ""
+	}
+	return 0, fmt.Sprintf("decision must be Accepted, Overridden or Rejected, got %d", req.Decision)
+}
+
+func describeCalibrationEntry(decision enums.CalibrationDecision, grade enums.PerformanceGrade, justification string) string {
+	desc := fmt.Sprintf("%s: %s", decision, grade)
+	if justification != "" {
+		desc += " (" + justification + ")"
+	}
+	return desc
+}
+
+// writeCalibrationAudit records a field-level change made during calibration.
+func writeCalibrationAudit(tx *gorm.DB, user, tableName, recordID, field, oldValue, newValue string) error {
+	entry := audit.AuditLog{
+		UserName:          user,
+		AuditEventDateUTC: time.Now().UTC(),
+		AuditEventType:    enums.AuditEventModified,
+		AuditTableName:    tableName,
+		RecordID:          recordID,
+		FieldName:         field,
+		OriginalValue:     oldValue,
+		NewValue:          newValue,
+	}
+	if err := tx.Create(&entry).Error; err != nil {
+		return fmt.Errorf("writing calibration audit entry: %w", err)
+	}
+	return nil
+}
+
+// calibratedGradeName is the display name of a period score's calibrated
+// grade, or empty when the score has not been calibrated.
+func calibratedGradeName(score performance.PeriodScore) string {
+	if !score.IsCalibrated {
+		return ""
+	}
+	return score.CalibratedGrade.String()
+}
+
+func toCalibrationQuotaVm(q performance.CalibrationQuota) performance.CalibrationQuotaVm {
+	return performance.CalibrationQuotaVm{
+		CalibrationQuotaID:  q.CalibrationQuotaID,
+		ReviewPeriodID:      q.ReviewPeriodID,
+		OrganogramLevel:     int(q.OrganogramLevel),
+		OrganogramLevelName: q.OrganogramLevel.String(),
+		Grade:               int(q.Grade),
+		GradeName:           q.Grade.String(),
+		MinPercentage:       q.MinPercentage,
+		MaxPercentage:       q.MaxPercentage,
+	}
+}
+
+func toCalibrationSessionVm(cs performance.CalibrationSession) performance.CalibrationSessionVm {
+	vm := performance.CalibrationSessionVm{
+		BaseEntityVm:         toBaseEntityVm(cs.BaseEntity),
+		CalibrationSessionID: cs.CalibrationSessionID,
+		ReviewPeriodID:       cs.ReviewPeriodID,
+		OrganogramLevel:      int(cs.OrganogramLevel),
+		OrganogramLevelName:  cs.OrganogramLevel.String(),
+		ReferenceID:          cs.ReferenceID,
+		ReferenceName:        cs.ReferenceName,
+		SessionStatus:        int(cs.SessionStatus),
+		SessionStatusName:    cs.SessionStatus.String(),
+		TotalStaff:           cs.TotalStaff,
+		FinalizedBy:          cs.FinalizedBy,
+		DateFinalized:        cs.DateFinalized,
+	}
+	if cs.ReviewPeriod != nil {
+		vm.ReviewPeriod = cs.ReviewPeriod.Name
+	}
+	return vm
+}
+
+func toCalibrationEntryVm(e performance.CalibrationEntry, scale GradeScale) performance.CalibrationEntryVm {
+	return performance.CalibrationEntryVm{
+		CalibrationEntryID:  e.CalibrationEntryID,
+		PeriodScoreID:       e.PeriodScoreID,
+		StaffID:             e.StaffID,
+		ScorePercentage:     e.ScorePercentage,
+		OriginalGrade:       int(e.OriginalGrade),
+		OriginalGradeName:   scaleGradeLabel(scale, e.OriginalGrade),
+		ProposedGrade:       int(e.ProposedGrade),
+		ProposedGradeName:   scaleGradeLabel(scale, e.ProposedGrade),
+		CalibratedGrade:     int(e.ProjectedGrade()),
+		CalibratedGradeName: scaleGradeLabel(scale, e.ProjectedGrade()),
+		Decision:            int(e.Decision),
+		DecisionName:        e.Decision.String(),
+		ProposalReason:      e.ProposalReason,
+		Justification:       e.Justification,
+		DecidedBy:           e.DecidedBy,
+		DateDecided:         e.DateDecided,
+	}
+}
@@ -0,0 +1,287 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/enums"
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+)
+
+// calibrationEntries builds one entry per grade/percentage pair, staff IDs in
+// input order.
+func calibrationEntries(pairs ...interface{}) []calibrationEntry {
+	var out []calibrationEntry
+	for i := 0; i < len(pairs); i += 2 {
+		out = append(out, calibrationEntry{
+			PeriodScoreID:   fmt.Sprintf("ps-%d", i/2),
+			StaffID:         fmt.Sprintf("%04d", i/2),
+			Grade:           pairs[i].(enums.PerformanceGrade),
+			ScorePercentage: pairs[i+1].(float64),
+		})
+	}
+	return out
+}
+
+func proposedGrades(proposals []calibrationProposal) map[string]enums.PerformanceGrade {
+	out := make(map[string]enums.PerformanceGrade)
+	for _, p := range proposals {
+		out[p.StaffID] = p.ProposedGrade
+	}
+	return out
+}
+
+// ---------------------------------------------------------------------------
+// Proposal engine
+// ---------------------------------------------------------------------------
+
+func TestProposeCalibration_CeilingMovesLowestScorersDown(t *testing.T) {
+	// 10 staff, Exemplary capped at 10% -> only one may stay Exemplary.
+	entries := calibrationEntries(
+		enums.PerformanceGradeExemplary, 97.0,
+		enums.PerformanceGradeExemplary, 92.0,
+		enums.PerformanceGradeExemplary, 94.0,
+		enums.PerformanceGradeAccomplished, 85.0,
+		enums.PerformanceGradeCompetent, 70.0,
+		enums.PerformanceGradeCompetent, 70.0,
+		enums.PerformanceGradeCompetent, 68.0,
+		enums.PerformanceGradeProgressive, 60.0,
+		enums.PerformanceGradeProgressive, 55.0,
+		enums.PerformanceGradeDeveloping, 40.0,
+	)
+	quotas := map[enums.PerformanceGrade]gradeQuota{
+		enums.PerformanceGradeExemplary: {Min: 0, Max: 10},
+	}
+
+	got := proposedGrades(proposeCalibration(entries, quotas, DefaultGradeScale()))
+
+	if got["0000"] != enums.PerformanceGradeExemplary {
+		t.Errorf("top scorer proposed %s, want Exemplary", got["0000"])
+	}
+	for _, id := range []string{"0001", "0002"} {
+		if got[id] != enums.PerformanceGradeAccomplished {
+			t.Errorf("staff %s proposed %s, want Accomplished", id, got[id])
+		}
+	}
+	if got["0003"] != enums.PerformanceGradeAccomplished {
+		t.Errorf("staff 0003 should be unchanged, got %s", got["0003"])
+	}
+}
+
+func TestProposeCalibration_CeilingsCascade(t *testing.T) {
+	// 4 staff; Exemplary and Accomplished each capped at 25%.
+	entries := calibrationEntries(
+		enums.PerformanceGradeExemplary, 95.0,
+		enums.PerformanceGradeExemplary, 91.0,
+		enums.PerformanceGradeAccomplished, 88.0,
+		enums.PerformanceGradeCompetent, 70.0,
+	)
+	quotas := map[enums.PerformanceGrade]gradeQuota{
+		enums.PerformanceGradeExemplary:    {Max: 25},
+		enums.PerformanceGradeAccomplished: {Max: 25},
+	}
+
+	got := proposedGrades(proposeCalibration(entries, quotas, DefaultGradeScale()))
+
+	want := map[string]enums.PerformanceGrade{
+		"0000": enums.PerformanceGradeExemplary,
+		"0001": enums.PerformanceGradeAccomplished,
+		"0002": enums.PerformanceGradeCompetent,
+		"0003": enums.PerformanceGradeCompetent,
+	}
+	for id, grade := range want {
+		if got[id] != grade {
+			t.Errorf("staff %s proposed %s, want %s", id, got[id], grade)
+		}
+	}
+}
+
+func TestProposeCalibration_FloorPullsFromAbove(t *testing.T) {
+	// 5 staff, at least 20% must be Developing: the lowest scorer above moves down.
+	entries := calibrationEntries(
+		enums.PerformanceGradeCompetent, 75.0,
+		enums.PerformanceGradeCompetent, 70.0,
+		enums.PerformanceGradeProgressive, 62.0,
+		enums.PerformanceGradeProgressive, 51.0,
+		enums.PerformanceGradeCompetent, 68.0,
+	)
+	quotas := map[enums.PerformanceGrade]gradeQuota{
+		enums.PerformanceGradeDeveloping: {Min: 20, Max: 100},
+	}
+
+	proposals := proposeCalibration(entries, quotas, DefaultGradeScale())
+	got := proposedGrades(proposals)
+
+	if got["0003"] != enums.PerformanceGradeDeveloping {
+		t.Errorf("lowest scorer proposed %s, want Developing", got["0003"])
+	}
+	moved := 0
+	for _, p := range proposals {
+		if p.ProposedGrade != p.Grade {
+			moved++
+			if p.Reason == "" {
+				t.Errorf("staff %s moved without a reason", p.StaffID)
+			}
+		}
+	}
+	if moved != 1 {
+		t.Errorf("moved %d staff, want 1", moved)
+	}
+}
+
+func TestProposeCalibration_NoQuotasNoChanges(t *testing.T) {
+	entries := calibrationEntries(
+		enums.PerformanceGradeExemplary, 95.0,
+		enums.PerformanceGradeExemplary, 93.0,
+	)
+	for _, p := range proposeCalibration(entries, nil, DefaultGradeScale()) {
+		if p.ProposedGrade != p.Grade {
+			t.Errorf("staff %s proposed %s without quotas", p.StaffID, p.ProposedGrade)
+		}
+	}
+}
+
+func TestProposeCalibration_FollowsScaleGrades(t *testing.T) {
+	// The scale has no Accomplished band: capped Exemplary staff drop straight
+	// to Competent and the reason uses the scale's label.
+	scale := GradeScale{Bands: []GradeBand{
+		gradeBand(0, 40, enums.PerformanceGradeDeveloping, "Below Expectations", true),
+		gradeBand(40, 75, enums.PerformanceGradeCompetent, "Meets Expectations", false),
+		gradeBand(75, 100, enums.PerformanceGradeExemplary, "Exceeds Expectations", false),
+	}}
+	entries := calibrationEntries(
+		enums.PerformanceGradeExemplary, 95.0,
+		enums.PerformanceGradeExemplary, 80.0,
+	)
+	quotas := map[enums.PerformanceGrade]gradeQuota{
+		enums.PerformanceGradeExemplary: {Max: 50},
+	}
+
+	proposals := proposeCalibration(entries, quotas, scale)
+	got := proposedGrades(proposals)
+
+	if got["0001"] != enums.PerformanceGradeCompetent {
+		t.Errorf("staff 0001 proposed %s, want Competent", got["0001"])
+	}
+	for _, p := range proposals {
+		if p.StaffID == "0001" && p.Reason != "Exceeds Expectations is capped at 50% of staff" {
+			t.Errorf("reason = %q, want the scale label", p.Reason)
+		}
+	}
+
+	dist := calibrationDistribution(nil, quotas, scale)
+	if len(dist) != 3 || dist[0].GradeName != "Exceeds Expectations" {
+		t.Errorf("expected the scale's three grades, highest first, got %+v", dist)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Distribution
+// ---------------------------------------------------------------------------
+
+func TestCalibrationDistribution(t *testing.T) {
+	entries := []performance.CalibrationEntry{
+		{OriginalGrade: enums.PerformanceGradeExemplary, ProposedGrade: enums.PerformanceGradeExemplary,
+			CalibratedGrade: enums.PerformanceGradeExemplary, Decision: enums.CalibrationDecisionRetained},
+		{OriginalGrade: enums.PerformanceGradeExemplary, ProposedGrade: enums.PerformanceGradeAccomplished,
+			Decision: enums.CalibrationDecisionProposed},
+		{OriginalGrade: enums.PerformanceGradeExemplary, ProposedGrade: enums.PerformanceGradeAccomplished,
+			CalibratedGrade: enums.PerformanceGradeExemplary, Decision: enums.CalibrationDecisionRejected},
+		{OriginalGrade: enums.PerformanceGradeCompetent, ProposedGrade: enums.PerformanceGradeCompetent,
+			CalibratedGrade: enums.PerformanceGradeCompetent, Decision: enums.CalibrationDecisionRetained},
+	}
+	quotas := map[enums.PerformanceGrade]gradeQuota{enums.PerformanceGradeExemplary: {Max: 25}}
+
+	dist := calibrationDistribution(entries, quotas, DefaultGradeScale())
+	if len(dist) != 6 || dist[0].Grade != int(enums.PerformanceGradeExemplary) {
+		t.Fatalf("expected six grades, highest first, got %+v", dist)
+	}
+
+	exemplary := dist[0]
+	if exemplary.ActualCount != 3 || exemplary.ActualPercentage != 75 {
+		t.Errorf("actual Exemplary = %d (%.2f%%), want 3 (75%%)", exemplary.ActualCount, exemplary.ActualPercentage)
+	}
+	if exemplary.CalibratedCount != 2 || exemplary.WithinQuota {
+		t.Errorf("calibrated Exemplary = %d within=%v, want 2 and outside quota", exemplary.CalibratedCount, exemplary.WithinQuota)
+	}
+	if dist[1].CalibratedCount != 1 || !dist[1].WithinQuota {
+		t.Errorf("calibrated Accomplished = %d within=%v, want 1 within quota", dist[1].CalibratedCount, dist[1].WithinQuota)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Validation
+// ---------------------------------------------------------------------------
+
+func TestValidateCalibrationQuotas(t *testing.T) {
+	quota := func(grade int, min, max float64) performance.CalibrationQuotaItemRequestModel {
+		return performance.CalibrationQuotaItemRequestModel{Grade: grade, MinPercentage: min, MaxPercentage: max}
+	}
+
+	valid := []performance.CalibrationQuotaItemRequestModel{
+		quota(int(enums.PerformanceGradeExemplary), 0, 10),
+		quota(int(enums.PerformanceGradeDeveloping), 5, 100),
+	}
+	if err := validateCalibrationQuotas(valid); err != nil {
+		t.Fatalf("expected valid quotas, got %v", err)
+	}
+
+	tests := map[string][]performance.CalibrationQuotaItemRequestModel{
+		"unknown grade":   {quota(9, 0, 10)},
+		"duplicate grade": {quota(6, 0, 10), quota(6, 0, 20)},
+		"min above max":   {quota(6, 30, 10)},
+		"max above 100":   {quota(6, 0, 110)},
+		"minimums > 100":  {quota(2, 60, 100), quota(3, 50, 100)},
+	}
+	for name, quotas := range tests {
+		t.Run(name, func(t *testing.T) {
+			if err := validateCalibrationQuotas(quotas); !errors.Is(err, ErrInvalidCalibrationQuota) {
+				t.Errorf("validateCalibrationQuotas() = %v, want ErrInvalidCalibrationQuota", err)
+			}
+		})
+	}
+}
+
+func TestResolveCalibrationDecision(t *testing.T) {
+	proposed := performance.CalibrationEntry{
+		OriginalGrade: enums.PerformanceGradeExemplary,
+		ProposedGrade: enums.PerformanceGradeAccomplished,
+		Decision:      enums.CalibrationDecisionProposed,
+	}
+	retained := performance.CalibrationEntry{
+		OriginalGrade: enums.PerformanceGradeCompetent,
+		ProposedGrade: enums.PerformanceGradeCompetent,
+		Decision:      enums.CalibrationDecisionRetained,
+	}
+	req := func(decision enums.CalibrationDecision, grade enums.PerformanceGrade, why string) *performance.CalibrationDecisionRequestModel {
+		return &performance.CalibrationDecisionRequestModel{Decision: int(decision), CalibratedGrade: int(grade), Justification: why}
+	}
+
+	tests := []struct {
+		name    string
+		entry   performance.CalibrationEntry
+		req     *performance.CalibrationDecisionRequestModel
+		want    enums.PerformanceGrade
+		wantErr bool
+	}{
+		{"accept proposal", proposed, req(enums.CalibrationDecisionAccepted, 0, ""), enums.PerformanceGradeAccomplished, false},
+		{"reject needs justification", proposed, req(enums.CalibrationDecisionRejected, 0, ""), 0, true},
+		{"reject keeps original", proposed, req(enums.CalibrationDecisionRejected, 0, "sustained delivery"), enums.PerformanceGradeExemplary, false},
+		{"override needs justification", retained, req(enums.CalibrationDecisionOverridden, enums.PerformanceGradeProgressive, ""), 0, true},
+		{"override retained entry", retained, req(enums.CalibrationDecisionOverridden, enums.PerformanceGradeProgressive, "missed targets"), enums.PerformanceGradeProgressive, false},
+		{"accept without proposal", retained, req(enums.CalibrationDecisionAccepted, 0, ""), 0, true},
+		{"proposed is not a decision", proposed, req(enums.CalibrationDecisionProposed, 0, ""), 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, msg := resolveCalibrationDecision(tt.entry, tt.req)
+			if (msg != "") != tt.wantErr {
+				t.Fatalf("message = %q, wantErr %v", msg, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("grade = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -163,7 +163,11 @@ func (cs *committeeService) addCommittee(ctx context.Context, req *performance.C
 			DepartmentID:   req.DepartmentID,
 		},
 	}
-	committee.RecordStatus = enums.StatusPendingApproval.String()
+	submitted, err := nextApprovalStatus(ctx, cs.parent.chainSvc, enums.ApprovalEntityCommittee, enums.StatusDraft.String(), enums.OperationAdd)
+	if err != nil {
+		return resp, err
+	}
+	committee.RecordStatus = submitted.String()
 	committee.IsActive = true
 	committee.CreatedBy = req.CreatedBy
 
@@ -205,35 +209,53 @@ func (cs *committeeService) updateCommittee(ctx context.Context, req *performanc
 func (cs *committeeService) approveCommittee(ctx context.Context, req *performance.CommitteeRequestModel) (performance.ResponseVm, error) {
 	resp := performance.ResponseVm{}
 
-	now := time.Now().UTC()
-	cs.db.WithContext(ctx).Model(&performance.Committee{}).
+	auditCtx, actor, next, err := cs.authorizeCommitteeApproval(ctx, req, enums.OperationApprove)
+	if err != nil {
+		return resp, err
+	}
+
+	updates := map[string]interface{}{"record_status": next.String()}
+	resp.Message = "committee approved and sent to the next approval stage"
+	if next == enums.StatusApprovedAndActive {
+		updates["record_status"] = enums.StatusActive.String()
+		updates["is_active"] = true
+		updates["is_approved"] = true
+		updates["approved_by"] = actor.StaffID
+		updates["date_approved"] = time.Now().UTC()
+		resp.Message = "committee approved successfully"
+	}
+	if err := cs.db.WithContext(auditCtx).Model(&performance.Committee{}).
 		Where("committee_id = ?", req.CommitteeID).
-		Updates(map[string]interface{}{
-			"record_status": enums.StatusActive.String(),
-			"is_active":     true,
-			"is_approved":   true,
-			"approved_by":   req.UpdatedBy,
-			"date_approved": now,
-		})
+		Updates(updates).Error; err != nil {
+		return resp, fmt.Errorf("approving committee: %w", err)
+	}
+	recordApprovalAction(ctx, cs.parent.delegationSvc, actor, performance.Committee{}.TableName(), req.CommitteeID, enums.OperationApprove)
 
 	resp.ID = req.CommitteeID
-	resp.Message = "committee approved successfully"
 	return resp, nil
 }
 
 func (cs *committeeService) rejectCommittee(ctx context.Context, req *performance.CommitteeRequestModel) (performance.ResponseVm, error) {
 	resp := performance.ResponseVm{}
 
+	auditCtx, actor, next, err := cs.authorizeCommitteeApproval(ctx, req, enums.OperationReject)
+	if err != nil {
+		return resp, err
+	}
+
 	now := time.Now().UTC()
-	cs.db.WithContext(ctx).Model(&performance.Committee{}).
+	if err := cs.db.WithContext(auditCtx).Model(&performance.Committee{}).
 		Where("committee_id = ?", req.CommitteeID).
 		Updates(map[string]interface{}{
-			"record_status":    enums.StatusRejected.String(),
+			"record_status":    next.String(),
 			"is_rejected":      true,
-			"rejected_by":      req.UpdatedBy,
+			"rejected_by":      actor.StaffID,
 			"rejection_reason": req.RejectionReason,
 			"date_rejected":    now,
-		})
+		}).Error; err != nil {
+		return resp, fmt.Errorf("rejecting committee: %w", err)
+	}
+	recordApprovalAction(ctx, cs.parent.delegationSvc, actor, performance.Committee{}.TableName(), req.CommitteeID, enums.OperationReject)
 
 	resp.ID = req.CommitteeID
 	resp.Message = "committee rejected successfully"
@@ -243,26 +265,63 @@ func (cs *committeeService) rejectCommittee(ctx context.Context, req *performanc
 func (cs *committeeService) returnCommittee(ctx context.Context, req *performance.CommitteeRequestModel) (performance.ResponseVm, error) {
 	resp := performance.ResponseVm{}
 
-	cs.db.WithContext(ctx).Model(&performance.Committee{}).
+	auditCtx, actor, next, err := cs.authorizeCommitteeApproval(ctx, req, enums.OperationReturn)
+	if err != nil {
+		return resp, err
+	}
+
+	if err := cs.db.WithContext(auditCtx).Model(&performance.Committee{}).
 		Where("committee_id = ?", req.CommitteeID).
 		Updates(map[string]interface{}{
-			"record_status": enums.StatusReturned.String(),
-		})
+			"record_status": next.String(),
+		}).Error; err != nil {
+		return resp, fmt.Errorf("returning committee: %w", err)
+	}
+	recordApprovalAction(ctx, cs.parent.delegationSvc, actor, performance.Committee{}.TableName(), req.CommitteeID, enums.OperationReturn)
 
 	resp.ID = req.CommitteeID
 	resp.Message = "committee returned successfully"
 	return resp, nil
 }
 
+// authorizeCommitteeApproval loads the committee and checks that the caller is its
+// approver or holds a delegation from them, and returns the status op moves it
+// to under the active approval chain.
+func (cs *committeeService) authorizeCommitteeApproval(ctx context.Context, req *performance.CommitteeRequestModel, op enums.OperationType) (context.Context, ApprovalActor, enums.Status, error) {
+	var rec performance.Committee
+	if err := cs.db.WithContext(ctx).
+		Where("committee_id = ?", req.CommitteeID).First(&rec).Error; err != nil {
+		return ctx, ApprovalActor{}, 0, fmt.Errorf("committee not found: %w", err)
+	}
+	next, err := nextApprovalStatus(ctx, cs.parent.chainSvc, enums.ApprovalEntityCommittee, rec.RecordStatus, op)
+	if err != nil {
+		return ctx, ApprovalActor{}, 0, err
+	}
+	auditCtx, actor, err := authorizeApproval(ctx, cs.parent.delegationSvc, enums.ApprovalEntityCommittee, rec.RecordStatus, rec.Chairperson, req.UpdatedBy)
+	return auditCtx, actor, next, err
+}
+
 func (cs *committeeService) reSubmitCommittee(ctx context.Context, req *performance.CommitteeRequestModel) (performance.ResponseVm, error) {
 	resp := performance.ResponseVm{}
 
-	cs.db.WithContext(ctx).Model(&performance.Committee{}).
+	var rec performance.Committee
+	if err := cs.db.WithContext(ctx).
+		Where("committee_id = ?", req.CommitteeID).First(&rec).Error; err != nil {
+		return resp, fmt.Errorf("committee not found: %w", err)
+	}
+	next, err := nextApprovalStatus(ctx, cs.parent.chainSvc, enums.ApprovalEntityCommittee, rec.RecordStatus, enums.OperationReSubmit)
+	if err != nil {
+		return resp, err
+	}
+
+	if err := cs.db.WithContext(ctx).Model(&performance.Committee{}).
 		Where("committee_id = ?", req.CommitteeID).
 		Updates(map[string]interface{}{
-			"record_status": enums.StatusPendingApproval.String(),
+			"record_status": next.String(),
 			"is_rejected":   false,
-		})
+		}).Error; err != nil {
+		return resp, fmt.Errorf("re-submitting committee: %w", err)
+	}
 
 	resp.ID = req.CommitteeID
 	resp.Message = "committee re-submitted successfully"
@@ -0,0 +1,366 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/competency"
+	"github.com/enterprise-pms/pms-api/internal/domain/enums"
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// gapClosureCategoryConfig is the PMS configuration naming the objective
+// category competency gap closures are scored under, by id or name.
+const gapClosureCategoryConfig = "COMPETENCY_GAP_CLOSURE_CATEGORY"
+
+// Development plans count towards a review period's gap closure when their
+// target date falls in the period. Draft and cancelled plans never count.
+const (
+	gapClosurePlanStatus  = `LOWER(COALESCE(TRIM(dp.task_status), ''))`
+	gapClosureEligibleSQL = `dp.soft_deleted = ? AND p.soft_deleted = ?
+		AND dp.target_date >= ? AND dp.target_date <= ?
+		AND LOWER(COALESCE(p.competency_category_name, '')) <> 'leadership'`
+	gapClosureClosedSQL = gapClosurePlanStatus + ` = 'closedgap' AND p.have_gap = ?`
+)
+
+// gapClosurePlan is a development plan targeted in a review period, reduced
+// to what the gap closure score needs.
+type gapClosurePlan struct {
+	ProfileID int
+	Closed    bool
+}
+
+// gapClosureScore returns the percentage of competency gaps, one per review
+// profile, that at least one of their plans closed, to two decimals.
+func gapClosureScore(plans []gapClosurePlan) float64 {
+	closed := make(map[int]bool, len(plans))
+	for _, p := range plans {
+		closed[p.ProfileID] = closed[p.ProfileID] || p.Closed
+	}
+	if len(closed) == 0 {
+		return 0
+	}
+	n := 0
+	for _, c := range closed {
+		if c {
+			n++
+		}
+	}
+	return math.Round(float64(n)/float64(len(closed))*10000) / 100
+}
+
+// pickCategoryDefinition picks a category's definition for a job grade
+// group. A category defined once in the period applies to every group.
+func pickCategoryDefinition(defs []performance.CategoryDefinition, gradeGroupID int) *performance.CategoryDefinition {
+	for i := range defs {
+		if defs[i].GradeGroupID == gradeGroupID {
+			return &defs[i]
+		}
+	}
+	if len(defs) == 1 {
+		return &defs[0]
+	}
+	return nil
+}
+
+// gapClosureMaxPoints returns the points the gap closure category carries
+// for a staff member in a review period: the max points of its definition
+// for their job grade group, the category weight's share of the period's.
+func (cr *competencyReviewService) gapClosureMaxPoints(ctx context.Context, staffID, reviewPeriodID, categoryID string) (float64, error) {
+	var defs []performance.CategoryDefinition
+	if err := cr.db.WithContext(ctx).
+		Where("review_period_id = ? AND objective_category_id = ? AND record_status != ?",
+			reviewPeriodID, categoryID, enums.StatusCancelled.String()).
+		Find(&defs).Error; err != nil {
+		return 0, fmt.Errorf("loading gap closure category definitions: %w", err)
+	}
+	def := pickCategoryDefinition(defs, cr.staffGradeGroupID(ctx, staffID))
+	if def == nil {
+		return 0, fmt.Errorf("objective category %s is not defined for staff %s in review period %s", categoryID, staffID, reviewPeriodID)
+	}
+	return def.MaxPoints, nil
+}
+
+// staffGradeGroupID returns the job grade group of a staff member's ERP
+// grade, or 0 when it cannot be resolved.
+func (cr *competencyReviewService) staffGradeGroupID(ctx context.Context, staffID string) int {
+	if cr.erpRepo == nil {
+		return 0
+	}
+	emp, err := cr.erpRepo.GetEmployeeByID(ctx, staffID)
+	if err != nil || emp == nil {
+		cr.log.Debug().Err(err).Str("staffID", staffID).Msg("could not look up employee grade")
+		return 0
+	}
+	var group competency.AssignJobGradeGroup
+	err = cr.db.WithContext(ctx).
+		Joins(`JOIN "CoreSchema"."job_grades" jg ON jg.job_grade_id = "CoreSchema"."assign_job_grade_groups".job_grade_id`).
+		Where(`jg.grade_code = ? AND "CoreSchema"."assign_job_grade_groups".soft_deleted = ?`, strings.TrimSpace(emp.Grade), false).
+		First(&group).Error
+	if err != nil {
+		cr.log.Debug().Err(err).Str("staffID", staffID).Str("grade", emp.Grade).Msg("could not resolve job grade group")
+		return 0
+	}
+	return group.JobGradeGroupID
+}
+
+// activeGapClosurePeriod returns the active review period and the objective
+// category gap closures are scored under. The period is nil when none is
+// active.
+func (cr *competencyReviewService) activeGapClosurePeriod(ctx context.Context) (*performance.PerformanceReviewPeriod, *performance.ObjectiveCategory, error) {
+	var period performance.PerformanceReviewPeriod
+	err := cr.db.WithContext(ctx).
+		Where("record_status = ?", enums.StatusActive.String()).
+		Order("start_date DESC").
+		First(&period).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("loading active review period: %w", err)
+	}
+
+	var setting performance.PmsConfiguration
+	err = cr.db.WithContext(ctx).Where("name = ?", gapClosureCategoryConfig).First(&setting).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) || strings.TrimSpace(setting.Value) == "" {
+		return nil, nil, fmt.Errorf("%s is not configured", gapClosureCategoryConfig)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("loading %s: %w", gapClosureCategoryConfig, err)
+	}
+
+	value := strings.TrimSpace(setting.Value)
+	var category performance.ObjectiveCategory
+	err = cr.db.WithContext(ctx).
+		Where("objective_category_id = ? OR LOWER(name) = LOWER(?)", value, value).
+		First(&category).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil, fmt.Errorf("%s refers to unknown objective category %q", gapClosureCategoryConfig, value)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("loading gap closure objective category: %w", err)
+	}
+	return &period, &category, nil
+}
+
+// =========================================================================
+// GetPendingCompetencyGapClosures -- lists the staff with closed development
+// plans not yet credited to the active review period. Used by
+// CompetencyClosureJob to queue CreditCompetencyGapClosure per staff.
+// =========================================================================
+
+func (cr *competencyReviewService) GetPendingCompetencyGapClosures(ctx context.Context) ([]performance.CompetencyGapClosureRequestModel, error) {
+	period, category, err := cr.activeGapClosurePeriod(ctx)
+	if err != nil || period == nil {
+		return nil, err
+	}
+
+	var staff []string
+	err = cr.db.WithContext(ctx).
+		Table(`"CoreSchema".development_plans AS dp`).
+		Joins(`JOIN "CoreSchema".competency_review_profiles AS p ON p.competency_review_profile_id = dp.competency_review_profile_id`).
+		Where(gapClosureEligibleSQL, false, false, period.StartDate, period.EndDate).
+		Where(gapClosureClosedSQL, false).
+		Where(`NOT EXISTS (SELECT 1 FROM pms.competency_gap_closure_items i WHERE i.development_plan_id = dp.development_plan_id)`).
+		Distinct("p.employee_number").
+		Order("p.employee_number").
+		Pluck("p.employee_number", &staff).Error
+	if err != nil {
+		return nil, fmt.Errorf("finding closed development plans: %w", err)
+	}
+
+	pending := make([]performance.CompetencyGapClosureRequestModel, 0, len(staff))
+	for _, staffID := range staff {
+		pending = append(pending, performance.CompetencyGapClosureRequestModel{
+			StaffID:             staffID,
+			ReviewPeriodID:      period.PeriodID,
+			ObjectiveCategoryID: category.ObjectiveCategoryID,
+		})
+	}
+	return pending, nil
+}
+
+// =========================================================================
+// CreditCompetencyGapClosure -- credits a staff member's closed development
+// plans to their competency gap closure in the active review period,
+// rescores it and recalculates their period score. The closure carries the
+// gap closure category's weighted max points. Each plan is credited once;
+// re-running is a no-op.
+// =========================================================================
+
+func (cr *competencyReviewService) CreditCompetencyGapClosure(ctx context.Context, req *performance.CompetencyGapClosureRequestModel) (performance.ResponseVm, error) {
+	resp := performance.ResponseVm{}
+
+	var period performance.PerformanceReviewPeriod
+	if err := cr.db.WithContext(ctx).Where("period_id = ?", req.ReviewPeriodID).First(&period).Error; err != nil {
+		return resp, fmt.Errorf("loading review period %s: %w", req.ReviewPeriodID, err)
+	}
+
+	var plans []struct {
+		DevelopmentPlanID         int
+		CompetencyReviewProfileID int
+		EmployeeName              string
+		CompetencyName            string
+		Activity                  string
+		Closed                    bool
+		Credited                  bool
+	}
+	err := cr.db.WithContext(ctx).
+		Table(`"CoreSchema".development_plans AS dp`).
+		Joins(`JOIN "CoreSchema".competency_review_profiles AS p ON p.competency_review_profile_id = dp.competency_review_profile_id`).
+		Select(`dp.development_plan_id, dp.competency_review_profile_id, p.employee_name, p.competency_name, dp.activity,
+			(`+gapClosureClosedSQL+`) AS closed,
+			EXISTS (SELECT 1 FROM pms.competency_gap_closure_items i WHERE i.development_plan_id = dp.development_plan_id) AS credited`, false).
+		Where("p.employee_number = ?", req.StaffID).
+		Where(gapClosureEligibleSQL, false, false, period.StartDate, period.EndDate).
+		Where(gapClosurePlanStatus + " NOT IN ('draft', 'cancelled')").
+		Scan(&plans).Error
+	if err != nil {
+		return resp, fmt.Errorf("loading development plans of %s: %w", req.StaffID, err)
+	}
+
+	scored := make([]gapClosurePlan, 0, len(plans))
+	for _, p := range plans {
+		scored = append(scored, gapClosurePlan{ProfileID: p.CompetencyReviewProfileID, Closed: p.Closed})
+	}
+	score := gapClosureScore(scored)
+
+	maxPoints, err := cr.gapClosureMaxPoints(ctx, req.StaffID, req.ReviewPeriodID, req.ObjectiveCategoryID)
+	if err != nil {
+		return resp, err
+	}
+
+	credited := 0
+	err = cr.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var gap performance.CompetencyGapClosure
+		err := tx.Where("staff_id = ? AND review_period_id = ? AND objective_category_id = ? AND record_status = ?",
+			req.StaffID, req.ReviewPeriodID, req.ObjectiveCategoryID, enums.StatusActive.String()).
+			First(&gap).Error
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			gap = performance.CompetencyGapClosure{
+				CompetencyGapClosureID: GenerateID(),
+				StaffID:                req.StaffID,
+				MaxPoints:              maxPoints,
+				ReviewPeriodID:         req.ReviewPeriodID,
+				ObjectiveCategoryID:    req.ObjectiveCategoryID,
+			}
+			gap.RecordStatus = enums.StatusActive.String()
+			gap.IsActive = true
+			if err := tx.Create(&gap).Error; err != nil {
+				return fmt.Errorf("creating competency gap closure: %w", err)
+			}
+		case err != nil:
+			return fmt.Errorf("loading competency gap closure: %w", err)
+		}
+		resp.ID = gap.CompetencyGapClosureID
+
+		now := time.Now().UTC()
+		for _, p := range plans {
+			if !p.Closed || p.Credited {
+				continue
+			}
+			item := performance.CompetencyGapClosureItem{
+				CompetencyGapClosureItemID: GenerateID(),
+				CompetencyGapClosureID:     gap.CompetencyGapClosureID,
+				DevelopmentPlanID:          p.DevelopmentPlanID,
+				CompetencyReviewProfileID:  p.CompetencyReviewProfileID,
+				StaffID:                    req.StaffID,
+				StaffName:                  p.EmployeeName,
+				ReviewPeriodID:             req.ReviewPeriodID,
+				CompetencyName:             p.CompetencyName,
+				Activity:                   p.Activity,
+				CreditedAt:                 now,
+			}
+			res := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "development_plan_id"}},
+				DoNothing: true,
+			}).Create(&item)
+			if res.Error != nil {
+				return fmt.Errorf("crediting development plan %d: %w", p.DevelopmentPlanID, res.Error)
+			}
+			credited += int(res.RowsAffected)
+		}
+
+		return tx.Model(&gap).Updates(map[string]interface{}{
+			"max_points":  maxPoints,
+			"final_score": score,
+			"updated_at":  now,
+		}).Error
+	})
+	if err != nil {
+		return resp, err
+	}
+
+	if _, err := cr.parent.workProduct.ReCalculateWorkProductPoints(ctx, req.StaffID, req.ReviewPeriodID); err != nil {
+		return resp, fmt.Errorf("recalculating period score of %s: %w", req.StaffID, err)
+	}
+
+	cr.log.Info().
+		Str("staffID", req.StaffID).
+		Str("reviewPeriodID", req.ReviewPeriodID).
+		Int("credited", credited).
+		Float64("finalScore", score).
+		Msg("competency gap closure credited")
+
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// =========================================================================
+// GetCompetencyGapClosureReport -- lists the development plans converted to
+// competency gap closure credit in a review period.
+// =========================================================================
+
+func (cr *competencyReviewService) GetCompetencyGapClosureReport(ctx context.Context, reviewPeriodID string) (performance.CompetencyGapClosureReportResponseVm, error) {
+	resp := performance.CompetencyGapClosureReportResponseVm{ReviewPeriodID: reviewPeriodID}
+
+	var items []performance.CompetencyGapClosureItem
+	if err := cr.db.WithContext(ctx).
+		Where("review_period_id = ?", reviewPeriodID).
+		Order("staff_id, credited_at").
+		Find(&items).Error; err != nil {
+		return resp, fmt.Errorf("loading competency gap closure items: %w", err)
+	}
+
+	ids := make([]string, 0, len(items))
+	for _, it := range items {
+		ids = append(ids, it.CompetencyGapClosureID)
+	}
+	var closures []performance.CompetencyGapClosure
+	if len(ids) > 0 {
+		if err := cr.db.WithContext(ctx).Where("competency_gap_closure_id IN ?", ids).Find(&closures).Error; err != nil {
+			return resp, fmt.Errorf("loading competency gap closures: %w", err)
+		}
+	}
+	scores := make(map[string]float64, len(closures))
+	for _, c := range closures {
+		scores[c.CompetencyGapClosureID] = c.FinalScore
+	}
+
+	resp.Data = make([]performance.CompetencyGapClosureReportItemVm, 0, len(items))
+	for _, it := range items {
+		resp.Data = append(resp.Data, performance.CompetencyGapClosureReportItemVm{
+			StaffID:                   it.StaffID,
+			StaffName:                 it.StaffName,
+			CompetencyReviewProfileID: it.CompetencyReviewProfileID,
+			CompetencyName:            it.CompetencyName,
+			DevelopmentPlanID:         it.DevelopmentPlanID,
+			Activity:                  it.Activity,
+			CompetencyGapClosureID:    it.CompetencyGapClosureID,
+			GapClosureScore:           scores[it.CompetencyGapClosureID],
+			CreditedAt:                it.CreditedAt,
+		})
+	}
+	sort.SliceStable(resp.Data, func(i, j int) bool { return resp.Data[i].StaffName < resp.Data[j].StaffName })
+	resp.TotalRecord = len(resp.Data)
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
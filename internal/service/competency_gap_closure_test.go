@@ -0,0 +1,65 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/enums"
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+)
+
+func TestGapClosureScore(t *testing.T) {
+	tests := []struct {
+		name  string
+		plans []gapClosurePlan
+		want  float64
+	}{
+		{"no plans", nil, 0},
+		{"nothing closed", []gapClosurePlan{{1, false}, {2, false}}, 0},
+		{"all closed", []gapClosurePlan{{1, true}, {2, true}}, 100},
+		{"one of three gaps closed", []gapClosurePlan{{1, true}, {2, false}, {3, false}}, 33.33},
+		{"one closed plan closes the gap", []gapClosurePlan{{1, false}, {1, true}, {2, false}}, 50},
+		{"several plans on one gap count once", []gapClosurePlan{{1, true}, {1, true}, {2, false}, {3, true}}, 66.67},
+	}
+	for _, tt := range tests {
+		if got := gapClosureScore(tt.plans); got != tt.want {
+			t.Errorf("%s: score %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestPickCategoryDefinition(t *testing.T) {
+	defs := []performance.CategoryDefinition{{DefinitionID: "D1", GradeGroupID: 1}, {DefinitionID: "D2", GradeGroupID: 2}}
+	if got := pickCategoryDefinition(defs, 2); got == nil || got.DefinitionID != "D2" {
+		t.Errorf("grade group 2 picked %v, want D2", got)
+	}
+	if got := pickCategoryDefinition(defs, 3); got != nil {
+		t.Errorf("unknown grade group picked %s, want none", got.DefinitionID)
+	}
+	if got := pickCategoryDefinition(defs[:1], 3); got == nil || got.DefinitionID != "D1" {
+		t.Errorf("single definition should apply to every grade group, got %v", got)
+	}
+}
+
+func TestClosedPlanRaisesPeriodScore(t *testing.T) {
+	// The gap closure category weighs 20% of a 100-point period.
+	rp := performance.PerformanceReviewPeriod{MaxPoints: 100}
+	def := performance.CategoryDefinition{Weight: 20, MaxPoints: rp.MaxPoints * 20 / 100}
+	wps := []performance.WorkProduct{{MaxPoint: 50, FinalScore: 40}, {MaxPoint: 30, FinalScore: 30}}
+	wps[0].RecordStatus = enums.StatusClosed.String()
+	wps[1].RecordStatus = enums.StatusActive.String()
+
+	closure := func(plans []gapClosurePlan) []performance.CompetencyGapClosure {
+		return []performance.CompetencyGapClosure{{MaxPoints: def.MaxPoints, FinalScore: gapClosureScore(plans)}}
+	}
+	open := closure([]gapClosurePlan{{1, false}, {2, false}})
+	closed := closure([]gapClosurePlan{{1, true}, {2, false}})
+
+	before, available := periodScorePoints(wps, open)
+	after, _ := periodScorePoints(wps, closed)
+	if available != 100 {
+		t.Errorf("available points %v, want 100 with the category weighted in", available)
+	}
+	if before != 40 || after != 50 {
+		t.Errorf("period score %v before and %v after closing one of two gaps, want 40 and 50", before, after)
+	}
+}
@@ -40,6 +40,8 @@ type competencyReviewService struct {
 	questionaireRepo       *repository.PMSRepository[performance.FeedbackQuestionaire]
 	questionaireOptRepo    *repository.PMSRepository[performance.FeedbackQuestionaireOption]
 	feedbackLogRepo        *repository.PMSRepository[performance.FeedbackRequestLog]
+
+	erpRepo *repository.ErpRepository
 }
 
 func newCompetencyReviewService(
@@ -47,6 +49,7 @@ func newCompetencyReviewService(
 	cfg *config.Config,
 	log zerolog.Logger,
 	parent *performanceManagementService,
+	erpRepo *repository.ErpRepository,
 ) *competencyReviewService {
 	return &competencyReviewService{
 		db:     db,
@@ -62,6 +65,8 @@ func newCompetencyReviewService(
 		questionaireRepo:       repository.NewPMSRepository[performance.FeedbackQuestionaire](db),
 		questionaireOptRepo:    repository.NewPMSRepository[performance.FeedbackQuestionaireOption](db),
 		feedbackLogRepo:        repository.NewPMSRepository[performance.FeedbackRequestLog](db),
+
+		erpRepo: erpRepo,
 	}
 }
 
@@ -12,6 +12,7 @@ import (
 	"github.com/enterprise-pms/pms-api/internal/config"
 	"github.com/enterprise-pms/pms-api/internal/domain"
 	"github.com/enterprise-pms/pms-api/internal/domain/competency"
+	"github.com/enterprise-pms/pms-api/internal/domain/enums"
 	"github.com/enterprise-pms/pms-api/internal/domain/identity"
 	"github.com/enterprise-pms/pms-api/internal/domain/performance"
 	"github.com/enterprise-pms/pms-api/internal/repository"
@@ -25,7 +26,9 @@ type competencyService struct {
 	cfg        *config.Config
 	log        zerolog.Logger
 	emailSvc   EmailService // for sending competency-related email notifications
-	httpClient *http.Client // for external SOA/ERP API calls
+	scope      DataScopeService
+	devPlans   DevelopmentPlanService // drafts development plans after profiles are calculated
+	httpClient *http.Client           // for external SOA/ERP API calls
 
 	reviewAgent *reviewAgentService // handles population & calculation
 
@@ -51,12 +54,14 @@ type competencyService struct {
 	bankYearRepo             *repository.Repository[identity.BankYear]
 }
 
-func newCompetencyService(repos *repository.Container, cfg *config.Config, log zerolog.Logger, emailSvc EmailService) CompetencyService {
+func newCompetencyService(repos *repository.Container, cfg *config.Config, log zerolog.Logger, emailSvc EmailService, scope DataScopeService, devPlans DevelopmentPlanService) CompetencyService {
 	return &competencyService{
 		db:       repos.GormDB,
 		cfg:      cfg,
 		log:      log.With().Str("service", "competency").Logger(),
 		emailSvc: emailSvc,
+		scope:    scope,
+		devPlans: devPlans,
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
@@ -487,10 +492,29 @@ func (s *competencyService) GetCompetencyReviews(ctx context.Context) (interface
 		return nil, fmt.Errorf("get competency reviews: %w", err)
 	}
 
-	return mapReviewsToVms(entities), nil
+	employees := make([]string, 0, len(entities))
+	for _, e := range entities {
+		employees = append(employees, e.EmployeeNumber)
+	}
+	visible, err := s.inScope(ctx, employees)
+	if err != nil {
+		return nil, err
+	}
+	inScope := entities[:0]
+	for _, e := range entities {
+		if visible[e.EmployeeNumber] {
+			inScope = append(inScope, e)
+		}
+	}
+
+	return mapReviewsToVms(inScope), nil
 }
 
 func (s *competencyService) GetCompetencyReviewByReviewer(ctx context.Context, reviewerId string, reviewPeriodId *int) (interface{}, error) {
+	if err := s.scope.AuthorizeStaff(ctx, reviewerId); err != nil {
+		return nil, err
+	}
+
 	q := s.reviewRepo.Query(ctx).
 		Where("reviewer_id = ?", reviewerId).
 		Preload("Competency").
@@ -512,6 +536,10 @@ func (s *competencyService) GetCompetencyReviewByReviewer(ctx context.Context, r
 }
 
 func (s *competencyService) GetCompetencyReviewForEmployee(ctx context.Context, employeeNumber string, reviewPeriodId *int) (interface{}, error) {
+	if err := s.scope.AuthorizeStaff(ctx, employeeNumber); err != nil {
+		return nil, err
+	}
+
 	q := s.reviewRepo.Query(ctx).
 		Where("employee_number = ?", employeeNumber).
 		Preload("Competency").
@@ -536,6 +564,9 @@ func (s *competencyService) GetCompetencyReviewDetail(ctx context.Context, req i
 	if !ok {
 		return nil, fmt.Errorf("invalid request type for GetCompetencyReviewDetail")
 	}
+	if err := s.scope.AuthorizeAnyStaff(ctx, vm.ReviewerID, vm.EmployeeID); err != nil {
+		return nil, err
+	}
 
 	var entities []competency.CompetencyReview
 	err := s.reviewRepo.Query(ctx).
@@ -598,6 +629,9 @@ func (s *competencyService) SaveCompetencyReview(ctx context.Context, req interf
 		if existing == nil {
 			return &responseVm{IsSuccess: false, Message: "Review not found"}, nil
 		}
+		if err := s.scope.AuthorizeAnyStaff(ctx, existing.ReviewerID, existing.EmployeeNumber); err != nil {
+			return nil, err
+		}
 		existing.CompetencyID = vm.CompetencyID
 		existing.ReviewDate = vm.ReviewDate
 		existing.ExpectedRatingID = vm.ExpectedRatingID
@@ -611,6 +645,9 @@ func (s *competencyService) SaveCompetencyReview(ctx context.Context, req interf
 		}
 		message = "Competency Review has been updated successfully"
 	} else {
+		if err := s.scope.AuthorizeStaff(ctx, vm.EmployeeNumber); err != nil {
+			return nil, err
+		}
 		entity := competency.CompetencyReview{
 			EmployeeNumber:   vm.EmployeeNumber,
 			CompetencyID:     vm.CompetencyID,
@@ -635,6 +672,10 @@ func (s *competencyService) SaveCompetencyReview(ctx context.Context, req interf
 // ==================== Competency Review Profiles ============================
 
 func (s *competencyService) GetCompetencyReviewProfiles(ctx context.Context, employeeNumber string, reviewPeriodId *int) (interface{}, error) {
+	if err := s.scope.AuthorizeStaff(ctx, employeeNumber); err != nil {
+		return nil, err
+	}
+
 	q := s.reviewProfileRepo.Query(ctx).
 		Where("employee_number = ?", employeeNumber).
 		Preload("DevelopmentPlans")
@@ -652,6 +693,10 @@ func (s *competencyService) GetCompetencyReviewProfiles(ctx context.Context, emp
 }
 
 func (s *competencyService) GetOfficeCompetencyReviews(ctx context.Context, officeId int, reviewPeriodId *int) (interface{}, error) {
+	if err := s.scope.AuthorizeOrgUnit(ctx, enums.OrganogramLevelOffice, officeId); err != nil {
+		return nil, err
+	}
+
 	q := s.reviewProfileRepo.Query(ctx).
 		Where("office_id = ?", fmt.Sprintf("%d", officeId))
 
@@ -726,6 +771,10 @@ func (s *competencyService) GetOfficeCompetencyReviews(ctx context.Context, offi
 }
 
 func (s *competencyService) GetGroupCompetencyReviewProfiles(ctx context.Context, reviewPeriodId, officeId, divisionId, departmentId *int) (interface{}, error) {
+	if err := authorizeOrgFilter(ctx, s.scope, officeId, divisionId, departmentId); err != nil {
+		return nil, err
+	}
+
 	q := s.reviewProfileRepo.Query(ctx)
 	q = applyOrgFilter(q, reviewPeriodId, officeId, divisionId, departmentId)
 
@@ -820,6 +869,10 @@ func (s *competencyService) GetGroupCompetencyReviewProfiles(ctx context.Context
 }
 
 func (s *competencyService) GetCompetencyMatrixReviewProfiles(ctx context.Context, reviewPeriodId, officeId, divisionId, departmentId *int) (interface{}, error) {
+	if err := authorizeOrgFilter(ctx, s.scope, officeId, divisionId, departmentId); err != nil {
+		return nil, err
+	}
+
 	q := s.reviewProfileRepo.Query(ctx)
 	q = applyOrgFilter(q, reviewPeriodId, officeId, divisionId, departmentId)
 	q = q.Where("LOWER(competency_category_name) != ?", "technical")
@@ -854,6 +907,9 @@ func (s *competencyService) SaveCompetencyReviewProfile(ctx context.Context, req
 	if !ok {
 		return nil, fmt.Errorf("invalid request type for SaveCompetencyReviewProfile")
 	}
+	if err := s.scope.AuthorizeStaff(ctx, vm.EmployeeNumber); err != nil {
+		return nil, err
+	}
 
 	var message string
 	if vm.CompetencyReviewProfileID > 0 {
@@ -864,6 +920,9 @@ func (s *competencyService) SaveCompetencyReviewProfile(ctx context.Context, req
 		if existing == nil {
 			return &responseVm{IsSuccess: false, Message: "Review Profile not found"}, nil
 		}
+		if err := s.scope.AuthorizeStaff(ctx, existing.EmployeeNumber); err != nil {
+			return nil, err
+		}
 		existing.EmployeeNumber = vm.EmployeeNumber
 		existing.CompetencyID = vm.CompetencyID
 		existing.CompetencyName = vm.CompetencyName
@@ -931,6 +990,10 @@ func (s *competencyService) SaveCompetencyReviewProfile(ctx context.Context, req
 // ======================== Competency Gaps ====================================
 
 func (s *competencyService) GetCompetencyGaps(ctx context.Context, employeeNumber string) (interface{}, error) {
+	if err := s.scope.AuthorizeStaff(ctx, employeeNumber); err != nil {
+		return nil, err
+	}
+
 	var entities []competency.CompetencyReviewProfile
 	err := s.reviewProfileRepo.Query(ctx).
 		Where("employee_number = ? AND have_gap = ?", employeeNumber, true).
@@ -960,6 +1023,9 @@ func (s *competencyService) CloseCompetencyGap(ctx context.Context, req interfac
 	if existing == nil {
 		return &responseVm{IsSuccess: false, Message: "Competency Profile does not exist!"}, nil
 	}
+	if err := s.scope.AuthorizeStaff(ctx, existing.EmployeeNumber); err != nil {
+		return nil, err
+	}
 
 	message := ""
 	if existing.HaveGap {
@@ -1000,28 +1066,22 @@ func (s *competencyService) GetDevelopmentPlans(ctx context.Context, competencyP
 		return nil, fmt.Errorf("get development plans: %w", err)
 	}
 
+	employees := make([]string, 0, len(entities))
+	for _, e := range entities {
+		employees = append(employees, e.EmployeeNumber)
+	}
+	visible, err := s.inScope(ctx, employees)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
 	vms := make([]competency.DevelopmentPlanVm, 0, len(entities))
 	for _, e := range entities {
-		vm := competency.DevelopmentPlanVm{
-			DevelopmentPlanID:         e.DevelopmentPlanID,
-			EmployeeNumber:            e.EmployeeNumber,
-			CompetencyReviewProfileID: e.CompetencyReviewProfileID,
-			Activity:                  e.Activity,
-			CompletionDate:            e.CompletionDate,
-			LearningResource:          e.LearningResource,
-			TargetDate:                e.TargetDate,
-			TaskStatus:                e.TaskStatus,
-			TrainingTypeName:          e.TrainingTypeName,
-			BaseAuditVm:               toBaseAuditVm(e.BaseAudit),
-		}
-		if e.CompetencyReviewProfile != nil {
-			vm.CompetencyCategoryName = e.CompetencyReviewProfile.CompetencyCategoryName
-			vm.CompetencyName = e.CompetencyReviewProfile.CompetencyName
-			vm.CurrentGap = e.CompetencyReviewProfile.CompetencyGap
-			vm.ReviewPeriod = e.CompetencyReviewProfile.ReviewPeriodName
-			vm.EmployeeName = e.CompetencyReviewProfile.EmployeeName
+		if e.EmployeeNumber != "" && !visible[e.EmployeeNumber] {
+			continue
 		}
-		vms = append(vms, vm)
+		vms = append(vms, toDevelopmentPlanVm(e, now))
 	}
 	return vms, nil
 }
@@ -1031,6 +1091,16 @@ func (s *competencyService) SaveDevelopmentPlan(ctx context.Context, req interfa
 	if !ok {
 		return nil, fmt.Errorf("invalid request type for SaveDevelopmentPlan")
 	}
+	if err := s.scope.AuthorizeStaff(ctx, vm.EmployeeNumber); err != nil {
+		return nil, err
+	}
+
+	// Once created, a plan's status only moves through
+	// UpdateDevelopmentPlanStatus, which enforces who may move it.
+	status, known := enums.ParseDevelopmentTaskStatus(vm.TaskStatus)
+	if !known {
+		return &responseVm{IsSuccess: false, Message: fmt.Sprintf("unknown development plan status %q", vm.TaskStatus)}, nil
+	}
 
 	var message string
 	if vm.DevelopmentPlanID > 0 {
@@ -1041,6 +1111,12 @@ func (s *competencyService) SaveDevelopmentPlan(ctx context.Context, req interfa
 		if existing == nil {
 			return &responseVm{IsSuccess: false, Message: "Development Plan not found"}, nil
 		}
+		if err := s.scope.AuthorizeStaff(ctx, existing.EmployeeNumber); err != nil {
+			return nil, err
+		}
+		if current, _ := enums.ParseDevelopmentTaskStatus(existing.TaskStatus); current != status {
+			return &responseVm{IsSuccess: false, Message: "Development Plan status can only be changed through its status endpoint"}, nil
+		}
 		existing.EmployeeNumber = vm.EmployeeNumber
 		existing.CompetencyReviewProfileID = vm.CompetencyReviewProfileID
 		existing.Activity = vm.Activity
@@ -1048,7 +1124,6 @@ func (s *competencyService) SaveDevelopmentPlan(ctx context.Context, req interfa
 		existing.LearningResource = vm.LearningResource
 		existing.TrainingTypeName = vm.TrainingTypeName
 		existing.TargetDate = vm.TargetDate
-		existing.TaskStatus = vm.TaskStatus
 		existing.IsActive = vm.IsActive
 
 		if err := s.developmentPlanRepo.Update(ctx, existing); err != nil {
@@ -1056,6 +1131,9 @@ func (s *competencyService) SaveDevelopmentPlan(ctx context.Context, req interfa
 		}
 		message = "Development Plan has been updated successfully"
 	} else {
+		if !competency.IsDevelopmentPlanOpen(status) {
+			return &responseVm{IsSuccess: false, Message: "A new Development Plan must be assigned, initiated or in progress"}, nil
+		}
 		entity := competency.DevelopmentPlan{
 			EmployeeNumber:            vm.EmployeeNumber,
 			CompetencyReviewProfileID: vm.CompetencyReviewProfileID,
@@ -1063,7 +1141,7 @@ func (s *competencyService) SaveDevelopmentPlan(ctx context.Context, req interfa
 			CompletionDate:            vm.CompletionDate,
 			LearningResource:          vm.LearningResource,
 			TargetDate:                vm.TargetDate,
-			TaskStatus:                vm.TaskStatus,
+			TaskStatus:                status.String(),
 			TrainingTypeName:          vm.TrainingTypeName,
 		}
 		entity.IsActive = vm.IsActive
@@ -1074,20 +1152,6 @@ func (s *competencyService) SaveDevelopmentPlan(ctx context.Context, req interfa
 		message = "Development Plan has been Created Successfully"
 	}
 
-	// Handle gap closure when task status is ClosedGap
-	if strings.EqualFold(vm.TaskStatus, "ClosedGap") {
-		profile, err := s.reviewProfileRepo.GetByID(ctx, vm.CompetencyReviewProfileID)
-		if err == nil && profile != nil {
-			profile.HaveGap = false
-			profile.AverageRatingID = profile.ExpectedRatingID
-			profile.AverageRatingValue = profile.ExpectedRatingValue
-			profile.AverageScore = float64(profile.ExpectedRatingValue)
-			profile.AverageRatingName = profile.ExpectedRatingName
-			_ = s.reviewProfileRepo.Update(ctx, profile)
-			message = "Competency Gap has been Closed successfully"
-		}
-	}
-
 	return &responseVm{IsSuccess: true, Message: message}, nil
 }
 
@@ -2158,6 +2222,9 @@ func (s *competencyService) SaveTrainingType(ctx context.Context, req interface{
 // full implementation ported from the .NET ReviewAgentService (~1,415 lines).
 
 func (s *competencyService) PopulateAllReviews(ctx context.Context) (interface{}, error) {
+	if err := s.scope.AuthorizeOrgUnit(ctx, enums.OrganogramLevelBankwide, 0); err != nil {
+		return nil, err
+	}
 	s.log.Info().Msg("PopulateAllReviews: populating reviews for all employees")
 	if err := s.reviewAgent.PopulateAllEmployeeReviews(ctx); err != nil {
 		s.log.Error().Err(err).Msg("PopulateAllReviews failed")
@@ -2167,6 +2234,9 @@ func (s *competencyService) PopulateAllReviews(ctx context.Context) (interface{}
 }
 
 func (s *competencyService) PopulateOfficeReviews(ctx context.Context, officeId int) (interface{}, error) {
+	if err := s.scope.AuthorizeOrgUnit(ctx, enums.OrganogramLevelOffice, officeId); err != nil {
+		return nil, err
+	}
 	s.log.Info().Int("officeId", officeId).Msg("PopulateOfficeReviews: populating reviews for office employees")
 	if err := s.reviewAgent.PopulateOfficeEmployeeReviews(ctx, officeId); err != nil {
 		s.log.Error().Err(err).Int("officeId", officeId).Msg("PopulateOfficeReviews failed")
@@ -2176,6 +2246,9 @@ func (s *competencyService) PopulateOfficeReviews(ctx context.Context, officeId
 }
 
 func (s *competencyService) PopulateDivisionReviews(ctx context.Context, divisionId int) (interface{}, error) {
+	if err := s.scope.AuthorizeOrgUnit(ctx, enums.OrganogramLevelDivision, divisionId); err != nil {
+		return nil, err
+	}
 	s.log.Info().Int("divisionId", divisionId).Msg("PopulateDivisionReviews: populating reviews for division employees")
 	if err := s.reviewAgent.PopulateDivisionEmployeeReviews(ctx, divisionId); err != nil {
 		s.log.Error().Err(err).Int("divisionId", divisionId).Msg("PopulateDivisionReviews failed")
@@ -2185,6 +2258,9 @@ func (s *competencyService) PopulateDivisionReviews(ctx context.Context, divisio
 }
 
 func (s *competencyService) PopulateDepartmentReviews(ctx context.Context, departmentId int) (interface{}, error) {
+	if err := s.scope.AuthorizeOrgUnit(ctx, enums.OrganogramLevelDepartment, departmentId); err != nil {
+		return nil, err
+	}
 	s.log.Info().Int("departmentId", departmentId).Msg("PopulateDepartmentReviews: populating reviews for department employees")
 	if err := s.reviewAgent.PopulateDepartmentEmployeeReviews(ctx, departmentId); err != nil {
 		s.log.Error().Err(err).Int("departmentId", departmentId).Msg("PopulateDepartmentReviews failed")
@@ -2194,6 +2270,9 @@ func (s *competencyService) PopulateDepartmentReviews(ctx context.Context, depar
 }
 
 func (s *competencyService) PopulateReviewsByEmployeeId(ctx context.Context, employeeNumber string) (interface{}, error) {
+	if err := s.scope.AuthorizeStaff(ctx, employeeNumber); err != nil {
+		return nil, err
+	}
 	s.log.Info().Str("employeeNumber", employeeNumber).Msg("PopulateReviewsByEmployeeId: populating reviews for single employee")
 	if err := s.reviewAgent.PopulateReviewsForEmployee(ctx, employeeNumber); err != nil {
 		s.log.Error().Err(err).Str("employeeNumber", employeeNumber).Msg("PopulateReviewsByEmployeeId failed")
@@ -2234,7 +2313,23 @@ func (s *competencyService) RecalculateReviewsProfiles(ctx context.Context, req
 		s.log.Error().Err(err).Msg("RecalculateReviewsProfiles failed")
 		return &responseVm{IsSuccess: false, Message: err.Error()}, nil
 	}
-	return &responseVm{IsSuccess: true, Message: "All review profiles recalculated successfully"}, nil
+	message := "All review profiles recalculated successfully"
+
+	// Draft development plans for the gaps the recalculation found. Like the
+	// recalculation itself this spans every employee, so it runs unscoped;
+	// a failure leaves the profiles recalculated.
+	if s.devPlans != nil {
+		drafted, err := s.devPlans.DraftDevelopmentPlans(WithSystemScope(ctx), &competency.DevelopmentPlanDraftRequestModel{
+			RequestedBy: callerStaffID(ctx),
+		})
+		switch {
+		case err != nil:
+			s.log.Error().Err(err).Msg("RecalculateReviewsProfiles: drafting development plans failed")
+		case !drafted.HasError:
+			message += "; " + drafted.Message
+		}
+	}
+	return &responseVm{IsSuccess: true, Message: message}, nil
 }
 
 // ========================= Email / Sync ======================================
@@ -2514,6 +2609,33 @@ func applyOrgFilter(q *gorm.DB, reviewPeriodId, officeId, divisionId, department
 	return q
 }
 
+// authorizeOrgFilter checks the unit applyOrgFilter narrows to. Without a
+// unit the query spans the enterprise.
+func authorizeOrgFilter(ctx context.Context, scope DataScopeService, officeId, divisionId, departmentId *int) error {
+	switch {
+	case officeId != nil && *officeId > 0:
+		return scope.AuthorizeOrgUnit(ctx, enums.OrganogramLevelOffice, *officeId)
+	case divisionId != nil && *divisionId > 0:
+		return scope.AuthorizeOrgUnit(ctx, enums.OrganogramLevelDivision, *divisionId)
+	case departmentId != nil && *departmentId > 0:
+		return scope.AuthorizeOrgUnit(ctx, enums.OrganogramLevelDepartment, *departmentId)
+	}
+	return scope.AuthorizeOrgUnit(ctx, enums.OrganogramLevelBankwide, 0)
+}
+
+// inScope returns the set of employeeNumbers within the caller's data scope.
+func (s *competencyService) inScope(ctx context.Context, employeeNumbers []string) (map[string]bool, error) {
+	visible, err := s.scope.FilterStaff(ctx, employeeNumbers)
+	if err != nil {
+		return nil, err
+	}
+	set := make(map[string]bool, len(visible))
+	for _, id := range visible {
+		set[id] = true
+	}
+	return set, nil
+}
+
 // buildMatrixResult constructs the competency matrix overview from profiles.
 func buildMatrixResult(profiles []competency.CompetencyReviewProfile) interface{} {
 	type matrixDetail struct {
@@ -10,6 +10,7 @@ import (
 	"github.com/enterprise-pms/pms-api/internal/domain/performance"
 	"github.com/enterprise-pms/pms-api/internal/repository"
 	"github.com/rs/zerolog"
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 )
 
@@ -109,7 +110,6 @@ func (d *dashboardService) GetRequestStatistics(ctx context.Context, staffID str
 		return resp, err
 	}
 
-	requestSLA, pms360SLA := d.parent.getSLAConfig(ctx)
 	resp.ReviewPeriodID = reviewPeriod.PeriodID
 	reviewPeriodMaxPoints := reviewPeriod.MaxPoints
 
@@ -119,8 +119,6 @@ func (d *dashboardService) GetRequestStatistics(ctx context.Context, staffID str
 		Where("assigned_staff_id = ? AND review_period_id = ?", staffID, reviewPeriod.PeriodID).
 		Find(&requests)
 
-	now := time.Now()
-
 	resp.CompletedRequests = countWhere(requests, func(r performance.FeedbackRequestLog) bool {
 		return r.TimeCompleted != nil
 	})
@@ -129,43 +127,9 @@ func (d *dashboardService) GetRequestStatistics(ctx context.Context, staffID str
 		return r.RecordStatus == enums.StatusActive.String()
 	})
 
-	// Completed overdue: non-360 requests
-	completedOverdueNon360 := filterRequests(requests, func(r performance.FeedbackRequestLog) bool {
-		return r.FeedbackRequestType != enums.FeedbackRequest360ReviewFeedback &&
-			isClosedOrCompletedOrBreached(r.RecordStatus) &&
-			r.HasSLA &&
-			r.TimeCompleted != nil &&
-			r.TimeCompleted.Sub(r.TimeInitiated).Hours() > float64(requestSLA)
-	})
-	completedOverdueNon360Count := d.parent.countSLABreachedRequests(completedOverdueNon360, staffID, requestSLA)
-
-	// Completed overdue: 360 requests
-	completedOverdue360 := filterRequests(requests, func(r performance.FeedbackRequestLog) bool {
-		return r.FeedbackRequestType == enums.FeedbackRequest360ReviewFeedback &&
-			isClosedOrCompletedOrBreached(r.RecordStatus) &&
-			r.HasSLA &&
-			r.TimeCompleted != nil &&
-			r.TimeCompleted.Sub(r.TimeInitiated).Hours() > float64(pms360SLA)
-	})
-	completedOverdue360Count := d.parent.countSLABreachedRequests(completedOverdue360, staffID, pms360SLA)
-	resp.CompletedOverdueRequests = completedOverdueNon360Count + completedOverdue360Count
-
-	// Pending overdue: non-360 requests
-	pendingOverdueNon360Count := countWhere(requests, func(r performance.FeedbackRequestLog) bool {
-		return r.FeedbackRequestType != enums.FeedbackRequest360ReviewFeedback &&
-			r.RecordStatus == enums.StatusActive.String() &&
-			r.HasSLA &&
-			r.TimeInitiated.Add(time.Duration(requestSLA)*time.Hour).Before(now)
-	})
-
-	// Pending overdue: 360 requests
-	pendingOverdue360Count := countWhere(requests, func(r performance.FeedbackRequestLog) bool {
-		return r.FeedbackRequestType == enums.FeedbackRequest360ReviewFeedback &&
-			r.RecordStatus == enums.StatusActive.String() &&
-			r.HasSLA &&
-			r.TimeInitiated.Add(time.Duration(pms360SLA)*time.Hour).Before(now)
-	})
-	resp.PendingOverdueRequests = pendingOverdueNon360Count + pendingOverdue360Count
+	// Overdue requests, measured on the assignee's business calendar
+	sla := d.parent.feedbackReq.sla.evaluator(ctx, requests)
+	resp.CompletedOverdueRequests, resp.PendingOverdueRequests = sla.countOverdue(requests)
 
 	resp.BreachedRequests = resp.CompletedOverdueRequests + resp.PendingOverdueRequests
 
@@ -201,8 +165,6 @@ func (d *dashboardService) GetStaffPerformanceStatistics(ctx context.Context, st
 	}
 	resp.Message = "an error occurred"
 
-	requestSLA, pms360SLA := d.parent.getSLAConfig(ctx)
-
 	reviewPeriod, err := d.parent.getStaffActiveReviewPeriod(ctx, staffID)
 	if err != nil {
 		d.log.Error().Err(err).Msg("GetStaffPerformanceStatistics: no active review period")
@@ -314,39 +276,8 @@ func (d *dashboardService) GetStaffPerformanceStatistics(ctx context.Context, st
 		Find(&allRequests)
 
 	if len(allRequests) > 0 {
-		now := time.Now()
-
-		// Completed overdue: non-360
-		completedOverdueList := filterRequests(allRequests, func(r performance.FeedbackRequestLog) bool {
-			return r.FeedbackRequestType != enums.FeedbackRequest360ReviewFeedback &&
-				isClosedOrCompletedOrBreached(r.RecordStatus) &&
-				r.HasSLA && r.TimeCompleted != nil &&
-				r.TimeCompleted.Sub(r.TimeInitiated).Hours() > float64(requestSLA)
-		})
-		// Completed overdue: 360
-		completedOverdue360List := filterRequests(allRequests, func(r performance.FeedbackRequestLog) bool {
-			return r.FeedbackRequestType == enums.FeedbackRequest360ReviewFeedback &&
-				isClosedOrCompletedOrBreached(r.RecordStatus) &&
-				r.HasSLA && r.TimeCompleted != nil &&
-				r.TimeCompleted.Sub(r.TimeInitiated).Hours() > float64(pms360SLA)
-		})
-		completedOverdue := d.parent.countSLABreachedRequests(completedOverdueList, staffID, requestSLA) +
-			d.parent.countSLABreachedRequests(completedOverdue360List, staffID, pms360SLA)
-
-		// Pending overdue: non-360
-		pendingOverdueList := filterRequests(allRequests, func(r performance.FeedbackRequestLog) bool {
-			return r.FeedbackRequestType != enums.FeedbackRequest360ReviewFeedback &&
-				r.RecordStatus == enums.StatusActive.String() &&
-				r.HasSLA && r.TimeInitiated.Add(time.Duration(requestSLA)*time.Hour).Before(now)
-		})
-		// Pending overdue: 360
-		pendingOverdue360List := filterRequests(allRequests, func(r performance.FeedbackRequestLog) bool {
-			return r.FeedbackRequestType == enums.FeedbackRequest360ReviewFeedback &&
-				r.RecordStatus == enums.StatusActive.String() &&
-				r.HasSLA && r.TimeInitiated.Add(time.Duration(pms360SLA)*time.Hour).Before(now)
-		})
-		pendingOverdue := d.parent.countSLABreachedRequests(pendingOverdueList, staffID, requestSLA) +
-			d.parent.countSLABreachedRequests(pendingOverdue360List, staffID, pms360SLA)
+		sla := d.parent.feedbackReq.sla.evaluator(ctx, allRequests)
+		completedOverdue, pendingOverdue := sla.countOverdue(allRequests)
 
 		deductedPoints = float64(completedOverdue + pendingOverdue)
 		if deductedPoints > reviewPeriodMaxPoints {
@@ -569,8 +500,6 @@ func (d *dashboardService) GetStaffPerformanceScoreCardStatistics(ctx context.Co
 	resp := performance.StaffScoreCardResponseVm{}
 	resp.Message = "an error occurred"
 
-	requestSLA, pms360SLA := d.parent.getSLAConfig(ctx)
-
 	// Look up the review period
 	var reviewPeriod performance.PerformanceReviewPeriod
 	if err := d.db.WithContext(ctx).
@@ -800,35 +729,8 @@ func (d *dashboardService) GetStaffPerformanceScoreCardStatistics(ctx context.Co
 
 	var deductedPoints float64
 	if len(allRequests) > 0 {
-		now := time.Now()
-
-		completedOverdueList := filterRequests(allRequests, func(r performance.FeedbackRequestLog) bool {
-			return r.FeedbackRequestType != enums.FeedbackRequest360ReviewFeedback &&
-				isClosedOrCompletedOrBreached(r.RecordStatus) &&
-				r.HasSLA && r.TimeCompleted != nil &&
-				r.TimeCompleted.Sub(r.TimeInitiated).Hours() > float64(requestSLA)
-		})
-		completedOverdue360List := filterRequests(allRequests, func(r performance.FeedbackRequestLog) bool {
-			return r.FeedbackRequestType == enums.FeedbackRequest360ReviewFeedback &&
-				isClosedOrCompletedOrBreached(r.RecordStatus) &&
-				r.HasSLA && r.TimeCompleted != nil &&
-				r.TimeCompleted.Sub(r.TimeInitiated).Hours() > float64(pms360SLA)
-		})
-		completedOverdue := d.parent.countSLABreachedRequests(completedOverdueList, staffID, requestSLA) +
-			d.parent.countSLABreachedRequests(completedOverdue360List, staffID, pms360SLA)
-
-		pendingOverdueList := filterRequests(allRequests, func(r performance.FeedbackRequestLog) bool {
-			return r.FeedbackRequestType != enums.FeedbackRequest360ReviewFeedback &&
-				r.RecordStatus == enums.StatusActive.String() &&
-				r.HasSLA && r.TimeInitiated.Add(time.Duration(requestSLA)*time.Hour).Before(now)
-		})
-		pendingOverdue360List := filterRequests(allRequests, func(r performance.FeedbackRequestLog) bool {
-			return r.FeedbackRequestType == enums.FeedbackRequest360ReviewFeedback &&
-				r.RecordStatus == enums.StatusActive.String() &&
-				r.HasSLA && r.TimeInitiated.Add(time.Duration(pms360SLA)*time.Hour).Before(now)
-		})
-		pendingOverdue := d.parent.countSLABreachedRequests(pendingOverdueList, staffID, requestSLA) +
-			d.parent.countSLABreachedRequests(pendingOverdue360List, staffID, pms360SLA)
+		sla := d.parent.feedbackReq.sla.evaluator(ctx, allRequests)
+		completedOverdue, pendingOverdue := sla.countOverdue(allRequests)
 
 		deductedPoints = float64(completedOverdue + pendingOverdue)
 		if deductedPoints > reviewPeriodMaxPoints {
@@ -850,9 +752,9 @@ func (d *dashboardService) GetStaffPerformanceScoreCardStatistics(ctx context.Co
 	// Performance grade
 	if reviewPeriod.MaxPoints > 0 {
 		performancePercentage := 100 * (actualPoints / reviewPeriod.MaxPoints)
-		grade := getGrade(performancePercentage)
+		band := d.parent.gradeScaleFor(ctx, reviewPeriod).Band(decimal.NewFromFloat(performancePercentage))
 		scoreCard.PercentageScore = performancePercentage
-		scoreCard.StaffPerformanceGrade = grade.String()
+		scoreCard.StaffPerformanceGrade = band.Label
 	}
 
 	resp.ScoreCard = &scoreCard
@@ -1078,8 +980,8 @@ func (d *dashboardService) GetOrganogramPerformanceSummaryStatistics(
 	if totalStaff > 0 {
 		avgPercentage := (totalActual / (float64(totalStaff) * reviewPeriod.MaxPoints)) * 100
 		resp.PerformanceScore = avgPercentage
-		grade := getGrade(avgPercentage)
-		resp.EarnedPerformanceGrade = grade.String()
+		band := d.parent.gradeScaleFor(ctx, reviewPeriod).Band(decimal.NewFromFloat(avgPercentage))
+		resp.EarnedPerformanceGrade = band.Label
 	}
 
 	// Work product stats across all staff in scope
@@ -1241,6 +1143,7 @@ func (d *dashboardService) GetOrganogramPerformanceSummaryListStatistics(
 		enums.StatusClosed.String(),
 	}
 
+	scale := d.parent.gradeScaleFor(ctx, reviewPeriod)
 	var summaries []performance.OrganogramPerformanceSummaryDetails
 	for _, grp := range groupMap {
 		detail := performance.OrganogramPerformanceSummaryDetails{
@@ -1259,7 +1162,7 @@ func (d *dashboardService) GetOrganogramPerformanceSummaryListStatistics(
 		if detail.TotalStaff > 0 && reviewPeriod.MaxPoints > 0 {
 			avgPct := (totalActual / (float64(detail.TotalStaff) * reviewPeriod.MaxPoints)) * 100
 			detail.PerformanceScore = avgPct
-			detail.EarnedPerformanceGrade = getGrade(avgPct).String()
+			detail.EarnedPerformanceGrade = scale.Band(decimal.NewFromFloat(avgPct)).Label
 		}
 
 		// Work product stats for this group
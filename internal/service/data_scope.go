@@ -0,0 +1,292 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/auth"
+	"github.com/enterprise-pms/pms-api/internal/domain/enums"
+	"github.com/enterprise-pms/pms-api/internal/domain/erp"
+	"github.com/enterprise-pms/pms-api/internal/middleware"
+	"github.com/enterprise-pms/pms-api/internal/repository"
+	"github.com/rs/zerolog"
+)
+
+// ---------------------------------------------------------------------------
+// Organisation-scoped data access.
+//
+// Route permissions decide what a caller may do; the data scope decides
+// whose records they may do it to. A caller reaches themselves, their
+// direct reports and everyone in the office, division or department they
+// head, as recorded in the ERP. Holders of StaffData.All (HRD by default)
+// reach every staff member. The performance, competency and grievance
+// services check staff- and unit-keyed requests here and fail with
+// ErrOutOfScope, which handlers answer with 403.
+// ---------------------------------------------------------------------------
+
+// DataScopeLevel is how a caller reaches a staff member, from narrowest to
+// widest.
+type DataScopeLevel int
+
+const (
+	ScopeNone DataScopeLevel = iota
+	ScopeSelf
+	ScopeDirectReports
+	ScopeOffice
+	ScopeDivision
+	ScopeDepartment
+	ScopeEnterprise
+)
+
+func (l DataScopeLevel) String() string {
+	switch l {
+	case ScopeSelf:
+		return "Self"
+	case ScopeDirectReports:
+		return "DirectReports"
+	case ScopeOffice:
+		return "Office"
+	case ScopeDivision:
+		return "Division"
+	case ScopeDepartment:
+		return "Department"
+	case ScopeEnterprise:
+		return "Enterprise"
+	}
+	return "None"
+}
+
+type systemScopeKey struct{}
+
+// WithSystemScope returns a context with enterprise reach. Background jobs
+// and internal lookups made on the system's behalf, rather than the
+// caller's, run under it.
+func WithSystemScope(ctx context.Context) context.Context {
+	return context.WithValue(ctx, systemScopeKey{}, true)
+}
+
+// orgDirectory is the part of the ERP repository the data scope reads.
+type orgDirectory interface {
+	GetEmployeeByID(ctx context.Context, employeeID string) (*erp.EmployeeDetails, error)
+	GetEmployeesByIDs(ctx context.Context, employeeIDs []string) ([]erp.EmployeeDetails, error)
+	GetByOfficeID(ctx context.Context, officeID int) ([]erp.EmployeeDetails, error)
+	GetByDivisionID(ctx context.Context, divisionID int) ([]erp.EmployeeDetails, error)
+	GetByDepartmentID(ctx context.Context, deptID int) ([]erp.EmployeeDetails, error)
+}
+
+// dataScopeService implements DataScopeService over the ERP hierarchy.
+type dataScopeService struct {
+	directory orgDirectory
+	log       zerolog.Logger
+}
+
+// newDataScopeService creates the data scope. Without an ERP connection
+// callers reach only themselves, unless they have enterprise reach.
+func newDataScopeService(repos *repository.Container, log zerolog.Logger) DataScopeService {
+	svc := &dataScopeService{log: log.With().Str("service", "data_scope").Logger()}
+	if repos.Erp != nil {
+		svc.directory = repos.Erp
+	}
+	return svc
+}
+
+// ScopeOf returns the narrowest level at which the caller reaches staffID,
+// or ScopeNone.
+func (s *dataScopeService) ScopeOf(ctx context.Context, staffID string) (DataScopeLevel, error) {
+	caller := callerStaffID(ctx)
+	staffID = strings.TrimSpace(staffID)
+	switch {
+	case caller != "" && caller == staffID:
+		return ScopeSelf, nil
+	case hasEnterpriseReach(ctx):
+		return ScopeEnterprise, nil
+	case caller == "" || staffID == "" || s.directory == nil:
+		return ScopeNone, nil
+	}
+
+	emp, err := s.directory.GetEmployeeByID(ctx, staffID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ScopeNone, nil
+	}
+	if err != nil {
+		return ScopeNone, fmt.Errorf("resolving data scope for staff %s: %w", staffID, err)
+	}
+	return scopeOver(caller, emp), nil
+}
+
+// AuthorizeStaff returns ErrOutOfScope unless the caller reaches staffID.
+func (s *dataScopeService) AuthorizeStaff(ctx context.Context, staffID string) error {
+	level, err := s.ScopeOf(ctx, staffID)
+	if err != nil {
+		return err
+	}
+	if level == ScopeNone {
+		s.log.Warn().Str("caller", callerStaffID(ctx)).Str("staffId", staffID).Msg("Staff outside caller's data scope")
+		return ErrOutOfScope
+	}
+	return nil
+}
+
+// AuthorizeAnyStaff returns ErrOutOfScope unless the caller reaches at
+// least one of staffIDs. It guards records with several parties, such as
+// grievances.
+func (s *dataScopeService) AuthorizeAnyStaff(ctx context.Context, staffIDs ...string) error {
+	caller := callerStaffID(ctx)
+	if hasEnterpriseReach(ctx) {
+		return nil
+	}
+	for _, id := range staffIDs {
+		if caller != "" && strings.TrimSpace(id) == caller {
+			return nil
+		}
+	}
+	visible, err := s.FilterStaff(ctx, staffIDs)
+	if err != nil {
+		return err
+	}
+	if len(visible) > 0 {
+		return nil
+	}
+	s.log.Warn().Str("caller", caller).Strs("staffIds", staffIDs).Msg("Record outside caller's data scope")
+	return ErrOutOfScope
+}
+
+// AuthorizeOrgUnit returns ErrOutOfScope unless the caller heads the unit
+// or a unit above it. Bankwide and directorate views need enterprise reach.
+func (s *dataScopeService) AuthorizeOrgUnit(ctx context.Context, level enums.OrganogramLevel, unitID int) error {
+	if hasEnterpriseReach(ctx) {
+		return nil
+	}
+	caller := callerStaffID(ctx)
+	if caller == "" || s.directory == nil {
+		return ErrOutOfScope
+	}
+
+	var members []erp.EmployeeDetails
+	var err error
+	switch level {
+	case enums.OrganogramLevelOffice:
+		members, err = s.directory.GetByOfficeID(ctx, unitID)
+	case enums.OrganogramLevelDivision:
+		members, err = s.directory.GetByDivisionID(ctx, unitID)
+	case enums.OrganogramLevelDepartment:
+		members, err = s.directory.GetByDepartmentID(ctx, unitID)
+	default:
+		return ErrOutOfScope
+	}
+	if err != nil {
+		return fmt.Errorf("resolving data scope for %s %d: %w", level.String(), unitID, err)
+	}
+
+	for i := range members {
+		if headsUnit(caller, &members[i], level) {
+			return nil
+		}
+	}
+	s.log.Warn().Str("caller", caller).Str("level", level.String()).Int("unitId", unitID).Msg("Unit outside caller's data scope")
+	return ErrOutOfScope
+}
+
+// FilterStaff returns the staffIDs the caller reaches, in their original
+// order and without duplicates. Staff other than the caller are resolved in
+// one batched ERP lookup.
+func (s *dataScopeService) FilterStaff(ctx context.Context, staffIDs []string) ([]string, error) {
+	caller := callerStaffID(ctx)
+	enterprise := hasEnterpriseReach(ctx)
+	seen := make(map[string]bool, len(staffIDs))
+	unique := make([]string, 0, len(staffIDs))
+	var lookup []string
+	for _, id := range staffIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		unique = append(unique, id)
+		trimmed := strings.TrimSpace(id)
+		if !enterprise && caller != "" && trimmed != "" && trimmed != caller {
+			lookup = append(lookup, trimmed)
+		}
+	}
+
+	reached := make(map[string]bool, len(lookup))
+	if len(lookup) > 0 && s.directory != nil {
+		emps, err := s.directory.GetEmployeesByIDs(ctx, lookup)
+		if err != nil {
+			return nil, fmt.Errorf("resolving data scope for %d staff: %w", len(lookup), err)
+		}
+		for i := range emps {
+			if scopeOver(caller, &emps[i]) != ScopeNone {
+				reached[strings.TrimSpace(emps[i].EmployeeNumber)] = true
+			}
+		}
+	}
+
+	result := make([]string, 0, len(unique))
+	for _, id := range unique {
+		trimmed := strings.TrimSpace(id)
+		if enterprise || (caller != "" && trimmed == caller) || reached[trimmed] {
+			result = append(result, id)
+		}
+	}
+	return result, nil
+}
+
+// scopeOver returns the level at which caller reaches emp through the ERP
+// reporting lines.
+func scopeOver(caller string, emp *erp.EmployeeDetails) DataScopeLevel {
+	switch caller {
+	case strings.TrimSpace(emp.SupervisorID):
+		return ScopeDirectReports
+	case strings.TrimSpace(emp.HeadOfOfficeID):
+		return ScopeOffice
+	case strings.TrimSpace(emp.HeadOfDivID):
+		return ScopeDivision
+	case strings.TrimSpace(emp.HeadOfDeptID):
+		return ScopeDepartment
+	}
+	return ScopeNone
+}
+
+// headsUnit reports whether caller heads member's unit at level or one
+// above it: an office is covered by its head and by the heads of its
+// division and department.
+func headsUnit(caller string, member *erp.EmployeeDetails, level enums.OrganogramLevel) bool {
+	heads := []string{member.HeadOfDeptID}
+	switch level {
+	case enums.OrganogramLevelOffice:
+		heads = append(heads, member.HeadOfOfficeID, member.HeadOfDivID)
+	case enums.OrganogramLevelDivision:
+		heads = append(heads, member.HeadOfDivID)
+	}
+	for _, h := range heads {
+		if strings.TrimSpace(h) == caller {
+			return true
+		}
+	}
+	return false
+}
+
+// callerStaffID returns the staff ID of the authenticated caller, which is
+// their user ID.
+func callerStaffID(ctx context.Context) string {
+	id, _ := ctx.Value(middleware.UserIDKey).(string)
+	return strings.TrimSpace(id)
+}
+
+// hasEnterpriseReach reports whether the context runs with system scope or
+// the caller holds StaffData.All.
+func hasEnterpriseReach(ctx context.Context) bool {
+	if system, _ := ctx.Value(systemScopeKey{}).(bool); system {
+		return true
+	}
+	perms, _ := ctx.Value(middleware.PermissionsKey).([]string)
+	for _, p := range perms {
+		if p == auth.PermStaffDataAll {
+			return true
+		}
+	}
+	return false
+}
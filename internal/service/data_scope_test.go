@@ -0,0 +1,242 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/auth"
+	"github.com/enterprise-pms/pms-api/internal/domain/enums"
+	"github.com/enterprise-pms/pms-api/internal/domain/erp"
+	"github.com/enterprise-pms/pms-api/internal/middleware"
+	"github.com/rs/zerolog"
+)
+
+// fakeOrgDirectory serves a fixed set of employees.
+type fakeOrgDirectory struct {
+	employees map[string]erp.EmployeeDetails
+	lookups   int
+}
+
+func (d *fakeOrgDirectory) GetEmployeeByID(_ context.Context, id string) (*erp.EmployeeDetails, error) {
+	d.lookups++
+	if id == "broken" {
+		return nil, errors.New("erp unavailable")
+	}
+	e, ok := d.employees[id]
+	if !ok {
+		return nil, fmt.Errorf("erpRepo.GetEmployeeByID: %w", sql.ErrNoRows)
+	}
+	return &e, nil
+}
+
+func (d *fakeOrgDirectory) GetEmployeesByIDs(_ context.Context, ids []string) ([]erp.EmployeeDetails, error) {
+	d.lookups++
+	var result []erp.EmployeeDetails
+	for _, id := range ids {
+		if id == "broken" {
+			return nil, errors.New("erp unavailable")
+		}
+		if e, ok := d.employees[id]; ok {
+			result = append(result, e)
+		}
+	}
+	return result, nil
+}
+
+func (d *fakeOrgDirectory) members(match func(erp.EmployeeDetails) bool) []erp.EmployeeDetails {
+	var result []erp.EmployeeDetails
+	for _, e := range d.employees {
+		if match(e) {
+			result = append(result, e)
+		}
+	}
+	return result
+}
+
+func (d *fakeOrgDirectory) GetByOfficeID(_ context.Context, id int) ([]erp.EmployeeDetails, error) {
+	return d.members(func(e erp.EmployeeDetails) bool { return e.OfficeID != nil && *e.OfficeID == id }), nil
+}
+
+func (d *fakeOrgDirectory) GetByDivisionID(_ context.Context, id int) ([]erp.EmployeeDetails, error) {
+	return d.members(func(e erp.EmployeeDetails) bool { return e.DivisionID != nil && *e.DivisionID == id }), nil
+}
+
+func (d *fakeOrgDirectory) GetByDepartmentID(_ context.Context, id int) ([]erp.EmployeeDetails, error) {
+	return d.members(func(e erp.EmployeeDetails) bool { return e.DepartmentID != nil && *e.DepartmentID == id }), nil
+}
+
+// testOrg is one department (30) with one division (20) holding two
+// offices (10 and 11):
+//
+//	D (head of department 30)
+//	└── V (head of division 20)
+//	    ├── O (head of office 10) ── M (supervisor) ── S
+//	    └── P (head of office 11) ── T
+func testOrg() *fakeOrgDirectory {
+	emp := func(id, supervisor, headOfOffice string, office int) erp.EmployeeDetails {
+		div, dept := 20, 30
+		return erp.EmployeeDetails{
+			EmployeeNumber: id,
+			SupervisorID:   supervisor,
+			HeadOfOfficeID: headOfOffice,
+			HeadOfDivID:    "V",
+			HeadOfDeptID:   "D",
+			OfficeID:       &office,
+			DivisionID:     &div,
+			DepartmentID:   &dept,
+		}
+	}
+	return &fakeOrgDirectory{employees: map[string]erp.EmployeeDetails{
+		"S": emp("S", "M", "O", 10),
+		"M": emp("M", "O", "O", 10),
+		"O": emp("O", "V", "O", 10),
+		"T": emp("T", "P", "P", 11),
+		"P": emp("P", "V", "P", 11),
+	}}
+}
+
+func callerCtx(staffID string, perms ...string) context.Context {
+	ctx := context.WithValue(context.Background(), middleware.UserIDKey, staffID)
+	return context.WithValue(ctx, middleware.PermissionsKey, perms)
+}
+
+func TestDataScopeStaffLevels(t *testing.T) {
+	svc := &dataScopeService{directory: testOrg(), log: zerolog.Nop()}
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		target string
+		want   DataScopeLevel
+	}{
+		{"self", callerCtx("S"), "S", ScopeSelf},
+		{"direct report", callerCtx("M"), "S", ScopeDirectReports},
+		{"office", callerCtx("O"), "S", ScopeOffice},
+		{"division", callerCtx("V"), "S", ScopeDivision},
+		{"department", callerCtx("D"), "T", ScopeDepartment},
+		{"enterprise permission", callerCtx("X", auth.PermStaffDataAll), "S", ScopeEnterprise},
+		{"system scope", WithSystemScope(context.Background()), "S", ScopeEnterprise},
+		{"peer", callerCtx("T"), "S", ScopeNone},
+		{"head of another office", callerCtx("P"), "S", ScopeNone},
+		{"subordinate looking up", callerCtx("S"), "M", ScopeNone},
+		{"unknown staff", callerCtx("M"), "Z", ScopeNone},
+		{"anonymous", context.Background(), "S", ScopeNone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := svc.ScopeOf(tt.ctx, tt.target)
+			if err != nil {
+				t.Fatalf("ScopeOf: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ScopeOf(%s) = %s, want %s", tt.target, got, tt.want)
+			}
+
+			err = svc.AuthorizeStaff(tt.ctx, tt.target)
+			if tt.want == ScopeNone && !errors.Is(err, ErrOutOfScope) {
+				t.Errorf("AuthorizeStaff = %v, want ErrOutOfScope", err)
+			}
+			if tt.want != ScopeNone && err != nil {
+				t.Errorf("AuthorizeStaff = %v, want nil", err)
+			}
+		})
+	}
+}
+
+func TestDataScopeDirectoryErrors(t *testing.T) {
+	svc := &dataScopeService{directory: testOrg(), log: zerolog.Nop()}
+	err := svc.AuthorizeStaff(callerCtx("M"), "broken")
+	if err == nil || errors.Is(err, ErrOutOfScope) {
+		t.Errorf("AuthorizeStaff = %v, want a lookup error", err)
+	}
+
+	// Without an ERP connection callers keep their own records.
+	noERP := &dataScopeService{log: zerolog.Nop()}
+	if err := noERP.AuthorizeStaff(callerCtx("S"), "S"); err != nil {
+		t.Errorf("self without ERP = %v, want nil", err)
+	}
+	if err := noERP.AuthorizeStaff(callerCtx("M"), "S"); !errors.Is(err, ErrOutOfScope) {
+		t.Errorf("report without ERP = %v, want ErrOutOfScope", err)
+	}
+}
+
+func TestDataScopeOrgUnits(t *testing.T) {
+	svc := &dataScopeService{directory: testOrg(), log: zerolog.Nop()}
+
+	tests := []struct {
+		name   string
+		caller string
+		level  enums.OrganogramLevel
+		unit   int
+		allow  bool
+	}{
+		{"office head, own office", "O", enums.OrganogramLevelOffice, 10, true},
+		{"office head, other office", "O", enums.OrganogramLevelOffice, 11, false},
+		{"office head, own division", "O", enums.OrganogramLevelDivision, 20, false},
+		{"division head, office below", "V", enums.OrganogramLevelOffice, 11, true},
+		{"division head, own division", "V", enums.OrganogramLevelDivision, 20, true},
+		{"division head, own department", "V", enums.OrganogramLevelDepartment, 30, false},
+		{"department head, office below", "D", enums.OrganogramLevelOffice, 10, true},
+		{"department head, own department", "D", enums.OrganogramLevelDepartment, 30, true},
+		{"department head, bankwide", "D", enums.OrganogramLevelBankwide, 0, false},
+		{"supervisor, own office", "M", enums.OrganogramLevelOffice, 10, false},
+		{"empty unit", "D", enums.OrganogramLevelOffice, 99, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := svc.AuthorizeOrgUnit(callerCtx(tt.caller), tt.level, tt.unit)
+			if tt.allow && err != nil {
+				t.Errorf("AuthorizeOrgUnit = %v, want nil", err)
+			}
+			if !tt.allow && !errors.Is(err, ErrOutOfScope) {
+				t.Errorf("AuthorizeOrgUnit = %v, want ErrOutOfScope", err)
+			}
+		})
+	}
+
+	hr := callerCtx("X", auth.PermStaffDataAll)
+	if err := svc.AuthorizeOrgUnit(hr, enums.OrganogramLevelBankwide, 0); err != nil {
+		t.Errorf("enterprise bankwide = %v, want nil", err)
+	}
+}
+
+func TestDataScopeAnyStaffAndFilter(t *testing.T) {
+	dir := testOrg()
+	svc := &dataScopeService{directory: dir, log: zerolog.Nop()}
+
+	// A grievance between S and T is visible to either party's line.
+	if err := svc.AuthorizeAnyStaff(callerCtx("P"), "S", "T"); err != nil {
+		t.Errorf("AuthorizeAnyStaff via T = %v, want nil", err)
+	}
+	if err := svc.AuthorizeAnyStaff(callerCtx("T"), "S", "T"); err != nil {
+		t.Errorf("AuthorizeAnyStaff as party = %v, want nil", err)
+	}
+	if err := svc.AuthorizeAnyStaff(callerCtx("M"), "T", "P"); !errors.Is(err, ErrOutOfScope) {
+		t.Errorf("AuthorizeAnyStaff = %v, want ErrOutOfScope", err)
+	}
+
+	dir.lookups = 0
+	got, err := svc.FilterStaff(callerCtx("O"), []string{"S", "T", "M", "S", "O", "P", "unknown"})
+	if err != nil {
+		t.Fatalf("FilterStaff: %v", err)
+	}
+	want := []string{"S", "M", "O"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("FilterStaff = %v, want %v", got, want)
+	}
+	if dir.lookups != 1 {
+		t.Errorf("FilterStaff made %d ERP lookups, want 1 batched lookup", dir.lookups)
+	}
+
+	// Enterprise callers are not looked up in the ERP.
+	dir.lookups = 0
+	if _, err := svc.FilterStaff(callerCtx("X", auth.PermStaffDataAll), []string{"S", "T"}); err != nil {
+		t.Fatalf("FilterStaff: %v", err)
+	}
+	if dir.lookups != 0 {
+		t.Errorf("enterprise FilterStaff made %d ERP lookups, want 0", dir.lookups)
+	}
+}
@@ -0,0 +1,416 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/config"
+	"github.com/enterprise-pms/pms-api/internal/domain/audit"
+	"github.com/enterprise-pms/pms-api/internal/domain/enums"
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"github.com/enterprise-pms/pms-api/internal/middleware"
+	"github.com/enterprise-pms/pms-api/internal/repository"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+)
+
+// ---------------------------------------------------------------------------
+// delegationService manages date-bounded approval delegations and decides
+// whether a caller may act on a pending record, either as one of the stage
+// approvers resolved by the approval chain or as their delegate.
+// ---------------------------------------------------------------------------
+
+// ApprovalActor identifies who acted on a record. OnBehalfOf is set when the
+// actor used a delegation granted by one of the stage approvers.
+type ApprovalActor struct {
+	StaffID    string
+	OnBehalfOf string
+}
+
+// IsDelegated reports whether the action was taken under a delegation.
+func (a ApprovalActor) IsDelegated() bool { return a.OnBehalfOf != "" }
+
+// Describe renders the action for the audit trail, e.g.
+// "approved by 1001 on behalf of 2002".
+func (a ApprovalActor) Describe(op enums.OperationType) string {
+	verb := map[enums.OperationType]string{
+		enums.OperationApprove: "approved",
+		enums.OperationReject:  "rejected",
+		enums.OperationReturn:  "returned",
+	}[op]
+	if verb == "" {
+		verb = strings.ToLower(op.String())
+	}
+	msg := fmt.Sprintf("%s by %s", verb, a.StaffID)
+	if a.IsDelegated() {
+		msg += " on behalf of " + a.OnBehalfOf
+	}
+	return msg
+}
+
+// AuditUser is the user name recorded by the audit interceptor for writes
+// made by this actor.
+func (a ApprovalActor) AuditUser() string {
+	if a.IsDelegated() {
+		return a.StaffID + " on behalf of " + a.OnBehalfOf
+	}
+	return a.StaffID
+}
+
+// actingStaffID returns the authenticated caller, falling back to the staff
+// ID carried on the request when there is no token (e.g. background jobs).
+func actingStaffID(ctx context.Context, fallback string) string {
+	if v, ok := ctx.Value(middleware.UserIDKey).(string); ok && v != "" {
+		return v
+	}
+	return fallback
+}
+
+// authorizeApproval is the nil-safe entry point used by the approve, reject
+// and return paths. The returned context attributes audited writes to the
+// actor (and the approver they stand in for).
+func authorizeApproval(ctx context.Context, svc DelegationService, entityType enums.ApprovalEntityType, recordStatus, ownerStaffID, requestedBy string) (context.Context, ApprovalActor, error) {
+	actor := ApprovalActor{StaffID: actingStaffID(ctx, requestedBy)}
+	if svc != nil {
+		var err error
+		if actor, err = svc.AuthorizeApproval(ctx, entityType, recordStatus, ownerStaffID, actor.StaffID); err != nil {
+			return ctx, actor, err
+		}
+	}
+	if actor.StaffID == "" {
+		return ctx, actor, nil
+	}
+	return repository.WithAuditUser(ctx, actor.AuditUser()), actor, nil
+}
+
+// recordApprovalAction is the nil-safe counterpart of
+// DelegationService.RecordApprovalAction.
+func recordApprovalAction(ctx context.Context, svc DelegationService, actor ApprovalActor, tableName, recordID string, op enums.OperationType) {
+	if svc != nil {
+		svc.RecordApprovalAction(ctx, actor, tableName, recordID, op)
+	}
+}
+
+type delegationService struct {
+	db       *gorm.DB
+	erpRepo  *repository.ErpRepository
+	chainSvc ApprovalChainService
+	log      zerolog.Logger
+}
+
+func newDelegationService(repos *repository.Container, cfg *config.Config, log zerolog.Logger, chainSvc ApprovalChainService) DelegationService {
+	return &delegationService{
+		db:       repos.GormDB,
+		erpRepo:  repos.Erp,
+		chainSvc: chainSvc,
+		log:      log.With().Str("service", "delegation").Logger(),
+	}
+}
+
+// ==========================================================================
+// Delegation management
+// ==========================================================================
+
+// CreateDelegation records a new delegation for the caller. Overlapping
+// active delegations from the same delegator are rejected so that at most one
+// proxy applies to any given day.
+func (s *delegationService) CreateDelegation(ctx context.Context, req *performance.ApprovalDelegationRequestModel) (performance.ResponseVm, error) {
+	resp := performance.ResponseVm{}
+
+	if msg := validateDelegationRequest(req); msg != "" {
+		resp.HasError = true
+		resp.Message = msg
+		return resp, nil
+	}
+
+	var overlapping int64
+	if err := s.db.WithContext(ctx).Model(&performance.ApprovalDelegation{}).
+		Where("delegator_staff_id = ? AND is_active = ? AND soft_deleted = ?", req.DelegatorStaffID, true, false).
+		Where("start_date <= ? AND end_date >= ?", req.EndDate, req.StartDate).
+		Count(&overlapping).Error; err != nil {
+		return resp, fmt.Errorf("checking overlapping delegations: %w", err)
+	}
+	if overlapping > 0 {
+		resp.HasError = true
+		resp.Message = "an active delegation already covers part of this date range"
+		return resp, nil
+	}
+
+	types := make([]string, 0, len(req.EntityTypes))
+	for _, et := range req.EntityTypes {
+		types = append(types, strconv.Itoa(et))
+	}
+
+	d := performance.ApprovalDelegation{
+		ApprovalDelegationID: GenerateID(),
+		DelegatorStaffID:     req.DelegatorStaffID,
+		DelegatorStaffName:   s.staffName(ctx, req.DelegatorStaffID),
+		DelegateStaffID:      req.DelegateStaffID,
+		DelegateStaffName:    s.staffName(ctx, req.DelegateStaffID),
+		StartDate:            req.StartDate,
+		EndDate:              req.EndDate,
+		EntityTypes:          strings.Join(types, ","),
+		Reason:               req.Reason,
+	}
+	d.RecordStatus = enums.StatusActive.String()
+	d.IsActive = true
+	d.CreatedBy = req.DelegatorStaffID
+
+	if err := s.db.WithContext(ctx).Create(&d).Error; err != nil {
+		return resp, fmt.Errorf("creating delegation: %w", err)
+	}
+
+	s.log.Info().
+		Str("delegator", d.DelegatorStaffID).
+		Str("delegate", d.DelegateStaffID).
+		Time("start", d.StartDate).
+		Time("end", d.EndDate).
+		Msg("approval delegation created")
+
+	resp.ID = d.ApprovalDelegationID
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// RevokeDelegation deactivates a delegation. Only the delegator may revoke it.
+func (s *delegationService) RevokeDelegation(ctx context.Context, delegationID, staffID string) (performance.ResponseVm, error) {
+	resp := performance.ResponseVm{ID: delegationID}
+
+	result := s.db.WithContext(ctx).Model(&performance.ApprovalDelegation{}).
+		Where("approval_delegation_id = ? AND delegator_staff_id = ? AND soft_deleted = ?", delegationID, staffID, false).
+		Updates(map[string]interface{}{
+			"is_active":     false,
+			"record_status": enums.StatusCancelled.String(),
+			"updated_by":    staffID,
+		})
+	if result.Error != nil {
+		return resp, fmt.Errorf("revoking delegation: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		resp.HasError = true
+		resp.Message = "delegation not found"
+		return resp, nil
+	}
+
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// GetDelegations lists the delegations a staff member has granted and the
+// ones they currently hold for others.
+func (s *delegationService) GetDelegations(ctx context.Context, staffID string) (performance.ApprovalDelegationListResponseVm, error) {
+	resp := performance.ApprovalDelegationListResponseVm{}
+
+	var rows []performance.ApprovalDelegation
+	if err := s.db.WithContext(ctx).
+		Where("(delegator_staff_id = ? OR delegate_staff_id = ?) AND soft_deleted = ?", staffID, staffID, false).
+		Order("start_date DESC").
+		Find(&rows).Error; err != nil {
+		return resp, fmt.Errorf("listing delegations: %w", err)
+	}
+
+	now := time.Now().UTC()
+	for i := range rows {
+		vm := toApprovalDelegationVm(&rows[i], now)
+		if rows[i].DelegatorStaffID == staffID {
+			resp.Granted = append(resp.Granted, vm)
+		} else {
+			resp.Received = append(resp.Received, vm)
+		}
+	}
+
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// ==========================================================================
+// Runtime resolution
+// ==========================================================================
+
+// FindActiveDelegation returns the delegation delegatorStaffID has in effect
+// at the given time for the entity type, or nil when there is none.
+func (s *delegationService) FindActiveDelegation(ctx context.Context, delegatorStaffID string, entityType enums.ApprovalEntityType, at time.Time) (*performance.ApprovalDelegation, error) {
+	var rows []performance.ApprovalDelegation
+	if err := s.db.WithContext(ctx).
+		Where("delegator_staff_id = ? AND is_active = ? AND soft_deleted = ?", delegatorStaffID, true, false).
+		Order("start_date DESC").
+		Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("loading delegations for %s: %w", delegatorStaffID, err)
+	}
+	return pickDelegation(rows, entityType, at), nil
+}
+
+// AuthorizeApproval checks that actorStaffID may approve, reject or return a
+// record of the given entity type owned by ownerStaffID while it sits in
+// recordStatus. The actor must be one of the approvers of that stage of the
+// active chain or hold an in-effect delegation from one of them; anything
+// else, including approvers that cannot be resolved, is refused.
+func (s *delegationService) AuthorizeApproval(ctx context.Context, entityType enums.ApprovalEntityType, recordStatus, ownerStaffID, actorStaffID string) (ApprovalActor, error) {
+	actor := ApprovalActor{StaffID: actorStaffID}
+	if actorStaffID == "" {
+		return actor, fmt.Errorf("%w: no acting staff member", ErrUnauthorizedApprover)
+	}
+
+	stages, err := s.chainSvc.GetActiveStages(ctx, entityType)
+	if err != nil {
+		return actor, err
+	}
+	var stage *performance.ApprovalChainStage
+	for i := range stages {
+		if stages[i].PendingStatus.String() == recordStatus {
+			stage = &stages[i]
+			break
+		}
+	}
+	if stage == nil {
+		return actor, fmt.Errorf("%w: this %s is not awaiting approval (status %s)", ErrUnauthorizedApprover, entityType, recordStatus)
+	}
+
+	approvers, err := s.chainSvc.ResolveStageApprovers(ctx, entityType, stage.PendingStatus, ownerStaffID)
+	if err != nil {
+		return actor, fmt.Errorf("%w: resolving %s approvers: %v", ErrUnauthorizedApprover, stage.Name, err)
+	}
+	if len(approvers) == 0 {
+		return actor, fmt.Errorf("%w: no %s approver could be resolved for this %s", ErrUnauthorizedApprover, stage.Name, entityType)
+	}
+
+	for _, a := range approvers {
+		if strings.EqualFold(a, actorStaffID) {
+			return actor, nil
+		}
+	}
+
+	var held []performance.ApprovalDelegation
+	if err := s.db.WithContext(ctx).
+		Where("delegate_staff_id = ? AND delegator_staff_id IN ? AND is_active = ? AND soft_deleted = ?", actorStaffID, approvers, true, false).
+		Find(&held).Error; err != nil {
+		return actor, fmt.Errorf("loading delegations held by %s: %w", actorStaffID, err)
+	}
+	if d := pickDelegation(held, entityType, time.Now().UTC()); d != nil {
+		actor.OnBehalfOf = d.DelegatorStaffID
+		return actor, nil
+	}
+
+	return actor, fmt.Errorf("%w: %s is neither an approver nor a delegate for this %s", ErrUnauthorizedApprover, actorStaffID, entityType)
+}
+
+// RecordApprovalAction writes the delegated action to the audit trail. Direct
+// approvals are already captured by the audit interceptor, so only delegated
+// actions get an explicit entry.
+func (s *delegationService) RecordApprovalAction(ctx context.Context, actor ApprovalActor, tableName, recordID string, op enums.OperationType) {
+	if !actor.IsDelegated() {
+		return
+	}
+
+	entry := audit.AuditLog{
+		UserName:          actor.StaffID,
+		AuditEventDateUTC: time.Now().UTC(),
+		AuditEventType:    enums.AuditEventModified,
+		AuditTableName:    tableName,
+		RecordID:          recordID,
+		FieldName:         "delegated_action",
+		NewValue:          actor.Describe(op),
+	}
+	if err := s.db.WithContext(ctx).Create(&entry).Error; err != nil {
+		s.log.Error().Err(err).
+			Str("table", tableName).
+			Str("recordID", recordID).
+			Msg("failed to record delegated approval action")
+		return
+	}
+
+	s.log.Info().
+		Str("table", tableName).
+		Str("recordID", recordID).
+		Msg(entry.NewValue)
+}
+
+// ==========================================================================
+// Helpers
+// ==========================================================================
+
+// pickDelegation returns the first delegation in effect at the given time for
+// the entity type.
+func pickDelegation(rows []performance.ApprovalDelegation, entityType enums.ApprovalEntityType, at time.Time) *performance.ApprovalDelegation {
+	for i := range rows {
+		if rows[i].InEffect(at) && rows[i].Covers(entityType) {
+			return &rows[i]
+		}
+	}
+	return nil
+}
+
+// approvalEntityForFeedback maps a feedback request type to the approval
+// entity type whose delegations apply to it. Types without an approval
+// counterpart return 0, which only matches unrestricted delegations.
+func approvalEntityForFeedback(t enums.FeedbackRequestType) enums.ApprovalEntityType {
+	switch t {
+	case enums.FeedbackRequestWorkProductPlanning, enums.FeedbackRequestWorkProductEvaluation:
+		return enums.ApprovalEntityWorkProduct
+	case enums.FeedbackRequestObjectivePlanning:
+		return enums.ApprovalEntityIndividualPlannedObjective
+	case enums.FeedbackRequestProjectPlanning:
+		return enums.ApprovalEntityProject
+	case enums.FeedbackRequestCommitteePlanning:
+		return enums.ApprovalEntityCommittee
+	case enums.FeedbackRequestReviewPeriodExtension:
+		return enums.ApprovalEntityReviewPeriodExtension
+	}
+	return 0
+}
+
+func validateDelegationRequest(req *performance.ApprovalDelegationRequestModel) string {
+	switch {
+	case req.DelegatorStaffID == "":
+		return "delegator is required"
+	case req.DelegateStaffID == "":
+		return "delegate is required"
+	case strings.EqualFold(req.DelegateStaffID, req.DelegatorStaffID):
+		return "a staff member cannot delegate to themselves"
+	case req.StartDate.IsZero() || req.EndDate.IsZero():
+		return "start and end dates are required"
+	case req.EndDate.Before(req.StartDate):
+		return "end date cannot be before start date"
+	}
+	for _, et := range req.EntityTypes {
+		if enums.ApprovalEntityType(et).String() == "Unknown" {
+			return fmt.Sprintf("unknown entity type %d", et)
+		}
+	}
+	return ""
+}
+
+func (s *delegationService) staffName(ctx context.Context, staffID string) string {
+	if s.erpRepo == nil {
+		return staffID
+	}
+	emp, err := s.erpRepo.GetEmployeeByID(ctx, staffID)
+	if err != nil || emp == nil || emp.FullName == "" {
+		return staffID
+	}
+	return emp.FullName
+}
+
+func toApprovalDelegationVm(d *performance.ApprovalDelegation, now time.Time) performance.ApprovalDelegationVm {
+	vm := performance.ApprovalDelegationVm{
+		ApprovalDelegationID: d.ApprovalDelegationID,
+		DelegatorStaffID:     d.DelegatorStaffID,
+		DelegatorStaffName:   d.DelegatorStaffName,
+		DelegateStaffID:      d.DelegateStaffID,
+		DelegateStaffName:    d.DelegateStaffName,
+		StartDate:            d.StartDate,
+		EndDate:              d.EndDate,
+		Reason:               d.Reason,
+		IsActive:             d.IsActive,
+		InEffect:             d.InEffect(now),
+	}
+	for _, part := range strings.Split(d.EntityTypes, ",") {
+		if v, err := strconv.Atoi(strings.TrimSpace(part)); err == nil {
+			vm.EntityTypes = append(vm.EntityTypes, v)
+		}
+	}
+	return vm
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/domain"
+	"github.com/enterprise-pms/pms-api/internal/domain/enums"
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"github.com/enterprise-pms/pms-api/internal/middleware"
+)
+
+func delegation(delegate string, start, end time.Time, entityTypes string) performance.ApprovalDelegation {
+	return performance.ApprovalDelegation{
+		DelegatorStaffID: "2002",
+		DelegateStaffID:  delegate,
+		StartDate:        start,
+		EndDate:          end,
+		EntityTypes:      entityTypes,
+		BaseEntity:       domain.BaseEntity{IsActive: true},
+	}
+}
+
+// ---------------------------------------------------------------------------
+// ApprovalActor
+// ---------------------------------------------------------------------------
+
+func TestApprovalActor_Describe(t *testing.T) {
+	direct := ApprovalActor{StaffID: "1001"}
+	if got := direct.Describe(enums.OperationApprove); got != "approved by 1001" {
+		t.Errorf("direct approval = %q", got)
+	}
+
+	delegated := ApprovalActor{StaffID: "1001", OnBehalfOf: "2002"}
+	if got := delegated.Describe(enums.OperationReturn); got != "returned by 1001 on behalf of 2002" {
+		t.Errorf("delegated return = %q", got)
+	}
+	if got := delegated.AuditUser(); got != "1001 on behalf of 2002" {
+		t.Errorf("AuditUser = %q", got)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Delegation window and entity scope
+// ---------------------------------------------------------------------------
+
+func TestPickDelegation(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 0, 0, 0, 0, time.UTC) }
+	noon := func(d int) time.Time { return day(d).Add(12 * time.Hour) }
+
+	rows := []performance.ApprovalDelegation{
+		delegation("1001", day(1), day(5), "2,3"),
+		delegation("1003", day(10), day(12), ""),
+	}
+	revoked := delegation("1004", day(1), day(31), "")
+	revoked.IsActive = false
+	rows = append(rows, revoked)
+
+	tests := []struct {
+		name       string
+		at         time.Time
+		entityType enums.ApprovalEntityType
+		want       string
+	}{
+		{"inside window, covered type", noon(3), enums.ApprovalEntityProject, "1001"},
+		{"end date is inclusive", noon(5), enums.ApprovalEntityIndividualPlannedObjective, "1001"},
+		{"inside window, type not covered", noon(3), enums.ApprovalEntityWorkProduct, ""},
+		{"unrestricted delegation covers all types", noon(11), enums.ApprovalEntityWorkProduct, "1003"},
+		{"revoked delegation ignored", noon(20), enums.ApprovalEntityWorkProduct, ""},
+		{"before any window", noon(0), enums.ApprovalEntityProject, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ""
+			if d := pickDelegation(rows, tt.entityType, tt.at); d != nil {
+				got = d.DelegateStaffID
+			}
+			if got != tt.want {
+				t.Errorf("pickDelegation() delegate = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateDelegationRequest(t *testing.T) {
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	valid := func() *performance.ApprovalDelegationRequestModel {
+		return &performance.ApprovalDelegationRequestModel{
+			DelegatorStaffID: "2002",
+			DelegateStaffID:  "1001",
+			StartDate:        start,
+			EndDate:          start.AddDate(0, 0, 7),
+			EntityTypes:      []int{int(enums.ApprovalEntityWorkProduct)},
+		}
+	}
+
+	if msg := validateDelegationRequest(valid()); msg != "" {
+		t.Fatalf("expected valid request, got %q", msg)
+	}
+
+	self := valid()
+	self.DelegateStaffID = "2002"
+	backwards := valid()
+	backwards.EndDate = start.AddDate(0, 0, -1)
+	unknown := valid()
+	unknown.EntityTypes = []int{99}
+
+	for name, req := range map[string]*performance.ApprovalDelegationRequestModel{
+		"self delegation":     self,
+		"end before start":    backwards,
+		"unknown entity type": unknown,
+	} {
+		if msg := validateDelegationRequest(req); msg == "" {
+			t.Errorf("%s: expected validation error", name)
+		}
+	}
+}
+
+func TestAuthorizeApproval_NilServiceUsesCaller(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middleware.UserIDKey, "1001")
+
+	_, actor, err := authorizeApproval(ctx, nil, enums.ApprovalEntityWorkProduct, "PendingApproval", "3003", "spoofed")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if actor.StaffID != "1001" || actor.IsDelegated() {
+		t.Errorf("expected direct action by token user, got %+v", actor)
+	}
+}
+
+// approverChainStub serves a fixed single-stage chain and approver list.
+type approverChainStub struct {
+	ApprovalChainService
+	stagesErr    error
+	approvers    []string
+	approversErr error
+}
+
+func (s approverChainStub) GetActiveStages(context.Context, enums.ApprovalEntityType) ([]performance.ApprovalChainStage, error) {
+	return builtInApprovalChainStages(), s.stagesErr
+}
+
+func (s approverChainStub) ResolveStageApprovers(context.Context, enums.ApprovalEntityType, enums.Status, string) ([]string, error) {
+	return s.approvers, s.approversErr
+}
+
+func TestAuthorizeApproval_FailsClosed(t *testing.T) {
+	ctx := context.Background()
+	lineManager := approverChainStub{approvers: []string{"2002"}}
+
+	tests := []struct {
+		name   string
+		chain  approverChainStub
+		status string
+		actor  string
+	}{
+		{"no acting staff", lineManager, "PendingApproval", ""},
+		{"stages fail to load", approverChainStub{stagesErr: errors.New("db down")}, "PendingApproval", "2002"},
+		{"status is not a chain stage", lineManager, "Active", "2002"},
+		{"approvers fail to resolve", approverChainStub{approversErr: errors.New("erp down")}, "PendingApproval", "2002"},
+		{"no approver resolved", approverChainStub{}, "PendingApproval", "2002"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &delegationService{chainSvc: tt.chain, log: nopLogger()}
+			if _, err := svc.AuthorizeApproval(ctx, enums.ApprovalEntityWorkProduct, tt.status, "3003", tt.actor); err == nil {
+				t.Error("expected the action to be refused")
+			}
+		})
+	}
+
+	svc := &delegationService{chainSvc: lineManager, log: nopLogger()}
+	actor, err := svc.AuthorizeApproval(ctx, enums.ApprovalEntityWorkProduct, "PendingApproval", "3003", "2002")
+	if err != nil || actor.IsDelegated() {
+		t.Errorf("expected the line manager to approve directly, got %+v, %v", actor, err)
+	}
+}
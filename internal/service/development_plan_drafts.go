@@ -0,0 +1,525 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/competency"
+	"github.com/enterprise-pms/pms-api/internal/domain/enums"
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"gorm.io/gorm"
+)
+
+// ---------------------------------------------------------------------------
+// Development plan drafting. Rules map a competency, or a competency
+// category, and the size of a gap to a recommended training activity.
+// After review profiles are calculated, every profile with a gap and no
+// development plan gets a Draft plan per recommended activity; the
+// employee's supervisor then confirms or discards the drafts in bulk.
+// ---------------------------------------------------------------------------
+
+// defaultDevelopmentPlanTargetDays is how long a drafted plan runs when its
+// rule does not say.
+const defaultDevelopmentPlanTargetDays = 90
+
+// errDraftsAlreadyDecided rolls back a decision when one of its plans was
+// no longer a draft by the time it was updated.
+var errDraftsAlreadyDecided = errors.New("development plans already decided")
+
+// ==========================================================================
+// Rules
+// ==========================================================================
+
+// GetDevelopmentPlanRules returns the development plan rules.
+func (s *developmentPlanService) GetDevelopmentPlanRules(ctx context.Context) (competency.DevelopmentPlanRuleListResponseVm, error) {
+	resp := competency.DevelopmentPlanRuleListResponseVm{}
+
+	var rules []competency.DevelopmentPlanRule
+	if err := s.db.WithContext(ctx).
+		Where("soft_deleted = ?", false).
+		Order("competency_id NULLS LAST, competency_category_name, min_gap, development_plan_rule_id").
+		Find(&rules).Error; err != nil {
+		return resp, fmt.Errorf("listing development plan rules: %w", err)
+	}
+
+	ids := make([]int, 0, len(rules))
+	for _, r := range rules {
+		if r.CompetencyID != nil {
+			ids = append(ids, *r.CompetencyID)
+		}
+	}
+	names := map[int]string{}
+	if len(ids) > 0 {
+		var comps []competency.Competency
+		if err := s.db.WithContext(ctx).Select("competency_id", "competency_name").
+			Where("competency_id IN ?", ids).Find(&comps).Error; err != nil {
+			return resp, fmt.Errorf("loading rule competencies: %w", err)
+		}
+		for _, c := range comps {
+			names[c.CompetencyID] = c.CompetencyName
+		}
+	}
+
+	resp.Data = make([]competency.DevelopmentPlanRuleVm, 0, len(rules))
+	for _, r := range rules {
+		vm := toDevelopmentPlanRuleVm(r)
+		if r.CompetencyID != nil {
+			vm.CompetencyName = names[*r.CompetencyID]
+		}
+		resp.Data = append(resp.Data, vm)
+	}
+	resp.TotalRecord = len(resp.Data)
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// SaveDevelopmentPlanRule creates a rule, or updates it when
+// DevelopmentPlanRuleID is set. The training type, competency and category
+// it names must exist.
+func (s *developmentPlanService) SaveDevelopmentPlanRule(ctx context.Context, vm *competency.DevelopmentPlanRuleVm, savedBy string) (competency.BaseAPIResponse, error) {
+	resp := competency.BaseAPIResponse{}
+
+	if msg, err := s.validateRule(ctx, vm); err != nil || msg != "" {
+		resp.HasError = msg != ""
+		resp.Message = msg
+		return resp, err
+	}
+
+	rule := competency.DevelopmentPlanRule{}
+	if vm.DevelopmentPlanRuleID > 0 {
+		err := s.db.WithContext(ctx).
+			Where("development_plan_rule_id = ? AND soft_deleted = ?", vm.DevelopmentPlanRuleID, false).
+			First(&rule).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			resp.HasError = true
+			resp.Message = fmt.Sprintf("development plan rule %d not found", vm.DevelopmentPlanRuleID)
+			return resp, nil
+		}
+		if err != nil {
+			return resp, fmt.Errorf("loading development plan rule %d: %w", vm.DevelopmentPlanRuleID, err)
+		}
+		now := time.Now().UTC()
+		rule.UpdatedBy = savedBy
+		rule.DateUpdated = &now
+	} else {
+		rule.CreatedBy = savedBy
+	}
+	rule.CompetencyID = vm.CompetencyID
+	rule.CompetencyCategoryName = vm.CompetencyCategoryName
+	rule.MinGap = vm.MinGap
+	rule.MaxGap = vm.MaxGap
+	rule.TrainingTypeName = vm.TrainingTypeName
+	rule.Activity = vm.Activity
+	rule.LearningResource = vm.LearningResource
+	rule.TargetDays = vm.TargetDays
+	rule.IsActive = vm.IsActive || vm.DevelopmentPlanRuleID == 0
+
+	if err := s.db.WithContext(ctx).Save(&rule).Error; err != nil {
+		return resp, fmt.Errorf("saving development plan rule: %w", err)
+	}
+	resp.Message = "Development plan rule has been saved successfully"
+	return resp, nil
+}
+
+// validateRule normalises a rule and checks what it names exists. A
+// non-empty message explains why the rule is invalid.
+func (s *developmentPlanService) validateRule(ctx context.Context, vm *competency.DevelopmentPlanRuleVm) (string, error) {
+	vm.Activity = strings.TrimSpace(vm.Activity)
+	vm.LearningResource = strings.TrimSpace(vm.LearningResource)
+	vm.CompetencyCategoryName = strings.TrimSpace(vm.CompetencyCategoryName)
+	if vm.CompetencyID != nil && *vm.CompetencyID <= 0 {
+		vm.CompetencyID = nil
+	}
+	if vm.MinGap <= 0 {
+		vm.MinGap = 1
+	}
+	if vm.TargetDays <= 0 {
+		vm.TargetDays = defaultDevelopmentPlanTargetDays
+	}
+
+	switch {
+	case vm.Activity == "":
+		return "activity is required", nil
+	case vm.MaxGap < 0 || (vm.MaxGap > 0 && vm.MaxGap < vm.MinGap):
+		return "maximum gap must be 0 (no limit) or at least the minimum gap", nil
+	case vm.CompetencyID != nil && vm.CompetencyCategoryName != "":
+		return "a rule applies to a competency or to a competency category, not both", nil
+	}
+
+	var trainingType competency.TrainingType
+	err := s.db.WithContext(ctx).
+		Where("LOWER(training_type_name) = LOWER(?) AND soft_deleted = ?", strings.TrimSpace(vm.TrainingTypeName), false).
+		First(&trainingType).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Sprintf("training type %q not found", vm.TrainingTypeName), nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("loading training type: %w", err)
+	}
+	vm.TrainingTypeName = trainingType.TrainingTypeName
+
+	if vm.CompetencyID != nil {
+		var n int64
+		if err := s.db.WithContext(ctx).Model(&competency.Competency{}).
+			Where("competency_id = ? AND soft_deleted = ?", *vm.CompetencyID, false).Count(&n).Error; err != nil {
+			return "", fmt.Errorf("loading competency %d: %w", *vm.CompetencyID, err)
+		}
+		if n == 0 {
+			return fmt.Sprintf("competency %d not found", *vm.CompetencyID), nil
+		}
+	}
+	if vm.CompetencyCategoryName != "" {
+		var category competency.CompetencyCategory
+		err := s.db.WithContext(ctx).
+			Where("LOWER(category_name) = LOWER(?) AND soft_deleted = ?", vm.CompetencyCategoryName, false).
+			First(&category).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Sprintf("competency category %q not found", vm.CompetencyCategoryName), nil
+		}
+		if err != nil {
+			return "", fmt.Errorf("loading competency category: %w", err)
+		}
+		vm.CompetencyCategoryName = category.CategoryName
+	}
+	return "", nil
+}
+
+// DeleteDevelopmentPlanRule removes a rule. Plans already drafted from it
+// are kept.
+func (s *developmentPlanService) DeleteDevelopmentPlanRule(ctx context.Context, ruleID int, deletedBy string) (competency.BaseAPIResponse, error) {
+	resp := competency.BaseAPIResponse{}
+	now := time.Now().UTC()
+	result := s.db.WithContext(ctx).Model(&competency.DevelopmentPlanRule{}).
+		Where("development_plan_rule_id = ? AND soft_deleted = ?", ruleID, false).
+		Updates(map[string]interface{}{"soft_deleted": true, "updated_by": deletedBy, "date_updated": now})
+	if result.Error != nil {
+		return resp, fmt.Errorf("deleting development plan rule %d: %w", ruleID, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		resp.HasError = true
+		resp.Message = fmt.Sprintf("development plan rule %d not found", ruleID)
+		return resp, nil
+	}
+	resp.Message = "Development plan rule has been deleted"
+	return resp, nil
+}
+
+func toDevelopmentPlanRuleVm(r competency.DevelopmentPlanRule) competency.DevelopmentPlanRuleVm {
+	return competency.DevelopmentPlanRuleVm{
+		DevelopmentPlanRuleID:  r.DevelopmentPlanRuleID,
+		CompetencyID:           r.CompetencyID,
+		CompetencyCategoryName: r.CompetencyCategoryName,
+		MinGap:                 r.MinGap,
+		MaxGap:                 r.MaxGap,
+		TrainingTypeName:       r.TrainingTypeName,
+		Activity:               r.Activity,
+		LearningResource:       r.LearningResource,
+		TargetDays:             r.TargetDays,
+		BaseAuditVm:            toBaseAuditVm(r.BaseAudit),
+	}
+}
+
+// ==========================================================================
+// Drafting
+// ==========================================================================
+
+// DraftDevelopmentPlans drafts development plans from the rules for every
+// review profile with a gap and no development plan yet.
+func (s *developmentPlanService) DraftDevelopmentPlans(ctx context.Context, req *competency.DevelopmentPlanDraftRequestModel) (competency.DevelopmentPlanDraftResultVm, error) {
+	resp := competency.DevelopmentPlanDraftResultVm{}
+
+	if err := authorizeOrgFilter(ctx, s.scope, req.OfficeID, req.DivisionID, req.DepartmentID); err != nil {
+		return resp, err
+	}
+	if req.EmployeeNumber != "" {
+		if err := s.scope.AuthorizeStaff(ctx, req.EmployeeNumber); err != nil {
+			return resp, err
+		}
+	}
+
+	var rules []competency.DevelopmentPlanRule
+	if err := s.db.WithContext(ctx).
+		Where("soft_deleted = ? AND is_active = ?", false, true).
+		Order("development_plan_rule_id").
+		Find(&rules).Error; err != nil {
+		return resp, fmt.Errorf("listing development plan rules: %w", err)
+	}
+	if len(rules) == 0 {
+		resp.HasError = true
+		resp.Message = "no development plan rules are set up"
+		return resp, nil
+	}
+
+	q := s.db.WithContext(ctx).
+		Where("have_gap = ? AND competency_gap > 0 AND soft_deleted = ?", true, false).
+		Where(`NOT EXISTS (SELECT 1 FROM "CoreSchema".development_plans dp
+			WHERE dp.competency_review_profile_id = competency_review_profiles.competency_review_profile_id
+			AND dp.soft_deleted = FALSE)`)
+	q = applyOrgFilter(q, req.ReviewPeriodID, req.OfficeID, req.DivisionID, req.DepartmentID)
+	if req.EmployeeNumber != "" {
+		q = q.Where("employee_number = ?", req.EmployeeNumber)
+	}
+	var profiles []competency.CompetencyReviewProfile
+	if err := q.Order("competency_review_profile_id").Find(&profiles).Error; err != nil {
+		return resp, fmt.Errorf("listing review profiles with gaps: %w", err)
+	}
+	resp.ProfilesWithGaps = len(profiles)
+
+	createdBy := req.RequestedBy
+	if createdBy == "" {
+		createdBy = "SYSTEM"
+	}
+	plans, unmatched := draftDevelopmentPlans(rules, profiles, time.Now().UTC(), createdBy)
+	resp.ProfilesWithoutRule = unmatched
+	resp.ProfilesDrafted = resp.ProfilesWithGaps - unmatched
+	resp.PlansDrafted = len(plans)
+	if len(plans) > 0 {
+		if err := s.db.WithContext(ctx).CreateInBatches(plans, 200).Error; err != nil {
+			return resp, fmt.Errorf("drafting development plans: %w", err)
+		}
+	}
+
+	s.log.Info().
+		Int("profiles", resp.ProfilesWithGaps).
+		Int("drafted", resp.PlansDrafted).
+		Int("withoutRule", resp.ProfilesWithoutRule).
+		Str("requestedBy", createdBy).
+		Msg("development plans drafted from competency gaps")
+	resp.Message = fmt.Sprintf("%d development plan(s) drafted for %d competency gap(s)", resp.PlansDrafted, resp.ProfilesDrafted)
+	return resp, nil
+}
+
+// draftDevelopmentPlans builds a Draft plan for each activity the rules
+// recommend for each profile, and counts the profiles no rule covers.
+func draftDevelopmentPlans(rules []competency.DevelopmentPlanRule, profiles []competency.CompetencyReviewProfile, now time.Time, createdBy string) ([]competency.DevelopmentPlan, int) {
+	var plans []competency.DevelopmentPlan
+	unmatched := 0
+	for _, p := range profiles {
+		recommended := competency.RecommendDevelopmentActivities(rules, p)
+		if len(recommended) == 0 {
+			unmatched++
+			continue
+		}
+		for _, r := range recommended {
+			days := r.TargetDays
+			if days <= 0 {
+				days = defaultDevelopmentPlanTargetDays
+			}
+			plan := competency.DevelopmentPlan{
+				CompetencyReviewProfileID: p.CompetencyReviewProfileID,
+				EmployeeNumber:            p.EmployeeNumber,
+				TrainingTypeName:          r.TrainingTypeName,
+				Activity:                  r.Activity,
+				LearningResource:          r.LearningResource,
+				TargetDate:                now.AddDate(0, 0, days),
+				TaskStatus:                enums.DevelopmentTaskStatusDraft.String(),
+			}
+			plan.CreatedBy = createdBy
+			plan.IsActive = true
+			plans = append(plans, plan)
+		}
+	}
+	return plans, unmatched
+}
+
+// ==========================================================================
+// Supervisor confirmation
+// ==========================================================================
+
+// GetDevelopmentPlanDrafts lists the drafted plans within the caller's data
+// scope, other than their own, for them to confirm or discard.
+func (s *developmentPlanService) GetDevelopmentPlanDrafts(ctx context.Context, search *competency.DevelopmentPlanDraftSearchModel) (competency.DevelopmentPlanListResponseVm, error) {
+	resp := competency.DevelopmentPlanListResponseVm{}
+
+	q := s.db.WithContext(ctx).
+		Preload("CompetencyReviewProfile").
+		Where("soft_deleted = ? AND task_status = ?", false, enums.DevelopmentTaskStatusDraft.String())
+	if search.EmployeeNumber != "" {
+		q = q.Where("employee_number = ?", search.EmployeeNumber)
+	}
+	if search.ReviewPeriodID != nil {
+		q = q.Where(`competency_review_profile_id IN (SELECT competency_review_profile_id
+			FROM "CoreSchema".competency_review_profiles WHERE review_period_id = ?)`, *search.ReviewPeriodID)
+	}
+	var plans []competency.DevelopmentPlan
+	if err := q.Order("employee_number, development_plan_id").Find(&plans).Error; err != nil {
+		return resp, fmt.Errorf("listing drafted development plans: %w", err)
+	}
+
+	staff := make([]string, 0, len(plans))
+	for _, p := range plans {
+		staff = append(staff, p.EmployeeNumber)
+	}
+	visible, err := s.scope.FilterStaff(ctx, staff)
+	if err != nil {
+		return resp, err
+	}
+	inScope := make(map[string]bool, len(visible))
+	for _, id := range visible {
+		inScope[id] = true
+	}
+
+	caller := callerStaffID(ctx)
+	now := time.Now().UTC()
+	resp.Data = make([]competency.DevelopmentPlanVm, 0, len(plans))
+	for _, p := range plans {
+		if !inScope[p.EmployeeNumber] || strings.TrimSpace(p.EmployeeNumber) == caller {
+			continue
+		}
+		resp.Data = append(resp.Data, toDevelopmentPlanVm(p, now))
+	}
+	resp.TotalRecord = len(resp.Data)
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// DecideDevelopmentPlanDrafts confirms drafted plans, assigning them to
+// their employees, and discards others. Each employee is told once about
+// their newly assigned plans.
+func (s *developmentPlanService) DecideDevelopmentPlanDrafts(ctx context.Context, req *competency.DevelopmentPlanDraftDecisionRequestModel) (competency.BaseAPIResponse, error) {
+	resp := competency.BaseAPIResponse{}
+
+	ids := make([]int, 0, len(req.Confirm)+len(req.Discard))
+	seen := map[int]bool{}
+	for _, id := range append(append([]int{}, req.Confirm...), req.Discard...) {
+		if seen[id] {
+			resp.HasError = true
+			resp.Message = fmt.Sprintf("development plan %d is listed more than once", id)
+			return resp, nil
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		resp.HasError = true
+		resp.Message = "at least one development plan to confirm or discard is required"
+		return resp, nil
+	}
+
+	var plans []competency.DevelopmentPlan
+	if err := s.db.WithContext(ctx).
+		Where("development_plan_id IN ? AND soft_deleted = ?", ids, false).
+		Find(&plans).Error; err != nil {
+		return resp, fmt.Errorf("loading drafted development plans: %w", err)
+	}
+	byID := make(map[int]*competency.DevelopmentPlan, len(plans))
+	for i := range plans {
+		byID[plans[i].DevelopmentPlanID] = &plans[i]
+	}
+
+	caller := callerStaffID(ctx)
+	for _, id := range ids {
+		p, ok := byID[id]
+		if !ok {
+			resp.HasError = true
+			resp.Message = fmt.Sprintf("development plan %d not found", id)
+			return resp, nil
+		}
+		if err := s.scope.AuthorizeStaff(ctx, p.EmployeeNumber); err != nil {
+			return resp, err
+		}
+		if status, _ := enums.ParseDevelopmentTaskStatus(p.TaskStatus); status != enums.DevelopmentTaskStatusDraft {
+			resp.HasError = true
+			resp.Message = fmt.Sprintf("development plan %d is not a draft", id)
+			return resp, nil
+		}
+		if !isLineManager(caller, s.employee(ctx, p.EmployeeNumber)) {
+			resp.HasError = true
+			resp.Message = "drafted development plans must be confirmed by the employee's supervisor"
+			return resp, nil
+		}
+	}
+
+	// The updates only touch plans still in Draft, so a concurrent decision
+	// on the same plans rolls this one back instead of overwriting it.
+	now := time.Now().UTC()
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if len(req.Confirm) > 0 {
+			res := tx.Model(&competency.DevelopmentPlan{}).
+				Where("development_plan_id IN ? AND task_status = ? AND soft_deleted = ?",
+					req.Confirm, enums.DevelopmentTaskStatusDraft.String(), false).
+				Updates(map[string]interface{}{
+					"task_status":  enums.DevelopmentTaskStatusAssigned.String(),
+					"updated_by":   req.DecidedBy,
+					"date_updated": now,
+				})
+			if res.Error != nil {
+				return fmt.Errorf("confirming development plans: %w", res.Error)
+			}
+			if res.RowsAffected != int64(len(req.Confirm)) {
+				return errDraftsAlreadyDecided
+			}
+		}
+		if len(req.Discard) > 0 {
+			res := tx.Model(&competency.DevelopmentPlan{}).
+				Where("development_plan_id IN ? AND task_status = ? AND soft_deleted = ?",
+					req.Discard, enums.DevelopmentTaskStatusDraft.String(), false).
+				Updates(map[string]interface{}{
+					"soft_deleted": true,
+					"updated_by":   req.DecidedBy,
+					"date_updated": now,
+				})
+			if res.Error != nil {
+				return fmt.Errorf("discarding development plans: %w", res.Error)
+			}
+			if res.RowsAffected != int64(len(req.Discard)) {
+				return errDraftsAlreadyDecided
+			}
+		}
+		return nil
+	})
+	if errors.Is(err, errDraftsAlreadyDecided) {
+		resp.HasError = true
+		resp.Message = "some of the development plans have already been decided; reload the drafts and try again"
+		return resp, nil
+	}
+	if err != nil {
+		return resp, err
+	}
+
+	s.log.Info().Ints("confirmed", req.Confirm).Ints("discarded", req.Discard).Str("by", req.DecidedBy).
+		Msg("drafted development plans decided")
+	s.notifyAssigned(ctx, req.Confirm, byID)
+
+	resp.Message = fmt.Sprintf("%d development plan(s) confirmed, %d discarded", len(req.Confirm), len(req.Discard))
+	return resp, nil
+}
+
+// notifyAssigned tells each employee once about their confirmed plans.
+func (s *developmentPlanService) notifyAssigned(ctx context.Context, confirmed []int, byID map[int]*competency.DevelopmentPlan) {
+	byEmployee := map[string][]*competency.DevelopmentPlan{}
+	for _, id := range confirmed {
+		p := byID[id]
+		byEmployee[p.EmployeeNumber] = append(byEmployee[p.EmployeeNumber], p)
+	}
+	employees := make([]string, 0, len(byEmployee))
+	for e := range byEmployee {
+		employees = append(employees, e)
+	}
+	sort.Strings(employees)
+
+	for _, e := range employees {
+		plans := byEmployee[e]
+		title := "New development plan assigned"
+		if len(plans) > 1 {
+			title = fmt.Sprintf("%d new development plans assigned", len(plans))
+		}
+		s.notify(ctx, plans[0], e, s.employee(ctx, e), performance.NotificationTypeDevelopmentPlanUpdate, title,
+			fmt.Sprintf("Your supervisor has assigned you %d development activity(ies) to close your competency gaps, the first due on %s.",
+				len(plans), earliestTarget(plans).Format("02 Jan 2006")))
+	}
+}
+
+func earliestTarget(plans []*competency.DevelopmentPlan) time.Time {
+	earliest := plans[0].TargetDate
+	for _, p := range plans[1:] {
+		if p.TargetDate.Before(earliest) {
+			earliest = p.TargetDate
+		}
+	}
+	return earliest
+}
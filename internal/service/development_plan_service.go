@@ -0,0 +1,759 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"html/template"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/config"
+	"github.com/enterprise-pms/pms-api/internal/domain/competency"
+	"github.com/enterprise-pms/pms-api/internal/domain/enums"
+	"github.com/enterprise-pms/pms-api/internal/domain/erp"
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"github.com/enterprise-pms/pms-api/internal/repository"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ---------------------------------------------------------------------------
+// developmentPlanService runs the development plan lifecycle. The employee
+// starts and completes a plan and attaches evidence; their supervisor
+// verifies it or returns it for more work, and may then close the
+// competency gap. The allowed moves are competency.DevelopmentPlanTransition.
+// Staff are reminded of open plans before and after the target date, and
+// completion is reported per office, division or department.
+// ---------------------------------------------------------------------------
+
+type developmentPlanService struct {
+	db              *gorm.DB
+	directory       orgDirectory
+	scope           DataScopeService
+	globalSetting   GlobalSettingService
+	notificationSvc NotificationService
+	reminderBefore  []int
+	reminderAfter   []int
+	log             zerolog.Logger
+}
+
+func newDevelopmentPlanService(
+	repos *repository.Container,
+	cfg *config.Config,
+	log zerolog.Logger,
+	scope DataScopeService,
+	globalSetting GlobalSettingService,
+	notificationSvc NotificationService,
+) DevelopmentPlanService {
+	svc := &developmentPlanService{
+		db:              repos.GormDB,
+		scope:           scope,
+		globalSetting:   globalSetting,
+		notificationSvc: notificationSvc,
+		reminderBefore:  cfg.Competency.ReminderDaysBefore,
+		reminderAfter:   cfg.Competency.ReminderDaysAfter,
+		log:             log.With().Str("service", "development_plan").Logger(),
+	}
+	if repos.Erp != nil {
+		svc.directory = repos.Erp
+	}
+	return svc
+}
+
+// ==========================================================================
+// Lifecycle
+// ==========================================================================
+
+// GetDevelopmentPlan returns a development plan with its evidence.
+func (s *developmentPlanService) GetDevelopmentPlan(ctx context.Context, planID int) (competency.DevelopmentPlanResponseVm, error) {
+	resp := competency.DevelopmentPlanResponseVm{}
+
+	plan, err := s.loadPlan(ctx, planID)
+	if err != nil {
+		return resp, err
+	}
+	if plan == nil {
+		resp.HasError = true
+		resp.Message = fmt.Sprintf("development plan %d not found", planID)
+		return resp, nil
+	}
+	if err := s.scope.AuthorizeStaff(ctx, plan.EmployeeNumber); err != nil {
+		return resp, err
+	}
+
+	vm, err := s.toVm(ctx, plan)
+	if err != nil {
+		return resp, err
+	}
+	resp.Data = vm
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// UpdateDevelopmentPlanStatus moves a development plan along its lifecycle.
+// Only the employee may start or complete their plan, and only their line
+// manager may verify it, return it or close the gap.
+func (s *developmentPlanService) UpdateDevelopmentPlanStatus(ctx context.Context, req *competency.DevelopmentPlanStatusRequestModel) (competency.DevelopmentPlanResponseVm, error) {
+	resp := competency.DevelopmentPlanResponseVm{}
+
+	plan, err := s.loadPlan(ctx, req.DevelopmentPlanID)
+	if err != nil {
+		return resp, err
+	}
+	if plan == nil {
+		resp.HasError = true
+		resp.Message = fmt.Sprintf("development plan %d not found", req.DevelopmentPlanID)
+		return resp, nil
+	}
+	if err := s.scope.AuthorizeStaff(ctx, plan.EmployeeNumber); err != nil {
+		return resp, err
+	}
+
+	from, ok := enums.ParseDevelopmentTaskStatus(plan.TaskStatus)
+	if !ok {
+		resp.HasError = true
+		resp.Message = fmt.Sprintf("development plan %d has an unrecognised status %q", plan.DevelopmentPlanID, plan.TaskStatus)
+		return resp, nil
+	}
+	to, ok := enums.ParseDevelopmentTaskStatus(req.Status)
+	if !ok {
+		resp.HasError = true
+		resp.Message = fmt.Sprintf("unknown development plan status %q", req.Status)
+		return resp, nil
+	}
+
+	caller := callerStaffID(ctx)
+	isEmployee := caller != "" && caller == strings.TrimSpace(plan.EmployeeNumber)
+	switch competency.DevelopmentPlanTransition(from, to) {
+	case competency.DevelopmentPlanActorEmployee:
+		if !isEmployee {
+			resp.HasError = true
+			resp.Message = "only the employee can start or complete their development plan"
+			return resp, nil
+		}
+	case competency.DevelopmentPlanActorSupervisor:
+		if !isLineManager(caller, s.employee(ctx, plan.EmployeeNumber)) {
+			resp.HasError = true
+			resp.Message = "a development plan must be signed off by the employee's supervisor"
+			return resp, nil
+		}
+	default:
+		resp.HasError = true
+		resp.Message = fmt.Sprintf("a %s development plan cannot be moved to %s", from, to)
+		return resp, nil
+	}
+
+	now := time.Now().UTC()
+	switch to {
+	case enums.DevelopmentTaskStatusInProgress:
+		if from == enums.DevelopmentTaskStatusCompleted {
+			plan.CompletionDate = nil
+			plan.SupervisorComment = req.Comment
+		}
+	case enums.DevelopmentTaskStatusCompleted:
+		completed := now
+		if req.CompletionDate != nil {
+			completed = req.CompletionDate.UTC()
+		}
+		if completed.After(now) {
+			resp.HasError = true
+			resp.Message = "completion date cannot be in the future"
+			return resp, nil
+		}
+		plan.CompletionDate = &completed
+	case enums.DevelopmentTaskStatusVerified:
+		plan.VerifiedBy = caller
+		plan.DateVerified = &now
+		plan.SupervisorComment = req.Comment
+	case enums.DevelopmentTaskStatusClosedGap:
+		if req.Comment != "" {
+			plan.SupervisorComment = req.Comment
+		}
+	}
+	plan.TaskStatus = to.String()
+	plan.UpdatedBy = req.UpdatedBy
+	plan.DateUpdated = &now
+
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Save(plan).Error; err != nil {
+			return fmt.Errorf("updating development plan %d: %w", plan.DevelopmentPlanID, err)
+		}
+		if to != enums.DevelopmentTaskStatusClosedGap || plan.CompetencyReviewProfile == nil {
+			return nil
+		}
+		profile := plan.CompetencyReviewProfile
+		profile.CloseGap()
+		profile.UpdatedBy = req.UpdatedBy
+		profile.DateUpdated = &now
+		if err := tx.Omit(clause.Associations).Save(profile).Error; err != nil {
+			return fmt.Errorf("closing competency gap of profile %d: %w", profile.CompetencyReviewProfileID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return resp, err
+	}
+
+	s.log.Info().
+		Int("developmentPlanId", plan.DevelopmentPlanID).
+		Str("from", from.String()).
+		Str("to", to.String()).
+		Str("by", caller).
+		Msg("development plan status changed")
+	s.notifyStatusChange(ctx, plan, from, to)
+
+	resp, err = s.GetDevelopmentPlan(ctx, plan.DevelopmentPlanID)
+	if err == nil && !resp.HasError {
+		resp.Message = fmt.Sprintf("Development plan is now %s", to)
+	}
+	return resp, err
+}
+
+// AddDevelopmentPlanEvidence attaches a file the employee uploaded to their
+// development plan. Evidence is frozen once the plan is verified.
+func (s *developmentPlanService) AddDevelopmentPlanEvidence(ctx context.Context, req *competency.DevelopmentPlanEvidenceRequestModel) (competency.DevelopmentPlanResponseVm, error) {
+	resp := competency.DevelopmentPlanResponseVm{}
+
+	plan, msg, err := s.loadPlanForEvidence(ctx, req.DevelopmentPlanID)
+	if err != nil || msg != "" {
+		resp.HasError = msg != ""
+		resp.Message = msg
+		return resp, err
+	}
+
+	fileID := strings.TrimSpace(req.FileUploadID)
+	var upload performance.FileUpload
+	err = s.db.WithContext(ctx).
+		Where("file_upload_id = ? AND soft_deleted = ?", fileID, false).
+		First(&upload).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		resp.HasError = true
+		resp.Message = fmt.Sprintf("file %s not found", fileID)
+		return resp, nil
+	}
+	if err != nil {
+		return resp, fmt.Errorf("loading file %s: %w", fileID, err)
+	}
+	if upload.UploadedBy != req.UploadedBy {
+		resp.HasError = true
+		resp.Message = "evidence must be a file you uploaded"
+		return resp, nil
+	}
+	for _, e := range plan.Evidence {
+		if e.FileUploadID == fileID {
+			resp.HasError = true
+			resp.Message = "the file is already attached to this development plan"
+			return resp, nil
+		}
+	}
+
+	evidence := competency.DevelopmentPlanEvidence{
+		DevelopmentPlanID: plan.DevelopmentPlanID,
+		FileUploadID:      fileID,
+		Description:       strings.TrimSpace(req.Description),
+		UploadedBy:        req.UploadedBy,
+	}
+	if err := s.db.WithContext(ctx).Create(&evidence).Error; err != nil {
+		return resp, fmt.Errorf("attaching evidence to development plan %d: %w", plan.DevelopmentPlanID, err)
+	}
+
+	resp, err = s.GetDevelopmentPlan(ctx, plan.DevelopmentPlanID)
+	if err == nil && !resp.HasError {
+		resp.Message = "Evidence has been attached"
+	}
+	return resp, err
+}
+
+// RemoveDevelopmentPlanEvidence detaches evidence from the employee's
+// development plan. The file itself is kept.
+func (s *developmentPlanService) RemoveDevelopmentPlanEvidence(ctx context.Context, planID, evidenceID int) (competency.DevelopmentPlanResponseVm, error) {
+	resp := competency.DevelopmentPlanResponseVm{}
+
+	plan, msg, err := s.loadPlanForEvidence(ctx, planID)
+	if err != nil || msg != "" {
+		resp.HasError = msg != ""
+		resp.Message = msg
+		return resp, err
+	}
+
+	result := s.db.WithContext(ctx).
+		Where("development_plan_evidence_id = ? AND development_plan_id = ?", evidenceID, plan.DevelopmentPlanID).
+		Delete(&competency.DevelopmentPlanEvidence{})
+	if result.Error != nil {
+		return resp, fmt.Errorf("removing evidence %d: %w", evidenceID, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		resp.HasError = true
+		resp.Message = fmt.Sprintf("evidence %d not found on development plan %d", evidenceID, planID)
+		return resp, nil
+	}
+
+	resp, err = s.GetDevelopmentPlan(ctx, plan.DevelopmentPlanID)
+	if err == nil && !resp.HasError {
+		resp.Message = "Evidence has been removed"
+	}
+	return resp, err
+}
+
+// loadPlanForEvidence loads a plan whose evidence the caller may change: it
+// must be their own and not yet verified. A non-empty message explains why
+// it may not be changed.
+func (s *developmentPlanService) loadPlanForEvidence(ctx context.Context, planID int) (*competency.DevelopmentPlan, string, error) {
+	plan, err := s.loadPlan(ctx, planID)
+	if err != nil {
+		return nil, "", err
+	}
+	if plan == nil {
+		return nil, fmt.Sprintf("development plan %d not found", planID), nil
+	}
+	if err := s.scope.AuthorizeStaff(ctx, plan.EmployeeNumber); err != nil {
+		return nil, "", err
+	}
+	if callerStaffID(ctx) != strings.TrimSpace(plan.EmployeeNumber) {
+		return nil, "only the employee can change the evidence on their development plan", nil
+	}
+	status, _ := enums.ParseDevelopmentTaskStatus(plan.TaskStatus)
+	if status == enums.DevelopmentTaskStatusVerified || status == enums.DevelopmentTaskStatusClosedGap {
+		return nil, "evidence cannot be changed once the development plan is verified", nil
+	}
+	return plan, "", nil
+}
+
+// loadPlan returns a development plan with its review profile and evidence,
+// or nil when there is none.
+func (s *developmentPlanService) loadPlan(ctx context.Context, planID int) (*competency.DevelopmentPlan, error) {
+	var plan competency.DevelopmentPlan
+	err := s.db.WithContext(ctx).
+		Preload("CompetencyReviewProfile").
+		Preload("Evidence", func(db *gorm.DB) *gorm.DB { return db.Order("date_uploaded") }).
+		Where("development_plan_id = ? AND soft_deleted = ?", planID, false).
+		First(&plan).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("loading development plan %d: %w", planID, err)
+	}
+	return &plan, nil
+}
+
+func (s *developmentPlanService) toVm(ctx context.Context, plan *competency.DevelopmentPlan) (*competency.DevelopmentPlanVm, error) {
+	vm := toDevelopmentPlanVm(*plan, time.Now().UTC())
+	if len(plan.Evidence) == 0 {
+		return &vm, nil
+	}
+
+	ids := make([]string, len(plan.Evidence))
+	for i, e := range plan.Evidence {
+		ids[i] = e.FileUploadID
+	}
+	var uploads []performance.FileUpload
+	if err := s.db.WithContext(ctx).Where("file_upload_id IN ?", ids).Find(&uploads).Error; err != nil {
+		return nil, fmt.Errorf("loading evidence files of development plan %d: %w", plan.DevelopmentPlanID, err)
+	}
+	names := make(map[string]string, len(uploads))
+	for _, u := range uploads {
+		names[u.FileUploadID] = u.OriginalName
+	}
+
+	vm.Evidence = make([]competency.DevelopmentPlanEvidenceVm, 0, len(plan.Evidence))
+	for _, e := range plan.Evidence {
+		vm.Evidence = append(vm.Evidence, competency.DevelopmentPlanEvidenceVm{
+			DevelopmentPlanEvidenceID: e.DevelopmentPlanEvidenceID,
+			FileUploadID:              e.FileUploadID,
+			OriginalName:              names[e.FileUploadID],
+			Description:               e.Description,
+			UploadedBy:                e.UploadedBy,
+			DateUploaded:              e.DateUploaded,
+		})
+	}
+	return &vm, nil
+}
+
+// toDevelopmentPlanVm maps a development plan without its evidence.
+func toDevelopmentPlanVm(e competency.DevelopmentPlan, now time.Time) competency.DevelopmentPlanVm {
+	vm := competency.DevelopmentPlanVm{
+		DevelopmentPlanID:         e.DevelopmentPlanID,
+		EmployeeNumber:            e.EmployeeNumber,
+		CompetencyReviewProfileID: e.CompetencyReviewProfileID,
+		Activity:                  e.Activity,
+		CompletionDate:            e.CompletionDate,
+		LearningResource:          e.LearningResource,
+		TargetDate:                e.TargetDate,
+		TaskStatus:                e.TaskStatus,
+		TrainingTypeName:          e.TrainingTypeName,
+		VerifiedBy:                e.VerifiedBy,
+		DateVerified:              e.DateVerified,
+		SupervisorComment:         e.SupervisorComment,
+		BaseAuditVm:               toBaseAuditVm(e.BaseAudit),
+	}
+	if status, ok := enums.ParseDevelopmentTaskStatus(e.TaskStatus); ok {
+		vm.IsOverdue = competency.IsDevelopmentPlanOpen(status) && e.TargetDate.Before(now)
+	}
+	if e.CompetencyReviewProfile != nil {
+		vm.CompetencyCategoryName = e.CompetencyReviewProfile.CompetencyCategoryName
+		vm.CompetencyName = e.CompetencyReviewProfile.CompetencyName
+		vm.CurrentGap = e.CompetencyReviewProfile.CompetencyGap
+		vm.ReviewPeriod = e.CompetencyReviewProfile.ReviewPeriodName
+		vm.EmployeeName = e.CompetencyReviewProfile.EmployeeName
+	}
+	return vm
+}
+
+// ==========================================================================
+// Completion report
+// ==========================================================================
+
+// developmentPlanGroupings maps a report grouping to the review profile
+// columns holding the unit's ID and name.
+var developmentPlanGroupings = map[string][2]string{
+	"office":     {"office_id", "office_name"},
+	"division":   {"division_id", "division_name"},
+	"department": {"department_id", "department_name"},
+}
+
+// GetDevelopmentPlanCompletion counts development plans by status for each
+// office, division or department within the caller's data scope.
+func (s *developmentPlanService) GetDevelopmentPlanCompletion(ctx context.Context, search *competency.DevelopmentPlanCompletionSearchModel) (competency.DevelopmentPlanCompletionListResponseVm, error) {
+	resp := competency.DevelopmentPlanCompletionListResponseVm{}
+
+	groupBy := strings.ToLower(strings.TrimSpace(search.GroupBy))
+	if groupBy == "" {
+		groupBy = "department"
+	}
+	cols, ok := developmentPlanGroupings[groupBy]
+	if !ok {
+		resp.HasError = true
+		resp.Message = fmt.Sprintf("cannot group development plans by %q; use office, division or department", search.GroupBy)
+		return resp, nil
+	}
+	if err := authorizeOrgFilter(ctx, s.scope, search.OfficeID, search.DivisionID, search.DepartmentID); err != nil {
+		return resp, err
+	}
+
+	const (
+		status  = `LOWER(COALESCE(TRIM(dp.task_status), ''))`
+		planned = `('', 'planned', 'assigned', 'initiated')`
+		open    = `('', 'planned', 'assigned', 'initiated', 'inprogress')`
+	)
+	var rows []struct {
+		UnitID     string
+		UnitName   string
+		TotalPlans int
+		Planned    int
+		InProgress int
+		Completed  int
+		Verified   int
+		ClosedGap  int
+		Overdue    int
+	}
+	q := s.db.WithContext(ctx).
+		Table(`"CoreSchema".development_plans AS dp`).
+		Joins(`JOIN "CoreSchema".competency_review_profiles AS p ON p.competency_review_profile_id = dp.competency_review_profile_id`).
+		Select(fmt.Sprintf(`COALESCE(p.%[1]s, '') AS unit_id, MAX(p.%[2]s) AS unit_name, COUNT(*) AS total_plans,
+			SUM(CASE WHEN %[3]s IN %[4]s THEN 1 ELSE 0 END) AS planned,
+			SUM(CASE WHEN %[3]s = 'inprogress' THEN 1 ELSE 0 END) AS in_progress,
+			SUM(CASE WHEN %[3]s = 'completed' THEN 1 ELSE 0 END) AS completed,
+			SUM(CASE WHEN %[3]s = 'verified' THEN 1 ELSE 0 END) AS verified,
+			SUM(CASE WHEN %[3]s = 'closedgap' THEN 1 ELSE 0 END) AS closed_gap,
+			SUM(CASE WHEN %[3]s IN %[5]s AND dp.target_date < ? THEN 1 ELSE 0 END) AS overdue`,
+			cols[0], cols[1], status, planned, open), time.Now().UTC()).
+		Where("dp.soft_deleted = ? AND p.soft_deleted = ?", false, false).
+		Where(status + " <> 'draft'")
+	q = applyOrgFilter(q, search.ReviewPeriodID, search.OfficeID, search.DivisionID, search.DepartmentID)
+	if err := q.Group("COALESCE(p." + cols[0] + ", '')").Scan(&rows).Error; err != nil {
+		return resp, fmt.Errorf("reporting development plan completion: %w", err)
+	}
+
+	resp.Data = make([]competency.DevelopmentPlanCompletionVm, 0, len(rows))
+	for _, r := range rows {
+		vm := competency.DevelopmentPlanCompletionVm{
+			UnitID:     r.UnitID,
+			UnitName:   r.UnitName,
+			TotalPlans: r.TotalPlans,
+			Planned:    r.Planned,
+			InProgress: r.InProgress,
+			Completed:  r.Completed,
+			Verified:   r.Verified,
+			ClosedGap:  r.ClosedGap,
+			Overdue:    r.Overdue,
+		}
+		vm.CompletionRate = completionRate(r.Completed+r.Verified+r.ClosedGap, r.TotalPlans)
+		resp.Data = append(resp.Data, vm)
+	}
+	sort.Slice(resp.Data, func(i, j int) bool { return resp.Data[i].UnitName < resp.Data[j].UnitName })
+	resp.GroupBy = groupBy
+	resp.TotalRecord = len(resp.Data)
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// completionRate returns done as a percentage of total, to one decimal.
+func completionRate(done, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return math.Round(float64(done)*1000/float64(total)) / 10
+}
+
+// ==========================================================================
+// Reminders
+// ==========================================================================
+
+// SendDevelopmentPlanReminders reminds employees of open development plans
+// approaching or past their target date; their supervisor is told about
+// overdue plans too. Each reminder is sent once per plan. It returns the
+// number of plans reminded about.
+func (s *developmentPlanService) SendDevelopmentPlanReminders(ctx context.Context) (int, error) {
+	now := time.Now().UTC()
+	horizon := now.AddDate(0, 0, maxDays(s.reminderBefore)+1)
+
+	var plans []competency.DevelopmentPlan
+	if err := s.db.WithContext(ctx).
+		Preload("CompetencyReviewProfile").
+		Where("soft_deleted = ? AND target_date < ?", false, horizon).
+		Where(`LOWER(COALESCE(TRIM(task_status), '')) IN ?`, []string{"", "planned", "assigned", "initiated", "inprogress"}).
+		Find(&plans).Error; err != nil {
+		return 0, fmt.Errorf("listing open development plans: %w", err)
+	}
+	if len(plans) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]int, len(plans))
+	for i, p := range plans {
+		ids[i] = p.DevelopmentPlanID
+	}
+	var reminders []competency.DevelopmentPlanReminder
+	if err := s.db.WithContext(ctx).Where("development_plan_id IN ?", ids).Find(&reminders).Error; err != nil {
+		return 0, fmt.Errorf("listing development plan reminders: %w", err)
+	}
+	sent := make(map[string]bool, len(reminders))
+	for _, r := range reminders {
+		sent[strconv.Itoa(r.DevelopmentPlanID)+"|"+r.ReminderKey] = true
+	}
+
+	reminded := 0
+	for i := range plans {
+		plan := &plans[i]
+		key, daysLeft, due := dueDevelopmentPlanReminder(plan.TargetDate, now, s.reminderBefore, s.reminderAfter)
+		if !due || sent[strconv.Itoa(plan.DevelopmentPlanID)+"|"+key] {
+			continue
+		}
+
+		// Record the reminder first so a failed delivery is not repeated daily.
+		result := s.db.WithContext(ctx).
+			Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&competency.DevelopmentPlanReminder{DevelopmentPlanID: plan.DevelopmentPlanID, ReminderKey: key, SentAt: now})
+		if result.Error != nil {
+			return reminded, fmt.Errorf("recording reminder %s for development plan %d: %w", key, plan.DevelopmentPlanID, result.Error)
+		}
+		if result.RowsAffected == 0 {
+			continue
+		}
+		s.remind(ctx, plan, daysLeft)
+		reminded++
+	}
+	return reminded, nil
+}
+
+// dueDevelopmentPlanReminder returns the reminder due for a plan with the
+// given target date: the nearest of the before offsets not yet passed, or
+// the furthest after offset reached. Offsets are in calendar days; daysLeft
+// is negative once the target date has passed.
+func dueDevelopmentPlanReminder(target, now time.Time, before, after []int) (key string, daysLeft int, due bool) {
+	daysLeft = calendarDaysBetween(now, target)
+	best := -1
+	if daysLeft >= 0 {
+		for _, d := range before {
+			if d >= daysLeft && (best < 0 || d < best) {
+				best = d
+			}
+		}
+		if best < 0 {
+			return "", daysLeft, false
+		}
+		return fmt.Sprintf("before:%d", best), daysLeft, true
+	}
+	for _, d := range after {
+		if d > 0 && d <= -daysLeft && d > best {
+			best = d
+		}
+	}
+	if best < 0 {
+		return "", daysLeft, false
+	}
+	return fmt.Sprintf("after:%d", best), daysLeft, true
+}
+
+// calendarDaysBetween returns the number of UTC calendar days from a to b.
+func calendarDaysBetween(a, b time.Time) int {
+	ay, am, ad := a.UTC().Date()
+	by, bm, bd := b.UTC().Date()
+	da := time.Date(ay, am, ad, 0, 0, 0, 0, time.UTC)
+	db := time.Date(by, bm, bd, 0, 0, 0, 0, time.UTC)
+	return int(db.Sub(da).Hours() / 24)
+}
+
+func maxDays(days []int) int {
+	m := 0
+	for _, d := range days {
+		if d > m {
+			m = d
+		}
+	}
+	return m
+}
+
+// remind notifies the employee of a plan due in daysLeft days, and their
+// supervisor as well once it is overdue.
+func (s *developmentPlanService) remind(ctx context.Context, plan *competency.DevelopmentPlan, daysLeft int) {
+	target := plan.TargetDate.Format("02 Jan 2006")
+	activity := template.HTMLEscapeString(plan.Activity)
+
+	var title, line string
+	switch {
+	case daysLeft == 0:
+		title = "Development plan due today"
+		line = fmt.Sprintf("Your development activity <b>%s</b> is due today.", activity)
+	case daysLeft > 0:
+		title = fmt.Sprintf("Development plan due in %d day(s)", daysLeft)
+		line = fmt.Sprintf("Your development activity <b>%s</b> is due on %s.", activity, target)
+	default:
+		title = "Development plan overdue"
+		line = fmt.Sprintf("Your development activity <b>%s</b> was due on %s and has not been completed.", activity, target)
+	}
+	emp := s.employee(ctx, plan.EmployeeNumber)
+	s.notify(ctx, plan, plan.EmployeeNumber, emp, performance.NotificationTypeDevelopmentPlanReminder, title,
+		line+" Kindly update its progress on Performance Management System.")
+
+	if daysLeft >= 0 || emp == nil || strings.TrimSpace(emp.SupervisorID) == "" {
+		return
+	}
+	supervisorID := strings.TrimSpace(emp.SupervisorID)
+	s.notify(ctx, plan, supervisorID, s.employee(ctx, supervisorID), performance.NotificationTypeDevelopmentPlanReminder,
+		"Development plan overdue: "+strings.TrimSpace(emp.FullName),
+		fmt.Sprintf("The development activity <b>%s</b> of %s was due on %s and has not been completed.",
+			activity, template.HTMLEscapeString(strings.TrimSpace(emp.FullName)), target))
+}
+
+// notifyStatusChange tells the other party about a status change: the
+// supervisor when a plan awaits sign-off, the employee otherwise.
+func (s *developmentPlanService) notifyStatusChange(ctx context.Context, plan *competency.DevelopmentPlan, from, to enums.DevelopmentTaskStatus) {
+	activity := template.HTMLEscapeString(plan.Activity)
+	emp := s.employee(ctx, plan.EmployeeNumber)
+
+	switch to {
+	case enums.DevelopmentTaskStatusAssigned:
+		s.notify(ctx, plan, plan.EmployeeNumber, emp, performance.NotificationTypeDevelopmentPlanUpdate,
+			"New development plan assigned",
+			fmt.Sprintf("Your supervisor has assigned you the development activity <b>%s</b>, due on %s.",
+				activity, plan.TargetDate.Format("02 Jan 2006")))
+	case enums.DevelopmentTaskStatusCompleted:
+		if emp == nil || strings.TrimSpace(emp.SupervisorID) == "" {
+			return
+		}
+		supervisorID := strings.TrimSpace(emp.SupervisorID)
+		s.notify(ctx, plan, supervisorID, s.employee(ctx, supervisorID), performance.NotificationTypeDevelopmentPlanUpdate,
+			"Development plan awaiting your sign-off",
+			fmt.Sprintf("%s has completed the development activity <b>%s</b>. Kindly review the evidence and verify it.",
+				template.HTMLEscapeString(strings.TrimSpace(emp.FullName)), activity))
+	case enums.DevelopmentTaskStatusInProgress:
+		if from != enums.DevelopmentTaskStatusCompleted {
+			return
+		}
+		s.notify(ctx, plan, plan.EmployeeNumber, emp, performance.NotificationTypeDevelopmentPlanUpdate,
+			"Development plan returned",
+			fmt.Sprintf("Your supervisor has returned the development activity <b>%s</b> for more work. %s",
+				activity, template.HTMLEscapeString(plan.SupervisorComment)))
+	case enums.DevelopmentTaskStatusVerified:
+		s.notify(ctx, plan, plan.EmployeeNumber, emp, performance.NotificationTypeDevelopmentPlanUpdate,
+			"Development plan verified",
+			fmt.Sprintf("Your supervisor has verified the development activity <b>%s</b>. %s",
+				activity, template.HTMLEscapeString(plan.SupervisorComment)))
+	case enums.DevelopmentTaskStatusClosedGap:
+		s.notify(ctx, plan, plan.EmployeeNumber, emp, performance.NotificationTypeDevelopmentPlanUpdate,
+			"Competency gap closed",
+			fmt.Sprintf("Your supervisor has closed the competency gap addressed by the development activity <b>%s</b>.", activity))
+	}
+}
+
+// notify delivers a development plan notification to a staff member.
+// Failures are logged: notifications never block the plan workflow.
+func (s *developmentPlanService) notify(ctx context.Context, plan *competency.DevelopmentPlan, staffID string, emp *erp.EmployeeDetails, notificationType, title, line string) {
+	staffID = strings.TrimSpace(staffID)
+	if s.notificationSvc == nil || staffID == "" {
+		return
+	}
+	name := staffID
+	if emp != nil && strings.TrimSpace(emp.FullName) != "" {
+		name = strings.TrimSpace(emp.FullName)
+	}
+
+	n := performance.Notification{
+		RecipientStaffID: staffID,
+		Type:             notificationType,
+		Title:            title,
+		Message:          stripTags(line),
+		EntityType:       performance.NotificationEntityDevelopmentPlan,
+		EntityID:         strconv.Itoa(plan.DevelopmentPlanID),
+	}
+	body := fmt.Sprintf(`<p>Dear %s,</p><br/><p>%s</p><br/><p>Regards, <br/>Performance Management System (PMS)</p>`,
+		template.HTMLEscapeString(name), line)
+	email := &NotificationEmail{To: s.emailAddress(ctx, emp), Subject: "PMS | " + title, Body: body}
+	if err := s.notificationSvc.Deliver(ctx, n, email); err != nil {
+		s.log.Warn().Err(err).Str("type", notificationType).Str("recipient", staffID).
+			Int("developmentPlanId", plan.DevelopmentPlanID).Msg("failed to send development plan notification")
+	}
+}
+
+// isLineManager reports whether caller is emp's supervisor in the ERP.
+// Heads of office, division and department reach the employee's plans but
+// do not sign them off.
+func isLineManager(caller string, emp *erp.EmployeeDetails) bool {
+	if caller == "" || emp == nil {
+		return false
+	}
+	return strings.TrimSpace(emp.SupervisorID) == caller
+}
+
+// employee looks a staff member up in the ERP, or returns nil.
+func (s *developmentPlanService) employee(ctx context.Context, staffID string) *erp.EmployeeDetails {
+	if s.directory == nil || strings.TrimSpace(staffID) == "" {
+		return nil
+	}
+	emp, err := s.directory.GetEmployeeByID(ctx, strings.TrimSpace(staffID))
+	if err != nil {
+		s.log.Debug().Err(err).Str("staffId", staffID).Msg("could not look up employee")
+		return nil
+	}
+	return emp
+}
+
+// emailAddress returns the address to email a staff member at.
+func (s *developmentPlanService) emailAddress(ctx context.Context, emp *erp.EmployeeDetails) string {
+	return staffEmailAddress(ctx, s.globalSetting, emp)
+}
+
+// stripTags removes the HTML tags of an email line for the in-app inbox.
+func stripTags(s string) string {
+	var b strings.Builder
+	inTag := false
+	for _, r := range s {
+		switch {
+		case r == '<':
+			inTag = true
+		case r == '>':
+			inTag = false
+		case !inTag:
+			b.WriteRune(r)
+		}
+	}
+	return html.UnescapeString(strings.TrimSpace(b.String()))
+}
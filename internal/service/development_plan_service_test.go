@@ -0,0 +1,212 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/competency"
+	"github.com/enterprise-pms/pms-api/internal/domain/enums"
+	"github.com/enterprise-pms/pms-api/internal/domain/erp"
+)
+
+func TestParseDevelopmentTaskStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		want enums.DevelopmentTaskStatus
+		ok   bool
+	}{
+		{"", enums.DevelopmentTaskStatusAssigned, true},
+		{"Planned", enums.DevelopmentTaskStatusAssigned, true},
+		{"in progress", enums.DevelopmentTaskStatusInProgress, true},
+		{"InProgress", enums.DevelopmentTaskStatusInProgress, true},
+		{"VERIFIED", enums.DevelopmentTaskStatusVerified, true},
+		{"ClosedGap", enums.DevelopmentTaskStatusClosedGap, true},
+		{"draft", enums.DevelopmentTaskStatusDraft, true},
+		{"Not Started", enums.DevelopmentTaskStatusAssigned, true},
+		{"in-progress", enums.DevelopmentTaskStatusInProgress, true},
+		{"Done", enums.DevelopmentTaskStatusCompleted, true},
+		{"Gap Closed", enums.DevelopmentTaskStatusClosedGap, true},
+		{"Abandoned", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := enums.ParseDevelopmentTaskStatus(tt.name)
+		if ok != tt.ok || (ok && got != tt.want) {
+			t.Errorf("ParseDevelopmentTaskStatus(%q) = %v, %v; want %v, %v", tt.name, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestDevelopmentPlanTransition(t *testing.T) {
+	const (
+		none       = competency.DevelopmentPlanActorNone
+		employee   = competency.DevelopmentPlanActorEmployee
+		supervisor = competency.DevelopmentPlanActorSupervisor
+	)
+	tests := []struct {
+		from, to enums.DevelopmentTaskStatus
+		want     competency.DevelopmentPlanActor
+	}{
+		{enums.DevelopmentTaskStatusDraft, enums.DevelopmentTaskStatusAssigned, supervisor},
+		{enums.DevelopmentTaskStatusDraft, enums.DevelopmentTaskStatusInProgress, none},
+		{enums.DevelopmentTaskStatusAssigned, enums.DevelopmentTaskStatusInProgress, employee},
+		{enums.DevelopmentTaskStatusInProgress, enums.DevelopmentTaskStatusCompleted, employee},
+		{enums.DevelopmentTaskStatusAssigned, enums.DevelopmentTaskStatusCompleted, employee},
+		{enums.DevelopmentTaskStatusCompleted, enums.DevelopmentTaskStatusVerified, supervisor},
+		{enums.DevelopmentTaskStatusCompleted, enums.DevelopmentTaskStatusInProgress, supervisor},
+		{enums.DevelopmentTaskStatusVerified, enums.DevelopmentTaskStatusClosedGap, supervisor},
+		{enums.DevelopmentTaskStatusInProgress, enums.DevelopmentTaskStatusVerified, none},
+		{enums.DevelopmentTaskStatusCompleted, enums.DevelopmentTaskStatusClosedGap, none},
+		{enums.DevelopmentTaskStatusVerified, enums.DevelopmentTaskStatusInProgress, none},
+		{enums.DevelopmentTaskStatusClosedGap, enums.DevelopmentTaskStatusInProgress, none},
+	}
+	for _, tt := range tests {
+		if got := competency.DevelopmentPlanTransition(tt.from, tt.to); got != tt.want {
+			t.Errorf("DevelopmentPlanTransition(%s, %s) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestIsLineManager(t *testing.T) {
+	emp := &erp.EmployeeDetails{EmployeeNumber: "E1", SupervisorID: " S1 ", HeadOfDeptID: "H1"}
+	tests := []struct {
+		caller string
+		emp    *erp.EmployeeDetails
+		want   bool
+	}{
+		{"S1", emp, true},
+		{"H1", emp, false},
+		{"E1", emp, false},
+		{"", &erp.EmployeeDetails{}, false},
+		{"S1", nil, false},
+	}
+	for _, tt := range tests {
+		if got := isLineManager(tt.caller, tt.emp); got != tt.want {
+			t.Errorf("isLineManager(%q) = %v, want %v", tt.caller, got, tt.want)
+		}
+	}
+}
+
+func TestDueDevelopmentPlanReminder(t *testing.T) {
+	now := time.Date(2026, 5, 10, 8, 0, 0, 0, time.UTC)
+	day := func(offset int) time.Time { return time.Date(2026, 5, 10+offset, 17, 0, 0, 0, time.UTC) }
+	before, after := []int{7, 1}, []int{1, 7}
+
+	tests := []struct {
+		target   time.Time
+		key      string
+		daysLeft int
+		due      bool
+	}{
+		{day(10), "", 10, false},
+		{day(7), "before:7", 7, true},
+		{day(5), "before:7", 5, true},
+		{day(1), "before:1", 1, true},
+		{day(0), "before:1", 0, true},
+		{day(-1), "after:1", -1, true},
+		{day(-3), "after:1", -3, true},
+		{day(-30), "after:7", -30, true},
+	}
+	for _, tt := range tests {
+		key, daysLeft, due := dueDevelopmentPlanReminder(tt.target, now, before, after)
+		if key != tt.key || daysLeft != tt.daysLeft || due != tt.due {
+			t.Errorf("dueDevelopmentPlanReminder(%s) = %q, %d, %v; want %q, %d, %v",
+				tt.target.Format("2006-01-02"), key, daysLeft, due, tt.key, tt.daysLeft, tt.due)
+		}
+	}
+}
+
+func TestCompletionRate(t *testing.T) {
+	if got := completionRate(1, 3); got != 33.3 {
+		t.Errorf("completionRate(1, 3) = %v", got)
+	}
+	if got := completionRate(0, 0); got != 0 {
+		t.Errorf("completionRate(0, 0) = %v", got)
+	}
+}
+
+func TestStripTags(t *testing.T) {
+	got := stripTags("Your activity <b>Coaching &amp; mentoring</b> is due.")
+	if got != "Your activity Coaching & mentoring is due." {
+		t.Errorf("stripTags = %q", got)
+	}
+}
+
+func TestRecommendDevelopmentActivities(t *testing.T) {
+	competencyID := 12
+	rule := func(id int, competencyID *int, category string, minGap, maxGap int) competency.DevelopmentPlanRule {
+		r := competency.DevelopmentPlanRule{
+			DevelopmentPlanRuleID:  id,
+			CompetencyID:           competencyID,
+			CompetencyCategoryName: category,
+			MinGap:                 minGap,
+			MaxGap:                 maxGap,
+		}
+		r.IsActive = true
+		return r
+	}
+	inactive := rule(6, &competencyID, "", 1, 0)
+	inactive.IsActive = false
+	rules := []competency.DevelopmentPlanRule{
+		rule(1, nil, "", 1, 0),
+		rule(2, nil, "Technical", 1, 1),
+		rule(3, nil, "technical", 2, 0),
+		rule(4, &competencyID, "", 3, 0),
+		rule(5, &competencyID, "", 3, 4),
+		inactive,
+	}
+	profile := func(competencyID int, category string, gap int) competency.CompetencyReviewProfile {
+		return competency.CompetencyReviewProfile{CompetencyID: competencyID, CompetencyCategoryName: category, CompetencyGap: gap, HaveGap: gap > 0}
+	}
+
+	tests := []struct {
+		name    string
+		profile competency.CompetencyReviewProfile
+		want    []int
+	}{
+		{"no gap", profile(12, "Technical", 0), nil},
+		{"any competency", profile(30, "Behavioral", 2), []int{1}},
+		{"category by gap size", profile(30, "Technical", 1), []int{2}},
+		{"category ignores case", profile(30, "Technical", 5), []int{3}},
+		{"competency gap too small falls back to category", profile(12, "Technical", 2), []int{3}},
+		{"competency rules replace category rules", profile(12, "Technical", 3), []int{4, 5}},
+		{"competency rule past max gap", profile(12, "Technical", 5), []int{4}},
+	}
+	for _, tt := range tests {
+		var got []int
+		for _, r := range competency.RecommendDevelopmentActivities(rules, tt.profile) {
+			got = append(got, r.DevelopmentPlanRuleID)
+		}
+		if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+			t.Errorf("%s: rules %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestDraftDevelopmentPlans(t *testing.T) {
+	now := time.Date(2026, 5, 10, 8, 0, 0, 0, time.UTC)
+	rule := competency.DevelopmentPlanRule{
+		CompetencyCategoryName: "Technical",
+		MinGap:                 1,
+		TrainingTypeName:       "Classroom",
+		Activity:               "Attend the credit risk course",
+		LearningResource:       "Training school",
+	}
+	rule.IsActive = true
+	profiles := []competency.CompetencyReviewProfile{
+		{CompetencyReviewProfileID: 1, EmployeeNumber: "1001", CompetencyCategoryName: "Technical", CompetencyGap: 2, HaveGap: true},
+		{CompetencyReviewProfileID: 2, EmployeeNumber: "1002", CompetencyCategoryName: "Behavioral", CompetencyGap: 1, HaveGap: true},
+	}
+
+	plans, unmatched := draftDevelopmentPlans([]competency.DevelopmentPlanRule{rule}, profiles, now, "SYSTEM")
+	if len(plans) != 1 || unmatched != 1 {
+		t.Fatalf("drafted %d plan(s) with %d unmatched, want 1 and 1", len(plans), unmatched)
+	}
+	p := plans[0]
+	if p.CompetencyReviewProfileID != 1 || p.EmployeeNumber != "1001" || p.TaskStatus != "Draft" || p.Activity != rule.Activity {
+		t.Errorf("plan = %+v", p)
+	}
+	if want := now.AddDate(0, 0, defaultDevelopmentPlanTargetDays); !p.TargetDate.Equal(want) {
+		t.Errorf("target date = %s, want %s", p.TargetDate, want)
+	}
+}
@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/config"
+	"github.com/enterprise-pms/pms-api/internal/domain/erp"
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"github.com/enterprise-pms/pms-api/internal/repository"
+	"github.com/rs/zerolog"
+)
+
+// ---------------------------------------------------------------------------
+// emailDeliveryService gives administrators visibility of outbound mail the
+// mail sender could not deliver: emails that failed after their last retry,
+// bounced, or were left Processing longer than EmailConfig.StuckAfter. The
+// emails live in the email service database and their delivery history in
+// pms.email_delivery_attempts. The sender itself lives in the jobs package.
+// ---------------------------------------------------------------------------
+
+const msgEmailDBNotConfigured = "email service database is not configured"
+
+type emailDeliveryService struct {
+	emailRepo  *repository.EmailRepository
+	deliveries *repository.EmailDeliveryRepository
+	stuckAfter time.Duration
+	log        zerolog.Logger
+}
+
+func newEmailDeliveryService(repos *repository.Container, cfg *config.Config, log zerolog.Logger) EmailDeliveryService {
+	stuckAfter := cfg.Email.StuckAfter
+	if stuckAfter <= 0 {
+		stuckAfter = 30 * time.Minute
+	}
+	return &emailDeliveryService{
+		emailRepo:  repos.Email,
+		deliveries: repos.Deliveries,
+		stuckAfter: stuckAfter,
+		log:        log.With().Str("service", "email_delivery").Logger(),
+	}
+}
+
+// ListUndeliveredEmails returns a page of failed, bounced and stuck emails,
+// each with its last delivery error.
+func (s *emailDeliveryService) ListUndeliveredEmails(ctx context.Context, search *performance.EmailDeliverySearchModel) (performance.EmailDeliveryListResponseVm, error) {
+	resp := performance.EmailDeliveryListResponseVm{}
+	if s.emailRepo == nil {
+		resp.HasError = true
+		resp.Message = msgEmailDBNotConfigured
+		return resp, nil
+	}
+
+	stuckBefore := s.stuckBefore()
+	emails, total, err := s.emailRepo.ListUndelivered(ctx, stuckBefore, search.Skip, pageSize(search.PageSize))
+	if err != nil {
+		return resp, err
+	}
+
+	ids := make([]int, len(emails))
+	for i, e := range emails {
+		ids[i] = e.ID
+	}
+	latest, err := s.deliveries.LatestByEmails(ctx, ids)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.Data = make([]performance.EmailDeliveryVm, 0, len(emails))
+	for _, e := range emails {
+		vm := toEmailDeliveryVm(e, stuckBefore)
+		if a, ok := latest[e.ID]; ok {
+			applyLastAttempt(&vm, a)
+		}
+		resp.Data = append(resp.Data, vm)
+	}
+	resp.TotalRecord = total
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// GetEmailDelivery returns an email and every recorded delivery attempt.
+func (s *emailDeliveryService) GetEmailDelivery(ctx context.Context, emailID int) (performance.EmailDeliveryResponseVm, error) {
+	resp := performance.EmailDeliveryResponseVm{}
+	if s.emailRepo == nil {
+		resp.HasError = true
+		resp.Message = msgEmailDBNotConfigured
+		return resp, nil
+	}
+
+	email, err := s.emailRepo.GetEmailByID(ctx, emailID)
+	if err != nil {
+		return resp, err
+	}
+	if email == nil {
+		resp.HasError = true
+		resp.Message = fmt.Sprintf("email %d not found", emailID)
+		return resp, nil
+	}
+	attempts, err := s.deliveries.ListByEmail(ctx, emailID)
+	if err != nil {
+		return resp, err
+	}
+
+	vm := toEmailDeliveryVm(*email, s.stuckBefore())
+	if len(attempts) > 0 {
+		applyLastAttempt(&vm, attempts[0])
+	}
+	resp.Data = &vm
+	resp.Attempts = make([]performance.EmailDeliveryAttemptVm, 0, len(attempts))
+	for _, a := range attempts {
+		resp.Attempts = append(resp.Attempts, performance.EmailDeliveryAttemptVm{
+			Attempt:     a.Attempt,
+			Outcome:     a.Outcome,
+			Error:       a.Error,
+			AttemptedAt: a.AttemptedAt,
+			DurationMs:  a.DurationMs,
+		})
+	}
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// ResendEmails makes failed, bounced and stuck emails due now with a fresh
+// attempt budget. Emails in any other state are left alone; the request
+// fails only when none could be requeued.
+func (s *emailDeliveryService) ResendEmails(ctx context.Context, req *performance.EmailResendRequestModel) (performance.ResponseVm, error) {
+	resp := performance.ResponseVm{}
+	if s.emailRepo == nil {
+		resp.HasError = true
+		resp.Message = msgEmailDBNotConfigured
+		return resp, nil
+	}
+	if len(req.EmailIDs) == 0 {
+		resp.HasError = true
+		resp.Message = "at least one email ID is required"
+		return resp, nil
+	}
+	if len(req.EmailIDs) == 1 {
+		resp.ID = fmt.Sprint(req.EmailIDs[0])
+	}
+
+	stuckBefore := s.stuckBefore()
+	requeued := 0
+	for _, id := range req.EmailIDs {
+		ok, err := s.emailRepo.Requeue(ctx, id, stuckBefore, req.RequestedBy)
+		if err != nil {
+			s.log.Error().Err(err).Str("action", "RESEND_EMAIL").Int("emailId", id).Msg("failed to requeue email")
+			return resp, err
+		}
+		if ok {
+			requeued++
+		}
+	}
+
+	s.log.Info().Ints("emailIds", req.EmailIDs).Int("requeued", requeued).Str("requestedBy", req.RequestedBy).Msg("emails requeued for delivery")
+	if requeued == 0 {
+		resp.HasError = true
+		resp.Message = "only failed, bounced or stuck emails can be resent"
+		return resp, nil
+	}
+	resp.Message = fmt.Sprintf("%d of %d email(s) requeued for delivery", requeued, len(req.EmailIDs))
+	return resp, nil
+}
+
+func (s *emailDeliveryService) stuckBefore() time.Time {
+	return time.Now().UTC().Add(-s.stuckAfter)
+}
+
+func toEmailDeliveryVm(e erp.EmailObject, stuckBefore time.Time) performance.EmailDeliveryVm {
+	return performance.EmailDeliveryVm{
+		EmailID:          e.ID,
+		From:             e.From,
+		To:               e.To,
+		CC:               e.CC,
+		Subject:          e.Subject,
+		Action:           e.Action,
+		AppSource:        e.AppSource,
+		Status:           e.Status,
+		Stuck:            e.Status == erp.EmailStatusProcessing && e.LastUpdatedDate != nil && e.LastUpdatedDate.Before(stuckBefore),
+		Attempts:         e.NoOfRetry,
+		ExpectedSendDate: e.ExpectedSendDate,
+		DateCreated:      e.DateCreated,
+		LastUpdatedDate:  e.LastUpdatedDate,
+	}
+}
+
+func applyLastAttempt(vm *performance.EmailDeliveryVm, a performance.EmailDeliveryAttempt) {
+	at := a.AttemptedAt
+	vm.LastAttemptAt = &at
+	vm.LastError = a.Error
+}
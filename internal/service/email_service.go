@@ -22,10 +22,6 @@ import (
 // delivers them via SMTP.
 // ---------------------------------------------------------------------------
 
-// defaultSenderEmail is the fallback sender address when the global setting
-// SENDER_EMAIL is not configured. Mirrors the .NET default.
-const defaultSenderEmail = "pms@cbn.gov.ng"
-
 // emailService persists email records to the EmailLogs table via the
 // EmailSvcSQL (sqlx) connection, matching the .NET EmailServiceDBContext.
 type emailService struct {
@@ -82,8 +78,9 @@ func (s *emailService) saveEmailToDb(ctx context.Context, emailTo, subject, body
 		return nil
 	}
 
-	// Resolve global settings with safe defaults (mirrors the .NET try/catch pattern)
-	senderEmail := defaultSenderEmail
+	// Resolve global settings with safe defaults (mirrors the .NET try/catch pattern).
+	// Without SENDER_EMAIL the configured email.sender_email is used.
+	senderEmail := s.cfg.Email.SenderEmail
 	enableNotification := false
 
 	if s.gs != nil {
@@ -149,7 +146,7 @@ func (s *emailService) SendEmailWithCC(ctx context.Context, to string, cc []stri
 	}
 
 	// Resolve global settings with safe defaults
-	senderEmail := defaultSenderEmail
+	senderEmail := s.cfg.Email.SenderEmail
 	enableNotification := false
 
 	if s.gs != nil {
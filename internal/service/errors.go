@@ -23,6 +23,8 @@ var (
 	ErrUnauthorizedApprover      = errors.New("caller is not authorized to approve this record")
 	ErrAlreadyApproved           = errors.New("record has already been approved")
 	ErrAlreadyRejected           = errors.New("record has already been rejected")
+	ErrInvalidApprovalChain      = errors.New("invalid approval chain definition")
+	ErrInvalidSLAEscalation      = errors.New("invalid SLA escalation ladder")
 
 	// Review period errors
 	ErrDuplicateReviewPeriod = errors.New("a review period already exists for this range and year")
@@ -35,6 +37,7 @@ var (
 	ErrMaxObjectivesExceeded = errors.New("maximum number of objectives exceeded")
 	ErrWeightsNotBalanced    = errors.New("category weights must sum to 100%")
 	ErrParentObjectiveNotFound = errors.New("parent objective not found for cascading")
+	ErrInvalidObjectiveUpload = errors.New("invalid objective upload")
 
 	// Strategy errors
 	ErrStrategyNotActive = errors.New("strategy must be in ApprovedAndActive status")
@@ -43,9 +46,24 @@ var (
 	ErrInvalidRangeValue = errors.New("invalid range value for the specified period type")
 
 	// Scoring errors
-	ErrScoreOutOfRange     = errors.New("score value is outside the valid range")
-	ErrNoScoreData         = errors.New("no score data available for calculation")
-	ErrInvalidWeightConfig = errors.New("invalid weight configuration for scoring")
+	ErrScoreOutOfRange         = errors.New("score value is outside the valid range")
+	ErrNoScoreData             = errors.New("no score data available for calculation")
+	ErrInvalidWeightConfig     = errors.New("invalid weight configuration for scoring")
+	ErrInvalidGradingScale     = errors.New("invalid grading scale definition")
+	ErrInvalidCalibrationQuota = errors.New("invalid calibration quota")
+
+	// File storage errors
+	ErrFileNotFound         = errors.New("file not found")
+	ErrInvalidFileReference = errors.New("invalid file reference")
+
+	// Session errors
+	ErrSessionNotFound = errors.New("session not found")
+
+	// Data scope errors
+	ErrOutOfScope = errors.New("requested data is outside your organisational scope")
+
+	// Single sign-on errors
+	ErrSSODisabled = errors.New("single sign-on is not enabled")
 )
 
 // ---------------------------------------------------------------------------
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/enums"
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"github.com/rs/zerolog"
+)
+
+// recordingNotifications records the feedback request events it is asked
+// to deliver and whether each carried an email.
+type recordingNotifications struct {
+	NotificationService
+	sent []string
+}
+
+func (r *recordingNotifications) SendNewRequestNotification(_ context.Context, _ enums.FeedbackRequestType, staffID, _, _, _, _ string, _ time.Time, hasSLA bool, slaHours int) error {
+	r.sent = append(r.sent, fmt.Sprintf("new:%s:%v:%d", staffID, hasSLA, slaHours))
+	return nil
+}
+
+func (r *recordingNotifications) SendAssignerNewRequestNotification(_ context.Context, _ enums.FeedbackRequestType, staffID, _, _, _, _ string, _ time.Time) error {
+	r.sent = append(r.sent, "initiated:"+staffID)
+	return nil
+}
+
+func (r *recordingNotifications) SendRequestTreatedNotification(_ context.Context, _ enums.FeedbackRequestType, staffID, _, _, _, _ string, _ time.Time) error {
+	r.sent = append(r.sent, "treated:"+staffID)
+	return nil
+}
+
+func (r *recordingNotifications) SendAssignerRequestTreatedNotification(_ context.Context, _ enums.FeedbackRequestType, staffID, _, _, _, _ string, _ time.Time) error {
+	r.sent = append(r.sent, "owner-treated:"+staffID)
+	return nil
+}
+
+func (r *recordingNotifications) Deliver(_ context.Context, n performance.Notification, email *NotificationEmail) error {
+	r.sent = append(r.sent, fmt.Sprintf("%s:%s:%s:email=%v", n.Type, n.Category, n.RecipientStaffID, email != nil))
+	return nil
+}
+
+func TestFeedbackRequestNotificationsAreDelivered(t *testing.T) {
+	notifications := &recordingNotifications{}
+	s := &feedbackRequestService{
+		log:    zerolog.Nop(),
+		parent: &performanceManagementService{notificationSvc: notifications},
+		sla:    &slaCalendar{},
+	}
+	ctx := context.Background()
+	r := performance.FeedbackRequestLog{
+		FeedbackRequestLogID: "R1",
+		FeedbackRequestType:  enums.FeedbackRequest360ReviewFeedback,
+		AssignedStaffID:      "A",
+		RequestOwnerStaffID:  "O",
+		HasSLA:               true,
+		TimeInitiated:        time.Now(),
+	}
+
+	s.notifyNewRequest(ctx, r)
+	s.notifyReassigned(ctx, r)
+	s.notifyTreated(ctx, r, time.Now())
+	r.RequestOwnerStaffID = "A"
+	s.notifyTreated(ctx, r, time.Now())
+
+	want := fmt.Sprint([]string{
+		"new:A:true:336", "initiated:O",
+		performance.NotificationTypeRequestReassigned + ":" + performance.NotificationCategoryReviews360 + ":A:email=true",
+		"treated:A", "owner-treated:O",
+		"treated:A",
+	})
+	if got := fmt.Sprint(notifications.sent); got != want {
+		t.Errorf("sent = %s\nwant %s", got, want)
+	}
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"math"
 	"strings"
 	"time"
 
@@ -51,6 +52,10 @@ type feedbackRequestService struct {
 	// External database repositories for HR integration (SLA calculation).
 	erpRepo *repository.ErpRepository
 	sasRepo *repository.SasRepository
+
+	sla          *slaCalendar
+	vacation     reassignmentPolicy
+	reassignment []reassignmentPolicy
 }
 
 func newFeedbackRequestService(
@@ -60,8 +65,9 @@ func newFeedbackRequestService(
 	parent *performanceManagementService,
 	erpRepo *repository.ErpRepository,
 	sasRepo *repository.SasRepository,
+	sla *slaCalendar,
 ) *feedbackRequestService {
-	return &feedbackRequestService{
+	s := &feedbackRequestService{
 		db:     db,
 		cfg:    cfg,
 		log:    log.With().Str("sub", "feedback_request").Logger(),
@@ -71,7 +77,14 @@ func newFeedbackRequestService(
 		reviewPeriodRepo: repository.NewPMSRepository[performance.PerformanceReviewPeriod](db),
 		erpRepo:          erpRepo,
 		sasRepo:          sasRepo,
+		sla:              sla,
+		reassignment:     newReassignmentPolicies(cfg.Reassignment, db, erpRepo, log),
+	}
+	s.vacation = &vacationRulePolicy{
+		onVacation:  s.HasVacationRule,
+		lineManager: &orgReassignmentPolicy{policy: reassignPolicySupervisor, erpRepo: erpRepo},
 	}
+	return s
 }
 
 // =========================================================================
@@ -123,6 +136,8 @@ func (s *feedbackRequestService) LogRequest(ctx context.Context, feedbackType en
 		Str("assignedTo", assignedStaffID).
 		Msg("feedback request logged")
 
+	s.notifyNewRequest(ctx, log)
+
 	// Recalculate deducted points asynchronously
 	go s.parent.recalculateDeductedPoints(context.Background(), assignedStaffID, reviewPeriodID)
 
@@ -204,50 +219,36 @@ func (s *feedbackRequestService) GetBreachedRequests(ctx context.Context, staffI
 	resp := performance.BreachedFeedbackRequestListResponseVm{}
 	resp.Message = "an error occurred"
 
-	requestSLA, pms360SLA := s.parent.getSLAConfig(ctx)
-	now := time.Now()
-
 	var requests []performance.FeedbackRequestLog
 	s.db.WithContext(ctx).
 		Where("assigned_staff_id = ? AND review_period_id = ?", staffID, reviewPeriodID).
 		Find(&requests)
 
+	sla := s.sla.evaluator(ctx, requests)
+
 	var breached []performance.FeedbackRequestLogVm
 	for _, r := range requests {
-		sla := requestSLA
-		if r.FeedbackRequestType == enums.FeedbackRequest360ReviewFeedback {
-			sla = pms360SLA
-		}
-
-		isBreach := false
-		if r.TimeCompleted != nil {
-			if r.TimeCompleted.Sub(r.TimeInitiated).Hours() > float64(sla) {
-				isBreach = true
-			}
-		} else {
-			deadline := r.TimeInitiated.Add(time.Duration(sla) * time.Hour)
-			if deadline.Before(now) || deadline.Equal(now) {
-				isBreach = true
-			}
-		}
-
-		if isBreach && r.HasSLA {
-			vm := performance.FeedbackRequestLogVm{
-				FeedbackRequestLogID:  r.FeedbackRequestLogID,
-				FeedbackRequestType:   r.FeedbackRequestType,
-				ReferenceID:           r.ReferenceID,
-				TimeInitiated:         r.TimeInitiated,
-				AssignedStaffID:       r.AssignedStaffID,
-				AssignedStaffName:     r.AssignedStaffName,
-				RequestOwnerStaffID:   r.RequestOwnerStaffID,
-				RequestOwnerStaffName: r.RequestOwnerStaffName,
-				TimeCompleted:         r.TimeCompleted,
-				HasSLA:                r.HasSLA,
-				IsBreached:            true,
-				ReviewPeriodID:        r.ReviewPeriodID,
-			}
-			breached = append(breached, vm)
+		status := sla.status(r)
+		if !r.HasSLA || !status.IsBreached {
+			continue
 		}
+		dueAt := status.DueAt
+		breached = append(breached, performance.FeedbackRequestLogVm{
+			FeedbackRequestLogID:  r.FeedbackRequestLogID,
+			FeedbackRequestType:   r.FeedbackRequestType,
+			ReferenceID:           r.ReferenceID,
+			TimeInitiated:         r.TimeInitiated,
+			AssignedStaffID:       r.AssignedStaffID,
+			AssignedStaffName:     r.AssignedStaffName,
+			RequestOwnerStaffID:   r.RequestOwnerStaffID,
+			RequestOwnerStaffName: r.RequestOwnerStaffName,
+			TimeCompleted:         r.TimeCompleted,
+			HasSLA:                r.HasSLA,
+			IsBreached:            true,
+			DueAt:                 &dueAt,
+			ElapsedBusinessHours:  math.Round(status.Elapsed.Hours()*100) / 100,
+			ReviewPeriodID:        r.ReviewPeriodID,
+		})
 	}
 
 	resp.Requests = breached
@@ -257,7 +258,8 @@ func (s *feedbackRequestService) GetBreachedRequests(ctx context.Context, staffI
 }
 
 // =========================================================================
-// GetPendingRequests – retrieves pending (active) requests for a staff member.
+// GetPendingRequests – retrieves pending (active) requests for a staff member,
+// or for all staff when staffID is empty.
 // Mirrors .NET GetPendingRequests.
 // =========================================================================
 
@@ -265,17 +267,21 @@ func (s *feedbackRequestService) GetPendingRequests(ctx context.Context, staffID
 	resp := performance.GetStaffPendingRequestVm{}
 	resp.Message = "an error occurred"
 
+	query := s.db.WithContext(ctx).Where("record_status = ?", enums.StatusActive.String())
+	if staffID != "" {
+		query = query.Where("assigned_staff_id = ?", staffID)
+	}
+
 	var requests []performance.FeedbackRequestLog
-	err := s.db.WithContext(ctx).
-		Where("assigned_staff_id = ? AND record_status = ?", staffID, enums.StatusActive.String()).
-		Order("time_initiated DESC").
-		Find(&requests).Error
+	err := query.Order("time_initiated DESC").Find(&requests).Error
 	if err != nil {
 		s.log.Error().Err(err).Str("staffID", staffID).Msg("failed to get pending requests")
 		resp.HasError = true
 		return resp, err
 	}
 
+	sla := s.sla.evaluator(ctx, requests)
+
 	var pending []performance.StaffPendingRequestVm
 	for _, r := range requests {
 		pending = append(pending, performance.StaffPendingRequestVm{
@@ -286,6 +292,7 @@ func (s *feedbackRequestService) GetPendingRequests(ctx context.Context, staffID
 			AssignedStaffID:      r.AssignedStaffID,
 			RequestOwnerStaffID:  r.RequestOwnerStaffID,
 			HasSLA:               r.HasSLA,
+			IsBreached:           sla.breached(r),
 			ID:                   r.ID,
 			IsActive:             r.IsActive,
 		})
@@ -340,6 +347,17 @@ func (s *feedbackRequestService) GetRequestDetails(ctx context.Context, requestI
 		return resp, fmt.Errorf("request details not found: %w", err)
 	}
 
+	var escalations []performance.FeedbackRequestEscalation
+	if err := s.db.WithContext(ctx).
+		Where("feedback_request_log_id = ?", requestID).
+		Order("time_initiated, threshold_percent").
+		Find(&escalations).Error; err != nil {
+		s.log.Warn().Err(err).Str("requestID", requestID).Msg("failed to load SLA escalations")
+	}
+	for _, e := range escalations {
+		resp.Escalations = append(resp.Escalations, toFeedbackRequestEscalationVm(e))
+	}
+
 	resp.Request = &request
 	resp.Message = "operation completed successfully"
 	return resp, nil
@@ -365,7 +383,13 @@ func (s *feedbackRequestService) UpdateRequest(ctx context.Context, requestID st
 		request.AssignedStaffAttachment = attachment
 	}
 
-	return s.db.WithContext(ctx).Save(&request).Error
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := attachFileUpload(ctx, tx, attachment, request.AssignedStaffID,
+			performance.FileEntityFeedbackRequest, request.FeedbackRequestLogID); err != nil {
+			return err
+		}
+		return tx.Save(&request).Error
+	})
 }
 
 // =========================================================================
@@ -381,32 +405,44 @@ func (s *feedbackRequestService) ReassignRequest(ctx context.Context, requestID,
 		return fmt.Errorf("feedback request not found: %w", err)
 	}
 
-	// Get new staff name
-	newAssignedName := newAssignedStaffID
-	if s.parent.erpEmployeeSvc != nil {
-		if detail, err := s.parent.erpEmployeeSvc.GetEmployeeDetail(ctx, newAssignedStaffID); err == nil && detail != nil {
-			if nameHolder, ok := detail.(interface{ GetFullName() string }); ok {
-				newAssignedName = nameHolder.GetFullName()
-			}
-		}
+	if err := s.reassign(s.db.WithContext(ctx), &request, newAssignedStaffID, s.staffName(ctx, newAssignedStaffID)); err != nil {
+		return err
 	}
 
+	s.log.Info().
+		Str("requestID", requestID).
+		Str("newAssignedStaffID", newAssignedStaffID).
+		Msg("feedback request reassigned")
+
+	s.notifyReassigned(ctx, request)
+
+	return nil
+}
+
+// reassign moves a request to a new assignee through db, restarting its SLA.
+func (s *feedbackRequestService) reassign(db *gorm.DB, request *performance.FeedbackRequestLog, newAssignedStaffID, newAssignedName string) error {
 	request.AssignedStaffID = newAssignedStaffID
 	request.AssignedStaffName = newAssignedName
 	request.TimeInitiated = time.Now().UTC()
 
-	if err := s.db.WithContext(ctx).Save(&request).Error; err != nil {
+	if err := db.Save(request).Error; err != nil {
 		return fmt.Errorf("reassigning request: %w", err)
 	}
-
-	s.log.Info().
-		Str("requestID", requestID).
-		Str("newAssignedStaffID", newAssignedStaffID).
-		Msg("feedback request reassigned")
-
 	return nil
 }
 
+// staffName returns a staff member's full name from the ERP, or their ID.
+func (s *feedbackRequestService) staffName(ctx context.Context, staffID string) string {
+	if s.parent.erpEmployeeSvc != nil {
+		if detail, err := s.parent.erpEmployeeSvc.GetEmployeeDetail(ctx, staffID); err == nil && detail != nil {
+			if nameHolder, ok := detail.(interface{ GetFullName() string }); ok {
+				return nameHolder.GetFullName()
+			}
+		}
+	}
+	return staffID
+}
+
 // ReassignSelfRequest – reassigns a request from the current staff to another.
 // Mirrors .NET ReassignSelfRequestAsync.
 func (s *feedbackRequestService) ReassignSelfRequest(ctx context.Context, requestID, currentStaffID, newAssignedStaffID string) error {
@@ -557,9 +593,22 @@ func (s *feedbackRequestService) TreatAssignedRequest(ctx context.Context, req *
 	default:
 		return fmt.Errorf("unsupported operation type: %d", req.OperationType)
 	}
+	if req.Attachment != "" {
+		request.AssignedStaffAttachment = req.Attachment
+	}
 
-	if err := s.db.WithContext(ctx).Save(&request).Error; err != nil {
-		return fmt.Errorf("treating request: %w", err)
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := attachFileUpload(ctx, tx, req.Attachment, request.AssignedStaffID,
+			performance.FileEntityFeedbackRequest, request.FeedbackRequestLogID); err != nil {
+			return err
+		}
+		if err := tx.Save(&request).Error; err != nil {
+			return fmt.Errorf("treating request: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	// Recalculate deducted points
@@ -570,9 +619,107 @@ func (s *feedbackRequestService) TreatAssignedRequest(ctx context.Context, req *
 		Int("operation", int(req.OperationType)).
 		Msg("feedback request treated")
 
+	s.notifyTreated(ctx, request, now)
+
 	return nil
 }
 
+// The notify* helpers deliver request events to the inbox and by email,
+// as each recipient's preferences allow. Failures are logged: notifications
+// never block the request workflow.
+
+// notifyNewRequest tells the assignee of a new request, and its owner when
+// someone else, that the request was initiated.
+func (s *feedbackRequestService) notifyNewRequest(ctx context.Context, r performance.FeedbackRequestLog) {
+	if s.parent.notificationSvc == nil {
+		return
+	}
+	typeName := feedbackRequestTypeName(r.FeedbackRequestType)
+	to, name := s.recipient(ctx, r.AssignedStaffID, r.AssignedStaffName)
+	if err := s.parent.notificationSvc.SendNewRequestNotification(ctx, r.FeedbackRequestType, r.AssignedStaffID, to, name,
+		r.FeedbackRequestLogID, typeName, r.TimeInitiated, r.HasSLA, s.requestSLAHours(ctx, r.FeedbackRequestType)); err != nil {
+		s.log.Warn().Err(err).Str("recipient", r.AssignedStaffID).Msg("failed to send new request notification")
+	}
+	if r.RequestOwnerStaffID == r.AssignedStaffID {
+		return
+	}
+	to, name = s.recipient(ctx, r.RequestOwnerStaffID, r.RequestOwnerStaffName)
+	if err := s.parent.notificationSvc.SendAssignerNewRequestNotification(ctx, r.FeedbackRequestType, r.RequestOwnerStaffID, to, name,
+		r.FeedbackRequestLogID, typeName, r.TimeInitiated); err != nil {
+		s.log.Warn().Err(err).Str("recipient", r.RequestOwnerStaffID).Msg("failed to send request initiated notification")
+	}
+}
+
+// notifyReassigned tells the new assignee of a request that it has been
+// reassigned to them.
+func (s *feedbackRequestService) notifyReassigned(ctx context.Context, r performance.FeedbackRequestLog) {
+	if s.parent.notificationSvc == nil {
+		return
+	}
+	typeName := feedbackRequestTypeName(r.FeedbackRequestType)
+	n := feedbackRequestNotification(r.AssignedStaffID, performance.NotificationTypeRequestReassigned, r.FeedbackRequestLogID,
+		"Request reassigned to you: "+typeName,
+		fmt.Sprintf("A request on %s from %s has been reassigned to you.", typeName, r.RequestOwnerStaffName))
+	n.Category = feedbackNotificationCategory(r.FeedbackRequestType)
+
+	to, name := s.recipient(ctx, r.AssignedStaffID, r.AssignedStaffName)
+	email, err := newRequestEmail(to, name, typeName, r.TimeInitiated, r.HasSLA, s.requestSLAHours(ctx, r.FeedbackRequestType))
+	if err != nil {
+		s.log.Warn().Err(err).Str("recipient", r.AssignedStaffID).Msg("failed to render reassignment email, notifying in the inbox only")
+	}
+	if err := s.parent.notificationSvc.Deliver(ctx, n, email); err != nil {
+		s.log.Warn().Err(err).Str("recipient", r.AssignedStaffID).Msg("failed to send reassignment notification")
+	}
+}
+
+// notifyTreated confirms a treated request to its assignee and tells its
+// owner, when someone else, that it was treated.
+func (s *feedbackRequestService) notifyTreated(ctx context.Context, r performance.FeedbackRequestLog, treated time.Time) {
+	if s.parent.notificationSvc == nil {
+		return
+	}
+	typeName := feedbackRequestTypeName(r.FeedbackRequestType)
+	to, name := s.recipient(ctx, r.AssignedStaffID, r.AssignedStaffName)
+	if err := s.parent.notificationSvc.SendRequestTreatedNotification(ctx, r.FeedbackRequestType, r.AssignedStaffID, to, name,
+		r.FeedbackRequestLogID, typeName, treated); err != nil {
+		s.log.Warn().Err(err).Str("recipient", r.AssignedStaffID).Msg("failed to send request treated notification")
+	}
+	if r.RequestOwnerStaffID == r.AssignedStaffID {
+		return
+	}
+	to, name = s.recipient(ctx, r.RequestOwnerStaffID, r.RequestOwnerStaffName)
+	if err := s.parent.notificationSvc.SendAssignerRequestTreatedNotification(ctx, r.FeedbackRequestType, r.RequestOwnerStaffID, to, name,
+		r.FeedbackRequestLogID, typeName, treated); err != nil {
+		s.log.Warn().Err(err).Str("recipient", r.RequestOwnerStaffID).Msg("failed to send request treated notification")
+	}
+}
+
+// recipient returns the email address and name to notify a staff member
+// by, falling back to name when the ERP does not know them.
+func (s *feedbackRequestService) recipient(ctx context.Context, staffID, name string) (string, string) {
+	if s.erpRepo == nil || strings.TrimSpace(staffID) == "" {
+		return "", name
+	}
+	emp, err := s.erpRepo.GetEmployeeByID(ctx, strings.TrimSpace(staffID))
+	if err != nil {
+		s.log.Debug().Err(err).Str("staffId", staffID).Msg("could not look up employee")
+		return "", name
+	}
+	if strings.TrimSpace(emp.FullName) != "" {
+		name = strings.TrimSpace(emp.FullName)
+	}
+	return staffEmailAddress(ctx, s.parent.globalSettingSvc, emp), name
+}
+
+// requestSLAHours returns the SLA, in hours, of requests of requestType.
+func (s *feedbackRequestService) requestSLAHours(ctx context.Context, requestType enums.FeedbackRequestType) int {
+	requestSLA, pms360SLA := s.sla.slaHours(ctx)
+	if requestType == enums.FeedbackRequest360ReviewFeedback {
+		return pms360SLA
+	}
+	return requestSLA
+}
+
 // =========================================================================
 // HasLineManager – checks if a staff member has a line manager.
 // Mirrors .NET HasLineManager.
@@ -678,14 +825,7 @@ func (s *feedbackRequestService) GetStaffLeaveDays(ctx context.Context, staffID
 		return resp, nil
 	}
 
-	// Resolve the PRESENT_ABSENCE_ID from global settings (default: 19).
-	// In the .NET code: PRESENT_ABSENCE_ID = await _globalSetting.GetIntValue("PRESENT_ABSENCE_ID")
-	presentAbsenceID := 19
-	if s.parent.globalSettingSvc != nil {
-		if val, err := s.parent.globalSettingSvc.GetIntValue(ctx, "PRESENT_ABSENCE_ID"); err == nil {
-			presentAbsenceID = val
-		}
-	}
+	presentAbsenceID := s.sla.presentAbsenceID(ctx)
 
 	// Validate the employee exists before querying leave.
 	if s.parent.erpEmployeeSvc != nil {
@@ -762,9 +902,16 @@ func (s *feedbackRequestService) GetPublicDays(ctx context.Context, startDate, e
 }
 
 // =========================================================================
-// AutoReassignAndLogRequest – automatically reassigns a request to a
-// line manager and logs the reassignment.
+// AutoReassignAndLogRequest – automatically reassigns a breached request away
+// from its assignee and logs the reassignment.
 // Mirrors .NET AutoReassignAndLogRequestAsync.
+//
+// PMS delegations are consulted first: if the assignee has a delegation in
+// effect that covers the request's entity type, the request moves to the
+// delegate. Otherwise, when the assignee has an ERP vacation rule, the
+// request moves to their line manager, and failing that the policies in
+// reassignment.policies are tried in order. A request is never handed back to a previous assignee or to its
+// owner, and stays put once reassignment.max_per_request is reached.
 // =========================================================================
 
 func (s *feedbackRequestService) AutoReassignAndLogRequest(ctx context.Context, requestID string) error {
@@ -775,17 +922,113 @@ func (s *feedbackRequestService) AutoReassignAndLogRequest(ctx context.Context,
 		return fmt.Errorf("feedback request not found: %w", err)
 	}
 
-	// Try to find a line manager for the assigned staff
-	if s.parent.erpEmployeeSvc != nil {
-		subordinateInfo, err := s.parent.erpEmployeeSvc.GetHeadSubordinates(ctx, request.AssignedStaffID)
-		if err == nil && subordinateInfo != nil {
-			// The subordinateInfo should provide the line manager's ID
-			// For now, log a warning as the interface is generic
-			s.log.Warn().
-				Str("requestID", requestID).
-				Msg("AutoReassignAndLogRequest: line manager resolution requires specific ERP integration")
+	// The request may have been treated, closed or reassigned since it was
+	// queued; reassigning restarts the SLA, so this also makes retries safe.
+	if request.RecordStatus != enums.StatusActive.String() || request.TimeCompleted != nil {
+		return nil
+	}
+	if !s.sla.evaluator(ctx, []performance.FeedbackRequestLog{request}).breached(request) {
+		return nil
+	}
+
+	var history []performance.FeedbackRequestReassignment
+	if err := s.db.WithContext(ctx).
+		Where("feedback_request_log_id = ?", requestID).
+		Find(&history).Error; err != nil {
+		return fmt.Errorf("loading reassignment history: %w", err)
+	}
+	if limit := s.cfg.Reassignment.MaxPerRequest; limit > 0 && len(history) >= limit {
+		s.log.Warn().Str("requestID", requestID).Int("reassignments", len(history)).
+			Msg("AutoReassignAndLogRequest: reassignment limit reached, leaving request with current assignee")
+		return nil
+	}
+
+	exclude := map[string]bool{request.AssignedStaffID: true, request.RequestOwnerStaffID: true}
+	for _, h := range history {
+		exclude[h.FromStaffID] = true
+	}
+
+	target, policy := s.reassignTarget(ctx, request, exclude)
+	if target == "" {
+		s.log.Warn().Str("requestID", requestID).Msg("AutoReassignAndLogRequest: no reassignment policy found a new assignee")
+		return nil
+	}
+
+	s.log.Info().
+		Str("requestID", requestID).
+		Str("from", request.AssignedStaffID).
+		Str("to", target).
+		Str("policy", policy).
+		Msg("AutoReassignAndLogRequest: reassigning breached request")
+
+	// The reassignment and its history row commit together, so the
+	// reassignment limit and the excluded assignees always see it.
+	from, targetName := request.AssignedStaffID, s.staffName(ctx, target)
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := s.reassign(tx, &request, target, targetName); err != nil {
+			return err
+		}
+		if err := tx.Create(&performance.FeedbackRequestReassignment{
+			FeedbackRequestReassignmentID: GenerateID(),
+			FeedbackRequestLogID:          requestID,
+			FromStaffID:                   from,
+			ToStaffID:                     target,
+			Policy:                        policy,
+			ReassignedAt:                  request.TimeInitiated,
+		}).Error; err != nil {
+			return fmt.Errorf("logging reassignment: %w", err)
 		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
+	s.notifyReassigned(ctx, request)
 	return nil
 }
+
+// reassignTarget picks the new assignee of a breached request and the
+// policy that chose them: the assignee's delegate, then their line manager
+// under a vacation rule, then the configured policies in order. It returns
+// "" when none of them finds an eligible assignee.
+func (s *feedbackRequestService) reassignTarget(ctx context.Context, request performance.FeedbackRequestLog, exclude map[string]bool) (string, string) {
+	if target := s.delegate(ctx, request, exclude); target != "" {
+		return target, reassignPolicyDelegation
+	}
+
+	policies := s.reassignment
+	if s.vacation != nil {
+		policies = append([]reassignmentPolicy{s.vacation}, s.reassignment...)
+	}
+	for _, p := range policies {
+		target, err := p.target(ctx, request, exclude)
+		if err != nil {
+			s.log.Warn().Err(err).Str("requestID", request.FeedbackRequestLogID).Str("policy", p.name()).
+				Msg("AutoReassignAndLogRequest: reassignment policy failed, trying the next one")
+			continue
+		}
+		if target != "" {
+			return target, p.name()
+		}
+	}
+	return "", ""
+}
+
+// delegate returns the assignee's delegate when a PMS delegation covering
+// the request's entity type is in effect, or "" when there is none.
+func (s *feedbackRequestService) delegate(ctx context.Context, request performance.FeedbackRequestLog, exclude map[string]bool) string {
+	if s.parent.delegationSvc == nil {
+		return ""
+	}
+	entityType := approvalEntityForFeedback(request.FeedbackRequestType)
+	delegation, err := s.parent.delegationSvc.FindActiveDelegation(ctx, request.AssignedStaffID, entityType, time.Now().UTC())
+	if err != nil {
+		s.log.Warn().Err(err).Str("requestID", request.FeedbackRequestLogID).Msg("AutoReassignAndLogRequest: delegation lookup failed, falling back to vacation rules")
+		return ""
+	}
+	if delegation == nil || exclude[delegation.DelegateStaffID] {
+		return ""
+	}
+	return delegation.DelegateStaffID
+}
@@ -0,0 +1,206 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/config"
+	"github.com/enterprise-pms/pms-api/internal/domain/enums"
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"github.com/enterprise-pms/pms-api/internal/repository"
+	"github.com/rs/zerolog"
+)
+
+// slaCalendar measures feedback request SLAs on the configured business
+// calendar, ERP public holidays and SAS absences. The feedback request and
+// SLA escalation services share one, so both measure the same due times.
+type slaCalendar struct {
+	erpRepo       *repository.ErpRepository
+	sasRepo       *repository.SasRepository
+	globalSetting GlobalSettingService
+	calendar      businessCalendar
+	log           zerolog.Logger
+}
+
+func newSLACalendar(repos *repository.Container, cfg *config.Config, log zerolog.Logger, globalSetting GlobalSettingService) *slaCalendar {
+	log = log.With().Str("service", "sla_calendar").Logger()
+	calendar, err := newBusinessCalendar(cfg.SLA)
+	if err != nil {
+		log.Warn().Err(err).Msg("invalid SLA calendar settings, using defaults")
+	}
+	return &slaCalendar{
+		erpRepo:       repos.Erp,
+		sasRepo:       repos.Sas,
+		globalSetting: globalSetting,
+		calendar:      calendar,
+		log:           log,
+	}
+}
+
+// slaHours retrieves the SLA configuration values from global settings.
+// Returns (REQUEST_SLA_IN_HOURS, PMS_360_FEEDBACK_REQUEST_SLA_IN_HOURS).
+func (c *slaCalendar) slaHours(ctx context.Context) (int, int) {
+	requestSLA := 168 // default 168 hours / 7 days
+	pms360SLA := 336  // default 336 hours / 14 days
+	if c.globalSetting == nil {
+		return requestSLA, pms360SLA
+	}
+
+	if val, err := c.globalSetting.GetIntValue(ctx, "REQUEST_SLA_IN_HOURS"); err == nil {
+		requestSLA = val
+	}
+	if val, err := c.globalSetting.GetIntValue(ctx, "PMS_360_FEEDBACK_REQUEST_SLA_IN_HOURS"); err == nil {
+		pms360SLA = val
+	}
+
+	return requestSLA, pms360SLA
+}
+
+// presentAbsenceID resolves the PRESENT_ABSENCE_ID global setting
+// (default: 19), the SAS attendance status that marks a staff member present.
+// In the .NET code: PRESENT_ABSENCE_ID = await _globalSetting.GetIntValue("PRESENT_ABSENCE_ID")
+func (c *slaCalendar) presentAbsenceID(ctx context.Context) int {
+	if c.globalSetting != nil {
+		if val, err := c.globalSetting.GetIntValue(ctx, "PRESENT_ABSENCE_ID"); err == nil {
+			return val
+		}
+	}
+	return 19
+}
+
+// requestSLAStatus is a feedback request's SLA measured on the business
+// calendar of its assignee.
+type requestSLAStatus struct {
+	Allowed    time.Duration // business time the assignee has to respond
+	Elapsed    time.Duration // business time used, up to completion or now
+	DueAt      time.Time
+	IsBreached bool
+}
+
+// slaEvaluator measures the SLAs of a batch of feedback requests. Public
+// holidays are loaded once for the batch and approved absences once per
+// assignee, so callers should build one evaluator per batch.
+type slaEvaluator struct {
+	requestSLA int
+	pms360SLA  int
+	now        time.Time
+
+	calendar  businessCalendar
+	assignees map[string]businessCalendar
+}
+
+// evaluator prepares an evaluator for the given requests. When the ERP
+// or SAS databases are unavailable the requests are measured without public
+// holidays or absences respectively.
+func (c *slaCalendar) evaluator(ctx context.Context, requests []performance.FeedbackRequestLog) *slaEvaluator {
+	requestSLA, pms360SLA := c.slaHours(ctx)
+	e := &slaEvaluator{
+		requestSLA: requestSLA,
+		pms360SLA:  pms360SLA,
+		now:        time.Now(),
+		calendar:   c.calendar,
+		assignees:  make(map[string]businessCalendar),
+	}
+
+	var start time.Time
+	for _, r := range requests {
+		if r.HasSLA && (start.IsZero() || r.TimeInitiated.Before(start)) {
+			start = r.TimeInitiated
+		}
+	}
+	if start.IsZero() {
+		return e
+	}
+	// Pending requests fall due after now; look far enough ahead to cover
+	// the longest SLA stretched over weekends, holidays and leave.
+	end := e.now.Add(3 * time.Duration(max(requestSLA, pms360SLA)) * time.Hour)
+
+	if c.erpRepo != nil {
+		holidays, err := c.erpRepo.GetPublicHolidaysBetween(ctx, start, end)
+		if err != nil {
+			c.log.Warn().Err(err).Msg("SLA: failed to query public holidays, ignoring them")
+		}
+		var days []time.Time
+		for _, h := range holidays {
+			if h.HDate != nil {
+				days = append(days, *h.HDate)
+			}
+		}
+		e.calendar = e.calendar.withClosedDays(days...)
+	}
+
+	if c.sasRepo == nil {
+		return e
+	}
+	presentAbsenceID := c.presentAbsenceID(ctx)
+	for _, r := range requests {
+		if _, ok := e.assignees[r.AssignedStaffID]; ok || !r.HasSLA {
+			continue
+		}
+		leave, err := c.sasRepo.GetStaffLeaveDaysBetween(ctx, r.AssignedStaffID, start, end, presentAbsenceID)
+		if err != nil {
+			c.log.Warn().Err(err).Str("staffID", r.AssignedStaffID).Msg("SLA: failed to query leave records, ignoring them")
+		}
+		var days []time.Time
+		for _, l := range leave {
+			if day, ok := l.AttendanceDay(); ok {
+				days = append(days, day)
+			}
+		}
+		e.assignees[r.AssignedStaffID] = e.calendar.withClosedDays(days...)
+	}
+	return e
+}
+
+// status measures a request against the SLA for its type.
+func (e *slaEvaluator) status(r performance.FeedbackRequestLog) requestSLAStatus {
+	slaHours := e.requestSLA
+	if r.FeedbackRequestType == enums.FeedbackRequest360ReviewFeedback {
+		slaHours = e.pms360SLA
+	}
+	calendar, ok := e.assignees[r.AssignedStaffID]
+	if !ok {
+		calendar = e.calendar
+	}
+	return measureSLA(calendar, r.TimeInitiated, r.TimeCompleted, slaHours, e.now)
+}
+
+// breached reports whether a request is bound by an SLA and has breached it.
+func (e *slaEvaluator) breached(r performance.FeedbackRequestLog) bool {
+	return r.HasSLA && e.status(r).IsBreached
+}
+
+// countOverdue counts the breached requests that were completed late and the
+// ones still pending past their due time.
+func (e *slaEvaluator) countOverdue(requests []performance.FeedbackRequestLog) (completed, pending int) {
+	for _, r := range requests {
+		switch {
+		case isClosedOrCompletedOrBreached(r.RecordStatus) && r.TimeCompleted != nil:
+			if e.breached(r) {
+				completed++
+			}
+		case r.RecordStatus == enums.StatusActive.String():
+			if e.breached(r) {
+				pending++
+			}
+		}
+	}
+	return completed, pending
+}
+
+// measureSLA applies an SLA of slaHours to a request initiated at initiated.
+// The SLA settings count 24-hour days, so every 24 hours allowed is one full
+// working day on the calendar. A completed request breaches when it took more
+// business time than allowed, a pending one once its due time has passed.
+func measureSLA(calendar businessCalendar, initiated time.Time, completed *time.Time, slaHours int, now time.Time) requestSLAStatus {
+	st := requestSLAStatus{Allowed: time.Duration(slaHours) * calendar.workingDay() / 24}
+	st.DueAt = calendar.Add(initiated, st.Allowed)
+	if completed != nil {
+		st.Elapsed = calendar.Elapsed(initiated, *completed)
+		st.IsBreached = st.Elapsed > st.Allowed
+		return st
+	}
+	st.Elapsed = calendar.Elapsed(initiated, now)
+	st.IsBreached = !now.Before(st.DueAt)
+	return st
+}
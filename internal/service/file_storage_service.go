@@ -1,132 +1,447 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
-	"os"
-	"path/filepath"
+	"io"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/enterprise-pms/pms-api/internal/config"
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"github.com/enterprise-pms/pms-api/internal/repository"
+	"github.com/enterprise-pms/pms-api/pkg/storage"
+	"github.com/gabriel-vasile/mimetype"
+	"github.com/google/uuid"
 	"github.com/rs/zerolog"
+	"gorm.io/gorm"
 )
 
 // ---------------------------------------------------------------------------
 // fileStorageService implements the FileStorageService interface.
-// It stores files on the local filesystem under a configurable base directory
-// read from Config.Storage.BasePath. Sub-directories are created automatically
-// when saving files.
+// Files are held by a storage.Backend selected by Config.Storage.Backend:
+// the local filesystem under Config.Storage.BasePath, or an S3-compatible
+// bucket. Uploads are sniffed, size-limited and checksummed, and their
+// metadata is recorded in pms.file_uploads. Downloads go through signed,
+// time-limited URLs bound to the requesting user, and only to callers whose
+// data scope reaches the file's owner or a party to the record it is
+// attached to.
 // ---------------------------------------------------------------------------
 
+const (
+	defaultMaxUploadBytes = 10 << 20
+	defaultDownloadURLTTL = 15 * time.Minute
+	defaultFileCategory   = "general"
+)
+
 type fileStorageService struct {
-	basePath string
-	log      zerolog.Logger
+	repos        *repository.Container
+	scope        DataScopeService
+	backend      storage.Backend
+	signer       *storage.URLSigner
+	maxBytes     int64
+	allowedTypes []string
+	urlTTL       time.Duration
+	log          zerolog.Logger
 }
 
-// newFileStorageService creates a new FileStorageService backed by the local
-// filesystem. The base storage directory is read from cfg.Storage.BasePath
-// and created if it does not already exist.
-func newFileStorageService(cfg *config.Config, log zerolog.Logger) FileStorageService {
+// newFileStorageService creates a new FileStorageService using the backend
+// configured in cfg.Storage. A backend that cannot be initialised is
+// logged and the local filesystem is used instead.
+func newFileStorageService(repos *repository.Container, cfg *config.Config, log zerolog.Logger, scope DataScopeService) FileStorageService {
 	l := log.With().Str("service", "file_storage").Logger()
 
-	basePath := cfg.Storage.BasePath
-	if basePath == "" {
-		basePath = "./uploads"
+	backend, err := newStorageBackend(cfg.Storage)
+	if err != nil {
+		l.Error().Err(err).Str("backend", cfg.Storage.Backend).Msg("failed to initialise storage backend, falling back to local filesystem")
+		backend, err = newStorageBackend(config.StorageConfig{BasePath: cfg.Storage.BasePath})
+		if err != nil {
+			l.Error().Err(err).Msg("failed to initialise local storage backend")
+		}
 	}
 
-	// Resolve to an absolute path for deterministic behaviour.
-	absPath, err := filepath.Abs(basePath)
-	if err != nil {
-		l.Error().Err(err).Str("basePath", basePath).Msg("failed to resolve absolute storage path, using raw value")
-		absPath = basePath
+	signingKey := cfg.Storage.URLSigningKey
+	if signingKey == "" {
+		signingKey = cfg.JWT.Secret
+	}
+	maxBytes := cfg.Storage.MaxUploadBytes
+	if maxBytes <= 0 {
+		maxBytes = defaultMaxUploadBytes
+	}
+	urlTTL := cfg.Storage.DownloadURLTTL
+	if urlTTL <= 0 {
+		urlTTL = defaultDownloadURLTTL
 	}
 
-	// Ensure the base directory exists.
-	if err := os.MkdirAll(absPath, 0o755); err != nil {
-		l.Error().Err(err).Str("basePath", absPath).Msg("failed to create storage base directory")
+	if backend != nil {
+		l.Info().Str("backend", backend.Name()).Msg("file storage service initialised")
+	}
+	return &fileStorageService{
+		repos:        repos,
+		scope:        scope,
+		backend:      backend,
+		signer:       storage.NewURLSigner(signingKey),
+		maxBytes:     maxBytes,
+		allowedTypes: cfg.Storage.AllowedContentTypes,
+		urlTTL:       urlTTL,
+		log:          l,
 	}
+}
 
-	l.Info().Str("basePath", absPath).Msg("file storage service initialised")
-	return &fileStorageService{basePath: absPath, log: l}
+// newStorageBackend builds the backend named by cfg.Backend.
+func newStorageBackend(cfg config.StorageConfig) (storage.Backend, error) {
+	switch strings.ToLower(cfg.Backend) {
+	case "", "local":
+		basePath := cfg.BasePath
+		if basePath == "" {
+			basePath = "./uploads"
+		}
+		return storage.NewLocalBackend(basePath)
+	case "s3":
+		return storage.NewS3Backend(storage.S3Options{
+			Endpoint:        cfg.S3.Endpoint,
+			Region:          cfg.S3.Region,
+			Bucket:          cfg.S3.Bucket,
+			AccessKeyID:     cfg.S3.AccessKeyID,
+			SecretAccessKey: cfg.S3.SecretAccessKey,
+			UsePathStyle:    cfg.S3.UsePathStyle,
+		})
+	default:
+		return nil, fmt.Errorf("unknown storage backend %q", cfg.Backend)
+	}
 }
 
-// SaveFile writes data to disk under the base storage directory and returns
-// the full path of the persisted file. Parent directories are created
-// automatically if the fileName contains path separators (e.g. "2024/report.pdf").
-func (s *fileStorageService) SaveFile(_ context.Context, fileName string, data []byte) (string, error) {
+// SaveFile stores data under the given key (e.g. "2024/report.pdf") and
+// returns the key.
+func (s *fileStorageService) SaveFile(ctx context.Context, fileName string, data []byte) (string, error) {
 	if fileName == "" {
 		return "", fmt.Errorf("file_storage: file name must not be empty")
 	}
+	key := strings.TrimLeft(path.Clean("/"+fileName), "/")
+	if err := s.backend.Put(ctx, key, bytes.NewReader(data), int64(len(data)), ""); err != nil {
+		s.log.Error().Err(err).Str("key", key).Msg("failed to write file")
+		return "", fmt.Errorf("file_storage: writing file %s: %w", key, err)
+	}
 
-	// Clean the file name to prevent directory traversal.
-	cleaned := filepath.Clean(fileName)
-	fullPath := filepath.Join(s.basePath, cleaned)
+	s.log.Info().Str("key", key).Int("size", len(data)).Msg("file saved")
+	return key, nil
+}
 
-	// Ensure the parent directory exists.
-	dir := filepath.Dir(fullPath)
-	if err := os.MkdirAll(dir, 0o755); err != nil {
-		s.log.Error().Err(err).Str("dir", dir).Msg("failed to create parent directory")
-		return "", fmt.Errorf("file_storage: creating directory %s: %w", dir, err)
+// DeleteFile removes a stored file. Deleting a missing file is not an error.
+func (s *fileStorageService) DeleteFile(ctx context.Context, key string) error {
+	if key == "" {
+		return fmt.Errorf("file_storage: path must not be empty")
+	}
+	if err := s.backend.Delete(ctx, key); err != nil {
+		s.log.Error().Err(err).Str("key", key).Msg("failed to delete file")
+		return fmt.Errorf("file_storage: deleting file %s: %w", key, err)
 	}
 
-	if err := os.WriteFile(fullPath, data, 0o644); err != nil {
-		s.log.Error().Err(err).Str("path", fullPath).Msg("failed to write file")
-		return "", fmt.Errorf("file_storage: writing file %s: %w", fullPath, err)
+	s.log.Info().Str("key", key).Msg("file deleted")
+	return nil
+}
+
+// GetFile reads and returns the contents of a stored file.
+func (s *fileStorageService) GetFile(ctx context.Context, key string) ([]byte, error) {
+	if key == "" {
+		return nil, fmt.Errorf("file_storage: path must not be empty")
 	}
+	rc, err := s.backend.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, fmt.Errorf("file_storage: file not found %s: %w", key, ErrFileNotFound)
+		}
+		return nil, fmt.Errorf("file_storage: reading file %s: %w", key, err)
+	}
+	defer rc.Close()
 
-	s.log.Info().Str("path", fullPath).Int("size", len(data)).Msg("file saved")
-	return fullPath, nil
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, fmt.Errorf("file_storage: reading file %s: %w", key, err)
+	}
+	return data, nil
 }
 
-// DeleteFile removes a file from disk. The path may be absolute or relative
-// to the base storage directory.
-func (s *fileStorageService) DeleteFile(_ context.Context, path string) error {
-	if path == "" {
-		return fmt.Errorf("file_storage: path must not be empty")
+// Upload validates, checksums and stores an uploaded file and records its
+// metadata. The returned FileUploadID is what evidence and attachment
+// fields should reference.
+func (s *fileStorageService) Upload(ctx context.Context, req *performance.FileUploadRequestModel, body io.Reader) (*performance.FileUploadResponseVm, error) {
+	resp := &performance.FileUploadResponseVm{}
+
+	data, err := io.ReadAll(io.LimitReader(body, s.maxBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("reading upload: %w", err)
+	}
+	contentType, msg := checkUpload(data, s.maxBytes, s.allowedTypes)
+	if msg != "" {
+		resp.HasError = true
+		resp.Message = msg
+		return resp, nil
 	}
 
-	fullPath := s.resolvePath(path)
+	sum := sha256.Sum256(data)
+	category := uploadCategory(req.Category)
+	fileID := uuid.New().String()
+	key := uploadStorageKey(category, fileID, contentType.Extension(), time.Now())
 
-	if err := os.Remove(fullPath); err != nil {
-		if os.IsNotExist(err) {
-			s.log.Warn().Str("path", fullPath).Msg("file does not exist, nothing to delete")
-			return nil
+	if err := s.backend.Put(ctx, key, bytes.NewReader(data), int64(len(data)), contentType.String()); err != nil {
+		return nil, fmt.Errorf("storing upload: %w", err)
+	}
+
+	upload := &performance.FileUpload{
+		FileUploadID: fileID,
+		StorageKey:   key,
+		Backend:      s.backend.Name(),
+		OriginalName: path.Base(strings.ReplaceAll(strings.TrimSpace(req.FileName), "\\", "/")),
+		ContentType:  contentType.String(),
+		SizeBytes:    int64(len(data)),
+		Sha256:       hex.EncodeToString(sum[:]),
+		Category:     category,
+		UploadedBy:   req.UploadedBy,
+		OwnerStaffID: req.UploadedBy,
+	}
+	upload.CreatedBy = req.UploadedBy
+	if err := s.repos.Files.Create(ctx, upload); err != nil {
+		if delErr := s.backend.Delete(ctx, key); delErr != nil {
+			s.log.Warn().Err(delErr).Str("key", key).Msg("failed to remove stored object after metadata insert failed")
 		}
-		s.log.Error().Err(err).Str("path", fullPath).Msg("failed to delete file")
-		return fmt.Errorf("file_storage: deleting file %s: %w", fullPath, err)
+		return nil, fmt.Errorf("recording upload: %w", err)
 	}
 
-	s.log.Info().Str("path", fullPath).Msg("file deleted")
-	return nil
+	s.log.Info().Str("fileUploadId", fileID).Str("backend", upload.Backend).
+		Str("contentType", upload.ContentType).Int64("size", upload.SizeBytes).Msg("file uploaded")
+	resp.Data = fileUploadToVm(upload)
+	resp.Message = msgOperationCompleted
+	return resp, nil
 }
 
-// GetFile reads and returns the contents of a file from disk.
-func (s *fileStorageService) GetFile(_ context.Context, path string) ([]byte, error) {
-	if path == "" {
-		return nil, fmt.Errorf("file_storage: path must not be empty")
+// GetFileInfo returns the metadata of a stored file.
+func (s *fileStorageService) GetFileInfo(ctx context.Context, fileUploadID string) (*performance.FileUploadResponseVm, error) {
+	resp := &performance.FileUploadResponseVm{}
+
+	upload, err := s.repos.Files.GetByID(ctx, fileUploadID)
+	if err != nil {
+		return nil, fmt.Errorf("getting file upload: %w", err)
+	}
+	if upload == nil {
+		resp.HasError = true
+		resp.Message = "File not found"
+		return resp, nil
+	}
+	if err := s.authorizeFile(ctx, upload); err != nil {
+		return nil, err
 	}
 
-	fullPath := s.resolvePath(path)
+	resp.Data = fileUploadToVm(upload)
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// GetDownloadURL issues a signed download link for a file, valid for the
+// configured TTL and only for userID.
+func (s *fileStorageService) GetDownloadURL(ctx context.Context, fileUploadID, userID string) (*performance.FileDownloadURLResponseVm, error) {
+	resp := &performance.FileDownloadURLResponseVm{}
 
-	data, err := os.ReadFile(fullPath)
+	upload, err := s.repos.Files.GetByID(ctx, fileUploadID)
 	if err != nil {
-		if os.IsNotExist(err) {
-			s.log.Warn().Str("path", fullPath).Msg("file not found")
-			return nil, fmt.Errorf("file_storage: file not found %s: %w", fullPath, err)
+		return nil, fmt.Errorf("getting file upload: %w", err)
+	}
+	if upload == nil {
+		resp.HasError = true
+		resp.Message = "File not found"
+		return resp, nil
+	}
+	if err := s.authorizeFile(ctx, upload); err != nil {
+		return nil, err
+	}
+
+	expires := time.Now().Add(s.urlTTL).Truncate(time.Second)
+	query := url.Values{
+		"expires":   {strconv.FormatInt(expires.Unix(), 10)},
+		"signature": {s.signer.Sign(upload.FileUploadID, userID, expires)},
+	}
+	resp.Data = &performance.FileDownloadURLVm{
+		FileUploadID: upload.FileUploadID,
+		URL:          "/api/v1/files/" + url.PathEscape(upload.FileUploadID) + "/download?" + query.Encode(),
+		ExpiresAt:    expires.UTC(),
+	}
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// OpenDownload verifies a download signature for userID and opens the file.
+// It returns ErrFileNotFound, ErrOutOfScope, storage.ErrURLExpired or
+// storage.ErrInvalidSignature for requests that should not be served. The
+// caller must close the returned reader.
+func (s *fileStorageService) OpenDownload(ctx context.Context, fileUploadID, userID string, expiresUnix int64, signature string) (*performance.FileUpload, io.ReadCloser, error) {
+	if err := s.signer.Verify(fileUploadID, userID, time.Unix(expiresUnix, 0), signature, time.Now()); err != nil {
+		return nil, nil, err
+	}
+
+	upload, err := s.repos.Files.GetByID(ctx, fileUploadID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting file upload: %w", err)
+	}
+	if upload == nil {
+		return nil, nil, ErrFileNotFound
+	}
+	if err := s.authorizeFile(ctx, upload); err != nil {
+		return nil, nil, err
+	}
+
+	rc, err := s.backend.Get(ctx, upload.StorageKey)
+	if errors.Is(err, storage.ErrNotFound) {
+		s.log.Error().Str("fileUploadId", fileUploadID).Str("key", upload.StorageKey).Msg("stored object missing for file upload")
+		return nil, nil, ErrFileNotFound
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("opening stored file: %w", err)
+	}
+	return upload, rc, nil
+}
+
+// authorizeFile returns ErrOutOfScope unless the caller's data scope reaches
+// the file's uploader, its owner or a party to the record it is attached to.
+func (s *fileStorageService) authorizeFile(ctx context.Context, upload *performance.FileUpload) error {
+	parties := []string{upload.UploadedBy, upload.OwnerStaffID}
+	db := s.repos.GormDB.WithContext(ctx)
+	switch upload.EntityType {
+	case performance.FileEntityGrievance:
+		var g performance.Grievance
+		if err := db.Select("complainant_staff_id", "respondent_staff_id", "current_mediator_staff_id").
+			Where("grievance_id = ?", upload.EntityID).
+			Limit(1).Find(&g).Error; err != nil {
+			return fmt.Errorf("loading grievance of file upload: %w", err)
+		}
+		parties = append(parties, g.ComplainantStaffID, g.RespondentStaffID, g.CurrentMediatorStaffID)
+	case performance.FileEntityFeedbackRequest:
+		var request performance.FeedbackRequestLog
+		if err := db.Select("request_owner_staff_id", "assigned_staff_id").
+			Where("feedback_request_log_id = ?", upload.EntityID).
+			Limit(1).Find(&request).Error; err != nil {
+			return fmt.Errorf("loading feedback request of file upload: %w", err)
 		}
-		s.log.Error().Err(err).Str("path", fullPath).Msg("failed to read file")
-		return nil, fmt.Errorf("file_storage: reading file %s: %w", fullPath, err)
+		parties = append(parties, request.RequestOwnerStaffID, request.AssignedStaffID)
 	}
+	return s.scope.AuthorizeAnyStaff(ctx, parties...)
+}
 
-	return data, nil
+// attachFileUpload validates a file reference submitted as evidence or an
+// attachment and records, in tx, the entity it now belongs to and its owner.
+// Only the uploader, or a caller with enterprise reach, may attach a file,
+// and a file attached to one record cannot be attached to another. An empty
+// reference is ignored.
+func attachFileUpload(ctx context.Context, tx *gorm.DB, fileUploadID, ownerStaffID, entityType, entityID string) error {
+	if fileUploadID == "" {
+		return nil
+	}
+	var upload performance.FileUpload
+	err := tx.Where("file_upload_id = ? AND soft_deleted = ?", fileUploadID, false).First(&upload).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("%w: file %s does not exist", ErrInvalidFileReference, fileUploadID)
+	}
+	if err != nil {
+		return fmt.Errorf("loading file upload %s: %w", fileUploadID, err)
+	}
+	if err := checkAttachment(&upload, callerStaffID(ctx), hasEnterpriseReach(ctx), entityType, entityID); err != nil {
+		return err
+	}
+	if upload.EntityType == entityType && upload.EntityID == entityID && upload.OwnerStaffID == ownerStaffID {
+		return nil
+	}
+	if err := tx.Model(&performance.FileUpload{}).
+		Where("file_upload_id = ?", fileUploadID).
+		Updates(map[string]interface{}{
+			"entity_type":    entityType,
+			"entity_id":      entityID,
+			"owner_staff_id": ownerStaffID,
+		}).Error; err != nil {
+		return fmt.Errorf("attaching file upload %s: %w", fileUploadID, err)
+	}
+	return nil
+}
+
+// checkAttachment applies the attachment rules of attachFileUpload to a
+// loaded upload.
+func checkAttachment(upload *performance.FileUpload, caller string, enterprise bool, entityType, entityID string) error {
+	if upload.EntityType != "" && (upload.EntityType != entityType || upload.EntityID != entityID) {
+		return fmt.Errorf("%w: file %s is attached to another record", ErrInvalidFileReference, upload.FileUploadID)
+	}
+	if upload.EntityType == "" && !enterprise && (caller == "" || upload.UploadedBy != caller) {
+		return fmt.Errorf("%w: file %s was not uploaded by you", ErrInvalidFileReference, upload.FileUploadID)
+	}
+	return nil
+}
+
+// checkUpload sniffs the content type of data and checks it against the
+// size limit and allow-list. An empty allow-list accepts any type. The
+// returned message is non-empty when the upload must be rejected.
+func checkUpload(data []byte, maxBytes int64, allowed []string) (*mimetype.MIME, string) {
+	if len(data) == 0 {
+		return nil, "File is empty"
+	}
+	if int64(len(data)) > maxBytes {
+		return nil, fmt.Sprintf("File exceeds the maximum upload size of %d bytes", maxBytes)
+	}
+
+	detected := mimetype.Detect(data)
+	if len(allowed) == 0 {
+		return detected, ""
+	}
+	for _, t := range allowed {
+		if detected.Is(t) {
+			return detected, ""
+		}
+	}
+	return nil, fmt.Sprintf("File type %s is not allowed", detected.String())
+}
+
+// uploadCategory normalises a caller-supplied category into a safe key
+// prefix.
+func uploadCategory(category string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(strings.TrimSpace(category)) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '-', r == '_':
+			b.WriteRune(r)
+		case r == ' ':
+			b.WriteRune('-')
+		}
+	}
+	if b.Len() == 0 {
+		return defaultFileCategory
+	}
+	return b.String()
+}
+
+// uploadStorageKey returns the backend key for a new upload, e.g.
+// "grievance-evidence/2026/03/<id>.pdf". The original file name is kept
+// only in the metadata so it never reaches the storage layer.
+func uploadStorageKey(category, fileID, ext string, now time.Time) string {
+	return fmt.Sprintf("%s/%s/%s%s", category, now.UTC().Format("2006/01"), fileID, ext)
 }
 
-// resolvePath returns an absolute path. If the input is already absolute it is
-// returned as-is; otherwise it is joined with the base storage directory.
-func (s *fileStorageService) resolvePath(path string) string {
-	if filepath.IsAbs(path) {
-		return path
+func fileUploadToVm(u *performance.FileUpload) *performance.FileUploadVm {
+	return &performance.FileUploadVm{
+		BaseEntityVm: toBaseEntityVm(u.BaseEntity),
+		FileUploadID: u.FileUploadID,
+		OriginalName: u.OriginalName,
+		ContentType:  u.ContentType,
+		SizeBytes:    u.SizeBytes,
+		Sha256:       u.Sha256,
+		Category:     u.Category,
+		UploadedBy:   u.UploadedBy,
+		OwnerStaffID: u.OwnerStaffID,
+		EntityType:   u.EntityType,
+		EntityID:     u.EntityID,
 	}
-	return filepath.Join(s.basePath, path)
 }
 
 func init() {
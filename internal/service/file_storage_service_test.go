@@ -0,0 +1,95 @@
+package service
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+)
+
+func TestCheckUpload(t *testing.T) {
+	allowed := []string{"application/pdf", "image/png", "text/plain"}
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	tests := []struct {
+		name     string
+		data     []byte
+		maxBytes int64
+		allowed  []string
+		wantType string
+		wantErr  bool
+	}{
+		{"pdf", []byte("%PDF-1.7\n%evidence"), 1024, allowed, "application/pdf", false},
+		{"png", png, 1024, allowed, "image/png", false},
+		{"plain text", []byte("meeting notes"), 1024, allowed, "text/plain; charset=utf-8", false},
+		{"html sniffed despite name", []byte("<html><script>alert(1)</script></html>"), 1024, allowed, "", true},
+		{"executable", []byte("MZ\x90\x00\x03\x00\x00\x00"), 1024, allowed, "", true},
+		{"empty", nil, 1024, allowed, "", true},
+		{"too large", bytes.Repeat([]byte("a"), 11), 10, allowed, "", true},
+		{"exactly the limit", bytes.Repeat([]byte("a"), 10), 10, allowed, "text/plain; charset=utf-8", false},
+		{"empty allow-list accepts anything", []byte("MZ\x90\x00\x03\x00\x00\x00"), 1024, nil, "application/vnd.microsoft.portable-executable", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mt, msg := checkUpload(tt.data, tt.maxBytes, tt.allowed)
+			if (msg != "") != tt.wantErr {
+				t.Fatalf("checkUpload() message = %q, wantErr %v", msg, tt.wantErr)
+			}
+			if !tt.wantErr && mt.String() != tt.wantType {
+				t.Errorf("checkUpload() type = %q, want %q", mt.String(), tt.wantType)
+			}
+		})
+	}
+}
+
+func TestUploadStorageKey(t *testing.T) {
+	now := time.Date(2026, 3, 9, 23, 30, 0, 0, time.FixedZone("WAT", 3600))
+	tests := []struct {
+		category string
+		want     string
+	}{
+		{"grievance-evidence", "grievance-evidence/2026/03/id.pdf"},
+		{"  Feedback Attachments ", "feedback-attachments/2026/03/id.pdf"},
+		{"../../etc", "etc/2026/03/id.pdf"},
+		{"", "general/2026/03/id.pdf"},
+	}
+	for _, tt := range tests {
+		if got := uploadStorageKey(uploadCategory(tt.category), "id", ".pdf", now); got != tt.want {
+			t.Errorf("storage key for category %q = %q, want %q", tt.category, got, tt.want)
+		}
+	}
+}
+
+func TestCheckAttachment(t *testing.T) {
+	attached := &performance.FileUpload{FileUploadID: "F1", UploadedBy: "S1", EntityType: performance.FileEntityGrievance, EntityID: "G1"}
+	fresh := &performance.FileUpload{FileUploadID: "F2", UploadedBy: "S1"}
+	tests := []struct {
+		name       string
+		upload     *performance.FileUpload
+		caller     string
+		enterprise bool
+		entityType string
+		entityID   string
+		wantErr    bool
+	}{
+		{"own upload", fresh, "S1", false, performance.FileEntityGrievance, "G1", false},
+		{"someone else's upload", fresh, "S2", false, performance.FileEntityGrievance, "G1", true},
+		{"no caller", fresh, "", false, performance.FileEntityGrievance, "G1", true},
+		{"enterprise reach", fresh, "S2", true, performance.FileEntityGrievance, "G1", false},
+		{"same record again", attached, "S2", false, performance.FileEntityGrievance, "G1", false},
+		{"another record", attached, "S1", false, performance.FileEntityGrievance, "G2", true},
+		{"another entity type", attached, "S1", true, performance.FileEntityFeedbackRequest, "G1", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkAttachment(tt.upload, tt.caller, tt.enterprise, tt.entityType, tt.entityID)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidFileReference) {
+				t.Errorf("err = %v, want ErrInvalidFileReference", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,452 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/enterprise-pms/pms-api/internal/config"
+	"github.com/enterprise-pms/pms-api/internal/domain/enums"
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"github.com/enterprise-pms/pms-api/internal/repository"
+	"github.com/rs/zerolog"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// ---------------------------------------------------------------------------
+// gradingScaleService manages versioned grading scales and resolves the scale
+// that applies to a review period: the period's own scale, then its
+// strategy's scale, then the built-in default. Saving a scale creates a new
+// version; strategies and open review periods move to it while closed
+// periods keep grading against the version they were scored with.
+// ---------------------------------------------------------------------------
+
+type gradingScaleService struct {
+	db  *gorm.DB
+	log zerolog.Logger
+}
+
+func newGradingScaleService(repos *repository.Container, cfg *config.Config, log zerolog.Logger) GradingScaleService {
+	return &gradingScaleService{
+		db:  repos.GormDB,
+		log: log.With().Str("service", "grading_scale").Logger(),
+	}
+}
+
+// ==========================================================================
+// Scale CRUD
+// ==========================================================================
+
+// GetGradingScales lists every stored scale version followed by the built-in
+// default scale.
+func (s *gradingScaleService) GetGradingScales(ctx context.Context) (performance.GradingScaleListResponseVm, error) {
+	resp := performance.GradingScaleListResponseVm{}
+
+	var scales []performance.GradingScale
+	if err := s.db.WithContext(ctx).
+		Preload("Bands", "soft_deleted = ?", false).
+		Where("soft_deleted = ?", false).
+		Order("name, version DESC").
+		Find(&scales).Error; err != nil {
+		return resp, fmt.Errorf("listing grading scales: %w", err)
+	}
+
+	for _, gs := range scales {
+		resp.Data = append(resp.Data, toGradingScaleVm(gs))
+	}
+	resp.Data = append(resp.Data, builtInGradingScaleVm())
+
+	resp.TotalRecord = len(resp.Data)
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// GetGradingScale returns a single stored scale version by ID.
+func (s *gradingScaleService) GetGradingScale(ctx context.Context, scaleID string) (performance.GradingScaleResponseVm, error) {
+	resp := performance.GradingScaleResponseVm{}
+
+	gs, err := s.loadScale(ctx, scaleID)
+	if err != nil {
+		return resp, err
+	}
+	if gs == nil {
+		resp.HasError = true
+		resp.Message = fmt.Sprintf("grading scale %s not found", scaleID)
+		return resp, nil
+	}
+
+	vm := toGradingScaleVm(*gs)
+	resp.Data = &vm
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// SaveGradingScale validates the bands and stores them as a new scale
+// version. When GradingScaleID is set the referenced version is retired and
+// its attachments on strategies and open review periods move to the new one.
+func (s *gradingScaleService) SaveGradingScale(ctx context.Context, req *performance.GradingScaleRequestModel) (performance.ResponseVm, error) {
+	resp := performance.ResponseVm{}
+
+	bands := make([]GradeBand, 0, len(req.Bands))
+	for _, b := range req.Bands {
+		bands = append(bands, gradeBandFromRequest(b))
+	}
+	if err := validateGradeBands(bands); err != nil {
+		resp.HasError = true
+		resp.Message = err.Error()
+		return resp, nil
+	}
+
+	var previous *performance.GradingScale
+	if req.GradingScaleID != "" {
+		prev, err := s.loadScale(ctx, req.GradingScaleID)
+		if err != nil {
+			return resp, err
+		}
+		if prev == nil {
+			resp.HasError = true
+			resp.Message = fmt.Sprintf("grading scale %s not found", req.GradingScaleID)
+			return resp, nil
+		}
+		previous = prev
+	}
+
+	scale := performance.GradingScale{
+		GradingScaleID: GenerateID(),
+		Name:           req.Name,
+		Description:    req.Description,
+		Version:        1,
+	}
+	scale.CreatedBy = req.UpdatedBy
+	scale.UpdatedBy = req.UpdatedBy
+	if previous != nil {
+		// Versions of a scale share its name.
+		scale.Name = previous.Name
+	}
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if previous != nil {
+			latest, err := latestScaleVersion(tx, previous.Name)
+			if err != nil {
+				return err
+			}
+			scale.Version = latest + 1
+		}
+		if err := tx.Create(&scale).Error; err != nil {
+			return fmt.Errorf("saving grading scale: %w", err)
+		}
+		for _, b := range bands {
+			lo, _ := b.MinPercentage.Float64()
+			hi, _ := b.MaxPercentage.Float64()
+			band := performance.GradingScaleBand{
+				GradingScaleBandID: GenerateID(),
+				GradingScaleID:     scale.GradingScaleID,
+				MinPercentage:      lo,
+				MaxPercentage:      hi,
+				Grade:              b.Grade,
+				Label:              b.Label,
+				IsUnderPerforming:  b.IsUnderPerforming,
+			}
+			band.CreatedBy = req.UpdatedBy
+			if err := tx.Create(&band).Error; err != nil {
+				return fmt.Errorf("saving grading scale band: %w", err)
+			}
+		}
+
+		if previous == nil {
+			return nil
+		}
+		if err := tx.Model(&performance.GradingScale{}).
+			Where("grading_scale_id = ?", previous.GradingScaleID).
+			Updates(map[string]interface{}{"is_active": false, "updated_by": req.UpdatedBy}).Error; err != nil {
+			return fmt.Errorf("retiring grading scale version: %w", err)
+		}
+		// Graded periods that inherit the scale from their strategy keep the
+		// version they were graded with.
+		if err := tx.Model(&performance.PerformanceReviewPeriod{}).
+			Where("grading_scale_id IS NULL AND record_status IN ?", gradedPeriodStatuses()).
+			Where("strategy_id IN (?)", tx.Model(&performance.Strategy{}).
+				Select("strategy_id").
+				Where("grading_scale_id = ?", previous.GradingScaleID)).
+			Update("grading_scale_id", previous.GradingScaleID).Error; err != nil {
+			return fmt.Errorf("pinning graded review periods to grading scale version: %w", err)
+		}
+		if err := tx.Model(&performance.Strategy{}).
+			Where("grading_scale_id = ?", previous.GradingScaleID).
+			Update("grading_scale_id", scale.GradingScaleID).Error; err != nil {
+			return fmt.Errorf("moving strategies to new grading scale version: %w", err)
+		}
+		if err := tx.Model(&performance.PerformanceReviewPeriod{}).
+			Where("grading_scale_id = ? AND record_status NOT IN ?", previous.GradingScaleID, gradedPeriodStatuses()).
+			Update("grading_scale_id", scale.GradingScaleID).Error; err != nil {
+			return fmt.Errorf("moving review periods to new grading scale version: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		s.log.Error().Err(err).Str("action", "SAVE_GRADING_SCALE").Msg("failed to save grading scale")
+		return resp, err
+	}
+
+	s.log.Info().Str("scaleId", scale.GradingScaleID).Str("name", scale.Name).Int("version", scale.Version).Msg("grading scale saved")
+	resp.ID = scale.GradingScaleID
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// DeleteGradingScale soft-deletes a scale version that is not attached to any
+// strategy or review period.
+func (s *gradingScaleService) DeleteGradingScale(ctx context.Context, scaleID string) (performance.ResponseVm, error) {
+	resp := performance.ResponseVm{ID: scaleID}
+
+	var inUse int64
+	if err := s.db.WithContext(ctx).Model(&performance.PerformanceReviewPeriod{}).
+		Where("grading_scale_id = ?", scaleID).Count(&inUse).Error; err != nil {
+		return resp, fmt.Errorf("checking grading scale usage: %w", err)
+	}
+	if inUse == 0 {
+		if err := s.db.WithContext(ctx).Model(&performance.Strategy{}).
+			Where("grading_scale_id = ?", scaleID).Count(&inUse).Error; err != nil {
+			return resp, fmt.Errorf("checking grading scale usage: %w", err)
+		}
+	}
+	if inUse > 0 {
+		resp.HasError = true
+		resp.Message = "grading scale is attached to a strategy or review period and cannot be deleted"
+		return resp, nil
+	}
+
+	result := s.db.WithContext(ctx).Model(&performance.GradingScale{}).
+		Where("grading_scale_id = ? AND soft_deleted = ?", scaleID, false).
+		Updates(map[string]interface{}{"soft_deleted": true, "is_active": false})
+	if result.Error != nil {
+		return resp, fmt.Errorf("deleting grading scale: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		resp.HasError = true
+		resp.Message = fmt.Sprintf("grading scale %s not found", scaleID)
+		return resp, nil
+	}
+
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// AssignGradingScale attaches a scale to exactly one review period or
+// strategy, or detaches it when GradingScaleID is empty.
+func (s *gradingScaleService) AssignGradingScale(ctx context.Context, req *performance.GradingScaleAssignmentRequestModel) (performance.ResponseVm, error) {
+	resp := performance.ResponseVm{ID: req.GradingScaleID}
+
+	if (req.ReviewPeriodID == "") == (req.StrategyID == "") {
+		resp.HasError = true
+		resp.Message = "specify either a review period or a strategy"
+		return resp, nil
+	}
+
+	var scaleID interface{}
+	if req.GradingScaleID != "" {
+		gs, err := s.loadScale(ctx, req.GradingScaleID)
+		if err != nil {
+			return resp, err
+		}
+		if gs == nil || !gs.IsActive {
+			resp.HasError = true
+			resp.Message = fmt.Sprintf("grading scale %s not found or retired", req.GradingScaleID)
+			return resp, nil
+		}
+		scaleID = gs.GradingScaleID
+	}
+
+	var result *gorm.DB
+	if req.ReviewPeriodID != "" {
+		result = s.db.WithContext(ctx).Model(&performance.PerformanceReviewPeriod{}).
+			Where("period_id = ?", req.ReviewPeriodID).
+			Updates(map[string]interface{}{"grading_scale_id": scaleID, "updated_by": req.UpdatedBy})
+	} else {
+		result = s.db.WithContext(ctx).Model(&performance.Strategy{}).
+			Where("strategy_id = ?", req.StrategyID).
+			Updates(map[string]interface{}{"grading_scale_id": scaleID, "updated_by": req.UpdatedBy})
+	}
+	if result.Error != nil {
+		return resp, fmt.Errorf("assigning grading scale: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		resp.HasError = true
+		resp.Message = "review period or strategy not found"
+		return resp, nil
+	}
+
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// ==========================================================================
+// Runtime resolution
+// ==========================================================================
+
+// ResolveForReviewPeriod returns the grading scale that applies to a review
+// period: its own scale, then its strategy's scale, then the default.
+func (s *gradingScaleService) ResolveForReviewPeriod(ctx context.Context, period performance.PerformanceReviewPeriod) (GradeScale, error) {
+	var scaleID string
+	if period.GradingScaleID != nil {
+		scaleID = *period.GradingScaleID
+	}
+	if scaleID == "" && period.StrategyID != "" {
+		var strategy performance.Strategy
+		err := s.db.WithContext(ctx).
+			Select("strategy_id", "grading_scale_id").
+			Where("strategy_id = ?", period.StrategyID).
+			First(&strategy).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return DefaultGradeScale(), fmt.Errorf("loading strategy grading scale: %w", err)
+		}
+		if strategy.GradingScaleID != nil {
+			scaleID = *strategy.GradingScaleID
+		}
+	}
+	if scaleID == "" {
+		return DefaultGradeScale(), nil
+	}
+
+	gs, err := s.loadScale(ctx, scaleID)
+	if err != nil {
+		return DefaultGradeScale(), err
+	}
+	if gs == nil || len(gs.Bands) == 0 {
+		s.log.Warn().Str("scaleId", scaleID).Str("reviewPeriodId", period.PeriodID).Msg("attached grading scale missing; using default")
+		return DefaultGradeScale(), nil
+	}
+	return toGradeScale(*gs), nil
+}
+
+// ResolveForReviewPeriodID loads a review period and resolves its scale.
+func (s *gradingScaleService) ResolveForReviewPeriodID(ctx context.Context, reviewPeriodID string) (GradeScale, error) {
+	var period performance.PerformanceReviewPeriod
+	if err := s.db.WithContext(ctx).
+		Where("period_id = ?", reviewPeriodID).
+		First(&period).Error; err != nil {
+		return DefaultGradeScale(), fmt.Errorf("loading review period %s: %w", reviewPeriodID, err)
+	}
+	return s.ResolveForReviewPeriod(ctx, period)
+}
+
+func (s *gradingScaleService) loadScale(ctx context.Context, scaleID string) (*performance.GradingScale, error) {
+	var gs performance.GradingScale
+	err := s.db.WithContext(ctx).
+		Preload("Bands", func(db *gorm.DB) *gorm.DB {
+			return db.Where("soft_deleted = ?", false).Order("min_percentage")
+		}).
+		Where("grading_scale_id = ? AND soft_deleted = ?", scaleID, false).
+		First(&gs).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("loading grading scale: %w", err)
+	}
+	return &gs, nil
+}
+
+// latestScaleVersion returns the highest version stored for a scale name.
+func latestScaleVersion(tx *gorm.DB, name string) (int, error) {
+	var version int
+	if err := tx.Model(&performance.GradingScale{}).
+		Where("name = ?", name).
+		Select("COALESCE(MAX(version), 0)").
+		Scan(&version).Error; err != nil {
+		return 0, fmt.Errorf("loading latest grading scale version: %w", err)
+	}
+	return version, nil
+}
+
+// ==========================================================================
+// Mapping helpers
+// ==========================================================================
+
+// gradedPeriodStatuses are review period states whose scores are final and
+// must keep the scale version they were graded with.
+func gradedPeriodStatuses() []string {
+	return []string{enums.StatusClosed.String(), enums.StatusCompleted.String()}
+}
+
+func gradeBandFromRequest(b performance.GradingScaleBandRequestModel) GradeBand {
+	grade := enums.PerformanceGrade(b.Grade)
+	label := b.Label
+	if label == "" {
+		label = grade.String()
+	}
+	return GradeBand{
+		MinPercentage:     decimal.NewFromFloat(b.MinPercentage),
+		MaxPercentage:     decimal.NewFromFloat(b.MaxPercentage),
+		Grade:             grade,
+		Label:             label,
+		IsUnderPerforming: b.IsUnderPerforming,
+	}
+}
+
+// toGradeScale converts a stored scale to its runtime form with bands in
+// ascending order.
+func toGradeScale(gs performance.GradingScale) GradeScale {
+	scale := GradeScale{ScaleID: gs.GradingScaleID, Name: gs.Name, Version: gs.Version}
+	for _, b := range gs.Bands {
+		scale.Bands = append(scale.Bands, GradeBand{
+			MinPercentage:     decimal.NewFromFloat(b.MinPercentage),
+			MaxPercentage:     decimal.NewFromFloat(b.MaxPercentage),
+			Grade:             b.Grade,
+			Label:             b.Label,
+			IsUnderPerforming: b.IsUnderPerforming,
+		})
+	}
+	sort.Slice(scale.Bands, func(i, j int) bool { return scale.Bands[i].MinPercentage.LessThan(scale.Bands[j].MinPercentage) })
+	return scale
+}
+
+func builtInGradingScaleVm() performance.GradingScaleVm {
+	def := DefaultGradeScale()
+	vm := performance.GradingScaleVm{
+		Name:      def.Name,
+		Version:   def.Version,
+		IsBuiltIn: true,
+	}
+	vm.IsActive = true
+	for _, b := range def.Bands {
+		lo, _ := b.MinPercentage.Float64()
+		hi, _ := b.MaxPercentage.Float64()
+		vm.Bands = append(vm.Bands, performance.GradingScaleBandVm{
+			MinPercentage:     lo,
+			MaxPercentage:     hi,
+			Grade:             int(b.Grade),
+			GradeName:         b.Grade.String(),
+			Label:             b.Label,
+			IsUnderPerforming: b.IsUnderPerforming,
+		})
+	}
+	return vm
+}
+
+func toGradingScaleVm(gs performance.GradingScale) performance.GradingScaleVm {
+	vm := performance.GradingScaleVm{
+		BaseEntityVm:   toBaseEntityVm(gs.BaseEntity),
+		GradingScaleID: gs.GradingScaleID,
+		Name:           gs.Name,
+		Description:    gs.Description,
+		Version:        gs.Version,
+	}
+	bands := make([]performance.GradingScaleBand, len(gs.Bands))
+	copy(bands, gs.Bands)
+	sort.Slice(bands, func(i, j int) bool { return bands[i].MinPercentage < bands[j].MinPercentage })
+	for _, b := range bands {
+		vm.Bands = append(vm.Bands, performance.GradingScaleBandVm{
+			GradingScaleBandID: b.GradingScaleBandID,
+			MinPercentage:      b.MinPercentage,
+			MaxPercentage:      b.MaxPercentage,
+			Grade:              int(b.Grade),
+			GradeName:          b.Grade.String(),
+			Label:              b.Label,
+			IsUnderPerforming:  b.IsUnderPerforming,
+		})
+	}
+	return vm
+}
@@ -33,6 +33,8 @@ type grievanceManagementService struct {
 	globalSettingSvc GlobalSettingService
 	userContextSvc   UserContextService
 	reviewPeriodSvc  ReviewPeriodService
+	scope            DataScopeService
+	notificationSvc  NotificationService
 	cfg              *config.Config
 	log              zerolog.Logger
 }
@@ -46,6 +48,8 @@ func newGrievanceManagementService(
 	ucSvc UserContextService,
 	emailSvc EmailService,
 	rpSvc ReviewPeriodService,
+	scope DataScopeService,
+	notificationSvc NotificationService,
 ) GrievanceManagementService {
 	return &grievanceManagementService{
 		grievanceRepo:    repository.NewPMSRepository[performance.Grievance](repos.GormDB),
@@ -57,6 +61,8 @@ func newGrievanceManagementService(
 		globalSettingSvc: gsSvc,
 		userContextSvc:   ucSvc,
 		reviewPeriodSvc:  rpSvc,
+		scope:            scope,
+		notificationSvc:  notificationSvc,
 		cfg:              cfg,
 		log:              log.With().Str("service", "grievance").Logger(),
 	}
@@ -72,6 +78,10 @@ func (s *grievanceManagementService) RaiseNewGrievance(ctx context.Context, req
 		return nil, errors.New("invalid request type: expected *GrievanceVm")
 	}
 
+	if err := s.scope.AuthorizeStaff(ctx, vm.ComplainantStaffID); err != nil {
+		return nil, err
+	}
+
 	s.log.Info().Str("complainant", vm.ComplainantStaffID).Msg("raising new grievance")
 
 	// Generate a unique grievance ID from the sequence table.
@@ -108,8 +118,18 @@ func (s *grievanceManagementService) RaiseNewGrievance(ctx context.Context, req
 	}
 	grievance.RecordStatus = enums.StatusAwaitingRespondentComment.String()
 
-	if err := s.grievanceRepo.InsertAndSave(ctx, &grievance); err != nil {
-		return nil, fmt.Errorf("saving new grievance: %w", err)
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := attachFileUpload(ctx, tx, grievance.ComplainantEvidenceUpload, grievance.ComplainantStaffID,
+			performance.FileEntityGrievance, grievanceID); err != nil {
+			return err
+		}
+		if err := tx.Create(&grievance).Error; err != nil {
+			return fmt.Errorf("saving new grievance: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	s.log.Info().Str("grievanceId", grievanceID).Msg("grievance raised successfully")
@@ -140,13 +160,26 @@ func (s *grievanceManagementService) UpdateGrievance(ctx context.Context, req in
 	if grievance == nil {
 		return nil, fmt.Errorf("no record found for grievance(%s)", vm.GrievanceID)
 	}
+	if err := s.authorizeGrievance(ctx, grievance); err != nil {
+		return nil, err
+	}
 
 	grievance.RespondentComment = vm.RespondentComment
 	grievance.RespondentEvidenceUpload = vm.RespondentEvidenceUpload
 	grievance.RecordStatus = enums.StatusPendingResolution.String()
 
-	if err := s.grievanceRepo.UpdateAndSave(ctx, grievance); err != nil {
-		return nil, fmt.Errorf("updating grievance: %w", err)
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := attachFileUpload(ctx, tx, grievance.RespondentEvidenceUpload, grievance.RespondentStaffID,
+			performance.FileEntityGrievance, grievance.GrievanceID); err != nil {
+			return err
+		}
+		if err := tx.Save(grievance).Error; err != nil {
+			return fmt.Errorf("updating grievance: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	s.log.Info().Str("grievanceId", vm.GrievanceID).Msg("grievance updated successfully")
@@ -182,6 +215,10 @@ func (s *grievanceManagementService) CreateGrievanceResolution(ctx context.Conte
 		tx.Rollback()
 		return nil, err
 	}
+	if err := s.authorizeGrievance(ctx, grievance); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
 
 	// Generate a unique resolution ID.
 	resolutionID, err := s.generateCode(ctx, enums.SeqGrievanceComment, 8)
@@ -237,6 +274,11 @@ func (s *grievanceManagementService) CreateGrievanceResolution(ctx context.Conte
 		grievance.RecordStatus = enums.StatusResolvedAwaitingFeedback.String()
 	}
 
+	if err := attachFileUpload(ctx, tx, resolution.EvidenceUpload, resolution.MediatorStaffID,
+		performance.FileEntityGrievance, grievance.GrievanceID); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
 	if err := tx.Create(&resolution).Error; err != nil {
 		tx.Rollback()
 		return nil, fmt.Errorf("saving grievance resolution: %w", err)
@@ -288,6 +330,10 @@ func (s *grievanceManagementService) UpdateGrievanceResolution(ctx context.Conte
 		tx.Rollback()
 		return nil, err
 	}
+	if err := s.authorizeGrievance(ctx, grievance); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
 
 	var resolution performance.GrievanceResolution
 	if err := tx.Where("grievance_resolution_id = ? AND soft_deleted = false", vm.GrievanceResolutionID).
@@ -345,6 +391,11 @@ func (s *grievanceManagementService) UpdateGrievanceResolution(ctx context.Conte
 		s.log.Info().Str("grievanceId", vm.GrievanceID).Msg("grievance closed by mutual acceptance")
 	}
 
+	if err := attachFileUpload(ctx, tx, resolution.EvidenceUpload, resolution.MediatorStaffID,
+		performance.FileEntityGrievance, grievance.GrievanceID); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
 	if err := tx.Save(&resolution).Error; err != nil {
 		tx.Rollback()
 		return nil, fmt.Errorf("updating resolution: %w", err)
@@ -372,6 +423,10 @@ func (s *grievanceManagementService) UpdateGrievanceResolution(ctx context.Conte
 // Maps to .NET GetStaffGrievances.
 // ---------------------------------------------------------------------------
 func (s *grievanceManagementService) GetStaffGrievances(ctx context.Context, staffID string) (interface{}, error) {
+	if err := s.scope.AuthorizeStaff(ctx, staffID); err != nil {
+		return nil, err
+	}
+
 	s.log.Info().Str("staffId", staffID).Msg("fetching staff grievances")
 
 	// Fetch grievances where the staff is complainant, respondent, or current mediator.
@@ -447,6 +502,11 @@ func (s *grievanceManagementService) GetGrievancesReport(ctx context.Context) (i
 		return nil, fmt.Errorf("querying all grievances: %w", err)
 	}
 
+	grievances, err = s.grievancesInScope(ctx, grievances)
+	if err != nil {
+		return nil, err
+	}
+
 	vmList, err := s.mapGrievancesToVm(ctx, grievances)
 	if err != nil {
 		return nil, err
@@ -476,6 +536,37 @@ func (s *grievanceManagementService) GetGrievancesReport(ctx context.Context) (i
 // Private helpers
 // ===========================================================================
 
+// authorizeGrievance rejects callers who reach none of the grievance's
+// parties.
+func (s *grievanceManagementService) authorizeGrievance(ctx context.Context, g *performance.Grievance) error {
+	return s.scope.AuthorizeAnyStaff(ctx, g.ComplainantStaffID, g.RespondentStaffID, g.CurrentMediatorStaffID)
+}
+
+// grievancesInScope keeps the grievances with at least one party within the
+// caller's data scope.
+func (s *grievanceManagementService) grievancesInScope(ctx context.Context, grievances []performance.Grievance) ([]performance.Grievance, error) {
+	parties := make([]string, 0, len(grievances)*3)
+	for _, g := range grievances {
+		parties = append(parties, g.ComplainantStaffID, g.RespondentStaffID, g.CurrentMediatorStaffID)
+	}
+	visible, err := s.scope.FilterStaff(ctx, parties)
+	if err != nil {
+		return nil, err
+	}
+	inScope := make(map[string]bool, len(visible))
+	for _, id := range visible {
+		inScope[id] = true
+	}
+
+	result := grievances[:0]
+	for _, g := range grievances {
+		if inScope[g.ComplainantStaffID] || inScope[g.RespondentStaffID] || inScope[g.CurrentMediatorStaffID] {
+			result = append(result, g)
+		}
+	}
+	return result, nil
+}
+
 // resolutionLevelName returns the human-readable name for a ResolutionLevel.
 // Mirrors .NET's Humanize() call on the ResolutionLevl enum.
 func resolutionLevelName(level enums.ResolutionLevel) string {
@@ -760,20 +851,27 @@ func (s *grievanceManagementService) sendGrievanceResolutionEmail(
 	hrdGrievanceNotificationMail string,
 	actionDescription string,
 ) {
+	n := grievanceNotification(grievance, performance.NotificationTypeGrievanceResolution,
+		"Grievance resolution: "+grievance.Subject,
+		"The grievance raised on this subject "+actionDescription+".")
+
 	if s.emailSvc == nil {
 		s.log.Warn().Msg("email service not configured, skipping grievance resolution notification")
+		s.notify(ctx, n)
 		return
 	}
 
 	complainant, err := s.getEmployeeData(ctx, grievance.ComplainantStaffID)
 	if err != nil {
 		s.log.Warn().Err(err).Msg("failed to fetch complainant for resolution email")
+		s.notify(ctx, n)
 		return
 	}
 
 	respondent, err := s.getEmployeeData(ctx, grievance.RespondentStaffID)
 	if err != nil {
 		s.log.Warn().Err(err).Msg("failed to fetch respondent for resolution email")
+		s.notify(ctx, n)
 		return
 	}
 
@@ -793,7 +891,7 @@ func (s *grievanceManagementService) sendGrievanceResolutionEmail(
 		emailTo = s.getEmployeeEmail(ctx, grievance.ComplainantStaffID)
 	}
 
-	if err := s.emailSvc.SendEmail(ctx, emailTo, subject, body); err != nil {
+	if err := s.deliver(ctx, n, &NotificationEmail{To: emailTo, Subject: subject, Body: body}); err != nil {
 		s.log.Warn().Err(err).Str("action", action).Msg("failed to send resolution email to complainant")
 	}
 
@@ -825,20 +923,27 @@ func (s *grievanceManagementService) sendGrievanceEscalationEmail(
 	useActualUserMail bool,
 	hrdGrievanceNotificationMail string,
 ) {
+	n := grievanceNotification(grievance, performance.NotificationTypeGrievanceEscalation,
+		"Grievance escalated: "+grievance.Subject,
+		"The grievance raised on this subject has been escalated to "+resolutionLevelName(grievance.CurrentResolutionLevel)+".")
+
 	if s.emailSvc == nil {
 		s.log.Warn().Msg("email service not configured, skipping grievance escalation notification")
+		s.notify(ctx, n)
 		return
 	}
 
 	complainant, err := s.getEmployeeData(ctx, grievance.ComplainantStaffID)
 	if err != nil {
 		s.log.Warn().Err(err).Msg("failed to fetch complainant for escalation email")
+		s.notify(ctx, n)
 		return
 	}
 
 	respondent, err := s.getEmployeeData(ctx, grievance.RespondentStaffID)
 	if err != nil {
 		s.log.Warn().Err(err).Msg("failed to fetch respondent for escalation email")
+		s.notify(ctx, n)
 		return
 	}
 
@@ -859,7 +964,7 @@ func (s *grievanceManagementService) sendGrievanceEscalationEmail(
 		emailTo = s.getEmployeeEmail(ctx, grievance.ComplainantStaffID)
 	}
 
-	if err := s.emailSvc.SendEmail(ctx, emailTo, subject, body); err != nil {
+	if err := s.deliver(ctx, n, &NotificationEmail{To: emailTo, Subject: subject, Body: body}); err != nil {
 		s.log.Warn().Err(err).Str("action", action).Msg("failed to send escalation email to complainant")
 	}
 
@@ -888,6 +993,39 @@ func (s *grievanceManagementService) sendGrievanceEscalationEmail(
 	}
 }
 
+// notify adds a notification to the recipient's inbox. Failures are logged:
+// the inbox never blocks the grievance workflow.
+func (s *grievanceManagementService) notify(ctx context.Context, n performance.Notification) {
+	if s.notificationSvc == nil {
+		return
+	}
+	if err := s.notificationSvc.Notify(ctx, n); err != nil {
+		s.log.Warn().Err(err).Str("type", n.Type).Str("recipient", n.RecipientStaffID).Msg("failed to add notification to inbox")
+	}
+}
+
+// deliver sends a notification and its email as the recipient's
+// notification preferences allow. Without a notification service the email
+// is sent directly.
+func (s *grievanceManagementService) deliver(ctx context.Context, n performance.Notification, email *NotificationEmail) error {
+	if s.notificationSvc != nil {
+		return s.notificationSvc.Deliver(ctx, n, email)
+	}
+	return s.emailSvc.SendEmail(ctx, email.To, email.Subject, email.Body)
+}
+
+// grievanceNotification builds the complainant's inbox entry about a grievance.
+func grievanceNotification(grievance *performance.Grievance, notificationType, title, message string) performance.Notification {
+	return performance.Notification{
+		RecipientStaffID: grievance.ComplainantStaffID,
+		Type:             notificationType,
+		Title:            title,
+		Message:          message,
+		EntityType:       performance.NotificationEntityGrievance,
+		EntityID:         grievance.GrievanceID,
+	}
+}
+
 // getEmployeeEmail fetches an employee's email address by staff ID.
 // Returns empty string on failure (best-effort).
 func (s *grievanceManagementService) getEmployeeEmail(ctx context.Context, staffID string) string {
@@ -939,6 +1077,7 @@ func (s *grievanceManagementService) LogRequestAsync(
 	}
 
 	// Check if a log already exists for this reference + assignee + type.
+	var requestID string
 	var existingLog performance.FeedbackRequestLog
 	err = s.db.WithContext(ctx).
 		Where("reference_id = ? AND assigned_staff_id = ? AND feedback_request_type = ? AND soft_deleted = false",
@@ -955,6 +1094,7 @@ func (s *grievanceManagementService) LogRequestAsync(
 		if err := s.db.WithContext(ctx).Save(&existingLog).Error; err != nil {
 			return fmt.Errorf("updating existing feedback request log: %w", err)
 		}
+		requestID = existingLog.FeedbackRequestLogID
 	} else if errors.Is(err, gorm.ErrRecordNotFound) {
 		// Create new log.
 		logID, err := s.generateCode(ctx, enums.SeqFeedbackRequest, 15)
@@ -1002,12 +1142,13 @@ func (s *grievanceManagementService) LogRequestAsync(
 		if err := s.db.WithContext(ctx).Create(&newLog).Error; err != nil {
 			return fmt.Errorf("creating feedback request log: %w", err)
 		}
+		requestID = newLog.FeedbackRequestLogID
 	} else {
 		return fmt.Errorf("querying feedback request log: %w", err)
 	}
 
 	// Send notification email to assignee.
-	s.sendAssigneeNotificationEmail(ctx, assigneeStaffID, assignee.FullName, feedbackRequestType)
+	s.sendAssigneeNotificationEmail(ctx, assigneeStaffID, assignee.FullName, requestID, feedbackRequestType)
 
 	return nil
 }
@@ -1034,7 +1175,9 @@ func (s *grievanceManagementService) resolveReviewPeriodID(
 	}
 
 	// Fall back to staff-specific active period.
-	rpResult, err = s.reviewPeriodSvc.GetStaffActiveReviewPeriod(ctx, assigneeStaffID)
+	// The assignee is usually outside the caller's data scope; the lookup
+	// only picks the period to log the request against.
+	rpResult, err = s.reviewPeriodSvc.GetStaffActiveReviewPeriod(WithSystemScope(ctx), assigneeStaffID)
 	if err == nil && rpResult != nil {
 		if !rpResult.HasError && rpResult.PerformanceReviewPeriod != nil {
 			return rpResult.PerformanceReviewPeriod.PeriodID
@@ -1055,21 +1198,28 @@ func (s *grievanceManagementService) resolveReviewPeriodID(
 }
 
 // sendAssigneeNotificationEmail sends an email notification to the assigned
-// staff member about a new feedback request.
+// staff member about a new feedback request and adds it to their inbox.
 // Mirrors the .NET email sending at the end of LogRequestAsync.
 func (s *grievanceManagementService) sendAssigneeNotificationEmail(
 	ctx context.Context,
 	assigneeStaffID string,
 	assigneeName string,
+	requestID string,
 	feedbackRequestType enums.FeedbackRequestType,
 ) {
+	requestTypeName := feedbackRequestTypeName(feedbackRequestType)
+	n := feedbackRequestNotification(assigneeStaffID, performance.NotificationTypeNewRequest, requestID,
+		"New request: "+requestTypeName,
+		fmt.Sprintf("You have been assigned a request on %s.", requestTypeName))
+	n.Category = feedbackNotificationCategory(feedbackRequestType)
+
 	if s.emailSvc == nil {
+		s.notify(ctx, n)
 		return
 	}
 
 	useActualUserMail, _ := s.getGlobalBool(ctx, "USE_ACTUAL_USER_MAIL")
 
-	requestTypeName := feedbackRequestTypeName(feedbackRequestType)
 	action := fmt.Sprintf("PMS_%s", feedbackRequestTypeEnumName(feedbackRequestType))
 	subject := fmt.Sprintf("NEW ASSIGNED REQUEST ON: %s", strings.ToUpper(requestTypeName))
 	now := time.Now().Format("02 Jan 2006, 03:04:05 PM")
@@ -1086,7 +1236,7 @@ func (s *grievanceManagementService) sendAssigneeNotificationEmail(
 		emailTo = s.getEmployeeEmail(ctx, assigneeStaffID)
 	}
 
-	if err := s.emailSvc.SendEmail(ctx, emailTo, subject, body); err != nil {
+	if err := s.deliver(ctx, n, &NotificationEmail{To: emailTo, Subject: subject, Body: body}); err != nil {
 		s.log.Warn().Err(err).Str("action", action).Msg("failed to send assignee notification email")
 	}
 }
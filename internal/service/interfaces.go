@@ -2,9 +2,11 @@ package service
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"github.com/enterprise-pms/pms-api/internal/domain/auth"
+	"github.com/enterprise-pms/pms-api/internal/domain/competency"
 	"github.com/enterprise-pms/pms-api/internal/domain/enums"
 	"github.com/enterprise-pms/pms-api/internal/domain/performance"
 )
@@ -65,6 +67,8 @@ type PerformanceManagementService interface {
 	GetConsolidatedObjectives(ctx context.Context) (interface{}, error)
 	GetConsolidatedObjectivesPaginated(ctx context.Context, params interface{}) (interface{}, error)
 	ProcessObjectivesUpload(ctx context.Context, req interface{}) (interface{}, error)
+	ImportObjectives(ctx context.Context, req *performance.ObjectiveImportRequestModel) (performance.ObjectiveImportResponseVm, error)
+	GetObjectiveUploadTemplate(ctx context.Context, strategyID string) (performance.ObjectiveUploadTemplateVm, error)
 	DeActivateOrReactivateObjectives(ctx context.Context, req interface{}, deactivate bool) (interface{}, error)
 
 	// Evaluation Options & Questionnaires (via objectiveService)
@@ -201,6 +205,9 @@ type PerformanceManagementService interface {
 	GetReviewerFeedbackDetails(ctx context.Context, reviewerID string) (performance.CompetencyReviewersResponseVm, error)
 	GetQuestionnaire(ctx context.Context, staffID string) (performance.QuestionnaireListResponseVm, error)
 	CompetencyGapClosureSetup(ctx context.Context, req *performance.CompetencyGapClosureRequestModel) (performance.ResponseVm, error)
+	GetPendingCompetencyGapClosures(ctx context.Context) ([]performance.CompetencyGapClosureRequestModel, error)
+	CreditCompetencyGapClosure(ctx context.Context, req *performance.CompetencyGapClosureRequestModel) (performance.ResponseVm, error)
+	GetCompetencyGapClosureReport(ctx context.Context, reviewPeriodID string) (performance.CompetencyGapClosureReportResponseVm, error)
 	Initiate360Review(ctx context.Context, req *performance.Initiate360ReviewRequestModel) (performance.ResponseVm, error)
 	Complete360Review(ctx context.Context, req *performance.Complete360ReviewRequestModel) (performance.ResponseVm, error)
 
@@ -273,6 +280,134 @@ type PmsSetupService interface {
 	ListAllPmsConfigurations(ctx context.Context) (interface{}, error)
 }
 
+// --- Approval Chains ---
+
+// ApprovalChainService manages configurable multi-stage approval chains and
+// builds WorkflowEngine rule sets from the active chain of an entity type.
+type ApprovalChainService interface {
+	// Setup
+	GetApprovalChains(ctx context.Context) (performance.ApprovalChainListResponseVm, error)
+	GetApprovalChain(ctx context.Context, chainID string) (performance.ApprovalChainResponseVm, error)
+	SaveApprovalChain(ctx context.Context, req *performance.ApprovalChainRequestModel) (performance.ResponseVm, error)
+	DeleteApprovalChain(ctx context.Context, chainID string) (performance.ResponseVm, error)
+
+	// Runtime
+	GetActiveStages(ctx context.Context, entityType enums.ApprovalEntityType) ([]performance.ApprovalChainStage, error)
+	ResolveWorkflowEngine(ctx context.Context, entityType enums.ApprovalEntityType) (*WorkflowEngine, error)
+	ResolveStageApprovers(ctx context.Context, entityType enums.ApprovalEntityType, pendingStatus enums.Status, ownerStaffID string) ([]string, error)
+	ResolveApprovers(ctx context.Context, resolver enums.ApproverResolverType, roleName, ownerStaffID string) ([]string, error)
+}
+
+// --- SLA Escalation ---
+
+// SLAEscalationService manages the escalation ladder of each feedback
+// request type and fires its steps as pending requests use up their SLA.
+type SLAEscalationService interface {
+	// Setup
+	GetSLAEscalationLadders(ctx context.Context) (performance.SLAEscalationLadderListResponseVm, error)
+	SaveSLAEscalationLadder(ctx context.Context, req *performance.SLAEscalationLadderRequestModel) (performance.SLAEscalationLadderListResponseVm, error)
+	DeleteSLAEscalationLadder(ctx context.Context, feedbackRequestType int, deletedBy string) (performance.ResponseVm, error)
+
+	// SendSLAEscalations fires the steps due for pending requests and
+	// returns how many requests were escalated.
+	SendSLAEscalations(ctx context.Context) (int, error)
+}
+
+// --- Grading Scales ---
+
+// GradingScaleService manages versioned grading scales and resolves the scale
+// used to grade a review period's scores.
+type GradingScaleService interface {
+	// Setup
+	GetGradingScales(ctx context.Context) (performance.GradingScaleListResponseVm, error)
+	GetGradingScale(ctx context.Context, scaleID string) (performance.GradingScaleResponseVm, error)
+	SaveGradingScale(ctx context.Context, req *performance.GradingScaleRequestModel) (performance.ResponseVm, error)
+	DeleteGradingScale(ctx context.Context, scaleID string) (performance.ResponseVm, error)
+	AssignGradingScale(ctx context.Context, req *performance.GradingScaleAssignmentRequestModel) (performance.ResponseVm, error)
+
+	// Runtime
+	ResolveForReviewPeriod(ctx context.Context, period performance.PerformanceReviewPeriod) (GradeScale, error)
+	ResolveForReviewPeriodID(ctx context.Context, reviewPeriodID string) (GradeScale, error)
+}
+
+// --- Calibration ---
+
+// CalibrationService calibrates period-score grades of a closed review period
+// against configured grade-distribution quotas.
+type CalibrationService interface {
+	// Setup
+	GetCalibrationQuotas(ctx context.Context, reviewPeriodID string) (performance.CalibrationQuotaListResponseVm, error)
+	SaveCalibrationQuotas(ctx context.Context, req *performance.CalibrationQuotaRequestModel) (performance.ResponseVm, error)
+
+	// Sessions
+	StartCalibration(ctx context.Context, req *performance.StartCalibrationRequestModel) (performance.ResponseVm, error)
+	GetCalibrationSessions(ctx context.Context, reviewPeriodID string) (performance.CalibrationSessionListResponseVm, error)
+	GetCalibrationSession(ctx context.Context, sessionID string) (performance.CalibrationSessionResponseVm, error)
+	DecideCalibrationEntry(ctx context.Context, req *performance.CalibrationDecisionRequestModel) (performance.ResponseVm, error)
+	FinalizeCalibration(ctx context.Context, sessionID, finalizedBy string) (performance.ResponseVm, error)
+}
+
+// --- Background Jobs ---
+
+// BackgroundJobService exposes the persistent background job queue to
+// administrators.
+type BackgroundJobService interface {
+	ListJobs(ctx context.Context, search *performance.BackgroundJobSearchModel) (performance.BackgroundJobListResponseVm, error)
+	GetJob(ctx context.Context, jobID string) (performance.BackgroundJobResponseVm, error)
+	RetryJob(ctx context.Context, jobID, requestedBy string) (performance.ResponseVm, error)
+	CancelJob(ctx context.Context, jobID, requestedBy string) (performance.ResponseVm, error)
+}
+
+// EmailDeliveryService lets administrators find emails the mail sender
+// could not deliver and send them again.
+type EmailDeliveryService interface {
+	ListUndeliveredEmails(ctx context.Context, search *performance.EmailDeliverySearchModel) (performance.EmailDeliveryListResponseVm, error)
+	GetEmailDelivery(ctx context.Context, emailID int) (performance.EmailDeliveryResponseVm, error)
+	ResendEmails(ctx context.Context, req *performance.EmailResendRequestModel) (performance.ResponseVm, error)
+}
+
+// RecurringJobService lets administrators control the scheduler's recurring
+// jobs.
+type RecurringJobService interface {
+	ListRecurringJobs(ctx context.Context) (performance.RecurringJobListResponseVm, error)
+	UpdateSchedule(ctx context.Context, req *performance.RecurringJobScheduleRequestModel) (performance.ResponseVm, error)
+	PauseJob(ctx context.Context, jobName, requestedBy string) (performance.ResponseVm, error)
+	ResumeJob(ctx context.Context, jobName, requestedBy string) (performance.ResponseVm, error)
+	TriggerJob(ctx context.Context, jobName, requestedBy string) (performance.ResponseVm, error)
+}
+
+// --- Audit Trail ---
+
+// AuditTrailService reconstructs the history of audited records from
+// pmsaudit.audit_logs.
+type AuditTrailService interface {
+	GetEntityTimeline(ctx context.Context, tableName, recordID string) (performance.EntityTimelineResponseVm, error)
+	GetEntityStateAsOf(ctx context.Context, tableName, recordID string, asOf time.Time) (performance.EntityStateResponseVm, error)
+	DiffEntityStates(ctx context.Context, tableName, recordID string, from, to time.Time) (performance.EntityDiffResponseVm, error)
+}
+
+// AuditConfigService manages which entities and columns are audited.
+type AuditConfigService interface {
+	GetAuditConfiguration(ctx context.Context) (performance.AuditableEntityListResponseVm, error)
+	SaveAuditableEntity(ctx context.Context, req *performance.AuditableEntityRequestModel) (performance.ResponseVm, error)
+	SaveAuditableAttribute(ctx context.Context, req *performance.AuditableAttributeRequestModel) (performance.ResponseVm, error)
+	DeleteAuditableAttribute(ctx context.Context, entityName, attributeName, deletedBy string) (performance.ResponseVm, error)
+}
+
+// --- Approval Delegations ---
+
+// DelegationService manages date-bounded approval delegations and authorises
+// approve/reject/return actions taken directly or on behalf of an approver.
+type DelegationService interface {
+	CreateDelegation(ctx context.Context, req *performance.ApprovalDelegationRequestModel) (performance.ResponseVm, error)
+	RevokeDelegation(ctx context.Context, delegationID, staffID string) (performance.ResponseVm, error)
+	GetDelegations(ctx context.Context, staffID string) (performance.ApprovalDelegationListResponseVm, error)
+
+	FindActiveDelegation(ctx context.Context, delegatorStaffID string, entityType enums.ApprovalEntityType, at time.Time) (*performance.ApprovalDelegation, error)
+	AuthorizeApproval(ctx context.Context, entityType enums.ApprovalEntityType, recordStatus, ownerStaffID, actorStaffID string) (ApprovalActor, error)
+	RecordApprovalAction(ctx context.Context, actor ApprovalActor, tableName, recordID string, op enums.OperationType)
+}
+
 // --- Review Period ---
 
 // ReviewPeriodService manages review periods and objectives planning.
@@ -479,6 +614,9 @@ type RoleManagementService interface {
 	AddPermissionToRole(ctx context.Context, req interface{}) (interface{}, error)
 	// RemovePermissionFromRole removes a permission from a role.
 	RemovePermissionFromRole(ctx context.Context, roleId string, permissionId int) (interface{}, error)
+	// RegisterPermissions adds missing permissions, granting each new one to
+	// its default roles, and returns how many were added.
+	RegisterPermissions(ctx context.Context, defs []auth.PermissionDefinition) (int, error)
 }
 
 // --- Global Settings ---
@@ -496,9 +634,27 @@ type GlobalSettingService interface {
 // AuthService handles user authentication and token management.
 type AuthService interface {
 	AuthenticateAD(ctx context.Context, username, password string) (interface{}, error)
+	// VerifyMFA exchanges an MFA challenge and RSA SecurID token code for the JWT pair.
+	VerifyMFA(ctx context.Context, challengeToken, tokenCode string) (*auth.AuthenticateResponse, error)
+	// BeginOIDCLogin starts a single sign-on login at the OIDC provider.
+	BeginOIDCLogin(ctx context.Context) (*auth.OIDCLoginResponse, error)
+	// CompleteOIDCLogin exchanges the provider's code and state for the JWT
+	// pair, or for an MFA challenge as AuthenticateAD does.
+	CompleteOIDCLogin(ctx context.Context, code, state string) (interface{}, error)
 	GenerateTokenPair(ctx context.Context, userID string, roles []string) (accessToken string, refreshToken string, err error)
 	ValidateToken(ctx context.Context, token string) (claims interface{}, err error)
 	RefreshAccessToken(ctx context.Context, refreshToken string) (*auth.TokenResponse, error)
+
+	// IsSessionActive reports whether an access token's session may still be used.
+	IsSessionActive(ctx context.Context, sessionID string) (bool, error)
+	// Logout revokes the caller's current session.
+	Logout(ctx context.Context, userID, sessionID string) error
+	// ListSessions returns the user's active sessions.
+	ListSessions(ctx context.Context, userID, currentSessionID string) ([]auth.SessionVm, error)
+	// RevokeSession revokes one of the user's own sessions.
+	RevokeSession(ctx context.Context, userID, sessionID string) error
+	// RevokeAllSessions signs a user out on every device.
+	RevokeAllSessions(ctx context.Context, userID, revokedBy string) (int, error)
 }
 
 // --- Email ---
@@ -518,29 +674,72 @@ type EmailService interface {
 
 // --- File Storage ---
 
-// FileStorageService handles file upload and storage.
+// FileStorageService handles file upload and storage on the configured
+// backend (local filesystem or S3-compatible object store).
 type FileStorageService interface {
-	SaveFile(ctx context.Context, fileName string, data []byte) (path string, err error)
-	DeleteFile(ctx context.Context, path string) error
-	GetFile(ctx context.Context, path string) ([]byte, error)
+	SaveFile(ctx context.Context, fileName string, data []byte) (key string, err error)
+	DeleteFile(ctx context.Context, key string) error
+	GetFile(ctx context.Context, key string) ([]byte, error)
+	// Upload validates, checksums and stores a file and records its metadata.
+	Upload(ctx context.Context, req *performance.FileUploadRequestModel, body io.Reader) (*performance.FileUploadResponseVm, error)
+	// GetFileInfo returns the metadata of a stored file.
+	GetFileInfo(ctx context.Context, fileUploadID string) (*performance.FileUploadResponseVm, error)
+	// GetDownloadURL issues a signed, time-limited download link bound to userID.
+	GetDownloadURL(ctx context.Context, fileUploadID, userID string) (*performance.FileDownloadURLResponseVm, error)
+	// OpenDownload verifies a download link for userID and opens the file.
+	OpenDownload(ctx context.Context, fileUploadID, userID string, expiresUnix int64, signature string) (*performance.FileUpload, io.ReadCloser, error)
 }
 
 // --- Notification ---
 
-// NotificationService handles email notifications using templates.
+// NotificationService handles email notifications using templates and the
+// in-app notification inbox. Every templated email is also added to the
+// recipient's inbox; Notify writes to the inbox alone. Each recipient's
+// per-category preferences decide whether the email goes out at once, in
+// the daily digest, or not at all.
 // Mirrors the .NET NotificationService + NotificationTemplates pattern.
 type NotificationService interface {
-	// Send sends a plain-text/HTML notification email.
+	// Send sends a plain-text/HTML notification email and adds it to the user's inbox.
 	Send(ctx context.Context, userID string, message string) error
 	// SendNewRequestNotification sends a notification for a new feedback request.
 	// Uses SLA template if hasSLA is true, otherwise generic template.
-	SendNewRequestNotification(ctx context.Context, recipientEmail, recipientName, requestName string, assignedDate time.Time, hasSLA bool, slaHours int) error
+	SendNewRequestNotification(ctx context.Context, requestType enums.FeedbackRequestType, recipientStaffID, recipientEmail, recipientName, requestID, requestName string, assignedDate time.Time, hasSLA bool, slaHours int) error
 	// SendAssignerNewRequestNotification notifies the assigner that their request was initiated.
-	SendAssignerNewRequestNotification(ctx context.Context, recipientEmail, recipientName, requestName string, assignedDate time.Time) error
+	SendAssignerNewRequestNotification(ctx context.Context, requestType enums.FeedbackRequestType, recipientStaffID, recipientEmail, recipientName, requestID, requestName string, assignedDate time.Time) error
 	// SendRequestTreatedNotification notifies when a request is treated.
-	SendRequestTreatedNotification(ctx context.Context, recipientEmail, recipientName, requestName string, treatedDate time.Time) error
+	SendRequestTreatedNotification(ctx context.Context, requestType enums.FeedbackRequestType, recipientStaffID, recipientEmail, recipientName, requestID, requestName string, treatedDate time.Time) error
 	// SendAssignerRequestTreatedNotification notifies the assigner when their request is treated.
-	SendAssignerRequestTreatedNotification(ctx context.Context, recipientEmail, recipientName, requestName string, treatedDate time.Time) error
+	SendAssignerRequestTreatedNotification(ctx context.Context, requestType enums.FeedbackRequestType, recipientStaffID, recipientEmail, recipientName, requestID, requestName string, treatedDate time.Time) error
+
+	// Notify adds notifications to their recipients' inboxes without sending email.
+	Notify(ctx context.Context, notifications ...performance.Notification) error
+	// ListNotifications returns a page of a staff member's inbox, newest first.
+	ListNotifications(ctx context.Context, search *performance.NotificationSearchModel) (performance.NotificationListResponseVm, error)
+	// ListNotificationsAfter returns a staff member's notifications with an ID
+	// above afterID, oldest first. It feeds the live notification stream.
+	ListNotificationsAfter(ctx context.Context, staffID string, afterID int64) ([]performance.NotificationVm, error)
+	// MarkNotificationsRead marks notifications in a staff member's inbox as read.
+	MarkNotificationsRead(ctx context.Context, req *performance.NotificationMarkReadRequestModel) (performance.ResponseVm, error)
+	// MarkAllNotificationsRead marks a staff member's whole inbox as read.
+	MarkAllNotificationsRead(ctx context.Context, staffID string) (performance.ResponseVm, error)
+	// Subscribe returns a channel that receives a signal whenever this
+	// instance adds to the staff member's inbox, and a func that cancels it.
+	// The channel is closed when the subscription ends.
+	Subscribe(staffID string) (<-chan struct{}, func())
+	// CloseStreams ends every subscription; used on server shutdown.
+	CloseStreams()
+
+	// Deliver sends a notification to the inbox and, when email is not nil,
+	// by email, as the recipient's preference for its category allows.
+	Deliver(ctx context.Context, n performance.Notification, email *NotificationEmail) error
+	// GetNotificationPreferences returns a staff member's delivery mode for
+	// every notification category.
+	GetNotificationPreferences(ctx context.Context, staffID string) (performance.NotificationPreferenceListResponseVm, error)
+	// UpdateNotificationPreferences sets a staff member's delivery modes.
+	UpdateNotificationPreferences(ctx context.Context, req *performance.NotificationPreferenceRequestModel) (performance.NotificationPreferenceListResponseVm, error)
+	// SendDigests emails each staff member with pending digest items one
+	// summary of them and returns how many digests were sent.
+	SendDigests(ctx context.Context) (int, error)
 }
 
 // --- Encryption ---
@@ -559,6 +758,26 @@ type ActiveDirectoryService interface {
 	GetUser(username string) (interface{}, error)
 }
 
+// --- Data Scope ---
+
+// DataScopeService decides which staff records a caller may read and act
+// on, from their place in the ERP hierarchy. Checks fail with ErrOutOfScope.
+type DataScopeService interface {
+	// ScopeOf returns the narrowest level at which the caller reaches the
+	// staff member, or ScopeNone.
+	ScopeOf(ctx context.Context, staffID string) (DataScopeLevel, error)
+	// AuthorizeStaff rejects staff outside the caller's scope.
+	AuthorizeStaff(ctx context.Context, staffID string) error
+	// AuthorizeAnyStaff rejects records none of whose parties are within
+	// the caller's scope.
+	AuthorizeAnyStaff(ctx context.Context, staffIDs ...string) error
+	// AuthorizeOrgUnit rejects units the caller does not head, directly or
+	// through a unit above it.
+	AuthorizeOrgUnit(ctx context.Context, level enums.OrganogramLevel, unitID int) error
+	// FilterStaff returns the staff IDs within the caller's scope.
+	FilterStaff(ctx context.Context, staffIDs []string) ([]string, error)
+}
+
 // --- User Context ---
 
 // UserContextService provides current user information from the request.
@@ -687,6 +906,28 @@ type CompetencyService interface {
 	SyncJobRoleUpdateSOA(ctx context.Context, req interface{}) (interface{}, error)
 }
 
+// DevelopmentPlanService runs the development plan lifecycle: drafting from
+// competency gaps, progress, evidence, supervisor sign-off, target-date
+// reminders and completion reporting.
+type DevelopmentPlanService interface {
+	GetDevelopmentPlan(ctx context.Context, planID int) (competency.DevelopmentPlanResponseVm, error)
+	UpdateDevelopmentPlanStatus(ctx context.Context, req *competency.DevelopmentPlanStatusRequestModel) (competency.DevelopmentPlanResponseVm, error)
+	AddDevelopmentPlanEvidence(ctx context.Context, req *competency.DevelopmentPlanEvidenceRequestModel) (competency.DevelopmentPlanResponseVm, error)
+	RemoveDevelopmentPlanEvidence(ctx context.Context, planID, evidenceID int) (competency.DevelopmentPlanResponseVm, error)
+	GetDevelopmentPlanCompletion(ctx context.Context, search *competency.DevelopmentPlanCompletionSearchModel) (competency.DevelopmentPlanCompletionListResponseVm, error)
+
+	// SendDevelopmentPlanReminders is run by the development_plan_reminders job.
+	SendDevelopmentPlanReminders(ctx context.Context) (int, error)
+
+	// Drafting from competency gaps
+	GetDevelopmentPlanRules(ctx context.Context) (competency.DevelopmentPlanRuleListResponseVm, error)
+	SaveDevelopmentPlanRule(ctx context.Context, vm *competency.DevelopmentPlanRuleVm, savedBy string) (competency.BaseAPIResponse, error)
+	DeleteDevelopmentPlanRule(ctx context.Context, ruleID int, deletedBy string) (competency.BaseAPIResponse, error)
+	DraftDevelopmentPlans(ctx context.Context, req *competency.DevelopmentPlanDraftRequestModel) (competency.DevelopmentPlanDraftResultVm, error)
+	GetDevelopmentPlanDrafts(ctx context.Context, search *competency.DevelopmentPlanDraftSearchModel) (competency.DevelopmentPlanListResponseVm, error)
+	DecideDevelopmentPlanDrafts(ctx context.Context, req *competency.DevelopmentPlanDraftDecisionRequestModel) (competency.BaseAPIResponse, error)
+}
+
 // --- Bitly URL Shortening ---
 
 // BitlyService shortens URLs via the Bitly API.
@@ -25,9 +25,11 @@ func NewJWTService(cfg config.JWTConfig, log zerolog.Logger) *JWTService {
 }
 
 // TokenClaims contains the claims embedded in the JWT.
-// Includes: UserId, Email, FullName, Roles, Permissions, OrganizationalUnit.
+// Includes: UserId, Email, FullName, Roles, Permissions, OrganizationalUnit
+// and the SessionID that JWTAuth checks for revocation.
 type TokenClaims struct {
 	UserID             string   `json:"user_id"`
+	SessionID          string   `json:"sid"`
 	Email              string   `json:"email"`
 	Name               string   `json:"name"`
 	Roles              []string `json:"roles"`
@@ -54,6 +56,7 @@ func (s *JWTService) GenerateAccessToken(claims TokenClaims, expiryMinutes int)
 		"roles":               claims.Roles,
 		"permissions":         claims.Permissions,
 		"organizational_unit": claims.OrganizationalUnit,
+		"sid":                 claims.SessionID,
 		"iss":                 s.cfg.Issuer,
 		"aud":                 s.cfg.Audience,
 		"iat":                 time.Now().UTC().Unix(),
@@ -131,6 +134,9 @@ func (s *JWTService) ValidateToken(tokenString string) (*TokenClaims, error) {
 	if ou, ok := mapClaims["organizational_unit"].(string); ok {
 		claims.OrganizationalUnit = ou
 	}
+	if sid, ok := mapClaims["sid"].(string); ok {
+		claims.SessionID = sid
+	}
 
 	return claims, nil
 }
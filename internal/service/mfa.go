@@ -0,0 +1,224 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/auth"
+	"github.com/enterprise-pms/pms-api/internal/domain/identity"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ---------------------------------------------------------------------------
+// RSA SecurID second factor.
+//
+// When a user must complete MFA, login starts an RSA authentication attempt
+// and returns a short-lived challenge instead of tokens. VerifyMFA exchanges
+// the challenge and a token code for the JWT pair. Failed token codes count
+// towards the same lockout as failed passwords.
+// ---------------------------------------------------------------------------
+
+const (
+	// mfaChallengeTTL bounds how long a user has to enter a token code.
+	mfaChallengeTTL = 5 * time.Minute
+	// mfaMaxVerifyAttempts is the number of token codes accepted per
+	// challenge before the user has to log in again.
+	mfaMaxVerifyAttempts = 3
+
+	rsaTokenMethod       = "TOKEN"
+	rsaAttemptChallenge  = "CHALLENGE"
+	rsaAttemptSuccess    = "SUCCESS"
+	rsaMethodSuccessCode = "SUCCESS"
+)
+
+var errInvalidMFAChallenge = errors.New("invalid or expired MFA challenge")
+
+// mfaRequiredFor reports whether a user must complete a second factor: when
+// they have opted in, when MFA is required globally, or when they hold one
+// of the comma-separated requiredRoles.
+func mfaRequiredFor(twoFactorEnabled bool, roles []string, requireAll bool, requiredRoles string) bool {
+	if twoFactorEnabled || requireAll {
+		return true
+	}
+	for _, required := range strings.Split(requiredRoles, ",") {
+		required = strings.TrimSpace(required)
+		if required == "" {
+			continue
+		}
+		for _, role := range roles {
+			if strings.EqualFold(role, required) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// mfaRequired reads the MFA global settings and applies mfaRequiredFor.
+// Missing settings leave MFA off unless the user has opted in.
+func (s *authService) mfaRequired(ctx context.Context, user *identity.ApplicationUser, roles []string) bool {
+	requireAll, _ := s.gs.GetBoolValue(ctx, auth.SettingRequireMFA)
+	requiredRoles, _ := s.gs.GetStringValue(ctx, auth.SettingMFARequiredRoles)
+	return mfaRequiredFor(user.TwoFactorEnabled, roles, requireAll, requiredRoles)
+}
+
+// startRSAChallenge begins an RSA SecurID token authentication for subject
+// and returns the attempt context to answer with verifyRSAToken.
+func startRSAChallenge(ctx context.Context, rsa RSAAuthService, subject string) (*RSAContext, error) {
+	resp, err := rsa.Initialize(ctx, &RSAInitializeRequest{
+		AuthnAttemptTimeout: int(mfaChallengeTTL / time.Second),
+		SubjectName:         subject,
+		Lang:                "us_EN",
+		AuthMethodID:        rsaTokenMethod,
+		Context:             RSAInitializeContext{MessageID: uuid.NewString()},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.AttemptResponseCode != rsaAttemptChallenge || resp.Context.AuthnAttemptID == "" {
+		return nil, fmt.Errorf("rsa_auth: initialize returned %s (%s)", resp.AttemptResponseCode, resp.AttemptReasonCode)
+	}
+	return &resp.Context, nil
+}
+
+// verifyRSAToken submits tokenCode against an attempt started by
+// startRSAChallenge. It returns false for a rejected code and an error only
+// when RSA could not be asked.
+func verifyRSAToken(ctx context.Context, rsa RSAAuthService, authnAttemptID, inResponseTo, tokenCode string) (bool, error) {
+	resp, err := rsa.Verify(ctx, &RSAVerifyRequest{
+		SubjectCredentials: []SubjectCredential{{
+			MethodID:        rsaTokenMethod,
+			CollectedInputs: []CollectedInput{{Name: rsaTokenMethod, Value: tokenCode}},
+		}},
+		Context: RSAContext{
+			AuthnAttemptID: authnAttemptID,
+			MessageID:      uuid.NewString(),
+			InResponseTo:   inResponseTo,
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+	if resp.AttemptResponseCode != rsaAttemptSuccess {
+		return false, nil
+	}
+	for _, result := range resp.CredentialValidationResults {
+		if result.MethodID == rsaTokenMethod && result.MethodResponseCode != rsaMethodSuccessCode {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// issueMFAChallenge starts an RSA attempt for user and persists a challenge
+// for it, returning the plaintext challenge token to the client.
+func (s *authService) issueMFAChallenge(ctx context.Context, user *identity.ApplicationUser) (*auth.MFAChallengeResponse, error) {
+	rsaCtx, err := startRSAChallenge(ctx, s.rsa, user.UserName)
+	if err != nil {
+		s.log.Error().Err(err).Str("user", user.UserName).Msg("Failed to start RSA challenge")
+		return nil, fmt.Errorf("second factor is unavailable, please try again later")
+	}
+
+	token, err := s.jwt.GenerateRefreshToken()
+	if err != nil {
+		return nil, fmt.Errorf("generating MFA challenge: %w", err)
+	}
+	now := time.Now().UTC()
+	challenge := auth.MFAChallenge{
+		ID:             uuid.NewString(),
+		UserID:         user.ID,
+		Token:          hashToken(token),
+		AuthnAttemptID: rsaCtx.AuthnAttemptID,
+		MessageID:      rsaCtx.MessageID,
+		ExpiresAt:      now.Add(mfaChallengeTTL),
+		CreatedAt:      now,
+	}
+	if err := s.db.WithContext(ctx).Create(&challenge).Error; err != nil {
+		return nil, fmt.Errorf("storing MFA challenge: %w", err)
+	}
+
+	s.log.Info().Str("user", user.UserName).Msg("MFA challenge issued")
+	return &auth.MFAChallengeResponse{
+		MFARequired:    true,
+		ChallengeToken: token,
+		ExpiresAt:      challenge.ExpiresAt.Unix(),
+	}, nil
+}
+
+// VerifyMFA completes a login that returned an MFA challenge. A correct
+// token code consumes the challenge and issues the JWT pair; a wrong one
+// counts as a failed access attempt.
+func (s *authService) VerifyMFA(ctx context.Context, challengeToken, tokenCode string) (*auth.AuthenticateResponse, error) {
+	var challenge auth.MFAChallenge
+	err := s.db.WithContext(ctx).Where("token = ?", hashToken(challengeToken)).First(&challenge).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errInvalidMFAChallenge
+	}
+	if err != nil {
+		return nil, fmt.Errorf("looking up MFA challenge: %w", err)
+	}
+	if !challenge.IsUsable(time.Now().UTC()) {
+		return nil, errInvalidMFAChallenge
+	}
+
+	user, err := s.users.FindByID(ctx, challenge.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("looking up user: %w", err)
+	}
+	if user == nil || !user.IsActive {
+		return nil, fmt.Errorf("user account is deactivated")
+	}
+	if s.users.IsLockedOut(user) {
+		_ = s.consumeMFAChallenge(ctx, &challenge)
+		return nil, fmt.Errorf("account is locked out")
+	}
+
+	ok, err := verifyRSAToken(ctx, s.rsa, challenge.AuthnAttemptID, challenge.MessageID, tokenCode)
+	if err != nil {
+		s.log.Error().Err(err).Str("user", user.UserName).Msg("RSA token verification failed")
+		return nil, fmt.Errorf("second factor is unavailable, please try again later")
+	}
+	if !ok {
+		s.recordFailedAttempt(ctx, user)
+		updates := map[string]interface{}{"attempts": gorm.Expr("attempts + 1")}
+		if challenge.Attempts+1 >= mfaMaxVerifyAttempts {
+			updates["consumed_at"] = time.Now().UTC()
+		}
+		if err := s.db.WithContext(ctx).Model(&challenge).Updates(updates).Error; err != nil {
+			s.log.Error().Err(err).Str("challenge_id", challenge.ID).Msg("Failed to record MFA attempt")
+		}
+		s.log.Warn().Str("user", user.UserName).Msg("Invalid MFA token code")
+		return nil, fmt.Errorf("invalid token code")
+	}
+
+	if err := s.consumeMFAChallenge(ctx, &challenge); err != nil {
+		return nil, err
+	}
+	_ = s.users.ResetAccessFailedCount(ctx, user)
+
+	roles, err := s.resolveRoles(ctx, user)
+	if err != nil {
+		s.log.Warn().Err(err).Str("user", user.UserName).Msg("Failed to resolve dynamic roles")
+		roles = []string{auth.RoleStaff}
+	}
+	return s.issueSession(ctx, user, roles)
+}
+
+// consumeMFAChallenge marks a challenge used. Only one concurrent caller can
+// consume a challenge; the others get errInvalidMFAChallenge.
+func (s *authService) consumeMFAChallenge(ctx context.Context, challenge *auth.MFAChallenge) error {
+	result := s.db.WithContext(ctx).Model(&auth.MFAChallenge{}).
+		Where("id = ? AND consumed_at IS NULL", challenge.ID).
+		Update("consumed_at", time.Now().UTC())
+	if result.Error != nil {
+		return fmt.Errorf("consuming MFA challenge: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errInvalidMFAChallenge
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/enterprise-pms/pms-api/internal/config"
+	"github.com/rs/zerolog"
+)
+
+// stubRSAServer mimics the RSA SecurID /initialize and /verify endpoints.
+// It accepts validCode for any attempt it started and rejects everything
+// else.
+type stubRSAServer struct {
+	apiKey    string
+	validCode string
+	fail      bool
+
+	mu       sync.Mutex
+	attempts map[string]string // attempt ID -> initialize response message ID
+}
+
+func (s *stubRSAServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.fail {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	if r.Header.Get("client-key") != s.apiKey {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch r.URL.Path {
+	case "/initialize":
+		var req RSAInitializeRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.SubjectName == "" || req.AuthMethodID != rsaTokenMethod {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		attemptID := "attempt-" + req.SubjectName
+		s.attempts[attemptID] = "init-msg-" + req.SubjectName
+		json.NewEncoder(w).Encode(RSAInitializeResponse{
+			AttemptResponseCode: rsaAttemptChallenge,
+			Context: RSAContext{
+				AuthnAttemptID: attemptID,
+				MessageID:      s.attempts[attemptID],
+				InResponseTo:   req.Context.MessageID,
+			},
+		})
+	case "/verify":
+		var req RSAVerifyRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || len(req.SubjectCredentials) != 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		inResponseTo, known := s.attempts[req.Context.AuthnAttemptID]
+		cred := req.SubjectCredentials[0]
+		ok := known && inResponseTo == req.Context.InResponseTo &&
+			cred.MethodID == rsaTokenMethod && len(cred.CollectedInputs) == 1 &&
+			cred.CollectedInputs[0].Value == s.validCode
+		resp := RSAVerifyResponse{AttemptResponseCode: "FAIL"}
+		result := CredentialValidationResult{MethodID: rsaTokenMethod, MethodResponseCode: "FAIL"}
+		if ok {
+			resp.AttemptResponseCode = rsaAttemptSuccess
+			result.MethodResponseCode = rsaMethodSuccessCode
+		}
+		resp.CredentialValidationResults = []CredentialValidationResult{result}
+		json.NewEncoder(w).Encode(resp)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestRSATokenChallenge(t *testing.T) {
+	stub := &stubRSAServer{apiKey: "key", validCode: "12345678", attempts: map[string]string{}}
+	srv := httptest.NewServer(stub)
+	defer srv.Close()
+	rsa := newRSAAuthService(config.RSAConfig{BaseURL: srv.URL, APIKey: "key"}, nil, zerolog.Nop())
+	ctx := context.Background()
+
+	rsaCtx, err := startRSAChallenge(ctx, rsa, "jdoe")
+	if err != nil {
+		t.Fatalf("startRSAChallenge: %v", err)
+	}
+	if rsaCtx.AuthnAttemptID != "attempt-jdoe" {
+		t.Fatalf("attempt ID = %q", rsaCtx.AuthnAttemptID)
+	}
+
+	tests := []struct {
+		name      string
+		attemptID string
+		code      string
+		want      bool
+	}{
+		{"valid code", rsaCtx.AuthnAttemptID, "12345678", true},
+		{"wrong code", rsaCtx.AuthnAttemptID, "00000000", false},
+		{"unknown attempt", "attempt-other", "12345678", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, err := verifyRSAToken(ctx, rsa, tt.attemptID, rsaCtx.MessageID, tt.code)
+			if err != nil {
+				t.Fatalf("verifyRSAToken: %v", err)
+			}
+			if ok != tt.want {
+				t.Errorf("verifyRSAToken() = %v, want %v", ok, tt.want)
+			}
+		})
+	}
+
+	stub.fail = true
+	if _, err := verifyRSAToken(ctx, rsa, rsaCtx.AuthnAttemptID, rsaCtx.MessageID, "12345678"); err == nil {
+		t.Error("verifyRSAToken should report an unavailable RSA server as an error, not a rejected code")
+	}
+	if _, err := startRSAChallenge(ctx, rsa, "jdoe"); err == nil {
+		t.Error("startRSAChallenge should fail when RSA is unavailable")
+	}
+}
+
+func TestMFARequiredFor(t *testing.T) {
+	tests := []struct {
+		name          string
+		optedIn       bool
+		roles         []string
+		requireAll    bool
+		requiredRoles string
+		want          bool
+	}{
+		{"off by default", false, []string{"Staff"}, false, "", false},
+		{"user opted in", true, []string{"Staff"}, false, "", true},
+		{"required globally", false, []string{"Staff"}, true, "", true},
+		{"required role held", false, []string{"Staff", "Admin"}, false, "SuperAdmin, admin", true},
+		{"required role not held", false, []string{"Staff"}, false, "Admin,SuperAdmin", false},
+		{"blank entries ignored", false, []string{""}, false, " , ", false},
+	}
+	for _, tt := range tests {
+		if got := mfaRequiredFor(tt.optedIn, tt.roles, tt.requireAll, tt.requiredRoles); got != tt.want {
+			t.Errorf("%s: mfaRequiredFor() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,311 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/enums"
+	"github.com/enterprise-pms/pms-api/internal/domain/erp"
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+)
+
+// ---------------------------------------------------------------------------
+// In-app notification inbox. Notifications are persisted in pms.notifications
+// and announced to this instance's live streams through notificationHub.
+// Streams served by other replicas pick new rows up when they next poll, so
+// the hub only shortens the delay; the table is the source of truth.
+// ---------------------------------------------------------------------------
+
+const msgInboxNotConfigured = "notification inbox is not configured"
+
+// notificationStreamBatch caps how many notifications one stream poll reads.
+const notificationStreamBatch = 100
+
+// notificationHub fans inbox writes out to the live streams of the
+// recipient. Signals carry no payload: a woken stream reads what it missed
+// from the inbox, so a dropped signal only delays delivery. A closed channel
+// tells the stream to end.
+type notificationHub struct {
+	mu     sync.Mutex
+	subs   map[string]map[chan struct{}]struct{}
+	closed bool
+}
+
+func newNotificationHub() *notificationHub {
+	return &notificationHub{subs: make(map[string]map[chan struct{}]struct{})}
+}
+
+func (h *notificationHub) subscribe(staffID string) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+	h.mu.Lock()
+	if h.closed {
+		h.mu.Unlock()
+		close(ch)
+		return ch, func() {}
+	}
+	if h.subs[staffID] == nil {
+		h.subs[staffID] = make(map[chan struct{}]struct{})
+	}
+	h.subs[staffID][ch] = struct{}{}
+	h.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			h.mu.Lock()
+			if _, ok := h.subs[staffID][ch]; ok {
+				close(ch)
+			}
+			delete(h.subs[staffID], ch)
+			if len(h.subs[staffID]) == 0 {
+				delete(h.subs, staffID)
+			}
+			h.mu.Unlock()
+		})
+	}
+}
+
+// close ends every stream and refuses new ones.
+func (h *notificationHub) close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.closed = true
+	for staffID, chans := range h.subs {
+		for ch := range chans {
+			close(ch)
+		}
+		delete(h.subs, staffID)
+	}
+}
+
+func (h *notificationHub) publish(staffID string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for ch := range h.subs[staffID] {
+		select {
+		case ch <- struct{}{}:
+		default: // a wake-up is already pending
+		}
+	}
+}
+
+// Notify adds notifications to their recipients' inboxes and wakes the
+// recipients' live streams. Notifications without a recipient, or in a
+// category the recipient muted, are skipped.
+func (s *notificationService) Notify(ctx context.Context, notifications ...performance.Notification) error {
+	if s.inbox == nil {
+		return nil
+	}
+	rows := make([]performance.Notification, 0, len(notifications))
+	for _, n := range notifications {
+		n = normalizeNotification(n)
+		if n.RecipientStaffID == "" {
+			continue
+		}
+		mode, err := s.deliveryMode(ctx, n.RecipientStaffID, n.Category)
+		if err != nil {
+			return err
+		}
+		if mode == performance.NotificationModeMuted {
+			continue
+		}
+		rows = append(rows, n)
+	}
+	return s.addToInbox(ctx, rows)
+}
+
+// addToInbox stores notifications, setting their IDs, and wakes the
+// recipients' live streams.
+func (s *notificationService) addToInbox(ctx context.Context, rows []performance.Notification) error {
+	if s.inbox == nil || len(rows) == 0 {
+		return nil
+	}
+	if err := s.inbox.Create(ctx, rows); err != nil {
+		return err
+	}
+	for _, n := range rows {
+		s.hub.publish(n.RecipientStaffID)
+	}
+	return nil
+}
+
+// normalizeNotification fills in the category and link a notification
+// derives from its type and entity.
+func normalizeNotification(n performance.Notification) performance.Notification {
+	n.RecipientStaffID = strings.TrimSpace(n.RecipientStaffID)
+	if n.Category == "" {
+		n.Category = performance.NotificationCategoryOf(n.Type)
+	}
+	if n.Link == "" {
+		n.Link = notificationLink(n.EntityType, n.EntityID)
+	}
+	return n
+}
+
+// Subscribe registers a live stream for a staff member's inbox.
+func (s *notificationService) Subscribe(staffID string) (<-chan struct{}, func()) {
+	return s.hub.subscribe(staffID)
+}
+
+// CloseStreams ends every live stream so the HTTP server can shut down
+// without waiting on them.
+func (s *notificationService) CloseStreams() {
+	s.hub.close()
+}
+
+// ListNotifications returns a page of a staff member's inbox, newest first,
+// with their unread count.
+func (s *notificationService) ListNotifications(ctx context.Context, search *performance.NotificationSearchModel) (performance.NotificationListResponseVm, error) {
+	resp := performance.NotificationListResponseVm{}
+	if s.inbox == nil {
+		resp.HasError = true
+		resp.Message = msgInboxNotConfigured
+		return resp, nil
+	}
+
+	rows, total, err := s.inbox.List(ctx, search.StaffID, search.UnreadOnly, search.Skip, pageSize(search.PageSize))
+	if err != nil {
+		return resp, err
+	}
+	unread, err := s.inbox.CountUnread(ctx, search.StaffID)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.Data = make([]performance.NotificationVm, 0, len(rows))
+	for _, n := range rows {
+		resp.Data = append(resp.Data, toNotificationVm(n))
+	}
+	resp.TotalRecord = int(total)
+	resp.UnreadCount = int(unread)
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// ListNotificationsAfter returns a staff member's notifications newer than
+// afterID, oldest first.
+func (s *notificationService) ListNotificationsAfter(ctx context.Context, staffID string, afterID int64) ([]performance.NotificationVm, error) {
+	if s.inbox == nil {
+		return nil, nil
+	}
+	rows, err := s.inbox.ListAfter(ctx, staffID, afterID, notificationStreamBatch)
+	if err != nil {
+		return nil, err
+	}
+	vms := make([]performance.NotificationVm, 0, len(rows))
+	for _, n := range rows {
+		vms = append(vms, toNotificationVm(n))
+	}
+	return vms, nil
+}
+
+// MarkNotificationsRead marks notifications in the caller's inbox as read.
+// IDs that are already read or belong to someone else are ignored.
+func (s *notificationService) MarkNotificationsRead(ctx context.Context, req *performance.NotificationMarkReadRequestModel) (performance.ResponseVm, error) {
+	resp := performance.ResponseVm{}
+	if s.inbox == nil {
+		resp.HasError = true
+		resp.Message = msgInboxNotConfigured
+		return resp, nil
+	}
+	if len(req.NotificationIDs) == 0 {
+		resp.HasError = true
+		resp.Message = "at least one notification ID is required"
+		return resp, nil
+	}
+
+	n, err := s.inbox.MarkRead(ctx, req.StaffID, req.NotificationIDs, time.Now().UTC())
+	if err != nil {
+		return resp, err
+	}
+	resp.Message = fmt.Sprintf("%d notification(s) marked as read", n)
+	return resp, nil
+}
+
+// MarkAllNotificationsRead marks the caller's whole inbox as read.
+func (s *notificationService) MarkAllNotificationsRead(ctx context.Context, staffID string) (performance.ResponseVm, error) {
+	resp := performance.ResponseVm{}
+	if s.inbox == nil {
+		resp.HasError = true
+		resp.Message = msgInboxNotConfigured
+		return resp, nil
+	}
+
+	n, err := s.inbox.MarkAllRead(ctx, staffID, time.Now().UTC())
+	if err != nil {
+		return resp, err
+	}
+	resp.Message = fmt.Sprintf("%d notification(s) marked as read", n)
+	return resp, nil
+}
+
+// feedbackRequestNotification builds an inbox entry about a feedback request.
+func feedbackRequestNotification(staffID, notificationType, requestID, title, message string) performance.Notification {
+	return performance.Notification{
+		RecipientStaffID: staffID,
+		Type:             notificationType,
+		Title:            title,
+		Message:          message,
+		EntityType:       performance.NotificationEntityFeedbackRequest,
+		EntityID:         requestID,
+	}
+}
+
+// staffEmailAddress returns the address to email a staff member at. As with
+// other notifications, real addresses are only used when the
+// USE_ACTUAL_USER_MAIL global setting is on.
+func staffEmailAddress(ctx context.Context, globalSetting GlobalSettingService, emp *erp.EmployeeDetails) string {
+	if emp == nil || globalSetting == nil {
+		return ""
+	}
+	if actual, err := globalSetting.GetBoolValue(ctx, "USE_ACTUAL_USER_MAIL"); err != nil || !actual {
+		return ""
+	}
+	return emp.Email
+}
+
+// feedbackNotificationCategory returns the notification category of
+// requests of a feedback request type.
+func feedbackNotificationCategory(requestType enums.FeedbackRequestType) string {
+	switch requestType {
+	case enums.FeedbackRequest360ReviewFeedback, enums.FeedbackRequestReviewPeriod360Review:
+		return performance.NotificationCategoryReviews360
+	}
+	return performance.NotificationCategoryApprovals
+}
+
+// notificationLink returns the web client route of the entity a
+// notification is about.
+func notificationLink(entityType, entityID string) string {
+	if entityID == "" {
+		return ""
+	}
+	switch entityType {
+	case performance.NotificationEntityFeedbackRequest:
+		return "/requests/" + entityID
+	case performance.NotificationEntityGrievance:
+		return "/grievances/" + entityID
+	case performance.NotificationEntityDevelopmentPlan:
+		return "/development-plans/" + entityID
+	}
+	return ""
+}
+
+func toNotificationVm(n performance.Notification) performance.NotificationVm {
+	return performance.NotificationVm{
+		NotificationID: n.NotificationID,
+		Type:           n.Type,
+		Category:       n.Category,
+		Title:          n.Title,
+		Message:        n.Message,
+		EntityType:     n.EntityType,
+		EntityID:       n.EntityID,
+		Link:           n.Link,
+		IsRead:         n.IsRead,
+		ReadAt:         n.ReadAt,
+		CreatedAt:      n.CreatedAt,
+	}
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+)
+
+func TestNotificationHub(t *testing.T) {
+	hub := newNotificationHub()
+	a, cancelA := hub.subscribe("1001")
+	b, cancelB := hub.subscribe("1001")
+	other, cancelOther := hub.subscribe("2002")
+	defer cancelB()
+	defer cancelOther()
+
+	// Repeated publishes collapse into one pending wake-up.
+	hub.publish("1001")
+	hub.publish("1001")
+	for name, ch := range map[string]<-chan struct{}{"a": a, "b": b} {
+		select {
+		case <-ch:
+		default:
+			t.Errorf("subscriber %s was not woken", name)
+		}
+		select {
+		case <-ch:
+			t.Errorf("subscriber %s was woken twice", name)
+		default:
+		}
+	}
+	select {
+	case <-other:
+		t.Error("another staff member's stream was woken")
+	default:
+	}
+
+	cancelA()
+	cancelA()
+	if _, ok := <-a; ok {
+		t.Error("cancelled subscription still open")
+	}
+	hub.publish("1001") // must not send on the closed channel
+
+	hub.close()
+	<-b // the wake-up from the last publish is still buffered
+	if _, ok := <-b; ok {
+		t.Error("subscription open after the hub closed")
+	}
+	late, cancelLate := hub.subscribe("1001")
+	defer cancelLate()
+	if _, ok := <-late; ok {
+		t.Error("subscription opened after the hub closed")
+	}
+}
+
+func TestNotificationLink(t *testing.T) {
+	tests := []struct {
+		entityType, entityID, want string
+	}{
+		{performance.NotificationEntityFeedbackRequest, "FR0001", "/requests/FR0001"},
+		{performance.NotificationEntityGrievance, "G12", "/grievances/G12"},
+		{performance.NotificationEntityDevelopmentPlan, "42", "/development-plans/42"},
+		{performance.NotificationEntityGrievance, "", ""},
+		{"Unknown", "1", ""},
+	}
+	for _, tt := range tests {
+		if got := notificationLink(tt.entityType, tt.entityID); got != tt.want {
+			t.Errorf("notificationLink(%q, %q) = %q, want %q", tt.entityType, tt.entityID, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,318 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html/template"
+	"strings"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+)
+
+// ---------------------------------------------------------------------------
+// Notification preferences. Each staff member picks, per notification
+// category, whether a notification is emailed at once, collected into the
+// daily digest email, kept in the inbox only, or muted. Categories without
+// a stored preference are delivered immediately, and mandatory categories
+// can never be muted or kept off email.
+// ---------------------------------------------------------------------------
+
+// NotificationEmail is the email a notification is delivered as when the
+// recipient's preferences allow.
+type NotificationEmail struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Deliver routes a notification by its recipient's preference for its
+// category: into the inbox with an email now, into the inbox and the next
+// digest, into the inbox only, or nowhere. Without an email the
+// notification only goes to the inbox. If the inbox cannot be written the
+// email is still sent at once so the notification is not lost.
+func (s *notificationService) Deliver(ctx context.Context, n performance.Notification, email *NotificationEmail) error {
+	n = normalizeNotification(n)
+
+	mode := performance.NotificationModeImmediate
+	if n.RecipientStaffID != "" {
+		m, err := s.deliveryMode(ctx, n.RecipientStaffID, n.Category)
+		if err != nil {
+			s.log.Warn().Err(err).Str("staffId", n.RecipientStaffID).Msg("failed to load notification preferences, delivering immediately")
+		} else {
+			mode = m
+		}
+	}
+	if email == nil {
+		if mode == performance.NotificationModeMuted {
+			return nil
+		}
+		return s.addToInbox(ctx, []performance.Notification{n})
+	}
+
+	switch mode {
+	case performance.NotificationModeMuted:
+		return nil
+	case performance.NotificationModeInAppOnly:
+		if s.inbox != nil {
+			return s.addToInbox(ctx, []performance.Notification{n})
+		}
+	case performance.NotificationModeDigest:
+		if s.inbox != nil {
+			err := s.queueForDigest(ctx, n, email.To)
+			if err == nil {
+				return nil
+			}
+			s.log.Warn().Err(err).Str("staffId", n.RecipientStaffID).Msg("failed to queue notification for digest, emailing immediately")
+		}
+	default:
+		if n.RecipientStaffID != "" {
+			if err := s.addToInbox(ctx, []performance.Notification{n}); err != nil {
+				s.log.Warn().Err(err).Str("staffId", n.RecipientStaffID).Str("type", n.Type).Msg("failed to add notification to inbox")
+			}
+		}
+	}
+	return s.emailSvc.SendEmail(ctx, email.To, email.Subject, email.Body)
+}
+
+// queueForDigest adds a notification to the inbox and to its recipient's
+// next digest email.
+func (s *notificationService) queueForDigest(ctx context.Context, n performance.Notification, emailTo string) error {
+	rows := []performance.Notification{n}
+	if err := s.addToInbox(ctx, rows); err != nil {
+		return err
+	}
+	return s.inbox.QueueDigestItems(ctx, []performance.NotificationDigestItem{{
+		NotificationID:   rows[0].NotificationID,
+		RecipientStaffID: n.RecipientStaffID,
+		EmailTo:          emailTo,
+	}})
+}
+
+// deliveryMode returns how a staff member wants notifications of a category
+// delivered. A stored mode a mandatory category does not allow is ignored.
+func (s *notificationService) deliveryMode(ctx context.Context, staffID, category string) (string, error) {
+	if s.inbox == nil {
+		return performance.NotificationModeImmediate, nil
+	}
+	prefs, err := s.inbox.GetPreferences(ctx, staffID)
+	if err != nil {
+		return "", err
+	}
+	return preferredMode(prefs, category), nil
+}
+
+// preferredMode returns the mode prefs hold for category, or Immediate when
+// there is none or the category does not allow it.
+func preferredMode(prefs []performance.NotificationPreference, category string) string {
+	info, known := performance.LookupNotificationCategory(category)
+	for _, p := range prefs {
+		if p.Category != category {
+			continue
+		}
+		if known && !info.AllowsMode(p.Mode) {
+			break
+		}
+		return p.Mode
+	}
+	return performance.NotificationModeImmediate
+}
+
+// GetNotificationPreferences returns a staff member's delivery mode for
+// every notification category.
+func (s *notificationService) GetNotificationPreferences(ctx context.Context, staffID string) (performance.NotificationPreferenceListResponseVm, error) {
+	resp := performance.NotificationPreferenceListResponseVm{}
+	if s.inbox == nil {
+		resp.HasError = true
+		resp.Message = msgInboxNotConfigured
+		return resp, nil
+	}
+
+	prefs, err := s.inbox.GetPreferences(ctx, staffID)
+	if err != nil {
+		return resp, err
+	}
+	resp.Data = make([]performance.NotificationPreferenceVm, 0, len(performance.NotificationCategories))
+	for _, c := range performance.NotificationCategories {
+		resp.Data = append(resp.Data, performance.NotificationPreferenceVm{
+			Category:     c.Name,
+			Description:  c.Description,
+			Mode:         preferredMode(prefs, c.Name),
+			Mandatory:    c.Mandatory,
+			AllowedModes: allowedModes(c),
+		})
+	}
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// UpdateNotificationPreferences stores the caller's delivery modes for the
+// listed categories and returns the full set. Nothing is saved if any
+// category is unknown or any mode is not allowed for its category.
+func (s *notificationService) UpdateNotificationPreferences(ctx context.Context, req *performance.NotificationPreferenceRequestModel) (performance.NotificationPreferenceListResponseVm, error) {
+	resp := performance.NotificationPreferenceListResponseVm{}
+	if s.inbox == nil {
+		resp.HasError = true
+		resp.Message = msgInboxNotConfigured
+		return resp, nil
+	}
+	if len(req.Preferences) == 0 {
+		resp.HasError = true
+		resp.Message = "at least one preference is required"
+		return resp, nil
+	}
+
+	now := time.Now().UTC()
+	prefs := make([]performance.NotificationPreference, 0, len(req.Preferences))
+	for _, p := range req.Preferences {
+		info, ok := performance.LookupNotificationCategory(p.Category)
+		if !ok {
+			resp.HasError = true
+			resp.Message = fmt.Sprintf("unknown notification category %q", p.Category)
+			return resp, nil
+		}
+		if !info.AllowsMode(p.Mode) {
+			resp.HasError = true
+			resp.Message = fmt.Sprintf("mode %q is not allowed for notification category %s; allowed modes: %s",
+				p.Mode, info.Name, strings.Join(allowedModes(info), ", "))
+			return resp, nil
+		}
+		prefs = append(prefs, performance.NotificationPreference{
+			StaffID:   req.StaffID,
+			Category:  info.Name,
+			Mode:      p.Mode,
+			UpdatedAt: now,
+		})
+	}
+	if err := s.inbox.SavePreferences(ctx, prefs); err != nil {
+		return resp, err
+	}
+	return s.GetNotificationPreferences(ctx, req.StaffID)
+}
+
+func allowedModes(c performance.NotificationCategoryInfo) []string {
+	modes := make([]string, 0, 4)
+	for _, m := range []string{
+		performance.NotificationModeImmediate,
+		performance.NotificationModeDigest,
+		performance.NotificationModeInAppOnly,
+		performance.NotificationModeMuted,
+	} {
+		if c.AllowsMode(m) {
+			modes = append(modes, m)
+		}
+	}
+	return modes
+}
+
+// ---------------------------------------------------------------------------
+// Daily digest
+// ---------------------------------------------------------------------------
+
+const tplNotificationDigest = `<p>Dear Colleague</p>` +
+	`<p>Here is a summary of your {{len .Items}} notification(s) on Performance Management System.</p>` +
+	`<ul>{{range .Items}}<li><p><strong>{{.Title}}</strong> ({{.Date}})<br/>{{.Message}}` +
+	`{{if .URL}}<br/><a href="{{.URL}}">View</a>{{end}}</p></li>{{end}}</ul>` +
+	`<p>Thank you, <br/>CBN PMS</p>`
+
+var notifTplDigest = template.Must(template.New("digest").Parse(tplNotificationDigest))
+
+type digestTemplateData struct {
+	Items []digestLine
+}
+
+type digestLine struct {
+	Title   string
+	Message string
+	Date    string
+	URL     string
+}
+
+// digest is one recipient's pending digest items.
+type digest struct {
+	staffID string
+	emailTo string
+	itemIDs []int64
+	lines   []digestLine
+}
+
+// SendDigests emails every staff member with pending digest items one
+// summary of them. Items whose notification was read in the meantime are
+// settled without being emailed. It returns the number of digests sent.
+func (s *notificationService) SendDigests(ctx context.Context) (int, error) {
+	if s.inbox == nil {
+		return 0, nil
+	}
+	items, err := s.inbox.PendingDigestItems(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	sent, failed := 0, 0
+	for _, d := range groupDigests(items, s.cfg.Email.ApplicationURL) {
+		if len(d.lines) > 0 {
+			body, err := renderDigest(d.lines)
+			if err == nil {
+				subject := fmt.Sprintf("PMS | Your daily summary (%d notifications)", len(d.lines))
+				err = s.emailSvc.SendEmail(ctx, d.emailTo, subject, body)
+			}
+			if err != nil {
+				s.log.Error().Err(err).Str("action", "SEND_DIGEST").Str("staffId", d.staffID).Msg("failed to send notification digest")
+				failed++
+				continue
+			}
+			sent++
+		}
+		if err := s.inbox.MarkDigestItemsSent(ctx, d.itemIDs, time.Now().UTC()); err != nil {
+			return sent, err
+		}
+	}
+
+	if failed > 0 {
+		return sent, fmt.Errorf("%d of %d notification digest(s) could not be sent", failed, sent+failed)
+	}
+	return sent, nil
+}
+
+// groupDigests groups pending digest items by recipient, in the order the
+// recipients first appear. A digest goes to the most recent address its
+// items were queued for.
+func groupDigests(items []performance.NotificationDigestItem, appURL string) []*digest {
+	var digests []*digest
+	byStaff := map[string]*digest{}
+	for _, it := range items {
+		d, ok := byStaff[it.RecipientStaffID]
+		if !ok {
+			d = &digest{staffID: it.RecipientStaffID}
+			byStaff[it.RecipientStaffID] = d
+			digests = append(digests, d)
+		}
+		d.itemIDs = append(d.itemIDs, it.NotificationDigestItemID)
+		if it.EmailTo != "" {
+			d.emailTo = it.EmailTo
+		}
+		n := it.Notification
+		if n == nil || n.IsRead {
+			continue
+		}
+		line := digestLine{
+			Title:   n.Title,
+			Message: n.Message,
+			Date:    n.CreatedAt.Format("02 Jan 2006 15:04"),
+		}
+		if n.Link != "" && appURL != "" {
+			line.URL = strings.TrimRight(appURL, "/") + n.Link
+		}
+		d.lines = append(d.lines, line)
+	}
+	return digests
+}
+
+func renderDigest(lines []digestLine) (string, error) {
+	var buf bytes.Buffer
+	if err := notifTplDigest.Execute(&buf, digestTemplateData{Items: lines}); err != nil {
+		return "", fmt.Errorf("rendering notification digest: %w", err)
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/enums"
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+)
+
+func TestPreferredMode(t *testing.T) {
+	prefs := []performance.NotificationPreference{
+		{Category: performance.NotificationCategoryApprovals, Mode: performance.NotificationModeDigest},
+		{Category: performance.NotificationCategoryGrievances, Mode: performance.NotificationModeMuted},
+		// Stored before the category became mandatory.
+		{Category: performance.NotificationCategoryGrievanceEscalation, Mode: performance.NotificationModeMuted},
+	}
+	tests := []struct {
+		category, want string
+	}{
+		{performance.NotificationCategoryApprovals, performance.NotificationModeDigest},
+		{performance.NotificationCategoryGrievances, performance.NotificationModeMuted},
+		{performance.NotificationCategoryGrievanceEscalation, performance.NotificationModeImmediate},
+		{performance.NotificationCategoryReviews360, performance.NotificationModeImmediate},
+	}
+	for _, tt := range tests {
+		if got := preferredMode(prefs, tt.category); got != tt.want {
+			t.Errorf("preferredMode(%s) = %s, want %s", tt.category, got, tt.want)
+		}
+	}
+}
+
+func TestNotificationCategories(t *testing.T) {
+	escalation, ok := performance.LookupNotificationCategory(performance.NotificationCategoryGrievanceEscalation)
+	if !ok || !escalation.Mandatory {
+		t.Fatalf("grievance escalation category = %+v, %v; want mandatory", escalation, ok)
+	}
+	if got := strings.Join(allowedModes(escalation), ","); got != "Immediate,Digest" {
+		t.Errorf("allowed modes for a mandatory category = %s", got)
+	}
+	approvals, _ := performance.LookupNotificationCategory(performance.NotificationCategoryApprovals)
+	if got := len(allowedModes(approvals)); got != 4 {
+		t.Errorf("allowed modes for approvals = %d, want 4", got)
+	}
+	if _, ok := performance.LookupNotificationCategory("Nope"); ok {
+		t.Error("unknown category found")
+	}
+
+	if got := performance.NotificationCategoryOf(performance.NotificationTypeGrievanceEscalation); got != performance.NotificationCategoryGrievanceEscalation {
+		t.Errorf("category of grievance escalation = %s", got)
+	}
+	if got := feedbackNotificationCategory(enums.FeedbackRequestReviewPeriod360Review); got != performance.NotificationCategoryReviews360 {
+		t.Errorf("category of a 360 review request = %s", got)
+	}
+	if got := feedbackNotificationCategory(enums.FeedbackRequestReviewPeriodExtension); got != performance.NotificationCategoryApprovals {
+		t.Errorf("category of an extension request = %s", got)
+	}
+}
+
+func TestGroupDigests(t *testing.T) {
+	at := time.Date(2026, 3, 2, 9, 30, 0, 0, time.UTC)
+	item := func(id int64, staffID, emailTo string, n *performance.Notification) performance.NotificationDigestItem {
+		return performance.NotificationDigestItem{NotificationDigestItemID: id, RecipientStaffID: staffID, EmailTo: emailTo, Notification: n}
+	}
+	items := []performance.NotificationDigestItem{
+		item(1, "1001", "old@bank.local", &performance.Notification{Title: "New request: <Objective>", Message: "Assigned", Link: "/requests/FR1", CreatedAt: at}),
+		item(2, "1001", "ada@bank.local", &performance.Notification{Title: "Already seen", IsRead: true, CreatedAt: at}),
+		item(3, "2002", "", &performance.Notification{Title: "Read", IsRead: true, CreatedAt: at}),
+		item(4, "1001", "", nil),
+	}
+
+	digests := groupDigests(items, "https://pms.local/")
+	if len(digests) != 2 {
+		t.Fatalf("got %d digests, want 2", len(digests))
+	}
+	ada := digests[0]
+	if ada.staffID != "1001" || ada.emailTo != "ada@bank.local" || len(ada.itemIDs) != 3 || len(ada.lines) != 1 {
+		t.Fatalf("digest for 1001 = %+v", ada)
+	}
+	if ada.lines[0].URL != "https://pms.local/requests/FR1" {
+		t.Errorf("URL = %q", ada.lines[0].URL)
+	}
+	// Everything read: the items are settled but nothing is emailed.
+	if len(digests[1].lines) != 0 || len(digests[1].itemIDs) != 1 {
+		t.Errorf("digest for 2002 = %+v", digests[1])
+	}
+
+	body, err := renderDigest(ada.lines)
+	if err != nil {
+		t.Fatalf("renderDigest: %v", err)
+	}
+	for _, want := range []string{"1 notification(s)", "New request: &lt;Objective&gt;", `href="https://pms.local/requests/FR1"`, "02 Mar 2026 09:30"} {
+		if !strings.Contains(body, want) {
+			t.Errorf("digest body missing %q:\n%s", want, body)
+		}
+	}
+}
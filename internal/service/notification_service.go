@@ -8,13 +8,17 @@ import (
 	"time"
 
 	"github.com/enterprise-pms/pms-api/internal/config"
+	"github.com/enterprise-pms/pms-api/internal/domain/enums"
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"github.com/enterprise-pms/pms-api/internal/repository"
 	"github.com/rs/zerolog"
 )
 
 // ---------------------------------------------------------------------------
 // notificationService implements the NotificationService interface.
 // Mirrors the .NET NotificationService + NotificationTemplates pattern.
-// It sends email notifications using template-based content.
+// It sends email notifications using template-based content and adds each
+// one to the recipient's in-app inbox (see notification_inbox.go).
 // ---------------------------------------------------------------------------
 
 // Notification template constants — direct conversion from
@@ -66,71 +70,94 @@ var (
 
 type notificationService struct {
 	emailSvc EmailService
+	inbox    *repository.NotificationRepository
+	hub      *notificationHub
 	cfg      *config.Config
 	log      zerolog.Logger
 }
 
 func newNotificationService(
+	repos *repository.Container,
 	emailSvc EmailService,
 	cfg *config.Config,
 	log zerolog.Logger,
 ) NotificationService {
 	return &notificationService{
 		emailSvc: emailSvc,
+		inbox:    repos.Inbox,
+		hub:      newNotificationHub(),
 		cfg:      cfg,
 		log:      log.With().Str("service", "notification").Logger(),
 	}
 }
 
-// Send sends a plain-text/HTML notification email to the specified user.
+// Send sends a plain-text/HTML notification email to the specified user and
+// adds it to their inbox, as their notification preferences allow.
 func (s *notificationService) Send(ctx context.Context, userID string, message string) error {
-	return s.emailSvc.SendEmail(ctx, userID, "PMS Notification", message)
+	return s.Deliver(ctx, performance.Notification{
+		RecipientStaffID: userID,
+		Type:             performance.NotificationTypeGeneral,
+		Title:            "PMS Notification",
+		Message:          message,
+	}, &NotificationEmail{To: userID, Subject: "PMS Notification", Body: message})
 }
 
+// notificationDateLayout formats dates in notification emails.
+const notificationDateLayout = "Monday, 02 January 2006 03:04 PM"
+
 // SendNewRequestNotification sends a notification for a new feedback request.
 // Uses the SLA template if hasSLA is true, otherwise the generic template.
 // Mirrors .NET NotificationTemplates.SlaGenericNewRequest / GenericNewRequest.
 func (s *notificationService) SendNewRequestNotification(
 	ctx context.Context,
-	recipientEmail, recipientName, requestName string,
+	requestType enums.FeedbackRequestType,
+	recipientStaffID, recipientEmail, recipientName, requestID, requestName string,
 	assignedDate time.Time,
 	hasSLA bool,
 	slaHours int,
 ) error {
-	data := notificationTemplateData{
-		Name:         recipientName,
-		RequestName:  requestName,
-		AssignedDate: assignedDate.Format("Monday, 02 January 2006 03:04 PM"),
-		SLAHours:     slaHours,
+	email, err := newRequestEmail(recipientEmail, recipientName, requestName, assignedDate, hasSLA, slaHours)
+	if err != nil {
+		return err
 	}
+	n := feedbackRequestNotification(recipientStaffID, performance.NotificationTypeNewRequest, requestID,
+		"New request: "+requestName,
+		fmt.Sprintf("A request on %s has been assigned to you on %s.", requestName, assignedDate.Format(notificationDateLayout)))
+	n.Category = feedbackNotificationCategory(requestType)
+	return s.Deliver(ctx, n, email)
+}
 
-	var tpl *template.Template
+// newRequestEmail renders the email telling a staff member a feedback
+// request has been assigned to them.
+func newRequestEmail(to, name, requestName string, assignedDate time.Time, hasSLA bool, slaHours int) (*NotificationEmail, error) {
+	tpl := notifTplNew
 	if hasSLA {
 		tpl = notifTplSlaNew
-	} else {
-		tpl = notifTplNew
 	}
-
-	body, err := renderNotifTemplate(tpl, data)
+	body, err := renderNotifTemplate(tpl, notificationTemplateData{
+		Name:         name,
+		RequestName:  requestName,
+		AssignedDate: assignedDate.Format(notificationDateLayout),
+		SLAHours:     slaHours,
+	})
 	if err != nil {
-		return fmt.Errorf("notification: rendering new request template: %w", err)
+		return nil, fmt.Errorf("notification: rendering new request template: %w", err)
 	}
-
-	subject := fmt.Sprintf("PMS | New Request: %s", requestName)
-	return s.emailSvc.SendEmail(ctx, recipientEmail, subject, body)
+	return &NotificationEmail{To: to, Subject: fmt.Sprintf("PMS | New Request: %s", requestName), Body: body}, nil
 }
 
 // SendAssignerNewRequestNotification notifies the assigner that their request was initiated.
 // Mirrors .NET NotificationTemplates.AssignerGenericNewRequest.
 func (s *notificationService) SendAssignerNewRequestNotification(
 	ctx context.Context,
-	recipientEmail, recipientName, requestName string,
+	requestType enums.FeedbackRequestType,
+	recipientStaffID, recipientEmail, recipientName, requestID, requestName string,
 	assignedDate time.Time,
 ) error {
 	data := notificationTemplateData{
 		Name:         recipientName,
 		RequestName:  requestName,
-		AssignedDate: assignedDate.Format("Monday, 02 January 2006 03:04 PM"),
+		AssignedDate: assignedDate.Format(notificationDateLayout),
 	}
 
 	body, err := renderNotifTemplate(notifTplAssignerNew, data)
@@ -139,20 +166,25 @@ func (s *notificationService) SendAssignerNewRequestNotification(
 	}
 
 	subject := fmt.Sprintf("PMS | Request Initiated: %s", requestName)
-	return s.emailSvc.SendEmail(ctx, recipientEmail, subject, body)
+	n := feedbackRequestNotification(recipientStaffID, performance.NotificationTypeRequestInitiated, requestID,
+		"Request initiated: "+requestName,
+		fmt.Sprintf("Your request on %s has been initiated on %s.", requestName, data.AssignedDate))
+	n.Category = feedbackNotificationCategory(requestType)
+	return s.Deliver(ctx, n, &NotificationEmail{To: recipientEmail, Subject: subject, Body: body})
 }
 
 // SendRequestTreatedNotification notifies when a request is treated.
 // Mirrors .NET NotificationTemplates.UpdateRequest.
 func (s *notificationService) SendRequestTreatedNotification(
 	ctx context.Context,
-	recipientEmail, recipientName, requestName string,
+	requestType enums.FeedbackRequestType,
+	recipientStaffID, recipientEmail, recipientName, requestID, requestName string,
 	treatedDate time.Time,
 ) error {
 	data := notificationTemplateData{
 		Name:        recipientName,
 		RequestName: requestName,
-		TreatedDate: treatedDate.Format("Monday, 02 January 2006 03:04 PM"),
+		TreatedDate: treatedDate.Format(notificationDateLayout),
 	}
 
 	body, err := renderNotifTemplate(notifTplUpdate, data)
@@ -161,20 +193,25 @@ func (s *notificationService) SendRequestTreatedNotification(
 	}
 
 	subject := fmt.Sprintf("PMS | Request Treated: %s", requestName)
-	return s.emailSvc.SendEmail(ctx, recipientEmail, subject, body)
+	n := feedbackRequestNotification(recipientStaffID, performance.NotificationTypeRequestTreated, requestID,
+		"Request treated: "+requestName,
+		fmt.Sprintf("You have treated the request on %s on %s.", requestName, data.TreatedDate))
+	n.Category = feedbackNotificationCategory(requestType)
+	return s.Deliver(ctx, n, &NotificationEmail{To: recipientEmail, Subject: subject, Body: body})
 }
 
 // SendAssignerRequestTreatedNotification notifies the assigner when their request is treated.
 // Mirrors .NET NotificationTemplates.AssignerUpdateRequest.
 func (s *notificationService) SendAssignerRequestTreatedNotification(
 	ctx context.Context,
-	recipientEmail, recipientName, requestName string,
+	requestType enums.FeedbackRequestType,
+	recipientStaffID, recipientEmail, recipientName, requestID, requestName string,
 	treatedDate time.Time,
 ) error {
 	data := notificationTemplateData{
 		Name:        recipientName,
 		RequestName: requestName,
-		TreatedDate: treatedDate.Format("Monday, 02 January 2006 03:04 PM"),
+		TreatedDate: treatedDate.Format(notificationDateLayout),
 	}
 
 	body, err := renderNotifTemplate(notifTplAssignerUp, data)
@@ -183,7 +220,11 @@ func (s *notificationService) SendAssignerRequestTreatedNotification(
 	}
 
 	subject := fmt.Sprintf("PMS | Your Request Treated: %s", requestName)
-	return s.emailSvc.SendEmail(ctx, recipientEmail, subject, body)
+	n := feedbackRequestNotification(recipientStaffID, performance.NotificationTypeRequestTreated, requestID,
+		"Your request was treated: "+requestName,
+		fmt.Sprintf("Your request on %s has been treated on %s.", requestName, data.TreatedDate))
+	n.Category = feedbackNotificationCategory(requestType)
+	return s.Deliver(ctx, n, &NotificationEmail{To: recipientEmail, Subject: subject, Body: body})
 }
 
 func renderNotifTemplate(tpl *template.Template, data notificationTemplateData) (string, error) {
@@ -0,0 +1,688 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/competency"
+	"github.com/enterprise-pms/pms-api/internal/domain/enums"
+	"github.com/enterprise-pms/pms-api/internal/domain/organogram"
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"gorm.io/gorm"
+)
+
+// ---------------------------------------------------------------------------
+// Cascaded objective import
+//
+// Spreadsheet uploads are parsed against objectiveImportColumns, every row is
+// validated against the strategy and organogram, and only then are the valid
+// rows handed to ProcessObjectivesUpload. A dry run stops after validation.
+// ---------------------------------------------------------------------------
+
+// objectiveImportColumn is a column of the upload sheet. Headers match
+// case-insensitively, ignoring spaces and punctuation, and the JSON field name
+// is accepted as an alias.
+type objectiveImportColumn struct {
+	header   string
+	alias    string
+	required bool
+	field    func(r *performance.CascadedObjectiveUploadVm) *string
+}
+
+// objectiveImportColumns is the layout of the upload template, in order. The
+// strategic theme column takes the theme's name or ID.
+var objectiveImportColumns = []objectiveImportColumn{
+	{"Strategic Theme", "strategicThemeId", true, func(r *performance.CascadedObjectiveUploadVm) *string { return &r.StrategicThemeID }},
+	{"Enterprise Objective", "eObjName", true, func(r *performance.CascadedObjectiveUploadVm) *string { return &r.EObjName }},
+	{"Enterprise Objective Description", "eObjDesc", false, func(r *performance.CascadedObjectiveUploadVm) *string { return &r.EObjDesc }},
+	{"Enterprise KPI", "eObjKPI", true, func(r *performance.CascadedObjectiveUploadVm) *string { return &r.EObjKPI }},
+	{"Enterprise Target", "eObjTarget", false, func(r *performance.CascadedObjectiveUploadVm) *string { return &r.EObjTarget }},
+	{"Category", "eObjCategory", true, func(r *performance.CascadedObjectiveUploadVm) *string { return &r.EObjCategory }},
+	{"Department", "dept", false, func(r *performance.CascadedObjectiveUploadVm) *string { return &r.Dept }},
+	{"Department Objective", "deptObjName", false, func(r *performance.CascadedObjectiveUploadVm) *string { return &r.DeptObjName }},
+	{"Department Objective Description", "deptObjDesc", false, func(r *performance.CascadedObjectiveUploadVm) *string { return &r.DeptObjDesc }},
+	{"Department KPI", "deptObjKPI", false, func(r *performance.CascadedObjectiveUploadVm) *string { return &r.DeptObjKPI }},
+	{"Department Target", "deptObjTarget", false, func(r *performance.CascadedObjectiveUploadVm) *string { return &r.DeptObjTarget }},
+	{"Division", "division", false, func(r *performance.CascadedObjectiveUploadVm) *string { return &r.Division }},
+	{"Division Objective", "divObjName", false, func(r *performance.CascadedObjectiveUploadVm) *string { return &r.DivObjName }},
+	{"Division Objective Description", "divObjDesc", false, func(r *performance.CascadedObjectiveUploadVm) *string { return &r.DivObjDesc }},
+	{"Division KPI", "divObjKPI", false, func(r *performance.CascadedObjectiveUploadVm) *string { return &r.DivObjKPI }},
+	{"Division Target", "divObjTarget", false, func(r *performance.CascadedObjectiveUploadVm) *string { return &r.DivObjTarget }},
+	{"Office", "office", false, func(r *performance.CascadedObjectiveUploadVm) *string { return &r.Office }},
+	{"Office Objective", "offObjName", false, func(r *performance.CascadedObjectiveUploadVm) *string { return &r.OffObjName }},
+	{"Office Objective Description", "offObjDesc", false, func(r *performance.CascadedObjectiveUploadVm) *string { return &r.OffObjDesc }},
+	{"Office KPI", "offObjKPI", false, func(r *performance.CascadedObjectiveUploadVm) *string { return &r.OffObjKPI }},
+	{"Office Target", "offObjTarget", false, func(r *performance.CascadedObjectiveUploadVm) *string { return &r.OffObjTarget }},
+	{"Job Grade Group", "jobGradeGroup", false, func(r *performance.CascadedObjectiveUploadVm) *string { return &r.JobGradeGroup }},
+}
+
+// normalizeImportHeader folds a header to lower-case letters and digits.
+func normalizeImportHeader(h string) string {
+	var b strings.Builder
+	for _, c := range strings.ToLower(h) {
+		if (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') {
+			b.WriteRune(c)
+		}
+	}
+	return b.String()
+}
+
+func isBlankImportRow(row []string) bool {
+	for _, c := range row {
+		if strings.TrimSpace(c) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// parseObjectiveImportSheet maps the cells of an upload sheet onto rows. The
+// first non-blank row is the header; unknown columns are ignored and blank
+// rows skipped. Row numbers are the sheet's, counting from 1.
+func parseObjectiveImportSheet(sheet [][]string) ([]performance.ObjectiveImportRow, error) {
+	h := 0
+	for h < len(sheet) && isBlankImportRow(sheet[h]) {
+		h++
+	}
+	if h == len(sheet) {
+		return nil, fmt.Errorf("%w: the sheet is empty", ErrInvalidObjectiveUpload)
+	}
+
+	index := make(map[string]int, len(sheet[h]))
+	for i, cell := range sheet[h] {
+		if key := normalizeImportHeader(cell); key != "" {
+			if _, dup := index[key]; !dup {
+				index[key] = i
+			}
+		}
+	}
+	position := make([]int, len(objectiveImportColumns))
+	var missing []string
+	for c, col := range objectiveImportColumns {
+		i, ok := index[normalizeImportHeader(col.header)]
+		if !ok {
+			i, ok = index[normalizeImportHeader(col.alias)]
+		}
+		if !ok {
+			i = -1
+			if col.required {
+				missing = append(missing, col.header)
+			}
+		}
+		position[c] = i
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%w: missing column(s) %s", ErrInvalidObjectiveUpload, strings.Join(missing, ", "))
+	}
+
+	var rows []performance.ObjectiveImportRow
+	for r := h + 1; r < len(sheet); r++ {
+		if isBlankImportRow(sheet[r]) {
+			continue
+		}
+		row := performance.ObjectiveImportRow{RowNumber: r + 1}
+		for c, col := range objectiveImportColumns {
+			if i := position[c]; i >= 0 && i < len(sheet[r]) {
+				*col.field(&row.CascadedObjectiveUploadVm) = strings.TrimSpace(sheet[r][i])
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// objectiveImportLookups is what rows are validated against: the strategy's
+// themes, the objective categories, the organogram and the objectives the
+// strategy already has, keyed by objectiveImportKey.
+type objectiveImportLookups struct {
+	strategyID  string
+	themes      map[string]string // lower-cased name or ID -> theme ID
+	categories  map[string]string // lower-cased upload value -> category ID, "" when not found
+	departments map[string][]organogram.Department
+	divisions   map[string][]organogram.Division
+	offices     map[string][]organogram.Office
+	gradeGroups map[string]int
+	existing    map[string]bool
+}
+
+// objectiveImportKey identifies an objective by level, organisational unit
+// and name; office objectives are also distinguished by job grade group.
+func objectiveImportKey(level string, unit, grade int, name string) string {
+	return level + "|" + strconv.Itoa(unit) + "|" + strconv.Itoa(grade) + "|" + strings.ToLower(strings.TrimSpace(name))
+}
+
+// leafObjectiveImportKey identifies the most specific objective a resolved row
+// describes, which is what makes the row a duplicate or not.
+func leafObjectiveImportKey(u performance.UploadCascadedObjectiveVm) string {
+	switch {
+	case u.OffObjName != "":
+		return objectiveImportKey("office", u.OfficeID, u.JobGradeGroupID, u.OffObjName)
+	case u.DivObjName != "":
+		return objectiveImportKey("division", u.DivisionID, 0, u.DivObjName)
+	case u.DeptObjName != "":
+		return objectiveImportKey("department", u.DepartmentID, 0, u.DeptObjName)
+	default:
+		return objectiveImportKey("enterprise", 0, 0, u.EObjName)
+	}
+}
+
+// resolveObjectiveImportRow validates a row and resolves its names to IDs.
+// It returns every problem found rather than stopping at the first.
+func resolveObjectiveImportRow(row performance.CascadedObjectiveUploadVm, lk *objectiveImportLookups) (performance.UploadCascadedObjectiveVm, []string) {
+	var errs []string
+	u := performance.UploadCascadedObjectiveVm{
+		StrategyID:    lk.strategyID,
+		EObjName:      row.EObjName,
+		EObjDesc:      row.EObjDesc,
+		EObjKPI:       row.EObjKPI,
+		EObjTarget:    row.EObjTarget,
+		Dept:          row.Dept,
+		DeptObjName:   row.DeptObjName,
+		DeptObjDesc:   row.DeptObjDesc,
+		DeptObjKPI:    row.DeptObjKPI,
+		DeptObjTarget: row.DeptObjTarget,
+		Division:      row.Division,
+		DivObjName:    row.DivObjName,
+		DivObjDesc:    row.DivObjDesc,
+		DivObjKPI:     row.DivObjKPI,
+		DivObjTarget:  row.DivObjTarget,
+		Office:        row.Office,
+		OffObjName:    row.OffObjName,
+		OffObjDesc:    row.OffObjDesc,
+		OffObjKPI:     row.OffObjKPI,
+		OffObjTarget:  row.OffObjTarget,
+		JobGradeGroup: row.JobGradeGroup,
+	}
+
+	if row.StrategyID != "" && row.StrategyID != lk.strategyID {
+		errs = append(errs, fmt.Sprintf("row is for strategy %q but the upload is for %q", row.StrategyID, lk.strategyID))
+	}
+
+	// Enterprise level
+	if row.EObjName == "" {
+		errs = append(errs, "enterprise objective is required")
+	}
+	if row.EObjKPI == "" {
+		errs = append(errs, "enterprise objective KPI is missing")
+	}
+	if row.StrategicThemeID == "" {
+		errs = append(errs, "strategic theme is required")
+	} else if id, ok := lk.themes[strings.ToLower(row.StrategicThemeID)]; ok {
+		u.StrategicThemeID = id
+	} else {
+		errs = append(errs, fmt.Sprintf("strategic theme %q not found in the strategy", row.StrategicThemeID))
+	}
+	if row.EObjCategory == "" {
+		errs = append(errs, "category is required")
+	} else if id := lk.categories[strings.ToLower(row.EObjCategory)]; id != "" {
+		u.EObjCategory = id
+	} else {
+		errs = append(errs, fmt.Sprintf("category %q not found", row.EObjCategory))
+	}
+
+	// Department level
+	hasDept := row.DeptObjName != "" || row.Dept != ""
+	var dept *organogram.Department
+	if hasDept {
+		if row.DeptObjName == "" {
+			errs = append(errs, "department objective is required when a department is given")
+		} else if row.DeptObjKPI == "" {
+			errs = append(errs, "department objective KPI is missing")
+		}
+		switch matches := lk.departments[strings.ToLower(row.Dept)]; {
+		case row.Dept == "":
+			errs = append(errs, "department is required for a department objective")
+		case len(matches) == 0:
+			errs = append(errs, fmt.Sprintf("unknown department %q", row.Dept))
+		case len(matches) > 1:
+			errs = append(errs, fmt.Sprintf("department %q is ambiguous", row.Dept))
+		default:
+			dept = &matches[0]
+			u.DepartmentID = dept.DepartmentID
+		}
+	}
+
+	// Division level
+	hasDiv := row.DivObjName != "" || row.Division != ""
+	var div *organogram.Division
+	if hasDiv {
+		if !hasDept {
+			errs = append(errs, "division objective needs a department objective")
+		}
+		if row.DivObjName == "" {
+			errs = append(errs, "division objective is required when a division is given")
+		} else if row.DivObjKPI == "" {
+			errs = append(errs, "division objective KPI is missing")
+		}
+		if row.Division == "" {
+			errs = append(errs, "division is required for a division objective")
+		} else if matches := lk.divisions[strings.ToLower(row.Division)]; len(matches) == 0 {
+			errs = append(errs, fmt.Sprintf("unknown division %q", row.Division))
+		} else if dept != nil {
+			for i := range matches {
+				if matches[i].DepartmentID == dept.DepartmentID {
+					div = &matches[i]
+				}
+			}
+			if div == nil {
+				errs = append(errs, fmt.Sprintf("division %q is not in department %q", row.Division, row.Dept))
+			} else {
+				u.DivisionID = div.DivisionID
+			}
+		}
+	}
+
+	// Office level
+	if row.OffObjName != "" || row.Office != "" {
+		if !hasDiv {
+			errs = append(errs, "office objective needs a division objective")
+		}
+		if row.OffObjName == "" {
+			errs = append(errs, "office objective is required when an office is given")
+		} else if row.OffObjKPI == "" {
+			errs = append(errs, "office objective KPI is missing")
+		}
+		if row.Office == "" {
+			errs = append(errs, "office is required for an office objective")
+		} else if matches := lk.offices[strings.ToLower(row.Office)]; len(matches) == 0 {
+			errs = append(errs, fmt.Sprintf("unknown office %q", row.Office))
+		} else if div != nil {
+			for i := range matches {
+				if matches[i].DivisionID == div.DivisionID {
+					u.OfficeID = matches[i].OfficeID
+				}
+			}
+			if u.OfficeID == 0 {
+				errs = append(errs, fmt.Sprintf("office %q is not in division %q", row.Office, row.Division))
+			}
+		}
+		if row.JobGradeGroup == "" {
+			errs = append(errs, "job grade group is required for an office objective")
+		} else if id, ok := lk.gradeGroups[strings.ToLower(row.JobGradeGroup)]; ok {
+			u.JobGradeGroupID = id
+		} else {
+			errs = append(errs, fmt.Sprintf("unknown job grade group %q", row.JobGradeGroup))
+		}
+	}
+
+	return u, errs
+}
+
+// validateObjectiveImportRows reports on every row and returns the resolved
+// valid ones. A row is a duplicate when the objective it adds already exists
+// in the strategy or was added by an earlier row of the upload.
+func validateObjectiveImportRows(rows []performance.ObjectiveImportRow, lk *objectiveImportLookups) ([]performance.ObjectiveImportRowResultVm, []performance.UploadCascadedObjectiveVm) {
+	results := make([]performance.ObjectiveImportRowResultVm, 0, len(rows))
+	var valid []performance.UploadCascadedObjectiveVm
+	seen := make(map[string]int)
+
+	for _, row := range rows {
+		u, errs := resolveObjectiveImportRow(row.CascadedObjectiveUploadVm, lk)
+		if len(errs) == 0 {
+			key := leafObjectiveImportKey(u)
+			if first, dup := seen[key]; dup {
+				errs = append(errs, fmt.Sprintf("duplicate objective: same as row %d", first))
+			} else if lk.existing[key] {
+				errs = append(errs, "duplicate objective: it already exists in the strategy")
+			} else {
+				seen[key] = row.RowNumber
+			}
+		}
+
+		results = append(results, performance.ObjectiveImportRowResultVm{
+			RowNumber:           row.RowNumber,
+			EnterpriseObjective: row.EObjName,
+			IsValid:             len(errs) == 0,
+			Errors:              errs,
+		})
+		if len(errs) == 0 {
+			valid = append(valid, u)
+		}
+	}
+	return results, valid
+}
+
+// importStrategy returns the strategy an upload is for: the requested one,
+// else the one named by the first row, else the current strategy.
+func (s *objectiveService) importStrategy(ctx context.Context, strategyID string, rows []performance.ObjectiveImportRow) (*performance.Strategy, error) {
+	if strategyID == "" && len(rows) > 0 {
+		strategyID = rows[0].StrategyID
+	}
+
+	var strategy performance.Strategy
+	q := s.db.WithContext(ctx)
+	if strategyID != "" {
+		q = q.Where("strategy_id = ?", strategyID)
+	} else {
+		q = q.Where("record_status = ? AND is_active = ?", enums.StatusActive.String(), true).Order("start_date DESC")
+	}
+	err := q.First(&strategy).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		if strategyID != "" {
+			return nil, fmt.Errorf("%w: strategy %q not found", ErrInvalidObjectiveUpload, strategyID)
+		}
+		return nil, fmt.Errorf("%w: there is no active strategy", ErrInvalidObjectiveUpload)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("loading strategy: %w", err)
+	}
+	return &strategy, nil
+}
+
+// loadObjectiveImportLookups loads what validateObjectiveImportRows checks
+// rows against. Categories are resolved per distinct upload value through
+// resolveObjectiveCategoryID, as ProcessObjectivesUpload does.
+func (s *objectiveService) loadObjectiveImportLookups(ctx context.Context, strategyID string, rows []performance.ObjectiveImportRow) (*objectiveImportLookups, error) {
+	db := s.db.WithContext(ctx)
+	cancelled := enums.StatusCancelled.String()
+	lk := &objectiveImportLookups{
+		strategyID:  strategyID,
+		themes:      make(map[string]string),
+		categories:  make(map[string]string),
+		departments: make(map[string][]organogram.Department),
+		divisions:   make(map[string][]organogram.Division),
+		offices:     make(map[string][]organogram.Office),
+		gradeGroups: make(map[string]int),
+		existing:    make(map[string]bool),
+	}
+
+	var themes []performance.StrategicTheme
+	if err := db.Where("strategy_id = ? AND record_status != ?", strategyID, cancelled).Find(&themes).Error; err != nil {
+		return nil, fmt.Errorf("loading strategic themes: %w", err)
+	}
+	for _, t := range themes {
+		lk.themes[strings.ToLower(t.StrategicThemeID)] = t.StrategicThemeID
+		lk.themes[strings.ToLower(strings.TrimSpace(t.Name))] = t.StrategicThemeID
+	}
+
+	var categoryIDs []string
+	if err := db.Model(&performance.ObjectiveCategory{}).
+		Where("record_status != ?", cancelled).
+		Pluck("objective_category_id", &categoryIDs).Error; err != nil {
+		return nil, fmt.Errorf("loading objective categories: %w", err)
+	}
+	known := make(map[string]bool, len(categoryIDs))
+	for _, id := range categoryIDs {
+		known[id] = true
+	}
+	for _, row := range rows {
+		key := strings.ToLower(row.EObjCategory)
+		if _, done := lk.categories[key]; done || key == "" {
+			continue
+		}
+		if id := s.resolveObjectiveCategoryID(db, row.EObjCategory); known[id] {
+			lk.categories[key] = id
+		} else {
+			lk.categories[key] = ""
+		}
+	}
+
+	var departments []organogram.Department
+	if err := db.Where("soft_deleted = ?", false).Find(&departments).Error; err != nil {
+		return nil, fmt.Errorf("loading departments: %w", err)
+	}
+	for _, d := range departments {
+		key := strings.ToLower(strings.TrimSpace(d.DepartmentName))
+		lk.departments[key] = append(lk.departments[key], d)
+	}
+	var divisions []organogram.Division
+	if err := db.Where("soft_deleted = ?", false).Find(&divisions).Error; err != nil {
+		return nil, fmt.Errorf("loading divisions: %w", err)
+	}
+	for _, d := range divisions {
+		key := strings.ToLower(strings.TrimSpace(d.DivisionName))
+		lk.divisions[key] = append(lk.divisions[key], d)
+	}
+	var offices []organogram.Office
+	if err := db.Where("soft_deleted = ?", false).Find(&offices).Error; err != nil {
+		return nil, fmt.Errorf("loading offices: %w", err)
+	}
+	for _, o := range offices {
+		key := strings.ToLower(strings.TrimSpace(o.OfficeName))
+		lk.offices[key] = append(lk.offices[key], o)
+	}
+	var grades []competency.JobGradeGroup
+	if err := db.Where("soft_deleted = ?", false).Find(&grades).Error; err != nil {
+		return nil, fmt.Errorf("loading job grade groups: %w", err)
+	}
+	for _, g := range grades {
+		lk.gradeGroups[strings.ToLower(strings.TrimSpace(g.GroupName))] = g.JobGradeGroupID
+	}
+
+	// Objectives the strategy already has, level by level.
+	var ent []performance.EnterpriseObjective
+	if err := db.Where("strategy_id = ? AND record_status != ?", strategyID, cancelled).Find(&ent).Error; err != nil {
+		return nil, fmt.Errorf("loading enterprise objectives: %w", err)
+	}
+	entIDs := make([]string, 0, len(ent))
+	for _, o := range ent {
+		entIDs = append(entIDs, o.EnterpriseObjectiveID)
+		lk.existing[objectiveImportKey("enterprise", 0, 0, o.Name)] = true
+	}
+	var deptObjs []performance.DepartmentObjective
+	if len(entIDs) > 0 {
+		if err := db.Where("enterprise_objective_id IN ? AND record_status != ?", entIDs, cancelled).Find(&deptObjs).Error; err != nil {
+			return nil, fmt.Errorf("loading department objectives: %w", err)
+		}
+	}
+	deptIDs := make([]string, 0, len(deptObjs))
+	for _, o := range deptObjs {
+		deptIDs = append(deptIDs, o.DepartmentObjectiveID)
+		lk.existing[objectiveImportKey("department", o.DepartmentID, 0, o.Name)] = true
+	}
+	var divObjs []performance.DivisionObjective
+	if len(deptIDs) > 0 {
+		if err := db.Where("department_objective_id IN ? AND record_status != ?", deptIDs, cancelled).Find(&divObjs).Error; err != nil {
+			return nil, fmt.Errorf("loading division objectives: %w", err)
+		}
+	}
+	divIDs := make([]string, 0, len(divObjs))
+	for _, o := range divObjs {
+		divIDs = append(divIDs, o.DivisionObjectiveID)
+		lk.existing[objectiveImportKey("division", o.DivisionID, 0, o.Name)] = true
+	}
+	if len(divIDs) > 0 {
+		var offObjs []performance.OfficeObjective
+		if err := db.Where("division_objective_id IN ? AND record_status != ?", divIDs, cancelled).Find(&offObjs).Error; err != nil {
+			return nil, fmt.Errorf("loading office objectives: %w", err)
+		}
+		for _, o := range offObjs {
+			lk.existing[objectiveImportKey("office", o.OfficeID, o.JobGradeGroupID, o.Name)] = true
+		}
+	}
+
+	return lk, nil
+}
+
+// =========================================================================
+// ImportObjectives -- validates a cascaded objective upload row by row and,
+// unless it is a dry run, commits it through ProcessObjectivesUpload.
+// =========================================================================
+
+func (s *objectiveService) ImportObjectives(ctx context.Context, req *performance.ObjectiveImportRequestModel) (performance.ObjectiveImportResponseVm, error) {
+	resp := performance.ObjectiveImportResponseVm{DryRun: req.DryRun}
+
+	rows := req.Rows
+	if req.Sheet != nil {
+		parsed, err := parseObjectiveImportSheet(req.Sheet)
+		if err != nil {
+			return resp, err
+		}
+		rows = parsed
+	}
+	if len(rows) == 0 {
+		return resp, fmt.Errorf("%w: no objective rows found", ErrInvalidObjectiveUpload)
+	}
+
+	strategy, err := s.importStrategy(ctx, req.StrategyID, rows)
+	if err != nil {
+		return resp, err
+	}
+	resp.StrategyID = strategy.StrategyID
+
+	lk, err := s.loadObjectiveImportLookups(ctx, strategy.StrategyID, rows)
+	if err != nil {
+		return resp, err
+	}
+	results, valid := validateObjectiveImportRows(rows, lk)
+	resp.Rows = results
+	resp.TotalRows = len(results)
+	resp.ValidRows = len(valid)
+	resp.InvalidRows = resp.TotalRows - resp.ValidRows
+
+	switch {
+	case req.DryRun:
+		resp.Message = fmt.Sprintf("dry run: %d of %d rows are valid; nothing was imported", resp.ValidRows, resp.TotalRows)
+		return resp, nil
+	case resp.InvalidRows > 0 && !req.SkipInvalid:
+		resp.HasError = true
+		resp.Message = fmt.Sprintf("%d of %d rows are invalid; nothing was imported", resp.InvalidRows, resp.TotalRows)
+		return resp, nil
+	case len(valid) == 0:
+		resp.HasError = true
+		resp.Message = "no valid rows to import"
+		return resp, nil
+	}
+
+	out, err := s.ProcessObjectivesUpload(ctx, &performance.ObjectivesUploadRequestModel{
+		Objectives: valid,
+		CreatedBy:  req.CreatedBy,
+	})
+	upload, _ := out.(performance.GenericResponseVm)
+	if err != nil {
+		resp.HasError = true
+		resp.Message = upload.Message
+		return resp, err
+	}
+
+	resp.Committed = true
+	resp.ObjectivesCreated = upload.TotalRecords
+	resp.Message = upload.Message
+	if resp.InvalidRows > 0 {
+		resp.Message += fmt.Sprintf("; %d invalid rows skipped", resp.InvalidRows)
+	}
+
+	s.log.Info().
+		Str("strategyID", strategy.StrategyID).
+		Int("rows", resp.TotalRows).
+		Int("skipped", resp.InvalidRows).
+		Int("created", resp.ObjectivesCreated).
+		Msg("objective import committed")
+	return resp, nil
+}
+
+// =========================================================================
+// GetObjectiveUploadTemplate -- builds the upload template of a strategy,
+// or of the current strategy when strategyID is empty.
+// =========================================================================
+
+func (s *objectiveService) GetObjectiveUploadTemplate(ctx context.Context, strategyID string) (performance.ObjectiveUploadTemplateVm, error) {
+	resp := performance.ObjectiveUploadTemplateVm{}
+
+	strategy, err := s.importStrategy(ctx, strategyID, nil)
+	if err != nil {
+		return resp, err
+	}
+	resp.StrategyID = strategy.StrategyID
+	resp.StrategyName = strategy.Name
+
+	db := s.db.WithContext(ctx)
+	cancelled := enums.StatusCancelled.String()
+
+	header := make([]string, len(objectiveImportColumns))
+	for i, col := range objectiveImportColumns {
+		header[i] = col.header
+	}
+
+	var themes []performance.StrategicTheme
+	if err := db.Where("strategy_id = ? AND record_status != ?", strategy.StrategyID, cancelled).
+		Order("name").Find(&themes).Error; err != nil {
+		return resp, fmt.Errorf("loading strategic themes: %w", err)
+	}
+	themeRows := [][]string{{"Strategic Theme", "Strategic Theme ID"}}
+	for _, t := range themes {
+		themeRows = append(themeRows, []string{t.Name, t.StrategicThemeID})
+	}
+
+	var categories []performance.ObjectiveCategory
+	if err := db.Where("record_status != ?", cancelled).Order("name").Find(&categories).Error; err != nil {
+		return resp, fmt.Errorf("loading objective categories: %w", err)
+	}
+	categoryRows := [][]string{{"Category"}}
+	for _, c := range categories {
+		categoryRows = append(categoryRows, []string{c.Name})
+	}
+
+	var departments []organogram.Department
+	if err := db.Where("soft_deleted = ?", false).Find(&departments).Error; err != nil {
+		return resp, fmt.Errorf("loading departments: %w", err)
+	}
+	var divisions []organogram.Division
+	if err := db.Where("soft_deleted = ?", false).Find(&divisions).Error; err != nil {
+		return resp, fmt.Errorf("loading divisions: %w", err)
+	}
+	var offices []organogram.Office
+	if err := db.Where("soft_deleted = ?", false).Find(&offices).Error; err != nil {
+		return resp, fmt.Errorf("loading offices: %w", err)
+	}
+	deptNames := make(map[int]string, len(departments))
+	for _, d := range departments {
+		deptNames[d.DepartmentID] = d.DepartmentName
+	}
+	divByID := make(map[int]organogram.Division, len(divisions))
+	for _, d := range divisions {
+		divByID[d.DivisionID] = d
+	}
+	// One row per office, plus one for each division or department with
+	// nothing beneath it, so every unit an upload may name is listed.
+	orgRows := [][]string{{"Department", "Division", "Office"}}
+	hasOffice := make(map[int]bool)
+	hasDivision := make(map[int]bool)
+	for _, o := range offices {
+		div := divByID[o.DivisionID]
+		hasOffice[div.DivisionID] = true
+		orgRows = append(orgRows, []string{deptNames[div.DepartmentID], div.DivisionName, o.OfficeName})
+	}
+	for _, d := range divisions {
+		hasDivision[d.DepartmentID] = true
+		if !hasOffice[d.DivisionID] {
+			orgRows = append(orgRows, []string{deptNames[d.DepartmentID], d.DivisionName, ""})
+		}
+	}
+	for _, d := range departments {
+		if !hasDivision[d.DepartmentID] {
+			orgRows = append(orgRows, []string{d.DepartmentName, "", ""})
+		}
+	}
+	sort.Slice(orgRows[1:], func(i, j int) bool {
+		a, b := orgRows[i+1], orgRows[j+1]
+		for k := range a {
+			if a[k] != b[k] {
+				return a[k] < b[k]
+			}
+		}
+		return false
+	})
+
+	var grades []competency.JobGradeGroup
+	if err := db.Where("soft_deleted = ?", false).Order(`"order"`).Find(&grades).Error; err != nil {
+		return resp, fmt.Errorf("loading job grade groups: %w", err)
+	}
+	gradeRows := [][]string{{"Job Grade Group"}}
+	for _, g := range grades {
+		gradeRows = append(gradeRows, []string{g.GroupName})
+	}
+
+	resp.Sheets = []performance.ObjectiveTemplateSheet{
+		{Name: "Objectives", Rows: [][]string{header}},
+		{Name: "Strategic Themes", Rows: themeRows},
+		{Name: "Categories", Rows: categoryRows},
+		{Name: "Organogram", Rows: orgRows},
+		{Name: "Job Grade Groups", Rows: gradeRows},
+	}
+	return resp, nil
+}
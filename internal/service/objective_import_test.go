@@ -0,0 +1,140 @@
+package service
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/organogram"
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+)
+
+func TestParseObjectiveImportSheet(t *testing.T) {
+	sheet := [][]string{
+		{},
+		{"strategic theme", "ENTERPRISE OBJECTIVE", "Enterprise KPI", "Category", "deptObjName", "Notes"},
+		{"Growth", "Grow deposits", "Deposit growth", "Financial", "Retail deposits", "ignored"},
+		{"", "", ""},
+		{"Growth", "Cut costs", "Cost to income", "Financial"},
+	}
+	rows, err := parseObjectiveImportSheet(sheet)
+	if err != nil {
+		t.Fatalf("parseObjectiveImportSheet: %v", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("got %d rows, want 2", len(rows))
+	}
+	if rows[0].RowNumber != 3 || rows[1].RowNumber != 5 {
+		t.Errorf("row numbers = %d, %d, want 3, 5", rows[0].RowNumber, rows[1].RowNumber)
+	}
+	if rows[0].EObjName != "Grow deposits" || rows[0].DeptObjName != "Retail deposits" || rows[0].StrategicThemeID != "Growth" {
+		t.Errorf("first row mapped to %+v", rows[0].CascadedObjectiveUploadVm)
+	}
+	if rows[1].DeptObjName != "" {
+		t.Errorf("short row should leave missing cells empty, got %q", rows[1].DeptObjName)
+	}
+
+	_, err = parseObjectiveImportSheet([][]string{{"Enterprise Objective", "Category"}})
+	if !errors.Is(err, ErrInvalidObjectiveUpload) || !strings.Contains(err.Error(), "Strategic Theme") {
+		t.Errorf("missing columns error = %v", err)
+	}
+}
+
+func testObjectiveImportLookups() *objectiveImportLookups {
+	return &objectiveImportLookups{
+		strategyID: "STR1",
+		themes:     map[string]string{"growth": "TH1", "th1": "TH1"},
+		categories: map[string]string{"financial": "CAT1", "bogus": ""},
+		departments: map[string][]organogram.Department{
+			"retail": {{DepartmentID: 10, DepartmentName: "Retail"}},
+		},
+		divisions: map[string][]organogram.Division{
+			"deposits": {{DivisionID: 20, DepartmentID: 10, DivisionName: "Deposits"}},
+			"treasury": {{DivisionID: 21, DepartmentID: 11, DivisionName: "Treasury"}},
+		},
+		offices: map[string][]organogram.Office{
+			"savings": {{OfficeID: 30, DivisionID: 20, OfficeName: "Savings"}},
+		},
+		gradeGroups: map[string]int{"officers": 5},
+		existing:    map[string]bool{objectiveImportKey("department", 10, 0, "Existing objective"): true},
+	}
+}
+
+func TestValidateObjectiveImportRows(t *testing.T) {
+	base := performance.CascadedObjectiveUploadVm{
+		StrategicThemeID: "Growth", EObjName: "Grow deposits", EObjKPI: "Deposit growth", EObjCategory: "Financial",
+	}
+	dept := base
+	dept.Dept, dept.DeptObjName, dept.DeptObjKPI = "Retail", "Retail deposits", "Retail growth"
+	office := dept
+	office.Division, office.DivObjName, office.DivObjKPI = "Deposits", "Savings drive", "New accounts"
+	office.Office, office.OffObjName, office.OffObjKPI, office.JobGradeGroup = "Savings", "Open accounts", "Accounts opened", "Officers"
+
+	withEdit := func(r performance.CascadedObjectiveUploadVm, edit func(*performance.CascadedObjectiveUploadVm)) performance.CascadedObjectiveUploadVm {
+		edit(&r)
+		return r
+	}
+
+	tests := []struct {
+		name string
+		row  performance.CascadedObjectiveUploadVm
+		want []string // substrings of the expected errors; none means valid
+	}{
+		{"enterprise only", base, nil},
+		{"full cascade", office, nil},
+		{"theme by id", withEdit(base, func(r *performance.CascadedObjectiveUploadVm) { r.StrategicThemeID = "TH1" }), nil},
+		{"category not found", withEdit(base, func(r *performance.CascadedObjectiveUploadVm) { r.EObjCategory = "Bogus" }), []string{`category "Bogus" not found`}},
+		{"missing KPI", withEdit(dept, func(r *performance.CascadedObjectiveUploadVm) { r.DeptObjKPI = "" }), []string{"department objective KPI is missing"}},
+		{"unknown department", withEdit(dept, func(r *performance.CascadedObjectiveUploadVm) { r.Dept = "Wholesale" }), []string{`unknown department "Wholesale"`}},
+		{"division outside department", withEdit(office, func(r *performance.CascadedObjectiveUploadVm) { r.Division = "Treasury" }), []string{`division "Treasury" is not in department "Retail"`}},
+		{"division without department", withEdit(base, func(r *performance.CascadedObjectiveUploadVm) {
+			r.Division, r.DivObjName, r.DivObjKPI = "Deposits", "x", "y"
+		}), []string{"needs a department objective"}},
+		{"unknown job grade group", withEdit(office, func(r *performance.CascadedObjectiveUploadVm) { r.JobGradeGroup = "Interns" }), []string{`unknown job grade group "Interns"`}},
+		{"other strategy", withEdit(base, func(r *performance.CascadedObjectiveUploadVm) { r.StrategyID = "STR2" }), []string{`strategy "STR2"`}},
+		{"already exists", withEdit(dept, func(r *performance.CascadedObjectiveUploadVm) { r.DeptObjName = "existing OBJECTIVE" }), []string{"already exists"}},
+	}
+	for _, tt := range tests {
+		results, valid := validateObjectiveImportRows([]performance.ObjectiveImportRow{{RowNumber: 2, CascadedObjectiveUploadVm: tt.row}}, testObjectiveImportLookups())
+		res, wantValid := results[0], len(tt.want) == 0
+		if res.IsValid != wantValid || (len(valid) == 1) != wantValid {
+			t.Errorf("%s: valid = %v, errors %q", tt.name, res.IsValid, res.Errors)
+			continue
+		}
+		if len(res.Errors) != len(tt.want) {
+			t.Errorf("%s: errors %q, want %d", tt.name, res.Errors, len(tt.want))
+			continue
+		}
+		for i, w := range tt.want {
+			if !strings.Contains(res.Errors[i], w) {
+				t.Errorf("%s: error %q, want it to mention %q", tt.name, res.Errors[i], w)
+			}
+		}
+	}
+}
+
+func TestValidateObjectiveImportRowsResolvesAndDeduplicates(t *testing.T) {
+	row := performance.CascadedObjectiveUploadVm{
+		StrategicThemeID: "Growth", EObjName: "Grow deposits", EObjKPI: "Deposit growth", EObjCategory: "financial",
+		Dept: "retail", DeptObjName: "Retail deposits", DeptObjKPI: "Retail growth",
+	}
+	rows := []performance.ObjectiveImportRow{
+		{RowNumber: 2, CascadedObjectiveUploadVm: row},
+		{RowNumber: 3, CascadedObjectiveUploadVm: row},
+	}
+	results, valid := validateObjectiveImportRows(rows, testObjectiveImportLookups())
+
+	if !results[0].IsValid || results[1].IsValid {
+		t.Fatalf("validity = %v, %v, want true, false", results[0].IsValid, results[1].IsValid)
+	}
+	if want := []string{"duplicate objective: same as row 2"}; !reflect.DeepEqual(results[1].Errors, want) {
+		t.Errorf("duplicate errors = %q, want %q", results[1].Errors, want)
+	}
+	if len(valid) != 1 {
+		t.Fatalf("got %d valid rows, want 1", len(valid))
+	}
+	if u := valid[0]; u.StrategyID != "STR1" || u.StrategicThemeID != "TH1" || u.EObjCategory != "CAT1" || u.DepartmentID != 10 {
+		t.Errorf("resolved row = %+v", u)
+	}
+}
@@ -1694,49 +1694,12 @@ func (s *objectiveService) ApproveRecords(ctx context.Context, req interface{})
 	}
 
 	for _, id := range r.RecordIDs {
-		switch strings.ToLower(r.EntityType) {
-		case "enterprise_objective", "enterpriseobjective":
-			s.db.WithContext(ctx).Model(&performance.EnterpriseObjective{}).
-				Where("enterprise_objective_id = ?", id).
-				Updates(map[string]interface{}{
-					"record_status": enums.StatusActive.String(),
-					"is_active":     true,
-					"is_approved":   true,
-				})
-		case "department_objective", "departmentobjective":
-			s.db.WithContext(ctx).Model(&performance.DepartmentObjective{}).
-				Where("department_objective_id = ?", id).
-				Updates(map[string]interface{}{
-					"record_status": enums.StatusActive.String(),
-					"is_active":     true,
-					"is_approved":   true,
-				})
-		case "division_objective", "divisionobjective":
-			s.db.WithContext(ctx).Model(&performance.DivisionObjective{}).
-				Where("division_objective_id = ?", id).
-				Updates(map[string]interface{}{
-					"record_status": enums.StatusActive.String(),
-					"is_active":     true,
-					"is_approved":   true,
-				})
-		case "office_objective", "officeobjective":
-			s.db.WithContext(ctx).Model(&performance.OfficeObjective{}).
-				Where("office_objective_id = ?", id).
-				Updates(map[string]interface{}{
-					"record_status": enums.StatusActive.String(),
-					"is_active":     true,
-					"is_approved":   true,
-				})
-		case "category_definition", "categorydefinition":
-			s.db.WithContext(ctx).Model(&performance.CategoryDefinition{}).
-				Where("definition_id = ?", id).
-				Updates(map[string]interface{}{
-					"record_status": enums.StatusActive.String(),
-					"is_active":     true,
-					"is_approved":   true,
-				})
-		default:
-			s.log.Warn().Str("entityType", r.EntityType).Msg("unknown entity type for approval")
+		err := s.applyRecordApproval(ctx, r.EntityType, id, enums.OperationApprove, map[string]interface{}{
+			"is_active":   true,
+			"is_approved": true,
+		})
+		if err != nil {
+			return nil, err
 		}
 	}
 
@@ -1752,49 +1715,12 @@ func (s *objectiveService) RejectRecords(ctx context.Context, req interface{}) (
 	}
 
 	for _, id := range r.RecordIDs {
-		switch strings.ToLower(r.EntityType) {
-		case "enterprise_objective", "enterpriseobjective":
-			s.db.WithContext(ctx).Model(&performance.EnterpriseObjective{}).
-				Where("enterprise_objective_id = ?", id).
-				Updates(map[string]interface{}{
-					"record_status":    enums.StatusRejected.String(),
-					"is_rejected":      true,
-					"rejection_reason": r.RejectionReason,
-				})
-		case "department_objective", "departmentobjective":
-			s.db.WithContext(ctx).Model(&performance.DepartmentObjective{}).
-				Where("department_objective_id = ?", id).
-				Updates(map[string]interface{}{
-					"record_status":    enums.StatusRejected.String(),
-					"is_rejected":      true,
-					"rejection_reason": r.RejectionReason,
-				})
-		case "division_objective", "divisionobjective":
-			s.db.WithContext(ctx).Model(&performance.DivisionObjective{}).
-				Where("division_objective_id = ?", id).
-				Updates(map[string]interface{}{
-					"record_status":    enums.StatusRejected.String(),
-					"is_rejected":      true,
-					"rejection_reason": r.RejectionReason,
-				})
-		case "office_objective", "officeobjective":
-			s.db.WithContext(ctx).Model(&performance.OfficeObjective{}).
-				Where("office_objective_id = ?", id).
-				Updates(map[string]interface{}{
-					"record_status":    enums.StatusRejected.String(),
-					"is_rejected":      true,
-					"rejection_reason": r.RejectionReason,
-				})
-		case "category_definition", "categorydefinition":
-			s.db.WithContext(ctx).Model(&performance.CategoryDefinition{}).
-				Where("definition_id = ?", id).
-				Updates(map[string]interface{}{
-					"record_status":    enums.StatusRejected.String(),
-					"is_rejected":      true,
-					"rejection_reason": r.RejectionReason,
-				})
-		default:
-			s.log.Warn().Str("entityType", r.EntityType).Msg("unknown entity type for rejection")
+		err := s.applyRecordApproval(ctx, r.EntityType, id, enums.OperationReject, map[string]interface{}{
+			"is_rejected":      true,
+			"rejection_reason": r.RejectionReason,
+		})
+		if err != nil {
+			return nil, err
 		}
 	}
 
@@ -1802,3 +1728,55 @@ func (s *objectiveService) RejectRecords(ctx context.Context, req interface{}) (
 	resp.Message = "records rejected successfully"
 	return resp, nil
 }
+
+// approvalRecordTable maps an ApproveRecords/RejectRecords entity type to its
+// model and key column.
+func approvalRecordTable(entityType string) (interface{}, string, bool) {
+	switch strings.ToLower(entityType) {
+	case "enterprise_objective", "enterpriseobjective":
+		return &performance.EnterpriseObjective{}, "enterprise_objective_id", true
+	case "department_objective", "departmentobjective":
+		return &performance.DepartmentObjective{}, "department_objective_id", true
+	case "division_objective", "divisionobjective":
+		return &performance.DivisionObjective{}, "division_objective_id", true
+	case "office_objective", "officeobjective":
+		return &performance.OfficeObjective{}, "office_objective_id", true
+	case "category_definition", "categorydefinition":
+		return &performance.CategoryDefinition{}, "definition_id", true
+	}
+	return nil, "", false
+}
+
+// applyRecordApproval moves a cascaded objective or category definition
+// through the built-in single-level approval chain, which governs these
+// records, and writes fields alongside the new status.
+func (s *objectiveService) applyRecordApproval(ctx context.Context, entityType, id string, op enums.OperationType, fields map[string]interface{}) error {
+	model, key, ok := approvalRecordTable(entityType)
+	if !ok {
+		s.log.Warn().Str("entityType", entityType).Str("operation", op.String()).Msg("unknown entity type for approval")
+		return nil
+	}
+
+	var statuses []string
+	if err := s.db.WithContext(ctx).Model(model).Where(key+" = ?", id).
+		Pluck("record_status", &statuses).Error; err != nil {
+		return fmt.Errorf("loading %s %s: %w", entityType, id, err)
+	}
+	if len(statuses) == 0 {
+		return fmt.Errorf("%s %s not found", entityType, id)
+	}
+
+	next, err := applyWorkflowOperation(NewBaseWorkflowEngine(s.log), statuses[0], op)
+	if err != nil {
+		return fmt.Errorf("%s %s: %w", entityType, id, err)
+	}
+	if next == enums.StatusApprovedAndActive {
+		next = enums.StatusActive
+	}
+	fields["record_status"] = next.String()
+
+	if err := s.db.WithContext(ctx).Model(model).Where(key+" = ?", id).Updates(fields).Error; err != nil {
+		return fmt.Errorf("updating %s %s: %w", entityType, id, err)
+	}
+	return nil
+}
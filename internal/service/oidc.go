@@ -0,0 +1,594 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/config"
+	"github.com/enterprise-pms/pms-api/internal/domain/auth"
+	"github.com/enterprise-pms/pms-api/internal/domain/identity"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ---------------------------------------------------------------------------
+// OpenID Connect single sign-on.
+//
+// BeginOIDCLogin stores a single-use login state with a nonce and PKCE code
+// verifier and returns the identity provider's authorization URL.
+// CompleteOIDCLogin consumes the state, redeems the code at the token
+// endpoint, verifies the ID token against the provider's JWKS and signs the
+// user in as a password login would: users are matched on the token's issuer
+// and subject, existing accounts are linked and new ones provisioned on
+// first login, IdP groups are mapped to PMS roles and merged with the assigned and ERP
+// hierarchy roles, and the MFA policy applies before the JWT pair is issued.
+// ---------------------------------------------------------------------------
+
+const (
+	// oidcLoginTTL bounds how long a user has to sign in at the provider.
+	oidcLoginTTL = 10 * time.Minute
+	// oidcKeyRefreshInterval is the minimum time between JWKS fetches
+	// triggered by an unknown signing key.
+	oidcKeyRefreshInterval = time.Minute
+	// oidcClockSkew is the leeway allowed on ID token time claims.
+	oidcClockSkew = 30 * time.Second
+)
+
+var errInvalidOIDCLogin = errors.New("invalid or expired single sign-on login")
+
+// oidcProvider is the part of the provider's discovery document the login
+// flow uses.
+type oidcProvider struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// oidcIdentity is the user described by a verified ID token.
+type oidcIdentity struct {
+	Issuer    string
+	Subject   string
+	Username  string
+	Email     string
+	FirstName string
+	LastName  string
+	Groups    []string
+}
+
+// oidcClient talks to the identity provider. The discovery document is
+// fetched once; signing keys are refetched when a token names a key that is
+// not cached, so provider key rotation needs no restart.
+type oidcClient struct {
+	cfg    config.OIDCConfig
+	client *http.Client
+
+	mu            sync.Mutex
+	provider      *oidcProvider
+	keys          map[string]*rsa.PublicKey
+	keysFetchedAt time.Time
+}
+
+func newOIDCClient(cfg config.OIDCConfig) *oidcClient {
+	return &oidcClient{
+		cfg:    cfg,
+		client: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// discover returns the provider metadata, fetching it on first use.
+func (c *oidcClient) discover(ctx context.Context) (*oidcProvider, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.provider != nil {
+		return c.provider, nil
+	}
+
+	issuer := strings.TrimRight(c.cfg.IssuerURL, "/")
+	var p oidcProvider
+	if err := c.getJSON(ctx, issuer+"/.well-known/openid-configuration", &p); err != nil {
+		return nil, fmt.Errorf("oidc: discovery: %w", err)
+	}
+	if strings.TrimRight(p.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("oidc: discovery returned issuer %q, want %q", p.Issuer, c.cfg.IssuerURL)
+	}
+	if p.AuthorizationEndpoint == "" || p.TokenEndpoint == "" || p.JWKSURI == "" {
+		return nil, fmt.Errorf("oidc: discovery document is missing endpoints")
+	}
+	c.provider = &p
+	return c.provider, nil
+}
+
+// authorizationURL builds the URL that sends the user to the provider.
+func (c *oidcClient) authorizationURL(ctx context.Context, state, nonce, codeVerifier string) (string, error) {
+	p, err := c.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	u, err := url.Parse(p.AuthorizationEndpoint)
+	if err != nil {
+		return "", fmt.Errorf("oidc: parsing authorization endpoint: %w", err)
+	}
+	q := u.Query()
+	q.Set("response_type", "code")
+	q.Set("client_id", c.cfg.ClientID)
+	q.Set("redirect_uri", c.cfg.RedirectURL)
+	q.Set("scope", strings.Join(c.scopes(), " "))
+	q.Set("state", state)
+	q.Set("nonce", nonce)
+	q.Set("code_challenge", pkceChallenge(codeVerifier))
+	q.Set("code_challenge_method", "S256")
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// scopes returns the configured scopes, always including openid.
+func (c *oidcClient) scopes() []string {
+	for _, s := range c.cfg.Scopes {
+		if s == "openid" {
+			return c.cfg.Scopes
+		}
+	}
+	return append([]string{"openid"}, c.cfg.Scopes...)
+}
+
+// exchange redeems an authorization code and returns the raw ID token.
+func (c *oidcClient) exchange(ctx context.Context, code, codeVerifier string) (string, error) {
+	p, err := c.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {c.cfg.RedirectURL},
+		"client_id":     {c.cfg.ClientID},
+		"code_verifier": {codeVerifier},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("oidc: building token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if c.cfg.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(c.cfg.ClientID), url.QueryEscape(c.cfg.ClientSecret))
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("oidc: token request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var body struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&body); err != nil {
+		return "", fmt.Errorf("oidc: token endpoint returned status %d", resp.StatusCode)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("oidc: token endpoint returned %s: %s", body.Error, body.ErrorDescription)
+	}
+	if body.IDToken == "" {
+		return "", fmt.Errorf("oidc: token response has no id_token")
+	}
+	return body.IDToken, nil
+}
+
+// verifyIDToken checks the ID token's signature, issuer, audience, expiry
+// and nonce and returns the identity it describes.
+func (c *oidcClient) verifyIDToken(ctx context.Context, raw, nonce string) (*oidcIdentity, error) {
+	p, err := c.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(raw, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return c.signingKey(ctx, kid)
+	},
+		jwt.WithValidMethods([]string{"RS256"}),
+		jwt.WithIssuer(p.Issuer),
+		jwt.WithAudience(c.cfg.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(oidcClockSkew),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("oidc: invalid ID token: %w", err)
+	}
+	if got, _ := claims["nonce"].(string); got == "" || got != nonce {
+		return nil, fmt.Errorf("oidc: ID token nonce does not match")
+	}
+
+	id := &oidcIdentity{
+		Issuer:    stringClaim(claims, "iss"),
+		Subject:   stringClaim(claims, "sub"),
+		Username:  strings.TrimSpace(stringClaim(claims, c.cfg.UsernameClaim)),
+		Email:     stringClaim(claims, "email"),
+		FirstName: stringClaim(claims, "given_name"),
+		LastName:  stringClaim(claims, "family_name"),
+		Groups:    stringsClaim(claims, c.cfg.GroupsClaim),
+	}
+	if id.Subject == "" {
+		return nil, fmt.Errorf("oidc: ID token has no sub claim")
+	}
+	if id.Username == "" {
+		return nil, fmt.Errorf("oidc: ID token has no %q claim", c.cfg.UsernameClaim)
+	}
+	return id, nil
+}
+
+// signingKey returns the provider's RSA key with the given ID, refetching
+// the JWKS at most once per oidcKeyRefreshInterval when it is not cached.
+// An empty kid matches the only key of a single-key set.
+func (c *oidcClient) signingKey(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if key := lookupKey(c.keys, kid); key != nil {
+		return key, nil
+	}
+	if time.Since(c.keysFetchedAt) < oidcKeyRefreshInterval {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+
+	var set struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Use string `json:"use"`
+			Kid string `json:"kid"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+	if err := c.getJSON(ctx, c.provider.JWKSURI, &set); err != nil {
+		return nil, fmt.Errorf("fetching signing keys: %w", err)
+	}
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" || (k.Use != "" && k.Use != "sig") {
+			continue
+		}
+		n, errN := base64.RawURLEncoding.DecodeString(k.N)
+		e, errE := base64.RawURLEncoding.DecodeString(k.E)
+		if errN != nil || errE != nil || len(e) > 4 {
+			continue
+		}
+		keys[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+	c.keys = keys
+	c.keysFetchedAt = time.Now()
+
+	if key := lookupKey(c.keys, kid); key != nil {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+func lookupKey(keys map[string]*rsa.PublicKey, kid string) *rsa.PublicKey {
+	if kid == "" && len(keys) == 1 {
+		for _, k := range keys {
+			return k
+		}
+	}
+	return keys[kid]
+}
+
+func (c *oidcClient) getJSON(ctx context.Context, rawURL string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s returned status %d", rawURL, resp.StatusCode)
+	}
+	return json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(v)
+}
+
+// stringClaim returns a string claim, or "" when it is missing.
+func stringClaim(claims jwt.MapClaims, name string) string {
+	s, _ := claims[name].(string)
+	return s
+}
+
+// stringsClaim returns a claim that is a string or an array of strings.
+func stringsClaim(claims jwt.MapClaims, name string) []string {
+	switch v := claims[name].(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		result := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				result = append(result, s)
+			}
+		}
+		return result
+	}
+	return nil
+}
+
+// pkceChallenge derives the S256 code challenge for a code verifier.
+func pkceChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// randomURLToken returns 32 random bytes, base64url encoded. The result is
+// a valid PKCE code verifier.
+func randomURLToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// mapIdPGroups returns the PMS roles mapped to the user's IdP groups.
+// Groups are matched case-insensitively.
+func mapIdPGroups(groups []string, mappings map[string]string) []string {
+	var roles []string
+	for group, role := range mappings {
+		for _, g := range groups {
+			if strings.EqualFold(strings.TrimSpace(g), group) {
+				roles = append(roles, role)
+				break
+			}
+		}
+	}
+	return mergeRoles(nil, roles)
+}
+
+// BeginOIDCLogin starts a single sign-on login and returns the URL to send
+// the user to.
+func (s *authService) BeginOIDCLogin(ctx context.Context) (*auth.OIDCLoginResponse, error) {
+	if !s.cfg.OIDC.Enabled {
+		return nil, ErrSSODisabled
+	}
+
+	var values [3]string
+	for i := range values {
+		v, err := randomURLToken()
+		if err != nil {
+			return nil, fmt.Errorf("generating login state: %w", err)
+		}
+		values[i] = v
+	}
+	state, nonce, verifier := values[0], values[1], values[2]
+
+	authURL, err := s.oidc.authorizationURL(ctx, state, nonce, verifier)
+	if err != nil {
+		s.log.Error().Err(err).Msg("Failed to build OIDC authorization URL")
+		return nil, fmt.Errorf("single sign-on is unavailable, please try again later")
+	}
+
+	now := time.Now().UTC()
+	login := auth.OIDCLoginState{
+		ID:           uuid.NewString(),
+		State:        hashToken(state),
+		Nonce:        nonce,
+		CodeVerifier: verifier,
+		ExpiresAt:    now.Add(oidcLoginTTL),
+		CreatedAt:    now,
+	}
+	if err := s.db.WithContext(ctx).Create(&login).Error; err != nil {
+		return nil, fmt.Errorf("storing login state: %w", err)
+	}
+
+	return &auth.OIDCLoginResponse{
+		AuthorizationURL: authURL,
+		State:            state,
+		ExpiresAt:        login.ExpiresAt.Unix(),
+	}, nil
+}
+
+// CompleteOIDCLogin finishes a login started by BeginOIDCLogin with the
+// code and state the provider returned. Like AuthenticateAD it returns an
+// AuthenticateResponse, or an MFAChallengeResponse when the user must
+// complete a second factor.
+func (s *authService) CompleteOIDCLogin(ctx context.Context, code, state string) (interface{}, error) {
+	if !s.cfg.OIDC.Enabled {
+		return nil, ErrSSODisabled
+	}
+
+	login, err := s.consumeOIDCLoginState(ctx, state)
+	if err != nil {
+		return nil, err
+	}
+
+	rawIDToken, err := s.oidc.exchange(ctx, code, login.CodeVerifier)
+	if err != nil {
+		s.log.Warn().Err(err).Msg("OIDC code exchange failed")
+		return nil, fmt.Errorf("single sign-on failed")
+	}
+	id, err := s.oidc.verifyIDToken(ctx, rawIDToken, login.Nonce)
+	if err != nil {
+		s.log.Warn().Err(err).Msg("OIDC ID token rejected")
+		return nil, fmt.Errorf("single sign-on failed")
+	}
+
+	user, err := s.oidcUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !user.IsActive {
+		return nil, fmt.Errorf("user account is deactivated")
+	}
+	if s.users.IsLockedOut(user) {
+		return nil, fmt.Errorf("account is locked out")
+	}
+
+	idpRoles := mapIdPGroups(id.Groups, s.cfg.OIDC.RoleMappings)
+	if err := s.syncIdPRoles(ctx, user.ID, idpRoles); err != nil {
+		s.log.Error().Err(err).Str("user", user.UserName).Msg("Failed to store IdP roles")
+	}
+
+	roles, err := s.resolveRoles(ctx, user)
+	if err != nil {
+		s.log.Warn().Err(err).Str("user", user.UserName).Msg("Failed to resolve dynamic roles")
+		roles = mergeRoles([]string{auth.RoleStaff}, idpRoles)
+	}
+
+	// The provider's own second factor is not visible here, so the PMS MFA
+	// policy applies as it does to password logins.
+	if s.mfaRequired(ctx, user, roles) {
+		return s.issueMFAChallenge(ctx, user)
+	}
+
+	_ = s.users.ResetAccessFailedCount(ctx, user)
+
+	return s.issueSession(ctx, user, roles)
+}
+
+// consumeOIDCLoginState looks up and consumes the login for state. Only one
+// concurrent caller can consume a login; the others get
+// errInvalidOIDCLogin.
+func (s *authService) consumeOIDCLoginState(ctx context.Context, state string) (*auth.OIDCLoginState, error) {
+	var login auth.OIDCLoginState
+	err := s.db.WithContext(ctx).Where("state = ?", hashToken(state)).First(&login).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errInvalidOIDCLogin
+	}
+	if err != nil {
+		return nil, fmt.Errorf("looking up login state: %w", err)
+	}
+	if !login.IsUsable(time.Now().UTC()) {
+		return nil, errInvalidOIDCLogin
+	}
+
+	result := s.db.WithContext(ctx).Model(&auth.OIDCLoginState{}).
+		Where("id = ? AND consumed_at IS NULL", login.ID).
+		Update("consumed_at", time.Now().UTC())
+	if result.Error != nil {
+		return nil, fmt.Errorf("consuming login state: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, errInvalidOIDCLogin
+	}
+	return &login, nil
+}
+
+// oidcUser returns the user linked to the ID token's issuer and subject.
+// On an account's first single sign-on login the username claim finds it,
+// unless it is already linked to another account at the same provider, and
+// the link is recorded; users unknown to PMS are provisioned and linked.
+func (s *authService) oidcUser(ctx context.Context, id *oidcIdentity) (*identity.ApplicationUser, error) {
+	var link auth.UserExternalLogin
+	if err := s.db.WithContext(ctx).
+		Where("issuer = ? AND subject = ?", id.Issuer, id.Subject).
+		Limit(1).Find(&link).Error; err != nil {
+		return nil, fmt.Errorf("looking up SSO link: %w", err)
+	}
+	if link.UserID != "" {
+		user, err := s.users.FindByID(ctx, link.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("looking up user: %w", err)
+		}
+		if user == nil {
+			return nil, fmt.Errorf("single sign-on account is linked to a missing user")
+		}
+		return user, nil
+	}
+
+	user, err := s.users.FindByUsername(ctx, id.Username)
+	if err != nil {
+		return nil, fmt.Errorf("looking up user: %w", err)
+	}
+	if user != nil {
+		var linked int64
+		if err := s.db.WithContext(ctx).Model(&auth.UserExternalLogin{}).
+			Where("issuer = ? AND user_id = ?", id.Issuer, user.ID).
+			Count(&linked).Error; err != nil {
+			return nil, fmt.Errorf("looking up SSO link: %w", err)
+		}
+		if linked > 0 {
+			s.log.Warn().Str("user", user.UserName).Str("subject", id.Subject).
+				Msg("SSO username claim names a user linked to another subject")
+			return nil, fmt.Errorf("single sign-on account does not match this user")
+		}
+	} else {
+		user, err = s.provisionOIDCUser(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("provisioning SSO user: %w", err)
+		}
+	}
+
+	link = auth.UserExternalLogin{Issuer: id.Issuer, Subject: id.Subject, UserID: user.ID}
+	if err := s.db.WithContext(ctx).Create(&link).Error; err != nil {
+		return nil, fmt.Errorf("linking SSO account: %w", err)
+	}
+	return user, nil
+}
+
+// provisionOIDCUser creates a local user record from ID token claims.
+func (s *authService) provisionOIDCUser(ctx context.Context, id *oidcIdentity) (*identity.ApplicationUser, error) {
+	user := &identity.ApplicationUser{
+		ID:        id.Username, // Staff ID, as for AD users
+		UserName:  id.Username,
+		Email:     id.Email,
+		FirstName: id.FirstName,
+		LastName:  id.LastName,
+		IsActive:  true,
+	}
+	if err := s.users.CreateUser(ctx, user, ""); err != nil {
+		return nil, err
+	}
+	s.log.Info().Str("user", user.UserName).Str("subject", id.Subject).Msg("Provisioned user from SSO login")
+	return user, nil
+}
+
+// syncIdPRoles replaces the roles the user holds through IdP groups.
+func (s *authService) syncIdPRoles(ctx context.Context, userID string, roles []string) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&auth.UserIdPRole{}).Error; err != nil {
+			return err
+		}
+		if len(roles) == 0 {
+			return nil
+		}
+		now := time.Now().UTC()
+		rows := make([]auth.UserIdPRole, len(roles))
+		for i, role := range roles {
+			rows[i] = auth.UserIdPRole{UserID: userID, RoleName: role, SyncedAt: now}
+		}
+		return tx.Create(&rows).Error
+	})
+}
+
+// idpRoles returns the roles the user holds through IdP groups as of their
+// last single sign-on login.
+func (s *authService) idpRoles(ctx context.Context, userID string) ([]string, error) {
+	var roles []string
+	err := s.db.WithContext(ctx).Model(&auth.UserIdPRole{}).
+		Where("user_id = ?", userID).
+		Pluck("role_name", &roles).Error
+	return roles, err
+}
@@ -0,0 +1,283 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/config"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// mockIdP is a minimal OpenID provider: discovery, JWKS and an
+// authorization-code token endpoint that enforces PKCE and client
+// authentication. authorize stands in for the user signing in.
+type mockIdP struct {
+	*httptest.Server
+	t        *testing.T
+	clientID string
+	secret   string
+
+	mu     sync.Mutex
+	key    *rsa.PrivateKey
+	kid    string
+	codes  map[string]mockGrant
+	claims jwt.MapClaims // overrides applied to the next ID token
+}
+
+type mockGrant struct {
+	challenge string
+	nonce     string
+	claims    jwt.MapClaims
+}
+
+func newMockIdP(t *testing.T) *mockIdP {
+	t.Helper()
+	idp := &mockIdP{t: t, clientID: "pms", secret: "s3cret", codes: map[string]mockGrant{}}
+	idp.rotateKey("key-1")
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 idp.URL,
+			"authorization_endpoint": idp.URL + "/authorize",
+			"token_endpoint":         idp.URL + "/token",
+			"jwks_uri":               idp.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("GET /jwks", func(w http.ResponseWriter, r *http.Request) {
+		idp.mu.Lock()
+		defer idp.mu.Unlock()
+		pub := idp.key.PublicKey
+		json.NewEncoder(w).Encode(map[string]interface{}{"keys": []map[string]string{{
+			"kty": "RSA",
+			"use": "sig",
+			"kid": idp.kid,
+			"n":   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("POST /token", idp.token)
+	idp.Server = httptest.NewServer(mux)
+	t.Cleanup(idp.Close)
+	return idp
+}
+
+func (idp *mockIdP) rotateKey(kid string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		idp.t.Fatalf("generating key: %v", err)
+	}
+	idp.mu.Lock()
+	idp.key, idp.kid = key, kid
+	idp.mu.Unlock()
+}
+
+// authorize signs a user in against authURL and returns the code the
+// provider would redirect back with.
+func (idp *mockIdP) authorize(authURL string, claims jwt.MapClaims) (code, state string) {
+	u, err := url.Parse(authURL)
+	if err != nil {
+		idp.t.Fatalf("parsing authorization URL: %v", err)
+	}
+	q := u.Query()
+	if q.Get("client_id") != idp.clientID || q.Get("code_challenge_method") != "S256" || !strings.Contains(q.Get("scope"), "openid") {
+		idp.t.Fatalf("unexpected authorization request: %s", u.RawQuery)
+	}
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+	code = fmt.Sprintf("code-%d", len(idp.codes))
+	idp.codes[code] = mockGrant{challenge: q.Get("code_challenge"), nonce: q.Get("nonce"), claims: claims}
+	return code, q.Get("state")
+}
+
+func (idp *mockIdP) token(w http.ResponseWriter, r *http.Request) {
+	fail := func(code string) {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": code})
+	}
+	if user, pass, ok := r.BasicAuth(); !ok || user != idp.clientID || pass != idp.secret {
+		fail("invalid_client")
+		return
+	}
+	if err := r.ParseForm(); err != nil || r.PostForm.Get("grant_type") != "authorization_code" {
+		fail("invalid_request")
+		return
+	}
+
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+	grant, ok := idp.codes[r.PostForm.Get("code")]
+	delete(idp.codes, r.PostForm.Get("code"))
+	if !ok || pkceChallenge(r.PostForm.Get("code_verifier")) != grant.challenge {
+		fail("invalid_grant")
+		return
+	}
+
+	claims := jwt.MapClaims{
+		"iss":   idp.URL,
+		"aud":   idp.clientID,
+		"sub":   "subject-1",
+		"iat":   time.Now().Unix(),
+		"exp":   time.Now().Add(5 * time.Minute).Unix(),
+		"nonce": grant.nonce,
+	}
+	for k, v := range grant.claims {
+		claims[k] = v
+	}
+	tok := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	tok.Header["kid"] = idp.kid
+	signed, err := tok.SignedString(idp.key)
+	if err != nil {
+		idp.t.Errorf("signing ID token: %v", err)
+		fail("server_error")
+		return
+	}
+	json.NewEncoder(w).Encode(map[string]string{"access_token": "at", "token_type": "Bearer", "id_token": signed})
+}
+
+func (idp *mockIdP) config() config.OIDCConfig {
+	return config.OIDCConfig{
+		Enabled:       true,
+		IssuerURL:     idp.URL + "/",
+		ClientID:      idp.clientID,
+		ClientSecret:  idp.secret,
+		RedirectURL:   "https://pms.local/auth/callback",
+		Scopes:        []string{"profile", "email"},
+		UsernameClaim: "preferred_username",
+		GroupsClaim:   "groups",
+	}
+}
+
+// login runs the browser leg of the flow and the code exchange, as
+// BeginOIDCLogin and CompleteOIDCLogin do.
+func login(t *testing.T, c *oidcClient, idp *mockIdP, claims jwt.MapClaims) (*oidcIdentity, error) {
+	t.Helper()
+	ctx := context.Background()
+	state, _ := randomURLToken()
+	nonce, _ := randomURLToken()
+	verifier, _ := randomURLToken()
+
+	authURL, err := c.authorizationURL(ctx, state, nonce, verifier)
+	if err != nil {
+		t.Fatalf("authorizationURL: %v", err)
+	}
+	code, gotState := idp.authorize(authURL, claims)
+	if gotState != state {
+		t.Fatalf("state = %q, want %q", gotState, state)
+	}
+	raw, err := c.exchange(ctx, code, verifier)
+	if err != nil {
+		t.Fatalf("exchange: %v", err)
+	}
+	return c.verifyIDToken(ctx, raw, nonce)
+}
+
+func TestOIDCLogin(t *testing.T) {
+	idp := newMockIdP(t)
+	c := newOIDCClient(idp.config())
+
+	id, err := login(t, c, idp, jwt.MapClaims{
+		"preferred_username": "E1234",
+		"email":              "ada@bank.local",
+		"given_name":         "Ada",
+		"family_name":        "Obi",
+		"groups":             []string{"PMS-HR", "Everyone"},
+	})
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	if id.Issuer != idp.URL || id.Subject != "subject-1" {
+		t.Errorf("issuer/subject = %q/%q, want %q/subject-1", id.Issuer, id.Subject, idp.URL)
+	}
+	if id.Username != "E1234" || id.Email != "ada@bank.local" || id.FirstName != "Ada" || id.LastName != "Obi" {
+		t.Errorf("identity = %+v", id)
+	}
+	if fmt.Sprint(id.Groups) != "[PMS-HR Everyone]" {
+		t.Errorf("groups = %v", id.Groups)
+	}
+
+	// A rotated provider key is picked up without a restart.
+	idp.rotateKey("key-2")
+	c.keysFetchedAt = time.Time{}
+	if _, err := login(t, c, idp, jwt.MapClaims{"preferred_username": "E1234"}); err != nil {
+		t.Errorf("login after key rotation: %v", err)
+	}
+}
+
+func TestOIDCRejectsBadTokens(t *testing.T) {
+	idp := newMockIdP(t)
+
+	tests := []struct {
+		name   string
+		claims jwt.MapClaims
+	}{
+		{"wrong audience", jwt.MapClaims{"aud": "other-app"}},
+		{"wrong issuer", jwt.MapClaims{"iss": "https://evil.example"}},
+		{"expired", jwt.MapClaims{"exp": time.Now().Add(-time.Hour).Unix()}},
+		{"replayed nonce", jwt.MapClaims{"nonce": "stale"}},
+		{"no username", jwt.MapClaims{"preferred_username": ""}},
+		{"no subject", jwt.MapClaims{"sub": ""}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newOIDCClient(idp.config())
+			claims := jwt.MapClaims{"preferred_username": "E1234"}
+			for k, v := range tt.claims {
+				claims[k] = v
+			}
+			if _, err := login(t, c, idp, claims); err == nil {
+				t.Error("login succeeded, want error")
+			}
+		})
+	}
+}
+
+func TestOIDCExchangeRequiresVerifier(t *testing.T) {
+	idp := newMockIdP(t)
+	c := newOIDCClient(idp.config())
+	ctx := context.Background()
+
+	authURL, err := c.authorizationURL(ctx, "state", "nonce", "the-right-verifier-0123456789abcdefghijklmno")
+	if err != nil {
+		t.Fatalf("authorizationURL: %v", err)
+	}
+	code, _ := idp.authorize(authURL, nil)
+	if _, err := c.exchange(ctx, code, "a-different-verifier-0123456789abcdefghijklm"); err == nil || !strings.Contains(err.Error(), "invalid_grant") {
+		t.Errorf("exchange = %v, want invalid_grant", err)
+	}
+
+	bad := idp.config()
+	bad.IssuerURL = idp.URL + "/tenant"
+	if _, err := newOIDCClient(bad).discover(ctx); err == nil {
+		t.Error("discover accepted a mismatched issuer")
+	}
+}
+
+func TestMapIdPGroups(t *testing.T) {
+	mappings := map[string]string{
+		"PMS-HR":     "HrAdmin",
+		"PMS-Admins": "SuperAdmin",
+		"HR-Reports": "HrAdmin",
+	}
+	got := mapIdPGroups([]string{"pms-hr", "Everyone", "HR-Reports"}, mappings)
+	sort.Strings(got)
+	if fmt.Sprint(got) != "[HrAdmin]" {
+		t.Errorf("mapIdPGroups = %v, want [HrAdmin]", got)
+	}
+	if got := mapIdPGroups(nil, mappings); len(got) != 0 {
+		t.Errorf("mapIdPGroups(nil) = %v, want none", got)
+	}
+}
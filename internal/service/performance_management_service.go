@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/enterprise-pms/pms-api/internal/domain/performance"
 	"github.com/enterprise-pms/pms-api/internal/repository"
 	"github.com/rs/zerolog"
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 )
 
@@ -22,6 +24,8 @@ import (
 // Legacy "thin" methods for projects, work products, feedback and
 // competency review are retained for backward compatibility; new callers
 // should prefer the typed sub-service methods.
+// Staff-keyed entry points are checked against the caller's data scope
+// here, so sub-services can assume an authorised staff ID.
 // ---------------------------------------------------------------------------
 
 type performanceManagementService struct {
@@ -45,6 +49,12 @@ type performanceManagementService struct {
 	reviewPeriodSvc ReviewPeriodService
 	erpEmployeeSvc  ErpEmployeeService
 	globalSettingSvc GlobalSettingService
+	chainSvc        ApprovalChainService
+	delegationSvc   DelegationService
+	gradingScaleSvc GradingScaleService
+	notificationSvc NotificationService
+
+	scope DataScopeService
 }
 
 // newPerformanceManagementService constructs the main performance management
@@ -57,6 +67,12 @@ func newPerformanceManagementService(
 	erpEmployeeSvc ErpEmployeeService,
 	globalSettingSvc GlobalSettingService,
 	userCtxSvc UserContextService,
+	chainSvc ApprovalChainService,
+	delegationSvc DelegationService,
+	gradingScaleSvc GradingScaleService,
+	scope DataScopeService,
+	notificationSvc NotificationService,
+	sla *slaCalendar,
 ) PerformanceManagementService {
 	db := repos.GormDB
 
@@ -68,6 +84,11 @@ func newPerformanceManagementService(
 		reviewPeriodSvc:  reviewPeriodSvc,
 		erpEmployeeSvc:   erpEmployeeSvc,
 		globalSettingSvc: globalSettingSvc,
+		chainSvc:         chainSvc,
+		delegationSvc:    delegationSvc,
+		gradingScaleSvc:  gradingScaleSvc,
+		notificationSvc:  notificationSvc,
+		scope:            scope,
 	}
 
 	// Compose sub-services sharing the same DB and repos
@@ -78,8 +99,8 @@ func newPerformanceManagementService(
 	svc.project = newProjectService(db, cfg, svc.log, svc)
 	svc.committee = newCommitteeService(db, cfg, svc.log, svc)
 	svc.workProduct = newWorkProductService(db, cfg, svc.log, svc)
-	svc.feedbackReq = newFeedbackRequestService(db, cfg, svc.log, svc, repos.Erp, repos.Sas)
-	svc.competencyReview = newCompetencyReviewService(db, cfg, svc.log, svc)
+	svc.feedbackReq = newFeedbackRequestService(db, cfg, svc.log, svc, repos.Erp, repos.Sas, sla)
+	svc.competencyReview = newCompetencyReviewService(db, cfg, svc.log, svc, repos.Erp)
 	svc.evaluation = newEvaluationService(db, cfg, svc.log, svc)
 
 	return svc
@@ -213,6 +234,14 @@ func (s *performanceManagementService) ProcessObjectivesUpload(ctx context.Conte
 	return s.objectives.ProcessObjectivesUpload(ctx, req)
 }
 
+func (s *performanceManagementService) ImportObjectives(ctx context.Context, req *performance.ObjectiveImportRequestModel) (performance.ObjectiveImportResponseVm, error) {
+	return s.objectives.ImportObjectives(ctx, req)
+}
+
+func (s *performanceManagementService) GetObjectiveUploadTemplate(ctx context.Context, strategyID string) (performance.ObjectiveUploadTemplateVm, error) {
+	return s.objectives.GetObjectiveUploadTemplate(ctx, strategyID)
+}
+
 func (s *performanceManagementService) DeActivateOrReactivateObjectives(ctx context.Context, req interface{}, deactivate bool) (interface{}, error) {
 	return s.objectives.DeActivateOrReactivateObjectives(ctx, req, deactivate)
 }
@@ -283,42 +312,73 @@ func (s *performanceManagementService) RejectRecords(ctx context.Context, req in
 // =========================================================================
 
 func (s *performanceManagementService) GetDashboardStats(ctx context.Context, staffID string) (interface{}, error) {
+	if err := s.scope.AuthorizeStaff(ctx, staffID); err != nil {
+		return nil, err
+	}
 	return s.dashboard.GetDashboardStats(ctx, staffID)
 }
 
 func (s *performanceManagementService) GetStaffAnnualPerformanceScoreCardStatistics(ctx context.Context, staffID string, year int) (performance.StaffAnnualScoreCardResponseVm, error) {
+	if err := s.scope.AuthorizeStaff(ctx, staffID); err != nil {
+		return performance.StaffAnnualScoreCardResponseVm{}, err
+	}
 	return s.dashboard.GetStaffAnnualPerformanceScoreCardStatistics(ctx, staffID, year)
 }
 
 func (s *performanceManagementService) GetSubordinatesStaffPerformanceScoreCardStatistics(ctx context.Context, managerStaffID, reviewPeriodID string) (performance.AllStaffScoreCardResponseVm, error) {
+	if err := s.scope.AuthorizeStaff(ctx, managerStaffID); err != nil {
+		return performance.AllStaffScoreCardResponseVm{}, err
+	}
 	return s.dashboard.GetSubordinatesStaffPerformanceScoreCardStatistics(ctx, managerStaffID, reviewPeriodID)
 }
 
 func (s *performanceManagementService) GetOrganogramPerformanceSummaryStatistics(ctx context.Context, referenceID, reviewPeriodID string, organogramLevel enums.OrganogramLevel) (performance.OrganogramPerformanceSummaryResponseVm, error) {
+	unitID, _ := strconv.Atoi(referenceID)
+	if err := s.scope.AuthorizeOrgUnit(ctx, organogramLevel, unitID); err != nil {
+		return performance.OrganogramPerformanceSummaryResponseVm{}, err
+	}
 	return s.dashboard.GetOrganogramPerformanceSummaryStatistics(ctx, referenceID, reviewPeriodID, organogramLevel)
 }
 
 func (s *performanceManagementService) GetOrganogramPerformanceSummaryListStatistics(ctx context.Context, headOfUnitID, reviewPeriodID string, organogramLevel enums.OrganogramLevel) (performance.OrganogramPerformanceSummaryListResponseVm, error) {
+	if err := s.scope.AuthorizeStaff(ctx, headOfUnitID); err != nil {
+		return performance.OrganogramPerformanceSummaryListResponseVm{}, err
+	}
 	return s.dashboard.GetOrganogramPerformanceSummaryListStatistics(ctx, headOfUnitID, reviewPeriodID, organogramLevel)
 }
 
 func (s *performanceManagementService) GetRequestStatistics(ctx context.Context, staffID string) (performance.FeedbackRequestDashboardResponseVm, error) {
+	if err := s.scope.AuthorizeStaff(ctx, staffID); err != nil {
+		return performance.FeedbackRequestDashboardResponseVm{}, err
+	}
 	return s.dashboard.GetRequestStatistics(ctx, staffID)
 }
 
 func (s *performanceManagementService) GetStaffPerformanceStatistics(ctx context.Context, staffID string) (performance.ReviewPeriodPointsDashboardResponseVm, error) {
+	if err := s.scope.AuthorizeStaff(ctx, staffID); err != nil {
+		return performance.ReviewPeriodPointsDashboardResponseVm{}, err
+	}
 	return s.dashboard.GetStaffPerformanceStatistics(ctx, staffID)
 }
 
 func (s *performanceManagementService) GetStaffWorkProductsStatistics(ctx context.Context, staffID string) (performance.ReviewPeriodWorkProductDashboardResponseVm, error) {
+	if err := s.scope.AuthorizeStaff(ctx, staffID); err != nil {
+		return performance.ReviewPeriodWorkProductDashboardResponseVm{}, err
+	}
 	return s.dashboard.GetStaffWorkProductsStatistics(ctx, staffID)
 }
 
 func (s *performanceManagementService) GetStaffWorkProductsDetailsStatistics(ctx context.Context, staffID string) (performance.ReviewPeriodWorkProductDetailsDashboardResponseVm, error) {
+	if err := s.scope.AuthorizeStaff(ctx, staffID); err != nil {
+		return performance.ReviewPeriodWorkProductDetailsDashboardResponseVm{}, err
+	}
 	return s.dashboard.GetStaffWorkProductsDetailsStatistics(ctx, staffID)
 }
 
 func (s *performanceManagementService) GetStaffPerformanceScoreCardStatistics(ctx context.Context, staffID, reviewPeriodID string) (performance.StaffScoreCardResponseVm, error) {
+	if err := s.scope.AuthorizeStaff(ctx, staffID); err != nil {
+		return performance.StaffScoreCardResponseVm{}, err
+	}
 	return s.dashboard.GetStaffPerformanceScoreCardStatistics(ctx, staffID, reviewPeriodID)
 }
 
@@ -327,18 +387,53 @@ func (s *performanceManagementService) GetStaffPerformanceScoreCardStatistics(ct
 // =========================================================================
 
 func (s *performanceManagementService) GetPerformanceScore(ctx context.Context, staffID string) (interface{}, error) {
+	if err := s.scope.AuthorizeStaff(ctx, staffID); err != nil {
+		return nil, err
+	}
 	return s.periodScr.GetPerformanceScore(ctx, staffID)
 }
 
 func (s *performanceManagementService) GetPeriodScoreDetails(ctx context.Context, reviewPeriodID, staffID string) (performance.PeriodScoreResponseVm, error) {
+	if err := s.scope.AuthorizeStaff(ctx, staffID); err != nil {
+		return performance.PeriodScoreResponseVm{}, err
+	}
 	return s.periodScr.GetPeriodScoreDetails(ctx, reviewPeriodID, staffID)
 }
 
+// GetPeriodScores lists only the period scores of staff in the caller's
+// data scope.
 func (s *performanceManagementService) GetPeriodScores(ctx context.Context, reviewPeriodID string) (performance.PeriodScoreListResponseVm, error) {
-	return s.periodScr.GetPeriodScores(ctx, reviewPeriodID)
+	resp, err := s.periodScr.GetPeriodScores(ctx, reviewPeriodID)
+	if err != nil {
+		return resp, err
+	}
+	staff := make([]string, 0, len(resp.PeriodScores))
+	for _, score := range resp.PeriodScores {
+		staff = append(staff, score.StaffID)
+	}
+	visible, err := s.scope.FilterStaff(ctx, staff)
+	if err != nil {
+		return performance.PeriodScoreListResponseVm{}, err
+	}
+	allowed := make(map[string]bool, len(visible))
+	for _, id := range visible {
+		allowed[id] = true
+	}
+	scores := resp.PeriodScores[:0]
+	for _, score := range resp.PeriodScores {
+		if allowed[score.StaffID] {
+			scores = append(scores, score)
+		}
+	}
+	resp.PeriodScores = scores
+	resp.TotalRecords = len(scores)
+	return resp, nil
 }
 
 func (s *performanceManagementService) GetStaffReviewPeriods(ctx context.Context, staffID string) (performance.GetStaffReviewPeriodResponseVm, error) {
+	if err := s.scope.AuthorizeStaff(ctx, staffID); err != nil {
+		return performance.GetStaffReviewPeriodResponseVm{}, err
+	}
 	return s.periodScr.GetStaffReviewPeriods(ctx, staffID)
 }
 
@@ -359,6 +454,9 @@ func (s *performanceManagementService) GetProjects(ctx context.Context) (perform
 }
 
 func (s *performanceManagementService) GetProjectsByManager(ctx context.Context, managerID string) (performance.ProjectListResponseVm, error) {
+	if err := s.scope.AuthorizeStaff(ctx, managerID); err != nil {
+		return performance.ProjectListResponseVm{}, err
+	}
 	return s.project.GetProjectsByManager(ctx, managerID)
 }
 
@@ -379,10 +477,16 @@ func (s *performanceManagementService) GetProjectMembers(ctx context.Context, pr
 }
 
 func (s *performanceManagementService) GetProjectsAssigned(ctx context.Context, staffID string) (performance.ProjectAssignedListResponseVm, error) {
+	if err := s.scope.AuthorizeStaff(ctx, staffID); err != nil {
+		return performance.ProjectAssignedListResponseVm{}, err
+	}
 	return s.project.GetProjectsAssigned(ctx, staffID)
 }
 
 func (s *performanceManagementService) GetStaffProjects(ctx context.Context, staffID string) (performance.ProjectAssignedListResponseVm, error) {
+	if err := s.scope.AuthorizeStaff(ctx, staffID); err != nil {
+		return performance.ProjectAssignedListResponseVm{}, err
+	}
 	return s.project.GetStaffProjects(ctx, staffID)
 }
 
@@ -435,10 +539,16 @@ func (s *performanceManagementService) GetCommitteeMembers(ctx context.Context,
 }
 
 func (s *performanceManagementService) GetCommitteesAssigned(ctx context.Context, staffID string) (performance.CommitteeAssignedListResponseVm, error) {
+	if err := s.scope.AuthorizeStaff(ctx, staffID); err != nil {
+		return performance.CommitteeAssignedListResponseVm{}, err
+	}
 	return s.committee.GetCommitteesAssigned(ctx, staffID)
 }
 
 func (s *performanceManagementService) GetStaffCommittees(ctx context.Context, staffID string) (performance.CommitteeAssignedListResponseVm, error) {
+	if err := s.scope.AuthorizeStaff(ctx, staffID); err != nil {
+		return performance.CommitteeAssignedListResponseVm{}, err
+	}
 	return s.committee.GetStaffCommittees(ctx, staffID)
 }
 
@@ -455,6 +565,9 @@ func (s *performanceManagementService) ChangeCommitteeChairperson(ctx context.Co
 // =========================================================================
 
 func (s *performanceManagementService) WorkProductSetup(ctx context.Context, req *performance.WorkProductRequestModel) (performance.ResponseVm, error) {
+	if err := s.scope.AuthorizeStaff(ctx, req.StaffID); err != nil {
+		return performance.ResponseVm{}, err
+	}
 	return s.workProduct.WorkProductSetup(ctx, req)
 }
 
@@ -491,10 +604,16 @@ func (s *performanceManagementService) GetOperationalWorkProducts(ctx context.Co
 }
 
 func (s *performanceManagementService) GetStaffWorkProducts(ctx context.Context, staffID, reviewPeriodID string) (performance.StaffWorkProductListResponseVm, error) {
+	if err := s.scope.AuthorizeStaff(ctx, staffID); err != nil {
+		return performance.StaffWorkProductListResponseVm{}, err
+	}
 	return s.workProduct.GetStaffWorkProducts(ctx, staffID, reviewPeriodID)
 }
 
 func (s *performanceManagementService) GetAllStaffWorkProducts(ctx context.Context, staffID string) (performance.StaffWorkProductListResponseVm, error) {
+	if err := s.scope.AuthorizeStaff(ctx, staffID); err != nil {
+		return performance.StaffWorkProductListResponseVm{}, err
+	}
 	return s.workProduct.GetAllStaffWorkProducts(ctx, staffID)
 }
 
@@ -515,6 +634,9 @@ func (s *performanceManagementService) GetWorkProductTaskDetail(ctx context.Cont
 }
 
 func (s *performanceManagementService) ReCalculateWorkProductPoints(ctx context.Context, staffID, reviewPeriodID string) (performance.RecalculateWorkProductResponseVm, error) {
+	if err := s.scope.AuthorizeStaff(ctx, staffID); err != nil {
+		return performance.RecalculateWorkProductResponseVm{}, err
+	}
 	return s.workProduct.ReCalculateWorkProductPoints(ctx, staffID, reviewPeriodID)
 }
 
@@ -543,18 +665,30 @@ func (s *performanceManagementService) LogAcceptanceRequest(ctx context.Context,
 }
 
 func (s *performanceManagementService) GetRequests(ctx context.Context, staffID string, feedbackType *enums.FeedbackRequestType, status *string) (performance.FeedbackRequestListResponseVm, error) {
+	if err := s.scope.AuthorizeStaff(ctx, staffID); err != nil {
+		return performance.FeedbackRequestListResponseVm{}, err
+	}
 	return s.feedbackReq.GetRequests(ctx, staffID, feedbackType, status)
 }
 
 func (s *performanceManagementService) GetRequestsByOwner(ctx context.Context, requestOwnerStaffID string, feedbackType *enums.FeedbackRequestType) (performance.FeedbackRequestListResponseVm, error) {
+	if err := s.scope.AuthorizeStaff(ctx, requestOwnerStaffID); err != nil {
+		return performance.FeedbackRequestListResponseVm{}, err
+	}
 	return s.feedbackReq.GetRequestsByOwner(ctx, requestOwnerStaffID, feedbackType)
 }
 
 func (s *performanceManagementService) GetBreachedRequests(ctx context.Context, staffID, reviewPeriodID string) (performance.BreachedFeedbackRequestListResponseVm, error) {
+	if err := s.scope.AuthorizeStaff(ctx, staffID); err != nil {
+		return performance.BreachedFeedbackRequestListResponseVm{}, err
+	}
 	return s.feedbackReq.GetBreachedRequests(ctx, staffID, reviewPeriodID)
 }
 
 func (s *performanceManagementService) GetPendingRequests(ctx context.Context, staffID string) (performance.GetStaffPendingRequestVm, error) {
+	if err := s.scope.AuthorizeStaff(ctx, staffID); err != nil {
+		return performance.GetStaffPendingRequestVm{}, err
+	}
 	return s.feedbackReq.GetPendingRequests(ctx, staffID)
 }
 
@@ -567,18 +701,30 @@ func (s *performanceManagementService) GetRequestDetails(ctx context.Context, re
 }
 
 func (s *performanceManagementService) UpdateRequest(ctx context.Context, requestID string, comment, attachment string) error {
+	if err := s.authorizeFeedbackRequest(ctx, requestID); err != nil {
+		return err
+	}
 	return s.feedbackReq.UpdateRequest(ctx, requestID, comment, attachment)
 }
 
 func (s *performanceManagementService) ReassignRequest(ctx context.Context, requestID, newAssignedStaffID string) error {
+	if err := s.authorizeFeedbackRequest(ctx, requestID); err != nil {
+		return err
+	}
 	return s.feedbackReq.ReassignRequest(ctx, requestID, newAssignedStaffID)
 }
 
 func (s *performanceManagementService) ReassignSelfRequest(ctx context.Context, requestID, currentStaffID, newAssignedStaffID string) error {
+	if err := s.scope.AuthorizeStaff(ctx, currentStaffID); err != nil {
+		return err
+	}
 	return s.feedbackReq.ReassignSelfRequest(ctx, requestID, currentStaffID, newAssignedStaffID)
 }
 
 func (s *performanceManagementService) CloseRequest(ctx context.Context, requestID string) error {
+	if err := s.authorizeFeedbackRequest(ctx, requestID); err != nil {
+		return err
+	}
 	return s.feedbackReq.CloseRequest(ctx, requestID)
 }
 
@@ -591,13 +737,36 @@ func (s *performanceManagementService) ReactivateReviewPeriodRequest(ctx context
 }
 
 func (s *performanceManagementService) ReInitiateSameRequest(ctx context.Context, requestID string) error {
+	if err := s.authorizeFeedbackRequest(ctx, requestID); err != nil {
+		return err
+	}
 	return s.feedbackReq.ReInitiateSameRequest(ctx, requestID)
 }
 
 func (s *performanceManagementService) TreatAssignedRequest(ctx context.Context, req *performance.TreatFeedbackRequestModel) error {
+	if err := s.authorizeFeedbackRequest(ctx, req.RequestID); err != nil {
+		return err
+	}
 	return s.feedbackReq.TreatAssignedRequest(ctx, req)
 }
 
+// authorizeFeedbackRequest returns ErrOutOfScope unless the caller reaches
+// the owner or the assignee of a feedback request. Unknown requests are left
+// to the delegated call to report.
+func (s *performanceManagementService) authorizeFeedbackRequest(ctx context.Context, requestID string) error {
+	var request performance.FeedbackRequestLog
+	if err := s.db.WithContext(ctx).
+		Select("request_owner_staff_id", "assigned_staff_id").
+		Where("feedback_request_log_id = ?", requestID).
+		Limit(1).Find(&request).Error; err != nil {
+		return fmt.Errorf("loading feedback request: %w", err)
+	}
+	if request.RequestOwnerStaffID == "" && request.AssignedStaffID == "" {
+		return nil
+	}
+	return s.scope.AuthorizeAnyStaff(ctx, request.RequestOwnerStaffID, request.AssignedStaffID)
+}
+
 func (s *performanceManagementService) HasLineManager(ctx context.Context, staffID string) (bool, error) {
 	return s.feedbackReq.HasLineManager(ctx, staffID)
 }
@@ -607,6 +776,9 @@ func (s *performanceManagementService) HasVacationRule(ctx context.Context, staf
 }
 
 func (s *performanceManagementService) GetStaffLeaveDays(ctx context.Context, staffID string, startDate, endDate time.Time) (performance.LeaveResponseVm, error) {
+	if err := s.scope.AuthorizeStaff(ctx, staffID); err != nil {
+		return performance.LeaveResponseVm{}, err
+	}
 	return s.feedbackReq.GetStaffLeaveDays(ctx, staffID, startDate, endDate)
 }
 
@@ -624,6 +796,9 @@ func (s *performanceManagementService) AutoReassignAndLogRequest(ctx context.Con
 // =========================================================================
 
 func (s *performanceManagementService) CompetencyReviewFeedbackSetup(ctx context.Context, req *performance.CompetencyReviewFeedbackRequestModel) (performance.ResponseVm, error) {
+	if err := s.scope.AuthorizeStaff(ctx, req.StaffID); err != nil {
+		return performance.ResponseVm{}, err
+	}
 	return s.competencyReview.CompetencyReviewFeedbackSetup(ctx, req)
 }
 
@@ -644,10 +819,16 @@ func (s *performanceManagementService) GetCompetencyReviewFeedbackDetails(ctx co
 }
 
 func (s *performanceManagementService) GetAllCompetencyReviewFeedbacks(ctx context.Context, staffID string) (performance.CompetencyReviewFeedbackListResponseVm, error) {
+	if err := s.scope.AuthorizeStaff(ctx, staffID); err != nil {
+		return performance.CompetencyReviewFeedbackListResponseVm{}, err
+	}
 	return s.competencyReview.GetAllCompetencyReviewFeedbacks(ctx, staffID)
 }
 
 func (s *performanceManagementService) GetCompetencyReviews(ctx context.Context, reviewerStaffID string) (performance.CompetencyReviewersListResponseVm, error) {
+	if err := s.scope.AuthorizeStaff(ctx, reviewerStaffID); err != nil {
+		return performance.CompetencyReviewersListResponseVm{}, err
+	}
 	return s.competencyReview.GetCompetencyReviews(ctx, reviewerStaffID)
 }
 
@@ -656,13 +837,60 @@ func (s *performanceManagementService) GetReviewerFeedbackDetails(ctx context.Co
 }
 
 func (s *performanceManagementService) GetQuestionnaire(ctx context.Context, staffID string) (performance.QuestionnaireListResponseVm, error) {
+	if err := s.scope.AuthorizeStaff(ctx, staffID); err != nil {
+		return performance.QuestionnaireListResponseVm{}, err
+	}
 	return s.competencyReview.GetQuestionnaire(ctx, staffID)
 }
 
 func (s *performanceManagementService) CompetencyGapClosureSetup(ctx context.Context, req *performance.CompetencyGapClosureRequestModel) (performance.ResponseVm, error) {
+	if err := s.scope.AuthorizeStaff(ctx, req.StaffID); err != nil {
+		return performance.ResponseVm{}, err
+	}
 	return s.competencyReview.CompetencyGapClosureSetup(ctx, req)
 }
 
+func (s *performanceManagementService) GetPendingCompetencyGapClosures(ctx context.Context) ([]performance.CompetencyGapClosureRequestModel, error) {
+	return s.competencyReview.GetPendingCompetencyGapClosures(ctx)
+}
+
+func (s *performanceManagementService) CreditCompetencyGapClosure(ctx context.Context, req *performance.CompetencyGapClosureRequestModel) (performance.ResponseVm, error) {
+	if err := s.scope.AuthorizeStaff(ctx, req.StaffID); err != nil {
+		return performance.ResponseVm{}, err
+	}
+	return s.competencyReview.CreditCompetencyGapClosure(ctx, req)
+}
+
+// GetCompetencyGapClosureReport lists only the converted gaps of staff in
+// the caller's data scope.
+func (s *performanceManagementService) GetCompetencyGapClosureReport(ctx context.Context, reviewPeriodID string) (performance.CompetencyGapClosureReportResponseVm, error) {
+	resp, err := s.competencyReview.GetCompetencyGapClosureReport(ctx, reviewPeriodID)
+	if err != nil {
+		return resp, err
+	}
+	staff := make([]string, 0, len(resp.Data))
+	for _, item := range resp.Data {
+		staff = append(staff, item.StaffID)
+	}
+	visible, err := s.scope.FilterStaff(ctx, staff)
+	if err != nil {
+		return performance.CompetencyGapClosureReportResponseVm{}, err
+	}
+	allowed := make(map[string]bool, len(visible))
+	for _, id := range visible {
+		allowed[id] = true
+	}
+	data := resp.Data[:0]
+	for _, item := range resp.Data {
+		if allowed[item.StaffID] {
+			data = append(data, item)
+		}
+	}
+	resp.Data = data
+	resp.TotalRecord = len(data)
+	return resp, nil
+}
+
 func (s *performanceManagementService) Initiate360Review(ctx context.Context, req *performance.Initiate360ReviewRequestModel) (performance.ResponseVm, error) {
 	return s.competencyReview.Initiate360Review(ctx, req)
 }
@@ -713,6 +941,10 @@ func (s *performanceManagementService) GetDepartmentsbyObjective(ctx context.Con
 // =========================================================================
 
 func (s *performanceManagementService) GetCompetencyReview(ctx context.Context, staffID string) (interface{}, error) {
+	if err := s.scope.AuthorizeStaff(ctx, staffID); err != nil {
+		return nil, err
+	}
+
 	resp := performance.CompetencyReviewFeedbackListResponseVm{}
 
 	var feedbacks []performance.CompetencyReviewFeedback
@@ -857,6 +1089,10 @@ func (s *performanceManagementService) SetupProject(ctx context.Context, req int
 }
 
 func (s *performanceManagementService) GetProjectsByStaff(ctx context.Context, staffID string) (interface{}, error) {
+	if err := s.scope.AuthorizeStaff(ctx, staffID); err != nil {
+		return nil, err
+	}
+
 	resp := performance.ProjectListResponseVm{}
 
 	var projects []performance.Project
@@ -1092,6 +1328,9 @@ func (s *performanceManagementService) EvaluateWorkProduct(ctx context.Context,
 		First(&wp).Error; err != nil {
 		return fmt.Errorf("work product not found: %w", err)
 	}
+	if err := s.scope.AuthorizeStaff(ctx, wp.StaffID); err != nil {
+		return err
+	}
 
 	if wp.RecordStatus != enums.StatusAwaitingEvaluation.String() &&
 		wp.RecordStatus != enums.StatusReEvaluate.String() {
@@ -1215,6 +1454,10 @@ func (s *performanceManagementService) RequestFeedback(ctx context.Context, req
 }
 
 func (s *performanceManagementService) GetFeedbackRequests(ctx context.Context, staffID string) (interface{}, error) {
+	if err := s.scope.AuthorizeStaff(ctx, staffID); err != nil {
+		return nil, err
+	}
+
 	resp := performance.FeedbackRequestListResponseVm{}
 
 	var requests []performance.FeedbackRequestLog
@@ -1334,16 +1577,27 @@ func (s *performanceManagementService) GetAuditLogDetails(ctx context.Context, i
 // =========================================================================
 
 func (s *performanceManagementService) GetLineManagerEmployees(ctx context.Context, staffID string, category enums.LineManagerPerformanceCategory) (interface{}, error) {
+	if err := s.scope.AuthorizeStaff(ctx, staffID); err != nil {
+		return nil, err
+	}
+
 	_ = category // category filtering to be added when business rules are finalized
 	return s.erpEmployeeSvc.GetEmployeeSubordinates(ctx, staffID)
 }
 
 func (s *performanceManagementService) GetAdhocAssignmentEmployees(ctx context.Context, leadStaffID string, category enums.LineManagerPerformanceCategory) (interface{}, error) {
+	if err := s.scope.AuthorizeStaff(ctx, leadStaffID); err != nil {
+		return nil, err
+	}
+
 	_ = category // category filtering to be added when business rules are finalized
 	return s.erpEmployeeSvc.GetEmployeeSubordinates(ctx, leadStaffID)
 }
 
 func (s *performanceManagementService) GetMyStaff(ctx context.Context, managerID string) (interface{}, error) {
+	if err := s.scope.AuthorizeStaff(ctx, managerID); err != nil {
+		return nil, err
+	}
 	return s.erpEmployeeSvc.GetEmployeeSubordinates(ctx, managerID)
 }
 
@@ -1388,39 +1642,52 @@ func excludedStatuses() []string {
 	}
 }
 
-// getGrade maps a percentage score to a PerformanceGrade.
-// Mirrors the .NET GetGrade method exactly.
-func getGrade(percentageScore float64) enums.PerformanceGrade {
-	switch {
-	case percentageScore < 30:
-		return enums.PerformanceGradeProbation
-	case percentageScore < 50:
-		return enums.PerformanceGradeDeveloping
-	case percentageScore < 66:
-		return enums.PerformanceGradeProgressive
-	case percentageScore < 80:
-		return enums.PerformanceGradeCompetent
-	case percentageScore < 90:
-		return enums.PerformanceGradeAccomplished
-	default:
-		return enums.PerformanceGradeExemplary
+// gradeScaleFor returns the grading scale for a review period, falling back
+// to the default scale when the attached scale cannot be loaded.
+func (s *performanceManagementService) gradeScaleFor(ctx context.Context, period performance.PerformanceReviewPeriod) GradeScale {
+	if s.gradingScaleSvc == nil {
+		return DefaultGradeScale()
 	}
+	scale, err := s.gradingScaleSvc.ResolveForReviewPeriod(ctx, period)
+	if err != nil {
+		s.log.Error().Err(err).Str("reviewPeriodId", period.PeriodID).Msg("failed to resolve grading scale; using default")
+	}
+	return scale
 }
 
-// getSLAConfig retrieves the SLA configuration values from global settings.
-// Returns (REQUEST_SLA_IN_HOURS, PMS_360_FEEDBACK_REQUEST_SLA_IN_HOURS).
-func (s *performanceManagementService) getSLAConfig(ctx context.Context) (int, int) {
-	requestSLA := 168 // default 168 hours / 7 days
-	pms360SLA := 336  // default 336 hours / 14 days
-
-	if val, err := s.globalSettingSvc.GetIntValue(ctx, "REQUEST_SLA_IN_HOURS"); err == nil {
-		requestSLA = val
+// gradeScaleForPeriodID is gradeScaleFor for callers holding only the ID.
+func (s *performanceManagementService) gradeScaleForPeriodID(ctx context.Context, reviewPeriodID string) GradeScale {
+	if s.gradingScaleSvc == nil {
+		return DefaultGradeScale()
 	}
-	if val, err := s.globalSettingSvc.GetIntValue(ctx, "PMS_360_FEEDBACK_REQUEST_SLA_IN_HOURS"); err == nil {
-		pms360SLA = val
+	scale, err := s.gradingScaleSvc.ResolveForReviewPeriodID(ctx, reviewPeriodID)
+	if err != nil {
+		s.log.Error().Err(err).Str("reviewPeriodId", reviewPeriodID).Msg("failed to resolve grading scale; using default")
 	}
+	return scale
+}
 
-	return requestSLA, pms360SLA
+// applyPeriodScoreGrade grades a period score's percentage against scale and
+// records which scale version produced the grade.
+func applyPeriodScoreGrade(score *performance.PeriodScore, scale GradeScale) {
+	band := scale.Band(decimal.NewFromFloat(score.ScorePercentage))
+	score.FinalGrade = band.Grade
+	score.FinalGradeLabel = band.Label
+	score.IsUnderPerforming = band.IsUnderPerforming
+	score.GradingScaleID = nil
+	if scale.ScaleID != "" {
+		id := scale.ScaleID
+		score.GradingScaleID = &id
+	}
+}
+
+// periodScoreGradeName is the display name of a period score's grade: the
+// band label it was graded with, or the grade name for older scores.
+func periodScoreGradeName(score performance.PeriodScore) string {
+	if score.FinalGradeLabel != "" {
+		return score.FinalGradeLabel
+	}
+	return score.FinalGrade.String()
 }
 
 // getStaffActiveReviewPeriod retrieves the active review period for a staff member.
@@ -1451,41 +1718,6 @@ func (s *performanceManagementService) getStaffActiveReviewPeriod(ctx context.Co
 	return &period, nil
 }
 
-// countSLABreachedRequests counts the number of requests that have breached
-// their SLA, accounting for leave days and public holidays.
-// Mirrors the .NET GetRequestCount method.
-func (s *performanceManagementService) countSLABreachedRequests(
-	requests []performance.FeedbackRequestLog,
-	staffID string,
-	slaHours int,
-) int {
-	count := 0
-	now := time.Now()
-
-	for _, r := range requests {
-		adjustedSLA := slaHours
-		// Note: Leave days and public holiday adjustments would be fetched
-		// from external services in production. Preserved as placeholder
-		// matching .NET business logic.
-
-		if r.TimeCompleted != nil {
-			// Request completed: check if it exceeded SLA
-			completionHours := r.TimeCompleted.Sub(r.TimeInitiated).Hours()
-			if completionHours > float64(adjustedSLA) {
-				count++
-			}
-		} else {
-			// Request still pending: check if SLA window has passed
-			deadline := r.TimeInitiated.Add(time.Duration(adjustedSLA) * time.Hour)
-			if deadline.Before(now) || deadline.Equal(now) {
-				count++
-			}
-		}
-	}
-
-	return count
-}
-
 // recalculateDeductedPoints recalculates and updates the deducted points
 // for a staff member based on SLA breaches.
 // Mirrors the .NET RecalculateDeductedPoints method.
@@ -1503,42 +1735,15 @@ func (s *performanceManagementService) recalculateDeductedPoints(ctx context.Con
 		return
 	}
 
-	requestSLA, pms360SLA := s.getSLAConfig(ctx)
-
 	// Get all feedback requests for this staff in this review period
 	var requests []performance.FeedbackRequestLog
 	s.db.WithContext(ctx).
 		Where("assigned_staff_id = ? AND review_period_id = ?", staffID, reviewPeriodID).
 		Find(&requests)
 
-	now := time.Now()
-	deductedPoints := 0.0
-
-	if len(requests) > 0 {
-		// Non-360 breached requests
-		var nonPMSBreached []performance.FeedbackRequestLog
-		for _, r := range requests {
-			if r.FeedbackRequestType != enums.FeedbackRequest360ReviewFeedback &&
-				r.HasSLA &&
-				r.TimeInitiated.Add(time.Duration(requestSLA)*time.Hour).Before(now) {
-				nonPMSBreached = append(nonPMSBreached, r)
-			}
-		}
-		nonPMSCount := s.countSLABreachedRequests(nonPMSBreached, staffID, requestSLA)
-
-		// 360 breached requests
-		var pmsBreached []performance.FeedbackRequestLog
-		for _, r := range requests {
-			if r.FeedbackRequestType == enums.FeedbackRequest360ReviewFeedback &&
-				r.HasSLA &&
-				r.TimeInitiated.Add(time.Duration(pms360SLA)*time.Hour).Before(now) {
-				pmsBreached = append(pmsBreached, r)
-			}
-		}
-		pmsCount := s.countSLABreachedRequests(pmsBreached, staffID, pms360SLA)
-
-		deductedPoints = float64(nonPMSCount + pmsCount)
-	}
+	// One point per request that breached its SLA on the business calendar
+	sla := s.feedbackReq.sla.evaluator(ctx, requests)
+	deductedPoints := float64(countWhere(requests, sla.breached))
 
 	if deductedPoints > reviewPeriod.MaxPoints {
 		deductedPoints = reviewPeriod.MaxPoints
@@ -1553,13 +1758,14 @@ func (s *performanceManagementService) recalculateDeductedPoints(ctx context.Con
 	if err != nil {
 		// Create new period score
 		periodScore = performance.PeriodScore{
+			PeriodScoreID:     GenerateID(),
 			ReviewPeriodID:    reviewPeriodID,
 			StaffID:           staffID,
-			FinalGrade:        enums.PerformanceGradeDeveloping,
 			HRDDeductedPoints: deductedPoints,
 			EndDate:           reviewPeriod.EndDate,
 			StrategyID:        reviewPeriod.StrategyID,
 		}
+		applyPeriodScoreGrade(&periodScore, s.gradeScaleFor(ctx, reviewPeriod))
 		periodScore.RecordStatus = enums.StatusActive.String()
 		periodScore.IsActive = true
 
@@ -1568,6 +1774,7 @@ func (s *performanceManagementService) recalculateDeductedPoints(ctx context.Con
 		}
 	} else {
 		periodScore.HRDDeductedPoints = deductedPoints
+		applyPeriodScoreGrade(&periodScore, s.gradeScaleFor(ctx, reviewPeriod))
 		if err := s.db.WithContext(ctx).Save(&periodScore).Error; err != nil {
 			s.log.Error().Err(err).Msg("failed to update period score deducted points")
 		}
@@ -301,7 +301,7 @@ func (ps *periodScoreService) mapPeriodScoreToData(score performance.PeriodScore
 		FinalScore:        score.FinalScore,
 		ScorePercentage:   score.ScorePercentage,
 		FinalGrade:        int(score.FinalGrade),
-		FinalGradeName:    score.FinalGrade.String(),
+		FinalGradeName:    periodScoreGradeName(score),
 		EndDate:           score.EndDate,
 		OfficeID:          score.OfficeID,
 		MinNoOfObjectives: score.MinNoOfObjectives,
@@ -312,6 +312,9 @@ func (ps *periodScoreService) mapPeriodScoreToData(score performance.PeriodScore
 		HRDDeductedPoints: score.HRDDeductedPoints,
 		IsUnderPerforming: score.IsUnderPerforming,
 	}
+	data.CalibratedGrade = int(score.CalibratedGrade)
+	data.CalibratedGradeName = calibratedGradeName(score)
+	data.IsCalibrated = score.IsCalibrated
 
 	// Enrich from ReviewPeriod navigation
 	if score.ReviewPeriod != nil {
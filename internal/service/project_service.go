@@ -164,7 +164,11 @@ func (ps *projectService) addProject(ctx context.Context, req *performance.Proje
 			DepartmentID:   req.DepartmentID,
 		},
 	}
-	project.RecordStatus = enums.StatusPendingApproval.String()
+	submitted, err := nextApprovalStatus(ctx, ps.parent.chainSvc, enums.ApprovalEntityProject, enums.StatusDraft.String(), enums.OperationAdd)
+	if err != nil {
+		return resp, err
+	}
+	project.RecordStatus = submitted.String()
 	project.IsActive = true
 	project.CreatedBy = req.CreatedBy
 
@@ -206,35 +210,53 @@ func (ps *projectService) updateProject(ctx context.Context, req *performance.Pr
 func (ps *projectService) approveProject(ctx context.Context, req *performance.ProjectRequestModel) (performance.ResponseVm, error) {
 	resp := performance.ResponseVm{}
 
-	now := time.Now().UTC()
-	ps.db.WithContext(ctx).Model(&performance.Project{}).
+	auditCtx, actor, next, err := ps.authorizeProjectApproval(ctx, req, enums.OperationApprove)
+	if err != nil {
+		return resp, err
+	}
+
+	updates := map[string]interface{}{"record_status": next.String()}
+	resp.Message = "project approved and sent to the next approval stage"
+	if next == enums.StatusApprovedAndActive {
+		updates["record_status"] = enums.StatusActive.String()
+		updates["is_active"] = true
+		updates["is_approved"] = true
+		updates["approved_by"] = actor.StaffID
+		updates["date_approved"] = time.Now().UTC()
+		resp.Message = "project approved successfully"
+	}
+	if err := ps.db.WithContext(auditCtx).Model(&performance.Project{}).
 		Where("project_id = ?", req.ProjectID).
-		Updates(map[string]interface{}{
-			"record_status": enums.StatusActive.String(),
-			"is_active":     true,
-			"is_approved":   true,
-			"approved_by":   req.UpdatedBy,
-			"date_approved":  now,
-		})
+		Updates(updates).Error; err != nil {
+		return resp, fmt.Errorf("approving project: %w", err)
+	}
+	recordApprovalAction(ctx, ps.parent.delegationSvc, actor, performance.Project{}.TableName(), req.ProjectID, enums.OperationApprove)
 
 	resp.ID = req.ProjectID
-	resp.Message = "project approved successfully"
 	return resp, nil
 }
 
 func (ps *projectService) rejectProject(ctx context.Context, req *performance.ProjectRequestModel) (performance.ResponseVm, error) {
 	resp := performance.ResponseVm{}
 
+	auditCtx, actor, next, err := ps.authorizeProjectApproval(ctx, req, enums.OperationReject)
+	if err != nil {
+		return resp, err
+	}
+
 	now := time.Now().UTC()
-	ps.db.WithContext(ctx).Model(&performance.Project{}).
+	if err := ps.db.WithContext(auditCtx).Model(&performance.Project{}).
 		Where("project_id = ?", req.ProjectID).
 		Updates(map[string]interface{}{
-			"record_status":    enums.StatusRejected.String(),
+			"record_status":    next.String(),
 			"is_rejected":      true,
-			"rejected_by":      req.UpdatedBy,
+			"rejected_by":      actor.StaffID,
 			"rejection_reason": req.RejectionReason,
 			"date_rejected":    now,
-		})
+		}).Error; err != nil {
+		return resp, fmt.Errorf("rejecting project: %w", err)
+	}
+	recordApprovalAction(ctx, ps.parent.delegationSvc, actor, performance.Project{}.TableName(), req.ProjectID, enums.OperationReject)
 
 	resp.ID = req.ProjectID
 	resp.Message = "project rejected successfully"
@@ -244,26 +266,63 @@ func (ps *projectService) rejectProject(ctx context.Context, req *performance.Pr
 func (ps *projectService) returnProject(ctx context.Context, req *performance.ProjectRequestModel) (performance.ResponseVm, error) {
 	resp := performance.ResponseVm{}
 
-	ps.db.WithContext(ctx).Model(&performance.Project{}).
+	auditCtx, actor, next, err := ps.authorizeProjectApproval(ctx, req, enums.OperationReturn)
+	if err != nil {
+		return resp, err
+	}
+
+	if err := ps.db.WithContext(auditCtx).Model(&performance.Project{}).
 		Where("project_id = ?", req.ProjectID).
 		Updates(map[string]interface{}{
-			"record_status": enums.StatusReturned.String(),
-		})
+			"record_status": next.String(),
+		}).Error; err != nil {
+		return resp, fmt.Errorf("returning project: %w", err)
+	}
+	recordApprovalAction(ctx, ps.parent.delegationSvc, actor, performance.Project{}.TableName(), req.ProjectID, enums.OperationReturn)
 
 	resp.ID = req.ProjectID
 	resp.Message = "project returned successfully"
 	return resp, nil
 }
 
+// authorizeProjectApproval loads the project and checks that the caller is its
+// approver or holds a delegation from them, and returns the status op moves it
+// to under the active approval chain.
+func (ps *projectService) authorizeProjectApproval(ctx context.Context, req *performance.ProjectRequestModel, op enums.OperationType) (context.Context, ApprovalActor, enums.Status, error) {
+	var rec performance.Project
+	if err := ps.db.WithContext(ctx).
+		Where("project_id = ?", req.ProjectID).First(&rec).Error; err != nil {
+		return ctx, ApprovalActor{}, 0, fmt.Errorf("project not found: %w", err)
+	}
+	next, err := nextApprovalStatus(ctx, ps.parent.chainSvc, enums.ApprovalEntityProject, rec.RecordStatus, op)
+	if err != nil {
+		return ctx, ApprovalActor{}, 0, err
+	}
+	auditCtx, actor, err := authorizeApproval(ctx, ps.parent.delegationSvc, enums.ApprovalEntityProject, rec.RecordStatus, rec.ProjectManager, req.UpdatedBy)
+	return auditCtx, actor, next, err
+}
+
 func (ps *projectService) reSubmitProject(ctx context.Context, req *performance.ProjectRequestModel) (performance.ResponseVm, error) {
 	resp := performance.ResponseVm{}
 
-	ps.db.WithContext(ctx).Model(&performance.Project{}).
+	var rec performance.Project
+	if err := ps.db.WithContext(ctx).
+		Where("project_id = ?", req.ProjectID).First(&rec).Error; err != nil {
+		return resp, fmt.Errorf("project not found: %w", err)
+	}
+	next, err := nextApprovalStatus(ctx, ps.parent.chainSvc, enums.ApprovalEntityProject, rec.RecordStatus, enums.OperationReSubmit)
+	if err != nil {
+		return resp, err
+	}
+
+	if err := ps.db.WithContext(ctx).Model(&performance.Project{}).
 		Where("project_id = ?", req.ProjectID).
 		Updates(map[string]interface{}{
-			"record_status": enums.StatusPendingApproval.String(),
+			"record_status": next.String(),
 			"is_rejected":   false,
-		})
+		}).Error; err != nil {
+		return resp, fmt.Errorf("re-submitting project: %w", err)
+	}
 
 	resp.ID = req.ProjectID
 	resp.Message = "project re-submitted successfully"
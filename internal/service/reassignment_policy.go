@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/enterprise-pms/pms-api/internal/config"
+	"github.com/enterprise-pms/pms-api/internal/domain/auth"
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"github.com/enterprise-pms/pms-api/internal/repository"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+)
+
+// Reassignment policy names, as listed in reassignment.policies and recorded
+// on each FeedbackRequestReassignment.
+const (
+	reassignPolicyDelegation   = "delegation"
+	reassignPolicyVacationRule = "vacation_rule"
+	reassignPolicySupervisor   = "supervisor"
+	reassignPolicyOrgChain     = "org_chain"
+	reassignPolicyPool         = "pool"
+	reassignPolicyHrdQueue     = "hrd_queue"
+)
+
+// reassignmentPolicy picks the staff member a breached feedback request
+// moves to. It returns "" when it has no eligible candidate, in which case
+// the next configured policy is tried. Staff in exclude are never eligible.
+type reassignmentPolicy interface {
+	name() string
+	target(ctx context.Context, r performance.FeedbackRequestLog, exclude map[string]bool) (string, error)
+}
+
+// newReassignmentPolicies builds the policies listed in cfg.Policies, in
+// order. Unknown names are logged and skipped.
+func newReassignmentPolicies(cfg config.ReassignmentConfig, db *gorm.DB, erpRepo *repository.ErpRepository, log zerolog.Logger) []reassignmentPolicy {
+	var policies []reassignmentPolicy
+	for _, name := range cfg.Policies {
+		switch name {
+		case reassignPolicySupervisor, reassignPolicyOrgChain:
+			policies = append(policies, &orgReassignmentPolicy{policy: name, erpRepo: erpRepo})
+		case reassignPolicyPool:
+			if len(cfg.Pool) == 0 {
+				log.Warn().Msg("reassignment policy \"pool\" is configured but reassignment.pool is empty")
+			}
+			pool := cfg.Pool
+			policies = append(policies, &poolReassignmentPolicy{policy: name, db: db,
+				members: func(context.Context) ([]string, error) { return pool, nil }})
+		case reassignPolicyHrdQueue:
+			policies = append(policies, &poolReassignmentPolicy{policy: name, db: db,
+				members: func(ctx context.Context) ([]string, error) {
+					staff, err := staffInRole(ctx, db, auth.RoleHRD)
+					sort.Strings(staff)
+					return staff, err
+				}})
+		default:
+			log.Warn().Str("policy", name).Msg("ignoring unknown reassignment policy")
+		}
+	}
+	return policies
+}
+
+// orgReassignmentPolicy moves a request up the assignee's reporting line in
+// ERP: to their supervisor, or for org_chain to the nearest head of their
+// office, division or department.
+type orgReassignmentPolicy struct {
+	policy  string
+	erpRepo *repository.ErpRepository
+}
+
+func (p *orgReassignmentPolicy) name() string { return p.policy }
+
+func (p *orgReassignmentPolicy) target(ctx context.Context, r performance.FeedbackRequestLog, exclude map[string]bool) (string, error) {
+	if p.erpRepo == nil {
+		return "", nil
+	}
+	emp, err := p.erpRepo.GetEmployeeByID(ctx, r.AssignedStaffID)
+	if err != nil {
+		return "", fmt.Errorf("resolving reporting line of %s: %w", r.AssignedStaffID, err)
+	}
+
+	candidates := []string{emp.SupervisorID}
+	if p.policy == reassignPolicyOrgChain {
+		candidates = []string{emp.HeadOfOfficeID, emp.HeadOfDivID, emp.HeadOfDeptID}
+	}
+	for _, c := range candidates {
+		if c != "" && !exclude[c] {
+			return c, nil
+		}
+	}
+	return "", nil
+}
+
+// vacationRulePolicy moves a request to the assignee's line manager while
+// the assignee has an ERP vacation rule in effect. It is not configurable:
+// AutoReassignAndLogRequest always tries it after delegations and before the
+// policies in reassignment.policies.
+type vacationRulePolicy struct {
+	onVacation  func(ctx context.Context, staffID string) (bool, error)
+	lineManager reassignmentPolicy
+}
+
+func (p *vacationRulePolicy) name() string { return reassignPolicyVacationRule }
+
+func (p *vacationRulePolicy) target(ctx context.Context, r performance.FeedbackRequestLog, exclude map[string]bool) (string, error) {
+	onVacation, err := p.onVacation(ctx, r.AssignedStaffID)
+	if err != nil || !onVacation {
+		return "", err
+	}
+	return p.lineManager.target(ctx, r, exclude)
+}
+
+// poolReassignmentPolicy hands requests to the members of a pool in turn,
+// continuing after whoever the policy last reassigned a request to.
+type poolReassignmentPolicy struct {
+	policy  string
+	db      *gorm.DB
+	members func(ctx context.Context) ([]string, error)
+}
+
+func (p *poolReassignmentPolicy) name() string { return p.policy }
+
+func (p *poolReassignmentPolicy) target(ctx context.Context, _ performance.FeedbackRequestLog, exclude map[string]bool) (string, error) {
+	members, err := p.members(ctx)
+	if err != nil || len(members) == 0 {
+		return "", err
+	}
+
+	var last performance.FeedbackRequestReassignment
+	err = p.db.WithContext(ctx).
+		Where("policy = ?", p.policy).
+		Order("reassigned_at DESC").
+		First(&last).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", fmt.Errorf("loading last %s reassignment: %w", p.policy, err)
+	}
+	return nextInRotation(members, last.ToStaffID, exclude), nil
+}
+
+// nextInRotation returns the first eligible member after last, wrapping
+// around; when last is not a member the rotation starts from the top.
+func nextInRotation(members []string, last string, exclude map[string]bool) string {
+	start := 0
+	for i, m := range members {
+		if m == last {
+			start = i + 1
+			break
+		}
+	}
+	for k := range members {
+		if m := members[(start+k)%len(members)]; m != "" && !exclude[m] {
+			return m
+		}
+	}
+	return ""
+}
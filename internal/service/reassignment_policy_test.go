@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/config"
+	"github.com/enterprise-pms/pms-api/internal/domain/enums"
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"github.com/rs/zerolog"
+)
+
+func TestNextInRotation(t *testing.T) {
+	pool := []string{"A", "B", "C"}
+
+	tests := []struct {
+		name    string
+		last    string
+		exclude []string
+		want    string
+	}{
+		{"first run starts at the top", "", nil, "A"},
+		{"continues after the last member", "A", nil, "B"},
+		{"wraps around", "C", nil, "A"},
+		{"former member starts at the top", "Z", nil, "A"},
+		{"skips excluded members", "A", []string{"B"}, "C"},
+		{"wraps past excluded members", "B", []string{"C", "A"}, "B"},
+		{"everyone excluded", "A", []string{"A", "B", "C"}, ""},
+	}
+	for _, tt := range tests {
+		exclude := make(map[string]bool)
+		for _, e := range tt.exclude {
+			exclude[e] = true
+		}
+		if got := nextInRotation(pool, tt.last, exclude); got != tt.want {
+			t.Errorf("%s: nextInRotation = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestNewReassignmentPolicies(t *testing.T) {
+	policies := newReassignmentPolicies(config.ReassignmentConfig{
+		Policies: []string{"pool", "supervisor", "manager", "org_chain", "hrd_queue"},
+		Pool:     []string{"A"},
+	}, nil, nil, zerolog.Nop())
+
+	want := []string{reassignPolicyPool, reassignPolicySupervisor, reassignPolicyOrgChain, reassignPolicyHrdQueue}
+	if len(policies) != len(want) {
+		t.Fatalf("got %d policies, want %d", len(policies), len(want))
+	}
+	for i, p := range policies {
+		if p.name() != want[i] {
+			t.Errorf("policy %d = %q, want %q", i, p.name(), want[i])
+		}
+	}
+}
+
+// fixedPolicy proposes the same staff member for every request unless they
+// are excluded.
+type fixedPolicy struct {
+	policy string
+	to     string
+	err    error
+}
+
+func (p fixedPolicy) name() string { return p.policy }
+
+func (p fixedPolicy) target(_ context.Context, _ performance.FeedbackRequestLog, exclude map[string]bool) (string, error) {
+	if p.err != nil || exclude[p.to] {
+		return "", p.err
+	}
+	return p.to, nil
+}
+
+// fakeDelegations returns the same delegation for every lookup.
+type fakeDelegations struct {
+	DelegationService
+	delegate string
+	err      error
+}
+
+func (f fakeDelegations) FindActiveDelegation(ctx context.Context, delegatorStaffID string, entityType enums.ApprovalEntityType, at time.Time) (*performance.ApprovalDelegation, error) {
+	if f.err != nil || f.delegate == "" {
+		return nil, f.err
+	}
+	return &performance.ApprovalDelegation{DelegatorStaffID: delegatorStaffID, DelegateStaffID: f.delegate}, nil
+}
+
+func TestReassignTargetOrder(t *testing.T) {
+	lookupFailed := errors.New("lookup failed")
+
+	tests := []struct {
+		name        string
+		delegations fakeDelegations
+		onVacation  bool
+		vacationErr error
+		exclude     []string
+		wantTarget  string
+		wantPolicy  string
+	}{
+		{
+			name:        "delegation comes first",
+			delegations: fakeDelegations{delegate: "DELEGATE"},
+			onVacation:  true,
+			wantTarget:  "DELEGATE",
+			wantPolicy:  reassignPolicyDelegation,
+		},
+		{
+			name:       "vacation rule when there is no delegation",
+			onVacation: true,
+			wantTarget: "MANAGER",
+			wantPolicy: reassignPolicyVacationRule,
+		},
+		{
+			name:        "vacation rule when the delegate is excluded",
+			delegations: fakeDelegations{delegate: "DELEGATE"},
+			onVacation:  true,
+			exclude:     []string{"DELEGATE"},
+			wantTarget:  "MANAGER",
+			wantPolicy:  reassignPolicyVacationRule,
+		},
+		{
+			name:        "vacation rule when the delegation lookup fails",
+			delegations: fakeDelegations{err: lookupFailed},
+			onVacation:  true,
+			wantTarget:  "MANAGER",
+			wantPolicy:  reassignPolicyVacationRule,
+		},
+		{
+			name:       "configured policies when not on vacation",
+			wantTarget: "POOL",
+			wantPolicy: reassignPolicyPool,
+		},
+		{
+			name:       "configured policies when the line manager is excluded",
+			onVacation: true,
+			exclude:    []string{"MANAGER"},
+			wantTarget: "POOL",
+			wantPolicy: reassignPolicyPool,
+		},
+		{
+			name:        "configured policies when the vacation lookup fails",
+			vacationErr: lookupFailed,
+			wantTarget:  "POOL",
+			wantPolicy:  reassignPolicyPool,
+		},
+		{
+			name:       "nobody eligible",
+			onVacation: true,
+			exclude:    []string{"MANAGER", "POOL"},
+			wantTarget: "",
+			wantPolicy: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var checked []string
+			s := &feedbackRequestService{
+				log:    zerolog.Nop(),
+				parent: &performanceManagementService{delegationSvc: tt.delegations},
+				vacation: &vacationRulePolicy{
+					onVacation: func(_ context.Context, staffID string) (bool, error) {
+						checked = append(checked, staffID)
+						return tt.onVacation, tt.vacationErr
+					},
+					lineManager: fixedPolicy{policy: reassignPolicySupervisor, to: "MANAGER"},
+				},
+				reassignment: []reassignmentPolicy{fixedPolicy{policy: reassignPolicyPool, to: "POOL"}},
+			}
+			exclude := map[string]bool{"ASSIGNEE": true}
+			for _, e := range tt.exclude {
+				exclude[e] = true
+			}
+
+			target, policy := s.reassignTarget(context.Background(), performance.FeedbackRequestLog{AssignedStaffID: "ASSIGNEE"}, exclude)
+			if target != tt.wantTarget || policy != tt.wantPolicy {
+				t.Errorf("reassignTarget = (%q, %q), want (%q, %q)", target, policy, tt.wantTarget, tt.wantPolicy)
+			}
+			if tt.wantPolicy == reassignPolicyDelegation && len(checked) > 0 {
+				t.Error("vacation rule was checked although a delegation applied")
+			}
+			if tt.wantPolicy != reassignPolicyDelegation && (len(checked) != 1 || checked[0] != "ASSIGNEE") {
+				t.Errorf("vacation rule checked for %v, want the assignee", checked)
+			}
+		})
+	}
+}
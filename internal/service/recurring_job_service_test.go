@@ -0,0 +1,26 @@
+package service
+
+import "testing"
+
+func TestValidateCronSchedule(t *testing.T) {
+	tests := []struct {
+		schedule string
+		wantErr  bool
+	}{
+		{"@every 10m", false},
+		{"@daily", false},
+		{"0 2 * * *", false},
+		{"*/15 8-18 * * MON-FRI", false},
+		{"", true},
+		{"every 10 minutes", true},
+		{"0 0 2 * * *", true}, // seconds field is not supported
+		{"@every -5m", true},
+		{"@every 30s", true},
+	}
+	for _, tt := range tests {
+		err := validateCronSchedule(tt.schedule)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("validateCronSchedule(%q) error = %v, wantErr %v", tt.schedule, err, tt.wantErr)
+		}
+	}
+}
@@ -33,13 +33,16 @@ type reviewPeriodService struct {
 	periodObjEvalRepo   *repository.PMSRepository[performance.PeriodObjectiveEvaluation]
 	periodObjDeptEvalRepo *repository.PMSRepository[performance.PeriodObjectiveDepartmentEvaluation]
 	strategyRepo        *repository.PMSRepository[performance.Strategy]
+	chainSvc            ApprovalChainService
+	delegationSvc       DelegationService
+	scope               DataScopeService
 	db                  *gorm.DB
 	cfg                 *config.Config
 	log                 zerolog.Logger
 }
 
 // newReviewPeriodService creates a ReviewPeriodService with all required repositories.
-func newReviewPeriodService(repos *repository.Container, cfg *config.Config, log zerolog.Logger) ReviewPeriodService {
+func newReviewPeriodService(repos *repository.Container, cfg *config.Config, log zerolog.Logger, chainSvc ApprovalChainService, delegationSvc DelegationService, scope DataScopeService) ReviewPeriodService {
 	return &reviewPeriodService{
 		reviewPeriodRepo:      repository.NewPMSRepository[performance.PerformanceReviewPeriod](repos.GormDB),
 		periodObjectiveRepo:   repository.NewPMSRepository[performance.PeriodObjective](repos.GormDB),
@@ -56,6 +59,9 @@ func newReviewPeriodService(repos *repository.Container, cfg *config.Config, log
 		periodObjEvalRepo:     repository.NewPMSRepository[performance.PeriodObjectiveEvaluation](repos.GormDB),
 		periodObjDeptEvalRepo: repository.NewPMSRepository[performance.PeriodObjectiveDepartmentEvaluation](repos.GormDB),
 		strategyRepo:          repository.NewPMSRepository[performance.Strategy](repos.GormDB),
+		chainSvc:              chainSvc,
+		delegationSvc:         delegationSvc,
+		scope:                 scope,
 		db:                    repos.GormDB,
 		cfg:                   cfg,
 		log:                   log.With().Str("service", "review_period").Logger(),
@@ -873,6 +879,20 @@ func (s *reviewPeriodService) CloseReviewPeriod(ctx context.Context, vm *perform
 	rp.AllowWorkProductPlanning = false
 	rp.AllowWorkProductEvaluation = false
 
+	// Pin the scale inherited from the strategy so later scale versions do
+	// not regrade the closed period.
+	if rp.GradingScaleID == nil && rp.StrategyID != "" {
+		var strategy performance.Strategy
+		if err := s.db.WithContext(ctx).
+			Select("grading_scale_id").
+			Where("strategy_id = ?", rp.StrategyID).
+			Limit(1).Find(&strategy).Error; err != nil {
+			s.log.Error().Err(err).Msg("failed to load strategy grading scale")
+			return response, err
+		}
+		rp.GradingScaleID = strategy.GradingScaleID
+	}
+
 	if err := s.reviewPeriodRepo.UpdateAndSave(ctx, rp); err != nil {
 		s.log.Error().Err(err).Msg("failed to close review period")
 		return response, err
@@ -1020,6 +1040,10 @@ func (s *reviewPeriodService) GetStaffActiveReviewPeriod(ctx context.Context, st
 	response.HasError = true
 	response.Message = "An error occurred"
 
+	if err := s.scope.AuthorizeStaff(ctx, staffID); err != nil {
+		return response, err
+	}
+
 	// First check if staff has any planned objectives
 	plannedObjs, err := s.plannedObjRepo.Where(ctx,
 		"staff_id = ? AND record_status != ?",
@@ -1755,7 +1779,11 @@ func (s *reviewPeriodService) AddReviewPeriodExtension(ctx context.Context, vm *
 		StartDate:               vm.StartDate,
 		EndDate:                 vm.EndDate,
 	}
-	entity.RecordStatus = enums.StatusPendingApproval.String()
+	submitted, err := nextApprovalStatus(ctx, s.chainSvc, enums.ApprovalEntityReviewPeriodExtension, enums.StatusDraft.String(), enums.OperationAdd)
+	if err != nil {
+		return response, err
+	}
+	entity.RecordStatus = submitted.String()
 
 	if err := s.extensionRepo.InsertAndSave(ctx, entity); err != nil {
 		s.log.Error().Err(err).Msg("failed to add review period extension")
@@ -1911,6 +1939,10 @@ func (s *reviewPeriodService) SaveDraftIndividualPlannedObjective(ctx context.Co
 	response.HasError = true
 	response.Message = "An error occurred"
 
+	if err := s.scope.AuthorizeStaff(ctx, vm.StaffID); err != nil {
+		return response, err
+	}
+
 	rpResp, err := s.getReviewPeriod(ctx, vm.ReviewPeriodID)
 	if err != nil {
 		return response, err
@@ -1973,6 +2005,10 @@ func (s *reviewPeriodService) AddIndividualPlannedObjective(ctx context.Context,
 	response.HasError = true
 	response.Message = "An error occurred"
 
+	if err := s.scope.AuthorizeStaff(ctx, vm.StaffID); err != nil {
+		return response, err
+	}
+
 	rpResp, err := s.getReviewPeriod(ctx, vm.ReviewPeriodID)
 	if err != nil {
 		return response, err
@@ -2015,7 +2051,11 @@ func (s *reviewPeriodService) AddIndividualPlannedObjective(ctx context.Context,
 		StaffJobRole:       "",
 		ReviewPeriodID:     vm.ReviewPeriodID,
 	}
-	entity.RecordStatus = enums.StatusPendingApproval.String()
+	submitted, err := nextApprovalStatus(ctx, s.chainSvc, enums.ApprovalEntityIndividualPlannedObjective, enums.StatusDraft.String(), enums.OperationAdd)
+	if err != nil {
+		return response, err
+	}
+	entity.RecordStatus = submitted.String()
 
 	if err := s.plannedObjRepo.InsertAndSave(ctx, entity); err != nil {
 		s.log.Error().Err(err).Msg("failed to add planned objective")
@@ -2044,12 +2084,20 @@ func (s *reviewPeriodService) SubmitDraftIndividualPlannedObjective(ctx context.
 		return response, nil
 	}
 
+	if err := s.scope.AuthorizeStaff(ctx, po.StaffID); err != nil {
+		return response, err
+	}
+
 	if po.RecordStatus != enums.StatusDraft.String() {
 		response.Message = "Planned objective cannot be submitted; it is not in Draft status"
 		return response, nil
 	}
 
-	po.RecordStatus = enums.StatusPendingApproval.String()
+	submitted, err := nextApprovalStatus(ctx, s.chainSvc, enums.ApprovalEntityIndividualPlannedObjective, po.RecordStatus, enums.OperationCommitDraft)
+	if err != nil {
+		return response, err
+	}
+	po.RecordStatus = submitted.String()
 
 	if err := s.plannedObjRepo.UpdateAndSave(ctx, po); err != nil {
 		s.log.Error().Err(err).Msg("failed to submit draft planned objective")
@@ -2062,6 +2110,21 @@ func (s *reviewPeriodService) SubmitDraftIndividualPlannedObjective(ctx context.
 	return response, nil
 }
 
+// plannedObjectiveApprovalStatus is the approval chain status whose approvers
+// act on po. Acceptances and suspension requests sit outside the chain and go
+// to the approvers of its first stage.
+func (s *reviewPeriodService) plannedObjectiveApprovalStatus(ctx context.Context, po *performance.ReviewPeriodIndividualPlannedObjective) (string, error) {
+	switch po.RecordStatus {
+	case enums.StatusPendingAcceptance.String(), enums.StatusSuspensionPendingApproval.String():
+		first, err := nextApprovalStatus(ctx, s.chainSvc, enums.ApprovalEntityIndividualPlannedObjective, enums.StatusDraft.String(), enums.OperationAdd)
+		if err != nil {
+			return "", err
+		}
+		return first.String(), nil
+	}
+	return po.RecordStatus, nil
+}
+
 // ApproveIndividualPlannedObjective approves a planned objective.
 func (s *reviewPeriodService) ApproveIndividualPlannedObjective(ctx context.Context, vm *performance.ReviewPeriodIndividualPlannedObjectiveRequestModel) (*performance.ResponseVm, error) {
 	response := &performance.ResponseVm{}
@@ -2077,34 +2140,55 @@ func (s *reviewPeriodService) ApproveIndividualPlannedObjective(ctx context.Cont
 		return response, nil
 	}
 
-	if po.RecordStatus != enums.StatusPendingApproval.String() &&
-		po.RecordStatus != enums.StatusPendingAcceptance.String() &&
-		po.RecordStatus != enums.StatusSuspensionPendingApproval.String() {
-		response.Message = "Planned objective cannot be approved"
-		return response, nil
+	// Suspension requests and acceptances sit outside the approval chain.
+	var next enums.Status
+	switch po.RecordStatus {
+	case enums.StatusSuspensionPendingApproval.String():
+		next = enums.StatusPaused
+	case enums.StatusPendingAcceptance.String():
+		next = enums.StatusApprovedAndActive
+	default:
+		if next, err = nextApprovalStatus(ctx, s.chainSvc, enums.ApprovalEntityIndividualPlannedObjective, po.RecordStatus, enums.OperationApprove); err != nil {
+			response.Message = "Planned objective cannot be approved"
+			return response, nil
+		}
+	}
+
+	authStatus, err := s.plannedObjectiveApprovalStatus(ctx, po)
+	if err != nil {
+		return response, err
+	}
+	auditCtx, actor, err := authorizeApproval(ctx, s.delegationSvc, enums.ApprovalEntityIndividualPlannedObjective, authStatus, po.StaffID, vm.ApprovedBy)
+	if err != nil {
+		response.Message = err.Error()
+		return response, err
 	}
 
 	now := time.Now().UTC()
 
-	// If SuspensionPendingApproval, transition to Paused
-	if po.RecordStatus == enums.StatusSuspensionPendingApproval.String() {
-		po.RecordStatus = enums.StatusPaused.String()
-	} else {
+	switch next {
+	case enums.StatusApprovedAndActive, enums.StatusPaused:
 		po.RecordStatus = enums.StatusActive.String()
+		if next == enums.StatusPaused {
+			po.RecordStatus = enums.StatusPaused.String()
+		}
+		po.IsActive = true
+		po.IsApproved = true
+		po.ApprovedBy = actor.StaffID
+		po.DateApproved = &now
+		po.IsRejected = false
+		po.RejectedBy = ""
+		po.RejectionReason = ""
+	default:
+		// Approved at an intermediate stage; the next approver takes over.
+		po.RecordStatus = next.String()
 	}
 
-	po.IsActive = true
-	po.IsApproved = true
-	po.ApprovedBy = vm.ApprovedBy
-	po.DateApproved = &now
-	po.IsRejected = false
-	po.RejectedBy = ""
-	po.RejectionReason = ""
-
-	if err := s.plannedObjRepo.UpdateAndSave(ctx, po); err != nil {
+	if err := s.plannedObjRepo.UpdateAndSave(auditCtx, po); err != nil {
 		s.log.Error().Err(err).Msg("failed to approve planned objective")
 		return response, err
 	}
+	recordApprovalAction(ctx, s.delegationSvc, actor, po.TableName(), po.PlannedObjectiveID, enums.OperationApprove)
 
 	response.HasError = false
 	response.Message = "Operation completed"
@@ -2127,10 +2211,21 @@ func (s *reviewPeriodService) RejectIndividualPlannedObjective(ctx context.Conte
 		return response, nil
 	}
 
-	if po.RecordStatus != enums.StatusPendingApproval.String() &&
-		po.RecordStatus != enums.StatusPendingAcceptance.String() {
-		response.Message = "Planned objective cannot be rejected"
-		return response, nil
+	if po.RecordStatus != enums.StatusPendingAcceptance.String() {
+		if _, err := nextApprovalStatus(ctx, s.chainSvc, enums.ApprovalEntityIndividualPlannedObjective, po.RecordStatus, enums.OperationReject); err != nil {
+			response.Message = "Planned objective cannot be rejected"
+			return response, nil
+		}
+	}
+
+	authStatus, err := s.plannedObjectiveApprovalStatus(ctx, po)
+	if err != nil {
+		return response, err
+	}
+	auditCtx, actor, err := authorizeApproval(ctx, s.delegationSvc, enums.ApprovalEntityIndividualPlannedObjective, authStatus, po.StaffID, vm.RejectedBy)
+	if err != nil {
+		response.Message = err.Error()
+		return response, err
 	}
 
 	now := time.Now().UTC()
@@ -2138,16 +2233,17 @@ func (s *reviewPeriodService) RejectIndividualPlannedObjective(ctx context.Conte
 	po.RecordStatus = enums.StatusRejected.String()
 	po.IsActive = false
 	po.IsRejected = true
-	po.RejectedBy = vm.RejectedBy
+	po.RejectedBy = actor.StaffID
 	po.DateRejected = &now
 	po.RejectionReason = vm.RejectionReason
 	po.IsApproved = false
 	po.ApprovedBy = ""
 
-	if err := s.plannedObjRepo.UpdateAndSave(ctx, po); err != nil {
+	if err := s.plannedObjRepo.UpdateAndSave(auditCtx, po); err != nil {
 		s.log.Error().Err(err).Msg("failed to reject planned objective")
 		return response, err
 	}
+	recordApprovalAction(ctx, s.delegationSvc, actor, po.TableName(), po.PlannedObjectiveID, enums.OperationReject)
 
 	response.HasError = false
 	response.Message = "Operation completed"
@@ -2171,10 +2267,21 @@ func (s *reviewPeriodService) ReturnIndividualPlannedObjective(ctx context.Conte
 		return response, nil
 	}
 
-	if po.RecordStatus != enums.StatusPendingApproval.String() &&
-		po.RecordStatus != enums.StatusPendingAcceptance.String() {
-		response.Message = "Planned objective cannot be returned"
-		return response, nil
+	if po.RecordStatus != enums.StatusPendingAcceptance.String() {
+		if _, err := nextApprovalStatus(ctx, s.chainSvc, enums.ApprovalEntityIndividualPlannedObjective, po.RecordStatus, enums.OperationReturn); err != nil {
+			response.Message = "Planned objective cannot be returned"
+			return response, nil
+		}
+	}
+
+	authStatus, err := s.plannedObjectiveApprovalStatus(ctx, po)
+	if err != nil {
+		return response, err
+	}
+	auditCtx, actor, err := authorizeApproval(ctx, s.delegationSvc, enums.ApprovalEntityIndividualPlannedObjective, authStatus, po.StaffID, vm.RejectedBy)
+	if err != nil {
+		response.Message = err.Error()
+		return response, err
 	}
 
 	now := time.Now().UTC()
@@ -2183,14 +2290,14 @@ func (s *reviewPeriodService) ReturnIndividualPlannedObjective(ctx context.Conte
 	po.RecordStatus = enums.StatusReturned.String()
 	po.IsActive = false
 	po.IsRejected = true
-	po.RejectedBy = vm.RejectedBy
+	po.RejectedBy = actor.StaffID
 	po.DateRejected = &now
 	po.RejectionReason = vm.RejectionReason
 	po.IsApproved = false
 	po.ApprovedBy = ""
 	po.Remark = vm.Remark
 
-	if err := s.plannedObjRepo.UpdateAndSave(ctx, po); err != nil {
+	if err := s.plannedObjRepo.UpdateAndSave(auditCtx, po); err != nil {
 		s.log.Error().Err(err).Msg("failed to return planned objective")
 		return response, err
 	}
@@ -2198,6 +2305,7 @@ func (s *reviewPeriodService) ReturnIndividualPlannedObjective(ctx context.Conte
 	// NOTE: In .NET, auto-grievance is triggered when NoReturned >= MAX_RETURN_NO.
 	// This is handled by the grievance service integration which is out of scope
 	// for this conversion but the NoReturned counter is properly incremented above.
+	recordApprovalAction(ctx, s.delegationSvc, actor, po.TableName(), po.PlannedObjectiveID, enums.OperationReturn)
 
 	response.HasError = false
 	response.Message = "Operation completed"
@@ -2220,6 +2328,10 @@ func (s *reviewPeriodService) CancelIndividualPlannedObjective(ctx context.Conte
 		return response, nil
 	}
 
+	if err := s.scope.AuthorizeStaff(ctx, po.StaffID); err != nil {
+		return response, err
+	}
+
 	if po.RecordStatus != enums.StatusDraft.String() && po.RecordStatus != enums.StatusReturned.String() {
 		response.Message = "Planned objective cannot be cancelled"
 		return response, nil
@@ -2245,6 +2357,10 @@ func (s *reviewPeriodService) GetStaffIndividualPlannedObjectives(ctx context.Co
 	response.HasError = true
 	response.Message = "An error occurred"
 
+	if err := s.scope.AuthorizeStaff(ctx, staffID); err != nil {
+		return response, err
+	}
+
 	var plannedObjs []performance.ReviewPeriodIndividualPlannedObjective
 	err := s.db.WithContext(ctx).
 		Where("staff_id = ? AND review_period_id = ? AND soft_deleted = ?", staffID, reviewPeriodID, false).
@@ -2569,6 +2685,10 @@ func (s *reviewPeriodService) GetStaffPeriodScore(ctx context.Context, staffID,
 	response.HasError = true
 	response.Message = "An error occurred"
 
+	if err := s.scope.AuthorizeStaff(ctx, staffID); err != nil {
+		return response, err
+	}
+
 	score, err := s.periodScoreRepo.FirstOrDefaultWithPreload(ctx,
 		[]string{"ReviewPeriod", "Strategy"},
 		"staff_id = ? AND review_period_id = ?",
@@ -2589,7 +2709,7 @@ func (s *reviewPeriodService) GetStaffPeriodScore(ctx context.Context, staffID,
 		FinalScore:        score.FinalScore,
 		ScorePercentage:   score.ScorePercentage,
 		FinalGrade:        int(score.FinalGrade),
-		FinalGradeName:    score.FinalGrade.String(),
+		FinalGradeName:    periodScoreGradeName(*score),
 		EndDate:           score.EndDate,
 		OfficeID:          score.OfficeID,
 		MinNoOfObjectives: score.MinNoOfObjectives,
@@ -2600,6 +2720,9 @@ func (s *reviewPeriodService) GetStaffPeriodScore(ctx context.Context, staffID,
 		HRDDeductedPoints: score.HRDDeductedPoints,
 		IsUnderPerforming: score.IsUnderPerforming,
 	}
+	data.CalibratedGrade = int(score.CalibratedGrade)
+	data.CalibratedGradeName = calibratedGradeName(*score)
+	data.IsCalibrated = score.IsCalibrated
 	data.IsActive = score.IsActive
 	data.CreatedBy = score.CreatedBy
 	data.CreatedAt = score.CreatedAt
@@ -3030,6 +3153,10 @@ func (s *reviewPeriodService) ArchiveCancelledObjectives(ctx context.Context, st
 	response.HasError = true
 	response.Message = "An error occurred"
 
+	if err := s.scope.AuthorizeStaff(ctx, staffID); err != nil {
+		return response, err
+	}
+
 	err := s.db.WithContext(ctx).
 		Exec("CALL pms.archive_cancelled_objectives(?, ?)", staffID, reviewPeriodID).Error
 	if err != nil {
@@ -3051,6 +3178,10 @@ func (s *reviewPeriodService) ArchiveCancelledWorkProducts(ctx context.Context,
 	response.HasError = true
 	response.Message = "An error occurred"
 
+	if err := s.scope.AuthorizeStaff(ctx, staffID); err != nil {
+		return response, err
+	}
+
 	err := s.db.WithContext(ctx).
 		Exec("CALL pms.archive_cancelled_workproducts(?, ?)", staffID, reviewPeriodID).Error
 	if err != nil {
@@ -3145,18 +3276,25 @@ func (s *reviewPeriodService) reviewPeriodExtensionSetup(ctx context.Context, vm
 
 	now := time.Now().UTC()
 
+	// Submission and approval steps follow the active approval chain.
+	var next enums.Status
 	switch op {
-	case enums.OperationCommitDraft:
-		if ext.RecordStatus != enums.StatusDraft.String() {
-			response.Message = "Extension cannot be submitted; it is not in Draft status"
+	case enums.OperationCommitDraft, enums.OperationApprove, enums.OperationReject, enums.OperationReturn, enums.OperationReSubmit:
+		if next, err = nextApprovalStatus(ctx, s.chainSvc, enums.ApprovalEntityReviewPeriodExtension, ext.RecordStatus, op); err != nil {
+			response.Message = fmt.Sprintf("Extension cannot be %s in %s status", extensionOperationVerb(op), ext.RecordStatus)
 			return response, nil
 		}
-		ext.RecordStatus = enums.StatusPendingApproval.String()
+	}
+
+	switch op {
+	case enums.OperationCommitDraft:
+		ext.RecordStatus = next.String()
 
 	case enums.OperationApprove:
-		if ext.RecordStatus != enums.StatusPendingApproval.String() {
-			response.Message = "Extension cannot be approved"
-			return response, nil
+		if next != enums.StatusApprovedAndActive {
+			// Approved at an intermediate stage; the next approver takes over.
+			ext.RecordStatus = next.String()
+			break
 		}
 		ext.RecordStatus = enums.StatusActive.String()
 		ext.IsActive = true
@@ -3166,11 +3304,7 @@ func (s *reviewPeriodService) reviewPeriodExtensionSetup(ctx context.Context, vm
 		ext.IsRejected = false
 
 	case enums.OperationReject:
-		if ext.RecordStatus != enums.StatusPendingApproval.String() {
-			response.Message = "Extension cannot be rejected"
-			return response, nil
-		}
-		ext.RecordStatus = enums.StatusRejected.String()
+		ext.RecordStatus = next.String()
 		ext.IsActive = false
 		ext.IsRejected = true
 		ext.RejectedBy = vm.RejectedBy
@@ -3179,11 +3313,7 @@ func (s *reviewPeriodService) reviewPeriodExtensionSetup(ctx context.Context, vm
 		ext.IsApproved = false
 
 	case enums.OperationReturn:
-		if ext.RecordStatus != enums.StatusPendingApproval.String() {
-			response.Message = "Extension cannot be returned"
-			return response, nil
-		}
-		ext.RecordStatus = enums.StatusReturned.String()
+		ext.RecordStatus = next.String()
 		ext.IsActive = false
 		ext.IsRejected = true
 		ext.RejectedBy = vm.RejectedBy
@@ -3192,11 +3322,7 @@ func (s *reviewPeriodService) reviewPeriodExtensionSetup(ctx context.Context, vm
 		ext.IsApproved = false
 
 	case enums.OperationReSubmit:
-		if ext.RecordStatus != enums.StatusReturned.String() {
-			response.Message = "Extension cannot be re-submitted; it is not in Returned status"
-			return response, nil
-		}
-		ext.RecordStatus = enums.StatusPendingApproval.String()
+		ext.RecordStatus = next.String()
 		ext.IsRejected = false
 		ext.RejectedBy = ""
 		ext.RejectionReason = ""
@@ -3244,6 +3370,22 @@ func (s *reviewPeriodService) reviewPeriodExtensionSetup(ctx context.Context, vm
 	return response, nil
 }
 
+// extensionOperationVerb describes op in extension lifecycle messages.
+func extensionOperationVerb(op enums.OperationType) string {
+	switch op {
+	case enums.OperationCommitDraft:
+		return "submitted"
+	case enums.OperationApprove:
+		return "approved"
+	case enums.OperationReject:
+		return "rejected"
+	case enums.OperationReturn:
+		return "returned"
+	default:
+		return "re-submitted"
+	}
+}
+
 func (s *reviewPeriodService) SubmitDraftReviewPeriodExtension(ctx context.Context, vm *performance.ReviewPeriodExtensionRequestModel) (*performance.ResponseVm, error) {
 	return s.reviewPeriodExtensionSetup(ctx, vm, enums.OperationCommitDraft)
 }
@@ -3335,6 +3477,10 @@ func (s *reviewPeriodService) AcceptIndividualPlannedObjective(ctx context.Conte
 		return response, nil
 	}
 
+	if err := s.scope.AuthorizeStaff(ctx, po.StaffID); err != nil {
+		return response, err
+	}
+
 	if po.RecordStatus != enums.StatusPendingAcceptance.String() {
 		response.Message = "Planned objective cannot be accepted; it is not in PendingAcceptance status"
 		return response, nil
@@ -3373,6 +3519,10 @@ func (s *reviewPeriodService) ReInstateIndividualPlannedObjective(ctx context.Co
 		return response, nil
 	}
 
+	if err := s.scope.AuthorizeStaff(ctx, po.StaffID); err != nil {
+		return response, err
+	}
+
 	if po.RecordStatus != enums.StatusCancelled.String() && po.RecordStatus != enums.StatusRejected.String() {
 		response.Message = "Planned objective can only be reinstated from Cancelled or Rejected status"
 		return response, nil
@@ -3412,6 +3562,10 @@ func (s *reviewPeriodService) PauseIndividualPlannedObjective(ctx context.Contex
 		return response, nil
 	}
 
+	if err := s.scope.AuthorizeStaff(ctx, po.StaffID); err != nil {
+		return response, err
+	}
+
 	if po.RecordStatus != enums.StatusActive.String() {
 		response.Message = "Planned objective cannot be paused; it is not in Active status"
 		return response, nil
@@ -3446,6 +3600,10 @@ func (s *reviewPeriodService) SuspendIndividualPlannedObjective(ctx context.Cont
 		return response, nil
 	}
 
+	if err := s.scope.AuthorizeStaff(ctx, po.StaffID); err != nil {
+		return response, err
+	}
+
 	if po.RecordStatus != enums.StatusActive.String() {
 		response.Message = "Planned objective cannot be suspended; it is not in Active status"
 		return response, nil
@@ -3479,6 +3637,10 @@ func (s *reviewPeriodService) ResumeIndividualPlannedObjective(ctx context.Conte
 		return response, nil
 	}
 
+	if err := s.scope.AuthorizeStaff(ctx, po.StaffID); err != nil {
+		return response, err
+	}
+
 	if po.RecordStatus != enums.StatusPaused.String() {
 		response.Message = "Planned objective cannot be resumed; it is not in Paused status"
 		return response, nil
@@ -3513,12 +3675,20 @@ func (s *reviewPeriodService) ReSubmitIndividualPlannedObjective(ctx context.Con
 		return response, nil
 	}
 
+	if err := s.scope.AuthorizeStaff(ctx, po.StaffID); err != nil {
+		return response, err
+	}
+
 	if po.RecordStatus != enums.StatusReturned.String() {
 		response.Message = "Planned objective cannot be re-submitted; it is not in Returned status"
 		return response, nil
 	}
 
-	po.RecordStatus = enums.StatusPendingApproval.String()
+	submitted, err := nextApprovalStatus(ctx, s.chainSvc, enums.ApprovalEntityIndividualPlannedObjective, po.RecordStatus, enums.OperationReSubmit)
+	if err != nil {
+		return response, err
+	}
+	po.RecordStatus = submitted.String()
 	po.IsRejected = false
 	po.RejectedBy = ""
 	po.RejectionReason = ""
@@ -3638,6 +3808,10 @@ func (s *reviewPeriodService) GetObjectivesByWorkproductStatus(ctx context.Conte
 	response.HasError = true
 	response.Message = "An error occurred"
 
+	if err := s.scope.AuthorizeStaff(ctx, staffID); err != nil {
+		return response, err
+	}
+
 	var plannedObjs []performance.ReviewPeriodIndividualPlannedObjective
 	err := s.db.WithContext(ctx).
 		Where("staff_id = ? AND review_period_id = ? AND soft_deleted = ?", staffID, reviewPeriodID, false).
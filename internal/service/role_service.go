@@ -6,10 +6,12 @@ import (
 	"time"
 
 	"github.com/enterprise-pms/pms-api/internal/config"
+	"github.com/enterprise-pms/pms-api/internal/domain/auth"
 	"github.com/enterprise-pms/pms-api/internal/domain/identity"
 	"github.com/enterprise-pms/pms-api/internal/repository"
 	"github.com/rs/zerolog"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // roleManagementService handles role-permission CRUD operations.
@@ -18,6 +20,9 @@ import (
 //   - GetPermissionsByRoleQueryHandler   -> GetPermissions
 //   - AddPermissionToRoleHandler         -> AddPermissionToRole
 //   - RemovePermissionFromRoleCmdHandler -> RemovePermissionFromRole
+//
+// RegisterPermissions has no .NET counterpart; it keeps the permission table
+// in step with the permissions the router enforces.
 type roleManagementService struct {
 	permissionRepo     *repository.Repository[identity.Permission]
 	rolePermissionRepo *repository.Repository[identity.RolePermission]
@@ -261,3 +266,63 @@ func (s *roleManagementService) RemovePermissionFromRole(ctx context.Context, ro
 		"message":   "Permission removed successfully",
 	}, nil
 }
+
+// ---------------------------------------------------------------------------
+// RegisterPermissions
+// ---------------------------------------------------------------------------
+
+// RegisterPermissions inserts the permissions the API enforces that are not
+// in the permission table yet and grants each new one to its default roles.
+// Existing permissions only have their description refreshed, so grants an
+// admin has changed are never restored. It returns the number of
+// permissions added.
+func (s *roleManagementService) RegisterPermissions(ctx context.Context, defs []auth.PermissionDefinition) (int, error) {
+	added := 0
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, def := range defs {
+			perm := identity.Permission{Name: def.Name, Description: def.Description}
+			result := tx.Clauses(clause.OnConflict{
+				Columns:     []clause.Column{{Name: "name"}},
+				TargetWhere: clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "soft_deleted = false"}}},
+				DoNothing:   true,
+			}).Create(&perm)
+			if result.Error != nil {
+				return fmt.Errorf("registering permission %s: %w", def.Name, result.Error)
+			}
+
+			if result.RowsAffected == 0 {
+				if err := tx.Model(&identity.Permission{}).
+					Where("name = ? AND soft_deleted = false AND description IS DISTINCT FROM ?", def.Name, def.Description).
+					Update("description", def.Description).Error; err != nil {
+					return fmt.Errorf("updating permission %s: %w", def.Name, err)
+				}
+				continue
+			}
+
+			added++
+			if len(def.DefaultRoles) == 0 {
+				continue
+			}
+			var roleIDs []string
+			if err := tx.Model(&identity.ApplicationRole{}).
+				Where("name IN ?", def.DefaultRoles).
+				Pluck("id", &roleIDs).Error; err != nil {
+				return fmt.Errorf("looking up default roles for %s: %w", def.Name, err)
+			}
+			for _, roleID := range roleIDs {
+				if err := tx.Create(&identity.RolePermission{RoleID: roleID, PermissionID: perm.PermissionID}).Error; err != nil {
+					return fmt.Errorf("granting %s: %w", def.Name, err)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if added > 0 {
+		s.log.Info().Int("added", added).Msg("permissions registered")
+	}
+	return added, nil
+}
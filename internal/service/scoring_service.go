@@ -1,6 +1,9 @@
 package service
 
 import (
+	"fmt"
+	"sort"
+
 	"github.com/enterprise-pms/pms-api/internal/domain/enums"
 	"github.com/rs/zerolog"
 	"github.com/shopspring/decimal"
@@ -27,6 +30,7 @@ type ScoringResult struct {
 	FinalScore        decimal.Decimal
 	ScorePercentage   decimal.Decimal
 	Grade             enums.PerformanceGrade
+	GradeLabel        string
 	IsUnderPerforming bool
 	CategoryBreakdown []CategoryScore
 }
@@ -41,52 +45,137 @@ type CompetencyScoreResult struct {
 }
 
 // ---------------------------------------------------------------------------
-// Grade thresholds (upper bound is exclusive except for Exemplary).
+// Grading scales
 // ---------------------------------------------------------------------------
 
 var (
-	thresholdDeveloping   = decimal.NewFromInt(30)
-	thresholdProgressive  = decimal.NewFromInt(50)
-	thresholdCompetent    = decimal.NewFromInt(66)
-	thresholdAccomplished = decimal.NewFromInt(80)
-	thresholdExemplary    = decimal.NewFromInt(90)
-
-	hundred          = decimal.NewFromInt(100)
-	zero             = decimal.NewFromInt(0)
-	weightTolerance  = decimal.NewFromFloat(0.01)
-	underPerfCutoff  = decimal.NewFromInt(50)
+	hundred         = decimal.NewFromInt(100)
+	zero            = decimal.NewFromInt(0)
+	weightTolerance = decimal.NewFromFloat(0.01)
 )
 
+// GradeBand maps a percentage range to a grade. MinPercentage is inclusive
+// and MaxPercentage exclusive; the top band also covers scores at or above
+// its maximum.
+type GradeBand struct {
+	MinPercentage     decimal.Decimal
+	MaxPercentage     decimal.Decimal
+	Grade             enums.PerformanceGrade
+	Label             string
+	IsUnderPerforming bool
+}
+
+// GradeScale is the runtime form of a grading scale. ScaleID is empty for
+// the built-in default scale.
+type GradeScale struct {
+	ScaleID string
+	Name    string
+	Version int
+	Bands   []GradeBand
+}
+
+// DefaultGradeScale returns the built-in scale used when no grading scale is
+// attached to a review period or its strategy. It mirrors the .NET GetGrade
+// thresholds; scores below 50% are under-performing.
+func DefaultGradeScale() GradeScale {
+	band := func(lo, hi int64, grade enums.PerformanceGrade, under bool) GradeBand {
+		return GradeBand{
+			MinPercentage:     decimal.NewFromInt(lo),
+			MaxPercentage:     decimal.NewFromInt(hi),
+			Grade:             grade,
+			Label:             grade.String(),
+			IsUnderPerforming: under,
+		}
+	}
+	return GradeScale{
+		Name:    "Default",
+		Version: 1,
+		Bands: []GradeBand{
+			band(0, 30, enums.PerformanceGradeProbation, true),
+			band(30, 50, enums.PerformanceGradeDeveloping, true),
+			band(50, 66, enums.PerformanceGradeProgressive, false),
+			band(66, 80, enums.PerformanceGradeCompetent, false),
+			band(80, 90, enums.PerformanceGradeAccomplished, false),
+			band(90, 100, enums.PerformanceGradeExemplary, false),
+		},
+	}
+}
+
+// Band returns the band a score percentage falls into. Scores below the
+// first band take the lowest band and scores above the last take the highest.
+func (g GradeScale) Band(scorePercentage decimal.Decimal) GradeBand {
+	bands := g.Bands
+	if len(bands) == 0 {
+		bands = DefaultGradeScale().Bands
+	}
+	for _, b := range bands {
+		if scorePercentage.LessThan(b.MaxPercentage) {
+			return b
+		}
+	}
+	return bands[len(bands)-1]
+}
+
+// validateGradeBands enforces that bands are ordered, contiguous and
+// non-overlapping, start at 0% and end at 100%, and that each band maps to
+// a known grade.
+func validateGradeBands(bands []GradeBand) error {
+	if len(bands) == 0 {
+		return fmt.Errorf("%w: at least one band is required", ErrInvalidGradingScale)
+	}
+
+	sorted := make([]GradeBand, len(bands))
+	copy(sorted, bands)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].MinPercentage.LessThan(sorted[j].MinPercentage) })
+
+	if !sorted[0].MinPercentage.IsZero() {
+		return fmt.Errorf("%w: the lowest band must start at 0%%", ErrInvalidGradingScale)
+	}
+	for i, b := range sorted {
+		if !b.MinPercentage.LessThan(b.MaxPercentage) {
+			return fmt.Errorf("%w: band %s-%s is empty", ErrInvalidGradingScale, b.MinPercentage, b.MaxPercentage)
+		}
+		if b.Grade < enums.PerformanceGradeProbation || b.Grade > enums.PerformanceGradeExemplary {
+			return fmt.Errorf("%w: band %s-%s has unknown grade %d", ErrInvalidGradingScale, b.MinPercentage, b.MaxPercentage, int(b.Grade))
+		}
+		if i == 0 {
+			continue
+		}
+		prev := sorted[i-1]
+		switch {
+		case b.MinPercentage.LessThan(prev.MaxPercentage):
+			return fmt.Errorf("%w: bands %s-%s and %s-%s overlap", ErrInvalidGradingScale,
+				prev.MinPercentage, prev.MaxPercentage, b.MinPercentage, b.MaxPercentage)
+		case b.MinPercentage.GreaterThan(prev.MaxPercentage):
+			return fmt.Errorf("%w: gap between %s%% and %s%%", ErrInvalidGradingScale, prev.MaxPercentage, b.MinPercentage)
+		}
+	}
+	if last := sorted[len(sorted)-1]; !last.MaxPercentage.Equal(hundred) {
+		return fmt.Errorf("%w: the highest band must end at 100%%", ErrInvalidGradingScale)
+	}
+	return nil
+}
+
 // ---------------------------------------------------------------------------
 // scoringService
 // ---------------------------------------------------------------------------
 
 type scoringService struct {
-	log zerolog.Logger
+	log   zerolog.Logger
+	scale GradeScale
 }
 
 func newScoringService(log zerolog.Logger) *scoringService {
 	return &scoringService{
-		log: log.With().Str("sub", "scoring").Logger(),
+		log:   log.With().Str("sub", "scoring").Logger(),
+		scale: DefaultGradeScale(),
 	}
 }
 
-// DetermineGrade maps a score percentage to the appropriate performance grade.
+// DetermineGrade maps a score percentage to the appropriate performance grade
+// using the service's grading scale.
 func (s *scoringService) DetermineGrade(scorePercentage decimal.Decimal) enums.PerformanceGrade {
-	switch {
-	case scorePercentage.LessThan(thresholdDeveloping):
-		return enums.PerformanceGradeProbation
-	case scorePercentage.LessThan(thresholdProgressive):
-		return enums.PerformanceGradeDeveloping
-	case scorePercentage.LessThan(thresholdCompetent):
-		return enums.PerformanceGradeProgressive
-	case scorePercentage.LessThan(thresholdAccomplished):
-		return enums.PerformanceGradeCompetent
-	case scorePercentage.LessThan(thresholdExemplary):
-		return enums.PerformanceGradeAccomplished
-	default:
-		return enums.PerformanceGradeExemplary
-	}
+	return s.scale.Band(scorePercentage).Grade
 }
 
 // CalculateWorkProductOutcome sums the three evaluation dimensions for a work product.
@@ -194,8 +283,8 @@ func (s *scoringService) ApplyHRDDeduction(score, deductedPoints decimal.Decimal
 //	FinalScore      = workProductScore + objectiveScore + competencyScore
 //	AdjustedScore   = FinalScore - hrdDeduction (floored at 0)
 //	ScorePercentage = (adjustedScore / maxPoints) * 100
-//	Grade           = DetermineGrade(ScorePercentage)
-//	IsUnderPerforming = ScorePercentage < 50
+//	Grade           = band of ScorePercentage in the grading scale
+//	IsUnderPerforming = the band's under-performance flag
 func (s *scoringService) CalculatePeriodScore(
 	workProductScore, objectiveScore, competencyScore,
 	maxPoints, hrdDeduction decimal.Decimal,
@@ -203,13 +292,14 @@ func (s *scoringService) CalculatePeriodScore(
 	finalScore := workProductScore.Add(objectiveScore).Add(competencyScore)
 	adjusted := s.ApplyHRDDeduction(finalScore, hrdDeduction)
 	pct := s.CalculateScorePercentage(adjusted, maxPoints)
-	grade := s.DetermineGrade(pct)
+	band := s.scale.Band(pct)
 
 	return ScoringResult{
 		FinalScore:        adjusted,
 		ScorePercentage:   pct,
-		Grade:             grade,
-		IsUnderPerforming: pct.LessThan(underPerfCutoff),
+		Grade:             band.Grade,
+		GradeLabel:        band.Label,
+		IsUnderPerforming: band.IsUnderPerforming,
 	}
 }
 
@@ -637,3 +637,103 @@ func TestDetermineGrade_Boundaries(t *testing.T) {
 		})
 	}
 }
+
+// ---------------------------------------------------------------------------
+// Grading scales
+// ---------------------------------------------------------------------------
+
+func gradeBand(lo, hi int64, grade enums.PerformanceGrade, label string, under bool) GradeBand {
+	return GradeBand{
+		MinPercentage:     decimal.NewFromInt(lo),
+		MaxPercentage:     decimal.NewFromInt(hi),
+		Grade:             grade,
+		Label:             label,
+		IsUnderPerforming: under,
+	}
+}
+
+func TestCalculatePeriodScore_CustomScale(t *testing.T) {
+	scale := GradeScale{
+		ScaleID: "scale-2027",
+		Version: 2,
+		Bands: []GradeBand{
+			gradeBand(0, 40, enums.PerformanceGradeDeveloping, "Below Expectations", true),
+			gradeBand(40, 75, enums.PerformanceGradeCompetent, "Meets Expectations", false),
+			gradeBand(75, 100, enums.PerformanceGradeExemplary, "Exceeds Expectations", false),
+		},
+	}
+	svc := newTestScoringService()
+	svc.scale = scale
+
+	// 45% would be Developing and under-performing on the default scale.
+	result := svc.CalculatePeriodScore(
+		decimal.NewFromInt(20),
+		decimal.NewFromInt(20),
+		decimal.NewFromInt(5),
+		decimal.NewFromInt(100),
+		decimal.NewFromInt(0),
+	)
+	if result.Grade != enums.PerformanceGradeCompetent || result.GradeLabel != "Meets Expectations" {
+		t.Errorf("Grade = %s (%q), want Competent (Meets Expectations)", result.Grade.String(), result.GradeLabel)
+	}
+	if result.IsUnderPerforming {
+		t.Error("expected IsUnderPerforming=false for 45% on custom scale")
+	}
+
+	if got := svc.DetermineGrade(decimal.NewFromInt(100)); got != enums.PerformanceGradeExemplary {
+		t.Errorf("DetermineGrade(100) = %s, want Exemplary", got.String())
+	}
+
+	// The original service keeps grading against the default scale.
+	if got := newTestScoringService().DetermineGrade(decimal.NewFromInt(45)); got != enums.PerformanceGradeDeveloping {
+		t.Errorf("default DetermineGrade(45) = %s, want Developing", got.String())
+	}
+}
+
+func TestValidateGradeBands(t *testing.T) {
+	if err := validateGradeBands(DefaultGradeScale().Bands); err != nil {
+		t.Fatalf("default scale should be valid: %v", err)
+	}
+
+	// Band order in the payload does not matter.
+	unordered := []GradeBand{
+		gradeBand(50, 100, enums.PerformanceGradeCompetent, "Pass", false),
+		gradeBand(0, 50, enums.PerformanceGradeDeveloping, "Fail", true),
+	}
+	if err := validateGradeBands(unordered); err != nil {
+		t.Errorf("unordered contiguous bands should be valid: %v", err)
+	}
+
+	tests := map[string][]GradeBand{
+		"empty": nil,
+		"gap": {
+			gradeBand(0, 40, enums.PerformanceGradeDeveloping, "Low", true),
+			gradeBand(50, 100, enums.PerformanceGradeCompetent, "High", false),
+		},
+		"overlap": {
+			gradeBand(0, 60, enums.PerformanceGradeDeveloping, "Low", true),
+			gradeBand(50, 100, enums.PerformanceGradeCompetent, "High", false),
+		},
+		"does not start at zero": {
+			gradeBand(10, 100, enums.PerformanceGradeCompetent, "All", false),
+		},
+		"does not reach 100": {
+			gradeBand(0, 90, enums.PerformanceGradeCompetent, "All", false),
+		},
+		"empty band": {
+			gradeBand(0, 0, enums.PerformanceGradeDeveloping, "None", true),
+			gradeBand(0, 100, enums.PerformanceGradeCompetent, "All", false),
+		},
+		"unknown grade": {
+			gradeBand(0, 100, enums.PerformanceGrade(9), "All", false),
+		},
+	}
+	for name, bands := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateGradeBands(bands)
+			if !errors.Is(err, ErrInvalidGradingScale) {
+				t.Errorf("validateGradeBands() = %v, want ErrInvalidGradingScale", err)
+			}
+		})
+	}
+}
@@ -9,26 +9,38 @@ import (
 // Container holds all service implementations.
 // This is the Go equivalent of the .NET DI container for services.
 type Container struct {
-	Performance   PerformanceManagementService
-	Competency    CompetencyService
-	PmsSetup      PmsSetupService
-	ReviewPeriod  ReviewPeriodService
-	Grievance     GrievanceManagementService
-	RoleMgt       RoleManagementService
-	StaffMgt      StaffManagementService
-	Organogram    OrganogramService
-	ErpEmployee   ErpEmployeeService
-	GlobalSetting GlobalSettingService
-	Auth          AuthService
-	Email         EmailService
-	FileStorage   FileStorageService
-	Notification  NotificationService
-	Encryption    EncryptionService
-	AD            ActiveDirectoryService
-	UserContext   UserContextService
-	PasswordGen   PasswordGenerator
-	Bitly         BitlyService
-	RSAAuth       RSAAuthService
+	Performance     PerformanceManagementService
+	Competency      CompetencyService
+	DevelopmentPlan DevelopmentPlanService
+	PmsSetup        PmsSetupService
+	ApprovalChain   ApprovalChainService
+	SLAEscalation   SLAEscalationService
+	Delegation      DelegationService
+	GradingScale    GradingScaleService
+	Calibration     CalibrationService
+	BackgroundJob   BackgroundJobService
+	RecurringJob    RecurringJobService
+	EmailDelivery   EmailDeliveryService
+	AuditTrail      AuditTrailService
+	AuditConfig     AuditConfigService
+	ReviewPeriod    ReviewPeriodService
+	Grievance       GrievanceManagementService
+	RoleMgt         RoleManagementService
+	StaffMgt        StaffManagementService
+	Organogram      OrganogramService
+	ErpEmployee     ErpEmployeeService
+	GlobalSetting   GlobalSettingService
+	Auth            AuthService
+	Email           EmailService
+	FileStorage     FileStorageService
+	Notification    NotificationService
+	Encryption      EncryptionService
+	AD              ActiveDirectoryService
+	UserContext     UserContextService
+	DataScope       DataScopeService
+	PasswordGen     PasswordGenerator
+	Bitly           BitlyService
+	RSAAuth         RSAAuthService
 }
 
 // New creates the service container with all dependencies wired up.
@@ -43,17 +55,24 @@ func New(repos *repository.Container, cfg *config.Config, log zerolog.Logger) *C
 	bitlySvc := newBitlyService(cfg.Bitly, log)
 	rsaAuthSvc := newRSAAuthService(cfg.RSA, gsSvc, log)
 	emailSvc := newEmailService(repos, cfg, log, gsSvc)
+	notifSvc := newNotificationService(repos, emailSvc, cfg, log)
 	encSvc := newEncryptionService(cfg, log)
-	fsSvc := newFileStorageService(cfg, log)
 	pmsSetupSvc := newPmsSetupService(repos, cfg, log, encSvc)
 	userMgr := NewUserManagementService(repos, log)
+	chainSvc := newApprovalChainService(repos, cfg, log)
+	delegationSvc := newDelegationService(repos, cfg, log, chainSvc)
+	gradingSvc := newGradingScaleService(repos, cfg, log)
+	scopeSvc := newDataScopeService(repos, log)
+	fsSvc := newFileStorageService(repos, cfg, log, scopeSvc)
+	slaCal := newSLACalendar(repos, cfg, log, gsSvc)
 
 	// --- Domain services ---
-	rpSvc := newReviewPeriodService(repos, cfg, log)
-	competencySvc := newCompetencyService(repos, cfg, log, emailSvc)
+	rpSvc := newReviewPeriodService(repos, cfg, log, chainSvc, delegationSvc, scopeSvc)
+	devPlanSvc := newDevelopmentPlanService(repos, cfg, log, scopeSvc, gsSvc, notifSvc)
+	competencySvc := newCompetencyService(repos, cfg, log, emailSvc, scopeSvc, devPlanSvc)
 	staffMgtSvc := newStaffManagementService(repos, cfg, log, userMgr)
 	erpSvc := newErpEmployeeService(repos, cfg, log)
-	perfSvc := newPerformanceManagementService(repos, cfg, log, rpSvc, erpSvc, gsSvc, ucSvc)
+	perfSvc := newPerformanceManagementService(repos, cfg, log, rpSvc, erpSvc, gsSvc, ucSvc, chainSvc, delegationSvc, gradingSvc, scopeSvc, notifSvc, slaCal)
 
 	// Grievance depends on several other services (mirrors .NET DI graph).
 	grievanceSvc := newGrievanceManagementService(repos, cfg, log,
@@ -62,28 +81,42 @@ func New(repos *repository.Container, cfg *config.Config, log zerolog.Logger) *C
 		ucSvc,    // UserContextService
 		emailSvc, // EmailService
 		rpSvc,    // ReviewPeriodService
+		scopeSvc, // DataScopeService
+		notifSvc, // NotificationService
 	)
 
 	return &Container{
-		Performance:   perfSvc,
-		PmsSetup:      pmsSetupSvc,
-		ReviewPeriod:  rpSvc,
-		Grievance:     grievanceSvc,
-		RoleMgt:       newRoleManagementService(repos, cfg, log),
-		StaffMgt:      staffMgtSvc,
-		Competency:    competencySvc,
-		Organogram:    newOrganogramService(repos, cfg, log),
-		ErpEmployee:   erpSvc,
-		GlobalSetting: gsSvc,
-		Auth:          authSvc,
-		Email:         emailSvc,
-		FileStorage:   fsSvc,
-		Notification:  newNotificationService(emailSvc, cfg, log),
-		Encryption:    encSvc,
-		AD:            adSvc,
-		UserContext:   ucSvc,
-		PasswordGen:   pwGen,
-		Bitly:         bitlySvc,
-		RSAAuth:       rsaAuthSvc,
+		Performance:     perfSvc,
+		PmsSetup:        pmsSetupSvc,
+		ApprovalChain:   chainSvc,
+		SLAEscalation:   newSLAEscalationService(repos, cfg, log, gsSvc, slaCal, chainSvc, notifSvc),
+		Delegation:      delegationSvc,
+		GradingScale:    gradingSvc,
+		Calibration:     newCalibrationService(repos, cfg, log, gradingSvc),
+		BackgroundJob:   newBackgroundJobService(repos, cfg, log),
+		RecurringJob:    newRecurringJobService(repos, cfg, log),
+		EmailDelivery:   newEmailDeliveryService(repos, cfg, log),
+		AuditTrail:      newAuditTrailService(repos, cfg, log),
+		AuditConfig:     newAuditConfigService(repos, cfg, log),
+		ReviewPeriod:    rpSvc,
+		Grievance:       grievanceSvc,
+		RoleMgt:         newRoleManagementService(repos, cfg, log),
+		StaffMgt:        staffMgtSvc,
+		Competency:      competencySvc,
+		DevelopmentPlan: devPlanSvc,
+		Organogram:      newOrganogramService(repos, cfg, log),
+		ErpEmployee:     erpSvc,
+		GlobalSetting:   gsSvc,
+		Auth:            authSvc,
+		Email:           emailSvc,
+		FileStorage:     fsSvc,
+		Notification:    notifSvc,
+		Encryption:      encSvc,
+		AD:              adSvc,
+		UserContext:     ucSvc,
+		DataScope:       scopeSvc,
+		PasswordGen:     pwGen,
+		Bitly:           bitlySvc,
+		RSAAuth:         rsaAuthSvc,
 	}
 }
@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/auth"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ---------------------------------------------------------------------------
+// Sessions.
+//
+// Each login opens a UserSession. Access tokens carry its ID in the "sid"
+// claim, which JWTAuth checks through IsSessionActive, and refresh tokens
+// are rotated within it. Revoking a session therefore signs the device out
+// on its next request rather than when its access token expires.
+// ---------------------------------------------------------------------------
+
+// sessionCacheTTL bounds how long a replica trusts its last answer about a
+// session. Revocations made on another replica, or by deactivating the
+// user, take effect within this window.
+const sessionCacheTTL = 5 * time.Second
+
+type clientInfoKey struct{}
+
+// ClientInfo identifies the device and address a request came from. It is
+// recorded on the session opened or refreshed by the request.
+type ClientInfo struct {
+	Device    string
+	IPAddress string
+}
+
+// WithClientInfo returns a context carrying the caller's device and address
+// for session bookkeeping.
+func WithClientInfo(ctx context.Context, device, ipAddress string) context.Context {
+	return context.WithValue(ctx, clientInfoKey{}, ClientInfo{Device: device, IPAddress: ipAddress})
+}
+
+func clientInfoFrom(ctx context.Context) ClientInfo {
+	info, _ := ctx.Value(clientInfoKey{}).(ClientInfo)
+	return info
+}
+
+// sessionCache remembers recent IsSessionActive answers so that JWTAuth does
+// not query the database on every request.
+type sessionCache struct {
+	mu      sync.Mutex
+	entries map[string]sessionCacheEntry
+}
+
+type sessionCacheEntry struct {
+	active    bool
+	checkedAt time.Time
+}
+
+func newSessionCache() *sessionCache {
+	return &sessionCache{entries: make(map[string]sessionCacheEntry)}
+}
+
+func (c *sessionCache) get(sessionID string, now time.Time) (active, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[sessionID]
+	if !ok || now.Sub(e.checkedAt) > sessionCacheTTL {
+		return false, false
+	}
+	return e.active, true
+}
+
+func (c *sessionCache) put(sessionID string, active bool, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.entries) >= 10000 {
+		for id, e := range c.entries {
+			if now.Sub(e.checkedAt) > sessionCacheTTL {
+				delete(c.entries, id)
+			}
+		}
+	}
+	c.entries[sessionID] = sessionCacheEntry{active: active, checkedAt: now}
+}
+
+func (c *sessionCache) forget(sessionIDs ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, id := range sessionIDs {
+		delete(c.entries, id)
+	}
+}
+
+// openSession creates the session for a new login.
+func (s *authService) openSession(ctx context.Context, userID string) (*auth.UserSession, error) {
+	info := clientInfoFrom(ctx)
+	now := time.Now().UTC()
+	session := &auth.UserSession{
+		ID:         uuid.NewString(),
+		UserID:     userID,
+		Device:     truncate(info.Device, 512),
+		IPAddress:  info.IPAddress,
+		CreatedAt:  now,
+		LastUsedAt: now,
+		ExpiresAt:  now.Add(s.refreshTokenExpiry()),
+	}
+	if err := s.db.WithContext(ctx).Create(session).Error; err != nil {
+		return nil, fmt.Errorf("creating session: %w", err)
+	}
+	return session, nil
+}
+
+// IsSessionActive reports whether the session is unrevoked, unexpired and
+// belongs to an active user. Answers are cached for sessionCacheTTL.
+func (s *authService) IsSessionActive(ctx context.Context, sessionID string) (bool, error) {
+	now := time.Now()
+	if active, ok := s.sessions.get(sessionID, now); ok {
+		return active, nil
+	}
+
+	var rows []struct{ Active bool }
+	err := s.db.WithContext(ctx).
+		Table(`"CoreSchema".user_sessions s`).
+		Joins(`JOIN "CoreSchema".asp_net_users u ON u.id = s.user_id`).
+		Where("s.id = ?", sessionID).
+		Select("s.revoked_at IS NULL AND s.expires_at > NOW() AND u.is_active AS active").
+		Scan(&rows).Error
+	if err != nil {
+		return false, fmt.Errorf("checking session: %w", err)
+	}
+
+	active := len(rows) == 1 && rows[0].Active
+	s.sessions.put(sessionID, active, now)
+	return active, nil
+}
+
+// Logout revokes the caller's current session.
+func (s *authService) Logout(ctx context.Context, userID, sessionID string) error {
+	if sessionID == "" {
+		return ErrSessionNotFound
+	}
+	_, err := s.revokeSessions(ctx, auth.SessionRevokedLogout, "id = ? AND user_id = ?", sessionID, userID)
+	return err
+}
+
+// ListSessions returns the user's active sessions, most recently used
+// first, marking the one making the request.
+func (s *authService) ListSessions(ctx context.Context, userID, currentSessionID string) ([]auth.SessionVm, error) {
+	var sessions []auth.UserSession
+	err := s.db.WithContext(ctx).
+		Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, time.Now().UTC()).
+		Order("last_used_at DESC").
+		Find(&sessions).Error
+	if err != nil {
+		return nil, fmt.Errorf("listing sessions: %w", err)
+	}
+
+	result := make([]auth.SessionVm, 0, len(sessions))
+	for _, sess := range sessions {
+		result = append(result, auth.SessionVm{
+			ID:         sess.ID,
+			Device:     sess.Device,
+			IPAddress:  sess.IPAddress,
+			CreatedAt:  sess.CreatedAt,
+			LastUsedAt: sess.LastUsedAt,
+			ExpiresAt:  sess.ExpiresAt,
+			Current:    sess.ID == currentSessionID,
+		})
+	}
+	return result, nil
+}
+
+// RevokeSession revokes one of the user's own sessions.
+func (s *authService) RevokeSession(ctx context.Context, userID, sessionID string) error {
+	_, err := s.revokeSessions(ctx, auth.SessionRevokedByUser, "id = ? AND user_id = ?", sessionID, userID)
+	return err
+}
+
+// RevokeAllSessions revokes every session of a user, signing them out on
+// all devices. It returns the number of sessions revoked.
+func (s *authService) RevokeAllSessions(ctx context.Context, userID, revokedBy string) (int, error) {
+	n, err := s.revokeSessions(ctx, auth.SessionRevokedByAdmin, "user_id = ?", userID)
+	if errors.Is(err, ErrSessionNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	s.log.Info().Str("user_id", userID).Str("revoked_by", revokedBy).Int("sessions", n).Msg("All sessions revoked")
+	return n, nil
+}
+
+// revokeSessions revokes the unrevoked sessions matching the condition,
+// and their refresh tokens, in one transaction. It returns the number of
+// sessions revoked, or ErrSessionNotFound when nothing matched.
+func (s *authService) revokeSessions(ctx context.Context, reason string, query string, args ...interface{}) (int, error) {
+	ids, err := revokeSessionsTx(s.db.WithContext(ctx), reason, query, args...)
+	if errors.Is(err, ErrSessionNotFound) {
+		return 0, err
+	}
+	if err != nil {
+		return 0, fmt.Errorf("revoking sessions: %w", err)
+	}
+	s.sessions.forget(ids...)
+	return len(ids), nil
+}
+
+// revokeSessionsTx is revokeSessions without the cache, for callers that
+// hold only a *gorm.DB.
+func revokeSessionsTx(db *gorm.DB, reason string, query string, args ...interface{}) ([]string, error) {
+	var ids []string
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&auth.UserSession{}).Where(query, args...).
+			Where("revoked_at IS NULL").Pluck("id", &ids).Error; err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return ErrSessionNotFound
+		}
+		if err := tx.Model(&auth.UserSession{}).Where("id IN ?", ids).Updates(map[string]interface{}{
+			"revoked_at":     time.Now().UTC(),
+			"revoked_reason": reason,
+		}).Error; err != nil {
+			return err
+		}
+		return tx.Model(&auth.RefreshToken{}).
+			Where("session_id IN ? AND revoked = ?", ids, false).
+			Update("revoked", true).Error
+	})
+	return ids, err
+}
+
+func (s *authService) refreshTokenExpiry() time.Duration {
+	if s.cfg.JWT.RefreshTokenExpiry > 0 {
+		return s.cfg.JWT.RefreshTokenExpiry
+	}
+	return 7 * 24 * time.Hour // default 7 days
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
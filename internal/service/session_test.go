@@ -0,0 +1,382 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/config"
+	"github.com/enterprise-pms/pms-api/internal/domain/auth"
+	"github.com/rs/zerolog"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestSessionCache(t *testing.T) {
+	c := newSessionCache()
+	now := time.Now()
+
+	if _, ok := c.get("s1", now); ok {
+		t.Fatal("empty cache reported a hit")
+	}
+
+	c.put("s1", true, now)
+	c.put("s2", false, now)
+	if active, ok := c.get("s1", now.Add(time.Second)); !ok || !active {
+		t.Errorf("s1 = (%v, %v), want (true, true)", active, ok)
+	}
+	if active, ok := c.get("s2", now.Add(time.Second)); !ok || active {
+		t.Errorf("s2 = (%v, %v), want (false, true)", active, ok)
+	}
+
+	if _, ok := c.get("s1", now.Add(sessionCacheTTL+time.Millisecond)); ok {
+		t.Error("stale entry reported a hit")
+	}
+
+	c.forget("s1", "s2")
+	if _, ok := c.get("s1", now); ok {
+		t.Error("forgotten entry reported a hit")
+	}
+	if _, ok := c.get("s2", now); ok {
+		t.Error("forgotten entry reported a hit")
+	}
+}
+
+func TestClientInfo(t *testing.T) {
+	if info := clientInfoFrom(context.Background()); info != (ClientInfo{}) {
+		t.Errorf("clientInfoFrom(empty) = %+v, want zero value", info)
+	}
+
+	ctx := WithClientInfo(context.Background(), "Mozilla/5.0", "10.0.0.7")
+	info := clientInfoFrom(ctx)
+	if info.Device != "Mozilla/5.0" || info.IPAddress != "10.0.0.7" {
+		t.Errorf("clientInfoFrom = %+v", info)
+	}
+}
+
+// sessionDB is a database/sql driver that keeps users, sessions and refresh
+// tokens in memory. It understands the statements the refresh, revoke,
+// deactivate and session check paths send; anything else fails the query.
+type sessionDB struct {
+	mu       sync.Mutex
+	active   map[string]bool // user ID -> is_active
+	sessions map[string]*sessionRow
+	tokens   []*auth.RefreshToken
+	// afterTokenLookup runs once the refresh token has been read, to
+	// simulate a concurrent refresh winning the rotation.
+	afterTokenLookup func()
+}
+
+type sessionRow struct {
+	userID        string
+	expiresAt     time.Time
+	revokedAt     *time.Time
+	revokedReason string
+}
+
+func newSessionDB() *sessionDB {
+	return &sessionDB{active: make(map[string]bool), sessions: make(map[string]*sessionRow)}
+}
+
+func (d *sessionDB) addSession(id, userID string) {
+	d.sessions[id] = &sessionRow{userID: userID, expiresAt: time.Now().Add(time.Hour)}
+}
+
+func (d *sessionDB) addToken(plaintext, userID, sessionID string, revoked bool) {
+	d.tokens = append(d.tokens, &auth.RefreshToken{
+		ID:        plaintext + "-id",
+		UserID:    userID,
+		SessionID: sessionID,
+		Token:     hashToken(plaintext),
+		ExpiresAt: time.Now().Add(time.Hour),
+		CreatedAt: time.Now(),
+		Revoked:   revoked,
+	})
+}
+
+func (d *sessionDB) token(plaintext string) *auth.RefreshToken {
+	for _, t := range d.tokens {
+		if t.Token == hashToken(plaintext) {
+			return t
+		}
+	}
+	return nil
+}
+
+func (d *sessionDB) gorm(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sql.OpenDB(d)}), &gorm.Config{
+		DisableAutomaticPing: true,
+		Logger:               logger.Discard,
+	})
+	if err != nil {
+		t.Fatalf("open gorm: %v", err)
+	}
+	return db
+}
+
+func (d *sessionDB) Connect(ctx context.Context) (driver.Conn, error) { return sessionConn{d}, nil }
+func (d *sessionDB) Driver() driver.Driver                            { return nil }
+
+type sessionConn struct{ db *sessionDB }
+
+func (c sessionConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, fmt.Errorf("prepare not supported")
+}
+func (c sessionConn) Close() error              { return nil }
+func (c sessionConn) Begin() (driver.Tx, error) { return c, nil }
+func (c sessionConn) Commit() error             { return nil }
+func (c sessionConn) Rollback() error           { return nil }
+
+func namedValues(args []driver.NamedValue) []interface{} {
+	values := make([]interface{}, len(args))
+	for i, a := range args {
+		values[i] = a.Value
+	}
+	return values
+}
+
+func containsValue(values []interface{}, v string) bool {
+	for _, x := range values {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+func (c sessionConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	d := c.db
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	values := namedValues(args)
+	n := int64(0)
+
+	switch {
+	case strings.HasPrefix(query, "SAVEPOINT"), strings.HasPrefix(query, "ROLLBACK TO SAVEPOINT"):
+
+	case strings.HasPrefix(query, `UPDATE "CoreSchema"."asp_net_users" SET "is_active"=$1 WHERE id = $2`):
+		if _, ok := d.active[values[1].(string)]; ok {
+			d.active[values[1].(string)] = values[0].(bool)
+			n = 1
+		}
+
+	case strings.HasPrefix(query, `UPDATE "CoreSchema"."user_sessions" SET "revoked_at"=$1,"revoked_reason"=$2 WHERE id IN`):
+		revokedAt := values[0].(time.Time)
+		for id, s := range d.sessions {
+			if containsValue(values[2:], id) {
+				s.revokedAt, s.revokedReason = &revokedAt, values[1].(string)
+				n++
+			}
+		}
+
+	case strings.HasPrefix(query, `UPDATE "CoreSchema"."refresh_tokens" SET "revoked"=$1 WHERE id = $2 AND revoked = $3`):
+		for _, t := range d.tokens {
+			if t.ID == values[1] && !t.Revoked {
+				t.Revoked = true
+				n++
+			}
+		}
+
+	case strings.HasPrefix(query, `UPDATE "CoreSchema"."refresh_tokens" SET "revoked"=$1 WHERE session_id IN`):
+		for _, t := range d.tokens {
+			if containsValue(values[1:len(values)-1], t.SessionID) && !t.Revoked {
+				t.Revoked = true
+				n++
+			}
+		}
+
+	default:
+		return nil, fmt.Errorf("unexpected statement %q", query)
+	}
+	return driver.RowsAffected(n), nil
+}
+
+func (c sessionConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	d := c.db
+	d.mu.Lock()
+	values := namedValues(args)
+	rows := &fakeRows{}
+
+	switch {
+	case strings.HasPrefix(query, `SELECT * FROM "CoreSchema"."refresh_tokens" WHERE token = $1`):
+		rows.columns = []string{"id", "user_id", "session_id", "token", "expires_at", "created_at", "revoked"}
+		for _, t := range d.tokens {
+			if t.Token == values[0] {
+				rows.values = append(rows.values, []driver.Value{t.ID, t.UserID, t.SessionID, t.Token, t.ExpiresAt, t.CreatedAt, t.Revoked})
+			}
+		}
+		if hook := d.afterTokenLookup; hook != nil {
+			d.afterTokenLookup = nil
+			d.mu.Unlock()
+			hook()
+			return rows, nil
+		}
+
+	case strings.HasPrefix(query, `SELECT "id" FROM "CoreSchema"."user_sessions" WHERE id = $1 AND revoked_at IS NULL`),
+		strings.HasPrefix(query, `SELECT "id" FROM "CoreSchema"."user_sessions" WHERE user_id = $1 AND revoked_at IS NULL`):
+		byUser := strings.Contains(query, "WHERE user_id")
+		rows.columns = []string{"id"}
+		for id, s := range d.sessions {
+			match := id == values[0]
+			if byUser {
+				match = s.userID == values[0]
+			}
+			if match && s.revokedAt == nil {
+				rows.values = append(rows.values, []driver.Value{id})
+			}
+		}
+
+	case strings.HasPrefix(query, `SELECT s.revoked_at IS NULL AND s.expires_at > NOW() AND u.is_active AS active`):
+		rows.columns = []string{"active"}
+		if s, ok := d.sessions[values[0].(string)]; ok {
+			rows.values = append(rows.values, []driver.Value{s.revokedAt == nil && s.expiresAt.After(time.Now()) && d.active[s.userID]})
+		}
+
+	default:
+		d.mu.Unlock()
+		return nil, fmt.Errorf("unexpected query %q", query)
+	}
+	d.mu.Unlock()
+	return rows, nil
+}
+
+type fakeRows struct {
+	columns []string
+	values  [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string { return r.columns }
+func (r *fakeRows) Close() error      { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+	return nil
+}
+
+func newSessionTestAuthService(db *gorm.DB) *authService {
+	return &authService{
+		db:       db,
+		users:    &UserManagementService{db: db, log: zerolog.Nop()},
+		sessions: newSessionCache(),
+		cfg:      &config.Config{},
+		log:      zerolog.Nop(),
+	}
+}
+
+func TestRefreshTokenReuseRevokesSession(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(d *sessionDB)
+	}{
+		{
+			name: "token already rotated",
+			setup: func(d *sessionDB) {
+				d.addToken("old", "u1", "s1", true)
+			},
+		},
+		{
+			name: "token rotated by a concurrent refresh",
+			setup: func(d *sessionDB) {
+				d.addToken("old", "u1", "s1", false)
+				d.afterTokenLookup = func() {
+					d.mu.Lock()
+					defer d.mu.Unlock()
+					d.token("old").Revoked = true
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newSessionDB()
+			d.active["u1"] = true
+			d.addSession("s1", "u1")
+			d.addSession("s2", "u1")
+			d.addToken("current", "u1", "s1", false)
+			d.addToken("other-device", "u1", "s2", false)
+			tt.setup(d)
+			s := newSessionTestAuthService(d.gorm(t))
+			ctx := context.Background()
+
+			// Prime the cache so the test also covers forgetting it.
+			if active, err := s.IsSessionActive(ctx, "s1"); err != nil || !active {
+				t.Fatalf("IsSessionActive(s1) before reuse = %v, %v; want true", active, err)
+			}
+
+			if _, err := s.RefreshAccessToken(ctx, "old"); err == nil {
+				t.Fatal("RefreshAccessToken accepted a rotated token")
+			}
+
+			if s1 := d.sessions["s1"]; s1.revokedAt == nil || s1.revokedReason != auth.SessionRevokedReuse {
+				t.Errorf("session s1 revoked = %v (%q), want revoked for %q", s1.revokedAt != nil, s1.revokedReason, auth.SessionRevokedReuse)
+			}
+			if !d.token("current").Revoked {
+				t.Error("the session's live refresh token was not revoked")
+			}
+			if active, err := s.IsSessionActive(ctx, "s1"); err != nil || active {
+				t.Errorf("IsSessionActive(s1) after reuse = %v, %v; want false", active, err)
+			}
+			if d.sessions["s2"].revokedAt != nil || d.token("other-device").Revoked {
+				t.Error("reuse in one session revoked another session")
+			}
+		})
+	}
+}
+
+func TestDeactivateUserRevokesSessions(t *testing.T) {
+	tests := []struct {
+		name     string
+		sessions []string
+	}{
+		{name: "signed in on two devices", sessions: []string{"s1", "s2"}},
+		{name: "no sessions", sessions: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newSessionDB()
+			d.active["u1"], d.active["u2"] = true, true
+			for _, id := range tt.sessions {
+				d.addSession(id, "u1")
+				d.addToken(id+"-token", "u1", id, false)
+			}
+			d.addSession("s3", "u2")
+			d.addToken("s3-token", "u2", "s3", false)
+			db := d.gorm(t)
+			users := &UserManagementService{db: db, log: zerolog.Nop()}
+
+			if err := users.DeactivateUser(context.Background(), "u1"); err != nil {
+				t.Fatalf("DeactivateUser: %v", err)
+			}
+
+			if d.active["u1"] {
+				t.Error("user is still active")
+			}
+			for _, id := range tt.sessions {
+				if s := d.sessions[id]; s.revokedAt == nil || s.revokedReason != auth.SessionRevokedDeactivated {
+					t.Errorf("session %s revoked = %v (%q), want revoked for %q", id, s.revokedAt != nil, s.revokedReason, auth.SessionRevokedDeactivated)
+				}
+				if !d.token(id + "-token").Revoked {
+					t.Errorf("refresh token of session %s was not revoked", id)
+				}
+				active, err := newSessionTestAuthService(db).IsSessionActive(context.Background(), id)
+				if err != nil || active {
+					t.Errorf("IsSessionActive(%s) = %v, %v; want false", id, active, err)
+				}
+			}
+			if d.sessions["s3"].revokedAt != nil || d.token("s3-token").Revoked {
+				t.Error("another user's session was revoked")
+			}
+		})
+	}
+}
@@ -0,0 +1,529 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"html/template"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/config"
+	"github.com/enterprise-pms/pms-api/internal/domain/enums"
+	"github.com/enterprise-pms/pms-api/internal/domain/erp"
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"github.com/enterprise-pms/pms-api/internal/repository"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ---------------------------------------------------------------------------
+// slaEscalationService manages the SLA escalation ladders of feedback request
+// types and fires their steps for pending requests. A step fires once a
+// request has used its threshold of the SLA, measured on the business
+// calendar, and each threshold fires at most once per request. Types without
+// a stored ladder use the built-in one.
+// ---------------------------------------------------------------------------
+
+type slaEscalationService struct {
+	db              *gorm.DB
+	directory       orgDirectory
+	sla             *slaCalendar
+	chain           ApprovalChainService
+	globalSetting   GlobalSettingService
+	notificationSvc NotificationService
+	log             zerolog.Logger
+}
+
+func newSLAEscalationService(
+	repos *repository.Container,
+	cfg *config.Config,
+	log zerolog.Logger,
+	globalSetting GlobalSettingService,
+	sla *slaCalendar,
+	chain ApprovalChainService,
+	notificationSvc NotificationService,
+) SLAEscalationService {
+	log = log.With().Str("service", "sla_escalation").Logger()
+	svc := &slaEscalationService{
+		db:              repos.GormDB,
+		sla:             sla,
+		chain:           chain,
+		globalSetting:   globalSetting,
+		notificationSvc: notificationSvc,
+		log:             log,
+	}
+	if repos.Erp != nil {
+		svc.directory = repos.Erp
+	}
+	return svc
+}
+
+// ==========================================================================
+// Ladder setup
+// ==========================================================================
+
+// GetSLAEscalationLadders lists the ladder of every feedback request type,
+// stored or built-in.
+func (s *slaEscalationService) GetSLAEscalationLadders(ctx context.Context) (performance.SLAEscalationLadderListResponseVm, error) {
+	resp := performance.SLAEscalationLadderListResponseVm{}
+
+	ladders, err := s.ladders(ctx)
+	if err != nil {
+		return resp, err
+	}
+	for _, t := range feedbackRequestTypes() {
+		steps, stored := ladders[t]
+		if !stored {
+			steps = builtInSLAEscalationSteps()
+		}
+		resp.Data = append(resp.Data, toSLAEscalationLadderVm(t, steps, !stored))
+	}
+
+	resp.TotalRecord = len(resp.Data)
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// SaveSLAEscalationLadder replaces the stored ladder of a feedback request
+// type.
+func (s *slaEscalationService) SaveSLAEscalationLadder(ctx context.Context, req *performance.SLAEscalationLadderRequestModel) (performance.SLAEscalationLadderListResponseVm, error) {
+	resp := performance.SLAEscalationLadderListResponseVm{}
+
+	requestType := enums.FeedbackRequestType(req.FeedbackRequestType)
+	if !isFeedbackRequestType(requestType) {
+		resp.HasError = true
+		resp.Message = fmt.Sprintf("unknown feedback request type %d", req.FeedbackRequestType)
+		return resp, nil
+	}
+	if err := validateSLAEscalationSteps(req.Steps); err != nil {
+		resp.HasError = true
+		resp.Message = err.Error()
+		return resp, nil
+	}
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&performance.SLAEscalationStep{}).
+			Where("feedback_request_type = ? AND soft_deleted = ?", requestType, false).
+			Updates(map[string]interface{}{"soft_deleted": true, "is_active": false, "updated_by": req.UpdatedBy}).Error; err != nil {
+			return fmt.Errorf("clearing escalation ladder: %w", err)
+		}
+		steps := make([]performance.SLAEscalationStep, 0, len(req.Steps))
+		for _, st := range req.Steps {
+			step := performance.SLAEscalationStep{
+				SLAEscalationStepID: GenerateID(),
+				FeedbackRequestType: requestType,
+				ThresholdPercent:    st.ThresholdPercent,
+				Name:                strings.TrimSpace(st.Name),
+				NotifyAssignee:      st.NotifyAssignee,
+				EscalateTo:          enums.ApproverResolverType(st.EscalateTo),
+				RoleName:            strings.TrimSpace(st.RoleName),
+			}
+			step.CreatedBy = req.UpdatedBy
+			steps = append(steps, step)
+		}
+		if err := tx.Create(&steps).Error; err != nil {
+			return fmt.Errorf("saving escalation ladder: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		s.log.Error().Err(err).Str("action", "SAVE_SLA_ESCALATION_LADDER").Msg("failed to save escalation ladder")
+		return resp, err
+	}
+
+	s.log.Info().Int("feedbackRequestType", int(requestType)).Int("steps", len(req.Steps)).Msg("SLA escalation ladder saved")
+	return s.GetSLAEscalationLadders(ctx)
+}
+
+// DeleteSLAEscalationLadder removes the stored ladder of a feedback request
+// type, which reverts to the built-in ladder.
+func (s *slaEscalationService) DeleteSLAEscalationLadder(ctx context.Context, feedbackRequestType int, deletedBy string) (performance.ResponseVm, error) {
+	resp := performance.ResponseVm{ID: fmt.Sprint(feedbackRequestType)}
+
+	result := s.db.WithContext(ctx).Model(&performance.SLAEscalationStep{}).
+		Where("feedback_request_type = ? AND soft_deleted = ?", feedbackRequestType, false).
+		Updates(map[string]interface{}{"soft_deleted": true, "is_active": false, "updated_by": deletedBy})
+	if result.Error != nil {
+		return resp, fmt.Errorf("deleting escalation ladder: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		resp.HasError = true
+		resp.Message = fmt.Sprintf("no stored escalation ladder for feedback request type %d", feedbackRequestType)
+		return resp, nil
+	}
+
+	resp.Message = msgOperationCompleted
+	return resp, nil
+}
+
+// ladders loads the stored ladders keyed by feedback request type, steps in
+// threshold order.
+func (s *slaEscalationService) ladders(ctx context.Context) (map[enums.FeedbackRequestType][]performance.SLAEscalationStep, error) {
+	var steps []performance.SLAEscalationStep
+	if err := s.db.WithContext(ctx).
+		Where("soft_deleted = ?", false).
+		Order("feedback_request_type, threshold_percent").
+		Find(&steps).Error; err != nil {
+		return nil, fmt.Errorf("loading escalation ladders: %w", err)
+	}
+
+	ladders := make(map[enums.FeedbackRequestType][]performance.SLAEscalationStep)
+	for _, st := range steps {
+		ladders[st.FeedbackRequestType] = append(ladders[st.FeedbackRequestType], st)
+	}
+	return ladders, nil
+}
+
+// ==========================================================================
+// Evaluation
+// ==========================================================================
+
+// SendSLAEscalations fires the escalation steps due for pending feedback
+// requests and returns how many requests were escalated.
+func (s *slaEscalationService) SendSLAEscalations(ctx context.Context) (int, error) {
+	pending := s.db.WithContext(ctx).Model(&performance.FeedbackRequestLog{}).
+		Where("record_status = ? AND has_sla = ? AND time_completed IS NULL AND soft_deleted = ?",
+			enums.StatusActive.String(), true, false)
+
+	var requests []performance.FeedbackRequestLog
+	if err := pending.Session(&gorm.Session{}).Find(&requests).Error; err != nil {
+		return 0, fmt.Errorf("loading pending requests: %w", err)
+	}
+	if len(requests) == 0 {
+		return 0, nil
+	}
+
+	ladders, err := s.ladders(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var records []performance.FeedbackRequestEscalation
+	if err := s.db.WithContext(ctx).
+		Where("feedback_request_log_id IN (?)", pending.Session(&gorm.Session{}).Select("feedback_request_log_id")).
+		Find(&records).Error; err != nil {
+		return 0, fmt.Errorf("loading sent escalations: %w", err)
+	}
+	fired := make(map[string]map[int]bool)
+	for _, rec := range records {
+		key := escalationKey(rec.FeedbackRequestLogID, rec.AssignedStaffID, rec.TimeInitiated)
+		if fired[key] == nil {
+			fired[key] = make(map[int]bool)
+		}
+		fired[key][rec.ThresholdPercent] = true
+	}
+
+	sla := s.sla.evaluator(ctx, requests)
+	escalated := 0
+	for _, r := range requests {
+		steps, stored := ladders[r.FeedbackRequestType]
+		if !stored {
+			steps = builtInSLAEscalationSteps()
+		}
+		status := sla.status(r)
+		if status.Allowed <= 0 {
+			continue
+		}
+		used := int(status.Elapsed * 100 / status.Allowed)
+		step, skipped := dueSLAEscalation(steps, fired[escalationKey(r.FeedbackRequestLogID, r.AssignedStaffID, r.TimeInitiated)], used)
+		if step == nil {
+			continue
+		}
+		sent, err := s.fire(ctx, r, *step, skipped, status, used)
+		if err != nil {
+			s.log.Warn().Err(err).Str("requestId", r.FeedbackRequestLogID).Int("threshold", step.ThresholdPercent).
+				Msg("failed to escalate feedback request")
+			continue
+		}
+		if sent {
+			escalated++
+		}
+	}
+	return escalated, nil
+}
+
+// escalationKey identifies one assignment of a request. Reassigning or
+// re-initiating a request starts a new assignment, which climbs the ladder
+// from the bottom.
+func escalationKey(requestID, assigneeID string, initiated time.Time) string {
+	return requestID + "|" + strings.TrimSpace(assigneeID) + "|" + initiated.UTC().Format(time.RFC3339Nano)
+}
+
+// dueSLAEscalation picks the step to fire for a request that has used
+// usedPercent of its SLA: the highest step reached above the last one fired.
+// Lower steps reached but not fired are overtaken and returned as skipped,
+// so a late run sends one notification rather than every missed one.
+func dueSLAEscalation(steps []performance.SLAEscalationStep, fired map[int]bool, usedPercent int) (*performance.SLAEscalationStep, []performance.SLAEscalationStep) {
+	lastFired := 0
+	for threshold := range fired {
+		lastFired = max(lastFired, threshold)
+	}
+	var reached []performance.SLAEscalationStep
+	for _, st := range steps {
+		if st.ThresholdPercent > lastFired && st.ThresholdPercent <= usedPercent {
+			reached = append(reached, st)
+		}
+	}
+	if len(reached) == 0 {
+		return nil, nil
+	}
+	due := reached[len(reached)-1]
+	return &due, reached[:len(reached)-1]
+}
+
+// fire records a step and the steps it overtook for a request, then sends
+// the step's notifications. It reports false when another run recorded the
+// step first, or when the step has nobody to notify; such a step is left
+// unrecorded so a later run can fire it once its recipients resolve.
+func (s *slaEscalationService) fire(ctx context.Context, r performance.FeedbackRequestLog, step performance.SLAEscalationStep, skipped []performance.SLAEscalationStep, status requestSLAStatus, used int) (bool, error) {
+	escalateTo, err := s.escalationRecipients(ctx, step, r.AssignedStaffID)
+	if err != nil {
+		return false, fmt.Errorf("resolving escalation recipients: %w", err)
+	}
+	var recipients []string
+	if step.NotifyAssignee {
+		recipients = append(recipients, r.AssignedStaffID)
+	}
+	recipients = append(recipients, escalateTo...)
+	if len(recipients) == 0 {
+		s.log.Debug().Str("requestId", r.FeedbackRequestLogID).Int("threshold", step.ThresholdPercent).
+			Msg("escalation step has nobody to notify")
+		return false, nil
+	}
+	assignee := s.employee(ctx, r.AssignedStaffID)
+
+	now := time.Now().UTC()
+	record := func(st performance.SLAEscalationStep, notified []string, skipped bool) performance.FeedbackRequestEscalation {
+		return performance.FeedbackRequestEscalation{
+			FeedbackRequestEscalationID: GenerateID(),
+			FeedbackRequestLogID:        r.FeedbackRequestLogID,
+			AssignedStaffID:             strings.TrimSpace(r.AssignedStaffID),
+			TimeInitiated:               r.TimeInitiated,
+			ThresholdPercent:            st.ThresholdPercent,
+			StepName:                    st.Name,
+			NotifiedStaffIDs:            strings.Join(notified, ","),
+			Skipped:                     skipped,
+			SLAUsedPercent:              used,
+			DueAt:                       status.DueAt,
+			SentAt:                      now,
+		}
+	}
+
+	sent := false
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		rec := record(step, recipients, false)
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&rec)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		for _, st := range skipped {
+			rec := record(st, nil, true)
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&rec).Error; err != nil {
+				return err
+			}
+		}
+		sent = true
+		return nil
+	})
+	if err != nil || !sent {
+		return false, err
+	}
+
+	typeName := template.HTMLEscapeString(feedbackRequestTypeName(r.FeedbackRequestType))
+	due := status.DueAt.In(s.sla.calendar.loc).Format("02 Jan 2006 15:04")
+	breached := status.IsBreached
+	if step.NotifyAssignee {
+		title, line := "Request due soon: "+feedbackRequestTypeName(r.FeedbackRequestType),
+			fmt.Sprintf("Your request on <b>%s</b> from %s has used %d%% of its SLA and is due on %s.",
+				typeName, template.HTMLEscapeString(r.RequestOwnerStaffName), used, due)
+		if breached {
+			title = "Request past its SLA: " + feedbackRequestTypeName(r.FeedbackRequestType)
+			line = fmt.Sprintf("Your request on <b>%s</b> from %s was due on %s and has used %d%% of its SLA.",
+				typeName, template.HTMLEscapeString(r.RequestOwnerStaffName), due, used)
+		}
+		s.notify(ctx, r, r.AssignedStaffID, assignee, performance.NotificationTypeSLAWarning, title,
+			line+" Kindly treat it on Performance Management System.")
+	}
+
+	assigneeName := r.AssignedStaffName
+	if assignee != nil && strings.TrimSpace(assignee.FullName) != "" {
+		assigneeName = strings.TrimSpace(assignee.FullName)
+	}
+	verb := "is"
+	if breached {
+		verb = "was"
+	}
+	for _, staffID := range escalateTo {
+		s.notify(ctx, r, staffID, s.employee(ctx, staffID), performance.NotificationTypeSLAEscalation,
+			fmt.Sprintf("%s: %s request for %s", step.Name, feedbackRequestTypeName(r.FeedbackRequestType), assigneeName),
+			fmt.Sprintf("The request on <b>%s</b> assigned to %s (%s) has used %d%% of its SLA; it %s due on %s.",
+				typeName, template.HTMLEscapeString(assigneeName), template.HTMLEscapeString(r.AssignedStaffID), used, verb, due))
+	}
+	return true, nil
+}
+
+// escalationRecipients resolves who a step escalates to for the assignee,
+// leaving out the assignee.
+func (s *slaEscalationService) escalationRecipients(ctx context.Context, step performance.SLAEscalationStep, assigneeID string) ([]string, error) {
+	if step.EscalateTo == 0 || s.chain == nil {
+		return nil, nil
+	}
+	staffIDs, err := s.chain.ResolveApprovers(ctx, step.EscalateTo, step.RoleName, assigneeID)
+	if err != nil {
+		return nil, err
+	}
+	var out []string
+	seen := map[string]bool{strings.TrimSpace(assigneeID): true}
+	for _, id := range staffIDs {
+		id = strings.TrimSpace(id)
+		if id != "" && !seen[id] {
+			seen[id] = true
+			out = append(out, id)
+		}
+	}
+	return out, nil
+}
+
+// notify sends a staff member an SLA notification about a request.
+func (s *slaEscalationService) notify(ctx context.Context, r performance.FeedbackRequestLog, staffID string, emp *erp.EmployeeDetails, notificationType, title, line string) {
+	if s.notificationSvc == nil || strings.TrimSpace(staffID) == "" {
+		return
+	}
+	name := staffID
+	if emp != nil && strings.TrimSpace(emp.FullName) != "" {
+		name = strings.TrimSpace(emp.FullName)
+	}
+
+	n := feedbackRequestNotification(staffID, notificationType, r.FeedbackRequestLogID, title, stripTags(line))
+	body := fmt.Sprintf(`<p>Dear %s,</p><br/><p>%s</p><br/><p>Regards, <br/>Performance Management System (PMS)</p>`,
+		template.HTMLEscapeString(name), line)
+	email := &NotificationEmail{To: staffEmailAddress(ctx, s.globalSetting, emp), Subject: "PMS | " + title, Body: body}
+	if err := s.notificationSvc.Deliver(ctx, n, email); err != nil {
+		s.log.Warn().Err(err).Str("type", notificationType).Str("recipient", staffID).
+			Str("requestId", r.FeedbackRequestLogID).Msg("failed to send SLA notification")
+	}
+}
+
+// employee looks a staff member up in the ERP, or returns nil.
+func (s *slaEscalationService) employee(ctx context.Context, staffID string) *erp.EmployeeDetails {
+	if s.directory == nil || strings.TrimSpace(staffID) == "" {
+		return nil
+	}
+	emp, err := s.directory.GetEmployeeByID(ctx, strings.TrimSpace(staffID))
+	if err != nil {
+		s.log.Debug().Err(err).Str("staffId", staffID).Msg("could not look up employee")
+		return nil
+	}
+	return emp
+}
+
+// ==========================================================================
+// Validation & mapping helpers
+// ==========================================================================
+
+// builtInSLAEscalationSteps is the ladder of feedback request types without
+// a stored one: a reminder to the assignee at half the SLA, a warning at
+// 80%, the assignee's line manager at breach and HRD at twice the SLA.
+func builtInSLAEscalationSteps() []performance.SLAEscalationStep {
+	return []performance.SLAEscalationStep{
+		{ThresholdPercent: 50, Name: "Reminder", NotifyAssignee: true},
+		{ThresholdPercent: 80, Name: "Warning", NotifyAssignee: true},
+		{ThresholdPercent: 100, Name: "Breach", NotifyAssignee: true, EscalateTo: enums.ApproverResolverLineManager},
+		{ThresholdPercent: 200, Name: "HRD escalation", EscalateTo: enums.ApproverResolverHrdRole},
+	}
+}
+
+// validateSLAEscalationSteps enforces that a ladder has at least one step,
+// that thresholds are positive and distinct and that every step notifies
+// someone.
+func validateSLAEscalationSteps(steps []performance.SLAEscalationStepRequestModel) error {
+	if len(steps) == 0 {
+		return fmt.Errorf("%w: at least one step is required", ErrInvalidSLAEscalation)
+	}
+	seen := make(map[int]bool)
+	for _, st := range steps {
+		if strings.TrimSpace(st.Name) == "" {
+			return fmt.Errorf("%w: every step needs a name", ErrInvalidSLAEscalation)
+		}
+		if st.ThresholdPercent <= 0 {
+			return fmt.Errorf("%w: step %q must have a threshold above 0%%", ErrInvalidSLAEscalation, st.Name)
+		}
+		if seen[st.ThresholdPercent] {
+			return fmt.Errorf("%w: threshold %d%% is used by more than one step", ErrInvalidSLAEscalation, st.ThresholdPercent)
+		}
+		seen[st.ThresholdPercent] = true
+
+		resolver := enums.ApproverResolverType(st.EscalateTo)
+		switch {
+		case st.EscalateTo == 0 && !st.NotifyAssignee:
+			return fmt.Errorf("%w: step %q notifies nobody", ErrInvalidSLAEscalation, st.Name)
+		case st.EscalateTo != 0 && resolver.String() == "Unknown":
+			return fmt.Errorf("%w: step %q escalates to unknown resolver %d", ErrInvalidSLAEscalation, st.Name, st.EscalateTo)
+		case resolver == enums.ApproverResolverNamedRole && strings.TrimSpace(st.RoleName) == "":
+			return fmt.Errorf("%w: step %q must name a role", ErrInvalidSLAEscalation, st.Name)
+		}
+	}
+	return nil
+}
+
+func toSLAEscalationLadderVm(t enums.FeedbackRequestType, steps []performance.SLAEscalationStep, builtIn bool) performance.SLAEscalationLadderVm {
+	vm := performance.SLAEscalationLadderVm{
+		FeedbackRequestType:     int(t),
+		FeedbackRequestTypeName: feedbackRequestTypeName(t),
+		IsBuiltIn:               builtIn,
+		Steps:                   []performance.SLAEscalationStepVm{},
+	}
+	sorted := make([]performance.SLAEscalationStep, len(steps))
+	copy(sorted, steps)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ThresholdPercent < sorted[j].ThresholdPercent })
+	for _, st := range sorted {
+		step := performance.SLAEscalationStepVm{
+			SLAEscalationStepID: st.SLAEscalationStepID,
+			ThresholdPercent:    st.ThresholdPercent,
+			Name:                st.Name,
+			NotifyAssignee:      st.NotifyAssignee,
+			EscalateTo:          int(st.EscalateTo),
+			RoleName:            st.RoleName,
+		}
+		if st.EscalateTo != 0 {
+			step.EscalateToName = st.EscalateTo.String()
+		}
+		vm.Steps = append(vm.Steps, step)
+	}
+	return vm
+}
+
+// toFeedbackRequestEscalationVm maps a recorded escalation for display.
+func toFeedbackRequestEscalationVm(e performance.FeedbackRequestEscalation) performance.FeedbackRequestEscalationVm {
+	vm := performance.FeedbackRequestEscalationVm{
+		AssignedStaffID:  e.AssignedStaffID,
+		ThresholdPercent: e.ThresholdPercent,
+		StepName:         e.StepName,
+		NotifiedStaffIDs: []string{},
+		Skipped:          e.Skipped,
+		SLAUsedPercent:   e.SLAUsedPercent,
+		DueAt:            e.DueAt,
+		SentAt:           e.SentAt,
+	}
+	if e.NotifiedStaffIDs != "" {
+		vm.NotifiedStaffIDs = strings.Split(e.NotifiedStaffIDs, ",")
+	}
+	return vm
+}
+
+func feedbackRequestTypes() []enums.FeedbackRequestType {
+	types := make([]enums.FeedbackRequestType, 0, 17)
+	for t := enums.FeedbackRequestWorkProductEvaluation; t <= enums.FeedbackRequestCommitteeWorkProductDef; t++ {
+		types = append(types, t)
+	}
+	return types
+}
+
+func isFeedbackRequestType(t enums.FeedbackRequestType) bool {
+	return t >= enums.FeedbackRequestWorkProductEvaluation && t <= enums.FeedbackRequestCommitteeWorkProductDef
+}
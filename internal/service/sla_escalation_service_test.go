@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/enterprise-pms/pms-api/internal/domain/enums"
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
+	"github.com/rs/zerolog"
+)
+
+func TestDueSLAEscalation(t *testing.T) {
+	steps := builtInSLAEscalationSteps()
+
+	tests := []struct {
+		name    string
+		fired   []int
+		used    int
+		due     int // 0 when nothing is due
+		skipped []int
+	}{
+		{"below the first step", nil, 49, 0, nil},
+		{"first step reached", nil, 50, 50, nil},
+		{"already fired", []int{50}, 79, 0, nil},
+		{"next step reached", []int{50}, 85, 80, nil},
+		{"jumps to breach", nil, 120, 100, []int{50, 80}},
+		{"jumps past a fired step", []int{50}, 250, 200, []int{80, 100}},
+		{"ladder exhausted", []int{50, 80, 100, 200}, 400, 0, nil},
+	}
+	for _, tt := range tests {
+		fired := make(map[int]bool)
+		for _, f := range tt.fired {
+			fired[f] = true
+		}
+		due, skipped := dueSLAEscalation(steps, fired, tt.used)
+		got := 0
+		if due != nil {
+			got = due.ThresholdPercent
+		}
+		if got != tt.due {
+			t.Errorf("%s: due step %d, want %d", tt.name, got, tt.due)
+		}
+		if len(skipped) != len(tt.skipped) {
+			t.Errorf("%s: skipped %d steps, want %v", tt.name, len(skipped), tt.skipped)
+			continue
+		}
+		for i, st := range skipped {
+			if st.ThresholdPercent != tt.skipped[i] {
+				t.Errorf("%s: skipped[%d] = %d, want %d", tt.name, i, st.ThresholdPercent, tt.skipped[i])
+			}
+		}
+	}
+}
+
+func TestValidateSLAEscalationSteps(t *testing.T) {
+	step := func(threshold int, notify bool, escalateTo enums.ApproverResolverType, role string) performance.SLAEscalationStepRequestModel {
+		return performance.SLAEscalationStepRequestModel{
+			ThresholdPercent: threshold,
+			Name:             "Step",
+			NotifyAssignee:   notify,
+			EscalateTo:       int(escalateTo),
+			RoleName:         role,
+		}
+	}
+
+	tests := []struct {
+		name  string
+		steps []performance.SLAEscalationStepRequestModel
+		valid bool
+	}{
+		{"valid ladder", []performance.SLAEscalationStepRequestModel{
+			step(75, true, 0, ""),
+			step(100, true, enums.ApproverResolverLineManager, ""),
+			step(150, false, enums.ApproverResolverNamedRole, "HR Business Partner"),
+		}, true},
+		{"no steps", nil, false},
+		{"zero threshold", []performance.SLAEscalationStepRequestModel{step(0, true, 0, "")}, false},
+		{"duplicate threshold", []performance.SLAEscalationStepRequestModel{step(80, true, 0, ""), step(80, false, enums.ApproverResolverHrdRole, "")}, false},
+		{"notifies nobody", []performance.SLAEscalationStepRequestModel{step(80, false, 0, "")}, false},
+		{"unknown resolver", []performance.SLAEscalationStepRequestModel{step(80, false, 99, "")}, false},
+		{"named role without a role", []performance.SLAEscalationStepRequestModel{step(80, false, enums.ApproverResolverNamedRole, " ")}, false},
+		{"missing name", []performance.SLAEscalationStepRequestModel{{ThresholdPercent: 80, NotifyAssignee: true}}, false},
+	}
+	for _, tt := range tests {
+		err := validateSLAEscalationSteps(tt.steps)
+		if tt.valid && err != nil {
+			t.Errorf("%s: unexpected error %v", tt.name, err)
+		}
+		if !tt.valid && !errors.Is(err, ErrInvalidSLAEscalation) {
+			t.Errorf("%s: error = %v, want ErrInvalidSLAEscalation", tt.name, err)
+		}
+	}
+}
+
+func TestBuiltInSLAEscalationStepsAreValid(t *testing.T) {
+	var steps []performance.SLAEscalationStepRequestModel
+	for _, st := range builtInSLAEscalationSteps() {
+		steps = append(steps, performance.SLAEscalationStepRequestModel{
+			ThresholdPercent: st.ThresholdPercent,
+			Name:             st.Name,
+			NotifyAssignee:   st.NotifyAssignee,
+			EscalateTo:       int(st.EscalateTo),
+			RoleName:         st.RoleName,
+		})
+	}
+	if err := validateSLAEscalationSteps(steps); err != nil {
+		t.Fatalf("built-in ladder is invalid: %v", err)
+	}
+}
+
+// fakeApproverResolver resolves approvers to a fixed list or error.
+type fakeApproverResolver struct {
+	ApprovalChainService
+	staffIDs []string
+	err      error
+}
+
+func (f fakeApproverResolver) ResolveApprovers(ctx context.Context, resolver enums.ApproverResolverType, roleName, ownerStaffID string) ([]string, error) {
+	return f.staffIDs, f.err
+}
+
+func TestFireRecordsNothingWithoutRecipients(t *testing.T) {
+	r := performance.FeedbackRequestLog{FeedbackRequestLogID: "R1", AssignedStaffID: "A1"}
+	escalate := performance.SLAEscalationStep{ThresholdPercent: 100, Name: "Breach", EscalateTo: enums.ApproverResolverLineManager}
+
+	// Without a database a step that got as far as recording would panic.
+	lookupFailed := errors.New("ERP unavailable")
+	svc := &slaEscalationService{chain: fakeApproverResolver{err: lookupFailed}, log: zerolog.Nop()}
+	if sent, err := svc.fire(context.Background(), r, escalate, nil, requestSLAStatus{}, 100); sent || !errors.Is(err, lookupFailed) {
+		t.Errorf("failed lookup: sent %v, err %v; want the lookup error", sent, err)
+	}
+
+	// The assignee is never their own escalation recipient.
+	svc.chain = fakeApproverResolver{staffIDs: []string{" A1 "}}
+	if sent, err := svc.fire(context.Background(), r, escalate, nil, requestSLAStatus{}, 100); sent || err != nil {
+		t.Errorf("nobody to notify: sent %v, err %v; want nothing recorded", sent, err)
+	}
+}
+
+func TestEscalationKeyPerAssignment(t *testing.T) {
+	initiated := time.Date(2026, 5, 4, 9, 0, 0, 0, time.UTC)
+	first := escalationKey("R1", "A1", initiated)
+	if got := escalationKey("R1", " A1 ", initiated.In(time.FixedZone("WAT", 3600))); got != first {
+		t.Errorf("same assignment keyed %q and %q", first, got)
+	}
+	if escalationKey("R1", "A2", initiated) == first {
+		t.Error("reassigned request should start a new ladder")
+	}
+	if escalationKey("R1", "A1", initiated.Add(time.Hour)) == first {
+		t.Error("re-initiated request should start a new ladder")
+	}
+}
@@ -604,6 +604,16 @@ func (s *strategyService) evaluationOptionsSetup(ctx context.Context, req *perfo
 	return s.db.WithContext(ctx).Save(&opt).Error
 }
 
+// approvalOutcome returns the status a record moves to when it is approved or
+// rejected under the built-in single-level approval chain.
+func (s *strategyService) approvalOutcome(recordStatus string, approval enums.Approval) (enums.Status, error) {
+	op := enums.OperationApprove
+	if approval != enums.ApprovalApproved {
+		op = enums.OperationReject
+	}
+	return applyWorkflowOperation(NewBaseWorkflowEngine(s.log), recordStatus, op)
+}
+
 // approveOrRejectEntity applies approval/rejection to a workflow entity.
 func (s *strategyService) approveOrRejectEntity(ctx context.Context, entity interface{}, approval enums.Approval, reason string) error {
 	now := time.Now().UTC()
@@ -611,121 +621,149 @@ func (s *strategyService) approveOrRejectEntity(ctx context.Context, entity inte
 
 	switch e := entity.(type) {
 	case *performance.EnterpriseObjective:
+		next, err := s.approvalOutcome(e.RecordStatus, approval)
+		if err != nil {
+			return err
+		}
 		if approval == enums.ApprovalApproved {
 			e.IsApproved = true
 			e.ApprovedBy = userID
 			e.DateApproved = &now
-			e.RecordStatus = enums.StatusApprovedAndActive.String()
-			e.Status = enums.StatusApprovedAndActive.String()
+			e.RecordStatus = next.String()
+			e.Status = next.String()
 		} else {
 			e.IsRejected = true
 			e.RejectedBy = userID
 			e.DateRejected = &now
 			e.RejectionReason = reason
-			e.RecordStatus = enums.StatusRejected.String()
-			e.Status = enums.StatusRejected.String()
+			e.RecordStatus = next.String()
+			e.Status = next.String()
 		}
 		return s.db.WithContext(ctx).Save(e).Error
 
 	case *performance.DepartmentObjective:
+		next, err := s.approvalOutcome(e.RecordStatus, approval)
+		if err != nil {
+			return err
+		}
 		if approval == enums.ApprovalApproved {
 			e.IsApproved = true
 			e.ApprovedBy = userID
 			e.DateApproved = &now
-			e.RecordStatus = enums.StatusApprovedAndActive.String()
-			e.Status = enums.StatusApprovedAndActive.String()
+			e.RecordStatus = next.String()
+			e.Status = next.String()
 		} else {
 			e.IsRejected = true
 			e.RejectedBy = userID
 			e.DateRejected = &now
 			e.RejectionReason = reason
-			e.RecordStatus = enums.StatusRejected.String()
-			e.Status = enums.StatusRejected.String()
+			e.RecordStatus = next.String()
+			e.Status = next.String()
 		}
 		return s.db.WithContext(ctx).Save(e).Error
 
 	case *performance.DivisionObjective:
+		next, err := s.approvalOutcome(e.RecordStatus, approval)
+		if err != nil {
+			return err
+		}
 		if approval == enums.ApprovalApproved {
 			e.IsApproved = true
 			e.ApprovedBy = userID
 			e.DateApproved = &now
-			e.RecordStatus = enums.StatusApprovedAndActive.String()
-			e.Status = enums.StatusApprovedAndActive.String()
+			e.RecordStatus = next.String()
+			e.Status = next.String()
 		} else {
 			e.IsRejected = true
 			e.RejectedBy = userID
 			e.DateRejected = &now
 			e.RejectionReason = reason
-			e.RecordStatus = enums.StatusRejected.String()
-			e.Status = enums.StatusRejected.String()
+			e.RecordStatus = next.String()
+			e.Status = next.String()
 		}
 		return s.db.WithContext(ctx).Save(e).Error
 
 	case *performance.OfficeObjective:
+		next, err := s.approvalOutcome(e.RecordStatus, approval)
+		if err != nil {
+			return err
+		}
 		if approval == enums.ApprovalApproved {
 			e.IsApproved = true
 			e.ApprovedBy = userID
 			e.DateApproved = &now
-			e.RecordStatus = enums.StatusApprovedAndActive.String()
-			e.Status = enums.StatusApprovedAndActive.String()
+			e.RecordStatus = next.String()
+			e.Status = next.String()
 		} else {
 			e.IsRejected = true
 			e.RejectedBy = userID
 			e.DateRejected = &now
 			e.RejectionReason = reason
-			e.RecordStatus = enums.StatusRejected.String()
-			e.Status = enums.StatusRejected.String()
+			e.RecordStatus = next.String()
+			e.Status = next.String()
 		}
 		return s.db.WithContext(ctx).Save(e).Error
 
 	case *performance.Strategy:
+		next, err := s.approvalOutcome(e.RecordStatus, approval)
+		if err != nil {
+			return err
+		}
 		if approval == enums.ApprovalApproved {
 			e.IsApproved = true
 			e.ApprovedBy = userID
 			e.DateApproved = &now
-			e.RecordStatus = enums.StatusApprovedAndActive.String()
-			e.Status = enums.StatusApprovedAndActive.String()
+			e.RecordStatus = next.String()
+			e.Status = next.String()
 		} else {
 			e.IsRejected = true
 			e.RejectedBy = userID
 			e.DateRejected = &now
 			e.RejectionReason = reason
-			e.RecordStatus = enums.StatusRejected.String()
-			e.Status = enums.StatusRejected.String()
+			e.RecordStatus = next.String()
+			e.Status = next.String()
 		}
 		return s.db.WithContext(ctx).Save(e).Error
 
 	case *performance.StrategicTheme:
+		next, err := s.approvalOutcome(e.RecordStatus, approval)
+		if err != nil {
+			return err
+		}
 		if approval == enums.ApprovalApproved {
 			e.IsApproved = true
 			e.ApprovedBy = userID
 			e.DateApproved = &now
-			e.RecordStatus = enums.StatusApprovedAndActive.String()
-			e.Status = enums.StatusApprovedAndActive.String()
+			e.RecordStatus = next.String()
+			e.Status = next.String()
 		} else {
 			e.IsRejected = true
 			e.RejectedBy = userID
 			e.DateRejected = &now
 			e.RejectionReason = reason
-			e.RecordStatus = enums.StatusRejected.String()
-			e.Status = enums.StatusRejected.String()
+			e.RecordStatus = next.String()
+			e.Status = next.String()
 		}
 		return s.db.WithContext(ctx).Save(e).Error
 
 	case *performance.ObjectiveCategory:
+		next, err := s.approvalOutcome(e.RecordStatus, approval)
+		if err != nil {
+			return err
+		}
 		if approval == enums.ApprovalApproved {
 			e.IsApproved = true
 			e.ApprovedBy = userID
 			e.DateApproved = &now
-			e.RecordStatus = enums.StatusApprovedAndActive.String()
-			e.Status = enums.StatusApprovedAndActive.String()
+			e.RecordStatus = next.String()
+			e.Status = next.String()
 		} else {
 			e.IsRejected = true
 			e.RejectedBy = userID
 			e.DateRejected = &now
 			e.RejectionReason = reason
-			e.RecordStatus = enums.StatusRejected.String()
-			e.Status = enums.StatusRejected.String()
+			e.RecordStatus = next.String()
+			e.Status = next.String()
 		}
 		return s.db.WithContext(ctx).Save(e).Error
 
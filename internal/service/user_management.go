@@ -4,10 +4,12 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/enterprise-pms/pms-api/internal/domain/auth"
 	"github.com/enterprise-pms/pms-api/internal/domain/identity"
 	"github.com/enterprise-pms/pms-api/internal/repository"
 	"github.com/rs/zerolog"
@@ -90,12 +92,20 @@ func (s *UserManagementService) UpdateUser(ctx context.Context, user *identity.A
 	return s.db.WithContext(ctx).Save(user).Error
 }
 
-// DeactivateUser sets a user as inactive (soft deactivation).
+// DeactivateUser sets a user as inactive (soft deactivation) and revokes all
+// of their sessions, so their access tokens stop working immediately.
 func (s *UserManagementService) DeactivateUser(ctx context.Context, userID string) error {
-	return s.db.WithContext(ctx).
-		Model(&identity.ApplicationUser{}).
-		Where("id = ?", userID).
-		Update("is_active", false).Error
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&identity.ApplicationUser{}).
+			Where("id = ?", userID).
+			Update("is_active", false).Error; err != nil {
+			return err
+		}
+		if _, err := revokeSessionsTx(tx, auth.SessionRevokedDeactivated, "user_id = ?", userID); err != nil && !errors.Is(err, ErrSessionNotFound) {
+			return err
+		}
+		return nil
+	})
 }
 
 // IncrementAccessFailedCount increments the failed login counter and locks
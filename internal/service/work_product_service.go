@@ -179,7 +179,11 @@ func (ws *workProductService) addWorkProduct(ctx context.Context, req *performan
 		Deliverables:    req.Deliverables,
 		Remark:          req.Remark,
 	}
-	wp.RecordStatus = enums.StatusPendingApproval.String()
+	submitted, err := nextApprovalStatus(ctx, ws.parent.chainSvc, enums.ApprovalEntityWorkProduct, enums.StatusDraft.String(), enums.OperationAdd)
+	if err != nil {
+		return resp, err
+	}
+	wp.RecordStatus = submitted.String()
 	wp.IsActive = true
 	wp.CreatedBy = req.CreatedBy
 
@@ -260,36 +264,56 @@ func (ws *workProductService) updateWorkProduct(ctx context.Context, req *perfor
 func (ws *workProductService) approveWorkProduct(ctx context.Context, req *performance.WorkProductRequestModel) (performance.ResponseVm, error) {
 	resp := performance.ResponseVm{}
 
-	now := time.Now().UTC()
-	ws.db.WithContext(ctx).Model(&performance.WorkProduct{}).
+	auditCtx, actor, next, err := ws.authorizeWorkProductApproval(ctx, req, enums.OperationApprove)
+	if err != nil {
+		return resp, err
+	}
+
+	updates := map[string]interface{}{
+		"record_status":    next.String(),
+		"approver_comment": req.ApproverComment,
+	}
+	resp.Message = "work product approved and sent to the next approval stage"
+	if next == enums.StatusApprovedAndActive {
+		updates["record_status"] = enums.StatusActive.String()
+		updates["is_active"] = true
+		updates["is_approved"] = true
+		updates["approved_by"] = actor.StaffID
+		updates["date_approved"] = time.Now().UTC()
+		resp.Message = "work product approved successfully"
+	}
+	if err := ws.db.WithContext(auditCtx).Model(&performance.WorkProduct{}).
 		Where("work_product_id = ?", req.WorkProductID).
-		Updates(map[string]interface{}{
-			"record_status":   enums.StatusActive.String(),
-			"is_active":       true,
-			"is_approved":     true,
-			"approved_by":     req.UpdatedBy,
-			"date_approved":   now,
-			"approver_comment": req.ApproverComment,
-		})
+		Updates(updates).Error; err != nil {
+		return resp, fmt.Errorf("approving work product: %w", err)
+	}
+	recordApprovalAction(ctx, ws.parent.delegationSvc, actor, performance.WorkProduct{}.TableName(), req.WorkProductID, enums.OperationApprove)
 
 	resp.ID = req.WorkProductID
-	resp.Message = "work product approved successfully"
 	return resp, nil
 }
 
 func (ws *workProductService) rejectWorkProduct(ctx context.Context, req *performance.WorkProductRequestModel) (performance.ResponseVm, error) {
 	resp := performance.ResponseVm{}
 
+	auditCtx, actor, next, err := ws.authorizeWorkProductApproval(ctx, req, enums.OperationReject)
+	if err != nil {
+		return resp, err
+	}
+
 	now := time.Now().UTC()
-	ws.db.WithContext(ctx).Model(&performance.WorkProduct{}).
+	if err := ws.db.WithContext(auditCtx).Model(&performance.WorkProduct{}).
 		Where("work_product_id = ?", req.WorkProductID).
 		Updates(map[string]interface{}{
-			"record_status":    enums.StatusRejected.String(),
+			"record_status":    next.String(),
 			"is_rejected":      true,
-			"rejected_by":      req.UpdatedBy,
+			"rejected_by":      actor.StaffID,
 			"rejection_reason": req.RejectionReason,
 			"date_rejected":    now,
-		})
+		}).Error; err != nil {
+		return resp, fmt.Errorf("rejecting work product: %w", err)
+	}
+	recordApprovalAction(ctx, ws.parent.delegationSvc, actor, performance.WorkProduct{}.TableName(), req.WorkProductID, enums.OperationReject)
 
 	resp.ID = req.WorkProductID
 	resp.Message = "work product rejected successfully"
@@ -305,29 +329,68 @@ func (ws *workProductService) returnWorkProduct(ctx context.Context, req *perfor
 		return resp, fmt.Errorf("work product not found: %w", err)
 	}
 
-	wp.RecordStatus = enums.StatusReturned.String()
+	next, err := nextApprovalStatus(ctx, ws.parent.chainSvc, enums.ApprovalEntityWorkProduct, wp.RecordStatus, enums.OperationReturn)
+	if err != nil {
+		return resp, err
+	}
+	auditCtx, actor, err := authorizeApproval(ctx, ws.parent.delegationSvc, enums.ApprovalEntityWorkProduct, wp.RecordStatus, wp.StaffID, req.UpdatedBy)
+	if err != nil {
+		return resp, err
+	}
+
+	wp.RecordStatus = next.String()
 	wp.NoReturned++
 	wp.ApproverComment = req.ApproverComment
 
-	if err := ws.db.WithContext(ctx).Save(&wp).Error; err != nil {
+	if err := ws.db.WithContext(auditCtx).Save(&wp).Error; err != nil {
 		return resp, fmt.Errorf("returning work product: %w", err)
 	}
+	recordApprovalAction(ctx, ws.parent.delegationSvc, actor, wp.TableName(), wp.WorkProductID, enums.OperationReturn)
 
 	resp.ID = wp.WorkProductID
 	resp.Message = "work product returned successfully"
 	return resp, nil
 }
 
+// authorizeWorkProductApproval loads the work product, checks that the caller
+// is its approver or holds a delegation from them, and returns the status op
+// moves it to under the active approval chain.
+func (ws *workProductService) authorizeWorkProductApproval(ctx context.Context, req *performance.WorkProductRequestModel, op enums.OperationType) (context.Context, ApprovalActor, enums.Status, error) {
+	var wp performance.WorkProduct
+	if err := ws.db.WithContext(ctx).
+		Where("work_product_id = ?", req.WorkProductID).First(&wp).Error; err != nil {
+		return ctx, ApprovalActor{}, 0, fmt.Errorf("work product not found: %w", err)
+	}
+	next, err := nextApprovalStatus(ctx, ws.parent.chainSvc, enums.ApprovalEntityWorkProduct, wp.RecordStatus, op)
+	if err != nil {
+		return ctx, ApprovalActor{}, 0, err
+	}
+	auditCtx, actor, err := authorizeApproval(ctx, ws.parent.delegationSvc, enums.ApprovalEntityWorkProduct, wp.RecordStatus, wp.StaffID, req.UpdatedBy)
+	return auditCtx, actor, next, err
+}
+
 func (ws *workProductService) reSubmitWorkProduct(ctx context.Context, req *performance.WorkProductRequestModel) (performance.ResponseVm, error) {
 	resp := performance.ResponseVm{}
 
-	ws.db.WithContext(ctx).Model(&performance.WorkProduct{}).
+	var wp performance.WorkProduct
+	if err := ws.db.WithContext(ctx).
+		Where("work_product_id = ?", req.WorkProductID).First(&wp).Error; err != nil {
+		return resp, fmt.Errorf("work product not found: %w", err)
+	}
+	next, err := nextApprovalStatus(ctx, ws.parent.chainSvc, enums.ApprovalEntityWorkProduct, wp.RecordStatus, enums.OperationReSubmit)
+	if err != nil {
+		return resp, err
+	}
+
+	if err := ws.db.WithContext(ctx).Model(&performance.WorkProduct{}).
 		Where("work_product_id = ?", req.WorkProductID).
 		Updates(map[string]interface{}{
-			"record_status": enums.StatusPendingApproval.String(),
+			"record_status": next.String(),
 			"is_rejected":   false,
 			"remark":         req.Remark,
-		})
+		}).Error; err != nil {
+		return resp, fmt.Errorf("re-submitting work product: %w", err)
+	}
 
 	resp.ID = req.WorkProductID
 	resp.Message = "work product re-submitted successfully"
@@ -1054,15 +1117,15 @@ func (ws *workProductService) ReCalculateWorkProductPoints(ctx context.Context,
 		Where("staff_id = ? AND record_status NOT IN ?", staffID, excludedStatuses()).
 		Find(&wps)
 
+	// Competency gap closures credited in the period count alongside the
+	// work products, weighted by their objective category.
+	var closures []performance.CompetencyGapClosure
+	ws.db.WithContext(ctx).
+		Where("staff_id = ? AND review_period_id = ? AND record_status = ?", staffID, reviewPeriodID, enums.StatusActive.String()).
+		Find(&closures)
+
 	// Recalculate period score based on work product evaluations
-	var totalPoints float64
-	var maxPoints float64
-	for _, wp := range wps {
-		maxPoints += wp.MaxPoint
-		if wp.RecordStatus == enums.StatusClosed.String() {
-			totalPoints += wp.FinalScore
-		}
-	}
+	totalPoints, maxPoints := periodScorePoints(wps, closures)
 
 	// Update period score
 	var periodScore performance.PeriodScore
@@ -1075,6 +1138,7 @@ func (ws *workProductService) ReCalculateWorkProductPoints(ctx context.Context,
 		if maxPoints > 0 {
 			periodScore.ScorePercentage = (totalPoints / maxPoints) * 100
 		}
+		applyPeriodScoreGrade(&periodScore, ws.parent.gradeScaleForPeriodID(ctx, reviewPeriodID))
 		ws.db.WithContext(ctx).Save(&periodScore)
 	}
 
@@ -1084,6 +1148,24 @@ func (ws *workProductService) ReCalculateWorkProductPoints(ctx context.Context,
 	return resp, nil
 }
 
+// periodScorePoints totals the points a staff member earned in a review
+// period and the points available: the final scores of closed work products
+// out of their max points, and each competency gap closure's score, a
+// percentage, applied to its category's max points.
+func periodScorePoints(wps []performance.WorkProduct, closures []performance.CompetencyGapClosure) (total, available float64) {
+	for _, wp := range wps {
+		available += wp.MaxPoint
+		if wp.RecordStatus == enums.StatusClosed.String() {
+			total += wp.FinalScore
+		}
+	}
+	for _, c := range closures {
+		available += c.MaxPoints
+		total += c.MaxPoints * c.FinalScore / 100
+	}
+	return total, available
+}
+
 // =========================================================================
 // WorkProductEvaluation -- evaluates a work product with T/Q/O scores.
 // Mirrors .NET WorkProductEvaluation (full workflow).
@@ -41,74 +41,95 @@ type WorkflowEngine struct {
 // Engine constructors
 // ---------------------------------------------------------------------------
 
-// NewBaseWorkflowEngine returns an engine configured for single-level
-// (line-manager) approval workflows used by most PMS entities.
-func NewBaseWorkflowEngine(log zerolog.Logger) *WorkflowEngine {
-	return &WorkflowEngine{
-		log: log,
-		transitions: []TransitionRule{
-			// Submission
-			{From: enums.StatusDraft, To: enums.StatusPendingApproval, Operation: enums.OperationCommitDraft},
-			{From: enums.StatusDraft, To: enums.StatusPendingApproval, Operation: enums.OperationAdd},
-
-			// Single-level approval
-			{From: enums.StatusPendingApproval, To: enums.StatusApprovedAndActive, Operation: enums.OperationApprove},
-			{From: enums.StatusPendingApproval, To: enums.StatusRejected, Operation: enums.OperationReject},
-			{From: enums.StatusPendingApproval, To: enums.StatusReturned, Operation: enums.OperationReturn},
-
-			// Re-submission after return
-			{From: enums.StatusReturned, To: enums.StatusPendingApproval, Operation: enums.OperationReSubmit},
-
-			// Deactivation and reactivation
-			{From: enums.StatusApprovedAndActive, To: enums.StatusDeactivated, Operation: enums.OperationCancel},
-			{From: enums.StatusApprovedAndActive, To: enums.StatusDeactivated, Operation: enums.OperationDelete},
-			{From: enums.StatusDeactivated, To: enums.StatusApprovedAndActive, Operation: enums.OperationReactivate},
+// ApprovalStage describes one approval step used to build a chain-driven
+// engine. PendingStatus is the status a record holds while it waits at the
+// stage; AllowReturn permits the approver to send it back to the submitter.
+type ApprovalStage struct {
+	Name          string
+	PendingStatus enums.Status
+	AllowReturn   bool
+}
 
-			// Closure
-			{From: enums.StatusApprovedAndActive, To: enums.StatusClosed, Operation: enums.OperationClose},
-			{From: enums.StatusActive, To: enums.StatusClosed, Operation: enums.OperationClose},
+// BaseApprovalStages is the built-in single-level (line-manager) chain.
+func BaseApprovalStages() []ApprovalStage {
+	return []ApprovalStage{
+		{Name: "Line Manager", PendingStatus: enums.StatusPendingApproval, AllowReturn: true},
+	}
+}
 
-			// Completion
-			{From: enums.StatusActive, To: enums.StatusCompleted, Operation: enums.OperationComplete},
-		},
+// HrdApprovalStages is the built-in two-level chain where both the line
+// manager and HRD must approve.
+func HrdApprovalStages() []ApprovalStage {
+	return []ApprovalStage{
+		{Name: "Line Manager", PendingStatus: enums.StatusPendingApproval, AllowReturn: true},
+		{Name: "HRD", PendingStatus: enums.StatusPendingHRDApproval},
 	}
 }
 
+// NewBaseWorkflowEngine returns an engine configured for single-level
+// (line-manager) approval workflows used by most PMS entities.
+func NewBaseWorkflowEngine(log zerolog.Logger) *WorkflowEngine {
+	return NewChainWorkflowEngine(BaseApprovalStages(), log)
+}
+
 // NewHrdWorkflowEngine returns an engine configured for two-level approval
 // workflows where both a line manager and HRD must approve.
 func NewHrdWorkflowEngine(log zerolog.Logger) *WorkflowEngine {
-	return &WorkflowEngine{
-		log: log,
-		transitions: []TransitionRule{
-			// Submission
-			{From: enums.StatusDraft, To: enums.StatusPendingApproval, Operation: enums.OperationCommitDraft},
-			{From: enums.StatusDraft, To: enums.StatusPendingApproval, Operation: enums.OperationAdd},
+	return NewChainWorkflowEngine(HrdApprovalStages(), log)
+}
 
-			// Line-manager approval escalates to HRD
-			{From: enums.StatusPendingApproval, To: enums.StatusPendingHRDApproval, Operation: enums.OperationApprove},
-			{From: enums.StatusPendingApproval, To: enums.StatusRejected, Operation: enums.OperationReject},
-			{From: enums.StatusPendingApproval, To: enums.StatusReturned, Operation: enums.OperationReturn},
+// NewChainWorkflowEngine builds an engine from an ordered list of approval
+// stages. Submission enters the first stage, each approval advances to the
+// next stage's pending status and the final approval activates the record.
+// Every stage may reject; only stages with AllowReturn may return. The
+// deactivation, closure and completion rules are shared by all chains.
+// An empty stage list falls back to the built-in single-level chain.
+func NewChainWorkflowEngine(stages []ApprovalStage, log zerolog.Logger) *WorkflowEngine {
+	if len(stages) == 0 {
+		stages = BaseApprovalStages()
+	}
+	first := stages[0].PendingStatus
 
-			// HRD-level approval
-			{From: enums.StatusPendingHRDApproval, To: enums.StatusApprovedAndActive, Operation: enums.OperationApprove},
-			{From: enums.StatusPendingHRDApproval, To: enums.StatusRejected, Operation: enums.OperationReject},
+	transitions := []TransitionRule{
+		// Submission
+		{From: enums.StatusDraft, To: first, Operation: enums.OperationCommitDraft},
+		{From: enums.StatusDraft, To: first, Operation: enums.OperationAdd},
+	}
 
-			// Re-submission after return
-			{From: enums.StatusReturned, To: enums.StatusPendingApproval, Operation: enums.OperationReSubmit},
+	// Stage approvals — each approval escalates to the next stage
+	for i, stage := range stages {
+		next := enums.StatusApprovedAndActive
+		if i+1 < len(stages) {
+			next = stages[i+1].PendingStatus
+		}
+		transitions = append(transitions,
+			TransitionRule{From: stage.PendingStatus, To: next, Operation: enums.OperationApprove},
+			TransitionRule{From: stage.PendingStatus, To: enums.StatusRejected, Operation: enums.OperationReject},
+		)
+		if stage.AllowReturn {
+			transitions = append(transitions,
+				TransitionRule{From: stage.PendingStatus, To: enums.StatusReturned, Operation: enums.OperationReturn})
+		}
+	}
 
-			// Deactivation and reactivation
-			{From: enums.StatusApprovedAndActive, To: enums.StatusDeactivated, Operation: enums.OperationCancel},
-			{From: enums.StatusApprovedAndActive, To: enums.StatusDeactivated, Operation: enums.OperationDelete},
-			{From: enums.StatusDeactivated, To: enums.StatusApprovedAndActive, Operation: enums.OperationReactivate},
+	transitions = append(transitions,
+		// Re-submission after return
+		TransitionRule{From: enums.StatusReturned, To: first, Operation: enums.OperationReSubmit},
 
-			// Closure
-			{From: enums.StatusApprovedAndActive, To: enums.StatusClosed, Operation: enums.OperationClose},
-			{From: enums.StatusActive, To: enums.StatusClosed, Operation: enums.OperationClose},
+		// Deactivation and reactivation
+		TransitionRule{From: enums.StatusApprovedAndActive, To: enums.StatusDeactivated, Operation: enums.OperationCancel},
+		TransitionRule{From: enums.StatusApprovedAndActive, To: enums.StatusDeactivated, Operation: enums.OperationDelete},
+		TransitionRule{From: enums.StatusDeactivated, To: enums.StatusApprovedAndActive, Operation: enums.OperationReactivate},
 
-			// Completion
-			{From: enums.StatusActive, To: enums.StatusCompleted, Operation: enums.OperationComplete},
-		},
-	}
+		// Closure
+		TransitionRule{From: enums.StatusApprovedAndActive, To: enums.StatusClosed, Operation: enums.OperationClose},
+		TransitionRule{From: enums.StatusActive, To: enums.StatusClosed, Operation: enums.OperationClose},
+
+		// Completion
+		TransitionRule{From: enums.StatusActive, To: enums.StatusCompleted, Operation: enums.OperationComplete},
+	)
+
+	return &WorkflowEngine{log: log, transitions: transitions}
 }
 
 // NewReviewPeriodWorkflowEngine returns an engine configured for review period
@@ -193,6 +214,18 @@ func (w *WorkflowEngine) GetValidTransitions(from enums.Status) []TransitionRule
 	return result
 }
 
+// NextStatus returns the status a record moves to when the given operation
+// is applied in its current status. The boolean is false when the chain has
+// no rule for that operation.
+func (w *WorkflowEngine) NextStatus(from enums.Status, op enums.OperationType) (enums.Status, bool) {
+	for _, r := range w.transitions {
+		if r.From == from && r.Operation == op {
+			return r.To, true
+		}
+	}
+	return 0, false
+}
+
 // Execute validates the requested transition, invokes the before-hook (if set),
 // logs the transition, and invokes the after-hook (if set).
 func (w *WorkflowEngine) Execute(entityID string, from, to enums.Status, actorID string) error {
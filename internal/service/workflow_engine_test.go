@@ -1,12 +1,14 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/enterprise-pms/pms-api/internal/domain"
 	"github.com/enterprise-pms/pms-api/internal/domain/enums"
+	"github.com/enterprise-pms/pms-api/internal/domain/performance"
 	"github.com/rs/zerolog"
 )
 
@@ -610,3 +612,165 @@ func TestBaseWorkflow_GetValidTransitions_NoTransitions(t *testing.T) {
 		t.Errorf("expected 0 transitions from Completed, got %d", len(transitions))
 	}
 }
+
+// ---------------------------------------------------------------------------
+// Configurable approval chains
+// ---------------------------------------------------------------------------
+
+func threeStageChain() []ApprovalStage {
+	return []ApprovalStage{
+		{Name: "Head of Department", PendingStatus: enums.StatusPendingHODReview, AllowReturn: true},
+		{Name: "HRD", PendingStatus: enums.StatusPendingHRDReview},
+		{Name: "Committee", PendingStatus: enums.StatusPendingHRDApproval},
+	}
+}
+
+func TestChainWorkflow_ThreeStageApprovalPath(t *testing.T) {
+	engine := NewChainWorkflowEngine(threeStageChain(), nopLogger())
+
+	steps := []struct {
+		from enums.Status
+		op   enums.OperationType
+		want enums.Status
+	}{
+		{enums.StatusDraft, enums.OperationCommitDraft, enums.StatusPendingHODReview},
+		{enums.StatusPendingHODReview, enums.OperationApprove, enums.StatusPendingHRDReview},
+		{enums.StatusPendingHRDReview, enums.OperationApprove, enums.StatusPendingHRDApproval},
+		{enums.StatusPendingHRDApproval, enums.OperationApprove, enums.StatusApprovedAndActive},
+	}
+	for _, s := range steps {
+		got, ok := engine.NextStatus(s.from, s.op)
+		if !ok || got != s.want {
+			t.Errorf("NextStatus(%s, %s) = %s, %v; want %s", s.from, s.op, got, ok, s.want)
+		}
+		if err := engine.ValidateTransition(s.from, s.want); err != nil {
+			t.Errorf("expected %s -> %s to be valid: %v", s.from, s.want, err)
+		}
+	}
+
+	if err := engine.ValidateTransition(enums.StatusPendingHODReview, enums.StatusApprovedAndActive); err == nil {
+		t.Error("expected skipping the HRD and committee stages to be invalid")
+	}
+}
+
+func TestChainWorkflow_ReturnOnlyWhereAllowed(t *testing.T) {
+	engine := NewChainWorkflowEngine(threeStageChain(), nopLogger())
+
+	if !engine.CanTransition(enums.StatusPendingHODReview, enums.StatusReturned) {
+		t.Error("expected the HOD stage to allow return")
+	}
+	if engine.CanTransition(enums.StatusPendingHRDReview, enums.StatusReturned) {
+		t.Error("expected the HRD stage not to allow return")
+	}
+	if got, ok := engine.NextStatus(enums.StatusReturned, enums.OperationReSubmit); !ok || got != enums.StatusPendingHODReview {
+		t.Errorf("expected resubmission to restart at the first stage, got %s", got)
+	}
+	for _, st := range threeStageChain() {
+		if !engine.CanTransition(st.PendingStatus, enums.StatusRejected) {
+			t.Errorf("expected stage %s to allow rejection", st.Name)
+		}
+	}
+}
+
+func TestChainWorkflow_EmptyChainFallsBackToBase(t *testing.T) {
+	engine := NewChainWorkflowEngine(nil, nopLogger())
+
+	if got, ok := engine.NextStatus(enums.StatusPendingApproval, enums.OperationApprove); !ok || got != enums.StatusApprovedAndActive {
+		t.Errorf("expected the built-in chain, got %s, %v", got, ok)
+	}
+}
+
+// stubChainService serves a fixed chain as the active one.
+type stubChainService struct {
+	ApprovalChainService
+	stages []ApprovalStage
+}
+
+func (s stubChainService) ResolveWorkflowEngine(context.Context, enums.ApprovalEntityType) (*WorkflowEngine, error) {
+	return NewChainWorkflowEngine(s.stages, nopLogger()), nil
+}
+
+func TestNextApprovalStatus_AdvancesStageByStage(t *testing.T) {
+	ctx := context.Background()
+	chain := stubChainService{stages: threeStageChain()}
+
+	status := enums.StatusDraft.String()
+	ops := []enums.OperationType{enums.OperationAdd, enums.OperationApprove, enums.OperationApprove, enums.OperationApprove}
+	var visited []string
+	for _, op := range ops {
+		next, err := nextApprovalStatus(ctx, chain, enums.ApprovalEntityWorkProduct, status, op)
+		if err != nil {
+			t.Fatalf("%s from %s: %v", op, status, err)
+		}
+		status = next.String()
+		visited = append(visited, status)
+	}
+	want := []string{"PendingHODReview", "PendingHRDReview", "PendingHRDApproval", "ApprovedAndActive"}
+	if fmt.Sprint(visited) != fmt.Sprint(want) {
+		t.Errorf("visited %v, want %v", visited, want)
+	}
+
+	if _, err := nextApprovalStatus(ctx, chain, enums.ApprovalEntityWorkProduct, "PendingHRDReview", enums.OperationReturn); !errors.Is(err, ErrInvalidWorkflowTransition) {
+		t.Errorf("expected return at the HRD stage to be refused, got %v", err)
+	}
+	if _, err := nextApprovalStatus(ctx, chain, enums.ApprovalEntityWorkProduct, "PendingApproval", enums.OperationApprove); !errors.Is(err, ErrInvalidWorkflowTransition) {
+		t.Errorf("expected a status outside the chain to be refused, got %v", err)
+	}
+	if _, err := nextApprovalStatus(ctx, chain, enums.ApprovalEntityWorkProduct, "Active", enums.OperationApprove); !errors.Is(err, ErrInvalidWorkflowTransition) {
+		t.Errorf("expected an already active record to be refused, got %v", err)
+	}
+
+	if got, err := nextApprovalStatus(ctx, nil, enums.ApprovalEntityWorkProduct, "PendingApproval", enums.OperationApprove); err != nil || got != enums.StatusApprovedAndActive {
+		t.Errorf("expected the built-in chain without a chain service, got %s, %v", got, err)
+	}
+}
+
+func TestValidateApprovalChainStages(t *testing.T) {
+	stage := func(order, resolver, status int, role string) performance.ApprovalChainStageRequestModel {
+		return performance.ApprovalChainStageRequestModel{
+			StageOrder: order, Name: fmt.Sprintf("stage %d", order),
+			ApproverResolver: resolver, PendingStatus: status, RoleName: role,
+		}
+	}
+	hod := int(enums.ApproverResolverHeadOfDepartment)
+	named := int(enums.ApproverResolverNamedRole)
+
+	tests := []struct {
+		name    string
+		stages  []performance.ApprovalChainStageRequestModel
+		wantErr bool
+	}{
+		{"valid", []performance.ApprovalChainStageRequestModel{
+			stage(2, int(enums.ApproverResolverHrdRole), int(enums.StatusPendingHRDReview), ""),
+			stage(1, hod, int(enums.StatusPendingHODReview), ""),
+			stage(3, named, int(enums.StatusPendingHRDApproval), "Committee"),
+		}, false},
+		{"empty", nil, true},
+		{"gap in order", []performance.ApprovalChainStageRequestModel{
+			stage(1, hod, int(enums.StatusPendingHODReview), ""),
+			stage(3, hod, int(enums.StatusPendingHRDReview), ""),
+		}, true},
+		{"duplicate status", []performance.ApprovalChainStageRequestModel{
+			stage(1, hod, int(enums.StatusPendingHODReview), ""),
+			stage(2, hod, int(enums.StatusPendingHODReview), ""),
+		}, true},
+		{"non-pending status", []performance.ApprovalChainStageRequestModel{
+			stage(1, hod, int(enums.StatusApprovedAndActive), ""),
+		}, true},
+		{"named role without role", []performance.ApprovalChainStageRequestModel{
+			stage(1, named, int(enums.StatusPendingApproval), ""),
+		}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateApprovalChainStages(tt.stages)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("validateApprovalChainStages() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidApprovalChain) {
+				t.Errorf("expected ErrInvalidApprovalChain, got %v", err)
+			}
+		})
+	}
+}
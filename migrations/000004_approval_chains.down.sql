@@ -0,0 +1,4 @@
+-- Reverse approval chains migration
+
+DROP TABLE IF EXISTS pms.approval_chain_stages;
+DROP TABLE IF EXISTS pms.approval_chains;
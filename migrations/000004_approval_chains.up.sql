@@ -0,0 +1,39 @@
+-- Approval Chains Migration
+-- Stores configurable multi-stage approval chains per entity type.
+
+-- ============================================================
+-- APPROVAL CHAINS (pms schema)
+-- ============================================================
+
+CREATE TABLE IF NOT EXISTS pms.approval_chains (
+    approval_chain_id TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    description TEXT,
+    entity_type INT NOT NULL,
+    id SERIAL, record_status TEXT DEFAULT 'Active', created_at TIMESTAMPTZ DEFAULT NOW(),
+    soft_deleted BOOLEAN DEFAULT FALSE, status TEXT, updated_at TIMESTAMPTZ,
+    created_by VARCHAR(100), updated_by VARCHAR(100), is_active BOOLEAN DEFAULT TRUE
+);
+
+CREATE INDEX IF NOT EXISTS idx_approval_chains_entity_type ON pms.approval_chains(entity_type);
+
+-- Only one active chain may govern an entity type at a time.
+CREATE UNIQUE INDEX IF NOT EXISTS ux_approval_chains_active_entity
+    ON pms.approval_chains(entity_type)
+    WHERE is_active = TRUE AND soft_deleted = FALSE;
+
+CREATE TABLE IF NOT EXISTS pms.approval_chain_stages (
+    approval_chain_stage_id TEXT PRIMARY KEY,
+    approval_chain_id TEXT NOT NULL REFERENCES pms.approval_chains(approval_chain_id),
+    stage_order INT NOT NULL,
+    name TEXT NOT NULL,
+    approver_resolver INT NOT NULL,
+    role_name TEXT,
+    pending_status INT NOT NULL,
+    allow_return BOOLEAN DEFAULT FALSE,
+    id SERIAL, record_status TEXT DEFAULT 'Active', created_at TIMESTAMPTZ DEFAULT NOW(),
+    soft_deleted BOOLEAN DEFAULT FALSE, status TEXT, updated_at TIMESTAMPTZ,
+    created_by VARCHAR(100), updated_by VARCHAR(100), is_active BOOLEAN DEFAULT TRUE
+);
+
+CREATE INDEX IF NOT EXISTS idx_approval_chain_stages_chain ON pms.approval_chain_stages(approval_chain_id);
@@ -0,0 +1,3 @@
+-- Reverse approval delegations migration
+
+DROP TABLE IF EXISTS pms.approval_delegations;
@@ -0,0 +1,24 @@
+-- Approval Delegations Migration
+-- Date-bounded proxies that may act on a staff member's approvals.
+
+-- ============================================================
+-- APPROVAL DELEGATIONS (pms schema)
+-- ============================================================
+
+CREATE TABLE IF NOT EXISTS pms.approval_delegations (
+    approval_delegation_id TEXT PRIMARY KEY,
+    delegator_staff_id TEXT NOT NULL,
+    delegator_staff_name TEXT,
+    delegate_staff_id TEXT NOT NULL,
+    delegate_staff_name TEXT,
+    start_date TIMESTAMPTZ NOT NULL,
+    end_date TIMESTAMPTZ NOT NULL,
+    entity_types TEXT,
+    reason TEXT,
+    id SERIAL, record_status TEXT DEFAULT 'Active', created_at TIMESTAMPTZ DEFAULT NOW(),
+    soft_deleted BOOLEAN DEFAULT FALSE, status TEXT, updated_at TIMESTAMPTZ,
+    created_by VARCHAR(100), updated_by VARCHAR(100), is_active BOOLEAN DEFAULT TRUE
+);
+
+CREATE INDEX IF NOT EXISTS idx_approval_delegations_delegator ON pms.approval_delegations(delegator_staff_id, start_date, end_date);
+CREATE INDEX IF NOT EXISTS idx_approval_delegations_delegate ON pms.approval_delegations(delegate_staff_id);
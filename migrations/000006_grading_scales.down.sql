@@ -0,0 +1,11 @@
+-- Reverse grading scales migration
+
+ALTER TABLE pms.period_scores
+    DROP COLUMN IF EXISTS final_grade_label,
+    DROP COLUMN IF EXISTS grading_scale_id;
+
+ALTER TABLE pms.performance_review_periods DROP COLUMN IF EXISTS grading_scale_id;
+ALTER TABLE pms.strategies DROP COLUMN IF EXISTS grading_scale_id;
+
+DROP TABLE IF EXISTS pms.grading_scale_bands;
+DROP TABLE IF EXISTS pms.grading_scales;
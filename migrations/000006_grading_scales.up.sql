@@ -0,0 +1,53 @@
+-- Grading Scales Migration
+-- Stores versioned percentage-to-grade bands and attaches them to strategies,
+-- review periods and the period scores they produced.
+
+-- ============================================================
+-- GRADING SCALES (pms schema)
+-- ============================================================
+
+CREATE TABLE IF NOT EXISTS pms.grading_scales (
+    grading_scale_id TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    description TEXT,
+    version INT NOT NULL DEFAULT 1,
+    id SERIAL, record_status TEXT DEFAULT 'Active', created_at TIMESTAMPTZ DEFAULT NOW(),
+    soft_deleted BOOLEAN DEFAULT FALSE, status TEXT, updated_at TIMESTAMPTZ,
+    created_by VARCHAR(100), updated_by VARCHAR(100), is_active BOOLEAN DEFAULT TRUE
+);
+
+CREATE INDEX IF NOT EXISTS idx_grading_scales_name ON pms.grading_scales(name);
+
+-- Each version of a named scale is stored once.
+CREATE UNIQUE INDEX IF NOT EXISTS ux_grading_scales_name_version
+    ON pms.grading_scales(name, version)
+    WHERE soft_deleted = FALSE;
+
+CREATE TABLE IF NOT EXISTS pms.grading_scale_bands (
+    grading_scale_band_id TEXT PRIMARY KEY,
+    grading_scale_id TEXT NOT NULL REFERENCES pms.grading_scales(grading_scale_id),
+    min_percentage DECIMAL(18,2) NOT NULL,
+    max_percentage DECIMAL(18,2) NOT NULL,
+    grade INT NOT NULL,
+    label TEXT NOT NULL,
+    is_under_performing BOOLEAN DEFAULT FALSE,
+    id SERIAL, record_status TEXT DEFAULT 'Active', created_at TIMESTAMPTZ DEFAULT NOW(),
+    soft_deleted BOOLEAN DEFAULT FALSE, status TEXT, updated_at TIMESTAMPTZ,
+    created_by VARCHAR(100), updated_by VARCHAR(100), is_active BOOLEAN DEFAULT TRUE
+);
+
+CREATE INDEX IF NOT EXISTS idx_grading_scale_bands_scale ON pms.grading_scale_bands(grading_scale_id);
+
+-- ============================================================
+-- SCALE ATTACHMENTS
+-- ============================================================
+
+ALTER TABLE pms.strategies
+    ADD COLUMN IF NOT EXISTS grading_scale_id TEXT REFERENCES pms.grading_scales(grading_scale_id);
+
+ALTER TABLE pms.performance_review_periods
+    ADD COLUMN IF NOT EXISTS grading_scale_id TEXT REFERENCES pms.grading_scales(grading_scale_id);
+
+ALTER TABLE pms.period_scores
+    ADD COLUMN IF NOT EXISTS grading_scale_id TEXT REFERENCES pms.grading_scales(grading_scale_id),
+    ADD COLUMN IF NOT EXISTS final_grade_label TEXT;
@@ -0,0 +1,11 @@
+-- Reverse calibration migration
+
+ALTER TABLE pms.period_scores
+    DROP COLUMN IF EXISTS date_calibrated,
+    DROP COLUMN IF EXISTS calibrated_by,
+    DROP COLUMN IF EXISTS is_calibrated,
+    DROP COLUMN IF EXISTS calibrated_grade;
+
+DROP TABLE IF EXISTS pms.calibration_entries;
+DROP TABLE IF EXISTS pms.calibration_sessions;
+DROP TABLE IF EXISTS pms.calibration_quotas;
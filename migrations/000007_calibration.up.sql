@@ -0,0 +1,81 @@
+-- Calibration Migration
+-- Stores grade-distribution quotas, calibration sessions and the committee's
+-- grade adjustments, and records the calibrated grade on period scores.
+
+-- ============================================================
+-- CALIBRATION (pms schema)
+-- ============================================================
+
+CREATE TABLE IF NOT EXISTS pms.calibration_quotas (
+    calibration_quota_id TEXT PRIMARY KEY,
+    review_period_id TEXT NOT NULL DEFAULT '',
+    organogram_level INT NOT NULL,
+    grade INT NOT NULL,
+    min_percentage DECIMAL(18,2) DEFAULT 0,
+    max_percentage DECIMAL(18,2) DEFAULT 100,
+    id SERIAL, record_status TEXT DEFAULT 'Active', created_at TIMESTAMPTZ DEFAULT NOW(),
+    soft_deleted BOOLEAN DEFAULT FALSE, status TEXT, updated_at TIMESTAMPTZ,
+    created_by VARCHAR(100), updated_by VARCHAR(100), is_active BOOLEAN DEFAULT TRUE
+);
+
+CREATE INDEX IF NOT EXISTS idx_calibration_quotas_period ON pms.calibration_quotas(review_period_id);
+
+-- One quota per grade for each level, globally ('') and per period.
+CREATE UNIQUE INDEX IF NOT EXISTS ux_calibration_quotas_scope
+    ON pms.calibration_quotas(review_period_id, organogram_level, grade)
+    WHERE soft_deleted = FALSE;
+
+CREATE TABLE IF NOT EXISTS pms.calibration_sessions (
+    calibration_session_id TEXT PRIMARY KEY,
+    review_period_id TEXT NOT NULL REFERENCES pms.performance_review_periods(period_id),
+    organogram_level INT NOT NULL,
+    reference_id TEXT NOT NULL DEFAULT '',
+    reference_name TEXT,
+    session_status INT NOT NULL DEFAULT 1,
+    total_staff INT,
+    finalized_by TEXT,
+    date_finalized TIMESTAMPTZ,
+    id SERIAL, record_status TEXT DEFAULT 'Active', created_at TIMESTAMPTZ DEFAULT NOW(),
+    soft_deleted BOOLEAN DEFAULT FALSE, status TEXT, updated_at TIMESTAMPTZ,
+    created_by VARCHAR(100), updated_by VARCHAR(100), is_active BOOLEAN DEFAULT TRUE
+);
+
+CREATE INDEX IF NOT EXISTS idx_calibration_sessions_period ON pms.calibration_sessions(review_period_id);
+
+-- A unit is calibrated once per review period.
+CREATE UNIQUE INDEX IF NOT EXISTS ux_calibration_sessions_scope
+    ON pms.calibration_sessions(review_period_id, organogram_level, reference_id)
+    WHERE soft_deleted = FALSE;
+
+CREATE TABLE IF NOT EXISTS pms.calibration_entries (
+    calibration_entry_id TEXT PRIMARY KEY,
+    calibration_session_id TEXT NOT NULL REFERENCES pms.calibration_sessions(calibration_session_id),
+    period_score_id TEXT NOT NULL REFERENCES pms.period_scores(period_score_id),
+    staff_id TEXT NOT NULL,
+    score_percentage DECIMAL(18,2),
+    original_grade INT NOT NULL,
+    proposed_grade INT NOT NULL,
+    calibrated_grade INT,
+    decision INT NOT NULL DEFAULT 1,
+    proposal_reason TEXT,
+    justification TEXT,
+    decided_by TEXT,
+    date_decided TIMESTAMPTZ,
+    id SERIAL, record_status TEXT DEFAULT 'Active', created_at TIMESTAMPTZ DEFAULT NOW(),
+    soft_deleted BOOLEAN DEFAULT FALSE, status TEXT, updated_at TIMESTAMPTZ,
+    created_by VARCHAR(100), updated_by VARCHAR(100), is_active BOOLEAN DEFAULT TRUE
+);
+
+CREATE INDEX IF NOT EXISTS idx_calibration_entries_session ON pms.calibration_entries(calibration_session_id);
+CREATE UNIQUE INDEX IF NOT EXISTS ux_calibration_entries_score
+    ON pms.calibration_entries(calibration_session_id, period_score_id);
+
+-- ============================================================
+-- CALIBRATED GRADE ON PERIOD SCORES
+-- ============================================================
+
+ALTER TABLE pms.period_scores
+    ADD COLUMN IF NOT EXISTS calibrated_grade INT,
+    ADD COLUMN IF NOT EXISTS is_calibrated BOOLEAN DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS calibrated_by TEXT,
+    ADD COLUMN IF NOT EXISTS date_calibrated TIMESTAMPTZ;
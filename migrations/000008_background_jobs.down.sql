@@ -0,0 +1,3 @@
+-- Reverse background jobs migration
+
+DROP TABLE IF EXISTS pms.background_jobs;
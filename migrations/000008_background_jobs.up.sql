@@ -0,0 +1,37 @@
+-- Background Jobs Migration
+-- Persistent job queue replacing the in-memory worker pool: jobs survive
+-- restarts, are retried with backoff and dead-lettered after max attempts.
+
+-- ============================================================
+-- BACKGROUND JOBS (pms schema)
+-- ============================================================
+
+CREATE TABLE IF NOT EXISTS pms.background_jobs (
+    background_job_id TEXT PRIMARY KEY,
+    job_type TEXT NOT NULL,
+    queue TEXT,
+    payload TEXT,
+    idempotency_key TEXT NOT NULL DEFAULT '',
+    job_status INT NOT NULL DEFAULT 1,
+    attempts INT DEFAULT 0,
+    max_attempts INT NOT NULL,
+    run_at TIMESTAMPTZ NOT NULL,
+    locked_by TEXT,
+    locked_until TIMESTAMPTZ,
+    last_error TEXT,
+    started_at TIMESTAMPTZ,
+    completed_at TIMESTAMPTZ,
+    id SERIAL, record_status TEXT DEFAULT 'Active', created_at TIMESTAMPTZ DEFAULT NOW(),
+    soft_deleted BOOLEAN DEFAULT FALSE, status TEXT, updated_at TIMESTAMPTZ,
+    created_by VARCHAR(100), updated_by VARCHAR(100), is_active BOOLEAN DEFAULT TRUE
+);
+
+-- Workers poll for due jobs in run_at order.
+CREATE INDEX IF NOT EXISTS idx_background_jobs_due ON pms.background_jobs(job_status, run_at);
+CREATE INDEX IF NOT EXISTS idx_background_jobs_type ON pms.background_jobs(job_type);
+
+-- An idempotency key is held while the job is enqueued (1), processing (2)
+-- or awaiting retry (4).
+CREATE UNIQUE INDEX IF NOT EXISTS ux_background_jobs_idempotency
+    ON pms.background_jobs(idempotency_key)
+    WHERE idempotency_key <> '' AND job_status IN (1, 2, 4) AND soft_deleted = FALSE;
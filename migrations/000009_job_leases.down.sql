@@ -0,0 +1,3 @@
+-- Reverse job leases migration
+
+DROP TABLE IF EXISTS pms.job_leases;
@@ -0,0 +1,15 @@
+-- Job Leases Migration
+-- Cluster-wide leases so that, with several API replicas, scheduler
+-- leadership, each recurring job run and each mail batch is held by one
+-- replica at a time. A replica that dies stops renewing and its lease lapses.
+
+-- ============================================================
+-- JOB LEASES (pms schema)
+-- ============================================================
+
+CREATE TABLE IF NOT EXISTS pms.job_leases (
+    lease_name TEXT PRIMARY KEY,
+    holder TEXT NOT NULL,
+    acquired_at TIMESTAMPTZ NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL
+);
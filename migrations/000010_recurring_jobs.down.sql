@@ -0,0 +1,3 @@
+-- Reverse recurring jobs migration
+
+DROP TABLE IF EXISTS pms.recurring_jobs;
@@ -0,0 +1,25 @@
+-- Recurring Jobs Migration
+-- Per-job cron schedules and run status for the scheduler's recurring jobs.
+-- Administrators can pause, resume, reschedule and trigger jobs; every
+-- replica reconciles its cron entries against this table.
+
+-- ============================================================
+-- RECURRING JOBS (pms schema)
+-- ============================================================
+
+CREATE TABLE IF NOT EXISTS pms.recurring_jobs (
+    job_name TEXT PRIMARY KEY,
+    description TEXT,
+    schedule TEXT NOT NULL,
+    is_enabled BOOLEAN DEFAULT TRUE,
+    last_run_at TIMESTAMPTZ,
+    last_duration_ms BIGINT DEFAULT 0,
+    last_run_succeeded BOOLEAN DEFAULT FALSE,
+    last_error TEXT,
+    next_run_at TIMESTAMPTZ,
+    trigger_requested_at TIMESTAMPTZ,
+    trigger_requested_by TEXT,
+    id SERIAL, record_status TEXT DEFAULT 'Active', created_at TIMESTAMPTZ DEFAULT NOW(),
+    soft_deleted BOOLEAN DEFAULT FALSE, status TEXT, updated_at TIMESTAMPTZ,
+    created_by VARCHAR(100), updated_by VARCHAR(100), is_active BOOLEAN DEFAULT TRUE
+);
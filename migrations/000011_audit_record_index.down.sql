@@ -0,0 +1,3 @@
+-- Reverse audit record index migration
+
+DROP INDEX IF EXISTS pmsaudit.idx_audit_logs_record;
@@ -0,0 +1,6 @@
+-- Audit Record Index Migration
+-- The audit trail API replays one record's history at a time; index the
+-- audit log by record in event order.
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_record
+    ON pmsaudit.audit_logs(table_name, record_id, audit_event_date_utc, id);
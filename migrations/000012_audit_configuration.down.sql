@@ -0,0 +1,13 @@
+-- Reverse audit configuration migration
+
+DELETE FROM pmsaudit.auditable_attributes
+WHERE created_by = 'SYSTEM'
+  AND auditable_entity_id IN (
+      SELECT id FROM pmsaudit.auditable_entities WHERE entity_name = 'pms.background_jobs'
+  );
+DELETE FROM pmsaudit.auditable_entities
+WHERE created_by = 'SYSTEM'
+  AND entity_name IN ('pms.feedback_request_logs', 'pms.recurring_jobs', 'pms.background_jobs');
+
+DROP INDEX IF EXISTS pmsaudit.idx_auditable_attributes_entity;
+DROP INDEX IF EXISTS pmsaudit.ux_auditable_entities_id;
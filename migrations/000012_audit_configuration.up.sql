@@ -0,0 +1,27 @@
+-- Audit Configuration Migration
+-- The audit interceptor now honours pmsaudit.auditable_entities and
+-- pmsaudit.auditable_attributes. Seed rules for the noisiest tables; scores
+-- and grades are audited regardless of these rules.
+
+CREATE UNIQUE INDEX IF NOT EXISTS ux_auditable_entities_id ON pmsaudit.auditable_entities(id);
+CREATE INDEX IF NOT EXISTS idx_auditable_attributes_entity
+    ON pmsaudit.auditable_attributes(auditable_entity_id, attribute_name);
+
+-- Feedback request logs are an append-only trail of their own.
+-- Recurring job admin changes are audited explicitly; the rest is run bookkeeping.
+INSERT INTO pmsaudit.auditable_entities (entity_name, enable_audit, created_by)
+VALUES ('pms.feedback_request_logs', FALSE, 'SYSTEM'),
+       ('pms.recurring_jobs', FALSE, 'SYSTEM'),
+       ('pms.background_jobs', TRUE, 'SYSTEM')
+ON CONFLICT (entity_name) DO NOTHING;
+
+-- Keep admin retries and cancellations of background jobs, not worker leases.
+INSERT INTO pmsaudit.auditable_attributes (auditable_entity_id, attribute_name, enable_audit, created_by)
+SELECT e.id, a.attribute_name, FALSE, 'SYSTEM'
+FROM pmsaudit.auditable_entities e
+CROSS JOIN (VALUES ('locked_by'), ('locked_until'), ('started_at'), ('completed_at'), ('attempts'), ('last_error')) AS a(attribute_name)
+WHERE e.entity_name = 'pms.background_jobs'
+  AND NOT EXISTS (
+      SELECT 1 FROM pmsaudit.auditable_attributes x
+      WHERE x.auditable_entity_id = e.id AND x.attribute_name = a.attribute_name
+  );
@@ -0,0 +1,3 @@
+-- Reverse file uploads migration
+
+DROP TABLE IF EXISTS pms.file_uploads;
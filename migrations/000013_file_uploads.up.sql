@@ -0,0 +1,29 @@
+-- File Uploads Migration
+-- Metadata for files held by the configured storage backend (local disk or
+-- an S3-compatible bucket). Grievance evidence and feedback attachment
+-- columns reference file_upload_id.
+
+-- ============================================================
+-- FILE UPLOADS (pms schema)
+-- ============================================================
+
+CREATE TABLE IF NOT EXISTS pms.file_uploads (
+    file_upload_id TEXT PRIMARY KEY,
+    storage_key TEXT NOT NULL,
+    backend TEXT NOT NULL,
+    original_name TEXT,
+    content_type TEXT,
+    size_bytes BIGINT DEFAULT 0,
+    sha256 CHAR(64),
+    category TEXT,
+    uploaded_by TEXT,
+    owner_staff_id TEXT,
+    entity_type TEXT,
+    entity_id TEXT,
+    id SERIAL, record_status TEXT DEFAULT 'Active', created_at TIMESTAMPTZ DEFAULT NOW(),
+    soft_deleted BOOLEAN DEFAULT FALSE, status TEXT, updated_at TIMESTAMPTZ,
+    created_by VARCHAR(100), updated_by VARCHAR(100), is_active BOOLEAN DEFAULT TRUE
+);
+
+CREATE INDEX IF NOT EXISTS idx_file_uploads_uploaded_by ON pms.file_uploads (uploaded_by);
+CREATE INDEX IF NOT EXISTS idx_file_uploads_entity ON pms.file_uploads (entity_type, entity_id);
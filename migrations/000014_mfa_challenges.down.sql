@@ -0,0 +1,3 @@
+-- Reverse MFA challenges migration
+
+DROP TABLE IF EXISTS "CoreSchema".mfa_challenges;
@@ -0,0 +1,22 @@
+-- MFA Challenges Migration
+-- Pending RSA SecurID second-factor steps issued at login. Only a SHA-256
+-- hash of the challenge token is stored; each challenge is single-use and
+-- expires after a few minutes.
+
+-- ============================================================
+-- MFA CHALLENGES (CoreSchema)
+-- ============================================================
+
+CREATE TABLE IF NOT EXISTS "CoreSchema".mfa_challenges (
+    id VARCHAR(450) PRIMARY KEY,
+    user_id VARCHAR(450) NOT NULL REFERENCES "CoreSchema".asp_net_users(id),
+    token VARCHAR(64) NOT NULL UNIQUE,
+    authn_attempt_id TEXT,
+    message_id TEXT,
+    attempts INT DEFAULT 0,
+    expires_at TIMESTAMPTZ NOT NULL,
+    consumed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_mfa_challenges_user_id ON "CoreSchema".mfa_challenges (user_id);
@@ -0,0 +1,5 @@
+-- Reverse user sessions migration
+
+DROP INDEX IF EXISTS "CoreSchema".idx_refresh_tokens_session_id;
+ALTER TABLE "CoreSchema".refresh_tokens DROP COLUMN IF EXISTS session_id;
+DROP TABLE IF EXISTS "CoreSchema".user_sessions;
@@ -0,0 +1,37 @@
+-- User Sessions Migration
+-- One row per signed-in device. Access tokens carry the session ID and are
+-- rejected once the session is revoked; refresh tokens are rotated on every
+-- use within their session, and reuse of a rotated token revokes the
+-- session.
+
+-- ============================================================
+-- USER SESSIONS (CoreSchema)
+-- ============================================================
+
+CREATE TABLE IF NOT EXISTS "CoreSchema".user_sessions (
+    id VARCHAR(450) PRIMARY KEY,
+    user_id VARCHAR(450) NOT NULL REFERENCES "CoreSchema".asp_net_users(id),
+    device TEXT,
+    ip_address TEXT,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    last_used_at TIMESTAMPTZ,
+    expires_at TIMESTAMPTZ NOT NULL,
+    revoked_at TIMESTAMPTZ,
+    revoked_reason TEXT
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_sessions_user_id ON "CoreSchema".user_sessions (user_id);
+
+CREATE TABLE IF NOT EXISTS "CoreSchema".refresh_tokens (
+    id VARCHAR(450) PRIMARY KEY,
+    user_id VARCHAR(450) NOT NULL,
+    token VARCHAR(64) NOT NULL UNIQUE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    revoked BOOLEAN DEFAULT FALSE
+);
+
+ALTER TABLE "CoreSchema".refresh_tokens ADD COLUMN IF NOT EXISTS session_id VARCHAR(450);
+
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_user_id ON "CoreSchema".refresh_tokens (user_id);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_session_id ON "CoreSchema".refresh_tokens (session_id);
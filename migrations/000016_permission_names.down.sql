@@ -0,0 +1,3 @@
+-- Reverse permission names migration
+
+DROP INDEX IF EXISTS "CoreSchema".ux_permissions_name;
@@ -0,0 +1,8 @@
+-- Permission Names Migration
+-- Permissions are looked up by name: the router requires them by name and
+-- registers any that are missing at startup. Live permission names are
+-- therefore unique.
+
+CREATE UNIQUE INDEX IF NOT EXISTS ux_permissions_name
+    ON "CoreSchema".permissions (name)
+    WHERE soft_deleted = false;
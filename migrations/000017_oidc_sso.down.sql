@@ -0,0 +1,5 @@
+-- Reverse OIDC single sign-on migration
+
+DROP TABLE IF EXISTS "CoreSchema".user_external_logins;
+DROP TABLE IF EXISTS "CoreSchema".user_idp_roles;
+DROP TABLE IF EXISTS "CoreSchema".oidc_login_states;
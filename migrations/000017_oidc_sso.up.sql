@@ -0,0 +1,47 @@
+-- OIDC Single Sign-On Migration
+-- Pending authorization-code logins, the identity provider accounts linked
+-- to PMS users and the PMS roles users hold through their identity provider
+-- groups. Only a SHA-256 hash of the login state is stored; each login is
+-- single-use and expires after a few minutes.
+
+-- ============================================================
+-- OIDC LOGIN STATES (CoreSchema)
+-- ============================================================
+
+CREATE TABLE IF NOT EXISTS "CoreSchema".oidc_login_states (
+    id VARCHAR(450) PRIMARY KEY,
+    state VARCHAR(64) NOT NULL UNIQUE,
+    nonce TEXT NOT NULL,
+    code_verifier TEXT NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    consumed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+-- ============================================================
+-- USER IDP ROLES (CoreSchema)
+-- ============================================================
+
+CREATE TABLE IF NOT EXISTS "CoreSchema".user_idp_roles (
+    user_id VARCHAR(450) NOT NULL REFERENCES "CoreSchema".asp_net_users(id),
+    role_name VARCHAR(256) NOT NULL,
+    synced_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (user_id, role_name)
+);
+
+-- ============================================================
+-- USER EXTERNAL LOGINS (CoreSchema)
+-- ============================================================
+
+-- Users are matched on the ID token's issuer and subject, which the
+-- provider never reassigns, rather than on the mutable username claim.
+CREATE TABLE IF NOT EXISTS "CoreSchema".user_external_logins (
+    issuer VARCHAR(512) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    user_id VARCHAR(450) NOT NULL REFERENCES "CoreSchema".asp_net_users(id),
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (issuer, subject)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_external_logins_user
+    ON "CoreSchema".user_external_logins (user_id);
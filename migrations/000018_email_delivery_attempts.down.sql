@@ -0,0 +1,3 @@
+-- Reverse email delivery attempts migration
+
+DROP TABLE IF EXISTS pms.email_delivery_attempts;
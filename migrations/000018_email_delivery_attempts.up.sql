@@ -0,0 +1,20 @@
+-- Email Delivery Attempts Migration
+-- One row per attempt by the mail sender to deliver an email queued in the
+-- email service database, so failed and bounced emails keep their history
+-- and last error. email_id is the EmailObjects Id in that database.
+
+-- ============================================================
+-- EMAIL DELIVERY ATTEMPTS (pms schema)
+-- ============================================================
+
+CREATE TABLE IF NOT EXISTS pms.email_delivery_attempts (
+    email_delivery_attempt_id BIGSERIAL PRIMARY KEY,
+    email_id INT NOT NULL,
+    attempt INT NOT NULL,
+    outcome TEXT NOT NULL,
+    error TEXT,
+    attempted_at TIMESTAMPTZ NOT NULL,
+    duration_ms BIGINT
+);
+
+CREATE INDEX IF NOT EXISTS idx_email_delivery_attempts_email ON pms.email_delivery_attempts(email_id, attempted_at DESC);
@@ -0,0 +1,3 @@
+-- Reverse notifications migration
+
+DROP TABLE IF EXISTS pms.notifications;
@@ -0,0 +1,25 @@
+-- Notifications Migration
+-- The in-app notification inbox: one row per notification addressed to a
+-- staff member. notification_id increases monotonically and is the cursor
+-- of the live notification stream.
+
+-- ============================================================
+-- NOTIFICATIONS (pms schema)
+-- ============================================================
+
+CREATE TABLE IF NOT EXISTS pms.notifications (
+    notification_id BIGSERIAL PRIMARY KEY,
+    recipient_staff_id TEXT NOT NULL,
+    type TEXT NOT NULL,
+    title TEXT NOT NULL,
+    message TEXT,
+    entity_type TEXT,
+    entity_id TEXT,
+    link TEXT,
+    is_read BOOLEAN NOT NULL DEFAULT FALSE,
+    read_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_notifications_recipient ON pms.notifications(recipient_staff_id, notification_id DESC);
+CREATE INDEX IF NOT EXISTS idx_notifications_unread ON pms.notifications(recipient_staff_id) WHERE NOT is_read;
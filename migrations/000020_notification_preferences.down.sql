@@ -0,0 +1,5 @@
+-- Reverse notification preferences migration
+
+DROP TABLE IF EXISTS pms.notification_digest_items;
+DROP TABLE IF EXISTS pms.notification_preferences;
+ALTER TABLE pms.notifications DROP COLUMN IF EXISTS category;
@@ -0,0 +1,32 @@
+-- Notification Preferences Migration
+-- Per-staff delivery modes for each notification category, and the queue of
+-- notifications waiting for their recipient's daily digest email.
+
+ALTER TABLE pms.notifications ADD COLUMN IF NOT EXISTS category TEXT NOT NULL DEFAULT 'General';
+
+-- ============================================================
+-- NOTIFICATION PREFERENCES (pms schema)
+-- ============================================================
+
+CREATE TABLE IF NOT EXISTS pms.notification_preferences (
+    staff_id TEXT NOT NULL,
+    category TEXT NOT NULL,
+    mode TEXT NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (staff_id, category)
+);
+
+-- ============================================================
+-- NOTIFICATION DIGEST ITEMS (pms schema)
+-- ============================================================
+
+CREATE TABLE IF NOT EXISTS pms.notification_digest_items (
+    notification_digest_item_id BIGSERIAL PRIMARY KEY,
+    notification_id BIGINT NOT NULL REFERENCES pms.notifications(notification_id) ON DELETE CASCADE,
+    recipient_staff_id TEXT NOT NULL,
+    email_to TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    sent_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_notification_digest_pending ON pms.notification_digest_items(recipient_staff_id, notification_id) WHERE sent_at IS NULL;
@@ -0,0 +1,8 @@
+-- Reverse development plan lifecycle migration
+
+DROP TABLE IF EXISTS "CoreSchema".development_plan_reminders;
+DROP TABLE IF EXISTS "CoreSchema".development_plan_evidence;
+DROP INDEX IF EXISTS "CoreSchema".idx_development_plans_open_target;
+ALTER TABLE "CoreSchema".development_plans DROP COLUMN IF EXISTS supervisor_comment;
+ALTER TABLE "CoreSchema".development_plans DROP COLUMN IF EXISTS date_verified;
+ALTER TABLE "CoreSchema".development_plans DROP COLUMN IF EXISTS verified_by;
@@ -0,0 +1,86 @@
+-- Development Plan Lifecycle Migration
+-- Supervisor sign-off on development plans, evidence files attached to
+-- them, and the reminders sent about their target dates. Plans saved
+-- without a status start as Assigned (planned), and other free-text
+-- statuses move to their lifecycle equivalent. Statuses with no
+-- equivalent are left as they are and reported.
+
+ALTER TABLE "CoreSchema".development_plans ADD COLUMN IF NOT EXISTS verified_by TEXT;
+ALTER TABLE "CoreSchema".development_plans ADD COLUMN IF NOT EXISTS date_verified TIMESTAMPTZ;
+ALTER TABLE "CoreSchema".development_plans ADD COLUMN IF NOT EXISTS supervisor_comment TEXT;
+
+UPDATE "CoreSchema".development_plans SET task_status = mapped.status
+FROM (
+    SELECT development_plan_id,
+        CASE LOWER(REGEXP_REPLACE(COALESCE(task_status, ''), '[[:space:]_-]', '', 'g'))
+            WHEN '' THEN 'Assigned'
+            WHEN 'planned' THEN 'Assigned'
+            WHEN 'notstarted' THEN 'Assigned'
+            WHEN 'pending' THEN 'Assigned'
+            WHEN 'open' THEN 'Assigned'
+            WHEN 'new' THEN 'Assigned'
+            WHEN 'assigned' THEN 'Assigned'
+            WHEN 'initiated' THEN 'Initiated'
+            WHEN 'started' THEN 'InProgress'
+            WHEN 'ongoing' THEN 'InProgress'
+            WHEN 'inprogress' THEN 'InProgress'
+            WHEN 'done' THEN 'Completed'
+            WHEN 'complete' THEN 'Completed'
+            WHEN 'finished' THEN 'Completed'
+            WHEN 'completed' THEN 'Completed'
+            WHEN 'verified' THEN 'Verified'
+            WHEN 'closed' THEN 'ClosedGap'
+            WHEN 'gapclosed' THEN 'ClosedGap'
+            WHEN 'closedgap' THEN 'ClosedGap'
+        END AS status
+    FROM "CoreSchema".development_plans
+) mapped
+WHERE mapped.development_plan_id = "CoreSchema".development_plans.development_plan_id
+  AND mapped.status IS NOT NULL
+  AND task_status IS DISTINCT FROM mapped.status;
+
+DO $$
+DECLARE
+    unmapped RECORD;
+BEGIN
+    FOR unmapped IN
+        SELECT task_status, COUNT(*) AS plans
+        FROM "CoreSchema".development_plans
+        WHERE task_status NOT IN ('Assigned', 'Initiated', 'InProgress', 'Completed', 'Verified', 'ClosedGap', 'Draft')
+        GROUP BY task_status
+    LOOP
+        RAISE WARNING 'development_plans: % plan(s) have unrecognised status %; update them by hand',
+            unmapped.plans, quote_literal(unmapped.task_status);
+    END LOOP;
+END $$;
+
+CREATE INDEX IF NOT EXISTS idx_development_plans_open_target
+    ON "CoreSchema".development_plans (target_date)
+    WHERE soft_deleted = FALSE AND task_status IN ('Assigned', 'Initiated', 'InProgress');
+
+-- ============================================================
+-- DEVELOPMENT PLAN EVIDENCE (CoreSchema)
+-- ============================================================
+
+CREATE TABLE IF NOT EXISTS "CoreSchema".development_plan_evidence (
+    development_plan_evidence_id SERIAL PRIMARY KEY,
+    development_plan_id INT NOT NULL REFERENCES "CoreSchema".development_plans(development_plan_id) ON DELETE CASCADE,
+    file_upload_id TEXT NOT NULL REFERENCES pms.file_uploads(file_upload_id),
+    description TEXT,
+    uploaded_by TEXT,
+    date_uploaded TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_development_plan_evidence_plan
+    ON "CoreSchema".development_plan_evidence (development_plan_id);
+
+-- ============================================================
+-- DEVELOPMENT PLAN REMINDERS (CoreSchema)
+-- ============================================================
+
+CREATE TABLE IF NOT EXISTS "CoreSchema".development_plan_reminders (
+    development_plan_id INT NOT NULL REFERENCES "CoreSchema".development_plans(development_plan_id) ON DELETE CASCADE,
+    reminder_key TEXT NOT NULL,
+    sent_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (development_plan_id, reminder_key)
+);
@@ -0,0 +1,6 @@
+-- Reverse development plan rules migration
+
+DELETE FROM "CoreSchema".development_plans WHERE task_status = 'Draft';
+
+DROP INDEX IF EXISTS "CoreSchema".idx_development_plans_drafts;
+DROP TABLE IF EXISTS "CoreSchema".development_plan_rules;
@@ -0,0 +1,32 @@
+-- Development Plan Rules Migration
+-- Rules recommending development activities for competency gaps. Review
+-- profiles with a gap get development plans drafted from the rules, which
+-- the employee's supervisor confirms before the plans are assigned.
+
+CREATE TABLE IF NOT EXISTS "CoreSchema".development_plan_rules (
+    development_plan_rule_id SERIAL PRIMARY KEY,
+    competency_id INT REFERENCES "CoreSchema".competencies(competency_id),
+    competency_category_name TEXT,
+    min_gap INT NOT NULL DEFAULT 1,
+    max_gap INT NOT NULL DEFAULT 0,
+    training_type_name TEXT NOT NULL,
+    activity TEXT NOT NULL,
+    learning_resource TEXT,
+    target_days INT NOT NULL DEFAULT 90,
+    -- BaseAudit fields
+    created_by VARCHAR(75) DEFAULT 'SYSTEM',
+    date_created TIMESTAMPTZ DEFAULT NOW(),
+    is_active BOOLEAN DEFAULT TRUE,
+    status VARCHAR(25),
+    soft_deleted BOOLEAN DEFAULT FALSE,
+    date_updated TIMESTAMPTZ,
+    updated_by TEXT,
+    CHECK (min_gap >= 1 AND max_gap >= 0 AND target_days >= 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_development_plan_rules_competency
+    ON "CoreSchema".development_plan_rules (competency_id);
+
+CREATE INDEX IF NOT EXISTS idx_development_plans_drafts
+    ON "CoreSchema".development_plans (employee_number)
+    WHERE soft_deleted = FALSE AND task_status = 'Draft';
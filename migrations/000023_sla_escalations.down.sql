@@ -0,0 +1,4 @@
+-- Reverse SLA escalations migration
+
+DROP TABLE IF EXISTS pms.feedback_request_escalations;
+DROP TABLE IF EXISTS pms.sla_escalation_steps;
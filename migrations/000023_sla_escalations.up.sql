@@ -0,0 +1,47 @@
+-- SLA Escalations Migration
+-- Configurable escalation ladders per feedback request type, and a record
+-- of every step fired for a request. Types without a stored ladder use the
+-- built-in one; each threshold fires at most once per assignment of a
+-- request, so a reassigned or re-initiated request climbs the ladder again.
+
+-- ============================================================
+-- SLA ESCALATION STEPS (pms schema)
+-- ============================================================
+
+CREATE TABLE IF NOT EXISTS pms.sla_escalation_steps (
+    sla_escalation_step_id TEXT PRIMARY KEY,
+    feedback_request_type INT NOT NULL,
+    threshold_percent INT NOT NULL CHECK (threshold_percent > 0),
+    name TEXT NOT NULL,
+    notify_assignee BOOLEAN DEFAULT FALSE,
+    escalate_to INT DEFAULT 0,
+    role_name TEXT,
+    id SERIAL, record_status TEXT DEFAULT 'Active', created_at TIMESTAMPTZ DEFAULT NOW(),
+    soft_deleted BOOLEAN DEFAULT FALSE, status TEXT, updated_at TIMESTAMPTZ,
+    created_by VARCHAR(100), updated_by VARCHAR(100), is_active BOOLEAN DEFAULT TRUE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS ux_sla_escalation_steps_threshold
+    ON pms.sla_escalation_steps(feedback_request_type, threshold_percent)
+    WHERE soft_deleted = FALSE;
+
+-- ============================================================
+-- FEEDBACK REQUEST ESCALATIONS (pms schema)
+-- ============================================================
+
+CREATE TABLE IF NOT EXISTS pms.feedback_request_escalations (
+    feedback_request_escalation_id TEXT PRIMARY KEY,
+    feedback_request_log_id TEXT NOT NULL REFERENCES pms.feedback_request_logs(feedback_request_log_id),
+    assigned_staff_id TEXT NOT NULL,
+    time_initiated TIMESTAMPTZ NOT NULL,
+    threshold_percent INT NOT NULL,
+    step_name TEXT NOT NULL,
+    notified_staff_ids TEXT,
+    skipped BOOLEAN DEFAULT FALSE,
+    sla_used_percent INT,
+    due_at TIMESTAMPTZ,
+    sent_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS ux_feedback_request_escalations_threshold
+    ON pms.feedback_request_escalations(feedback_request_log_id, assigned_staff_id, time_initiated, threshold_percent);
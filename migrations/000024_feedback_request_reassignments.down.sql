@@ -0,0 +1,3 @@
+-- Reverse feedback request reassignments migration
+
+DROP TABLE IF EXISTS pms.feedback_request_reassignments;
@@ -0,0 +1,22 @@
+-- Feedback Request Reassignments Migration
+-- A record of every automatic reassignment of a breached feedback request
+-- and the policy that chose the new assignee. Used to cap reassignments
+-- per request and to rotate through reassignment pools.
+
+-- ============================================================
+-- FEEDBACK REQUEST REASSIGNMENTS (pms schema)
+-- ============================================================
+
+CREATE TABLE IF NOT EXISTS pms.feedback_request_reassignments (
+    feedback_request_reassignment_id TEXT PRIMARY KEY,
+    feedback_request_log_id TEXT NOT NULL REFERENCES pms.feedback_request_logs(feedback_request_log_id),
+    from_staff_id TEXT NOT NULL,
+    to_staff_id TEXT NOT NULL,
+    policy TEXT NOT NULL,
+    reassigned_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_feedback_request_reassignments_request
+    ON pms.feedback_request_reassignments(feedback_request_log_id);
+CREATE INDEX IF NOT EXISTS idx_feedback_request_reassignments_policy
+    ON pms.feedback_request_reassignments(policy, reassigned_at);
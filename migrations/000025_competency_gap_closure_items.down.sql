@@ -0,0 +1,3 @@
+-- Reverse competency gap closure items migration
+
+DROP TABLE IF EXISTS pms.competency_gap_closure_items;
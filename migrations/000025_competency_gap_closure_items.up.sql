@@ -0,0 +1,27 @@
+-- Competency Gap Closure Items Migration
+-- The closed-gap development plans credited to each competency gap
+-- closure. A development plan is credited at most once.
+
+-- ============================================================
+-- COMPETENCY GAP CLOSURE ITEMS (pms schema)
+-- ============================================================
+
+CREATE TABLE IF NOT EXISTS pms.competency_gap_closure_items (
+    competency_gap_closure_item_id TEXT PRIMARY KEY,
+    competency_gap_closure_id TEXT NOT NULL REFERENCES pms.competency_gap_closures(competency_gap_closure_id),
+    development_plan_id INT NOT NULL,
+    competency_review_profile_id INT NOT NULL,
+    staff_id TEXT NOT NULL,
+    staff_name TEXT,
+    review_period_id TEXT NOT NULL REFERENCES pms.performance_review_periods(period_id),
+    competency_name TEXT,
+    activity TEXT,
+    credited_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS ux_competency_gap_closure_items_plan
+    ON pms.competency_gap_closure_items(development_plan_id);
+CREATE INDEX IF NOT EXISTS idx_competency_gap_closure_items_closure
+    ON pms.competency_gap_closure_items(competency_gap_closure_id);
+CREATE INDEX IF NOT EXISTS idx_competency_gap_closure_items_period
+    ON pms.competency_gap_closure_items(review_period_id);
@@ -0,0 +1,194 @@
+package excel
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// Content types of the formats this package reads and writes.
+const (
+	ContentTypeXLSX = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	ContentTypeCSV  = "text/csv"
+)
+
+// Limits on the spreadsheets ReadRows accepts. A workbook may not unzip to
+// more than the 64 MiB an upload request may carry, and at most MaxRows rows
+// are read from either format.
+const (
+	MaxRows          = 50000
+	maxUnzipBytes    = 64 << 20
+	maxUnzipXMLBytes = 16 << 20
+)
+
+var (
+	// ErrUnsupportedFormat is returned for files that are neither .xlsx nor .csv.
+	ErrUnsupportedFormat = errors.New("unsupported spreadsheet format; upload an .xlsx or .csv file")
+	// ErrTooManyRows is returned for spreadsheets with more than MaxRows rows.
+	ErrTooManyRows = fmt.Errorf("spreadsheet has more than %d rows; split it into smaller uploads", MaxRows)
+)
+
+// Sheet is a named worksheet of text cells; the first row is the header.
+type Sheet struct {
+	Name string
+	Rows [][]string
+}
+
+// ReadRows returns the rows of a spreadsheet, choosing the format from the
+// file name's extension. For workbooks the first worksheet is read. Cells are
+// trimmed; blank rows are kept so rows[i] is always spreadsheet row i+1.
+// Spreadsheets longer than MaxRows fail with ErrTooManyRows.
+func ReadRows(r io.Reader, fileName string) ([][]string, error) {
+	rows, err := readRaw(r, fileName)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		for i := range row {
+			row[i] = strings.TrimSpace(row[i])
+		}
+	}
+	return rows, nil
+}
+
+func readRaw(r io.Reader, fileName string) ([][]string, error) {
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".csv":
+		return readCSV(r)
+	case ".xlsx":
+		return readXLSX(r)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+// readCSV reads every record of a CSV file, up to the row limit.
+func readCSV(r io.Reader) ([][]string, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+	var rows [][]string
+	for {
+		row, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading csv: %w", err)
+		}
+		if len(rows) == MaxRows {
+			return nil, ErrTooManyRows
+		}
+		rows = append(rows, row)
+	}
+	if len(rows) > 0 && len(rows[0]) > 0 {
+		rows[0][0] = strings.TrimPrefix(rows[0][0], "\ufeff")
+	}
+	return rows, nil
+}
+
+// readXLSX reads the first worksheet like excelize's GetRows, dropping
+// trailing blank rows, but stops at the row limit.
+func readXLSX(r io.Reader) ([][]string, error) {
+	f, err := excelize.OpenReader(r, excelize.Options{UnzipSizeLimit: maxUnzipBytes, UnzipXMLSizeLimit: maxUnzipXMLBytes})
+	if err != nil {
+		return nil, fmt.Errorf("opening workbook: %w", err)
+	}
+	defer f.Close()
+	sheets := f.GetSheetList()
+	if len(sheets) == 0 {
+		return nil, nil
+	}
+	iter, err := f.Rows(sheets[0])
+	if err != nil {
+		return nil, fmt.Errorf("reading worksheet %q: %w", sheets[0], err)
+	}
+	defer iter.Close()
+
+	var rows [][]string
+	for n := 1; iter.Next(); n++ {
+		row, err := iter.Columns()
+		if err != nil {
+			return nil, fmt.Errorf("reading worksheet %q: %w", sheets[0], err)
+		}
+		if len(row) == 0 {
+			continue
+		}
+		if n > MaxRows {
+			return nil, ErrTooManyRows
+		}
+		for len(rows) < n-1 {
+			rows = append(rows, nil)
+		}
+		rows = append(rows, row)
+	}
+	if err := iter.Error(); err != nil {
+		return nil, fmt.Errorf("reading worksheet %q: %w", sheets[0], err)
+	}
+	return rows, nil
+}
+
+// WriteXLSX writes sheets as an .xlsx workbook with a bold, frozen header row
+// on each sheet.
+func WriteXLSX(w io.Writer, sheets []Sheet) error {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	bold, err := f.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}})
+	if err != nil {
+		return fmt.Errorf("creating header style: %w", err)
+	}
+
+	for i, sheet := range sheets {
+		if i == 0 {
+			if err := f.SetSheetName(f.GetSheetName(0), sheet.Name); err != nil {
+				return fmt.Errorf("naming worksheet %q: %w", sheet.Name, err)
+			}
+		} else if _, err := f.NewSheet(sheet.Name); err != nil {
+			return fmt.Errorf("adding worksheet %q: %w", sheet.Name, err)
+		}
+
+		for r, row := range sheet.Rows {
+			cell, err := excelize.CoordinatesToCellName(1, r+1)
+			if err != nil {
+				return err
+			}
+			values := make([]interface{}, len(row))
+			for c, v := range row {
+				values[c] = v
+			}
+			if err := f.SetSheetRow(sheet.Name, cell, &values); err != nil {
+				return fmt.Errorf("writing worksheet %q: %w", sheet.Name, err)
+			}
+		}
+
+		if len(sheet.Rows) > 0 && len(sheet.Rows[0]) > 0 {
+			last, err := excelize.CoordinatesToCellName(len(sheet.Rows[0]), 1)
+			if err != nil {
+				return err
+			}
+			if err := f.SetCellStyle(sheet.Name, "A1", last, bold); err != nil {
+				return fmt.Errorf("styling worksheet %q: %w", sheet.Name, err)
+			}
+			if err := f.SetPanes(sheet.Name, &excelize.Panes{Freeze: true, YSplit: 1, TopLeftCell: "A2", ActivePane: "bottomLeft"}); err != nil {
+				return fmt.Errorf("freezing header of %q: %w", sheet.Name, err)
+			}
+		}
+	}
+
+	return f.Write(w)
+}
+
+// WriteCSV writes rows as CSV.
+func WriteCSV(w io.Writer, rows [][]string) error {
+	cw := csv.NewWriter(w)
+	if err := cw.WriteAll(rows); err != nil {
+		return fmt.Errorf("writing csv: %w", err)
+	}
+	return nil
+}
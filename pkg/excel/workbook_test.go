@@ -0,0 +1,67 @@
+package excel
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestWriteXLSXRoundTrip(t *testing.T) {
+	sheets := []Sheet{
+		{Name: "Objectives", Rows: [][]string{{"Enterprise Objective", "Category"}, {"Grow deposits", "Financial"}}},
+		{Name: "Categories", Rows: [][]string{{"Category"}, {"Financial"}}},
+	}
+	var buf bytes.Buffer
+	if err := WriteXLSX(&buf, sheets); err != nil {
+		t.Fatalf("WriteXLSX: %v", err)
+	}
+
+	got, err := ReadRows(&buf, "template.XLSX")
+	if err != nil {
+		t.Fatalf("ReadRows: %v", err)
+	}
+	if !reflect.DeepEqual(got, sheets[0].Rows) {
+		t.Errorf("ReadRows = %q, want the first sheet %q", got, sheets[0].Rows)
+	}
+}
+
+func TestReadRowsCSV(t *testing.T) {
+	in := "\ufeffEnterprise Objective , Category\n Grow deposits ,Financial\n\n"
+	got, err := ReadRows(strings.NewReader(in), "upload.csv")
+	if err != nil {
+		t.Fatalf("ReadRows: %v", err)
+	}
+	want := [][]string{{"Enterprise Objective", "Category"}, {"Grow deposits", "Financial"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ReadRows = %q, want %q", got, want)
+	}
+}
+
+func TestReadRowsUnsupportedFormat(t *testing.T) {
+	if _, err := ReadRows(strings.NewReader("x"), "upload.xls"); !errors.Is(err, ErrUnsupportedFormat) {
+		t.Errorf("ReadRows(.xls) error = %v, want ErrUnsupportedFormat", err)
+	}
+}
+
+func TestReadRowsRowLimit(t *testing.T) {
+	rows := make([][]string, MaxRows+1)
+	for i := range rows {
+		rows[i] = []string{"x"}
+	}
+
+	var csvBuf, xlsxBuf bytes.Buffer
+	if err := WriteCSV(&csvBuf, rows); err != nil {
+		t.Fatalf("WriteCSV: %v", err)
+	}
+	if err := WriteXLSX(&xlsxBuf, []Sheet{{Name: "Sheet1", Rows: rows}}); err != nil {
+		t.Fatalf("WriteXLSX: %v", err)
+	}
+	if _, err := ReadRows(bytes.NewReader(csvBuf.Bytes()), "upload.csv"); !errors.Is(err, ErrTooManyRows) {
+		t.Errorf("csv over the limit: error = %v, want ErrTooManyRows", err)
+	}
+	if _, err := ReadRows(bytes.NewReader(xlsxBuf.Bytes()), "upload.xlsx"); !errors.Is(err, ErrTooManyRows) {
+		t.Errorf("xlsx over the limit: error = %v, want ErrTooManyRows", err)
+	}
+}
@@ -0,0 +1,26 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrNotFound is returned by Backend.Get when no object is stored under the key.
+var ErrNotFound = errors.New("storage: object not found")
+
+// Backend stores objects under slash-separated keys such as
+// "grievances/2026/03/3f2c….pdf". Implementations must be safe for
+// concurrent use.
+type Backend interface {
+	// Name identifies the backend ("local", "s3") in upload records.
+	Name() string
+	// Put stores size bytes read from body under key, replacing any object
+	// already there.
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+	// Get opens the object stored under key. The caller must close it.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the object stored under key. Deleting a missing
+	// object is not an error.
+	Delete(ctx context.Context, key string) error
+}
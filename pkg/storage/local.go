@@ -0,0 +1,100 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LocalBackend stores objects as files under a root directory. It suits
+// single-instance deployments and development; replicas that do not share
+// the directory will not see each other's files.
+type LocalBackend struct {
+	root string
+}
+
+// NewLocalBackend creates a backend rooted at root, creating the directory
+// if it does not already exist.
+func NewLocalBackend(root string) (*LocalBackend, error) {
+	abs, err := filepath.Abs(root)
+	if err != nil {
+		return nil, fmt.Errorf("resolve storage root %q: %w", root, err)
+	}
+	if err := os.MkdirAll(abs, 0o755); err != nil {
+		return nil, fmt.Errorf("create storage root %q: %w", abs, err)
+	}
+	return &LocalBackend{root: abs}, nil
+}
+
+// Name implements Backend.
+func (b *LocalBackend) Name() string { return "local" }
+
+// Put implements Backend. The object is written to a temporary file and
+// renamed into place so readers never see a partial file.
+func (b *LocalBackend) Put(_ context.Context, key string, body io.Reader, _ int64, _ string) error {
+	path, err := b.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("create directory for %q: %w", key, err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("create file for %q: %w", key, err)
+	}
+	defer os.Remove(tmp.Name()) // No-op once renamed.
+
+	if _, err := io.Copy(tmp, body); err != nil {
+		tmp.Close()
+		return fmt.Errorf("write %q: %w", key, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("write %q: %w", key, err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("store %q: %w", key, err)
+	}
+	return nil
+}
+
+// Get implements Backend.
+func (b *LocalBackend) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	path, err := b.path(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("open %q: %w", key, err)
+	}
+	return f, nil
+}
+
+// Delete implements Backend.
+func (b *LocalBackend) Delete(_ context.Context, key string) error {
+	path, err := b.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("delete %q: %w", key, err)
+	}
+	return nil
+}
+
+// path maps key to a file under the root, rejecting keys that would escape it.
+func (b *LocalBackend) path(key string) (string, error) {
+	cleaned := filepath.Clean(filepath.FromSlash("/" + key))
+	if key == "" || cleaned == string(filepath.Separator) || strings.Contains(key, "..") {
+		return "", fmt.Errorf("storage: invalid key %q", key)
+	}
+	return filepath.Join(b.root, cleaned), nil
+}
@@ -0,0 +1,174 @@
+package storage
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// S3Options configures an S3Backend.
+type S3Options struct {
+	// Endpoint is the service URL, e.g. "https://s3.eu-west-1.amazonaws.com"
+	// or "http://minio:9000".
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// UsePathStyle addresses the bucket as a path segment
+	// (endpoint/bucket/key) rather than a host name, as MinIO and most
+	// S3-compatible stores expect.
+	UsePathStyle bool
+	// HTTPClient defaults to a client with a one-minute timeout.
+	HTTPClient *http.Client
+}
+
+// S3Backend stores objects in an S3-compatible bucket using the REST API
+// signed with Signature Version 4.
+type S3Backend struct {
+	endpoint  *url.URL
+	bucket    string
+	pathStyle bool
+	signer    sigV4Signer
+	client    *http.Client
+	now       func() time.Time
+}
+
+// NewS3Backend creates a backend for the bucket described by opts.
+func NewS3Backend(opts S3Options) (*S3Backend, error) {
+	if opts.Endpoint == "" || opts.Bucket == "" {
+		return nil, fmt.Errorf("storage: s3 endpoint and bucket are required")
+	}
+	endpoint, err := url.Parse(strings.TrimRight(opts.Endpoint, "/"))
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("storage: invalid s3 endpoint %q", opts.Endpoint)
+	}
+	region := opts.Region
+	if region == "" {
+		region = "us-east-1"
+	}
+	client := opts.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: time.Minute}
+	}
+	return &S3Backend{
+		endpoint:  endpoint,
+		bucket:    opts.Bucket,
+		pathStyle: opts.UsePathStyle,
+		signer: sigV4Signer{
+			accessKeyID:     opts.AccessKeyID,
+			secretAccessKey: opts.SecretAccessKey,
+			region:          region,
+			service:         "s3",
+		},
+		client: client,
+		now:    time.Now,
+	}, nil
+}
+
+// Name implements Backend.
+func (b *S3Backend) Name() string { return "s3" }
+
+// Put implements Backend.
+func (b *S3Backend) Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error {
+	req, err := b.request(ctx, http.MethodPut, key, body)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	resp, err := b.do(req, unsignedPayload)
+	if err != nil {
+		return fmt.Errorf("put %q: %w", key, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("put %q: %w", key, s3Error(resp))
+	}
+	return nil
+}
+
+// Get implements Backend.
+func (b *S3Backend) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	req, err := b.request(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := b.do(req, emptyPayloadHash)
+	if err != nil {
+		return nil, fmt.Errorf("get %q: %w", key, err)
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.Body, nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, ErrNotFound
+	default:
+		defer resp.Body.Close()
+		return nil, fmt.Errorf("get %q: %w", key, s3Error(resp))
+	}
+}
+
+// Delete implements Backend.
+func (b *S3Backend) Delete(ctx context.Context, key string) error {
+	req, err := b.request(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := b.do(req, emptyPayloadHash)
+	if err != nil {
+		return fmt.Errorf("delete %q: %w", key, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("delete %q: %w", key, s3Error(resp))
+	}
+	return nil
+}
+
+func (b *S3Backend) request(ctx context.Context, method, key string, body io.Reader) (*http.Request, error) {
+	if key == "" || strings.Contains(key, "..") {
+		return nil, fmt.Errorf("storage: invalid key %q", key)
+	}
+	u := *b.endpoint
+	path := "/" + strings.TrimLeft(key, "/")
+	if b.pathStyle {
+		path = "/" + b.bucket + path
+	} else {
+		u.Host = b.bucket + "." + u.Host
+	}
+	u.Path = strings.TrimRight(b.endpoint.Path, "/") + path
+	u.RawPath = canonicalURI(&url.URL{Path: u.Path})
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, fmt.Errorf("storage: building %s request for %q: %w", method, key, err)
+	}
+	return req, nil
+}
+
+func (b *S3Backend) do(req *http.Request, payloadHash string) (*http.Response, error) {
+	b.signer.sign(req, payloadHash, b.now())
+	return b.client.Do(req)
+}
+
+// s3Error turns an S3 error response into an error carrying its code.
+func s3Error(resp *http.Response) error {
+	var body struct {
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	}
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if xml.Unmarshal(data, &body) == nil && body.Code != "" {
+		return fmt.Errorf("s3 %s: %s: %s", resp.Status, body.Code, body.Message)
+	}
+	return fmt.Errorf("s3 %s", resp.Status)
+}
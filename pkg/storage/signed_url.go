@@ -0,0 +1,50 @@
+package storage
+
+import (
+	"crypto/hmac"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"time"
+)
+
+var (
+	// ErrURLExpired is returned for a download signature past its expiry.
+	ErrURLExpired = errors.New("storage: download link has expired")
+	// ErrInvalidSignature is returned for a download signature that does
+	// not match the file, user and expiry it is presented with.
+	ErrInvalidSignature = errors.New("storage: invalid download signature")
+)
+
+// URLSigner issues and checks time-limited download signatures. A
+// signature binds a file ID to the user it was issued to and an expiry, so
+// a copied link is useless to anyone else and stops working once expired.
+type URLSigner struct {
+	key []byte
+}
+
+// NewURLSigner creates a signer using key as the HMAC secret.
+func NewURLSigner(key string) *URLSigner {
+	return &URLSigner{key: []byte(key)}
+}
+
+// Sign returns the signature for fileID, issued to subject, valid until expires.
+func (s *URLSigner) Sign(fileID, subject string, expires time.Time) string {
+	return hex.EncodeToString(hmacSHA256(s.key, fileID+"\n"+subject+"\n"+strconv.FormatInt(expires.Unix(), 10)))
+}
+
+// Verify checks signature against fileID, subject and expires at time now.
+func (s *URLSigner) Verify(fileID, subject string, expires time.Time, signature string, now time.Time) error {
+	want, err := hex.DecodeString(s.Sign(fileID, subject, expires))
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	got, err := hex.DecodeString(signature)
+	if err != nil || !hmac.Equal(got, want) {
+		return ErrInvalidSignature
+	}
+	if now.After(expires) {
+		return ErrURLExpired
+	}
+	return nil
+}